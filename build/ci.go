@@ -26,6 +26,7 @@ Available commands are:
    install    [ -arch architecture ] [ -cc compiler ] [ packages... ]                          -- builds packages and executables
    test       [ -coverage ] [ packages... ]                                                    -- runs the tests
    lint                                                                                        -- runs certain pre-selected linters
+   bench      [ -count n ] [ -o file ] [ -baseline file ] [ -threshold percent ] [ packages... ] -- runs the benchmarks, compares with baseline
    archive    [ -arch architecture ] [ -type zip|tar ] [ -signer key-envvar ] [ -signify key-envvar ] [ -upload dest ] -- archives build artifacts
    importkeys                                                                                  -- imports signing keys from env
    debsrc     [ -signer key-id ] [ -upload dest ]                                              -- creates a debian source package
@@ -46,6 +47,7 @@ import (
 	"encoding/base64"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -54,6 +56,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -180,6 +183,8 @@ func main() {
 		doTest(os.Args[2:])
 	case "lint":
 		doLint(os.Args[2:])
+	case "bench":
+		doBench(os.Args[2:])
 	case "archive":
 		doArchive(os.Args[2:])
 	case "debsrc":
@@ -308,6 +313,112 @@ func doTest(cmdline []string) {
 	build.MustRun(gotest)
 }
 
+// Running The Benchmarks
+//
+// The benchmarks of native contracts cover the governance methods, cross chain message import
+// and header sync, the results are written in the format of `go test -bench`, so that they can
+// be compared by benchstat as well.
+
+// benchPackages are the packages benchmarked by default.
+var benchPackages = []string{
+	"./contracts/native/governance/node_manager",
+	"./contracts/native/cross_chain_manager/common",
+	"./contracts/native/cross_chain_manager/eth",
+	"./contracts/native/header_sync/common",
+}
+
+// benchLine matches the result line of a benchmark, the suffix of GOMAXPROCS is dropped.
+var benchLine = regexp.MustCompile(`^(Benchmark[^\s]*?)(-\d+)?\s+\d+\s+([\d.]+) ns/op`)
+
+// parseBench returns the mean ns/op of the benchmarks in the output.
+func parseBench(output []byte) map[string]float64 {
+	var (
+		sums   = make(map[string]float64)
+		counts = make(map[string]int)
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		m := benchLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		var ns float64
+		fmt.Sscan(m[3], &ns)
+		sums[m[1]] += ns
+		counts[m[1]]++
+	}
+	for name := range sums {
+		sums[name] /= float64(counts[name])
+	}
+	return sums
+}
+
+func doBench(cmdline []string) {
+	var (
+		count     = flag.Int("count", 5, "Number of runs of each benchmark")
+		output    = flag.String("o", "", "File to write the benchmark results to")
+		baseline  = flag.String("baseline", "", "File of benchmark results to compare with")
+		threshold = flag.Float64("threshold", 20, "Allowed slowdown against baseline in percent")
+	)
+	flag.CommandLine.Parse(cmdline)
+
+	packages := benchPackages
+	if len(flag.CommandLine.Args()) > 0 {
+		packages = flag.CommandLine.Args()
+	}
+	gotest := new(build.GoToolchain).Go("test", "-run", "^$", "-bench", ".", "-benchmem", "-count", fmt.Sprint(*count))
+	gotest.Args = append(gotest.Args, packages...)
+
+	fmt.Println(">>>", strings.Join(gotest.Args, " "))
+	if *build.DryRunFlag {
+		return
+	}
+	var out bytes.Buffer
+	gotest.Stdout = io.MultiWriter(os.Stdout, &out)
+	gotest.Stderr = os.Stderr
+	if err := gotest.Run(); err != nil {
+		log.Fatal(err)
+	}
+	if *output != "" {
+		if err := ioutil.WriteFile(*output, out.Bytes(), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *baseline == "" {
+		return
+	}
+	base, err := ioutil.ReadFile(*baseline)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var (
+		prev      = parseBench(base)
+		cur       = parseBench(out.Bytes())
+		names     = make([]string, 0, len(cur))
+		regressed bool
+	)
+	for name := range cur {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		old, ok := prev[name]
+		if !ok || old == 0 {
+			fmt.Printf("%-50s %14.0f ns/op (new)\n", name, cur[name])
+			continue
+		}
+		delta := (cur[name] - old) / old * 100
+		mark := ""
+		if delta > *threshold {
+			mark, regressed = " REGRESSION", true
+		}
+		fmt.Printf("%-50s %14.0f ns/op %+8.2f%%%s\n", name, cur[name], delta, mark)
+	}
+	if regressed {
+		log.Fatalf("benchmarks regressed more than %.0f%% against %s", *threshold, *baseline)
+	}
+}
+
 // doLint runs golangci-lint on requested packages.
 func doLint(cmdline []string) {
 	var (
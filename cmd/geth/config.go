@@ -34,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/relayer"
 	"github.com/naoina/toml"
 )
 
@@ -164,6 +165,23 @@ func makeFullNode(ctx *cli.Context) (*node.Node, ethapi.Backend) {
 	if cfg.Ethstats.URL != "" {
 		utils.RegisterEthStatsService(stack, backend, cfg.Ethstats.URL)
 	}
+	// Add the bridge health endpoint if requested.
+	if ctx.GlobalBool(utils.BridgeHealthFlag.Name) {
+		utils.RegisterBridgeHealthService(stack, eth)
+	}
+	// Add the native state diff stream if requested.
+	if ctx.GlobalBool(utils.NativeDiffFlag.Name) {
+		utils.RegisterNativeDiffService(stack, eth)
+	}
+	// Add the relayer service if requested.
+	var relay *relayer.Service
+	if ctx.GlobalIsSet(utils.RelayerKeyFlag.Name) {
+		relay = utils.RegisterRelayerService(ctx, stack, backend)
+	}
+	// Add the header proxy service if requested.
+	if ctx.GlobalIsSet(utils.HeaderProxySourcesFlag.Name) {
+		utils.RegisterHeaderProxyService(ctx, stack, backend, relay)
+	}
 	return stack, backend
 }
 
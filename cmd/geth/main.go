@@ -104,6 +104,20 @@ var (
 		utils.UltraLightOnlyAnnounceFlag,
 		utils.LightNoSyncServeFlag,
 		utils.WhitelistFlag,
+		utils.SubjectivityCheckpointFlag,
+		utils.HeaderSyncAlertChainsFlag,
+		utils.HeaderSyncAlertLagFlag,
+		utils.HotStuffSignerFlag,
+		utils.HotStuffSignerTimeoutFlag,
+		utils.HotStuffValidatorFlag,
+		utils.BridgeHealthFlag,
+		utils.NativeDiffFlag,
+		utils.RelayerKeyFlag,
+		utils.RelayerSignersFlag,
+		utils.RelayerPriceBumpFlag,
+		utils.RelayerResubmitFlag,
+		utils.HeaderProxySourcesFlag,
+		utils.HeaderProxyMaxHeadersFlag,
 		utils.BloomFilterSizeFlag,
 		utils.CacheFlag,
 		utils.CacheDatabaseFlag,
@@ -238,6 +252,8 @@ func init() {
 		utils.ShowDeprecated,
 		// See snapshot.go
 		snapshotCommand,
+		// See zioncmd.go
+		zionCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -54,6 +54,20 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.IdentityFlag,
 			utils.LightKDFFlag,
 			utils.WhitelistFlag,
+			utils.SubjectivityCheckpointFlag,
+			utils.HeaderSyncAlertChainsFlag,
+			utils.HeaderSyncAlertLagFlag,
+			utils.HotStuffSignerFlag,
+			utils.HotStuffSignerTimeoutFlag,
+			utils.HotStuffValidatorFlag,
+			utils.BridgeHealthFlag,
+			utils.NativeDiffFlag,
+			utils.RelayerKeyFlag,
+			utils.RelayerSignersFlag,
+			utils.RelayerPriceBumpFlag,
+			utils.RelayerResubmitFlag,
+			utils.HeaderProxySourcesFlag,
+			utils.HeaderProxyMaxHeadersFlag,
 		},
 	},
 	{
@@ -0,0 +1,321 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	nutils "github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	validatorCheckKeyFlag = cli.StringFlag{
+		Name:  "nodekey",
+		Usage: "Node key file of the validator, which is also its consensus key",
+	}
+	validatorCheckRPCFlag = cli.StringFlag{
+		Name:  "rpc",
+		Usage: "RPC endpoint of the validator node with the admin API enabled, e.g. the IPC file",
+	}
+	validatorCheckRefFlag = cli.StringFlag{
+		Name:  "ref",
+		Usage: "RPC endpoint of a trusted Zion node to compare the chain head with, the validator node by default",
+	}
+	validatorCheckSkewFlag = cli.DurationFlag{
+		Name:  "maxskew",
+		Usage: "Max clock skew allowed against the timestamp of the chain head",
+		Value: 5 * time.Second,
+	}
+	validatorCheckLagFlag = cli.Uint64Flag{
+		Name:  "maxlag",
+		Usage: "Max blocks the validator node is allowed to lag behind the reference node",
+		Value: 10,
+	}
+	validatorCheckJSONFlag = cli.BoolFlag{
+		Name:  "json",
+		Usage: "Output the report in JSON",
+	}
+)
+
+const validatorCheckTimeout = 30 * time.Second
+
+// Status of the validator checks, the check fails only if the validator is sure to break the
+// epoch transition, e.g. a key rejected by the node manager.
+const (
+	checkOK   = "ok"
+	checkWarn = "warn"
+	checkFail = "fail"
+)
+
+type validatorCheckResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// validatorReport is the output of `geth zion validator-check`.
+type validatorReport struct {
+	Address common.Address          `json:"address"`
+	PubKey  string                  `json:"pubkey"`
+	NodeID  string                  `json:"nodeID"`
+	Checks  []*validatorCheckResult `json:"checks"`
+}
+
+func (r *validatorReport) add(name, status, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, &validatorCheckResult{Name: name, Status: status, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (r *validatorReport) failed() bool {
+	for _, c := range r.Checks {
+		if c.Status == checkFail {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *validatorReport) print(asJSON bool) error {
+	if asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+	fmt.Printf("validator %s\npubkey    %s\nnode id   %s\n\n", r.Address.Hex(), r.PubKey, r.NodeID)
+	for _, c := range r.Checks {
+		fmt.Printf("%-5s %-12s %s\n", c.Status, c.Name, c.Detail)
+	}
+	return nil
+}
+
+// validatorPeer returns the peer of the validator key as proposed in the epoch.
+func validatorPeer(key *ecdsa.PrivateKey) *node_manager.PeerInfo {
+	return &node_manager.PeerInfo{
+		Address: crypto.PubkeyToAddress(key.PublicKey),
+		PubKey:  hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)),
+	}
+}
+
+// checkValidatorKey checks the address and public key pair of the validator against the rule of
+// the node manager, which rejects the whole epoch proposal on a bad peer.
+func checkValidatorKey(r *validatorReport, peer *node_manager.PeerInfo) {
+	if err := node_manager.CheckPeer(peer); err != nil {
+		r.add("key", checkFail, "peer rejected by node manager: %v", err)
+		return
+	}
+	r.add("key", checkOK, "address and pubkey pass the peer check")
+}
+
+// checkNodeIdentity checks the node is running with the validator key, the consensus messages
+// are signed by the node key.
+func checkNodeIdentity(r *validatorReport, key *ecdsa.PrivateKey, info *p2p.NodeInfo) {
+	if id := enode.PubkeyToIDV4(&key.PublicKey).String(); info.ID != id {
+		r.add("identity", checkFail, "node is running with key of id %s, not the validator key", info.ID)
+		return
+	}
+	r.add("identity", checkOK, "node is running with the validator key")
+}
+
+// checkConnectivity checks the node is connected to the members of the current epoch, the
+// validator is not able to vote if it can't reach a quorum of them.
+func checkConnectivity(r *validatorReport, self common.Address, epoch *node_manager.EpochInfo, peers []*p2p.PeerInfo) {
+	connected := make(map[string]bool)
+	for _, p := range peers {
+		connected[p.ID] = true
+	}
+	var (
+		members, reached int
+		missing          []common.Address
+	)
+	for _, m := range epoch.Peers.List {
+		if m.Address == self {
+			continue
+		}
+		members++
+		raw, err := hexutil.Decode(m.PubKey)
+		if err != nil {
+			continue
+		}
+		pub, err := crypto.DecompressPubkey(raw)
+		if err != nil {
+			continue
+		}
+		if connected[enode.PubkeyToIDV4(pub).String()] {
+			reached++
+		} else {
+			missing = append(missing, m.Address)
+		}
+	}
+	if members == 0 {
+		r.add("connectivity", checkWarn, "no other member in epoch %d, %d peers connected", epoch.ID, len(peers))
+		return
+	}
+	quorum := validator.NewSet(epoch.MemberList(), hotstuff.RoundRobin).Q()
+	switch {
+	case reached == 0:
+		r.add("connectivity", checkFail, "none of %d members of epoch %d connected", members, epoch.ID)
+	case reached+1 < quorum:
+		r.add("connectivity", checkWarn, "%d of %d members of epoch %d connected, quorum %d, missing %v", reached, members, epoch.ID, quorum, missing)
+	default:
+		r.add("connectivity", checkOK, "%d of %d members of epoch %d connected", reached, members, epoch.ID)
+	}
+}
+
+// checkClockSkew checks the local clock against the timestamp of the chain head, the blocks
+// from the future are rejected by the consensus engine.
+func checkClockSkew(r *validatorReport, now time.Time, head *big.Int, headTime uint64, maxSkew time.Duration) {
+	skew := now.Sub(time.Unix(int64(headTime), 0))
+	switch {
+	case skew < -maxSkew:
+		r.add("clock", checkFail, "local clock is %v behind the head block %d", -skew, head)
+	case skew > maxSkew:
+		r.add("clock", checkWarn, "head block %d is %v old, the local clock is ahead or the chain stalls", head, skew)
+	default:
+		r.add("clock", checkOK, "skew %v against the head block %d", skew, head)
+	}
+}
+
+// checkSync checks the validator node is synced to the head of the reference node.
+func checkSync(r *validatorReport, progress *ethereum.SyncProgress, head, refHead uint64, maxLag uint64) {
+	if progress != nil {
+		r.add("sync", checkWarn, "syncing, block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+		return
+	}
+	if refHead > head && refHead-head > maxLag {
+		r.add("sync", checkFail, "head %d lags %d blocks behind the reference %d", head, refHead-head, refHead)
+		return
+	}
+	r.add("sync", checkOK, "head %d, reference %d", head, refHead)
+}
+
+// currentEpoch returns the current epoch of the node manager at the node.
+func currentEpoch(ctx context.Context, client *ethclient.Client) (*node_manager.EpochInfo, error) {
+	payload, err := new(node_manager.MethodEpochInput).Encode()
+	if err != nil {
+		return nil, err
+	}
+	enc, err := client.CallContract(ctx, ethereum.CallMsg{To: &nutils.NodeManagerContractAddress, Data: payload}, nil)
+	if err != nil {
+		return nil, err
+	}
+	output := new(node_manager.MethodEpochOutput)
+	if err := output.Decode(enc); err != nil {
+		return nil, err
+	}
+	return output.Epoch, nil
+}
+
+func zionValidatorCheck(ctx *cli.Context) error {
+	if args := ctx.Args(); len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+	keyfile, endpoint := ctx.String(validatorCheckKeyFlag.Name), ctx.String(validatorCheckRPCFlag.Name)
+	if keyfile == "" || endpoint == "" {
+		return fmt.Errorf("--%s and --%s are required", validatorCheckKeyFlag.Name, validatorCheckRPCFlag.Name)
+	}
+	key, err := crypto.LoadECDSA(keyfile)
+	if err != nil {
+		return fmt.Errorf("load node key: %v", err)
+	}
+	peer := validatorPeer(key)
+	r := &validatorReport{Address: peer.Address, PubKey: peer.PubKey, NodeID: enode.PubkeyToIDV4(&key.PublicKey).String()}
+	checkValidatorKey(r, peer)
+
+	c, cancel := context.WithTimeout(context.Background(), validatorCheckTimeout)
+	defer cancel()
+	node, err := rpc.DialContext(c, endpoint)
+	if err != nil {
+		return fmt.Errorf("dial %s: %v", endpoint, err)
+	}
+	defer node.Close()
+	client := ethclient.NewClient(node)
+
+	info := new(p2p.NodeInfo)
+	if err := node.CallContext(c, info, "admin_nodeInfo"); err != nil {
+		r.add("identity", checkFail, "admin_nodeInfo: %v", err)
+	} else {
+		checkNodeIdentity(r, key, info)
+	}
+
+	var peers []*p2p.PeerInfo
+	epoch, err := currentEpoch(c, client)
+	if err == nil {
+		err = node.CallContext(c, &peers, "admin_peers")
+	}
+	if err != nil {
+		r.add("connectivity", checkFail, "%v", err)
+	} else {
+		checkConnectivity(r, peer.Address, epoch, peers)
+		member := "not a member of"
+		for _, m := range epoch.MemberList() {
+			if m == peer.Address {
+				member = "a member of"
+			}
+		}
+		r.add("epoch", checkOK, "%s current epoch %d", member, epoch.ID)
+	}
+
+	head, err := client.HeaderByNumber(c, nil)
+	if err != nil {
+		return fmt.Errorf("get head: %v", err)
+	}
+	refHead := head
+	if ref := ctx.String(validatorCheckRefFlag.Name); ref != "" {
+		refClient, err := ethclient.DialContext(c, ref)
+		if err != nil {
+			return fmt.Errorf("dial %s: %v", ref, err)
+		}
+		defer refClient.Close()
+		if refHead, err = refClient.HeaderByNumber(c, nil); err != nil {
+			return fmt.Errorf("get reference head: %v", err)
+		}
+	}
+	checkClockSkew(r, time.Now(), refHead.Number, refHead.Time, ctx.Duration(validatorCheckSkewFlag.Name))
+	if progress, err := client.SyncProgress(c); err != nil {
+		r.add("sync", checkFail, "eth_syncing: %v", err)
+	} else {
+		checkSync(r, progress, head.Number.Uint64(), refHead.Number.Uint64(), ctx.Uint64(validatorCheckLagFlag.Name))
+	}
+
+	if err := r.print(ctx.Bool(validatorCheckJSONFlag.Name)); err != nil {
+		return err
+	}
+	if r.failed() {
+		return errors.New("validator check failed")
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+func lastCheck(r *validatorReport) *validatorCheckResult {
+	return r.Checks[len(r.Checks)-1]
+}
+
+func TestCheckValidatorKey(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	r := new(validatorReport)
+
+	checkValidatorKey(r, validatorPeer(key))
+	if c := lastCheck(r); c.Status != checkOK {
+		t.Fatalf("expect valid key, got %s: %s", c.Status, c.Detail)
+	}
+	peer := validatorPeer(key)
+	peer.PubKey = hexutil.Encode(crypto.CompressPubkey(&other.PublicKey))
+	checkValidatorKey(r, peer)
+	if c := lastCheck(r); c.Status != checkFail {
+		t.Fatalf("expect mismatched pubkey rejected, got %s", c.Status)
+	}
+	if !r.failed() {
+		t.Fatal("expect report failed")
+	}
+
+	checkNodeIdentity(r, key, &p2p.NodeInfo{ID: enode.PubkeyToIDV4(&other.PublicKey).String()})
+	if c := lastCheck(r); c.Status != checkFail {
+		t.Fatalf("expect node of other key rejected, got %s", c.Status)
+	}
+}
+
+func TestCheckConnectivity(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	epoch := &node_manager.EpochInfo{ID: 2, Peers: &node_manager.Peers{}}
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		epoch.Peers.List = append(epoch.Peers.List, validatorPeer(keys[i]))
+	}
+	self := crypto.PubkeyToAddress(keys[0].PublicKey)
+	connect := func(keys ...*ecdsa.PrivateKey) []*p2p.PeerInfo {
+		var peers []*p2p.PeerInfo
+		for _, key := range keys {
+			peers = append(peers, &p2p.PeerInfo{ID: enode.PubkeyToIDV4(&key.PublicKey).String()})
+		}
+		return peers
+	}
+
+	for _, c := range []struct {
+		peers  []*p2p.PeerInfo
+		status string
+	}{
+		{nil, checkFail},
+		{connect(keys[1]), checkWarn},
+		{connect(keys[1], keys[2]), checkOK},
+		{connect(keys[1:]...), checkOK},
+	} {
+		r := new(validatorReport)
+		checkConnectivity(r, self, epoch, c.peers)
+		if got := lastCheck(r); got.Status != c.status {
+			t.Fatalf("%d peers connected, expect %s, got %s: %s", len(c.peers), c.status, got.Status, got.Detail)
+		}
+	}
+}
+
+func TestCheckClockSkew(t *testing.T) {
+	now := time.Unix(1000, 0)
+	for _, c := range []struct {
+		headTime uint64
+		status   string
+	}{
+		{998, checkOK},
+		{1003, checkOK},
+		{1010, checkFail},
+		{900, checkWarn},
+	} {
+		r := new(validatorReport)
+		checkClockSkew(r, now, big.NewInt(1), c.headTime, 5*time.Second)
+		if got := lastCheck(r); got.Status != c.status {
+			t.Fatalf("head time %d, expect %s, got %s: %s", c.headTime, c.status, got.Status, got.Detail)
+		}
+	}
+}
@@ -0,0 +1,648 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/tool"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/selftest"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	nutils "github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	devnetValidatorsFlag = cli.IntFlag{
+		Name:  "validators",
+		Usage: "Number of genesis validators, at least 4",
+		Value: 4,
+	}
+	devnetSideChainsFlag = cli.StringFlag{
+		Name:  "sidechains",
+		Usage: "Comma separated side chains registered after start (" + strings.Join(devnetSideChainNames(), ",") + ")",
+		Value: "eth,heco",
+	}
+	devnetDirFlag = cli.StringFlag{
+		Name:  "dir",
+		Usage: "Data directory of the network, a temporary one is created and removed on exit if empty",
+	}
+	devnetPortFlag = cli.IntFlag{
+		Name:  "port",
+		Usage: "Network listening port of the first node, the others listen on the following ports",
+		Value: 30400,
+	}
+	devnetHTTPPortFlag = cli.IntFlag{
+		Name:  "http.port",
+		Usage: "HTTP-RPC server port of the first node, the others listen on the following ports",
+		Value: 8645,
+	}
+	devnetNoRotateFlag = cli.BoolFlag{
+		Name:  "norotate",
+		Usage: "Disable the scripted epoch rotation",
+	}
+
+	zionCommand = cli.Command{
+		Name:     "zion",
+		Usage:    "A set of commands for developing the Zion network",
+		Category: "MISCELLANEOUS COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:     "devnet",
+				Usage:    "Run a local multi-validator Zion network",
+				Action:   utils.MigrateFlags(zionDevnet),
+				Category: "MISCELLANEOUS COMMANDS",
+				Flags: []cli.Flag{
+					devnetValidatorsFlag,
+					devnetSideChainsFlag,
+					devnetDirFlag,
+					devnetPortFlag,
+					devnetHTTPPortFlag,
+					devnetNoRotateFlag,
+				},
+				Description: `
+geth zion devnet --validators 4 --sidechains eth,heco
+
+Starts the validators of a fresh hotstuff network in process, registers the
+side chains through the side chain manager and the approvals of the validators,
+then rotates the epoch by proposing and voting the next epoch of the validators,
+and waits for the consensus engines restarting at the new epoch. The network
+keeps running until interrupted, every node serves HTTP-RPC on the local host.`,
+			},
+			{
+				Name:     "verify-handlers",
+				Usage:    "Run the proof verifiers of cross chain handlers against the known good fixtures",
+				Action:   utils.MigrateFlags(zionVerifyHandlers),
+				Category: "MISCELLANEOUS COMMANDS",
+				Description: `
+geth zion verify-handlers
+
+Verifies the embedded known good proofs of the side chain routers with the
+handlers built into the binary, and fails if any of them is rejected. The same
+self-test runs at the node startup, the command checks a build without starting
+the node, e.g. after the dependencies are upgraded.`,
+			},
+			{
+				Name:     "validator-check",
+				Usage:    "Check the readiness of a prospective validator before it's proposed in an epoch",
+				Action:   utils.MigrateFlags(zionValidatorCheck),
+				Category: "MISCELLANEOUS COMMANDS",
+				Flags: []cli.Flag{
+					validatorCheckKeyFlag,
+					validatorCheckRPCFlag,
+					validatorCheckRefFlag,
+					validatorCheckSkewFlag,
+					validatorCheckLagFlag,
+					validatorCheckJSONFlag,
+				},
+				Description: `
+geth zion validator-check --nodekey <datadir>/geth/nodekey --rpc <datadir>/geth.ipc
+
+Verifies that the node key of the validator passes the peer check of the node
+manager, the node is running with the key, connected to the members of current
+epoch, synced to the head of the reference node and its clock agrees with the
+chain head. A report is printed, and the command fails if any check fails, so
+that a misconfigured member is found before the epoch transition.`,
+			},
+		},
+	}
+)
+
+// devnetSideChain is the preset used to register a side chain of the devnet.
+type devnetSideChain struct {
+	ChainID uint64
+	Router  uint64
+}
+
+var devnetSideChains = map[string]devnetSideChain{
+	"eth":     {ChainID: 2, Router: nutils.ETH_ROUTER},
+	"cosmos":  {ChainID: 5, Router: nutils.COSMOS_ROUTER},
+	"bsc":     {ChainID: 6, Router: nutils.BSC_ROUTER},
+	"heco":    {ChainID: 7, Router: nutils.HECO_ROUTER},
+	"quorum":  {ChainID: 8, Router: nutils.QUORUM_ROUTER},
+	"zilliqa": {ChainID: 9, Router: nutils.ZILLIQA_ROUTER},
+	"msc":     {ChainID: 10, Router: nutils.MSC_ROUTER},
+	"okex":    {ChainID: 12, Router: nutils.OKEX_ROUTER},
+	"polygon": {ChainID: 16, Router: nutils.POLYGON_BOR_ROUTER},
+}
+
+const (
+	devnetChainID      = 60801
+	devnetTxTimeout    = time.Minute
+	devnetRotateMargin = 20 // blocks left for voting before the proposal expires
+)
+
+func devnetSideChainNames() []string {
+	names := make([]string, 0, len(devnetSideChains))
+	for name := range devnetSideChains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseDevnetSideChains resolves the comma separated side chain names.
+func parseDevnetSideChains(list string) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		if _, ok := devnetSideChains[name]; !ok {
+			return nil, fmt.Errorf("unknown side chain %q, expect one of %s", name, strings.Join(devnetSideChainNames(), ","))
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// makeDevnetGenesis creates a hotstuff genesis of the validators, which are funded and
+// stored as the peers of the first epoch.
+func makeDevnetGenesis(validators []*ecdsa.PrivateKey) (*core.Genesis, error) {
+	config := *params.TestChainConfig
+	config.ChainID = big.NewInt(devnetChainID)
+	config.GovV2Block = big.NewInt(0)
+	config.CrossChainV2Block = big.NewInt(0)
+	config.StorageV2Block = big.NewInt(0)
+	config.CrossChainBloomBlock = big.NewInt(0)
+	config.ImportRootBlock = big.NewInt(0)
+	config.EpochHashV1Block = big.NewInt(0)
+	config.InputRulesBlock = big.NewInt(0)
+	config.SystemTxBlock = big.NewInt(0)
+	config.LightClientV2Block = big.NewInt(0)
+	config.StorageRefundBlock = big.NewInt(0)
+	config.EpochHashV2Block = big.NewInt(0)
+	config.AccessControlBlock = big.NewInt(0)
+	config.StorageRootCacheBlock = big.NewInt(0)
+	config.ReentrancyGuardBlock = big.NewInt(0)
+	config.NativeRevertBlock = big.NewInt(0)
+	config.Ethash = nil
+	config.HotStuff = &params.HotStuffConfig{Protocol: string(hotstuff.HOTSTUFF_PROTOCOL_BASIC)}
+
+	var (
+		alloc   = make(core.GenesisAlloc)
+		addrs   = make([]common.Address, len(validators))
+		balance = new(big.Int).Mul(big.NewInt(1000000), big.NewInt(params.Ether))
+	)
+	for i, key := range validators {
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+		alloc[addrs[i]] = core.GenesisAccount{Balance: balance, PublicKey: crypto.CompressPubkey(&key.PublicKey)}
+	}
+	extra, err := tool.Encode(validator.NewSet(addrs, hotstuff.RoundRobin).AddressList())
+	if err != nil {
+		return nil, err
+	}
+	return &core.Genesis{
+		Config:     &config,
+		Timestamp:  uint64(time.Now().Unix()),
+		ExtraData:  hexutil.MustDecode(extra),
+		GasLimit:   30000000,
+		Difficulty: big.NewInt(1),
+		Mixhash:    types.HotstuffDigest,
+		Alloc:      alloc,
+	}, nil
+}
+
+// devnetNode is a node of the devnet running in process.
+type devnetNode struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+	stack   *node.Node
+	backend *eth.Ethereum
+	client  *ethclient.Client
+}
+
+// startDevnetNode starts a mining node, the static nodes are the genesis validators which
+// are also taken as the initial validator set by the consensus engine.
+func startDevnetNode(dir string, key *ecdsa.PrivateKey, genesis *core.Genesis, statics []string, port, httpPort int) (*devnetNode, error) {
+	instance := filepath.Join(dir, "geth")
+	if err := os.MkdirAll(instance, 0700); err != nil {
+		return nil, err
+	}
+	enc, err := json.MarshalIndent(statics, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(instance, "static-nodes.json"), enc, 0600); err != nil {
+		return nil, err
+	}
+	stack, err := node.New(&node.Config{
+		Name:        "geth",
+		Version:     params.Version,
+		DataDir:     dir,
+		HTTPHost:    "127.0.0.1",
+		HTTPPort:    httpPort,
+		HTTPModules: []string{"eth", "net", "web3", "txpool"},
+		P2P: p2p.Config{
+			PrivateKey:  key,
+			ListenAddr:  fmt.Sprintf("127.0.0.1:%d", port),
+			NoDiscovery: true,
+			MaxPeers:    50,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	config := ethconfig.Defaults
+	config.Genesis = genesis
+	config.NetworkId = genesis.Config.ChainID.Uint64()
+	config.SyncMode = downloader.FullSync
+	config.Miner.Etherbase = crypto.PubkeyToAddress(key.PublicKey)
+	config.Miner.GasPrice = big.NewInt(1)
+	config.Miner.Recommit = time.Second
+	config.TxPool.PriceLimit = 1
+
+	backend, err := eth.New(stack, &config)
+	if err != nil {
+		stack.Close()
+		return nil, err
+	}
+	if err := stack.Start(); err != nil {
+		stack.Close()
+		return nil, err
+	}
+	rpc, err := stack.Attach()
+	if err != nil {
+		stack.Close()
+		return nil, err
+	}
+	return &devnetNode{
+		key:     key,
+		address: config.Miner.Etherbase,
+		stack:   stack,
+		backend: backend,
+		client:  ethclient.NewClient(rpc),
+	}, nil
+}
+
+// errTxDropped is returned if the nonce of transaction is taken by the system transactions,
+// which are signed by the validator when it proposes a block.
+var errTxDropped = errors.New("transaction dropped")
+
+// sendTx sends the payload to the native contract and waits for the successful receipt,
+// the transaction is sent again with a new nonce if it's dropped.
+func (n *devnetNode) sendTx(contract common.Address, payload []byte) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), devnetTxTimeout)
+	defer cancel()
+
+	price, err := n.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := n.client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		nonce, err := n.client.PendingNonceAt(ctx, n.address)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := types.SignTx(types.NewTransaction(nonce, contract, nil, 1000000, price, payload), types.LatestSignerForChainID(chainID), n.key)
+		if err != nil {
+			return nil, err
+		}
+		if err := n.client.SendTransaction(ctx, tx); err != nil {
+			return nil, err
+		}
+		receipt, err := n.waitReceipt(ctx, tx)
+		if err == errTxDropped {
+			log.Debug("Resend dropped devnet transaction", "hash", tx.Hash(), "nonce", nonce)
+			continue
+		}
+		return receipt, err
+	}
+}
+
+func (n *devnetNode) waitReceipt(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	for {
+		// the nonce is read ahead, so the transaction is dropped if the nonce is used
+		// while the receipt is still missing
+		nonce, err := n.client.NonceAt(ctx, n.address, nil)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := n.client.TransactionReceipt(ctx, tx.Hash())
+		if err == nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return receipt, fmt.Errorf("transaction %s failed", tx.Hash().Hex())
+			}
+			return receipt, nil
+		} else if err != ethereum.NotFound {
+			return nil, err
+		}
+		if nonce > tx.Nonce() {
+			return nil, errTxDropped
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for transaction %s: %v", tx.Hash().Hex(), ctx.Err())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// waitBlock waits until the head block of the node reaches the number.
+func (n *devnetNode) waitBlock(number uint64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		head, err := n.client.BlockNumber(context.Background())
+		if err != nil {
+			return err
+		}
+		if head >= number {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("block %d not reached in %v, head %d", number, timeout, head)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+// hasEvent reports whether the receipt contains the event of native contract, the event
+// may be named with the `evt` prefix in the abi.
+func hasEvent(ab *abi.ABI, receipt *types.Receipt, name string) bool {
+	ev, ok := ab.Events[name]
+	if !ok {
+		if ev, ok = ab.Events["evt"+abi.ToCamelCase(name)]; !ok {
+			return false
+		}
+	}
+	for _, l := range receipt.Logs {
+		if len(l.Topics) > 0 && l.Topics[0] == ev.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// devnet is a local network of the genesis validators.
+type devnet struct {
+	validators []*devnetNode
+}
+
+func (d *devnet) close() {
+	for _, n := range d.validators {
+		n.stack.Close()
+	}
+}
+
+// registerSideChain registers the side chain by the first validator and approves it with
+// the validators one by one, until the quorum is reached.
+func (d *devnet) registerSideChain(name string) error {
+	preset := devnetSideChains[name]
+	owner := d.validators[0]
+	payload, err := nutils.PackMethod(side_chain_manager.ABI, side_chain_manager.MethodRegisterSideChain,
+		owner.address, preset.ChainID, preset.Router, name, uint64(1), []byte{}, []byte{})
+	if err != nil {
+		return err
+	}
+	if _, err := owner.sendTx(nutils.SideChainManagerContractAddress, payload); err != nil {
+		return fmt.Errorf("register: %v", err)
+	}
+	for _, v := range d.validators {
+		payload, err := nutils.PackMethod(side_chain_manager.ABI, side_chain_manager.MethodApproveRegisterSideChain, preset.ChainID, v.address)
+		if err != nil {
+			return err
+		}
+		receipt, err := v.sendTx(nutils.SideChainManagerContractAddress, payload)
+		if err != nil {
+			return fmt.Errorf("approve by %s: %v", v.address.Hex(), err)
+		}
+		if hasEvent(side_chain_manager.ABI, receipt, side_chain_manager.EventApproveRegisterSideChain) {
+			log.Info("Registered side chain", "name", name, "chainID", preset.ChainID, "router", preset.Router)
+			return nil
+		}
+	}
+	return errors.New("approvals of all validators not reach the quorum")
+}
+
+// currentEpoch returns the current epoch of the node manager.
+func (d *devnet) currentEpoch() (*node_manager.EpochInfo, error) {
+	payload, err := new(node_manager.MethodEpochInput).Encode()
+	if err != nil {
+		return nil, err
+	}
+	msg := ethereum.CallMsg{To: &nutils.NodeManagerContractAddress, Data: payload}
+	enc, err := d.validators[0].client.CallContract(context.Background(), msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	output := new(node_manager.MethodEpochOutput)
+	if err := output.Decode(enc); err != nil {
+		return nil, err
+	}
+	return output.Epoch, nil
+}
+
+// rotateEpoch proposes the next epoch of the validators, collects the votes and waits for
+// the blocks sealed after the consensus engines switched to the new epoch.
+func (d *devnet) rotateEpoch() (*node_manager.EpochInfo, error) {
+	cur, err := d.currentEpoch()
+	if err != nil {
+		return nil, fmt.Errorf("get current epoch: %v", err)
+	}
+	peers := &node_manager.Peers{}
+	for _, n := range d.validators {
+		peers.List = append(peers.List, &node_manager.PeerInfo{
+			Address: n.address,
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&n.key.PublicKey)),
+		})
+	}
+	sort.Sort(peers)
+
+	proposer := d.validators[0]
+	head, err := proposer.client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	next := &node_manager.EpochInfo{
+		ID:          cur.ID + 1,
+		Peers:       peers,
+		StartHeight: head + node_manager.MinEpochValidPeriod + devnetRotateMargin,
+		Proposer:    proposer.address,
+		Version:     node_manager.EpochHashV2,
+	}
+	payload, err := (&node_manager.MethodProposeInput{StartHeight: next.StartHeight, Peers: peers}).Encode()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := proposer.sendTx(nutils.NodeManagerContractAddress, payload); err != nil {
+		return nil, fmt.Errorf("propose: %v", err)
+	}
+	log.Info("Proposed epoch", "id", next.ID, "start", next.StartHeight, "hash", next.Hash())
+
+	passed := false
+	for _, v := range d.validators[1:] {
+		payload, err := (&node_manager.MethodVoteInput{EpochID: next.ID, Hash: next.Hash()}).Encode()
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := v.sendTx(nutils.NodeManagerContractAddress, payload)
+		if err != nil {
+			return nil, fmt.Errorf("vote by %s: %v", v.address.Hex(), err)
+		}
+		if passed = hasEvent(node_manager.ABI, receipt, node_manager.EventEpochChange); passed {
+			break
+		}
+	}
+	if !passed {
+		return nil, errors.New("votes of all validators not reach the quorum")
+	}
+	log.Info("Epoch proposal passed, waiting for the start height", "id", next.ID, "start", next.StartHeight)
+
+	// the engines are restarted at the start height, which is proven by sealing blocks
+	wait := time.Duration(next.StartHeight-head+devnetRotateMargin) * 5 * time.Second
+	if err := proposer.waitBlock(next.StartHeight+devnetRotateMargin, wait); err != nil {
+		return nil, fmt.Errorf("new epoch not sealing: %v", err)
+	}
+	return next, nil
+}
+
+func zionVerifyHandlers(ctx *cli.Context) error {
+	if args := ctx.Args(); len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+	for _, r := range selftest.Run() {
+		status := "ok"
+		if r.Err != nil {
+			status = "FAILED: " + r.Err.Error()
+		}
+		fmt.Printf("router %-3d %-14s %s\n", r.Router, r.Fixture, status)
+	}
+	return selftest.Verify()
+}
+
+func zionDevnet(ctx *cli.Context) error {
+	if args := ctx.Args(); len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+	num := ctx.Int(devnetValidatorsFlag.Name)
+	if num < node_manager.MinProposalPeersLen {
+		return fmt.Errorf("at least %d validators required", node_manager.MinProposalPeersLen)
+	}
+	chains, err := parseDevnetSideChains(ctx.String(devnetSideChainsFlag.Name))
+	if err != nil {
+		return err
+	}
+	dir := ctx.String(devnetDirFlag.Name)
+	if dir == "" {
+		if dir, err = ioutil.TempDir("", "zion-devnet"); err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+	}
+
+	keys := make([]*ecdsa.PrivateKey, num)
+	for i := range keys {
+		if keys[i], err = crypto.GenerateKey(); err != nil {
+			return err
+		}
+	}
+	genesis, err := makeDevnetGenesis(keys)
+	if err != nil {
+		return err
+	}
+	port, httpPort := ctx.Int(devnetPortFlag.Name), ctx.Int(devnetHTTPPortFlag.Name)
+	statics := make([]string, num)
+	for i, key := range keys {
+		statics[i] = enode.NewV4(&key.PublicKey, net.IPv4(127, 0, 0, 1), port+i, 0).URLv4()
+	}
+
+	d := new(devnet)
+	defer d.close()
+	for i, key := range keys {
+		n, err := startDevnetNode(filepath.Join(dir, fmt.Sprintf("node%d", i)), key, genesis, statics, port+i, httpPort+i)
+		if err != nil {
+			return fmt.Errorf("start node %d: %v", i, err)
+		}
+		d.validators = append(d.validators, n)
+	}
+	for _, n := range d.validators {
+		if err := n.backend.StartMining(1); err != nil {
+			return err
+		}
+	}
+	if err := d.validators[0].waitBlock(1, time.Minute); err != nil {
+		return fmt.Errorf("network not sealing: %v", err)
+	}
+	log.Info("Devnet started", "validators", num, "dir", dir)
+
+	for _, name := range chains {
+		if err := d.registerSideChain(name); err != nil {
+			return fmt.Errorf("register side chain %s: %v", name, err)
+		}
+	}
+	if !ctx.Bool(devnetNoRotateFlag.Name) {
+		epoch, err := d.rotateEpoch()
+		if err != nil {
+			return fmt.Errorf("rotate epoch: %v", err)
+		}
+		log.Info("Rotated epoch", "id", epoch.ID, "start", epoch.StartHeight, "validators", len(epoch.Peers.List))
+	}
+
+	fmt.Println("Zion devnet is running, press Ctrl-C to stop")
+	for i, n := range d.validators {
+		fmt.Printf("  node%d %s http://127.0.0.1:%d\n", i, n.address.Hex(), httpPort+i)
+	}
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	<-sigc
+	log.Info("Shutting down devnet")
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestParseDevnetSideChains(t *testing.T) {
+	names, err := parseDevnetSideChains(" ETH,heco,,eth ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"eth", "heco"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("side chains mismatch, want %v, got %v", want, names)
+	}
+	if _, err := parseDevnetSideChains("eth,unknown"); err == nil {
+		t.Fatal("expect error of unknown side chain")
+	}
+}
+
+func TestMakeDevnetGenesis(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	genesis, err := makeDevnetGenesis(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(genesis.Alloc) != 4 {
+		t.Fatalf("expect 4 funded validators, got %d", len(genesis.Alloc))
+	}
+	for addr, account := range genesis.Alloc {
+		if len(account.PublicKey) == 0 {
+			t.Fatalf("public key of validator %s missing", addr.Hex())
+		}
+	}
+	if genesis.Config.HotStuff == nil {
+		t.Fatal("expect hotstuff config")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Zion Authors
+// This file is part of Zion.
+//
+// Zion is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Zion is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Zion. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/contracts/native"
+)
+
+// genGo binds the abi with the zion flavor of abigen, which exports the
+// `MethodXXX` name constants used by the native contract packages.
+func genGo(entry *native.ContractABI, parsed *abi.ABI) (string, error) {
+	sigs := make(map[string]string)
+	for _, m := range parsed.Methods {
+		sigs[m.Sig] = fmt.Sprintf("%x", m.ID)
+	}
+
+	zion := bind.Zion
+	bind.Zion = true
+	defer func() { bind.Zion = zion }()
+
+	return bind.Bind(
+		[]string{entry.Type},
+		[]string{entry.JSON},
+		[]string{""},
+		[]map[string]string{sigs},
+		goPackage(entry),
+		bind.LangGo,
+		nil,
+		nil,
+	)
+}
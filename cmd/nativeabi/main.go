@@ -0,0 +1,181 @@
+// Copyright 2021 The Zion Authors
+// This file is part of Zion.
+//
+// Zion is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Zion is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Zion. If not, see <http://www.gnu.org/licenses/>.
+
+// nativeabi generates go bindings, solidity interfaces and typescript typings
+// for every native contract from the abi registry.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/boot"
+)
+
+const header = "Code generated by cmd/nativeabi - DO NOT EDIT."
+
+var (
+	solDir = flag.String("sol", "", "output directory of solidity interfaces")
+	tsDir  = flag.String("ts", "", "output directory of typescript typings")
+	goDir  = flag.String("go", "", "output directory of go bindings")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage:", os.Args[0], "[-sol <dir>] [-ts <dir>] [-go <dir>]")
+		flag.PrintDefaults()
+		fmt.Fprintln(os.Stderr, `
+Generates code for all of the native contracts registered in the abi registry.`)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if *solDir == "" && *tsDir == "" && *goDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	boot.InitialNativeContracts()
+	files, err := generate(native.RegisteredABIs(), *solDir, *tsDir, *goDir)
+	if err != nil {
+		die(err)
+	}
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+			die(err)
+		}
+		if err := ioutil.WriteFile(f.path, []byte(f.content), 0644); err != nil {
+			die(err)
+		}
+	}
+}
+
+type file struct {
+	path    string
+	content string
+}
+
+// generate renders the code of all the entries, an empty output directory
+// disables the corresponding language.
+func generate(entries []*native.ContractABI, solDir, tsDir, goDir string) ([]*file, error) {
+	var files []*file
+	for _, entry := range entries {
+		entry = withStructTypes(entry)
+		parsed, err := abi.JSON(strings.NewReader(entry.JSON))
+		if err != nil {
+			return nil, fmt.Errorf("parse abi of %s: %v", entry.Name, err)
+		}
+		if solDir != "" {
+			files = append(files, &file{
+				path:    filepath.Join(solDir, "I"+entry.Type+".sol"),
+				content: genSolidity(entry, &parsed),
+			})
+		}
+		if tsDir != "" {
+			files = append(files, &file{
+				path:    filepath.Join(tsDir, entry.Type+".ts"),
+				content: genTypeScript(entry, &parsed),
+			})
+		}
+		if goDir != "" {
+			code, err := genGo(entry, &parsed)
+			if err != nil {
+				return nil, fmt.Errorf("bind abi of %s: %v", entry.Name, err)
+			}
+			pkg := goPackage(entry)
+			files = append(files, &file{
+				path:    filepath.Join(goDir, pkg, pkg+".go"),
+				content: code,
+			})
+		}
+	}
+	return files, nil
+}
+
+// untypedStruct matches the struct internal types missing the space after `struct`, e.g:
+// `structside_chain_manager.BtcTxParamDetial`, which are emitted by the earlier compilers.
+var untypedStruct = regexp.MustCompile(`("internalType"\s*:\s*"struct)([^ "])`)
+
+// withStructTypes returns the entry with the struct internal types normalized, so that the struct
+// names are derived from the internal types instead of the anonymous `StructN`.
+func withStructTypes(entry *native.ContractABI) *native.ContractABI {
+	normalized := *entry
+	normalized.JSON = untypedStruct.ReplaceAllString(entry.JSON, "$1 $2")
+	return &normalized
+}
+
+// sortedMethods returns the methods ordered by name, so the output is stable.
+func sortedMethods(parsed *abi.ABI) []abi.Method {
+	list := make([]abi.Method, 0, len(parsed.Methods))
+	for _, m := range parsed.Methods {
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// sortedEvents returns the events ordered by name, so the output is stable.
+func sortedEvents(parsed *abi.ABI) []abi.Event {
+	list := make([]abi.Event, 0, len(parsed.Events))
+	for _, e := range parsed.Events {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// goPackage converts the type name to the binding package name, e.g:
+// `Neo3StateManager` to `neo3_state_manager_abi`.
+func goPackage(entry *native.ContractABI) string {
+	var name []rune
+	for i, r := range entry.Type {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				name = append(name, '_')
+			}
+			r = unicode.ToLower(r)
+		}
+		name = append(name, r)
+	}
+	return string(name) + "_abi"
+}
+
+func argName(arg abi.Argument, idx int) string {
+	if arg.Name == "" {
+		return fmt.Sprintf("arg%d", idx)
+	}
+	return arg.Name
+}
+
+func die(args ...interface{}) {
+	fmt.Fprintln(os.Stderr, args...)
+	os.Exit(1)
+}
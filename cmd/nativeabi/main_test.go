@@ -0,0 +1,89 @@
+// Copyright 2021 The Zion Authors
+// This file is part of Zion.
+//
+// Zion is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Zion is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Zion. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/boot"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	boot.InitialNativeContracts()
+}
+
+// TestGeneratedCodeUpToDate fails if the registered abi changed without running `go generate`.
+func TestGeneratedCodeUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "contracts", "native")
+	files, err := generate(native.RegisteredABIs(),
+		filepath.Join(dir, "interfaces"),
+		filepath.Join(dir, "interfaces", "typings"),
+		filepath.Join(dir, "go_abi"),
+	)
+	assert.NoError(t, err)
+
+	for _, f := range files {
+		enc, err := ioutil.ReadFile(f.path)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, f.content, string(enc), "%s is out of date, run `go generate` in contracts/native", f.path)
+	}
+}
+
+func TestSelectorsConsistent(t *testing.T) {
+	entries := native.RegisteredABIs()
+	assert.NotEmpty(t, entries)
+
+	for _, entry := range entries {
+		parsed, err := abi.JSON(strings.NewReader(entry.JSON))
+		assert.NoError(t, err)
+
+		sol := genSolidity(entry, &parsed)
+		ts := genTypeScript(entry, &parsed)
+		code, err := genGo(entry, &parsed)
+		assert.NoError(t, err)
+
+		for _, m := range parsed.Methods {
+			selector := fmt.Sprintf("%x", m.ID)
+			assert.Contains(t, sol, fmt.Sprintf("selector 0x%s `%s`", selector, m.Sig))
+			assert.Contains(t, ts, fmt.Sprintf("\"%s\": \"0x%s\"", m.Sig, selector))
+			assert.Contains(t, code, fmt.Sprintf("\"%s\": \"%s\"", selector, m.Sig))
+		}
+	}
+}
+
+func TestStructNamesFromInternalType(t *testing.T) {
+	entry := &native.ContractABI{
+		Name: "test",
+		Type: "Test",
+		JSON: `[{"inputs":[{"components":[{"internalType":"uint64","name":"Fee","type":"uint64"}],"internalType":"structtest.Detail","name":"Detail","type":"tuple"}],"name":"set","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+	}
+	files, err := generate([]*native.ContractABI{entry}, "sol", "", "go")
+	assert.NoError(t, err)
+	for _, f := range files {
+		assert.Contains(t, f.content, "testDetail")
+		assert.NotContains(t, f.content, "Struct0")
+	}
+}
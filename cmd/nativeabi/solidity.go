@@ -0,0 +1,130 @@
+// Copyright 2021 The Zion Authors
+// This file is part of Zion.
+//
+// Zion is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Zion is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Zion. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native"
+)
+
+// solStructs collects the tuple types used by an interface, solidity requires
+// them to be declared as structs.
+type solStructs struct {
+	names []string
+	defs  map[string]string
+}
+
+func (s *solStructs) typeName(t *abi.Type, hint string) string {
+	switch t.T {
+	case abi.SliceTy:
+		return s.typeName(t.Elem, hint) + "[]"
+	case abi.ArrayTy:
+		return fmt.Sprintf("%s[%d]", s.typeName(t.Elem, hint), t.Size)
+	case abi.TupleTy:
+		name := t.TupleRawName
+		if name == "" {
+			// keep the struct name apart from the parameter name
+			name = abi.ToCamelCase(hint) + "Struct"
+		}
+		if _, ok := s.defs[name]; ok {
+			return name
+		}
+		def := new(strings.Builder)
+		fmt.Fprintf(def, "    struct %s {\n", name)
+		for i, elem := range t.TupleElems {
+			fmt.Fprintf(def, "        %s %s;\n", s.typeName(elem, t.TupleRawNames[i]), t.TupleRawNames[i])
+		}
+		def.WriteString("    }\n")
+		s.names = append(s.names, name)
+		s.defs[name] = def.String()
+		return name
+	default:
+		return t.String()
+	}
+}
+
+func (s *solStructs) param(arg abi.Argument, idx int, location string) string {
+	typ := s.typeName(&arg.Type, argName(arg, idx))
+	switch arg.Type.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		typ += " " + location
+	}
+	return typ + " " + argName(arg, idx)
+}
+
+func genSolidity(entry *native.ContractABI, parsed *abi.ABI) string {
+	structs := &solStructs{defs: make(map[string]string)}
+
+	body := new(strings.Builder)
+	for _, e := range sortedEvents(parsed) {
+		params := make([]string, len(e.Inputs))
+		for i, arg := range e.Inputs {
+			typ := structs.typeName(&arg.Type, argName(arg, i))
+			if arg.Indexed {
+				typ += " indexed"
+			}
+			params[i] = typ + " " + argName(arg, i)
+		}
+		anonymous := ""
+		if e.Anonymous {
+			anonymous = " anonymous"
+		}
+		fmt.Fprintf(body, "    event %s(%s)%s;\n", e.RawName, strings.Join(params, ", "), anonymous)
+	}
+	if len(parsed.Events) > 0 && len(parsed.Methods) > 0 {
+		body.WriteString("\n")
+	}
+	for _, m := range sortedMethods(parsed) {
+		inputs := make([]string, len(m.Inputs))
+		for i, arg := range m.Inputs {
+			inputs[i] = structs.param(arg, i, "calldata")
+		}
+		outputs := make([]string, len(m.Outputs))
+		for i, arg := range m.Outputs {
+			outputs[i] = structs.param(arg, i, "memory")
+		}
+
+		fmt.Fprintf(body, "    /// @dev selector 0x%x `%s`\n", m.ID, m.Sig)
+		fmt.Fprintf(body, "    function %s(%s) external", m.RawName, strings.Join(inputs, ", "))
+		switch {
+		case m.StateMutability == "view" || m.StateMutability == "pure" || (m.StateMutability == "" && m.Constant):
+			body.WriteString(" view")
+		case m.StateMutability == "payable" || m.Payable:
+			body.WriteString(" payable")
+		}
+		if len(outputs) > 0 {
+			fmt.Fprintf(body, " returns (%s)", strings.Join(outputs, ", "))
+		}
+		body.WriteString(";\n")
+	}
+
+	out := new(strings.Builder)
+	fmt.Fprintf(out, "// SPDX-License-Identifier: LGPL-3.0\n// %s\n\n", header)
+	out.WriteString("pragma solidity >=0.6.0 <0.9.0;\npragma experimental ABIEncoderV2;\n\n")
+	fmt.Fprintf(out, "/// @title I%s\n/// @notice interface of native contract `%s` at %s\n", entry.Type, entry.Name, entry.Address.Hex())
+	fmt.Fprintf(out, "interface I%s {\n", entry.Type)
+	for _, name := range structs.names {
+		out.WriteString(structs.defs[name])
+		out.WriteString("\n")
+	}
+	out.WriteString(body.String())
+	out.WriteString("}\n")
+	return out.String()
+}
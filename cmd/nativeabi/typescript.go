@@ -0,0 +1,110 @@
+// Copyright 2021 The Zion Authors
+// This file is part of Zion.
+//
+// Zion is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Zion is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Zion. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native"
+)
+
+// tsType maps the abi type to typescript, integers wider than 48 bits can not
+// be represented by `number` safely and use `bigint` instead.
+func tsType(t *abi.Type) string {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		if t.Size <= 48 {
+			return "number"
+		}
+		return "bigint"
+	case abi.BoolTy:
+		return "boolean"
+	case abi.SliceTy, abi.ArrayTy:
+		return tsType(t.Elem) + "[]"
+	case abi.TupleTy:
+		fields := make([]string, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			fields[i] = fmt.Sprintf("%s: %s", t.TupleRawNames[i], tsType(elem))
+		}
+		return "{ " + strings.Join(fields, "; ") + " }"
+	default:
+		// address, string, bytes and fixed bytes are all hex or plain strings
+		return "string"
+	}
+}
+
+func genTypeScript(entry *native.ContractABI, parsed *abi.ABI) string {
+	out := new(strings.Builder)
+	fmt.Fprintf(out, "// %s\n\n", header)
+	fmt.Fprintf(out, "/** address of native contract `%s` */\n", entry.Name)
+	fmt.Fprintf(out, "export const %sAddress = \"%s\";\n\n", entry.Type, entry.Address.Hex())
+
+	indented := new(bytes.Buffer)
+	if err := json.Indent(indented, []byte(strings.TrimSpace(entry.JSON)), "", "  "); err != nil {
+		// the json has been validated while registering
+		panic(err)
+	}
+	fmt.Fprintf(out, "export const %sABI = %s as const;\n\n", entry.Type, indented.String())
+
+	methods := sortedMethods(parsed)
+	fmt.Fprintf(out, "/** 4-byte selectors of the method signatures */\n")
+	fmt.Fprintf(out, "export const %sSelectors = {\n", entry.Type)
+	for _, m := range methods {
+		fmt.Fprintf(out, "  \"%s\": \"0x%x\",\n", m.Sig, m.ID)
+	}
+	out.WriteString("} as const;\n\n")
+
+	fmt.Fprintf(out, "export interface %s {\n", entry.Type)
+	for _, m := range methods {
+		inputs := make([]string, len(m.Inputs))
+		for i, arg := range m.Inputs {
+			inputs[i] = fmt.Sprintf("%s: %s", argName(arg, i), tsType(&arg.Type))
+		}
+		var ret string
+		switch len(m.Outputs) {
+		case 0:
+			ret = "void"
+		case 1:
+			ret = tsType(&m.Outputs[0].Type)
+		default:
+			outputs := make([]string, len(m.Outputs))
+			for i, arg := range m.Outputs {
+				outputs[i] = tsType(&arg.Type)
+			}
+			ret = "[" + strings.Join(outputs, ", ") + "]"
+		}
+		fmt.Fprintf(out, "  %s(%s): Promise<%s>;\n", m.Name, strings.Join(inputs, ", "), ret)
+	}
+	out.WriteString("}\n")
+
+	if events := sortedEvents(parsed); len(events) > 0 {
+		fmt.Fprintf(out, "\nexport interface %sEvents {\n", entry.Type)
+		for _, e := range events {
+			fields := make([]string, len(e.Inputs))
+			for i, arg := range e.Inputs {
+				fields[i] = fmt.Sprintf("%s: %s", argName(arg, i), tsType(&arg.Type))
+			}
+			fmt.Fprintf(out, "  %s: { %s };\n", e.RawName, strings.Join(fields, "; "))
+		}
+		out.WriteString("}\n")
+	}
+	return out.String()
+}
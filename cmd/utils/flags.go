@@ -40,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -52,6 +53,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethstats"
 	"github.com/ethereum/go-ethereum/graphql"
+	"github.com/ethereum/go-ethereum/headerproxy"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/internal/flags"
 	"github.com/ethereum/go-ethereum/les"
@@ -66,6 +68,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/relayer"
 	pcsclite "github.com/gballet/go-libpcsclite"
 	gopsutil "github.com/shirou/gopsutil/mem"
 	"gopkg.in/urfave/cli.v1"
@@ -238,6 +241,39 @@ var (
 		Name:  "whitelist",
 		Usage: "Comma separated block number-to-hash mappings to enforce (<number>=<hash>)",
 	}
+	SubjectivityCheckpointFlag = cli.StringFlag{
+		Name:  "checkpoint.trusted",
+		Usage: "Comma separated trusted checkpoints which the chain never reorgs behind (<number>=<hash>:<epoch hash>)",
+	}
+	HeaderSyncAlertChainsFlag = cli.StringFlag{
+		Name:  "crosschain.alert.chains",
+		Usage: "Comma separated side chain ids whose header sync lag is monitored",
+	}
+	HeaderSyncAlertLagFlag = cli.DurationFlag{
+		Name:  "crosschain.alert.lag",
+		Usage: "Lag of the latest header sync to raise the alert (0 = disabled)",
+	}
+	HotStuffSignerFlag = cli.StringFlag{
+		Name:  "hotstuff.signer",
+		Usage: "Comma separated RPC endpoints of the remote signers holding the validator key, in order of failover",
+	}
+	HotStuffValidatorFlag = cli.StringFlag{
+		Name:  "hotstuff.validator",
+		Usage: "Address of the validator key held by the remote signers",
+	}
+	HotStuffSignerTimeoutFlag = cli.DurationFlag{
+		Name:  "hotstuff.signer.timeout",
+		Usage: "Timeout of a signing request to the remote signer, the next signer is tried on timeout",
+		Value: snr.DefaultRemoteTimeout,
+	}
+	BridgeHealthFlag = cli.BoolFlag{
+		Name:  "crosschain.health",
+		Usage: "Enable the bridge health endpoint " + eth.BridgeHealthPath + " on the HTTP-RPC server",
+	}
+	NativeDiffFlag = cli.BoolFlag{
+		Name:  "native.diffs",
+		Usage: "Enable the native state diff stream " + eth.NativeDiffPath + " on the HTTP-RPC server",
+	}
 	BloomFilterSizeFlag = cli.Uint64Flag{
 		Name:  "bloomfilter.size",
 		Usage: "Megabytes of memory allocated to bloom-filter for pruning",
@@ -516,6 +552,33 @@ var (
 		Name:  "ethstats",
 		Usage: "Reporting URL of a ethstats service (nodename:secret@host:port)",
 	}
+	RelayerKeyFlag = cli.StringFlag{
+		Name:  "relayer.key",
+		Usage: "Key file of the relayer account, enables the relayer service which manages the account nonce for the relayers",
+	}
+	RelayerSignersFlag = cli.StringFlag{
+		Name:  "relayer.signers",
+		Usage: "Comma separated addresses allowed to submit intents to the relayer service (default = the relayer account)",
+	}
+	RelayerPriceBumpFlag = cli.Uint64Flag{
+		Name:  "relayer.pricebump",
+		Usage: "Gas price bump percentage of the relayer transaction resubmission",
+		Value: relayer.DefaultConfig.PriceBump,
+	}
+	RelayerResubmitFlag = cli.DurationFlag{
+		Name:  "relayer.resubmit",
+		Usage: "Time to wait for a relayer transaction to be included before the resubmission",
+		Value: relayer.DefaultConfig.Resubmit,
+	}
+	HeaderProxySourcesFlag = cli.StringFlag{
+		Name:  "headerproxy.sources",
+		Usage: "Comma separated source chain rpc endpoints by side chain id (<chainid>=<url>), enables the header proxy service which fetches the headers missing for imports, they are submitted with the relayer account if --relayer.key is set",
+	}
+	HeaderProxyMaxHeadersFlag = cli.Uint64Flag{
+		Name:  "headerproxy.maxheaders",
+		Usage: "Maximum number of headers fetched by the header proxy for a single import",
+		Value: headerproxy.DefaultConfig.MaxHeaders,
+	}
 	FakePoWFlag = cli.BoolFlag{
 		Name:  "fakepow",
 		Usage: "Disables proof-of-work verification",
@@ -1434,6 +1497,80 @@ func setWhitelist(ctx *cli.Context, cfg *ethconfig.Config) {
 	}
 }
 
+func setSubjectivityCheckpoints(ctx *cli.Context, cfg *ethconfig.Config) {
+	checkpoints := ctx.GlobalString(SubjectivityCheckpointFlag.Name)
+	if checkpoints == "" {
+		return
+	}
+	for _, entry := range strings.Split(checkpoints, ",") {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			Fatalf("Invalid trusted checkpoint entry: %s", entry)
+		}
+		number, err := strconv.ParseUint(parts[0], 0, 64)
+		if err != nil {
+			Fatalf("Invalid trusted checkpoint block number %s: %v", parts[0], err)
+		}
+		hashes := strings.Split(parts[1], ":")
+		if len(hashes) != 2 {
+			Fatalf("Invalid trusted checkpoint entry: %s", entry)
+		}
+		var hash, epochHash common.Hash
+		if err = hash.UnmarshalText([]byte(hashes[0])); err != nil {
+			Fatalf("Invalid trusted checkpoint hash %s: %v", hashes[0], err)
+		}
+		if err = epochHash.UnmarshalText([]byte(hashes[1])); err != nil {
+			Fatalf("Invalid trusted checkpoint epoch hash %s: %v", hashes[1], err)
+		}
+		cfg.SubjectivityCheckpoints = append(cfg.SubjectivityCheckpoints, &core.SubjectivityCheckpoint{
+			Number:    number,
+			Hash:      hash,
+			EpochHash: epochHash,
+		})
+	}
+}
+
+func setHeaderSyncAlert(ctx *cli.Context, cfg *ethconfig.Config) {
+	if ctx.GlobalIsSet(HeaderSyncAlertLagFlag.Name) {
+		cfg.HeaderSyncAlertLag = ctx.GlobalDuration(HeaderSyncAlertLagFlag.Name)
+	}
+	chains := ctx.GlobalString(HeaderSyncAlertChainsFlag.Name)
+	if chains == "" {
+		return
+	}
+	cfg.HeaderSyncAlertChains = cfg.HeaderSyncAlertChains[:0]
+	for _, entry := range strings.Split(chains, ",") {
+		chainID, err := strconv.ParseUint(strings.TrimSpace(entry), 0, 64)
+		if err != nil {
+			Fatalf("Invalid header sync alert chain id %s: %v", entry, err)
+		}
+		cfg.HeaderSyncAlertChains = append(cfg.HeaderSyncAlertChains, chainID)
+	}
+}
+
+func setHotStuffSigner(ctx *cli.Context, cfg *ethconfig.Config) {
+	if ctx.GlobalIsSet(HotStuffSignerTimeoutFlag.Name) {
+		cfg.HotStuffSignerTimeout = ctx.GlobalDuration(HotStuffSignerTimeoutFlag.Name)
+	}
+	if ctx.GlobalIsSet(HotStuffValidatorFlag.Name) {
+		address := ctx.GlobalString(HotStuffValidatorFlag.Name)
+		if !common.IsHexAddress(address) {
+			Fatalf("Invalid validator address %q", address)
+		}
+		cfg.HotStuffValidator = common.HexToAddress(address)
+	}
+	signers := ctx.GlobalString(HotStuffSignerFlag.Name)
+	if signers == "" {
+		return
+	}
+	cfg.HotStuffSigners = cfg.HotStuffSigners[:0]
+	for _, endpoint := range strings.Split(signers, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			cfg.HotStuffSigners = append(cfg.HotStuffSigners, endpoint)
+		}
+	}
+}
+
 // CheckExclusive verifies that only a single instance of the provided flags was
 // set by the user. Each flag might optionally be followed by a string type to
 // specialize it further.
@@ -1498,6 +1635,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	setEthash(ctx, cfg)
 	setMiner(ctx, &cfg.Miner)
 	setWhitelist(ctx, cfg)
+	setSubjectivityCheckpoints(ctx, cfg)
+	setHeaderSyncAlert(ctx, cfg)
+	setHotStuffSigner(ctx, cfg)
 	setLes(ctx, cfg)
 
 	// Cap the cache allowance and tune the garbage collector
@@ -1746,6 +1886,75 @@ func RegisterEthStatsService(stack *node.Node, backend ethapi.Backend, url strin
 	}
 }
 
+// RegisterRelayerService configures the relayer service from the command line flags and
+// registers it against the node.
+func RegisterRelayerService(ctx *cli.Context, stack *node.Node, backend ethapi.Backend) *relayer.Service {
+	key, err := crypto.LoadECDSA(ctx.GlobalString(RelayerKeyFlag.Name))
+	if err != nil {
+		Fatalf("Failed to load the relayer key: %v", err)
+	}
+	cfg := relayer.Config{
+		Key:       key,
+		PriceBump: ctx.GlobalUint64(RelayerPriceBumpFlag.Name),
+		Resubmit:  ctx.GlobalDuration(RelayerResubmitFlag.Name),
+	}
+	if signers := ctx.GlobalString(RelayerSignersFlag.Name); signers != "" {
+		for _, addr := range strings.Split(signers, ",") {
+			if !common.IsHexAddress(addr) {
+				Fatalf("Invalid relayer signer address %s", addr)
+			}
+			cfg.Signers = append(cfg.Signers, common.HexToAddress(addr))
+		}
+	}
+	service, err := relayer.New(stack, backend, cfg)
+	if err != nil {
+		Fatalf("Failed to register the relayer service: %v", err)
+	}
+	return service
+}
+
+// RegisterHeaderProxyService configures the header proxy service from the command line flags and
+// registers it against the node, the headers are submitted by the relayer service if it's not nil.
+func RegisterHeaderProxyService(ctx *cli.Context, stack *node.Node, backend ethapi.Backend, relay *relayer.Service) {
+	cfg := headerproxy.Config{
+		Sources:    make(map[uint64]string),
+		MaxHeaders: ctx.GlobalUint64(HeaderProxyMaxHeadersFlag.Name),
+	}
+	for _, source := range strings.Split(ctx.GlobalString(HeaderProxySourcesFlag.Name), ",") {
+		parts := strings.SplitN(source, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			Fatalf("Invalid header proxy source %s, want <chainid>=<url>", source)
+		}
+		id, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			Fatalf("Invalid chain id of header proxy source %s: %v", source, err)
+		}
+		cfg.Sources[id] = parts[1]
+	}
+	if relay != nil {
+		cfg.Submitter = relay
+	}
+	if err := headerproxy.New(stack, backend, cfg); err != nil {
+		Fatalf("Failed to register the header proxy service: %v", err)
+	}
+}
+
+// RegisterBridgeHealthService registers the bridge health endpoint on the HTTP-RPC server.
+func RegisterBridgeHealthService(stack *node.Node, backend *eth.Ethereum) {
+	if backend == nil {
+		Fatalf("Bridge health does not work in light client mode.")
+	}
+	stack.RegisterHandler("Bridge health", eth.BridgeHealthPath, eth.NewBridgeHealthHandler(backend))
+}
+
+// RegisterNativeDiffService registers the native state diff stream on the HTTP-RPC server.
+func RegisterNativeDiffService(stack *node.Node, backend *eth.Ethereum) {
+	if backend == nil {
+		Fatalf("Native state diffs do not work in light client mode.")
+	}
+	stack.RegisterHandler("Native state diffs", eth.NativeDiffPath, eth.NewNativeDiffHandler(backend))
+}
+
 // RegisterGraphQLService is a utility function to construct a new service and register it against a node.
 func RegisterGraphQLService(stack *node.Node, backend ethapi.Backend, cfg node.Config) {
 	if err := graphql.New(stack, backend, cfg.GraphQLCors, cfg.GraphQLVirtualHosts); err != nil {
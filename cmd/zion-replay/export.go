@@ -0,0 +1,346 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+	polycomm "github.com/polynetwork/poly/common"
+)
+
+// kinds of the bridge transactions exported
+const (
+	kindImport     = "import"
+	kindOutbound   = "outbound"
+	kindHeaderSync = "headerSync"
+)
+
+type exportStats struct {
+	blocks  int // blocks with bridge transactions of the chain
+	txs     int // bridge transactions exported
+	skipped int // transactions exported without state changes for the missing state
+}
+
+// forensicRecord is a bridge transaction of the side chain with everything needed to audit it,
+// the state changes are the native storage slots written by the transaction.
+type forensicRecord struct {
+	Block        uint64                 `json:"block"`
+	BlockHash    common.Hash            `json:"blockHash"`
+	TxIndex      int                    `json:"txIndex"`
+	TxHash       common.Hash            `json:"txHash"`
+	From         common.Address         `json:"from"`
+	To           *common.Address        `json:"to"`
+	Kinds        []string               `json:"kinds"`
+	Status       uint64                 `json:"status"`
+	GasUsed      uint64                 `json:"gasUsed"`
+	Call         *native.DecodedCall    `json:"call,omitempty"`
+	Events       []*native.DecodedEvent `json:"events,omitempty"`
+	Verification *verificationInputs    `json:"verification,omitempty"`
+	Outbound     []*outboundMessage     `json:"outbound,omitempty"`
+	Changes      []*stateChange         `json:"changes"`
+	ReplayError  string                 `json:"replayError,omitempty"`
+}
+
+// verificationInputs are the side chain settings in force when the transaction is verified,
+// the proofs and headers are part of the decoded call.
+type verificationInputs struct {
+	Router       uint64        `json:"router"`
+	BlocksToWait uint64        `json:"blocksToWait"`
+	CCMCAddress  hexutil.Bytes `json:"ccmcAddress"`
+}
+
+// outboundMessage is the message to the side chain decoded from the `makeProof` event.
+type outboundMessage struct {
+	FromChainID  uint64        `json:"fromChainId"`
+	TxHash       hexutil.Bytes `json:"txHash"`
+	CrossChainID hexutil.Bytes `json:"crossChainId"`
+	FromContract hexutil.Bytes `json:"fromContract"`
+	ToChainID    uint64        `json:"toChainId"`
+	ToContract   hexutil.Bytes `json:"toContract"`
+	Method       string        `json:"method"`
+	Args         hexutil.Bytes `json:"args"`
+}
+
+type stateChange struct {
+	Contract common.Address `json:"contract"`
+	Key      common.Hash    `json:"key"` // hashed slot key of the storage trie
+	Before   hexutil.Bytes  `json:"before"`
+	After    hexutil.Bytes  `json:"after"`
+}
+
+// exporter collects the bridge transactions of a side chain.
+type exporter struct {
+	chain   *core.BlockChain
+	chainID uint64
+}
+
+func newExporter(chain *core.BlockChain, chainID uint64) *exporter {
+	return &exporter{chain: chain, chainID: chainID}
+}
+
+// exportRange writes the bridge transactions of the blocks in range of [from, to] to w as a
+// gzipped tarball, one json file per transaction and a manifest of the export.
+func (e *exporter) exportRange(from, to uint64, w io.Writer) (*exportStats, error) {
+	var (
+		stats  = new(exportStats)
+		logged = time.Now()
+		zw     = gzip.NewWriter(w)
+		tw     = tar.NewWriter(zw)
+	)
+	if from == 0 {
+		from = 1 // genesis has no transaction
+	}
+	for number := from; number <= to; number++ {
+		block := e.chain.GetBlockByNumber(number)
+		if block == nil {
+			return stats, fmt.Errorf("block %d not found", number)
+		}
+		records, err := e.exportBlock(block)
+		if err != nil {
+			return stats, err
+		}
+		if len(records) == 0 {
+			continue
+		}
+		stats.blocks++
+		for _, record := range records {
+			name := fmt.Sprintf("txs/%d-%d-%s.json", record.Block, record.TxIndex, record.TxHash.Hex())
+			if err := writeJSON(tw, name, record); err != nil {
+				return stats, err
+			}
+			stats.txs++
+			if record.ReplayError != "" {
+				stats.skipped++
+			}
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Exporting bridge transactions", "number", number, "blocks", stats.blocks, "txs", stats.txs)
+			logged = time.Now()
+		}
+	}
+	manifest := map[string]interface{}{
+		"chainId": e.chainID,
+		"from":    from,
+		"to":      to,
+		"blocks":  stats.blocks,
+		"txs":     stats.txs,
+		"skipped": stats.skipped,
+	}
+	if err := writeJSON(tw, "manifest.json", manifest); err != nil {
+		return stats, err
+	}
+	if err := tw.Close(); err != nil {
+		return stats, err
+	}
+	return stats, zw.Close()
+}
+
+// exportBlock returns the records of the bridge transactions in the block, the block is replayed
+// on top of the parent state to collect the state changes of them.
+func (e *exporter) exportBlock(block *types.Block) ([]*forensicRecord, error) {
+	receipts := e.chain.GetReceiptsByHash(block.Hash())
+	if len(receipts) != len(block.Transactions()) {
+		return nil, fmt.Errorf("receipts of block %d not found", block.NumberU64())
+	}
+	signer := types.MakeSigner(e.chain.Config(), block.Number())
+	records := make(map[int]*forensicRecord)
+	for i, tx := range block.Transactions() {
+		record := e.classify(tx, receipts[i])
+		if record == nil {
+			continue
+		}
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return nil, err
+		}
+		record.Block, record.BlockHash, record.TxIndex, record.From = block.NumberU64(), block.Hash(), i, from
+		records[i] = record
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	if err := e.replay(block, records); err != nil {
+		log.Warn("Export block without state changes", "number", block.NumberU64(), "err", err)
+		for _, record := range records {
+			record.ReplayError = err.Error()
+		}
+	}
+	list := make([]*forensicRecord, 0, len(records))
+	for i := range block.Transactions() {
+		if record, ok := records[i]; ok {
+			list = append(list, record)
+		}
+	}
+	return list, nil
+}
+
+// classify returns the record of the transaction if it's an import from the side chain, a header
+// sync of the side chain, or it sends messages to the side chain. only the direct calls to the
+// native contracts are recognized as imports and header syncs.
+func (e *exporter) classify(tx *types.Transaction, receipt *types.Receipt) *forensicRecord {
+	record := &forensicRecord{TxHash: tx.Hash(), To: tx.To(), Status: receipt.Status, GasUsed: receipt.GasUsed, Changes: []*stateChange{}}
+	if to := tx.To(); to != nil && (*to == utils.CrossChainManagerContractAddress || *to == utils.HeaderSyncContractAddress) {
+		if call, err := native.DecodeCall(to, tx.Data()); err == nil {
+			switch {
+			case *to == utils.CrossChainManagerContractAddress && call.Method == scom.MethodImportOuterTransfer && call.Args["SourceChainID"] == e.chainID:
+				record.Kinds = append(record.Kinds, kindImport)
+			case *to == utils.HeaderSyncContractAddress && call.Args["ChainID"] == e.chainID:
+				record.Kinds = append(record.Kinds, kindHeaderSync)
+			}
+			record.Call = call
+		}
+	}
+	for _, l := range receipt.Logs {
+		event, err := native.DecodeLog(l)
+		if err != nil || event == nil {
+			continue
+		}
+		record.Events = append(record.Events, event)
+		if event.Contract != utils.CrossChainManagerContractAddress || event.Event != scom.NOTIFY_MAKE_PROOF_EVENT {
+			continue
+		}
+		if msg := decodeOutbound(event); msg != nil && msg.ToChainID == e.chainID {
+			record.Outbound = append(record.Outbound, msg)
+		}
+	}
+	if len(record.Outbound) > 0 {
+		record.Kinds = append(record.Kinds, kindOutbound)
+	}
+	if len(record.Kinds) == 0 {
+		return nil
+	}
+	return record
+}
+
+func decodeOutbound(event *native.DecodedEvent) *outboundMessage {
+	value, ok := event.Args["merkleValueHex"].(string)
+	if !ok {
+		return nil
+	}
+	raw, err := hex.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+	merkleValue := new(scom.ToMerkleValue)
+	if err := merkleValue.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil || merkleValue.MakeTxParam == nil {
+		return nil
+	}
+	param := merkleValue.MakeTxParam
+	return &outboundMessage{
+		FromChainID:  merkleValue.FromChainID,
+		TxHash:       merkleValue.TxHash,
+		CrossChainID: param.CrossChainID,
+		FromContract: param.FromContractAddress,
+		ToChainID:    param.ToChainID,
+		ToContract:   param.ToContractAddress,
+		Method:       param.Method,
+		Args:         param.Args,
+	}
+}
+
+// replay executes the transactions of the block in order, the native storage before and after
+// each of the recorded transactions are diffed as its state changes.
+func (e *exporter) replay(block *types.Block, records map[int]*forensicRecord) error {
+	parent := e.chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return fmt.Errorf("parent of block %d not found", block.NumberU64())
+	}
+	statedb, err := e.chain.StateAt(parent.Root())
+	if err != nil {
+		return errMissingState
+	}
+	config := e.chain.Config()
+	if err := native.ApplyMigrations(config, statedb, block.Number()); err != nil {
+		return err
+	}
+	var (
+		header  = block.Header()
+		gp      = new(core.GasPool).AddGas(math.MaxUint64)
+		usedGas = new(uint64)
+	)
+	for i, tx := range block.Transactions() {
+		record, ok := records[i]
+		var before *state.StateDB
+		if ok {
+			record.Verification = e.verificationInputs(statedb, record)
+			statedb.IntermediateRoot(config.IsEIP158(block.Number()))
+			before = statedb.Copy()
+		}
+		statedb.Prepare(tx.Hash(), block.Hash(), i)
+		if _, err := core.ApplyTransaction(config, e.chain, nil, gp, statedb, header, tx, usedGas, vm.Config{}); err != nil {
+			return fmt.Errorf("apply tx %d: %v", i, err)
+		}
+		if !ok {
+			continue
+		}
+		statedb.IntermediateRoot(config.IsEIP158(block.Number()))
+		diffs, err := diffNativeStorage(before, statedb)
+		if err != nil {
+			return err
+		}
+		for _, diff := range diffs {
+			record.Changes = append(record.Changes, &stateChange{Contract: diff.contract, Key: diff.key, Before: diff.want, After: diff.got})
+		}
+	}
+	return nil
+}
+
+// verificationInputs reads the side chain settings of the import from the state before it.
+func (e *exporter) verificationInputs(statedb *state.StateDB, record *forensicRecord) *verificationInputs {
+	if record.Call == nil || record.Call.Method != scom.MethodImportOuterTransfer {
+		return nil
+	}
+	height, _ := record.Call.Args["Height"].(uint32)
+	sideChain, err := side_chain_manager.GetSideChainAt(native.NewNativeContract(statedb, nil), e.chainID, uint64(height))
+	if err != nil || sideChain == nil {
+		return nil
+	}
+	return &verificationInputs{Router: sideChain.Router, BlocksToWait: sideChain.BlocksToWait, CCMCAddress: sideChain.CCMCAddress}
+}
+
+func writeJSON(tw *tar.Writer, name string, v interface{}) error {
+	enc, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s: %v", name, err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(enc))}); err != nil {
+		return err
+	}
+	_, err = tw.Write(enc)
+	return err
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	polycomm "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// registerTestHeaderSync replaces the genesis header sync with a handler which stores the
+// header of the chain without verification.
+func registerTestHeaderSync(s *native.NativeContract) {
+	s.Prepare(hscommon.ABI, hscommon.GasTable)
+	s.Register(hscommon.MethodSyncGenesisHeader, func(s *native.NativeContract) ([]byte, error) {
+		params := &hscommon.SyncGenesisHeaderParam{}
+		if err := utils.UnpackMethod(hscommon.ABI, hscommon.MethodSyncGenesisHeader, params, s.ContractRef().CurrentContext().Payload); err != nil {
+			return nil, err
+		}
+		s.GetCacheDB().Put(utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(hscommon.GENESIS_HEADER), utils.GetUint64Bytes(params.ChainID)), params.GenesisHeader)
+		return utils.PackOutputs(hscommon.ABI, hscommon.MethodSyncGenesisHeader, true)
+	})
+}
+
+func readExport(t *testing.T, data []byte) (map[string]interface{}, []*forensicRecord) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	tr := tar.NewReader(zr)
+
+	var (
+		manifest map[string]interface{}
+		records  []*forensicRecord
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		enc, err := io.ReadAll(tr)
+		assert.NoError(t, err)
+		if hdr.Name == "manifest.json" {
+			assert.NoError(t, json.Unmarshal(enc, &manifest))
+			continue
+		}
+		record := new(forensicRecord)
+		assert.NoError(t, json.Unmarshal(enc, record))
+		records = append(records, record)
+	}
+	return manifest, records
+}
+
+func TestExportRange(t *testing.T) {
+	header_sync.InitHeaderSync()
+	native.Contracts[utils.HeaderSyncContractAddress] = registerTestHeaderSync
+	defer func() { native.Contracts[utils.HeaderSyncContractAddress] = header_sync.RegisterHeaderSyncContract }()
+
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		key, _  = crypto.GenerateKey()
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: core.GenesisAlloc{
+				address: {Balance: big.NewInt(params.Ether), PublicKey: crypto.CompressPubkey(&key.PublicKey)},
+			},
+		}
+		genesis = gspec.MustCommit(db)
+		signer  = types.LatestSigner(gspec.Config)
+	)
+	// the genesis headers of chain 7 are synced in block 1 and 3, and chain 8 in block 2
+	chainIDs := []uint64{7, 8, 7}
+	chain, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 4, func(i int, gen *core.BlockGen) {
+		if i >= len(chainIDs) {
+			return
+		}
+		payload, err := utils.PackMethod(hscommon.ABI, hscommon.MethodSyncGenesisHeader, chainIDs[i], []byte{byte(i + 1)})
+		assert.NoError(t, err)
+		tx, err := types.SignTx(types.NewTransaction(gen.TxNonce(address), utils.HeaderSyncContractAddress, nil, 100000, big.NewInt(1), payload), signer, key)
+		assert.NoError(t, err)
+		gen.AddTx(tx)
+	})
+	bc, err := core.NewBlockChain(db, &core.CacheConfig{TrieDirtyDisabled: true}, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	assert.NoError(t, err)
+	defer bc.Stop()
+	_, err = bc.InsertChain(chain)
+	assert.NoError(t, err)
+
+	out := new(bytes.Buffer)
+	stats, err := newExporter(bc, 7).exportRange(0, 4, out)
+	assert.NoError(t, err)
+	assert.Equal(t, &exportStats{blocks: 2, txs: 2}, stats)
+
+	manifest, records := readExport(t, out.Bytes())
+	assert.Equal(t, float64(7), manifest["chainId"])
+	assert.Equal(t, float64(2), manifest["txs"])
+	assert.Equal(t, 2, len(records))
+	for i, record := range records {
+		block := chain[i*2]
+		assert.Equal(t, block.NumberU64(), record.Block)
+		assert.Equal(t, block.Transactions()[0].Hash(), record.TxHash)
+		assert.Equal(t, address, record.From)
+		assert.Equal(t, []string{kindHeaderSync}, record.Kinds)
+		assert.Equal(t, types.ReceiptStatusSuccessful, record.Status)
+		assert.Equal(t, hscommon.MethodSyncGenesisHeader, record.Call.Method)
+		assert.Equal(t, 1, len(record.Changes))
+		assert.Equal(t, utils.HeaderSyncContractAddress, record.Changes[0].Contract)
+		assert.Empty(t, record.ReplayError)
+	}
+	// the header of block 1 is overwritten in block 3
+	assert.Equal(t, records[0].Changes[0].Key, records[1].Changes[0].Key)
+	assert.Empty(t, records[0].Changes[0].Before)
+	assert.Equal(t, []byte(records[0].Changes[0].After), []byte(records[1].Changes[0].Before))
+	assert.NotEqual(t, []byte(records[1].Changes[0].Before), []byte(records[1].Changes[0].After))
+
+	// nothing is exported out of the range
+	out.Reset()
+	stats, err = newExporter(bc, 8).exportRange(3, 4, out)
+	assert.NoError(t, err)
+	assert.Equal(t, &exportStats{}, stats)
+}
+
+func TestDecodeOutbound(t *testing.T) {
+	value := &scom.ToMerkleValue{
+		TxHash:      []byte{1},
+		FromChainID: 2,
+		MakeTxParam: &scom.MakeTxParam{
+			TxHash:              []byte{3},
+			CrossChainID:        []byte{4},
+			FromContractAddress: []byte{5},
+			ToChainID:           7,
+			ToContractAddress:   []byte{6},
+			Method:              "unlock",
+			Args:                []byte{8},
+		},
+	}
+	sink := polycomm.NewZeroCopySink(nil)
+	value.Serialization(sink)
+	event := &native.DecodedEvent{Event: scom.NOTIFY_MAKE_PROOF_EVENT, Args: map[string]interface{}{"merkleValueHex": hex.EncodeToString(sink.Bytes())}}
+
+	msg := decodeOutbound(event)
+	assert.Equal(t, &outboundMessage{
+		FromChainID:  2,
+		TxHash:       []byte{1},
+		CrossChainID: []byte{4},
+		FromContract: []byte{5},
+		ToChainID:    7,
+		ToContract:   []byte{6},
+		Method:       "unlock",
+		Args:         []byte{8},
+	}, msg)
+
+	event.Args["merkleValueHex"] = "0x"
+	assert.Nil(t, decodeOutbound(event))
+}
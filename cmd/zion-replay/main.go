@@ -0,0 +1,197 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// zion-replay re-executes the native contract transactions of a chain data
+// directory and diffs the resulting native storage against the canonical
+// state, to catch nondeterministic handlers before they split the network.
+package main
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	hsb "github.com/ethereum/go-ethereum/consensus/hotstuff/backend"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/contracts/native/boot"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	dataDir   = flag.String("datadir", "", "data directory of the stopped node")
+	ancient   = flag.String("ancient", "", "directory of the ancient store (default = inside chaindata)")
+	fromBlock = flag.Uint64("from", 1, "first block to replay")
+	toBlock   = flag.Uint64("to", 0, "last block to replay (default = head block)")
+	runs      = flag.Int("runs", 2, "times each block is re-executed")
+	allBlocks = flag.Bool("all", false, "replay the blocks without native contract transactions as well")
+	cache     = flag.Int("cache", 512, "megabytes of memory allocated to database and trie caching")
+	verbosity = flag.Int("verbosity", int(log.LvlInfo), "log verbosity (0-5)")
+	export    = flag.String("export", "", "export the bridge transactions of -chainid into the tar.gz file instead of replaying")
+	chainID   = flag.Uint64("chainid", 0, "side chain id of the bridge transactions to export")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage:", os.Args[0], "-datadir <dir> [-from <n>] [-to <n>] [-runs <n>] [-all]")
+		fmt.Fprintln(os.Stderr, "      ", os.Args[0], "-datadir <dir> -export <file> -chainid <id> [-from <n>] [-to <n>]")
+		flag.PrintDefaults()
+		fmt.Fprintln(os.Stderr, `
+Re-executes the native contract transactions on top of the canonical parent
+state and reports every block whose native storage differs from the canonical
+one. The states of the replayed blocks and their parents must be available,
+so an archive node is required for old blocks. The database is opened read
+only, stop the node before replaying.
+
+With -export, every transaction which imports from, syncs headers of or sends
+messages to the side chain in the block range is written into a tar.gz file
+for incident forensics: the decoded calldata and events, the side chain
+settings it's verified against, and the native storage slots it changed.`)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if *dataDir == "" || *runs < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	log.Root().SetHandler(log.LvlFilterHandler(log.Lvl(*verbosity), log.StreamHandler(os.Stderr, log.TerminalFormat(true))))
+	boot.InitialNativeContracts()
+
+	if *export != "" {
+		stats, err := runExport()
+		if err != nil {
+			die(err)
+		}
+		fmt.Printf("exported %d txs of chain %d in %d blocks, %d without state changes\n", stats.txs, *chainID, stats.blocks, stats.skipped)
+		return
+	}
+	stats, err := run()
+	if err != nil {
+		die(err)
+	}
+	fmt.Printf("replayed %d blocks, %d native txs, %d skipped, %d diverged\n", stats.blocks, stats.txs, stats.skipped, stats.diverged)
+	if stats.diverged > 0 {
+		os.Exit(1)
+	}
+}
+
+func run() (*replayStats, error) {
+	var stats *replayStats
+	err := withChain(func(chain *core.BlockChain, to uint64) (err error) {
+		stats, err = newReplayer(chain, *runs, *allBlocks).replayRange(*fromBlock, to, os.Stdout)
+		return err
+	})
+	return stats, err
+}
+
+func runExport() (*exportStats, error) {
+	f, err := os.Create(*export)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var stats *exportStats
+	err = withChain(func(chain *core.BlockChain, to uint64) (err error) {
+		stats, err = newExporter(chain, *chainID).exportRange(*fromBlock, to, f)
+		return err
+	})
+	if err != nil {
+		return stats, err
+	}
+	return stats, f.Sync()
+}
+
+// withChain opens the chain of the data directory read only, and calls fn with the last block
+// of the range, which is capped at the head block.
+func withChain(fn func(chain *core.BlockChain, to uint64) error) error {
+	chaindata := filepath.Join(*dataDir, "geth", "chaindata")
+	freezer := *ancient
+	if freezer == "" {
+		freezer = filepath.Join(chaindata, "ancient")
+	}
+	db, err := rawdb.NewLevelDBDatabaseWithFreezer(chaindata, *cache/2, 256, freezer, "", true)
+	if err != nil {
+		return fmt.Errorf("open database: %v", err)
+	}
+	defer db.Close()
+
+	chain, err := openChain(db, *cache/2)
+	if err != nil {
+		return err
+	}
+	defer chain.Stop()
+
+	to := *toBlock
+	if head := chain.CurrentBlock().NumberU64(); to == 0 || to > head {
+		to = head
+	}
+	return fn(chain, to)
+}
+
+// openChain loads the chain of the database without the state snapshot, the dirty tries are
+// never cached so that nothing is flushed into the read only database on exit.
+func openChain(db ethdb.Database, cache int) (*core.BlockChain, error) {
+	genesis := rawdb.ReadCanonicalHash(db, 0)
+	config := rawdb.ReadChainConfig(db, genesis)
+	if config == nil {
+		return nil, errors.New("chain config not found, is the data directory initialized?")
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	cacheConfig := &core.CacheConfig{
+		TrieCleanLimit:    cache,
+		TrieDirtyDisabled: true,
+	}
+	chain, err := core.NewBlockChain(db, cacheConfig, config, makeEngine(config, key, db), vm.Config{}, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("load chain: %v", err)
+	}
+	return chain, nil
+}
+
+// makeEngine creates the consensus engine for block processing only, the replay never seals
+// blocks so the key and validators of the engine are irrelevant.
+func makeEngine(config *params.ChainConfig, key *ecdsa.PrivateKey, db ethdb.Database) consensus.Engine {
+	if config.HotStuff == nil {
+		return ethash.NewFaker()
+	}
+	valset := validator.NewSet(nil, hotstuff.RoundRobin)
+	return hsb.New(hotstuff.DefaultBasicConfig, key, db, valset, hotstuff.HotstuffProtocol(config.HotStuff.Protocol))
+}
+
+func die(args ...interface{}) {
+	fmt.Fprintln(os.Stderr, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+var errMissingState = errors.New("missing state")
+
+type replayStats struct {
+	blocks   int // blocks replayed
+	txs      int // native contract transactions of the replayed blocks
+	skipped  int // blocks skipped for the missing state
+	diverged int // blocks diverged from the canonical state in any run
+}
+
+// replayer re-executes the canonical blocks on top of their parent states.
+type replayer struct {
+	chain *core.BlockChain
+	runs  int
+	all   bool
+}
+
+func newReplayer(chain *core.BlockChain, runs int, all bool) *replayer {
+	return &replayer{chain: chain, runs: runs, all: all}
+}
+
+// replayRange replays the blocks in range of [from, to] and writes the divergences to w.
+func (r *replayer) replayRange(from, to uint64, w io.Writer) (*replayStats, error) {
+	var (
+		stats  = new(replayStats)
+		logged = time.Now()
+	)
+	if from == 0 {
+		from = 1 // genesis is not executed
+	}
+	for number := from; number <= to; number++ {
+		block := r.chain.GetBlockByNumber(number)
+		if block == nil {
+			return stats, fmt.Errorf("block %d not found", number)
+		}
+		txs := nativeTxs(block)
+		if txs == 0 && !r.all {
+			continue
+		}
+		diverged, err := r.replayBlock(block, w)
+		if err == errMissingState {
+			log.Warn("Skip block without state", "number", number, "hash", block.Hash())
+			stats.skipped++
+			continue
+		} else if err != nil {
+			return stats, err
+		}
+		stats.blocks++
+		stats.txs += txs
+		if diverged {
+			stats.diverged++
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Replaying blocks", "number", number, "replayed", stats.blocks, "diverged", stats.diverged)
+			logged = time.Now()
+		}
+	}
+	return stats, nil
+}
+
+// replayBlock executes the block for the configured times, each run starts from a fresh
+// parent state. any run ends with a different state root is reported with the diff of
+// native storage, the handlers are deterministic only if all runs match the canonical.
+func (r *replayer) replayBlock(block *types.Block, w io.Writer) (bool, error) {
+	parent := r.chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return false, fmt.Errorf("parent of block %d not found", block.NumberU64())
+	}
+	canonical, err := r.chain.StateAt(block.Root())
+	if err != nil {
+		return false, errMissingState
+	}
+	diverged := false
+	for run := 0; run < r.runs; run++ {
+		statedb, err := r.chain.StateAt(parent.Root())
+		if err != nil {
+			return false, errMissingState
+		}
+		if _, _, _, err := r.chain.Processor().Process(block, statedb, vm.Config{}); err != nil {
+			fmt.Fprintf(w, "block %d %s run %d: process failed: %v\n", block.NumberU64(), block.Hash().Hex(), run, err)
+			diverged = true
+			continue
+		}
+		root := statedb.IntermediateRoot(r.chain.Config().IsEIP158(block.Number()))
+		if root == block.Root() {
+			continue
+		}
+		diverged = true
+		fmt.Fprintf(w, "block %d %s run %d: state root %s, expect %s\n", block.NumberU64(), block.Hash().Hex(), run, root.Hex(), block.Root().Hex())
+		diffs, err := diffNativeStorage(canonical, statedb)
+		if err != nil {
+			return diverged, err
+		}
+		if len(diffs) == 0 {
+			fmt.Fprintln(w, "  native storage matches, the state diverged elsewhere")
+		}
+		for _, diff := range diffs {
+			fmt.Fprintf(w, "  %s\n", diff)
+		}
+	}
+	return diverged, nil
+}
+
+// nativeTxs returns the number of transactions calling to native contracts directly.
+func nativeTxs(block *types.Block) int {
+	n := 0
+	for _, tx := range block.Transactions() {
+		if to := tx.To(); to != nil && native.IsNativeContract(*to) {
+			n++
+		}
+	}
+	return n
+}
+
+// storageDiff is a storage slot of native contract differs between the states, the key is
+// the hashed slot key of the storage trie, and the value is nil if the slot is absent.
+type storageDiff struct {
+	contract common.Address
+	key      common.Hash
+	want     []byte
+	got      []byte
+}
+
+func (d *storageDiff) String() string {
+	return fmt.Sprintf("%s slot %s: canonical %x, replayed %x", d.contract.Hex(), d.key.Hex(), d.want, d.got)
+}
+
+// diffNativeStorage compares the storage of all native contracts, the diffs are ordered by
+// contract address and slot key so that the output is stable.
+func diffNativeStorage(want, got *state.StateDB) ([]*storageDiff, error) {
+	contracts := make([]common.Address, 0, len(native.NativeContractAddrMap))
+	for _, addr := range native.NativeContractAddrMap {
+		contracts = append(contracts, addr)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i][:], contracts[j][:]) < 0
+	})
+
+	var diffs []*storageDiff
+	for _, addr := range contracts {
+		list, err := diffStorage(addr, want.StorageTrie(addr), got.StorageTrie(addr))
+		if err != nil {
+			return nil, fmt.Errorf("diff storage of %s: %v", addr.Hex(), err)
+		}
+		diffs = append(diffs, list...)
+	}
+	return diffs, nil
+}
+
+// diffStorage walks the two storage tries in key order, a nil trie is taken as empty.
+func diffStorage(contract common.Address, want, got state.Trie) ([]*storageDiff, error) {
+	var (
+		diffs []*storageDiff
+		wit   = storageIterator(want)
+		git   = storageIterator(got)
+		wok   = next(wit)
+		gok   = next(git)
+	)
+	for wok || gok {
+		switch {
+		case !gok || (wok && bytes.Compare(wit.Key, git.Key) < 0):
+			diffs = append(diffs, &storageDiff{contract: contract, key: common.BytesToHash(wit.Key), want: slotValue(wit.Value)})
+			wok = next(wit)
+		case !wok || bytes.Compare(wit.Key, git.Key) > 0:
+			diffs = append(diffs, &storageDiff{contract: contract, key: common.BytesToHash(git.Key), got: slotValue(git.Value)})
+			gok = next(git)
+		default:
+			if !bytes.Equal(wit.Value, git.Value) {
+				diffs = append(diffs, &storageDiff{contract: contract, key: common.BytesToHash(wit.Key),
+					want: slotValue(wit.Value), got: slotValue(git.Value)})
+			}
+			wok, gok = next(wit), next(git)
+		}
+	}
+	for _, it := range []*trie.Iterator{wit, git} {
+		if it != nil && it.Err != nil {
+			return nil, it.Err
+		}
+	}
+	return diffs, nil
+}
+
+func storageIterator(t state.Trie) *trie.Iterator {
+	if t == nil {
+		return nil
+	}
+	return trie.NewIterator(t.NodeIterator(nil))
+}
+
+func next(it *trie.Iterator) bool {
+	return it != nil && it.Next()
+}
+
+// slotValue strips the rlp encoding of the storage value.
+func slotValue(enc []byte) []byte {
+	_, content, _, err := rlp.Split(enc)
+	if err != nil {
+		return common.CopyBytes(enc)
+	}
+	return common.CopyBytes(content)
+}
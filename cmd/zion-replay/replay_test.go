@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+const testABIJSON = `[
+	{"type":"function","name":"write","inputs":[],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"}
+]`
+
+var (
+	testContract = native.NativeContractAddrMap[native.NativeExtra19]
+	testStoreKey = []byte("written")
+
+	// testCounter makes the handler nondeterministic if enabled, the value written differs
+	// in every execution.
+	testCounter      uint64
+	testCounterDirty bool
+)
+
+func registerTestContract(s *native.NativeContract) {
+	ab, _ := abi.JSON(strings.NewReader(testABIJSON))
+	s.Prepare(&ab, map[string]uint64{"write": 1000})
+	s.Register("write", func(s *native.NativeContract) ([]byte, error) {
+		value := s.ContractRef().BlockHeight().Uint64()
+		if testCounterDirty {
+			testCounter++
+			value = testCounter
+		}
+		s.GetCacheDB().Put(utils.ConcatKey(testContract, testStoreKey), utils.GetUint64Bytes(value))
+		return utils.PackOutputs(&ab, "write", true)
+	})
+}
+
+// newTestChain creates an archive chain of the blocks, the first `nativeBlocks` of which
+// call the test contract.
+func newTestChain(t *testing.T, blocks, nativeBlocks int) *core.BlockChain {
+	native.Contracts[testContract] = registerTestContract
+
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		key, _  = crypto.GenerateKey()
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: core.GenesisAlloc{
+				address: {Balance: big.NewInt(params.Ether), PublicKey: crypto.CompressPubkey(&key.PublicKey)},
+			},
+		}
+		genesis = gspec.MustCommit(db)
+		signer  = types.LatestSigner(gspec.Config)
+	)
+	ab, _ := abi.JSON(strings.NewReader(testABIJSON))
+	payload, err := utils.PackMethod(&ab, "write")
+	assert.NoError(t, err)
+
+	chain, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, blocks, func(i int, gen *core.BlockGen) {
+		if i >= nativeBlocks {
+			return
+		}
+		tx, err := types.SignTx(types.NewTransaction(gen.TxNonce(address), testContract, nil, 100000, big.NewInt(1), payload), signer, key)
+		assert.NoError(t, err)
+		gen.AddTx(tx)
+	})
+	bc, err := core.NewBlockChain(db, &core.CacheConfig{TrieDirtyDisabled: true}, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	assert.NoError(t, err)
+	_, err = bc.InsertChain(chain)
+	assert.NoError(t, err)
+	return bc
+}
+
+func TestReplayRange(t *testing.T) {
+	chain := newTestChain(t, 5, 3)
+	defer chain.Stop()
+
+	// the blocks without native contract transactions are skipped by default
+	out := new(bytes.Buffer)
+	stats, err := newReplayer(chain, 2, false).replayRange(0, 5, out)
+	assert.NoError(t, err)
+	assert.Equal(t, &replayStats{blocks: 3, txs: 3}, stats)
+	assert.Empty(t, out.String())
+
+	stats, err = newReplayer(chain, 2, true).replayRange(0, 5, out)
+	assert.NoError(t, err)
+	assert.Equal(t, &replayStats{blocks: 5, txs: 3}, stats)
+	assert.Empty(t, out.String())
+
+	// the nondeterministic handler diverges from the canonical state
+	testCounterDirty = true
+	defer func() { testCounterDirty = false }()
+	stats, err = newReplayer(chain, 2, false).replayRange(2, 3, out)
+	assert.NoError(t, err)
+	assert.Equal(t, &replayStats{blocks: 2, txs: 2, diverged: 2}, stats)
+	assert.Contains(t, out.String(), "block 2")
+	assert.Contains(t, out.String(), "run 1")
+	assert.Contains(t, out.String(), testContract.Hex()+" slot")
+}
+
+func TestDiffNativeStorage(t *testing.T) {
+	newState := func(values map[byte]byte) *state.StateDB {
+		db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		db.SetCode(testContract, []byte{1})
+		for k, v := range values {
+			db.SetState(testContract, common.Hash{k}, common.BytesToHash([]byte{v}))
+		}
+		db.IntermediateRoot(true)
+		return db
+	}
+	want := newState(map[byte]byte{1: 1, 2: 2, 3: 3})
+	got := newState(map[byte]byte{1: 1, 2: 4, 5: 5})
+
+	diffs, err := diffNativeStorage(want, want)
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	diffs, err = diffNativeStorage(want, got)
+	assert.NoError(t, err)
+	index := make(map[common.Hash]*storageDiff)
+	for _, v := range diffs {
+		assert.Equal(t, testContract, v.contract)
+		index[v.key] = v
+	}
+	assert.Equal(t, 3, len(index))
+	slot := func(k byte) *storageDiff {
+		return index[crypto.Keccak256Hash(common.Hash{k}.Bytes())]
+	}
+	assert.Equal(t, &storageDiff{testContract, crypto.Keccak256Hash(common.Hash{2}.Bytes()), []byte{2}, []byte{4}}, slot(2))
+	assert.Equal(t, &storageDiff{testContract, crypto.Keccak256Hash(common.Hash{3}.Bytes()), []byte{3}, nil}, slot(3))
+	assert.Equal(t, &storageDiff{testContract, crypto.Keccak256Hash(common.Hash{5}.Bytes()), nil, []byte{5}}, slot(5))
+}
@@ -169,6 +169,13 @@ type Handler interface {
 	SetBroadcaster(Broadcaster)
 }
 
+// SystemTxSigner is implemented by the consensus engines which carry the consensus driven state
+// changes in blocks as system transactions, which are signed by the block proposer.
+type SystemTxSigner interface {
+	// SignSystemTx signs the system transaction with the key of the local block proposer.
+	SignSystemTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error)
+}
+
 // PoW is a consensus engine based on proof-of-work.
 type PoW interface {
 	Engine
@@ -81,11 +81,17 @@ type backend struct {
 }
 
 func New(config *hotstuff.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database, valset hotstuff.ValidatorSet, protocol hotstuff.HotstuffProtocol) consensus.HotStuff {
+	return NewWithKey(config, snr.NewLocalKey(privateKey), db, valset, protocol)
+}
+
+// NewWithKey creates the engine signing with the validator key, which may be held by the remote
+// signers.
+func NewWithKey(config *hotstuff.Config, key snr.Key, db ethdb.Database, valset hotstuff.ValidatorSet, protocol hotstuff.HotstuffProtocol) consensus.HotStuff {
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
 
-	signer := snr.NewSigner(privateKey, byte(hsb.MsgTypePrepareVote))
+	signer := snr.NewSignerWithKey(key, byte(hsb.MsgTypePrepareVote))
 	backend := &backend{
 		config: config,
 		//db:             db,
@@ -98,14 +98,16 @@ func (s *backend) Prepare(chain consensus.ChainHeaderReader, header *types.Heade
 }
 
 func (s *backend) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) {
-	// No block rewards in Istanbul, so the state remains as is and uncles are dropped
+	// No block rewards in Istanbul, the finalize hooks of native contracts are carried by the
+	// system transactions of the block, and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
 }
 
 func (s *backend) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
 	uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
-	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
+	/// No block rewards in Istanbul, the finalize hooks of native contracts are carried by the
+	/// system transactions of the block, and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
 
@@ -168,6 +170,12 @@ func (s *backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, re
 	return nil
 }
 
+// SignSystemTx implements consensus.SystemTxSigner, the system transactions are signed with the
+// key of the local validator as the block proposer.
+func (s *backend) SignSystemTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	return s.signer.SignTx(tx, signer)
+}
+
 func (s *backend) SealHash(header *types.Header) common.Hash {
 	return s.signer.SigHash(header)
 }
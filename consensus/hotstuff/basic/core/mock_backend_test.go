@@ -206,6 +206,10 @@ func (m *mockSinger) Sign(data []byte) ([]byte, error) {
 }
 
 // todo
+func (m *mockSinger) SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	return tx, nil
+}
+
 func (m *mockSinger) SigHash(header *types.Header) (hash common.Hash) {
 	return header.Hash()
 }
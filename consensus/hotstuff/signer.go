@@ -14,6 +14,9 @@ type Signer interface {
 	// SigHash generate header hash without signature
 	SigHash(header *types.Header) (hash common.Hash)
 
+	// SignTx signs the transaction with the validator's key
+	SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error)
+
 	// SignHash returns an signature of wrapped proposal hash which used as an vote
 	SignHash(hash common.Hash) ([]byte, error)
 
@@ -0,0 +1,52 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Key is the validator key signing the consensus messages and the system transactions, it's
+// either held in memory or by a remote signer.
+type Key interface {
+	// Address returns the address of the validator key.
+	Address() common.Address
+
+	// SignHash signs the 32 bytes hash, the signature is in the [R || S || V] format.
+	SignHash(hash []byte) ([]byte, error)
+}
+
+type localKey struct {
+	address    common.Address
+	privateKey *ecdsa.PrivateKey
+}
+
+// NewLocalKey creates the validator key held in memory.
+func NewLocalKey(privateKey *ecdsa.PrivateKey) Key {
+	return &localKey{address: crypto.PubkeyToAddress(privateKey.PublicKey), privateKey: privateKey}
+}
+
+func (k *localKey) Address() common.Address {
+	return k.address
+}
+
+func (k *localKey) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, k.privateKey)
+}
@@ -0,0 +1,196 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// DefaultRemoteTimeout is the timeout of a signing request to the remote signer.
+const DefaultRemoteTimeout = 2 * time.Second
+
+// RemoteConfig is the config of the remote signers holding the validator key, e.g. the signers
+// backed by HSMs. the signers are tried in order, the failed one is skipped until all the others
+// fail as well.
+type RemoteConfig struct {
+	Address   common.Address // Address of the validator key held by the signers
+	Endpoints []string       // RPC endpoints of the remote signers, in order of preference
+	Timeout   time.Duration  // Timeout of a signing request, the next signer is tried on timeout
+}
+
+// remoteKey is the validator key held by remote signers serving the `signer` namespace:
+//
+//	signer_address() -> address
+//	signer_signHash(address, hash) -> signature in [R || S || V]
+//
+// the signature is recovered and checked against the validator address before it's used, so a
+// misconfigured signer never produces a seal or vote of another key.
+type remoteKey struct {
+	address common.Address
+	config  RemoteConfig
+
+	lock    sync.Mutex    // protects the clients and the active index, never held across requests
+	clients []*rpc.Client // lazily dialed clients of the endpoints
+	active  int           // index of the endpoint serving the requests
+}
+
+// NewRemoteKey creates the validator key of the configured address held by the remote signers,
+// the endpoints are dialed on the first signing request.
+func NewRemoteKey(config RemoteConfig) (Key, error) {
+	if len(config.Endpoints) == 0 {
+		return nil, errors.New("no remote signer endpoint")
+	}
+	if config.Address == (common.Address{}) {
+		return nil, errors.New("no validator address of remote signer")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultRemoteTimeout
+	}
+	return &remoteKey{
+		address: config.Address,
+		config:  config,
+		clients: make([]*rpc.Client, len(config.Endpoints)),
+	}, nil
+}
+
+func (k *remoteKey) Address() common.Address {
+	return k.address
+}
+
+// SignHash signs the hash with the active signer, and fails over to the following ones in turn.
+// the lock is released during the requests, so that a slow signer never blocks the concurrent
+// signing requests beyond the timeout of its own.
+func (k *remoteKey) SignHash(hash []byte) ([]byte, error) {
+	k.lock.Lock()
+	active := k.active
+	k.lock.Unlock()
+
+	var errs []string
+	for i := range k.config.Endpoints {
+		index := (active + i) % len(k.config.Endpoints)
+		sig, err := k.signWith(index, hash)
+		if err == nil {
+			if index != active {
+				log.Warn("Remote signer failed over", "from", k.config.Endpoints[active], "to", k.config.Endpoints[index])
+				k.lock.Lock()
+				k.active = index
+				k.lock.Unlock()
+			}
+			return sig, nil
+		}
+		log.Warn("Remote signer failed", "endpoint", k.config.Endpoints[index], "err", err)
+		errs = append(errs, fmt.Sprintf("%s: %v", k.config.Endpoints[index], err))
+	}
+	return nil, fmt.Errorf("all remote signers failed, %s", strings.Join(errs, "; "))
+}
+
+// client returns the client of the endpoint, which is dialed if not yet. the client dialed
+// concurrently by another request is preferred.
+func (k *remoteKey) client(ctx context.Context, index int) (*rpc.Client, error) {
+	k.lock.Lock()
+	client := k.clients[index]
+	k.lock.Unlock()
+	if client != nil {
+		return client, nil
+	}
+	client, err := rpc.DialContext(ctx, k.config.Endpoints[index])
+	if err != nil {
+		return nil, err
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if dialed := k.clients[index]; dialed != nil {
+		client.Close()
+		return dialed, nil
+	}
+	k.clients[index] = client
+	return client, nil
+}
+
+// dropClient closes the failed client, the connection is dialed again by the next request.
+func (k *remoteKey) dropClient(index int, client *rpc.Client) {
+	k.lock.Lock()
+	if k.clients[index] == client {
+		k.clients[index] = nil
+	}
+	k.lock.Unlock()
+	client.Close()
+}
+
+func (k *remoteKey) signWith(index int, hash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.config.Timeout)
+	defer cancel()
+
+	client, err := k.client(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	var sig hexutil.Bytes
+	if err := client.CallContext(ctx, &sig, "signer_signHash", k.address, hexutil.Bytes(hash)); err != nil {
+		k.dropClient(index, client)
+		return nil, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+	pubkey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %v", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubkey); signer != k.address {
+		return nil, fmt.Errorf("signed by %s, expect %s", signer.Hex(), k.address.Hex())
+	}
+	return sig, nil
+}
+
+// KeyService serves the validator key in the `signer` namespace of the remote signer protocol,
+// e.g. by a signer daemon wrapping the HSM with a Key implementation.
+type KeyService struct {
+	key Key
+}
+
+func NewKeyService(key Key) *KeyService {
+	return &KeyService{key: key}
+}
+
+// Address returns the address of the validator key.
+func (s *KeyService) Address() common.Address {
+	return s.key.Address()
+}
+
+// SignHash signs the 32 bytes hash with the validator key of the address.
+func (s *KeyService) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	if address != s.key.Address() {
+		return nil, fmt.Errorf("unknown key %s", address.Hex())
+	}
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("invalid hash length %d", len(hash))
+	}
+	return s.key.SignHash(hash)
+}
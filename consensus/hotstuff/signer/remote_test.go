@@ -0,0 +1,79 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRemoteSigner(t *testing.T, key Key) *httptest.Server {
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("signer", NewKeyService(key)))
+	return httptest.NewServer(server)
+}
+
+func TestRemoteKey(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	good := newTestRemoteSigner(t, NewLocalKey(privateKey))
+	defer good.Close()
+	other := newTestRemoteSigner(t, NewLocalKey(otherKey))
+	defer other.Close()
+	down := newTestRemoteSigner(t, NewLocalKey(privateKey))
+	down.Close()
+
+	// the signer of another key and the signer down are skipped
+	key, err := NewRemoteKey(RemoteConfig{Address: address, Endpoints: []string{down.URL, other.URL, good.URL}, Timeout: time.Second})
+	assert.NoError(t, err)
+	hash := crypto.Keccak256([]byte("hash"))
+	sig, err := key.SignHash(hash)
+	assert.NoError(t, err)
+	pubkey, err := crypto.SigToPub(hash, sig)
+	assert.NoError(t, err)
+	assert.Equal(t, address, crypto.PubkeyToAddress(*pubkey))
+	assert.Equal(t, 2, key.(*remoteKey).active)
+
+	// the transactions are signed by the remote signer as well
+	signer := NewSignerWithKey(key, 3)
+	txSigner := types.LatestSignerForChainID(big.NewInt(1))
+	tx, err := signer.SignTx(types.NewTransaction(0, common.Address{}, nil, 21000, big.NewInt(1), nil), txSigner)
+	assert.NoError(t, err)
+	from, err := types.Sender(txSigner, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, address, from)
+
+	key, err = NewRemoteKey(RemoteConfig{Address: address, Endpoints: []string{down.URL, other.URL}})
+	assert.NoError(t, err)
+	_, err = key.SignHash(hash)
+	assert.Error(t, err)
+
+	_, err = NewRemoteKey(RemoteConfig{Address: address})
+	assert.Error(t, err)
+	_, err = NewRemoteKey(RemoteConfig{Endpoints: []string{good.URL}})
+	assert.Error(t, err)
+}
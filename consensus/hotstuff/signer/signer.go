@@ -19,17 +19,21 @@ const (
 
 type SignerImpl struct {
 	address       common.Address
-	privateKey    *ecdsa.PrivateKey
+	key           Key
 	signatures    *lru.ARCCache // Signatures of recent blocks to speed up mining
 	commitSigSalt byte          //
 }
 
 func NewSigner(privateKey *ecdsa.PrivateKey, commitMsgType byte) hotstuff.Signer {
+	return NewSignerWithKey(NewLocalKey(privateKey), commitMsgType)
+}
+
+// NewSignerWithKey creates the signer of the validator key, which may be held by a remote signer.
+func NewSignerWithKey(key Key, commitMsgType byte) hotstuff.Signer {
 	signatures, _ := lru.NewARC(inmemorySignatures)
-	address := crypto.PubkeyToAddress(privateKey.PublicKey)
 	return &SignerImpl{
-		address:       address,
-		privateKey:    privateKey,
+		address:       key.Address(),
+		key:           key,
 		signatures:    signatures,
 		commitSigSalt: commitMsgType,
 	}
@@ -41,7 +45,16 @@ func (s *SignerImpl) Address() common.Address {
 
 func (s *SignerImpl) Sign(data []byte) ([]byte, error) {
 	hashData := crypto.Keccak256(data)
-	return crypto.Sign(hashData, s.privateKey)
+	return s.key.SignHash(hashData)
+}
+
+func (s *SignerImpl) SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	h := signer.Hash(tx)
+	sig, err := s.key.SignHash(h[:])
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
 }
 
 func (s *SignerImpl) SignHash(hash common.Hash) ([]byte, error) {
@@ -336,6 +349,15 @@ func (s *SignerImpl) GetSignersFromCommittedSeals(hash common.Hash, seals [][]by
 	return addrs, nil
 }
 
+// Committers returns the validators who signed the committed seals of the header.
+func Committers(header *types.Header) ([]common.Address, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, errInvalidExtraDataFormat
+	}
+	return new(SignerImpl).GetSignersFromCommittedSeals(header.Hash(), extra.CommittedSeal)
+}
+
 // GetSignatureAddress gets the address address from the signature
 func getSignatureAddress(data []byte, sig []byte) (common.Address, error) {
 	// 1. Keccak data
@@ -0,0 +1,158 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package accounting
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "bridge accounting"
+
+const (
+	MethodContractName   = "name"
+	MethodLedger         = "ledger"
+	MethodCheckInvariant = "checkInvariant"
+	MethodReconcile      = "reconcile"
+
+	EventViolated   = "conservationViolated"
+	EventReconciled = "reconciled"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodLedger + `","inputs":[{"internalType":"address","name":"Asset","type":"address"},{"internalType":"uint64","name":"ChainID","type":"uint64"}],"outputs":[{"internalType":"uint256","name":"Locked","type":"uint256"},{"internalType":"uint256","name":"Minted","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodCheckInvariant + `","inputs":[{"internalType":"address","name":"Asset","type":"address"}],"outputs":[{"internalType":"bool","name":"Holds","type":"bool"},{"internalType":"uint256","name":"Locked","type":"uint256"},{"internalType":"uint256","name":"Minted","type":"uint256"},{"internalType":"uint64[]","name":"Violations","type":"uint64[]"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodReconcile + `","inputs":[{"internalType":"address","name":"Asset","type":"address"},{"internalType":"uint64","name":"ChainID","type":"uint64"},{"internalType":"uint256","name":"Locked","type":"uint256"},{"internalType":"uint256","name":"Minted","type":"uint256"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"` + EventViolated + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Asset","type":"address"},{"indexed":false,"internalType":"uint64","name":"ChainID","type":"uint64"},{"indexed":false,"internalType":"uint8","name":"Op","type":"uint8"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"Locked","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"Minted","type":"uint256"}]},
+	{"type":"event","name":"` + EventReconciled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Asset","type":"address"},{"indexed":false,"internalType":"uint64","name":"ChainID","type":"uint64"},{"indexed":false,"internalType":"uint256","name":"Locked","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"Minted","type":"uint256"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.AccountingContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+type MethodLedgerInput struct {
+	Asset   common.Address
+	ChainID uint64
+}
+
+func (m *MethodLedgerInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodLedger, m.Asset, m.ChainID)
+}
+func (m *MethodLedgerInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodLedger, m, payload)
+}
+
+type MethodLedgerOutput struct {
+	Locked *big.Int
+	Minted *big.Int
+}
+
+func (m *MethodLedgerOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodLedger, m.Locked, m.Minted)
+}
+func (m *MethodLedgerOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodLedger, m, payload)
+}
+
+type MethodCheckInvariantInput struct {
+	Asset common.Address
+}
+
+func (m *MethodCheckInvariantInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodCheckInvariant, m.Asset)
+}
+func (m *MethodCheckInvariantInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodCheckInvariant, m, payload)
+}
+
+type MethodCheckInvariantOutput struct {
+	Holds      bool
+	Locked     *big.Int
+	Minted     *big.Int
+	Violations []uint64
+}
+
+func (m *MethodCheckInvariantOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodCheckInvariant, m.Holds, m.Locked, m.Minted, m.Violations)
+}
+func (m *MethodCheckInvariantOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodCheckInvariant, m, payload)
+}
+
+type MethodReconcileInput struct {
+	Asset   common.Address
+	ChainID uint64
+	Locked  *big.Int
+	Minted  *big.Int
+}
+
+func (m *MethodReconcileInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodReconcile, m.Asset, m.ChainID, m.Locked, m.Minted)
+}
+func (m *MethodReconcileInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodReconcile, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitViolated(s *native.NativeContract, asset common.Address, chainID uint64, entry *Entry, ledger *Ledger) error {
+	return s.AddNotify(ABI, []string{EventViolated}, asset, chainID, uint8(entry.Op), entry.Amount, ledger.Locked, ledger.Minted)
+}
+
+func emitReconciled(s *native.NativeContract, asset common.Address, chainID uint64, ledger *Ledger) error {
+	return s.AddNotify(ABI, []string{EventReconciled}, asset, chainID, ledger.Locked, ledger.Minted)
+}
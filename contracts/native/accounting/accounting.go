@@ -0,0 +1,167 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package accounting
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName:   0,
+		MethodLedger:         0,
+		MethodCheckInvariant: 0,
+		MethodReconcile:      100000,
+	}
+)
+
+func InitAccounting() {
+	InitABI()
+	native.RegisterABI(native.NativeAccounting, "Accounting", abijson)
+	native.Contracts[this] = RegisterAccountingContract
+}
+
+func RegisterAccountingContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodLedger, GetLedger)
+	s.RegisterQuery(MethodCheckInvariant, CheckInvariant)
+	s.Register(MethodReconcile, Reconcile)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+// Apply records the operations of asset on the chain, it's called by the native contract of asset
+// in the same call as the lock, unlock, mint or burn, so that the ledger is updated atomically with
+// the asset. the operations are rejected as a whole if any of them would break the conservation,
+// the violation is alerted with the `conservationViolated` event and an error log, and the asset
+// contract should fail the call with the error returned.
+func Apply(s *native.NativeContract, asset common.Address, chainID uint64, entries ...*Entry) error {
+	ledger, err := getLedger(s, asset, chainID)
+	if err != nil {
+		return ErrStorage
+	}
+	for _, entry := range entries {
+		if entry.Amount == nil || entry.Amount.Sign() <= 0 {
+			return ErrInvalidAmount
+		}
+		if !ledger.apply(entry) || !ledger.Holds() {
+			log.Error("Bridge accounting conservation violated", "asset", asset, "chainID", chainID, "op", entry.Op,
+				"amount", entry.Amount, "locked", ledger.Locked, "minted", ledger.Minted)
+			if err := emitViolated(s, asset, chainID, entry, ledger); err != nil {
+				return ErrEmitLog
+			}
+			return ErrConservationViolated
+		}
+	}
+	if err := setLedger(s, asset, chainID, ledger); err != nil {
+		return ErrStorage
+	}
+	return nil
+}
+
+func GetLedger(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodLedgerInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	ledger, err := getLedger(s, input.Asset, input.ChainID)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	return (&MethodLedgerOutput{Locked: ledger.Locked, Minted: ledger.Minted}).Encode()
+}
+
+// CheckInvariant checks the conservation of asset on every chain which it's bridged to, and
+// returns the total locked and minted with the chains violating the conservation, which could
+// only be introduced by the reconciliation.
+func CheckInvariant(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodCheckInvariantInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	chains, err := getChains(s, input.Asset)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	output := &MethodCheckInvariantOutput{Locked: new(big.Int), Minted: new(big.Int), Violations: make([]uint64, 0)}
+	for _, chainID := range chains {
+		ledger, err := getLedger(s, input.Asset, chainID)
+		if err != nil {
+			return nil, ErrStorage
+		}
+		output.Locked.Add(output.Locked, ledger.Locked)
+		output.Minted.Add(output.Minted, ledger.Minted)
+		if !ledger.Holds() {
+			output.Violations = append(output.Violations, chainID)
+		}
+	}
+	output.Holds = len(output.Violations) == 0
+	return output.Encode()
+}
+
+// Reconcile validators reset the ledger of asset on the chain to the amounts audited, e.g. the
+// asset bridged before the ledger is tracked, the ledger changes after quorum reached.
+func Reconcile(s *native.NativeContract) ([]byte, error) {
+	if s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	payload := s.ContractRef().CurrentContext().Payload
+	input := new(MethodReconcileInput)
+	if err := input.Decode(payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Locked == nil || input.Minted == nil || input.Locked.Sign() < 0 || input.Minted.Sign() < 0 {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	ledger, err := getLedger(s, input.Asset, input.ChainID)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	sign := append(utils.GetUint64Bytes(ledger.Nonce), payload...)
+	ok, err := node_manager.CheckConsensusSigns(s, MethodReconcile, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodReconcile)
+	}
+
+	ledger = &Ledger{Locked: input.Locked, Minted: input.Minted, Nonce: ledger.Nonce + 1}
+	if err := setLedger(s, input.Asset, input.ChainID, ledger); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, MethodReconcile, s.ContractRef().MsgSender(), payload); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitReconciled(s, input.Asset, input.ChainID, ledger); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodReconcile)
+}
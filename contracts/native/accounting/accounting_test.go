@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package accounting
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(10000000)
+	testChainID    = uint64(2)
+)
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+	testAsset        = common.HexToAddress("0x1001")
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	InitAccounting()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+}
+
+func newTestContract(origin common.Address) *native.NativeContract {
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(1), common.HexToHash("0x1"), testSupplyGas, nil)
+	ref.PushContext(&native.Context{Caller: origin, ContractAddress: testAsset})
+	return native.NewNativeContract(testStateDB, ref)
+}
+
+func invoke(origin common.Address, payload []byte) ([]byte, error) {
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(1), common.HexToHash("0x1"), testSupplyGas, nil)
+	enc, _, err := ref.NativeCall(origin, this, payload)
+	return enc, err
+}
+
+func entry(op Op, amount int64) *Entry {
+	return &Entry{Op: op, Amount: big.NewInt(amount)}
+}
+
+func checkInvariant(t *testing.T) *MethodCheckInvariantOutput {
+	payload, _ := (&MethodCheckInvariantInput{Asset: testAsset}).Encode()
+	enc, err := invoke(common.EmptyAddress, payload)
+	assert.NoError(t, err)
+	output := new(MethodCheckInvariantOutput)
+	assert.NoError(t, output.Decode(enc))
+	return output
+}
+
+func TestApply(t *testing.T) {
+	resetTestContext()
+	s := newTestContract(common.EmptyAddress)
+
+	assert.NoError(t, Apply(s, testAsset, testChainID, entry(OpLock, 100), entry(OpMint, 100)))
+	assert.NoError(t, Apply(s, testAsset, testChainID+1, entry(OpLock, 50), entry(OpMint, 20)))
+	assert.Equal(t, ErrInvalidAmount, Apply(s, testAsset, testChainID, entry(OpLock, 0)))
+
+	// the operations breaking the conservation are rejected as a whole
+	assert.Equal(t, ErrConservationViolated, Apply(s, testAsset, testChainID, entry(OpMint, 1)))
+	assert.Equal(t, ErrConservationViolated, Apply(s, testAsset, testChainID, entry(OpBurn, 10), entry(OpUnlock, 11)))
+	assert.Equal(t, ErrConservationViolated, Apply(s, testAsset, testChainID+1, entry(OpBurn, 21)))
+	assert.NoError(t, Apply(s, testAsset, testChainID, entry(OpBurn, 10), entry(OpUnlock, 10)))
+
+	ledger, err := getLedger(s, testAsset, testChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(90), ledger.Locked)
+	assert.Equal(t, big.NewInt(90), ledger.Minted)
+	assert.Equal(t, &MethodCheckInvariantOutput{
+		Holds:      true,
+		Locked:     big.NewInt(140),
+		Minted:     big.NewInt(110),
+		Violations: []uint64{},
+	}, checkInvariant(t))
+}
+
+func TestReconcile(t *testing.T) {
+	resetTestContext()
+	payload, _ := (&MethodReconcileInput{Asset: testAsset, ChainID: testChainID, Locked: big.NewInt(10), Minted: big.NewInt(20)}).Encode()
+
+	// the ledger changes after quorum reached
+	_, err := invoke(common.HexToAddress("0xa"), payload)
+	assert.Error(t, err)
+	for _, peer := range testGenesisEpoch.Peers.List[:2] {
+		_, err := invoke(peer.Address, payload)
+		assert.NoError(t, err)
+	}
+	assert.True(t, checkInvariant(t).Holds)
+	_, err = invoke(testGenesisEpoch.Peers.List[2].Address, payload)
+	assert.NoError(t, err)
+
+	// the violation reconciled is reported by the invariant check
+	output := checkInvariant(t)
+	assert.False(t, output.Holds)
+	assert.Equal(t, []uint64{testChainID}, output.Violations)
+
+	// the reconciliation is signed with the nonce of ledger, the votes are never replayed
+	_, err = invoke(testGenesisEpoch.Peers.List[3].Address, payload)
+	assert.NoError(t, err)
+	ledger, err := getLedger(newTestContract(common.EmptyAddress), testAsset, testChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), ledger.Nonce)
+}
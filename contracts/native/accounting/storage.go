@@ -0,0 +1,97 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package accounting
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_LEDGER = "st_ledger"
+	SKP_CHAINS = "st_chains"
+)
+
+// getLedger returns an empty ledger if the asset never bridged to the chain.
+func getLedger(s *native.NativeContract, asset common.Address, chainID uint64) (*Ledger, error) {
+	value, err := s.GetCacheDB().Get(ledgerKey(asset, chainID))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return newLedger(), nil
+	}
+	ledger := new(Ledger)
+	if err := rlp.DecodeBytes(value, ledger); err != nil {
+		return nil, err
+	}
+	return ledger, nil
+}
+
+// setLedger stores the ledger, and records the chain of asset for the invariant check.
+func setLedger(s *native.NativeContract, asset common.Address, chainID uint64, ledger *Ledger) error {
+	value, err := rlp.EncodeToBytes(ledger)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(ledgerKey(asset, chainID), value)
+
+	chains, err := getChains(s, asset)
+	if err != nil {
+		return err
+	}
+	for _, id := range chains {
+		if id == chainID {
+			return nil
+		}
+	}
+	value, err = rlp.EncodeToBytes(append(chains, chainID))
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(chainsKey(asset), value)
+	return nil
+}
+
+// getChains returns the chains which the asset bridged to, in the order of first bridged.
+func getChains(s *native.NativeContract, asset common.Address) ([]uint64, error) {
+	value, err := s.GetCacheDB().Get(chainsKey(asset))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	var chains []uint64
+	if err := rlp.DecodeBytes(value, &chains); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
+
+func ledgerKey(asset common.Address, chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_LEDGER), asset.Bytes(), utils.GetUint64Bytes(chainID))
+}
+
+func chainsKey(asset common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_CHAINS), asset.Bytes())
+}
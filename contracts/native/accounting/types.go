@@ -0,0 +1,88 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package accounting
+
+import (
+	"math/big"
+)
+
+// Op is the accounting operation of a bridged asset on a chain.
+type Op uint8
+
+const (
+	OpLock   Op = iota + 1 // the asset is locked in custody for the chain
+	OpUnlock               // the asset locked for the chain is released
+	OpMint                 // the representation of the asset is minted on the chain
+	OpBurn                 // the representation minted on the chain is burned
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpLock:
+		return "lock"
+	case OpUnlock:
+		return "unlock"
+	case OpMint:
+		return "mint"
+	case OpBurn:
+		return "burn"
+	default:
+		return "unknown"
+	}
+}
+
+// Entry is an operation applied to the ledger.
+type Entry struct {
+	Op     Op
+	Amount *big.Int
+}
+
+// Ledger is the total locked and minted of an asset on a chain, the conservation holds if the
+// representation minted on the chain is backed by the asset locked for it, i.e. minted <= locked.
+type Ledger struct {
+	Locked *big.Int
+	Minted *big.Int
+	Nonce  uint64 // nonce of the reconciliation
+}
+
+func newLedger() *Ledger {
+	return &Ledger{Locked: new(big.Int), Minted: new(big.Int)}
+}
+
+// Holds returns whether the conservation holds.
+func (l *Ledger) Holds() bool {
+	return l.Minted.Cmp(l.Locked) <= 0
+}
+
+// apply returns false if the amount locked or minted goes negative.
+func (l *Ledger) apply(entry *Entry) bool {
+	switch entry.Op {
+	case OpLock:
+		l.Locked.Add(l.Locked, entry.Amount)
+	case OpUnlock:
+		l.Locked.Sub(l.Locked, entry.Amount)
+	case OpMint:
+		l.Minted.Add(l.Minted, entry.Amount)
+	case OpBurn:
+		l.Minted.Sub(l.Minted, entry.Amount)
+	default:
+		return false
+	}
+	return l.Locked.Sign() >= 0 && l.Minted.Sign() >= 0
+}
@@ -18,13 +18,25 @@
 package boot
 
 import (
+	"github.com/ethereum/go-ethereum/contracts/native/accounting"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/escrow"
 	"github.com/ethereum/go-ethereum/contracts/native/governance"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/access_control"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/data_oracle"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maintenance"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/neo3_state_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/price_oracle"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/relayer_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/scheduler"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/system"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/timelock"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+	"github.com/ethereum/go-ethereum/contracts/native/message_router"
+	"github.com/ethereum/go-ethereum/contracts/native/wzion"
 )
 
 func InitialNativeContracts() {
@@ -35,5 +47,17 @@ func InitialNativeContracts() {
 	node_manager.InitNodeManager()
 	relayer_manager.InitRelayerManager()
 	side_chain_manager.InitSideChainManager()
+	access_control.InitAccessControl()
+	timelock.InitTimelock()
+	audit_log.InitAuditLog()
+	price_oracle.InitPriceOracle()
+	data_oracle.InitDataOracle()
+	scheduler.InitScheduler()
+	system.InitSystem()
+	maintenance.InitMaintenance()
+	wzion.InitWZion()
+	message_router.InitMessageRouter()
+	escrow.InitEscrow()
+	accounting.InitAccounting()
 
 }
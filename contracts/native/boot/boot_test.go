@@ -0,0 +1,70 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package boot
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputRules(t *testing.T) {
+	InitialNativeContracts()
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	// the rules of every contract match its abi
+	for _, register := range native.Contracts {
+		assert.NotPanics(t, func() { register(native.NewNativeContract(db, nil)) })
+	}
+
+	caller := common.HexToAddress("0x01")
+	rejected := func(contract common.Address, payload []byte, method, arg string) {
+		ref := native.NewContractRef(db, caller, caller, big.NewInt(1), common.Hash{}, 1000000, nil)
+		_, _, err := ref.NativeCall(caller, contract, payload)
+		var invalid *native.InvalidInputError
+		if assert.True(t, errors.As(err, &invalid), "%s.%s", method, arg) {
+			assert.Equal(t, method, invalid.Method)
+			assert.Equal(t, arg, invalid.Arg)
+		}
+	}
+
+	payload, err := utils.PackMethod(scom.ABI, scom.MethodSubmitCheckpoint, uint64(1), make([]byte, common.HashLength+1), make([]byte, common.HashLength))
+	assert.NoError(t, err)
+	rejected(utils.CrossChainManagerContractAddress, payload, scom.MethodSubmitCheckpoint, "BlockHash")
+	payload, err = utils.PackMethod(scom.ABI, scom.MethodAttest, uint64(1), common.Hash{})
+	assert.NoError(t, err)
+	rejected(utils.CrossChainManagerContractAddress, payload, scom.MethodAttest, "MessageHash")
+
+	payload, err = utils.PackMethod(side_chain_manager.ABI, side_chain_manager.MethodQuitSideChain, uint64(1), common.Address{})
+	assert.NoError(t, err)
+	rejected(utils.SideChainManagerContractAddress, payload, side_chain_manager.MethodQuitSideChain, "Address")
+
+	payload, err = utils.PackMethod(hscommon.ABI, hscommon.MethodSyncGenesisHeader, uint64(1), []byte{})
+	assert.NoError(t, err)
+	rejected(utils.HeaderSyncContractAddress, payload, hscommon.MethodSyncGenesisHeader, "GenesisHeader")
+}
@@ -18,6 +18,7 @@
 package native
 
 import (
+	"errors"
 	"fmt"
 
 	abiPkg "github.com/ethereum/go-ethereum/accounts/abi"
@@ -25,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 type (
@@ -36,10 +38,25 @@ var (
 	Contracts = make(map[common.Address]RegisterService)
 )
 
+var (
+	ErrNativeCallDepth = errors.New("max native call depth exceeded")
+	ErrReentrantCall   = errors.New("reentrant call of non-query method")
+	ErrEVMUnavailable  = errors.New("evm not available in the native call")
+)
+
+// RevertError is implemented by the typed errors of native contracts, which are surfaced
+// to the caller as revert data instead of a plain failure.
+type RevertError interface {
+	error
+	RevertData() []byte
+}
+
 type NativeContract struct {
 	ref      *ContractRef
 	db       *state.StateDB
 	handlers map[string]MethodHandler // map method id to method handler
+	queries  map[string]struct{}      // map method id of read-only methods
+	rules    map[string]MethodRules   // map method id to argument rules
 	gasTable map[string]uint64        // map method id to gas usage
 	ab       *abiPkg.ABI
 }
@@ -49,6 +66,8 @@ func NewNativeContract(db *state.StateDB, ref *ContractRef) *NativeContract {
 		db:       db,
 		ref:      ref,
 		handlers: make(map[string]MethodHandler),
+		queries:  make(map[string]struct{}),
+		rules:    make(map[string]MethodRules),
 	}
 }
 
@@ -81,6 +100,24 @@ func (s *NativeContract) Register(name string, handler MethodHandler) {
 	s.handlers[methodID] = handler
 }
 
+// RegisterQuery register read-only method handler. query methods are free of gas and
+// dispatched without gas table checking, any state modification or event log generated
+// by the handler will be discarded, so that it's safe to be called in transactions.
+func (s *NativeContract) RegisterQuery(name string, handler MethodHandler) {
+	methodID := utils.MethodID(s.ab, name)
+	if gas := s.gasTable[methodID]; gas > 0 {
+		panic(fmt.Sprintf("query method %s should be free of gas, got %d", name, gas))
+	}
+	s.handlers[methodID] = handler
+	s.queries[methodID] = struct{}{}
+}
+
+// IsQuery return true if the method is registered as read-only method.
+func (s *NativeContract) IsQuery(methodID string) bool {
+	_, ok := s.queries[methodID]
+	return ok
+}
+
 // Invoke return execute ret and cost gas
 func (s *NativeContract) Invoke() ([]byte, error) {
 	// check context
@@ -108,6 +145,34 @@ func (s *NativeContract) Invoke() ([]byte, error) {
 		return nil, fmt.Errorf("failed to find method: [%s]", methodID)
 	}
 
+	// the calldata is checked against the argument rules before dispatching, queries included
+	if s.ref.IsInputRules() {
+		if err := s.checkInput(ctx.ContractAddress, methodID, ctx.Payload); err != nil {
+			return nil, err
+		}
+	}
+
+	// dispatch read-only method
+	if s.IsQuery(methodID) {
+		return s.query(handler)
+	}
+
+	// methods disabled for maintenance since governance v2 or by the caller guard are rejected,
+	// while the queries are always available
+	if s.ref.IsGovV2() {
+		if err := s.checkMethodGuard(ctx.ContractAddress, ctx.Payload[:4]); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkCallerGuard(ctx.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	// the contract should not be modified again before the previous call returned
+	if s.ref.IsReentrant() {
+		return nil, ErrReentrantCall
+	}
+
 	// check gasLeft
 	needGas, ok := s.gasTable[methodID]
 	if !ok {
@@ -118,17 +183,60 @@ func (s *NativeContract) Invoke() ([]byte, error) {
 		return nil, fmt.Errorf("gasLeft not enough, need %d, got %d", needGas, gasLeft)
 	}
 
-	// execute transaction and cost gas
+	// execute transaction and cost gas, the storage released by successful transaction is refunded
+	slots := s.db.NativeSlots()
 	ret, err := handler(s)
 	if err != nil && needGas > FailedTxGasUsage {
 		needGas = FailedTxGasUsage
 	}
+	if err == nil {
+		s.accountStorage(ctx.ContractAddress, methodID, s.db.NativeSlots()-slots)
+	}
+	// the handler may have charged the gas of evm callbacks by itself
+	if needGas > s.ref.gasLeft {
+		needGas = s.ref.gasLeft
+	}
 	if needGas > 0 {
 		s.ref.gasLeft -= needGas
 	}
 	return ret, err
 }
 
+// query execute read-only method handler. `eth_call` and other calls without tx hash
+// never persist the state, so the snapshot is only necessary in transactions.
+func (s *NativeContract) query(handler MethodHandler) ([]byte, error) {
+	if s.ref.TxHash() == common.EmptyHash {
+		return handler(s)
+	}
+
+	snapshot := s.db.Snapshot()
+	defer s.db.RevertToSnapshot(snapshot)
+	return handler(s)
+}
+
+// CallNative invoke method of another native contract with the current contract as caller. the callee
+// shares state and gas left with the caller, so the gas usage is accounted in the same way as a
+// transaction, and state modification of the callee will be reverted if it returns error.
+func (s *NativeContract) CallNative(to common.Address, input []byte) ([]byte, error) {
+	ctx := s.ref.CurrentContext()
+	if ctx == nil {
+		return nil, fmt.Errorf("context error")
+	}
+	if len(s.ref.contexts) >= MAX_NATIVE_CALL_DEPTH {
+		return nil, ErrNativeCallDepth
+	}
+	if _, ok := Contracts[to]; !ok {
+		return nil, fmt.Errorf("failed to find contract: [%x]", to)
+	}
+
+	snapshot := s.db.Snapshot()
+	ret, _, err := s.ref.NativeCall(ctx.ContractAddress, to, input)
+	if err != nil {
+		s.db.RevertToSnapshot(snapshot)
+	}
+	return ret, err
+}
+
 func (s *NativeContract) AddNotify(abi *abiPkg.ABI, topics []string, data ...interface{}) (err error) {
 
 	var topicIDs []common.Hash
@@ -158,3 +266,22 @@ func (s *NativeContract) AddNotify(abi *abiPkg.ABI, topics []string, data ...int
 	emitter.Event(topicIDs, packedData)
 	return
 }
+
+// AddCrossChainNotify emits the bridge event as `AddNotify` does, followed by the marker log of
+// `types.CrossChainTopic` and the event id since the cross chain bloom fork, so that the light
+// clients and relayers are able to filter the blocks with bridge activity by the logs bloom.
+func (s *NativeContract) AddCrossChainNotify(abi *abiPkg.ABI, topics []string, data ...interface{}) error {
+	if err := s.AddNotify(abi, topics, data...); err != nil {
+		return err
+	}
+	if !s.ContractRef().IsCrossChainBloom() {
+		return nil
+	}
+	event, ok := abi.Events[topics[0]]
+	if !ok {
+		event = abi.Events["evt"+abiPkg.ToCamelCase(topics[0])]
+	}
+	emitter := utils.NewEventEmitter(s.ref.CurrentContext().ContractAddress, s.ContractRef().BlockHeight().Uint64(), s.StateDB())
+	emitter.Event([]common.Hash{types.CrossChainTopic, event.ID}, nil)
+	return nil
+}
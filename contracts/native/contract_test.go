@@ -0,0 +1,279 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+const testABIJSON = `[
+	{"type":"function","name":"call","inputs":[{"name":"To","type":"address"},{"name":"Input","type":"bytes"}],"outputs":[{"name":"Ret","type":"bytes"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"callEVM","inputs":[{"name":"To","type":"address"},{"name":"Input","type":"bytes"}],"outputs":[{"name":"Ret","type":"bytes"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"recurse","inputs":[],"outputs":[{"name":"Depth","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"write","inputs":[],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"erase","inputs":[],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"fail","inputs":[],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"bridged","inputs":[{"indexed":false,"name":"Amount","type":"uint64"}],"anonymous":false}
+]`
+
+const testWriteGas = uint64(1000)
+
+var (
+	testABI      *abi.ABI
+	testCallerA  = NativeContractAddrMap[NativeExtra18]
+	testCalleeB  = NativeContractAddrMap[NativeExtra19]
+	testStoreKey = []byte("written")
+)
+
+type testCallParam struct {
+	To    common.Address
+	Input []byte
+}
+
+func TestMain(m *testing.M) {
+	ab, err := abi.JSON(strings.NewReader(testABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	testABI = &ab
+	Contracts[testCallerA] = registerTestContract
+	Contracts[testCalleeB] = registerTestContract
+	os.Exit(m.Run())
+}
+
+func registerTestContract(s *NativeContract) {
+	s.Prepare(testABI, map[string]uint64{"call": 0, "callEVM": 0, "write": testWriteGas, "erase": testWriteGas, "fail": testWriteGas})
+	s.Register("call", func(s *NativeContract) ([]byte, error) {
+		param := new(testCallParam)
+		if err := utils.UnpackMethod(testABI, "call", param, s.ContractRef().CurrentContext().Payload); err != nil {
+			return nil, err
+		}
+		return s.CallNative(param.To, param.Input)
+	})
+	s.RegisterRules("call", MethodRules{"To": {NonZero: true}, "Input": {MaxBytes: 256}})
+	s.Register("callEVM", func(s *NativeContract) ([]byte, error) {
+		param := new(testCallParam)
+		if err := utils.UnpackMethod(testABI, "callEVM", param, s.ContractRef().CurrentContext().Payload); err != nil {
+			return nil, err
+		}
+		return s.ContractRef().EVMCall(s.ContractRef().CurrentContext().ContractAddress, param.To, param.Input, s.ContractRef().GasLeft())
+	})
+	s.RegisterQuery("recurse", func(s *NativeContract) ([]byte, error) {
+		ctx := s.ContractRef().CurrentContext()
+		if _, err := s.CallNative(ctx.ContractAddress, ctx.Payload); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	s.Register("write", func(s *NativeContract) ([]byte, error) {
+		s.GetCacheDB().Put(utils.ConcatKey(s.ContractRef().CurrentContext().ContractAddress, testStoreKey), []byte{1})
+		return utils.PackOutputs(testABI, "write", true)
+	})
+	s.Register("erase", func(s *NativeContract) ([]byte, error) {
+		s.GetCacheDB().Delete(utils.ConcatKey(s.ContractRef().CurrentContext().ContractAddress, testStoreKey))
+		return utils.PackOutputs(testABI, "erase", true)
+	})
+	s.Register("fail", func(s *NativeContract) ([]byte, error) {
+		s.GetCacheDB().Put(utils.ConcatKey(s.ContractRef().CurrentContext().ContractAddress, testStoreKey), []byte{1})
+		return nil, ErrReentrantCall
+	})
+}
+
+func newTestRef(t *testing.T, gas uint64) (*state.StateDB, *ContractRef) {
+	db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	assert.NoError(t, err)
+	origin := common.HexToAddress("0x123")
+	return db, NewContractRef(db, origin, origin, big.NewInt(1), common.HexToHash("0x1"), gas, nil)
+}
+
+func packCall(t *testing.T, to common.Address, method string) []byte {
+	input, err := utils.PackMethod(testABI, method)
+	assert.NoError(t, err)
+	payload, err := utils.PackMethod(testABI, "call", to, input)
+	assert.NoError(t, err)
+	return payload
+}
+
+func written(db *state.StateDB, addr common.Address) bool {
+	value, _ := (*state.CacheDB)(db).Get(utils.ConcatKey(addr, testStoreKey))
+	return len(value) > 0
+}
+
+func TestCallNative(t *testing.T) {
+	db, ref := newTestRef(t, testWriteGas)
+	_, gasLeft, err := ref.NativeCall(ref.caller, testCallerA, packCall(t, testCalleeB, "write"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), gasLeft, "callee gas should be charged")
+	assert.True(t, written(db, testCalleeB))
+
+	// not enough gas left for the callee
+	db, ref = newTestRef(t, testWriteGas-1)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, packCall(t, testCalleeB, "write"))
+	assert.Error(t, err)
+	assert.False(t, written(db, testCalleeB))
+
+	// state of failed callee is reverted
+	db, ref = newTestRef(t, testWriteGas)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, packCall(t, testCalleeB, "fail"))
+	assert.Error(t, err)
+	assert.False(t, written(db, testCalleeB))
+
+	// unknown callee
+	_, ref = newTestRef(t, testWriteGas)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, packCall(t, common.HexToAddress("0x1"), "write"))
+	assert.Error(t, err)
+}
+
+func TestCallNativeReentrancy(t *testing.T) {
+	db, ref := newTestRef(t, testWriteGas)
+	_, _, err := ref.NativeCall(ref.caller, testCallerA, packCall(t, testCallerA, "write"))
+	assert.Equal(t, ErrReentrantCall, err)
+	assert.False(t, written(db, testCallerA))
+
+	// A -> B -> A
+	inner := packCall(t, testCallerA, "write")
+	outer, err := utils.PackMethod(testABI, "call", testCalleeB, inner)
+	assert.NoError(t, err)
+	_, ref = newTestRef(t, testWriteGas)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, outer)
+	assert.Equal(t, ErrReentrantCall, err)
+}
+
+func TestCallNativeReentrancyThroughEVM(t *testing.T) {
+	// A -> evm -> A, the evm contract calls back the native contract with a new contract ref
+	call := func(config *params.ChainConfig) (*state.StateDB, error) {
+		db, ref := newTestRef(t, testWriteGas)
+		ref.SetChainConfig(config)
+		ref.evmHandler = func(caller, addr common.Address, input []byte, gas uint64) ([]byte, uint64, error) {
+			inner := NewContractRef(db, ref.origin, addr, ref.blockHeight, ref.txHash, gas, nil)
+			inner.SetChainConfig(config)
+			inner.SetOuter(ref)
+			return inner.NativeCall(addr, testCallerA, input)
+		}
+		write, err := utils.PackMethod(testABI, "write")
+		assert.NoError(t, err)
+		payload, err := utils.PackMethod(testABI, "callEVM", common.HexToAddress("0xe"), write)
+		assert.NoError(t, err)
+		_, _, err = ref.NativeCall(ref.caller, testCallerA, payload)
+		return db, err
+	}
+
+	db, err := call(nil)
+	assert.Equal(t, ErrReentrantCall, err)
+	assert.False(t, written(db, testCallerA))
+
+	// allowed before the reentrancy guard fork
+	db, err = call(&params.ChainConfig{ReentrancyGuardBlock: big.NewInt(2)})
+	assert.NoError(t, err)
+	assert.True(t, written(db, testCallerA))
+}
+
+func TestCallNativeDepth(t *testing.T) {
+	input, err := utils.PackMethod(testABI, "recurse")
+	assert.NoError(t, err)
+
+	_, ref := newTestRef(t, 0)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, input)
+	assert.Equal(t, ErrNativeCallDepth, err)
+	assert.Equal(t, 1, len(ref.contexts))
+}
+
+func TestStorageRefund(t *testing.T) {
+	write, err := utils.PackMethod(testABI, "write")
+	assert.NoError(t, err)
+	erase, err := utils.PackMethod(testABI, "erase")
+	assert.NoError(t, err)
+
+	// storage growth is not refunded
+	db, ref := newTestRef(t, testWriteGas)
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, write)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), db.GetRefund())
+
+	// released slot is refunded
+	ref = NewContractRef(db, ref.caller, ref.caller, big.NewInt(1), common.HexToHash("0x2"), testWriteGas, nil)
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, erase)
+	assert.NoError(t, err)
+	assert.False(t, written(db, testCalleeB))
+	assert.Equal(t, StorageRefundGas, db.GetRefund())
+
+	// nested call is refunded only once in the entry context
+	db, ref = newTestRef(t, testWriteGas)
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, write)
+	assert.NoError(t, err)
+	ref = NewContractRef(db, ref.caller, ref.caller, big.NewInt(1), common.HexToHash("0x2"), testWriteGas, nil)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, packCall(t, testCalleeB, "erase"))
+	assert.NoError(t, err)
+	assert.Equal(t, StorageRefundGas, db.GetRefund())
+
+	// erasing nothing is not refunded
+	ref = NewContractRef(db, ref.caller, ref.caller, big.NewInt(1), common.HexToHash("0x3"), testWriteGas, nil)
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, erase)
+	assert.NoError(t, err)
+	assert.Equal(t, StorageRefundGas, db.GetRefund())
+
+	// released slot is not refunded before the fork
+	db, ref = newTestRef(t, testWriteGas)
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, write)
+	assert.NoError(t, err)
+	ref = NewContractRef(db, ref.caller, ref.caller, big.NewInt(1), common.HexToHash("0x2"), testWriteGas, nil)
+	ref.SetChainConfig(&params.ChainConfig{StorageRefundBlock: big.NewInt(2)})
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, erase)
+	assert.NoError(t, err)
+	assert.False(t, written(db, testCalleeB))
+	assert.Equal(t, uint64(0), db.GetRefund())
+}
+
+func TestAddCrossChainNotify(t *testing.T) {
+	notify := func(fork int64) []*types.Log {
+		db, ref := newTestRef(t, 0)
+		config := *params.TestChainConfig
+		config.CrossChainBloomBlock = big.NewInt(fork)
+		ref.SetChainConfig(&config)
+		ref.PushContext(&Context{Caller: ref.caller, ContractAddress: testCallerA})
+		assert.NoError(t, NewNativeContract(db, ref).AddCrossChainNotify(testABI, []string{"bridged"}, uint64(1)))
+		return db.GetLogs(common.Hash{})
+	}
+
+	// bridge event is not marked before the fork
+	logs := notify(2)
+	assert.Equal(t, 1, len(logs))
+	assert.False(t, types.IsCrossChainMarker(logs[0]))
+	assert.False(t, types.HasCrossChainEvents(types.CreateBloom(types.Receipts{{Logs: logs}})))
+
+	logs = notify(1)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, testABI.Events["bridged"].ID, logs[0].Topics[0])
+	assert.True(t, types.IsCrossChainMarker(logs[1]))
+	assert.Equal(t, testCallerA, logs[1].Address)
+	assert.Equal(t, testABI.Events["bridged"].ID, logs[1].Topics[1])
+	assert.True(t, types.HasCrossChainEvents(types.CreateBloom(types.Receipts{{Logs: logs}})))
+}
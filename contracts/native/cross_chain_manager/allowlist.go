@@ -0,0 +1,127 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+const SOURCE_ALLOWLIST = "sourceAllowlist"
+
+// SetSourceAllowlist validators replace the source contracts allowed to make cross chain messages
+// on the side chain, it takes effect after the consensus signs reached quorum. an empty list
+// removes the restriction, and the messages from any contract calling the CCMC are accepted.
+func SetSourceAllowlist(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetSourceAllowlistParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetSourceAllowlist, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChain(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetSourceAllowlist, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SetSourceAllowlist, side chain %d is not registered", params.ChainID)
+	}
+	for i, c := range params.Contracts {
+		if len(c) == 0 {
+			return nil, fmt.Errorf("SetSourceAllowlist, contract %d is empty", i)
+		}
+		for _, prev := range params.Contracts[:i] {
+			if bytes.Equal(prev, c) {
+				return nil, fmt.Errorf("SetSourceAllowlist, duplicated contract %x", c)
+			}
+		}
+	}
+
+	allowlist, err := GetSourceAllowlist(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetSourceAllowlist, GetSourceAllowlist error: %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(allowlist.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSetSourceAllowlist, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetSourceAllowlist, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetSourceAllowlist, true)
+	}
+
+	PutSourceAllowlist(native, params.ChainID, &scom.SourceAllowlist{
+		Contracts: params.Contracts,
+		Nonce:     allowlist.Nonce + 1,
+	})
+	return utils.PackOutputs(scom.ABI, scom.MethodSetSourceAllowlist, true)
+}
+
+func SourceAllowlist(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BlackChainParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSourceAllowlist, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	allowlist, err := GetSourceAllowlist(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SourceAllowlist, GetSourceAllowlist error: %v", err)
+	}
+	contracts := allowlist.Contracts
+	if contracts == nil {
+		contracts = [][]byte{}
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSourceAllowlist, contracts)
+}
+
+func PutSourceAllowlist(native *native.NativeContract, chainID uint64, allowlist *scom.SourceAllowlist) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	allowlist.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(SOURCE_ALLOWLIST), utils.GetUint64Bytes(chainID)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetSourceAllowlist returns an empty allowlist which accepts any source contract if it's never set.
+func GetSourceAllowlist(native *native.NativeContract, chainID uint64) (*scom.SourceAllowlist, error) {
+	contract := utils.CrossChainManagerContractAddress
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(SOURCE_ALLOWLIST), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("GetSourceAllowlist, get allowlist store error: %v", err)
+	}
+	allowlist := new(scom.SourceAllowlist)
+	if store == nil {
+		return allowlist, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetSourceAllowlist, deserialize from raw storage item err:%v", err)
+	}
+	if err := allowlist.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetSourceAllowlist, deserialize allowlist error: %v", err)
+	}
+	return allowlist, nil
+}
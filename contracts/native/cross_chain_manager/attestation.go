@@ -0,0 +1,249 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// SetDualVerification validators configure the attestors of the side chain, it takes effect after
+// the consensus signs reached quorum. while the attestors are set, a message of the chain is
+// imported only if both the proof is verified and the message is attested by the threshold of
+// attestors, which is used in the probation of a new chain or handler. an empty attestor list
+// with zero threshold switches the chain back to the proof verification only.
+func SetDualVerification(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetDualVerificationParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetDualVerification, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChain(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetDualVerification, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SetDualVerification, side chain %d is not registered", params.ChainID)
+	}
+	for i, a := range params.Attestors {
+		if a == (common.Address{}) {
+			return nil, fmt.Errorf("SetDualVerification, attestor %d is empty", i)
+		}
+		for _, prev := range params.Attestors[:i] {
+			if prev == a {
+				return nil, fmt.Errorf("SetDualVerification, duplicated attestor %s", a.Hex())
+			}
+		}
+	}
+	if len(params.Attestors) == 0 && params.Threshold != 0 {
+		return nil, fmt.Errorf("SetDualVerification, threshold %d without attestors", params.Threshold)
+	}
+	if len(params.Attestors) > 0 && (params.Threshold == 0 || params.Threshold > uint64(len(params.Attestors))) {
+		return nil, fmt.Errorf("SetDualVerification, threshold %d out of range of %d attestors", params.Threshold, len(params.Attestors))
+	}
+
+	dual, err := GetDualVerification(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetDualVerification, GetDualVerification error: %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(dual.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSetDualVerification, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetDualVerification, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetDualVerification, true)
+	}
+
+	PutDualVerification(native, params.ChainID, &scom.DualVerification{
+		Attestors: params.Attestors,
+		Threshold: params.Threshold,
+		Nonce:     dual.Nonce + 1,
+	})
+	attestors := params.Attestors
+	if attestors == nil {
+		attestors = []common.Address{}
+	}
+	if err := native.AddNotify(scom.ABI, []string{scom.NOTIFY_DUAL_VERIFICATION}, params.ChainID, attestors, params.Threshold); err != nil {
+		return nil, fmt.Errorf("SetDualVerification, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetDualVerification, true)
+}
+
+func DualVerification(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BlackChainParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodDualVerification, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	dual, err := GetDualVerification(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("DualVerification, GetDualVerification error: %v", err)
+	}
+	attestors := dual.Attestors
+	if attestors == nil {
+		attestors = []common.Address{}
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodDualVerification, attestors, dual.Threshold)
+}
+
+// Attest an attestor of the side chain confirms the message observed on the source chain, the
+// message hash is the keccak256 of the serialized `MakeTxParam`, the same as the one stored by
+// the CCMC of source chain.
+func Attest(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.AttestParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodAttest, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	dual, err := GetDualVerification(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("Attest, GetDualVerification error: %v", err)
+	}
+	if !dual.Enabled() {
+		return nil, fmt.Errorf("Attest, dual verification of chain %d is not enabled", params.ChainID)
+	}
+	sender := native.ContractRef().MsgSender()
+	if !dual.IsAttestor(sender) {
+		return nil, fmt.Errorf("Attest, %s is not an attestor of chain %d", sender.Hex(), params.ChainID)
+	}
+
+	attestation, err := GetAttestation(native, params.ChainID, params.MessageHash)
+	if err != nil {
+		return nil, fmt.Errorf("Attest, GetAttestation error: %v", err)
+	}
+	for _, a := range attestation.Attestors {
+		if a == sender {
+			return nil, fmt.Errorf("Attest, %s already attested message %s", sender.Hex(), params.MessageHash.Hex())
+		}
+	}
+	attestation.Attestors = append(attestation.Attestors, sender)
+	PutAttestation(native, params.ChainID, params.MessageHash, attestation)
+
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_ATTESTED_EVENT}, params.ChainID, params.MessageHash, sender); err != nil {
+		return nil, fmt.Errorf("Attest, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodAttest, true)
+}
+
+func Attestations(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.AttestParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodAttestations, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	attestation, err := GetAttestation(native, params.ChainID, params.MessageHash)
+	if err != nil {
+		return nil, fmt.Errorf("Attestations, GetAttestation error: %v", err)
+	}
+	attestors := attestation.Attestors
+	if attestors == nil {
+		attestors = []common.Address{}
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodAttestations, attestors)
+}
+
+// checkAttestation requires the message to be attested by the threshold of current attestors if
+// the dual verification of source chain is enabled, the attestations of removed attestors are
+// not counted.
+func checkAttestation(native *native.NativeContract, chainID uint64, txParam *scom.MakeTxParam) error {
+	dual, err := GetDualVerification(native, chainID)
+	if err != nil {
+		return fmt.Errorf("checkAttestation, GetDualVerification error: %v", err)
+	}
+	if !dual.Enabled() {
+		return nil
+	}
+	hash := messageHash(txParam)
+	attestation, err := GetAttestation(native, chainID, hash)
+	if err != nil {
+		return fmt.Errorf("checkAttestation, GetAttestation error: %v", err)
+	}
+	if !dual.Attested(attestation.Attestors) {
+		return scom.NewImportError(scom.ErrCodeNotAttested, "checkAttestation, message %s of chain %d is not attested by %d attestors",
+			hash.Hex(), chainID, dual.Threshold)
+	}
+	return nil
+}
+
+func PutDualVerification(native *native.NativeContract, chainID uint64, dual *scom.DualVerification) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	dual.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.DUAL_VERIFICATION), utils.GetUint64Bytes(chainID)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetDualVerification returns a disabled config if it's never set.
+func GetDualVerification(native *native.NativeContract, chainID uint64) (*scom.DualVerification, error) {
+	contract := utils.CrossChainManagerContractAddress
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.DUAL_VERIFICATION), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("GetDualVerification, get dual verification store error: %v", err)
+	}
+	dual := new(scom.DualVerification)
+	if store == nil {
+		return dual, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetDualVerification, deserialize from raw storage item err:%v", err)
+	}
+	if err := dual.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetDualVerification, deserialize dual verification error: %v", err)
+	}
+	return dual, nil
+}
+
+func PutAttestation(native *native.NativeContract, chainID uint64, hash common.Hash, attestation *scom.Attestation) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	attestation.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.ATTESTATION), utils.GetUint64Bytes(chainID), hash[:]),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+func GetAttestation(native *native.NativeContract, chainID uint64, hash common.Hash) (*scom.Attestation, error) {
+	contract := utils.CrossChainManagerContractAddress
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.ATTESTATION), utils.GetUint64Bytes(chainID), hash[:]))
+	if err != nil {
+		return nil, fmt.Errorf("GetAttestation, get attestation store error: %v", err)
+	}
+	attestation := new(scom.Attestation)
+	if store == nil {
+		return attestation, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetAttestation, deserialize from raw storage item err:%v", err)
+	}
+	if err := attestation.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetAttestation, deserialize attestation error: %v", err)
+	}
+	return attestation, nil
+}
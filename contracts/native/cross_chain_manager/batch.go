@@ -0,0 +1,214 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// MaxBatchSize is the maximum number of outbound messages in a batch.
+const MaxBatchSize = 16
+
+// MakeBatch groups the outbound messages just sent by the sender into a batch, which is tracked
+// with the delivery receipts: the batch is delivered once all of the messages are delivered, and
+// fails as soon as any of them fails, the failed batch is refunded as a whole by `refundBatch`.
+// the messages should be accepted and not in another batch.
+func MakeBatch(native *native.NativeContract, batchID common.Hash, sender common.Address, members []scom.BatchMember) error {
+	if len(members) == 0 || len(members) > MaxBatchSize {
+		return fmt.Errorf("MakeBatch, invalid batch size %d", len(members))
+	}
+	batch, err := GetBatch(native, batchID)
+	if err != nil {
+		return fmt.Errorf("MakeBatch, %v", err)
+	}
+	if batch != nil {
+		return fmt.Errorf("MakeBatch, batch %s already exists", batchID.Hex())
+	}
+	for _, m := range members {
+		state, err := GetOutboundState(native, m.ToChainID, m.Sequence)
+		if err != nil {
+			return fmt.Errorf("MakeBatch, GetOutboundState error: %v", err)
+		}
+		if state != StateAccepted {
+			return fmt.Errorf("MakeBatch, outbound message %d to chain %d is %s", m.Sequence, m.ToChainID, state)
+		}
+		exist, err := getBatchIndex(native, m.ToChainID, m.Sequence)
+		if err != nil {
+			return fmt.Errorf("MakeBatch, getBatchIndex error: %v", err)
+		}
+		if exist != nil {
+			return fmt.Errorf("MakeBatch, outbound message %d to chain %d is in batch %s", m.Sequence, m.ToChainID, exist.Hex())
+		}
+		native.GetCacheDB().Put(batchIndexKey(m.ToChainID, m.Sequence), cstates.GenRawStorageItem(batchID.Bytes()))
+	}
+	batch = &scom.Batch{Sender: sender, Members: members, State: uint8(StateAccepted)}
+	putBatch(native, batchID, batch)
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_BATCH_EVENT}, batchID, batch.State); err != nil {
+		return fmt.Errorf("MakeBatch, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// updateBatch moves the batch of the outbound message forward with the new state of the message,
+// and notifies the callback of sender in sandbox once the batch is delivered or failed.
+func updateBatch(native *native.NativeContract, chainID, seq uint64, state LifecycleState) error {
+	batchID, err := getBatchIndex(native, chainID, seq)
+	if err != nil || batchID == nil {
+		return err
+	}
+	batch, err := GetBatch(native, *batchID)
+	if err != nil {
+		return fmt.Errorf("updateBatch, %v", err)
+	}
+	if batch == nil || LifecycleState(batch.State) != StateAccepted {
+		return nil
+	}
+	switch state {
+	case StateDelivered:
+		batch.Delivered++
+		if batch.Delivered == uint64(len(batch.Members)) {
+			batch.State = uint8(StateDelivered)
+		}
+	case StateFailed:
+		batch.State = uint8(StateFailed)
+	}
+	putBatch(native, *batchID, batch)
+	if LifecycleState(batch.State) == StateAccepted {
+		return nil
+	}
+	return notifyBatch(native, *batchID, batch, true)
+}
+
+// RefundBatch anyone moves the failed batch to refunded, and the callback of sender compensates
+// the whole batch, including the messages delivered. the refund fails with the callback.
+func RefundBatch(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BatchParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodRefundBatch, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	batch, err := GetBatch(native, params.BatchID)
+	if err != nil {
+		return nil, fmt.Errorf("RefundBatch, %v", err)
+	}
+	if batch == nil {
+		return nil, fmt.Errorf("RefundBatch, batch %s not found", params.BatchID.Hex())
+	}
+	if state := LifecycleState(batch.State); state != StateFailed {
+		return nil, fmt.Errorf("RefundBatch, batch %s is %s", params.BatchID.Hex(), state)
+	}
+	batch.State = uint8(StateRefunded)
+	putBatch(native, params.BatchID, batch)
+	if err := notifyBatch(native, params.BatchID, batch, false); err != nil {
+		return nil, fmt.Errorf("RefundBatch, %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodRefundBatch, true)
+}
+
+func Batch(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BatchParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodBatch, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	batch, err := GetBatch(native, params.BatchID)
+	if err != nil {
+		return nil, fmt.Errorf("Batch, %v", err)
+	}
+	if batch == nil {
+		batch = new(scom.Batch)
+	}
+	chainIDs, seqs := make([]uint64, len(batch.Members)), make([]uint64, len(batch.Members))
+	for i, m := range batch.Members {
+		chainIDs[i], seqs[i] = m.ToChainID, m.Sequence
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodBatch, batch.Sender, batch.State, chainIDs, seqs)
+}
+
+// notifyBatch emits the new state of batch and calls the callback of sender with it.
+func notifyBatch(native *native.NativeContract, batchID common.Hash, batch *scom.Batch, sandboxed bool) error {
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_BATCH_EVENT}, batchID, batch.State); err != nil {
+		return fmt.Errorf("notifyBatch, AddNotify error: %v", err)
+	}
+	invoked, success, err := callOutboundCallback(native, batch.Sender, sandboxed, MethodOnCrossChainBatch, batchID, batch.State)
+	if err != nil || !invoked {
+		return err
+	}
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_BATCH_CALLBACK_EVENT}, batch.Sender, batchID, batch.State, success); err != nil {
+		return fmt.Errorf("notifyBatch, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+func putBatch(native *native.NativeContract, batchID common.Hash, batch *scom.Batch) {
+	sink := polycomm.NewZeroCopySink(nil)
+	batch.Serialization(sink)
+	native.GetCacheDB().Put(batchKey(batchID), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetBatch returns nil if the batch doesn't exist.
+func GetBatch(native *native.NativeContract, batchID common.Hash) (*scom.Batch, error) {
+	store, err := native.GetCacheDB().Get(batchKey(batchID))
+	if err != nil {
+		return nil, fmt.Errorf("GetBatch, get batch store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetBatch, deserialize from raw storage item err:%v", err)
+	}
+	batch := new(scom.Batch)
+	if err := batch.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetBatch, deserialize batch error: %v", err)
+	}
+	return batch, nil
+}
+
+// getBatchIndex returns the id of the batch which the outbound message belongs to, or nil.
+func getBatchIndex(native *native.NativeContract, chainID, seq uint64) (*common.Hash, error) {
+	store, err := native.GetCacheDB().Get(batchIndexKey(chainID, seq))
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, err
+	}
+	batchID := common.BytesToHash(raw)
+	return &batchID, nil
+}
+
+func batchKey(batchID common.Hash) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(scom.BATCH), batchID.Bytes())
+}
+
+func batchIndexKey(chainID, seq uint64) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(scom.BATCH_INDEX), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(seq))
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bsc
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/handlertest"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hsbsc "github.com/ethereum/go-ethereum/contracts/native/header_sync/bsc"
+	hscom "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync/eth/types"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	testChainID = uint64(2)
+	testHeight  = uint32(100)
+)
+
+var testCCMC = common.HexToAddress("0x1234")
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	hscom.ABI = hscom.GetABI()
+	side_chain_manager.ABI = side_chain_manager.GetABI()
+	os.Exit(m.Run())
+}
+
+// newFixture syncs the header of the source state as genesis, with a single validator.
+func newFixture(t *testing.T) *handlertest.Fixture {
+	src := handlertest.NewEVMSource(t, testCCMC, 3, 2)
+	env := handlertest.NewEnv(t)
+	env.RegisterSideChain(t, &side_chain_manager.SideChain{
+		ChainId:      testChainID,
+		Router:       utils.BSC_ROUTER,
+		Name:         "bsc",
+		BlocksToWait: 1,
+		CCMCAddress:  testCCMC.Bytes(),
+	})
+
+	extra := make([]byte, 32+common.AddressLength+crypto.SignatureLength)
+	copy(extra[32:], common.HexToAddress("0x5").Bytes())
+	header := types.Header{
+		Root:       src.Root,
+		Difficulty: big.NewInt(2),
+		Number:     big.NewInt(int64(testHeight)),
+		Extra:      extra,
+	}
+	genesis, _ := json.Marshal(&hsbsc.GenesisHeader{Header: header, PrevValidators: []hsbsc.HeightAndValidators{{Height: big.NewInt(int64(testHeight) - 1)}}})
+	payload, err := utils.PackMethod(hscom.ABI, hscom.MethodSyncGenesisHeader, testChainID, genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hsbsc.NewHandler().SyncGenesisHeader(env.Contract(env.Validator, payload)); err != nil {
+		t.Fatal(err)
+	}
+	return &handlertest.Fixture{
+		Env:     env,
+		Handler: NewHandler(),
+		Valid: &scom.EntranceParam{SourceChainID: testChainID, Height: testHeight,
+			Proof: src.Proof(t, 0), Extra: src.Extras[0]},
+		Message: src.Messages[0],
+		Unconfirmed: &scom.EntranceParam{SourceChainID: testChainID, Height: testHeight + 1,
+			Proof: src.Proof(t, 1), Extra: src.Extras[1]},
+	}
+}
+
+func TestHandlerConformance(t *testing.T) {
+	handlertest.TestHandlerSuite(t, newFixture)
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+const (
+	CHECKPOINT        = "checkpoint"
+	CHECKPOINT_CONFIG = "checkpointConfig"
+
+	// zion itself is the source chain of checkpoint messages
+	CheckpointFromChainID = uint64(0)
+	// method name called on the anchor contract of target chain
+	CheckpointMethod = "checkpoint"
+)
+
+// SetCheckpointConfig validators set the checkpoint interval and anchor chain, it takes effect after
+// the consensus signs reached quorum. checkpointing is disabled if the interval is 0.
+func SetCheckpointConfig(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetCheckpointConfigParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetCheckpointConfig, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	if params.Interval > 0 {
+		if len(params.AnchorContract) == 0 {
+			return nil, fmt.Errorf("SetCheckpointConfig, anchor contract is empty")
+		}
+		sideChain, err := side_chain_manager.GetSideChain(native, params.AnchorChainID)
+		if err != nil {
+			return nil, fmt.Errorf("SetCheckpointConfig, side_chain_manager.GetSideChain error: %v", err)
+		}
+		if sideChain == nil {
+			return nil, fmt.Errorf("SetCheckpointConfig, side chain %d is not registered", params.AnchorChainID)
+		}
+	}
+
+	config, err := GetCheckpointConfig(native)
+	if err != nil {
+		return nil, fmt.Errorf("SetCheckpointConfig, GetCheckpointConfig error: %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(config.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSetCheckpointConfig, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetCheckpointConfig, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetCheckpointConfig, true)
+	}
+
+	PutCheckpointConfig(native, &scom.CheckpointConfig{
+		Interval:       params.Interval,
+		AnchorChainID:  params.AnchorChainID,
+		AnchorContract: params.AnchorContract,
+		Nonce:          config.Nonce + 1,
+	})
+	return utils.PackOutputs(scom.ABI, scom.MethodSetCheckpointConfig, true)
+}
+
+// SubmitCheckpoint validators sign the block hash and state root at every `Interval` blocks, the
+// checkpoint is sent to the anchor chain as a cross chain message after the signs reached quorum.
+func SubmitCheckpoint(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SubmitCheckpointParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSubmitCheckpoint, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	config, err := GetCheckpointConfig(native)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, GetCheckpointConfig error: %v", err)
+	}
+	if config.Interval == 0 {
+		return nil, fmt.Errorf("SubmitCheckpoint, checkpoint is disabled")
+	}
+	if params.Height == 0 || params.Height%config.Interval != 0 {
+		return nil, fmt.Errorf("SubmitCheckpoint, height %d is not a checkpoint height", params.Height)
+	}
+	if params.Height >= native.ContractRef().BlockHeight().Uint64() {
+		return nil, fmt.Errorf("SubmitCheckpoint, height %d is not committed", params.Height)
+	}
+	if len(params.BlockHash) != common.HashLength || len(params.StateRoot) != common.HashLength {
+		return nil, fmt.Errorf("SubmitCheckpoint, invalid block hash or state root")
+	}
+	last, err := GetCheckpoint(native)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, GetCheckpoint error: %v", err)
+	}
+	if last != nil && params.Height <= last.Height {
+		return nil, fmt.Errorf("SubmitCheckpoint, height %d already checkpointed", params.Height)
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSubmitCheckpoint, ctx.Payload, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSubmitCheckpoint, true)
+	}
+
+	blacked, err := CheckIfChainBlacked(native, config.AnchorChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, CheckIfChainBlacked error: %v", err)
+	}
+	if blacked {
+		return nil, fmt.Errorf("SubmitCheckpoint, anchor chain is blacked")
+	}
+
+	epoch, err := node_manager.GetCurrentEpoch(native)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, node_manager.GetCurrentEpoch error: %v", err)
+	}
+	checkpoint := &scom.Checkpoint{
+		Height:    params.Height,
+		BlockHash: params.BlockHash,
+		StateRoot: params.StateRoot,
+		EpochHash: epoch.Hash().Bytes(),
+	}
+	PutCheckpoint(native, checkpoint)
+
+	sink := polycomm.NewZeroCopySink(nil)
+	checkpoint.Serialization(sink)
+	txHash := native.ContractRef().TxHash()
+	txParam := &scom.MakeTxParam{
+		TxHash:              txHash[:],
+		CrossChainID:        crypto.Keccak256(sink.Bytes()),
+		FromContractAddress: this[:],
+		ToChainID:           config.AnchorChainID,
+		ToContractAddress:   config.AnchorContract,
+		Method:              CheckpointMethod,
+		Args:                sink.Bytes(),
+	}
+	if err := MakeTransaction(native, txParam, CheckpointFromChainID); err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, MakeTransaction error: %v", err)
+	}
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_CHECKPOINT_EVENT}, checkpoint.Height,
+		checkpoint.BlockHash, checkpoint.StateRoot, checkpoint.EpochHash); err != nil {
+		return nil, fmt.Errorf("SubmitCheckpoint, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSubmitCheckpoint, true)
+}
+
+func CheckpointConfig(native *native.NativeContract) ([]byte, error) {
+	config, err := GetCheckpointConfig(native)
+	if err != nil {
+		return nil, fmt.Errorf("CheckpointConfig, GetCheckpointConfig error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodCheckpointConfig, config.Interval, config.AnchorChainID, config.AnchorContract)
+}
+
+func Checkpoint(native *native.NativeContract) ([]byte, error) {
+	checkpoint, err := GetCheckpoint(native)
+	if err != nil {
+		return nil, fmt.Errorf("Checkpoint, GetCheckpoint error: %v", err)
+	}
+	if checkpoint == nil {
+		return nil, fmt.Errorf("Checkpoint, checkpoint not exist")
+	}
+	sink := polycomm.NewZeroCopySink(nil)
+	checkpoint.Serialization(sink)
+	return utils.PackOutputs(scom.ABI, scom.MethodCheckpoint, sink.Bytes())
+}
+
+func PutCheckpointConfig(native *native.NativeContract, config *scom.CheckpointConfig) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	config.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(CHECKPOINT_CONFIG)), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetCheckpointConfig returns an empty config which disables checkpointing if it's never set.
+func GetCheckpointConfig(native *native.NativeContract) (*scom.CheckpointConfig, error) {
+	contract := utils.CrossChainManagerContractAddress
+	configStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(CHECKPOINT_CONFIG)))
+	if err != nil {
+		return nil, fmt.Errorf("GetCheckpointConfig, get configStore error: %v", err)
+	}
+	config := new(scom.CheckpointConfig)
+	if configStore == nil {
+		return config, nil
+	}
+	configBytes, err := cstates.GetValueFromRawStorageItem(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("GetCheckpointConfig, deserialize from raw storage item err:%v", err)
+	}
+	if err := config.Deserialization(polycomm.NewZeroCopySource(configBytes)); err != nil {
+		return nil, fmt.Errorf("GetCheckpointConfig, deserialize config error: %v", err)
+	}
+	return config, nil
+}
+
+func PutCheckpoint(native *native.NativeContract, checkpoint *scom.Checkpoint) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	checkpoint.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(CHECKPOINT)), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetCheckpoint returns the latest checkpoint, or nil if there is no checkpoint yet.
+func GetCheckpoint(native *native.NativeContract) (*scom.Checkpoint, error) {
+	contract := utils.CrossChainManagerContractAddress
+	checkpointStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(CHECKPOINT)))
+	if err != nil {
+		return nil, fmt.Errorf("GetCheckpoint, get checkpointStore error: %v", err)
+	}
+	if checkpointStore == nil {
+		return nil, nil
+	}
+	checkpointBytes, err := cstates.GetValueFromRawStorageItem(checkpointStore)
+	if err != nil {
+		return nil, fmt.Errorf("GetCheckpoint, deserialize from raw storage item err:%v", err)
+	}
+	checkpoint := new(scom.Checkpoint)
+	if err := checkpoint.Deserialization(polycomm.NewZeroCopySource(checkpointBytes)); err != nil {
+		return nil, fmt.Errorf("GetCheckpoint, deserialize checkpoint error: %v", err)
+	}
+	return checkpoint, nil
+}
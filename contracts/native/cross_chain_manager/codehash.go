@@ -0,0 +1,128 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// codeHashPinnable returns true if the CCMC of the router is proved by the evm account proof,
+// which carries the code hash of the contract.
+func codeHashPinnable(router uint64) bool {
+	switch router {
+	case utils.ETH_ROUTER, utils.BSC_ROUTER, utils.HECO_ROUTER, utils.MSC_ROUTER, utils.POLYGON_BOR_ROUTER:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetCodeHashPin validators pin the code hash of the CCMC of the side chain, it takes effect after
+// the consensus signs reached quorum. the code hash in the account proof of each import is checked
+// against the pinned one, and the chain is paused by blacking it on mismatch of a proof newer than
+// the latest synced height when the pin took effect, e.g. the proxy of the CCMC is upgraded on the
+// source chain, until the validators review the upgrade, pin the new code hash and white the
+// chain. the older proofs mismatched are rejected only. the zero code hash disables the pinning.
+func SetCodeHashPin(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetCodeHashPinParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetCodeHashPin, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChain(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetCodeHashPin, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SetCodeHashPin, side chain %d is not registered", params.ChainID)
+	}
+	if !codeHashPinnable(sideChain.Router) {
+		return nil, fmt.Errorf("SetCodeHashPin, code hash of router %d is not proved", sideChain.Router)
+	}
+
+	pin, err := scom.GetCodeHashPin(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetCodeHashPin, GetCodeHashPin error: %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(pin.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSetCodeHashPin, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetCodeHashPin, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetCodeHashPin, true)
+	}
+
+	status, err := hscommon.GetSyncStatus(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetCodeHashPin, GetSyncStatus error: %v", err)
+	}
+	var height uint64
+	if status != nil {
+		height = status.Height
+	}
+	scom.PutCodeHashPin(native, params.ChainID, &scom.CodeHashPin{
+		CodeHash: params.CodeHash,
+		Nonce:    pin.Nonce + 1,
+		Height:   height,
+	})
+	if err := native.AddNotify(scom.ABI, []string{scom.NOTIFY_CODE_HASH_PIN_EVENT}, params.ChainID, params.CodeHash); err != nil {
+		return nil, fmt.Errorf("SetCodeHashPin, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetCodeHashPin, true)
+}
+
+func CodeHashPin(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BlackChainParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodCodeHashPin, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	pin, err := scom.GetCodeHashPin(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("CodeHashPin, GetCodeHashPin error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodCodeHashPin, pin.CodeHash)
+}
+
+// pauseOnCodeHashMismatch blacks the source chain whose CCMC code hash mismatches the pinned one,
+// the import succeeds without executing the message so that the pause is persisted, and the
+// message is able to be imported again once the chain is reviewed and whited. the proof of block
+// not newer than the pin proves the code before the pin, which is rejected without the pause, so
+// that a stale proof is not able to pause the chain.
+func pauseOnCodeHashMismatch(native *native.NativeContract, height uint64, mismatch *scom.CodeHashMismatchError) ([]byte, error) {
+	if height <= mismatch.PinHeight {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "ImportExTransfer, proof at height %d is not newer than the pin at %d: %v",
+			height, mismatch.PinHeight, mismatch)
+	}
+	PutBlackChain(native, mismatch.ChainID)
+	utils.NewLogger(utils.LogModuleCrossChain, "chainID", mismatch.ChainID, "txHash", native.ContractRef().TxHash()).
+		Warn("Chain paused on CCMC code hash mismatch", "expected", mismatch.Expected, "actual", mismatch.Actual)
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_CODE_HASH_MISMATCHED}, mismatch.ChainID, mismatch.Expected, mismatch.Actual); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, false)
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	ecom "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
 )
 
@@ -31,6 +32,38 @@ var (
 	MethodMultiSign           = cross_chain_manager_abi.MethodMultiSign
 	MethodBlackChain          = cross_chain_manager_abi.MethodBlackChain
 	MethodWhiteChain          = cross_chain_manager_abi.MethodWhiteChain
+	MethodSetCheckpointConfig = cross_chain_manager_abi.MethodSetCheckpointConfig
+	MethodSubmitCheckpoint    = cross_chain_manager_abi.MethodSubmitCheckpoint
+	MethodCheckpointConfig    = cross_chain_manager_abi.MethodCheckpointConfig
+	MethodCheckpoint          = cross_chain_manager_abi.MethodCheckpoint
+	MethodConfirmDelivery     = cross_chain_manager_abi.MethodConfirmDelivery
+	MethodSetSourceAllowlist  = cross_chain_manager_abi.MethodSetSourceAllowlist
+	MethodSourceAllowlist     = cross_chain_manager_abi.MethodSourceAllowlist
+
+	MethodSetEntranceWhitelist = cross_chain_manager_abi.MethodSetEntranceWhitelist
+	MethodEntranceWhitelist    = cross_chain_manager_abi.MethodEntranceWhitelist
+
+	MethodSetOutboundCallback = cross_chain_manager_abi.MethodSetOutboundCallback
+	MethodOutboundCallback    = cross_chain_manager_abi.MethodOutboundCallback
+	MethodRefundOutbound      = cross_chain_manager_abi.MethodRefundOutbound
+	MethodOutboundState       = cross_chain_manager_abi.MethodOutboundState
+	MethodRefundBatch         = cross_chain_manager_abi.MethodRefundBatch
+	MethodBatch               = cross_chain_manager_abi.MethodBatch
+
+	MethodSetDualVerification = cross_chain_manager_abi.MethodSetDualVerification
+	MethodDualVerification    = cross_chain_manager_abi.MethodDualVerification
+	MethodAttest              = cross_chain_manager_abi.MethodAttest
+	MethodAttestations        = cross_chain_manager_abi.MethodAttestations
+
+	MethodSetProofMaxAge    = cross_chain_manager_abi.MethodSetProofMaxAge
+	MethodProofMaxAge       = cross_chain_manager_abi.MethodProofMaxAge
+	MethodApproveStaleProof = cross_chain_manager_abi.MethodApproveStaleProof
+
+	MethodSetCodeHashPin = cross_chain_manager_abi.MethodSetCodeHashPin
+	MethodCodeHashPin    = cross_chain_manager_abi.MethodCodeHashPin
+
+	MethodImportRoot  = cross_chain_manager_abi.MethodImportRoot
+	MethodImportProof = cross_chain_manager_abi.MethodImportProof
 )
 
 var ABI *abi.ABI
@@ -50,3 +83,82 @@ func GetABI() *abi.ABI {
 type BlackChainParam struct {
 	ChainID uint64
 }
+
+type SetCheckpointConfigParam struct {
+	Interval       uint64
+	AnchorChainID  uint64
+	AnchorContract []byte
+}
+
+type SetSourceAllowlistParam struct {
+	ChainID   uint64
+	Contracts [][]byte
+}
+
+type SetEntranceWhitelistParam struct {
+	ChainID uint64
+	Enabled bool
+	Callers []ecom.Address
+}
+
+type SetOutboundCallbackParam struct {
+	Callback ecom.Address
+	GasLimit uint64
+}
+
+type OutboundCallbackParam struct {
+	Sender ecom.Address
+}
+
+// OutboundParam is shared by `refundOutbound` and `outboundState`.
+type OutboundParam struct {
+	ToChainID uint64
+	Sequence  uint64
+}
+
+// BatchParam is shared by `refundBatch` and `batch`.
+type BatchParam struct {
+	BatchID ecom.Hash
+}
+
+type SetDualVerificationParam struct {
+	ChainID   uint64
+	Attestors []ecom.Address
+	Threshold uint64
+}
+
+// AttestParam is shared by `attest` and `attestations`.
+type AttestParam struct {
+	ChainID     uint64
+	MessageHash ecom.Hash
+}
+
+type SetProofMaxAgeParam struct {
+	ChainID uint64
+	MaxAge  uint64
+}
+
+type ApproveStaleProofParam struct {
+	ChainID      uint64
+	CrossChainID []byte
+}
+
+type SetCodeHashPinParam struct {
+	ChainID  uint64
+	CodeHash ecom.Hash
+}
+
+type ImportRootParam struct {
+	Height uint64
+}
+
+type ImportProofParam struct {
+	Height uint64
+	Index  uint64
+}
+
+type SubmitCheckpointParam struct {
+	Height    uint64
+	BlockHash []byte
+	StateRoot []byte
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ecom "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrorCode categorizes the failures of cross chain message importing, so that relayers are
+// able to retry the imports later or drop them. the values are part of the relayer interface
+// and must never be changed.
+type ErrorCode uint64
+
+const (
+	ErrCodeUnknown            ErrorCode = 0  // not categorized
+	ErrCodeInvalidParam       ErrorCode = 1  // malformed input of `importOuterTransfer`
+	ErrCodeChainNotRegistered ErrorCode = 2  // source or target chain is not registered
+	ErrCodeChainBlacked       ErrorCode = 3  // source or target chain is blacked
+	ErrCodeUnsupportedChain   ErrorCode = 4  // router of the chain is not supported
+	ErrCodeHeaderNotSynced    ErrorCode = 5  // header of the proof is not synced or confirmed yet
+	ErrCodeInvalidProof       ErrorCode = 6  // proof or cross chain message is invalid
+	ErrCodeTxAlreadyDone      ErrorCode = 7  // cross chain message has been imported
+	ErrCodeSourceNotAllowed   ErrorCode = 8  // source contract is not in the allowlist of source chain
+	ErrCodeTrustExpired       ErrorCode = 9  // trusted header of source chain expired, rebootstrap required
+	ErrCodeNotAttested        ErrorCode = 10 // attestations of the message not enough in dual verification
+	ErrCodeProofExpired       ErrorCode = 11 // proof is older than the max age of source chain, approval required
+	ErrCodeWrongRelayChain    ErrorCode = 12 // message is not bound to the chain id of zion
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	ErrCodeUnknown:            "unknown",
+	ErrCodeInvalidParam:       "invalid param",
+	ErrCodeChainNotRegistered: "chain not registered",
+	ErrCodeChainBlacked:       "chain blacked",
+	ErrCodeUnsupportedChain:   "unsupported chain",
+	ErrCodeHeaderNotSynced:    "header not synced",
+	ErrCodeInvalidProof:       "invalid proof",
+	ErrCodeTxAlreadyDone:      "tx already done",
+	ErrCodeSourceNotAllowed:   "source not allowed",
+	ErrCodeTrustExpired:       "trust expired",
+	ErrCodeNotAttested:        "not attested",
+	ErrCodeProofExpired:       "proof expired",
+	ErrCodeWrongRelayChain:    "wrong relay chain",
+}
+
+func (c ErrorCode) String() string {
+	if name, ok := errorCodeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("code %d", uint64(c))
+}
+
+// Retryable returns true if the import might succeed later without any change of input.
+func (c ErrorCode) Retryable() bool {
+	return c == ErrCodeHeaderNotSynced || c == ErrCodeNotAttested
+}
+
+// ImportError is the typed error of cross chain message importing.
+type ImportError struct {
+	Code ErrorCode
+	Err  error
+}
+
+// NewImportError creates an import error of the code with formatted message.
+func NewImportError(code ErrorCode, format string, args ...interface{}) *ImportError {
+	return &ImportError{Code: code, Err: fmt.Errorf(format, args...)}
+}
+
+func (e *ImportError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorData implements the rpc.DataError interface.
+func (e *ImportError) ErrorData() interface{} {
+	return map[string]interface{}{
+		"code":      uint64(e.Code),
+		"category":  e.Code.String(),
+		"retryable": e.Code.Retryable(),
+	}
+}
+
+// revertSelector is the selector of solidity `Error(string)`.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// RevertData implements the native.RevertError interface, the error is encoded as solidity
+// `Error(string)` with reason in format of `[code] message`.
+func (e *ImportError) RevertData() []byte {
+	typ, _ := abi.NewType("string", "", nil)
+	enc, err := (abi.Arguments{{Type: typ}}).Pack(fmt.Sprintf("[%d] %s", uint64(e.Code), e.Error()))
+	if err != nil {
+		return nil
+	}
+	return append(append([]byte{}, revertSelector...), enc...)
+}
+
+// CodeHashMismatchError is reported if the code hash of the CCMC proved mismatches the pinned
+// one, e.g. the proxy of the CCMC is upgraded on the source chain. the pin height is the height
+// of the source chain the pin took effect at.
+type CodeHashMismatchError struct {
+	ChainID   uint64
+	Expected  ecom.Hash
+	Actual    ecom.Hash
+	PinHeight uint64
+}
+
+func (e *CodeHashMismatchError) Error() string {
+	return fmt.Sprintf("code hash %s of CCMC of chain %d mismatches the pinned %s", e.Actual.Hex(), e.ChainID, e.Expected.Hex())
+}
+
+// ErrorCodeOf returns the code of the first import error in the error chain.
+func ErrorCodeOf(err error) ErrorCode {
+	var e *ImportError
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return ErrCodeUnknown
+}
+
+// AsImportError wraps the error as an import error with the code found in the error chain,
+// the message is kept as it is.
+func AsImportError(err error) *ImportError {
+	if e, ok := err.(*ImportError); ok {
+		return e
+	}
+	return &ImportError{Code: ErrorCodeOf(err), Err: err}
+}
+
+// ParseRevertData decodes the import error from the revert data of `importOuterTransfer`.
+func ParseRevertData(data []byte) (*ImportError, error) {
+	reason, err := abi.UnpackRevert(data)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		code uint64
+		msg  string
+	)
+	n, _ := fmt.Sscanf(reason, "[%d]", &code)
+	if n != 1 {
+		return nil, fmt.Errorf("invalid revert reason: %s", reason)
+	}
+	if idx := len(fmt.Sprintf("[%d] ", code)); idx <= len(reason) {
+		msg = reason[idx:]
+	}
+	return &ImportError{Code: ErrorCode(code), Err: errors.New(msg)}, nil
+}
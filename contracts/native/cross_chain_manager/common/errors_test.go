@@ -0,0 +1,45 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportError(t *testing.T) {
+	err := fmt.Errorf("MakeDepositProposal, verify error: %w", NewImportError(ErrCodeHeaderNotSynced, "header %d not synced", 100))
+	assert.Equal(t, ErrCodeHeaderNotSynced, ErrorCodeOf(err))
+	assert.Equal(t, ErrCodeUnknown, ErrorCodeOf(errors.New("plain error")))
+
+	ie := AsImportError(err)
+	assert.Equal(t, ErrCodeHeaderNotSynced, ie.Code)
+	assert.True(t, ie.Code.Retryable())
+	assert.Equal(t, err.Error(), ie.Error())
+
+	decoded, perr := ParseRevertData(ie.RevertData())
+	assert.NoError(t, perr)
+	assert.Equal(t, ErrCodeHeaderNotSynced, decoded.Code)
+	assert.Equal(t, err.Error(), decoded.Error())
+
+	_, perr = ParseRevertData([]byte{0x01, 0x02})
+	assert.Error(t, perr)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	ecom "github.com/ethereum/go-ethereum/common"
+)
+
+// the strict hex parsing of relayer payloads, e.g. the proofs in response of `eth_getProof`. the
+// `0x` prefix is optional, and any malformed input is rejected with an explicit error rather than
+// decoded partially, so that a malformed proof never turns into empty trie nodes.
+
+// DecodeHex decodes the hex string, the string must be of even length.
+func DecodeHex(s string) ([]byte, error) {
+	raw := Replace0x(s)
+	if len(raw)%2 != 0 {
+		return nil, fmt.Errorf("odd length hex string %q", s)
+	}
+	if err := checkHexChars(s, raw); err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(raw)/2)
+	for i := range out {
+		out[i] = hexNibble(raw[2*i])<<4 | hexNibble(raw[2*i+1])
+	}
+	return out, nil
+}
+
+// DecodeHexHash decodes the hex string of at most 32 bytes into a hash, the shorter one is left
+// padded as `HexToHash` does.
+func DecodeHexHash(s string) (ecom.Hash, error) {
+	b, err := DecodeHex(s)
+	if err != nil {
+		return ecom.Hash{}, err
+	}
+	if len(b) > ecom.HashLength {
+		return ecom.Hash{}, fmt.Errorf("hex string %q exceeds %d bytes", s, ecom.HashLength)
+	}
+	return ecom.BytesToHash(b), nil
+}
+
+// DecodeHexBig decodes the hex quantity, which might be of odd length, e.g. `0x0`.
+func DecodeHexBig(s string) (*big.Int, error) {
+	raw := Replace0x(s)
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("empty hex quantity %q", s)
+	}
+	if err := checkHexChars(s, raw); err != nil {
+		return nil, err
+	}
+	v, _ := new(big.Int).SetString(raw, 16)
+	return v, nil
+}
+
+func checkHexChars(s, raw string) error {
+	for i := 0; i < len(raw); i++ {
+		if hexNibble(raw[i]) == 0xff {
+			return fmt.Errorf("invalid hex character %q in %q", raw[i], s)
+		}
+	}
+	return nil
+}
+
+func hexNibble(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10
+	}
+	return 0xff
+}
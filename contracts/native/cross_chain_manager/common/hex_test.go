@@ -0,0 +1,65 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	ecom "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeHex(t *testing.T) {
+	for _, s := range []string{"", "0x", "0X"} {
+		b, err := DecodeHex(s)
+		assert.NoError(t, err)
+		assert.Empty(t, b)
+	}
+	b, err := DecodeHex("0xdeADbeef")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, b)
+	b, err = DecodeHex("0102")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, b)
+
+	for _, s := range []string{"0x1", "abc", "0x0g", "0x 1", "00x1", "0x0x01"} {
+		_, err := DecodeHex(s)
+		assert.Error(t, err, s)
+	}
+
+	h, err := DecodeHexHash("0x0102")
+	assert.NoError(t, err)
+	assert.Equal(t, ecom.HexToHash("0x0102"), h)
+	_, err = DecodeHexHash("0x" + ecom.Bytes2Hex(make([]byte, 33)))
+	assert.Error(t, err)
+
+	v, err := DecodeHexBig("0x0")
+	assert.NoError(t, err)
+	assert.Zero(t, v.Sign())
+	v, err = DecodeHexBig("0xfff")
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(0xfff), v)
+	for _, s := range []string{"", "0x", "-1", "0x+1", "0xg"} {
+		_, err := DecodeHexBig(s)
+		assert.Error(t, err, s)
+	}
+
+	assert.Equal(t, "ab0x", Replace0x("0Xab0x"))
+	assert.Equal(t, "ab0x", Replace0x("ab0x"))
+}
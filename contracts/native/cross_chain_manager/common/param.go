@@ -18,9 +18,14 @@
 package common
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 
+	ecom "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
 	polycomm "github.com/polynetwork/poly/common"
 )
 
@@ -30,8 +35,53 @@ const (
 	KEY_PREFIX_BTC_VOTE = "btcVote"
 	REQUEST             = "request"
 	DONE_TX             = "doneTx"
+	OUTBOUND            = "outbound"
+	OUTBOUND_COUNT      = "outboundCount"
+	OUTBOUND_INDEX      = "outboundIndex"
+	DELIVERED           = "delivered"
+	DELIVERY_FAILED     = "deliveryFailed"
+	REFUNDED            = "refunded"
+	OUTBOUND_CALLBACK   = "outboundCallback"
+	BATCH               = "batch"
+	BATCH_INDEX         = "batchIndex"
+	DUAL_VERIFICATION   = "dualVerification"
+	ATTESTATION         = "attestation"
+	PROOF_MAX_AGE       = "proofMaxAge"
+	STALE_PROOF         = "staleProof"
+	STORAGE_ROOT        = "storageRoot"
+	STORAGE_ROOT_SLOT   = "storageRootSlot"
+	STORAGE_ROOT_COUNT  = "storageRootCount"
+	CODE_HASH_PIN       = "codeHashPin"
+	IMPORT_RECEIPTS     = "importReceipts"
+	IMPORT_LEAF         = "importLeaf"
 
 	NOTIFY_MAKE_PROOF_EVENT = "makeProof"
+	NOTIFY_CHECKPOINT_EVENT = "checkpointMade"
+	NOTIFY_DELIVERY_EVENT   = "deliveryConfirmed"
+	NOTIFY_WHITELIST_EVENT  = "entranceWhitelistChanged"
+
+	NOTIFY_DELIVERY_FAILED_EVENT  = "deliveryFailed"
+	NOTIFY_REFUNDED_EVENT         = "outboundRefunded"
+	NOTIFY_CALLBACK_CHANGED_EVENT = "outboundCallbackChanged"
+	NOTIFY_CALLBACK_INVOKED_EVENT = "outboundCallbackInvoked"
+	NOTIFY_BATCH_EVENT            = "batchStateChanged"
+	NOTIFY_BATCH_CALLBACK_EVENT   = "batchCallbackInvoked"
+	NOTIFY_SHADOW_IMPORT_EVENT    = "importShadowed"
+	NOTIFY_DUAL_VERIFICATION      = "dualVerificationChanged"
+	NOTIFY_ATTESTED_EVENT         = "messageAttested"
+	NOTIFY_PROOF_MAX_AGE_EVENT    = "proofMaxAgeChanged"
+	NOTIFY_STALE_PROOF_EVENT      = "staleProofApproved"
+	NOTIFY_CODE_HASH_PIN_EVENT    = "codeHashPinChanged"
+	NOTIFY_CODE_HASH_MISMATCHED   = "codeHashMismatched"
+	NOTIFY_MESSAGE_IMPORTED       = "messageImported"
+
+	// MaxImportPayloadSize bounds the input of `importOuterTransfer`, the proofs of all the
+	// supported chains are far smaller.
+	MaxImportPayloadSize = 1 << 20
+
+	// StorageRootRetention is the number of the latest verified storage roots cached per source
+	// chain, the older ones are dropped as the new ones written.
+	StorageRootRetention = 64
 )
 
 type ChainHandler interface {
@@ -109,6 +159,23 @@ func (this *EntranceParam) Deserialization(source *polycomm.ZeroCopySource) erro
 	return nil
 }
 
+// UnpackEntranceParam decodes the input of `importOuterTransfer`, the oversized input is
+// rejected before decoding.
+func UnpackEntranceParam(payload []byte) (*EntranceParam, error) {
+	if len(payload) > MaxImportPayloadSize {
+		return nil, NewImportError(ErrCodeInvalidParam, "UnpackEntranceParam, payload size %d exceeds limit %d", len(payload), MaxImportPayloadSize)
+	}
+	params := &EntranceParam{}
+	if err := utils.UnpackMethod(ABI, MethodImportOuterTransfer, params, payload); err != nil {
+		return nil, NewImportError(ErrCodeInvalidParam, "UnpackEntranceParam, unpack params error: %v", err)
+	}
+	return params, nil
+}
+
+// MakeTxParam is the cross chain message emitted by the CCMC of source chain. the v2 message
+// appends the chain id of the relay chain it's sent to, so that the message is not able to be
+// replayed into another relay chain served by the same CCMC. the v1 message has no relay chain
+// id, which is 0.
 type MakeTxParam struct {
 	TxHash              []byte
 	CrossChainID        []byte
@@ -117,6 +184,8 @@ type MakeTxParam struct {
 	ToContractAddress   []byte
 	Method              string
 	Args                []byte
+	RelayChainID        uint64
+	GasLimit            uint64 // gas limit of the execution on the target chain, zero means no limit
 }
 
 func (this *MakeTxParam) Serialization(sink *polycomm.ZeroCopySink) {
@@ -127,6 +196,12 @@ func (this *MakeTxParam) Serialization(sink *polycomm.ZeroCopySink) {
 	sink.WriteVarBytes(this.ToContractAddress)
 	sink.WriteVarBytes([]byte(this.Method))
 	sink.WriteVarBytes(this.Args)
+	if this.RelayChainID != 0 || this.GasLimit != 0 {
+		sink.WriteUint64(this.RelayChainID)
+	}
+	if this.GasLimit != 0 {
+		sink.WriteUint64(this.GasLimit)
+	}
 }
 
 func (this *MakeTxParam) Deserialization(source *polycomm.ZeroCopySource) error {
@@ -158,6 +233,17 @@ func (this *MakeTxParam) Deserialization(source *polycomm.ZeroCopySource) error
 	if eof {
 		return fmt.Errorf("MakeTxParam deserialize args error")
 	}
+	var relayChainID, gasLimit uint64
+	if source.Len() > 0 {
+		if relayChainID, eof = source.NextUint64(); eof {
+			return fmt.Errorf("MakeTxParam deserialize relayChainID error")
+		}
+	}
+	if source.Len() > 0 {
+		if gasLimit, eof = source.NextUint64(); eof {
+			return fmt.Errorf("MakeTxParam deserialize gasLimit error")
+		}
+	}
 
 	this.TxHash = txHash
 	this.CrossChainID = crossChainID
@@ -166,6 +252,8 @@ func (this *MakeTxParam) Deserialization(source *polycomm.ZeroCopySource) error
 	this.ToContractAddress = toContractAddress
 	this.Method = method
 	this.Args = args
+	this.RelayChainID = relayChainID
+	this.GasLimit = gasLimit
 	return nil
 }
 
@@ -259,3 +347,624 @@ func (this *MultiSignParam) Deserialization(source *polycomm.ZeroCopySource) err
 	this.Signs = signs
 	return nil
 }
+
+type CheckpointConfig struct {
+	Interval       uint64
+	AnchorChainID  uint64
+	AnchorContract []byte
+	Nonce          uint64
+}
+
+func (this *CheckpointConfig) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.Interval)
+	sink.WriteUint64(this.AnchorChainID)
+	sink.WriteVarBytes(this.AnchorContract)
+	sink.WriteUint64(this.Nonce)
+}
+
+func (this *CheckpointConfig) Deserialization(source *polycomm.ZeroCopySource) error {
+	interval, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("CheckpointConfig deserialize interval error")
+	}
+	anchorChainID, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("CheckpointConfig deserialize anchorChainID error")
+	}
+	anchorContract, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("CheckpointConfig deserialize anchorContract error")
+	}
+	nonce, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("CheckpointConfig deserialize nonce error")
+	}
+
+	this.Interval = interval
+	this.AnchorChainID = anchorChainID
+	this.AnchorContract = anchorContract
+	this.Nonce = nonce
+	return nil
+}
+
+// Checkpoint is the zion block state anchored to the external chain, it's signed by the
+// validators of epoch `EpochHash`.
+type Checkpoint struct {
+	Height    uint64
+	BlockHash []byte
+	StateRoot []byte
+	EpochHash []byte
+}
+
+func (this *Checkpoint) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.Height)
+	sink.WriteVarBytes(this.BlockHash)
+	sink.WriteVarBytes(this.StateRoot)
+	sink.WriteVarBytes(this.EpochHash)
+}
+
+func (this *Checkpoint) Deserialization(source *polycomm.ZeroCopySource) error {
+	height, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("Checkpoint deserialize height error")
+	}
+	blockHash, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("Checkpoint deserialize blockHash error")
+	}
+	stateRoot, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("Checkpoint deserialize stateRoot error")
+	}
+	epochHash, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("Checkpoint deserialize epochHash error")
+	}
+
+	this.Height = height
+	this.BlockHash = blockHash
+	this.StateRoot = stateRoot
+	this.EpochHash = epochHash
+	return nil
+}
+
+// OutboundMessage is an entry of the outbound queue of a target chain.
+type OutboundMessage struct {
+	Height      uint64 // zion block height at which the message is made
+	Key         string // hex storage key of the request, which is the key of the storage proof
+	MerkleValue []byte // serialized ToMerkleValue
+}
+
+func (this *OutboundMessage) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.Height)
+	sink.WriteString(this.Key)
+	sink.WriteVarBytes(this.MerkleValue)
+}
+
+func (this *OutboundMessage) Deserialization(source *polycomm.ZeroCopySource) error {
+	height, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("OutboundMessage deserialize height error")
+	}
+	key, eof := source.NextString()
+	if eof {
+		return fmt.Errorf("OutboundMessage deserialize key error")
+	}
+	merkleValue, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("OutboundMessage deserialize merkleValue error")
+	}
+
+	this.Height = height
+	this.Key = key
+	this.MerkleValue = merkleValue
+	return nil
+}
+
+// SourceAllowlist is the source contracts of a side chain allowed to make cross chain
+// messages, the messages from any contract are accepted if the list is empty.
+type SourceAllowlist struct {
+	Contracts [][]byte
+	Nonce     uint64
+}
+
+// Allows returns true if the messages from the source contract are acceptable.
+func (this *SourceAllowlist) Allows(contract []byte) bool {
+	if len(this.Contracts) == 0 {
+		return true
+	}
+	for _, c := range this.Contracts {
+		if bytes.Equal(c, contract) {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *SourceAllowlist) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteVarUint(uint64(len(this.Contracts)))
+	for _, c := range this.Contracts {
+		sink.WriteVarBytes(c)
+	}
+	sink.WriteUint64(this.Nonce)
+}
+
+func (this *SourceAllowlist) Deserialization(source *polycomm.ZeroCopySource) error {
+	n, eof := source.NextVarUint()
+	if eof {
+		return fmt.Errorf("SourceAllowlist deserialize length error")
+	}
+	contracts := make([][]byte, 0, n)
+	for i := uint64(0); i < n; i++ {
+		c, eof := source.NextVarBytes()
+		if eof {
+			return fmt.Errorf("SourceAllowlist deserialize contract error")
+		}
+		contracts = append(contracts, c)
+	}
+	nonce, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("SourceAllowlist deserialize nonce error")
+	}
+
+	this.Contracts = contracts
+	this.Nonce = nonce
+	return nil
+}
+
+// EntranceWhitelist is the callers allowed to relay the headers and cross chain messages of a
+// side chain in the permissioned mode, anyone is allowed if the mode is disabled.
+type EntranceWhitelist struct {
+	Enabled bool
+	Callers []ecom.Address
+	Nonce   uint64
+}
+
+// Allows returns true if the caller is able to call the entrance of the side chain.
+func (this *EntranceWhitelist) Allows(caller ecom.Address) bool {
+	if !this.Enabled {
+		return true
+	}
+	for _, c := range this.Callers {
+		if c == caller {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *EntranceWhitelist) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteBool(this.Enabled)
+	sink.WriteVarUint(uint64(len(this.Callers)))
+	for _, c := range this.Callers {
+		sink.WriteAddress(polycomm.Address(c))
+	}
+	sink.WriteUint64(this.Nonce)
+}
+
+func (this *EntranceWhitelist) Deserialization(source *polycomm.ZeroCopySource) error {
+	enabled, eof := source.NextBool()
+	if eof {
+		return fmt.Errorf("EntranceWhitelist deserialize enabled error")
+	}
+	n, eof := source.NextVarUint()
+	if eof {
+		return fmt.Errorf("EntranceWhitelist deserialize length error")
+	}
+	callers := make([]ecom.Address, 0, n)
+	for i := uint64(0); i < n; i++ {
+		c, eof := source.NextAddress()
+		if eof {
+			return fmt.Errorf("EntranceWhitelist deserialize caller error")
+		}
+		callers = append(callers, ecom.Address(c))
+	}
+	nonce, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("EntranceWhitelist deserialize nonce error")
+	}
+
+	this.Enabled = enabled
+	this.Callers = callers
+	this.Nonce = nonce
+	return nil
+}
+
+// OutboundCallback is the evm contract notified of the state transitions of the outbound messages
+// sent by a contract, it's called with at most `GasLimit` gas.
+type OutboundCallback struct {
+	Callback ecom.Address
+	GasLimit uint64
+}
+
+func (this *OutboundCallback) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteAddress(polycomm.Address(this.Callback))
+	sink.WriteUint64(this.GasLimit)
+}
+
+func (this *OutboundCallback) Deserialization(source *polycomm.ZeroCopySource) error {
+	callback, eof := source.NextAddress()
+	if eof {
+		return fmt.Errorf("OutboundCallback deserialize callback error")
+	}
+	gasLimit, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("OutboundCallback deserialize gas limit error")
+	}
+
+	this.Callback = ecom.Address(callback)
+	this.GasLimit = gasLimit
+	return nil
+}
+
+// BatchMember is an outbound message of the batch.
+type BatchMember struct {
+	ToChainID uint64
+	Sequence  uint64
+}
+
+// Batch is a parent request of the outbound messages sent together by a contract, it's delivered
+// once all of the messages are delivered, and fails as soon as any of them fails.
+type Batch struct {
+	Sender    ecom.Address
+	Members   []BatchMember
+	Delivered uint64 // number of the messages delivered
+	State     uint8
+}
+
+func (this *Batch) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteAddress(polycomm.Address(this.Sender))
+	sink.WriteVarUint(uint64(len(this.Members)))
+	for _, m := range this.Members {
+		sink.WriteUint64(m.ToChainID)
+		sink.WriteUint64(m.Sequence)
+	}
+	sink.WriteUint64(this.Delivered)
+	sink.WriteUint8(this.State)
+}
+
+func (this *Batch) Deserialization(source *polycomm.ZeroCopySource) error {
+	sender, eof := source.NextAddress()
+	if eof {
+		return fmt.Errorf("Batch deserialize sender error")
+	}
+	n, eof := source.NextVarUint()
+	if eof {
+		return fmt.Errorf("Batch deserialize length error")
+	}
+	members := make([]BatchMember, 0, n)
+	for i := uint64(0); i < n; i++ {
+		chainID, eof := source.NextUint64()
+		if eof {
+			return fmt.Errorf("Batch deserialize chain id error")
+		}
+		seq, eof := source.NextUint64()
+		if eof {
+			return fmt.Errorf("Batch deserialize sequence error")
+		}
+		members = append(members, BatchMember{ToChainID: chainID, Sequence: seq})
+	}
+	delivered, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("Batch deserialize delivered error")
+	}
+	state, eof := source.NextUint8()
+	if eof {
+		return fmt.Errorf("Batch deserialize state error")
+	}
+
+	this.Sender = ecom.Address(sender)
+	this.Members = members
+	this.Delivered = delivered
+	this.State = state
+	return nil
+}
+
+// DualVerification requires the messages of a side chain to be attested by the threshold of
+// attestors besides the proof verification, it's enabled during the probation of a new chain or
+// handler, and disabled with an empty attestor list.
+type DualVerification struct {
+	Attestors []ecom.Address
+	Threshold uint64
+	Nonce     uint64
+}
+
+// Enabled returns true if the attestations are required.
+func (this *DualVerification) Enabled() bool {
+	return len(this.Attestors) > 0
+}
+
+// IsAttestor returns true if the address is one of the attestors.
+func (this *DualVerification) IsAttestor(addr ecom.Address) bool {
+	for _, a := range this.Attestors {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Attested returns true if the attestations from the current attestors reach the threshold.
+func (this *DualVerification) Attested(attestors []ecom.Address) bool {
+	count := uint64(0)
+	for _, a := range attestors {
+		if this.IsAttestor(a) {
+			count++
+		}
+	}
+	return count >= this.Threshold
+}
+
+func (this *DualVerification) Serialization(sink *polycomm.ZeroCopySink) {
+	writeAddresses(sink, this.Attestors)
+	sink.WriteUint64(this.Threshold)
+	sink.WriteUint64(this.Nonce)
+}
+
+func (this *DualVerification) Deserialization(source *polycomm.ZeroCopySource) error {
+	attestors, err := readAddresses(source)
+	if err != nil {
+		return fmt.Errorf("DualVerification deserialize attestors error: %v", err)
+	}
+	threshold, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("DualVerification deserialize threshold error")
+	}
+	nonce, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("DualVerification deserialize nonce error")
+	}
+
+	this.Attestors = attestors
+	this.Threshold = threshold
+	this.Nonce = nonce
+	return nil
+}
+
+// Attestation is the attestors which have attested a message of side chain.
+type Attestation struct {
+	Attestors []ecom.Address
+}
+
+func (this *Attestation) Serialization(sink *polycomm.ZeroCopySink) {
+	writeAddresses(sink, this.Attestors)
+}
+
+func (this *Attestation) Deserialization(source *polycomm.ZeroCopySource) error {
+	attestors, err := readAddresses(source)
+	if err != nil {
+		return fmt.Errorf("Attestation deserialize attestors error: %v", err)
+	}
+	this.Attestors = attestors
+	return nil
+}
+
+func writeAddresses(sink *polycomm.ZeroCopySink, addrs []ecom.Address) {
+	sink.WriteVarUint(uint64(len(addrs)))
+	for _, addr := range addrs {
+		sink.WriteAddress(polycomm.Address(addr))
+	}
+}
+
+func readAddresses(source *polycomm.ZeroCopySource) ([]ecom.Address, error) {
+	n, eof := source.NextVarUint()
+	if eof {
+		return nil, fmt.Errorf("length eof")
+	}
+	addrs := make([]ecom.Address, 0, n)
+	for i := uint64(0); i < n; i++ {
+		addr, eof := source.NextAddress()
+		if eof {
+			return nil, fmt.Errorf("address eof")
+		}
+		addrs = append(addrs, ecom.Address(addr))
+	}
+	return addrs, nil
+}
+
+// ProofMaxAge bounds the age of the proofs imported from a side chain, in the source blocks
+// behind the latest synced header. zero max age disables the check.
+type ProofMaxAge struct {
+	MaxAge uint64
+	Nonce  uint64
+}
+
+// Expired returns true if the proof at the height is older than the max age.
+func (this *ProofMaxAge) Expired(height, synced uint64) bool {
+	return this.MaxAge > 0 && synced > height && synced-height > this.MaxAge
+}
+
+func (this *ProofMaxAge) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.MaxAge)
+	sink.WriteUint64(this.Nonce)
+}
+
+func (this *ProofMaxAge) Deserialization(source *polycomm.ZeroCopySource) error {
+	maxAge, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("ProofMaxAge deserialize max age error")
+	}
+	nonce, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("ProofMaxAge deserialize nonce error")
+	}
+
+	this.MaxAge = maxAge
+	this.Nonce = nonce
+	return nil
+}
+
+// CodeHashPin is the expected code hash of the CCMC of a side chain, the zero hash disables the
+// pinning. the height is the latest synced height of the side chain when the pin took effect.
+type CodeHashPin struct {
+	CodeHash ecom.Hash
+	Nonce    uint64
+	Height   uint64
+}
+
+// Pinned returns true if the code hash is pinned.
+func (this *CodeHashPin) Pinned() bool {
+	return this.CodeHash != ecom.Hash{}
+}
+
+func (this *CodeHashPin) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteHash(polycomm.Uint256(this.CodeHash))
+	sink.WriteUint64(this.Nonce)
+	sink.WriteUint64(this.Height)
+}
+
+func (this *CodeHashPin) Deserialization(source *polycomm.ZeroCopySource) error {
+	codeHash, eof := source.NextHash()
+	if eof {
+		return fmt.Errorf("CodeHashPin deserialize code hash error")
+	}
+	nonce, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("CodeHashPin deserialize nonce error")
+	}
+	height, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("CodeHashPin deserialize height error")
+	}
+
+	this.CodeHash = ecom.Hash(codeHash)
+	this.Nonce = nonce
+	this.Height = height
+	return nil
+}
+
+// ImportReceipts is the messages imported in a block, which are committed by a single merkle root,
+// so that a destination chain verifies that a message is processed by zion with one proof of the
+// block instead of one per message. the tree hashes the sorted pairs of nodes, and the last node of
+// an odd level is promoted as is, which is compatible with `MerkleProof.verify` of openzeppelin.
+// the leaves are stored one per key, and only the frontier of the tree is kept here, so that the
+// root is updated in logarithmic time at each import.
+type ImportReceipts struct {
+	Count    uint64
+	Frontier []ecom.Hash // root of the complete subtree of 2^i leaves at i if the bit i of count is set
+	Root     ecom.Hash
+}
+
+// ImportReceiptLeaf returns the leaf of an imported message, which is
+// `keccak256(abi.encodePacked(uint64 fromChainID, bytes32 messageHash))`.
+func ImportReceiptLeaf(fromChainID uint64, messageHash ecom.Hash) ecom.Hash {
+	var chainID [8]byte
+	binary.BigEndian.PutUint64(chainID[:], fromChainID)
+	return crypto.Keccak256Hash(chainID[:], messageHash[:])
+}
+
+// Append adds the leaf and updates the root, it returns the index of the leaf.
+func (this *ImportReceipts) Append(leaf ecom.Hash) uint64 {
+	index := this.Count
+	node, level := leaf, 0
+	for ; (this.Count>>uint(level))&1 == 1; level++ {
+		node = hashSortedPair(this.Frontier[level], node)
+		this.Frontier[level] = ecom.Hash{}
+	}
+	if level == len(this.Frontier) {
+		this.Frontier = append(this.Frontier, node)
+	} else {
+		this.Frontier[level] = node
+	}
+	this.Count++
+
+	// the promoted nodes are hashed with the complete subtrees from the smallest one
+	root, found := ecom.Hash{}, false
+	for i, node := range this.Frontier {
+		if (this.Count>>uint(i))&1 == 0 {
+			continue
+		}
+		if found {
+			root = hashSortedPair(node, root)
+		} else {
+			root, found = node, true
+		}
+	}
+	this.Root = root
+	return index
+}
+
+// ImportReceiptProof returns the sibling nodes from the leaf at the index up to the root of the
+// leaves.
+func ImportReceiptProof(leaves []ecom.Hash, index uint64) ([]ecom.Hash, error) {
+	if index >= uint64(len(leaves)) {
+		return nil, fmt.Errorf("leaf index %d out of range %d", index, len(leaves))
+	}
+	proof := make([]ecom.Hash, 0)
+	for _, level := range importReceiptLevels(leaves) {
+		if sibling := index ^ 1; sibling < uint64(len(level)) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+func importReceiptLevels(leaves []ecom.Hash) [][]ecom.Hash {
+	levels := [][]ecom.Hash{leaves}
+	for level := leaves; len(level) > 1; levels = append(levels, level) {
+		next := make([]ecom.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+			} else {
+				next = append(next, hashSortedPair(level[i], level[i+1]))
+			}
+		}
+		level = next
+	}
+	return levels
+}
+
+// VerifyImportProof returns true if the leaf is committed by the root with the proof.
+func VerifyImportProof(root, leaf ecom.Hash, proof []ecom.Hash) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashSortedPair(node, sibling)
+	}
+	return node == root
+}
+
+func hashSortedPair(a, b ecom.Hash) ecom.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
+
+func (this *ImportReceipts) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.Count)
+	sink.WriteVarUint(uint64(len(this.Frontier)))
+	for _, node := range this.Frontier {
+		sink.WriteHash(polycomm.Uint256(node))
+	}
+	sink.WriteHash(polycomm.Uint256(this.Root))
+}
+
+func (this *ImportReceipts) Deserialization(source *polycomm.ZeroCopySource) error {
+	count, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("ImportReceipts deserialize count error")
+	}
+	n, eof := source.NextVarUint()
+	if eof || n > 64 {
+		return fmt.Errorf("ImportReceipts deserialize frontier length error")
+	}
+	frontier := make([]ecom.Hash, 0, n)
+	for i := uint64(0); i < n; i++ {
+		node, eof := source.NextHash()
+		if eof {
+			return fmt.Errorf("ImportReceipts deserialize frontier error")
+		}
+		frontier = append(frontier, ecom.Hash(node))
+	}
+	root, eof := source.NextHash()
+	if eof {
+		return fmt.Errorf("ImportReceipts deserialize root error")
+	}
+
+	this.Count = count
+	this.Frontier = frontier
+	this.Root = ecom.Hash(root)
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"testing"
+
+	ecom "github.com/ethereum/go-ethereum/common"
+
+	polycomm "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceAllowlist(t *testing.T) {
+	empty := new(SourceAllowlist)
+	assert.True(t, empty.Allows([]byte{1}))
+	assert.True(t, empty.Allows(nil))
+
+	allowlist := &SourceAllowlist{Contracts: [][]byte{{1}, {2, 3}}, Nonce: 5}
+	assert.True(t, allowlist.Allows([]byte{2, 3}))
+	assert.False(t, allowlist.Allows([]byte{2}))
+	assert.False(t, allowlist.Allows(nil))
+
+	sink := polycomm.NewZeroCopySink(nil)
+	allowlist.Serialization(sink)
+	decoded := new(SourceAllowlist)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, allowlist, decoded)
+
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:3])))
+}
+
+func TestEntranceWhitelist(t *testing.T) {
+	relayer := ecom.HexToAddress("0x01")
+	disabled := &EntranceWhitelist{Callers: []ecom.Address{relayer}}
+	assert.True(t, disabled.Allows(ecom.HexToAddress("0x02")))
+
+	whitelist := &EntranceWhitelist{Enabled: true, Callers: []ecom.Address{relayer}, Nonce: 2}
+	assert.True(t, whitelist.Allows(relayer))
+	assert.False(t, whitelist.Allows(ecom.HexToAddress("0x02")))
+
+	sink := polycomm.NewZeroCopySink(nil)
+	whitelist.Serialization(sink)
+	decoded := new(EntranceWhitelist)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, whitelist, decoded)
+
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:10])))
+}
+
+func TestOutboundCallback(t *testing.T) {
+	callback := &OutboundCallback{Callback: ecom.HexToAddress("0x01"), GasLimit: 100000}
+	sink := polycomm.NewZeroCopySink(nil)
+	callback.Serialization(sink)
+	decoded := new(OutboundCallback)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, callback, decoded)
+
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:24])))
+}
+
+func TestDualVerification(t *testing.T) {
+	a, b, c := ecom.HexToAddress("0x01"), ecom.HexToAddress("0x02"), ecom.HexToAddress("0x03")
+	assert.False(t, new(DualVerification).Enabled())
+
+	dual := &DualVerification{Attestors: []ecom.Address{a, b}, Threshold: 2, Nonce: 3}
+	assert.True(t, dual.Enabled())
+	assert.True(t, dual.Attested([]ecom.Address{b, a}))
+	assert.False(t, dual.Attested([]ecom.Address{a}))
+	assert.False(t, dual.Attested([]ecom.Address{a, c}))
+
+	sink := polycomm.NewZeroCopySink(nil)
+	dual.Serialization(sink)
+	decoded := new(DualVerification)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, dual, decoded)
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:10])))
+
+	attestation := &Attestation{Attestors: []ecom.Address{c}}
+	sink = polycomm.NewZeroCopySink(nil)
+	attestation.Serialization(sink)
+	decodedAttestation := new(Attestation)
+	assert.NoError(t, decodedAttestation.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, attestation, decodedAttestation)
+}
+
+func TestProofMaxAge(t *testing.T) {
+	assert.False(t, new(ProofMaxAge).Expired(1, 1000))
+
+	maxAge := &ProofMaxAge{MaxAge: 100, Nonce: 1}
+	assert.False(t, maxAge.Expired(900, 1000))
+	assert.True(t, maxAge.Expired(899, 1000))
+	assert.False(t, maxAge.Expired(1100, 1000))
+
+	sink := polycomm.NewZeroCopySink(nil)
+	maxAge.Serialization(sink)
+	decoded := new(ProofMaxAge)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, maxAge, decoded)
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:8])))
+}
+
+func TestMakeTxParamRelayChainID(t *testing.T) {
+	v1 := &MakeTxParam{TxHash: []byte{1}, CrossChainID: []byte{2}, FromContractAddress: []byte{3}, ToChainID: 4,
+		ToContractAddress: []byte{5}, Method: "unlock", Args: []byte{6}}
+	sink := polycomm.NewZeroCopySink(nil)
+	v1.Serialization(sink)
+	raw := sink.Bytes()
+	decoded := new(MakeTxParam)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(raw)))
+	assert.Equal(t, v1, decoded)
+
+	v2 := *v1
+	v2.RelayChainID = 1001
+	sink = polycomm.NewZeroCopySink(nil)
+	v2.Serialization(sink)
+	assert.Equal(t, raw, sink.Bytes()[:len(raw)])
+	decoded = new(MakeTxParam)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, &v2, decoded)
+
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:len(raw)+3])))
+
+	// the gas limit follows the relay chain id, which is written even if it's not set
+	v3 := *v1
+	v3.GasLimit = 200000
+	sink = polycomm.NewZeroCopySink(nil)
+	v3.Serialization(sink)
+	assert.Equal(t, len(raw)+16, len(sink.Bytes()))
+	decoded = new(MakeTxParam)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, &v3, decoded)
+}
+
+func TestImportReceipts(t *testing.T) {
+	receipts := new(ImportReceipts)
+	_, err := ImportReceiptProof(nil, 0)
+	assert.Error(t, err)
+
+	leaves := make([]ecom.Hash, 0)
+	for i := 0; i < 13; i++ {
+		leaf := ImportReceiptLeaf(uint64(i), ecom.BytesToHash([]byte{byte(i)}))
+		leaves = append(leaves, leaf)
+		assert.Equal(t, uint64(i), receipts.Append(leaf))
+		if i == 0 {
+			assert.Equal(t, leaf, receipts.Root)
+		}
+		// the root of frontier matches the one of the whole tree
+		levels := importReceiptLevels(leaves)
+		assert.Equal(t, levels[len(levels)-1][0], receipts.Root)
+		for j, leaf := range leaves {
+			proof, err := ImportReceiptProof(leaves, uint64(j))
+			assert.NoError(t, err)
+			assert.True(t, VerifyImportProof(receipts.Root, leaf, proof))
+		}
+	}
+	proof, err := ImportReceiptProof(leaves[:7], 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(proof))
+	assert.False(t, VerifyImportProof(receipts.Root, leaves[5], proof))
+
+	sink := polycomm.NewZeroCopySink(nil)
+	receipts.Serialization(sink)
+	decoded := new(ImportReceipts)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, receipts, decoded)
+	assert.Error(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()[:40])))
+}
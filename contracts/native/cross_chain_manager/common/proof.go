@@ -0,0 +1,285 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+
+	ecom "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/light"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// maxProofWorkers bounds the goroutines verifying the proof branches of one import.
+var maxProofWorkers = runtime.NumCPU()
+
+// StorageProof is the storage branch in response of `eth_getProof`.
+type StorageProof struct {
+	Key   string   `json:"key"`
+	Value string   `json:"value"`
+	Proof []string `json:"proof"`
+}
+
+// AccountProof is the response of `eth_getProof` on evm compatible chains, which proves the
+// storage of the cross chain manager contract in the source chain block.
+type AccountProof struct {
+	Address       string         `json:"address"`
+	Balance       string         `json:"balance"`
+	CodeHash      string         `json:"codeHash"`
+	Nonce         string         `json:"nonce"`
+	StorageHash   string         `json:"storageHash"`
+	AccountProof  []string       `json:"accountProof"`
+	StorageProofs []StorageProof `json:"storageProof"`
+}
+
+// ProofAccount is the account value in state trie.
+type ProofAccount struct {
+	Nounce   *big.Int
+	Balance  *big.Int
+	Storage  ecom.Hash
+	Codehash ecom.Hash
+}
+
+func proofNodes(proof []string) (*light.NodeList, error) {
+	nodeList := new(light.NodeList)
+	for i, s := range proof {
+		node, err := DecodeHex(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof node %d: %v", i, err)
+		}
+		nodeList.Put(nil, node)
+	}
+	return nodeList, nil
+}
+
+// StorageRoot returns the storage root claimed by the proof.
+func (p *AccountProof) StorageRoot() (ecom.Hash, error) {
+	root, err := DecodeHexHash(p.StorageHash)
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("invalid storage hash: %v", err)
+	}
+	return root, nil
+}
+
+// VerifyAccount verifies the account branch of the contract against the state root, and returns
+// the verified storage root.
+func (p *AccountProof) VerifyAccount(root ecom.Hash, contract []byte) (ecom.Hash, error) {
+	addr, err := DecodeHex(p.Address)
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, invalid address: %v", err)
+	}
+	if !bytes.Equal(addr, contract) {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, contract address is error, proof address: %s, side chain address: %s", p.Address, hex.EncodeToString(contract))
+	}
+	nodes, err := proofNodes(p.AccountProof)
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, account proof: %v", err)
+	}
+	acctVal, err := trie.VerifyProof(root, crypto.Keccak256(addr), nodes.NodeSet())
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, verify account proof error:%s", err)
+	}
+
+	nounce, err := DecodeHexBig(p.Nonce)
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, invalid format of nounce: %v", err)
+	}
+	balance, err := DecodeHexBig(p.Balance)
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, invalid format of balance: %v", err)
+	}
+	storage, err := p.StorageRoot()
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, %v", err)
+	}
+	codeHash, err := DecodeHexHash(p.CodeHash)
+	if err != nil {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, invalid code hash: %v", err)
+	}
+	acct := &ProofAccount{
+		Nounce:   nounce,
+		Balance:  balance,
+		Storage:  storage,
+		Codehash: codeHash,
+	}
+	acctrlp, err := rlp.EncodeToBytes(acct)
+	if err != nil {
+		return ecom.Hash{}, err
+	}
+	if !bytes.Equal(acctrlp, acctVal) {
+		return ecom.Hash{}, fmt.Errorf("verifyMerkleProof, verify account proof failed, wanted:%v, get:%v", acctrlp, acctVal)
+	}
+	return acct.Storage, nil
+}
+
+func verifyStorageProof(root ecom.Hash, sp *StorageProof) ([]byte, error) {
+	key, err := DecodeHexHash(sp.Key)
+	if err != nil {
+		return nil, fmt.Errorf("verifyMerkleProof, invalid storage key: %v", err)
+	}
+	nodes, err := proofNodes(sp.Proof)
+	if err != nil {
+		return nil, fmt.Errorf("verifyMerkleProof, storage proof: %v", err)
+	}
+	storageKey := crypto.Keccak256(key.Bytes())
+	val, err := trie.VerifyProof(root, storageKey, nodes.NodeSet())
+	if err != nil {
+		return nil, fmt.Errorf("verifyMerkleProof, verify storage proof error:%s", err)
+	}
+	return val, nil
+}
+
+// VerifyStorageProofs verifies the storage branches against the storage root concurrently with
+// bounded goroutines. the values are returned in order of the branches, and the error of the
+// first failed branch is returned if any, so that the result never depends on scheduling.
+func VerifyStorageProofs(root ecom.Hash, proofs []StorageProof) ([][]byte, error) {
+	var (
+		values = make([][]byte, len(proofs))
+		errs   = make([]error, len(proofs))
+	)
+	if len(proofs) == 1 {
+		values[0], errs[0] = verifyStorageProof(root, &proofs[0])
+	} else {
+		var (
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, maxProofWorkers)
+		)
+		for i := range proofs {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int) {
+				defer func() { <-sem; wg.Done() }()
+				values[i], errs[i] = verifyStorageProof(root, &proofs[i])
+			}(i)
+		}
+		wg.Wait()
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// Verify verifies the account branch and the storage branches concurrently, the storage branches
+// are verified against the claimed storage root which is bound to the state root by the account
+// branch. the account error takes precedence over the storage errors.
+func (p *AccountProof) Verify(root ecom.Hash, contract []byte) ([][]byte, error) {
+	storage, err := p.StorageRoot()
+	if err != nil {
+		return nil, fmt.Errorf("verifyMerkleProof, %v", err)
+	}
+	var (
+		acctErr error
+		done    = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+		_, acctErr = p.VerifyAccount(root, contract)
+	}()
+	values, err := VerifyStorageProofs(storage, p.StorageProofs)
+	<-done
+	if acctErr != nil {
+		return nil, acctErr
+	}
+	return values, err
+}
+
+// VerifyContractStorage verifies the storage branches of the contract in the source chain block,
+// the account branch is verified concurrently only for the first import against the block, and
+// the verified storage root is cached since the storage root cache fork so that the following
+// imports against the same block verify the storage branches only. the cache is keyed by block hash
+// rather than height, so that it never outlives a reorg, and only the latest roots of the chain are
+// kept. if the code hash of the CCMC is pinned, the account branch is always verified and the code
+// hash proved must match the pinned one.
+func VerifyContractStorage(native *native.NativeContract, chainID uint64, blockHash, stateRoot ecom.Hash,
+	contract []byte, proof *AccountProof) ([][]byte, error) {
+	pin, err := GetCodeHashPin(native, chainID)
+	if err != nil {
+		return nil, err
+	}
+	cache := native.ContractRef().IsStorageRootCache()
+	var (
+		root   ecom.Hash
+		cached bool
+	)
+	if cache {
+		if root, cached, err = getStorageRoot(native, chainID, blockHash, contract); err != nil {
+			return nil, err
+		}
+	}
+	if cached && !pin.Pinned() {
+		return VerifyStorageProofs(root, proof.StorageProofs)
+	}
+	values, err := proof.Verify(stateRoot, contract)
+	if err != nil {
+		return nil, err
+	}
+	if pin.Pinned() {
+		// the code hash is valid once the proof is verified
+		codeHash, _ := DecodeHexHash(proof.CodeHash)
+		if codeHash != pin.CodeHash {
+			return nil, &CodeHashMismatchError{ChainID: chainID, Expected: pin.CodeHash, Actual: codeHash, PinHeight: pin.Height}
+		}
+	}
+	// the storage hash is valid once the proof is verified
+	if cache && !cached {
+		root, _ = proof.StorageRoot()
+		if err := putStorageRoot(native, chainID, blockHash, contract, root); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// CheckProofResult checks the storage value proved against the cross chain message, the CCMC of
+// source chain stores the keccak256 hash of the serialized message in the slot. the slot value is
+// rlp encoded with the leading zero bytes trimmed, so it's left padded back to 32 bytes. an empty
+// value, i.e. the slot is never written, and a value longer than a slot are rejected explicitly.
+func CheckProofResult(result, value []byte) error {
+	if len(value) == 0 {
+		return fmt.Errorf("checkProofResult, cross chain message is empty")
+	}
+	if len(result) == 0 {
+		return fmt.Errorf("checkProofResult, storage value is empty")
+	}
+	var slot []byte
+	if err := rlp.DecodeBytes(result, &slot); err != nil {
+		return fmt.Errorf("checkProofResult, decode storage value %x error: %v", result, err)
+	}
+	if len(slot) == 0 {
+		return fmt.Errorf("checkProofResult, storage value is empty")
+	}
+	if len(slot) > ecom.HashLength {
+		return fmt.Errorf("checkProofResult, storage value of %d bytes exceeds %d bytes", len(slot), ecom.HashLength)
+	}
+	hash := crypto.Keccak256(value)
+	if !bytes.Equal(ecom.LeftPadBytes(slot, ecom.HashLength), hash) {
+		return fmt.Errorf("checkProofResult, storage value %x mismatches the message hash %x", slot, hash)
+	}
+	return nil
+}
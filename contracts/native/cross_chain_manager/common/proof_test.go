@@ -0,0 +1,293 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	ecom "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+var testContract = ecom.HexToAddress("0x1234")
+
+func hexNodes(nodes [][]byte) []string {
+	list := make([]string, len(nodes))
+	for i, node := range nodes {
+		list[i] = hexutil.Encode(node)
+	}
+	return list
+}
+
+// newTestProof builds the proof of the storage keys of the test contract on a fresh state.
+func newTestProof(t testing.TB, keys int) (ecom.Hash, *AccountProof) {
+	db, _ := state.New(ecom.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	db.SetNonce(testContract, 1)
+	db.SetCode(testContract, []byte{0x1})
+	for i := 1; i <= keys; i++ {
+		db.SetState(testContract, ecom.BigToHash(big.NewInt(int64(i))), crypto.Keccak256Hash([]byte{byte(i)}))
+	}
+	root, err := db.Commit(false)
+	assert.NoError(t, err)
+	db, _ = state.New(root, db.Database(), nil)
+
+	acctProof, err := db.GetProof(testContract)
+	assert.NoError(t, err)
+	proof := &AccountProof{
+		Address:      testContract.Hex(),
+		Balance:      "0x0",
+		CodeHash:     db.GetCodeHash(testContract).Hex(),
+		Nonce:        "0x1",
+		StorageHash:  db.StorageTrie(testContract).Hash().Hex(),
+		AccountProof: hexNodes(acctProof),
+	}
+	for i := 1; i <= keys; i++ {
+		key := ecom.BigToHash(big.NewInt(int64(i)))
+		storageProof, err := db.GetStorageProof(testContract, key)
+		assert.NoError(t, err)
+		proof.StorageProofs = append(proof.StorageProofs, StorageProof{Key: key.Hex(), Proof: hexNodes(storageProof)})
+	}
+	return root, proof
+}
+
+func TestVerifyAccountProof(t *testing.T) {
+	root, proof := newTestProof(t, 20)
+
+	values, err := proof.Verify(root, testContract.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, 20, len(values))
+	for i, value := range values {
+		assert.Equal(t, crypto.Keccak256([]byte{byte(i + 1)}), value[1:], "rlp encoded value in order of branches")
+	}
+
+	_, err = proof.Verify(root, ecom.HexToAddress("0x1").Bytes())
+	assert.Error(t, err)
+	_, err = proof.Verify(ecom.HexToHash("0x1"), testContract.Bytes())
+	assert.Error(t, err)
+
+	// the first failed branch is reported
+	proof.StorageProofs[5].Proof = proof.StorageProofs[5].Proof[1:]
+	proof.StorageProofs[10].Key = "0x0"
+	storage, _ := proof.StorageRoot()
+	_, err = VerifyStorageProofs(storage, proof.StorageProofs)
+	_, expected := verifyStorageProof(storage, &proof.StorageProofs[5])
+	assert.Error(t, err)
+	assert.Equal(t, expected, err)
+}
+
+func TestVerifyMalformedProof(t *testing.T) {
+	root, proof := newTestProof(t, 2)
+
+	// the malformed hex is rejected explicitly rather than decoded partially
+	for _, c := range []struct {
+		name   string
+		mutate func(p *AccountProof)
+		err    string
+	}{
+		{"account node odd length", func(p *AccountProof) { p.AccountProof[0] += "0" }, "odd length"},
+		{"account node invalid char", func(p *AccountProof) { p.AccountProof[0] = "0xzz" + p.AccountProof[0][4:] }, "invalid hex character"},
+		{"storage node invalid char", func(p *AccountProof) { p.StorageProofs[1].Proof[0] = "0x0g" }, "invalid hex character"},
+		{"storage key odd length", func(p *AccountProof) { p.StorageProofs[0].Key = "0x1" }, "odd length"},
+		{"storage hash too long", func(p *AccountProof) { p.StorageHash += "00" }, "exceeds"},
+		{"address invalid char", func(p *AccountProof) { p.Address = "0x12345g" }, "invalid hex character"},
+		{"nonce empty", func(p *AccountProof) { p.Nonce = "0x" }, "empty hex quantity"},
+		{"balance invalid char", func(p *AccountProof) { p.Balance = "0x-1" }, "invalid hex character"},
+	} {
+		_, malformed := newTestProof(t, 2)
+		c.mutate(malformed)
+		_, err := malformed.Verify(root, testContract.Bytes())
+		if assert.Error(t, err, c.name) {
+			assert.Contains(t, err.Error(), c.err, c.name)
+		}
+	}
+
+	_, err := proof.Verify(root, testContract.Bytes())
+	assert.NoError(t, err)
+}
+
+func TestVerifyContractStorage(t *testing.T) {
+	db, _ := state.New(ecom.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, ecom.EmptyAddress, ecom.EmptyAddress, big.NewInt(1), ecom.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	root, proof := newTestProof(t, 1)
+	block := ecom.HexToHash("0x01")
+
+	// failed verification is not cached
+	_, err := VerifyContractStorage(s, 2, block, ecom.HexToHash("0x1"), testContract.Bytes(), proof)
+	assert.Error(t, err)
+	_, ok, _ := getStorageRoot(s, 2, block, testContract.Bytes())
+	assert.False(t, ok)
+
+	_, err = VerifyContractStorage(s, 2, block, root, testContract.Bytes(), proof)
+	assert.NoError(t, err)
+	cached, ok, _ := getStorageRoot(s, 2, block, testContract.Bytes())
+	assert.True(t, ok)
+	storage, _ := proof.StorageRoot()
+	assert.Equal(t, storage, cached)
+
+	// the account branch is skipped against the same block
+	proof.AccountProof = nil
+	_, err = VerifyContractStorage(s, 2, block, root, testContract.Bytes(), proof)
+	assert.NoError(t, err)
+	_, err = VerifyContractStorage(s, 3, block, root, testContract.Bytes(), proof)
+	assert.Error(t, err)
+	_, err = VerifyContractStorage(s, 2, ecom.HexToHash("0x02"), root, testContract.Bytes(), proof)
+	assert.Error(t, err)
+}
+
+func TestStorageRootRetention(t *testing.T) {
+	db, _ := state.New(ecom.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, ecom.EmptyAddress, ecom.EmptyAddress, big.NewInt(1), ecom.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+	root, proof := newTestProof(t, 1)
+
+	// storage roots are not cached before the fork
+	ref.SetChainConfig(&params.ChainConfig{StorageRootCacheBlock: big.NewInt(2)})
+	_, err := VerifyContractStorage(s, 2, ecom.HexToHash("0x01"), root, testContract.Bytes(), proof)
+	assert.NoError(t, err)
+	_, ok, _ := getStorageRoot(s, 2, ecom.HexToHash("0x01"), testContract.Bytes())
+	assert.False(t, ok)
+
+	// only the latest roots of the chain are kept
+	ref.SetChainConfig(nil)
+	for i := 1; i <= StorageRootRetention+1; i++ {
+		_, err := VerifyContractStorage(s, 2, ecom.BigToHash(big.NewInt(int64(i))), root, testContract.Bytes(), proof)
+		assert.NoError(t, err)
+	}
+	_, err = VerifyContractStorage(s, 3, ecom.HexToHash("0x01"), root, testContract.Bytes(), proof)
+	assert.NoError(t, err)
+	_, ok, _ = getStorageRoot(s, 2, ecom.HexToHash("0x01"), testContract.Bytes())
+	assert.False(t, ok)
+	for i := 2; i <= StorageRootRetention+1; i++ {
+		_, ok, _ = getStorageRoot(s, 2, ecom.BigToHash(big.NewInt(int64(i))), testContract.Bytes())
+		assert.True(t, ok)
+	}
+	_, ok, _ = getStorageRoot(s, 3, ecom.HexToHash("0x01"), testContract.Bytes())
+	assert.True(t, ok)
+}
+
+func TestVerifyContractStorageCodeHashPin(t *testing.T) {
+	db, _ := state.New(ecom.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, ecom.EmptyAddress, ecom.EmptyAddress, big.NewInt(1), ecom.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	root, proof := newTestProof(t, 1)
+	block := ecom.HexToHash("0x01")
+	codeHash := ecom.HexToHash(proof.CodeHash)
+
+	PutCodeHashPin(s, 2, &CodeHashPin{CodeHash: codeHash})
+	_, err := VerifyContractStorage(s, 2, block, root, testContract.Bytes(), proof)
+	assert.NoError(t, err)
+
+	// the account branch is always verified if pinned
+	full := *proof
+	proof.AccountProof = nil
+	_, err = VerifyContractStorage(s, 2, block, root, testContract.Bytes(), proof)
+	assert.Error(t, err)
+
+	// the code hash claimed must be proved
+	forged := full
+	forged.CodeHash = ecom.HexToHash("0x02").Hex()
+	_, err = VerifyContractStorage(s, 2, block, root, testContract.Bytes(), &forged)
+	assert.Error(t, err)
+
+	PutCodeHashPin(s, 2, &CodeHashPin{CodeHash: ecom.HexToHash("0x02"), Nonce: 1, Height: 100})
+	_, err = VerifyContractStorage(s, 2, block, root, testContract.Bytes(), &full)
+	mismatch := new(CodeHashMismatchError)
+	if assert.True(t, errors.As(err, &mismatch)) {
+		assert.Equal(t, &CodeHashMismatchError{ChainID: 2, Expected: ecom.HexToHash("0x02"), Actual: codeHash, PinHeight: 100}, mismatch)
+	}
+
+	pin, err := GetCodeHashPin(s, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), pin.Nonce)
+	assert.Equal(t, uint64(100), pin.Height)
+	pin, err = GetCodeHashPin(s, 3)
+	assert.NoError(t, err)
+	assert.False(t, pin.Pinned())
+}
+
+func BenchmarkVerifyAccountProof(b *testing.B) {
+	root, proof := newTestProof(b, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := proof.Verify(root, testContract.Bytes()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyStorageProofs(b *testing.B) {
+	_, proof := newTestProof(b, 64)
+	storage, _ := proof.StorageRoot()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := VerifyStorageProofs(storage, proof.StorageProofs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestCheckProofResult(t *testing.T) {
+	msg := []byte("cross chain message")
+	hash := crypto.Keccak256(msg)
+	encode := func(v []byte) []byte {
+		enc, _ := rlp.EncodeToBytes(v)
+		return enc
+	}
+	assert.NoError(t, CheckProofResult(encode(hash), msg))
+
+	// the leading zero bytes of slot value are trimmed
+	var msgZero []byte
+	for i := 0; ; i++ {
+		msgZero = []byte{byte(i), byte(i >> 8)}
+		if crypto.Keccak256(msgZero)[0] == 0 {
+			break
+		}
+	}
+	assert.NoError(t, CheckProofResult(encode(crypto.Keccak256(msgZero)[1:]), msgZero))
+
+	for _, c := range []struct {
+		name          string
+		result, value []byte
+		err           string
+	}{
+		{"empty message", encode(hash), nil, "message is empty"},
+		{"nil result", nil, msg, "storage value is empty"},
+		{"empty slot", encode(nil), msg, "storage value is empty"},
+		{"malformed rlp", []byte{0xb8}, msg, "decode storage value"},
+		{"trailing rlp", append(encode(hash), 0x01), msg, "decode storage value"},
+		{"long rlp string", encode(append(hash, 0x01)), msg, "exceeds 32 bytes"},
+		{"mismatched", encode(hash[1:]), msg, "mismatches"},
+	} {
+		err := CheckProofResult(c.result, c.value)
+		if assert.Error(t, err, c.name) {
+			assert.Contains(t, err.Error(), c.err, c.name)
+		}
+	}
+}
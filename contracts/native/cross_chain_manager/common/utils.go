@@ -19,22 +19,31 @@ package common
 
 import (
 	"fmt"
-	"strings"
 
+	ecom "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/metrics"
+	polycomm "github.com/polynetwork/poly/common"
 	cstates "github.com/polynetwork/poly/core/states"
 )
 
+// Replace0x removes the `0x` prefix of the hex string if any.
 func Replace0x(s string) string {
-	return strings.Replace(strings.ToLower(s), "0x", "", 1)
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
 }
 
+// PutDoneTx records the cross chain message of the source chain as imported, the records written
+// are counted by `native/crosschain/<chainID>/donetx` as the growth of done tx set.
 func PutDoneTx(native *native.NativeContract, crossChainID []byte, chainID uint64) error {
 	contract := utils.CrossChainManagerContractAddress
 	chainIDBytes := utils.GetUint64Bytes(chainID)
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(DONE_TX), chainIDBytes, crossChainID),
 		cstates.GenRawStorageItem(crossChainID))
+	metrics.GetOrRegisterCounter(fmt.Sprintf("native/crosschain/%d/donetx", chainID), nil).Inc(1)
 	return nil
 }
 
@@ -46,13 +55,103 @@ func CheckDoneTx(native *native.NativeContract, crossChainID []byte, chainID uin
 		return fmt.Errorf("checkDoneTx, native.GetCacheDB().Get error: %v", err)
 	}
 	if value != nil {
-		return fmt.Errorf("checkDoneTx, tx already done")
+		return NewImportError(ErrCodeTxAlreadyDone, "checkDoneTx, tx already done")
+	}
+	return nil
+}
+
+func storageRootKey(chainID uint64, blockHash ecom.Hash, contract []byte) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(STORAGE_ROOT),
+		utils.GetUint64Bytes(chainID), blockHash.Bytes(), contract)
+}
+
+func getStorageRoot(native *native.NativeContract, chainID uint64, blockHash ecom.Hash, contract []byte) (ecom.Hash, bool, error) {
+	raw, err := native.GetCacheDB().Get(storageRootKey(chainID, blockHash, contract))
+	if err != nil {
+		return ecom.Hash{}, false, fmt.Errorf("getStorageRoot, native.GetCacheDB().Get error: %v", err)
+	}
+	if raw == nil {
+		return ecom.Hash{}, false, nil
+	}
+	value, err := cstates.GetValueFromRawStorageItem(raw)
+	if err != nil {
+		return ecom.Hash{}, false, fmt.Errorf("getStorageRoot, deserialize storage root error: %v", err)
+	}
+	return ecom.BytesToHash(value), true, nil
+}
+
+// putStorageRoot caches the storage root in the ring of the latest `StorageRootRetention` roots of
+// the chain, the root occupying the slot before is dropped.
+func putStorageRoot(native *native.NativeContract, chainID uint64, blockHash ecom.Hash, contract []byte, root ecom.Hash) error {
+	cache := native.GetCacheDB()
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	countKey := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(STORAGE_ROOT_COUNT), chainIDBytes)
+	raw, err := cache.Get(countKey)
+	if err != nil {
+		return fmt.Errorf("putStorageRoot, get storage root count error: %v", err)
+	}
+	count := uint64(0)
+	if raw != nil {
+		value, err := cstates.GetValueFromRawStorageItem(raw)
+		if err != nil {
+			return fmt.Errorf("putStorageRoot, deserialize storage root count error: %v", err)
+		}
+		count = utils.GetBytesUint64(value)
 	}
+
+	slotKey := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(STORAGE_ROOT_SLOT), chainIDBytes,
+		utils.GetUint64Bytes(count%StorageRootRetention))
+	raw, err = cache.Get(slotKey)
+	if err != nil {
+		return fmt.Errorf("putStorageRoot, get storage root slot error: %v", err)
+	}
+	if raw != nil {
+		evicted, err := cstates.GetValueFromRawStorageItem(raw)
+		if err != nil {
+			return fmt.Errorf("putStorageRoot, deserialize storage root slot error: %v", err)
+		}
+		cache.Delete(evicted)
+	}
+
+	key := storageRootKey(chainID, blockHash, contract)
+	cache.Put(key, cstates.GenRawStorageItem(root.Bytes()))
+	cache.Put(slotKey, cstates.GenRawStorageItem(key))
+	cache.Put(countKey, cstates.GenRawStorageItem(utils.GetUint64Bytes(count+1)))
 	return nil
 }
 
+func codeHashPinKey(chainID uint64) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(CODE_HASH_PIN), utils.GetUint64Bytes(chainID))
+}
+
+func PutCodeHashPin(native *native.NativeContract, chainID uint64, pin *CodeHashPin) {
+	sink := polycomm.NewZeroCopySink(nil)
+	pin.Serialization(sink)
+	native.GetCacheDB().Put(codeHashPinKey(chainID), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetCodeHashPin returns an empty pin which disables the check if it's never set.
+func GetCodeHashPin(native *native.NativeContract, chainID uint64) (*CodeHashPin, error) {
+	store, err := native.GetCacheDB().Get(codeHashPinKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("GetCodeHashPin, get code hash pin store error: %v", err)
+	}
+	pin := new(CodeHashPin)
+	if store == nil {
+		return pin, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetCodeHashPin, deserialize from raw storage item err:%v", err)
+	}
+	if err := pin.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetCodeHashPin, deserialize code hash pin error: %v", err)
+	}
+	return pin, nil
+}
+
 func NotifyMakeProof(native *native.NativeContract, merkleValueHex string, key string) {
 
-	native.AddNotify(ABI, []string{NOTIFY_MAKE_PROOF_EVENT}, merkleValueHex, native.ContractRef().BlockHeight(), key)
+	native.AddCrossChainNotify(ABI, []string{NOTIFY_MAKE_PROOF_EVENT}, merkleValueHex, native.ContractRef().BlockHeight(), key)
 
 }
@@ -20,17 +20,15 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/polynetwork/poly/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmosproof"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/cosmos"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
-	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
-	"github.com/ethereum/go-ethereum/contracts/native/utils"
 )
 
 type CosmosHandler struct{}
@@ -58,9 +56,8 @@ func newCDC() *codec.Codec {
 }
 
 func (this *CosmosHandler) MakeDepositProposal(service *native.NativeContract) (*scom.MakeTxParam, error) {
-	ctx := service.ContractRef().CurrentContext()
-	params := &scom.EntranceParam{}
-	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := scom.UnpackEntranceParam(service.ContractRef().CurrentContext().Payload)
+	if err != nil {
 		return nil, err
 	}
 
@@ -68,68 +65,77 @@ func (this *CosmosHandler) MakeDepositProposal(service *native.NativeContract) (
 	if err != nil {
 		return nil, fmt.Errorf("Cosmos MakeDepositProposal, failed to get epoch switching height: %v", err)
 	}
+	if err := cosmos.CheckTrustAt(service.ContractRef(), info.HeaderTime); err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeTrustExpired, "Cosmos MakeDepositProposal, %v", err)
+	}
 	if info.Height > int64(params.Height) {
 		return nil, fmt.Errorf("Cosmos MakeDepositProposal, the height %d of header is lower than epoch "+
 			"switching height %d", params.Height, info.Height)
 	}
 
 	if len(params.HeaderOrCrossChainMsg) == 0 {
-		return nil, fmt.Errorf("you must commit the header used to verify transaction's proof and get none")
+		return nil, scom.NewImportError(scom.ErrCodeInvalidParam, "you must commit the header used to verify transaction's proof and get none")
 	}
 	cdc := newCDC()
 	var myHeader cosmos.CosmosHeader
 	if err := cdc.UnmarshalBinaryBare(params.HeaderOrCrossChainMsg, &myHeader); err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, unmarshal cosmos header failed: %v", err)
+		return nil, scom.NewImportError(scom.ErrCodeInvalidParam, "Cosmos MakeDepositProposal, unmarshal cosmos header failed: %v", err)
 	}
 	if myHeader.Header.Height != int64(params.Height) {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, "+
+		return nil, scom.NewImportError(scom.ErrCodeInvalidParam, "Cosmos MakeDepositProposal, "+
 			"height of your header is %d not equal to %d in parameter", myHeader.Header.Height, params.Height)
 	}
-	if err = cosmos.VerifyCosmosHeader(&myHeader, info); err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, failed to verify cosmos header: %v", err)
+	if err = cosmos.VerifyCosmosHeader(service, &myHeader, info); err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "Cosmos MakeDepositProposal, failed to verify cosmos header: %v", err)
 	}
 	if !bytes.Equal(myHeader.Header.ValidatorsHash, myHeader.Header.NextValidatorsHash) &&
 		myHeader.Header.Height > info.Height {
-		cosmos.PutEpochSwitchInfo(service, params.SourceChainID, &cosmos.CosmosEpochSwitchInfo{
-			Height:             myHeader.Header.Height,
-			BlockHash:          myHeader.Header.Hash(),
-			NextValidatorsHash: myHeader.Header.NextValidatorsHash,
-			ChainID:            myHeader.Header.ChainID,
-		})
+		cosmos.PutEpochSwitchInfo(service, params.SourceChainID, cosmos.NewEpochSwitchInfo(&myHeader.Header))
 	}
 
+	txParam, err := VerifyProof(params.Proof, params.Extra, myHeader.Header.AppHash)
+	if err != nil {
+		return nil, err
+	}
+	if err := scom.CheckDoneTx(service, txParam.CrossChainID, params.SourceChainID); err != nil {
+		return nil, fmt.Errorf("Cosmos MakeDepositProposal, check done transaction error:%w", err)
+	}
+	if err := scom.PutDoneTx(service, txParam.CrossChainID, params.SourceChainID); err != nil {
+		return nil, fmt.Errorf("Cosmos MakeDepositProposal, PutDoneTx error:%s", err)
+	}
+	return txParam, nil
+}
+
+// VerifyProof verifies the amino encoded merkle proof of the cross chain message against the app
+// hash of the header, the message is in the proof value of extra.
+func VerifyProof(proof, extra, appHash []byte) (*scom.MakeTxParam, error) {
+	cdc := newCDC()
 	var proofValue CosmosProofValue
-	if err = cdc.UnmarshalBinaryBare(params.Extra, &proofValue); err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, unmarshal proof value err: %v", err)
+	if err := cdc.UnmarshalBinaryBare(extra, &proofValue); err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "Cosmos MakeDepositProposal, unmarshal proof value err: %v", err)
 	}
-	var proof merkle.Proof
-	err = cdc.UnmarshalBinaryBare(params.Proof, &proof)
+	var merkleProof cosmosproof.Proof
+	err := cdc.UnmarshalBinaryBare(proof, &merkleProof)
 	if err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, unmarshal proof err: %v", err)
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "Cosmos MakeDepositProposal, unmarshal proof err: %v", err)
 	}
 	if len(proofValue.Kp) != 0 {
-		prt := rootmulti.DefaultProofRuntime()
-		err = prt.VerifyValue(&proof, myHeader.Header.AppHash, proofValue.Kp, proofValue.Value)
+		prt := cosmosproof.DefaultProofRuntime()
+		err = prt.VerifyValue(&merkleProof, appHash, proofValue.Kp, proofValue.Value)
 		if err != nil {
-			return nil, fmt.Errorf("Cosmos MakeDepositProposal, proof error: %s", err)
+			return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "Cosmos MakeDepositProposal, proof error: %s", err)
 		}
 	} else {
-		prt := rootmulti.DefaultProofRuntime()
-		err = prt.VerifyAbsence(&proof, myHeader.Header.AppHash, string(proofValue.Value))
+		prt := cosmosproof.DefaultProofRuntime()
+		err = prt.VerifyAbsence(&merkleProof, appHash, string(proofValue.Value))
 		if err != nil {
-			return nil, fmt.Errorf("Cosmos MakeDepositProposal, proof error: %s", err)
+			return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "Cosmos MakeDepositProposal, proof error: %s", err)
 		}
 	}
 	data := common.NewZeroCopySource(proofValue.Value)
 	txParam := new(scom.MakeTxParam)
 	if err := txParam.Deserialization(data); err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, deserialize merkleValue error:%s", err)
-	}
-	if err := scom.CheckDoneTx(service, txParam.CrossChainID, params.SourceChainID); err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, check done transaction error:%s", err)
-	}
-	if err := scom.PutDoneTx(service, txParam.CrossChainID, params.SourceChainID); err != nil {
-		return nil, fmt.Errorf("Cosmos MakeDepositProposal, PutDoneTx error:%s", err)
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "Cosmos MakeDepositProposal, deserialize merkleValue error:%s", err)
 	}
 	return txParam, nil
 }
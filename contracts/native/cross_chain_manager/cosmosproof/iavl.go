@@ -0,0 +1,396 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cosmosproof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	amino "github.com/tendermint/go-amino"
+)
+
+const (
+	// ProofOpIAVLValue is the type of the value in an iavl tree.
+	ProofOpIAVLValue = "iavl:v"
+	// ProofOpIAVLAbsence is the type of the absence in an iavl tree.
+	ProofOpIAVLAbsence = "iavl:a"
+)
+
+var (
+	ErrInvalidProof = errors.New("invalid proof")
+	ErrInvalidRoot  = errors.New("invalid root")
+)
+
+type ProofInnerNode struct {
+	Height  int8   `json:"height"`
+	Size    int64  `json:"size"`
+	Version int64  `json:"version"`
+	Left    []byte `json:"left"`
+	Right   []byte `json:"right"`
+}
+
+func (pin ProofInnerNode) Hash(childHash []byte) []byte {
+	buf := new(bytes.Buffer)
+	err := amino.EncodeInt8(buf, pin.Height)
+	if err == nil {
+		err = amino.EncodeVarint(buf, pin.Size)
+	}
+	if err == nil {
+		err = amino.EncodeVarint(buf, pin.Version)
+	}
+	left, right := pin.Left, pin.Right
+	if len(left) == 0 {
+		left = childHash
+	} else {
+		right = childHash
+	}
+	if err == nil {
+		err = amino.EncodeByteSlice(buf, left)
+	}
+	if err == nil {
+		err = amino.EncodeByteSlice(buf, right)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("Failed to hash ProofInnerNode: %v", err))
+	}
+	return sum(buf.Bytes())
+}
+
+type ProofLeafNode struct {
+	Key       []byte `json:"key"`
+	ValueHash []byte `json:"value"`
+	Version   int64  `json:"version"`
+}
+
+func (pln ProofLeafNode) Hash() []byte {
+	buf := new(bytes.Buffer)
+	err := amino.EncodeInt8(buf, 0)
+	if err == nil {
+		err = amino.EncodeVarint(buf, 1)
+	}
+	if err == nil {
+		err = amino.EncodeVarint(buf, pln.Version)
+	}
+	if err == nil {
+		err = amino.EncodeByteSlice(buf, pln.Key)
+	}
+	if err == nil {
+		err = amino.EncodeByteSlice(buf, pln.ValueHash)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("Failed to hash ProofLeafNode: %v", err))
+	}
+	return sum(buf.Bytes())
+}
+
+// PathToLeaf is the inner nodes from the root to a leaf.
+type PathToLeaf []ProofInnerNode
+
+func (pl PathToLeaf) computeRootHash(leafHash []byte) []byte {
+	hash := leafHash
+	for i := len(pl) - 1; i >= 0; i-- {
+		hash = pl[i].Hash(hash)
+	}
+	return hash
+}
+
+func (pl PathToLeaf) isLeftmost() bool {
+	for _, node := range pl {
+		if len(node.Left) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (pl PathToLeaf) isRightmost() bool {
+	for _, node := range pl {
+		if len(node.Right) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RangeProof is the proof of the leaves in a range of the iavl tree.
+type RangeProof struct {
+	LeftPath   PathToLeaf      `json:"left_path"`
+	InnerNodes []PathToLeaf    `json:"inner_nodes"`
+	Leaves     []ProofLeafNode `json:"leaves"`
+
+	// memoize
+	rootHash     []byte // valid iff rootVerified is true
+	rootVerified bool
+	treeEnd      bool // valid iff rootVerified is true
+}
+
+// VerifyItem verifies the value of the key is in the proof, `Verify` must be called first.
+func (proof *RangeProof) VerifyItem(key, value []byte) error {
+	if !proof.rootVerified {
+		return fmt.Errorf("must call Verify(root) first")
+	}
+	leaves := proof.Leaves
+	i := sort.Search(len(leaves), func(i int) bool {
+		return bytes.Compare(key, leaves[i].Key) <= 0
+	})
+	if i >= len(leaves) || !bytes.Equal(leaves[i].Key, key) {
+		return fmt.Errorf("leaf key not found in proof: %w", ErrInvalidProof)
+	}
+	if !bytes.Equal(leaves[i].ValueHash, sum(value)) {
+		return fmt.Errorf("leaf value hash not same: %w", ErrInvalidProof)
+	}
+	return nil
+}
+
+// VerifyAbsence verifies the key is not in the tree, `Verify` must be called first.
+func (proof *RangeProof) VerifyAbsence(key []byte) error {
+	if !proof.rootVerified {
+		return fmt.Errorf("must call Verify(root) first")
+	}
+	cmp := bytes.Compare(key, proof.Leaves[0].Key)
+	if cmp < 0 {
+		if proof.LeftPath.isLeftmost() {
+			return nil
+		}
+		return fmt.Errorf("absence not proved by left path")
+	} else if cmp == 0 {
+		return fmt.Errorf("absence disproved via first item #0")
+	}
+	if len(proof.LeftPath) == 0 {
+		return nil // proof ok
+	}
+	if proof.LeftPath.isRightmost() {
+		return nil
+	}
+
+	// See if any of the leaves are greater than key.
+	for i := 1; i < len(proof.Leaves); i++ {
+		switch cmp := bytes.Compare(key, proof.Leaves[i].Key); {
+		case cmp < 0:
+			return nil // proof ok
+		case cmp == 0:
+			return fmt.Errorf("absence disproved via item #%v", i)
+		}
+	}
+
+	// It's still a valid proof if our last leaf is the rightmost child.
+	if proof.treeEnd {
+		return nil
+	}
+	if len(proof.Leaves) < 2 {
+		return fmt.Errorf("absence not proved by right leaf (need another leaf?)")
+	}
+	return fmt.Errorf("absence not proved by right leaf")
+}
+
+// Verify verifies the proof against the root.
+func (proof *RangeProof) Verify(root []byte) error {
+	rootHash := proof.rootHash
+	if rootHash == nil {
+		derivedHash, err := proof.computeRootHash()
+		if err != nil {
+			return err
+		}
+		rootHash = derivedHash
+	}
+	if !bytes.Equal(rootHash, root) {
+		return fmt.Errorf("root hash doesn't match: %w", ErrInvalidRoot)
+	}
+	proof.rootVerified = true
+	return nil
+}
+
+// ComputeRootHash returns the root hash of the proof, or nil if the proof is malformed.
+func (proof *RangeProof) ComputeRootHash() []byte {
+	rootHash, _ := proof.computeRootHash()
+	return rootHash
+}
+
+func (proof *RangeProof) computeRootHash() ([]byte, error) {
+	rootHash, treeEnd, err := proof._computeRootHash()
+	if err == nil {
+		proof.rootHash = rootHash
+		proof.treeEnd = treeEnd
+	}
+	return rootHash, err
+}
+
+func (proof *RangeProof) _computeRootHash() (rootHash []byte, treeEnd bool, err error) {
+	if len(proof.Leaves) == 0 {
+		return nil, false, fmt.Errorf("no leaves: %w", ErrInvalidProof)
+	}
+	if len(proof.InnerNodes)+1 != len(proof.Leaves) {
+		return nil, false, fmt.Errorf("InnerNodes vs Leaves length mismatch, leaves should be 1 more: %w", ErrInvalidProof)
+	}
+
+	// Start from the left path and prove each leaf.
+
+	// shared across recursive calls
+	var leaves = proof.Leaves
+	var innersq = proof.InnerNodes
+	var computeHash func(path PathToLeaf, rightmost bool) (hash []byte, treeEnd bool, done bool, err error)
+
+	// rightmost: is the root a rightmost child of the tree?
+	// treeEnd: true iff the last leaf is the last item of the tree.
+	// Returns the (possibly intermediate, possibly root) hash.
+	computeHash = func(path PathToLeaf, rightmost bool) (hash []byte, treeEnd bool, done bool, err error) {
+		// Pop next leaf.
+		nleaf, rleaves := leaves[0], leaves[1:]
+		leaves = rleaves
+
+		// Compute hash.
+		hash = path.computeRootHash(nleaf.Hash())
+
+		// If we don't have any leaves left, we're done.
+		if len(leaves) == 0 {
+			rightmost = rightmost && path.isRightmost()
+			return hash, rightmost, true, nil
+		}
+
+		// Prove along path (until we run out of leaves).
+		for len(path) > 0 {
+			// Drop the leaf-most (last-most) inner nodes from path until we encounter one with a
+			// left hash. We assume that the left side is already verified.
+			rpath, lpath := path[:len(path)-1], path[len(path)-1]
+			path = rpath
+			if len(lpath.Right) == 0 {
+				continue
+			}
+
+			// Pop next inners, a PathToLeaf (e.g. []ProofInnerNode).
+			if len(innersq) == 0 {
+				return nil, false, false, fmt.Errorf("inner nodes exhausted: %w", ErrInvalidProof)
+			}
+			inners, rinnersq := innersq[0], innersq[1:]
+			innersq = rinnersq
+
+			// Recursively verify inners against remaining leaves.
+			derivedRoot, treeEnd, done, err := computeHash(inners, rightmost && rpath.isRightmost())
+			if err != nil {
+				return nil, treeEnd, false, fmt.Errorf("recursive computeHash call: %w", err)
+			}
+			if !bytes.Equal(derivedRoot, lpath.Right) {
+				return nil, treeEnd, false, fmt.Errorf("intermediate root hash %X doesn't match, got %X: %w", lpath.Right, derivedRoot, ErrInvalidRoot)
+			}
+			if done {
+				return hash, treeEnd, true, nil
+			}
+		}
+
+		// We're not done yet (leaves left over). No error, not done either. Technically if
+		// rightmost, we know there's an error "left over leaves -- malformed proof", but we
+		// return that at the top level, below.
+		return hash, false, false, nil
+	}
+
+	// Verify!
+	rootHash, treeEnd, done, err := computeHash(proof.LeftPath, true)
+	if err != nil {
+		return nil, treeEnd, fmt.Errorf("root computeHash call: %w", err)
+	} else if !done {
+		return nil, treeEnd, fmt.Errorf("left over leaves -- malformed proof: %w", ErrInvalidProof)
+	}
+	return rootHash, treeEnd, nil
+}
+
+// ValueOp proves the value of the key in an iavl tree.
+type ValueOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data.
+	Proof *RangeProof `json:"proof"`
+}
+
+func ValueOpDecoder(pop ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpIAVLValue {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpIAVLValue)
+	}
+	var op ValueOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into IAVLValueOp: %v", err)
+	}
+	if op.Proof == nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into IAVLValueOp: %w", ErrInvalidProof)
+	}
+	return ValueOp{key: pop.Key, Proof: op.Proof}, nil
+}
+
+func (op ValueOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("value size is not 1")
+	}
+	root := op.Proof.ComputeRootHash()
+	if err := op.Proof.Verify(root); err != nil {
+		return nil, fmt.Errorf("computing root hash: %w", err)
+	}
+	if err := op.Proof.VerifyItem(op.key, args[0]); err != nil {
+		return nil, fmt.Errorf("verifying value: %w", err)
+	}
+	return [][]byte{root}, nil
+}
+
+func (op ValueOp) GetKey() []byte {
+	return op.key
+}
+
+// AbsenceOp proves the absence of the key in an iavl tree.
+type AbsenceOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data.
+	// Proof is nil for an empty tree.
+	// The hash of an empty tree is nil.
+	Proof *RangeProof `json:"proof"`
+}
+
+func AbsenceOpDecoder(pop ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpIAVLAbsence {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpIAVLAbsence)
+	}
+	var op AbsenceOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into IAVLAbsenceOp: %v", err)
+	}
+	return AbsenceOp{key: pop.Key, Proof: op.Proof}, nil
+}
+
+func (op AbsenceOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("expected 0 args, got %v", len(args))
+	}
+	// If the tree is nil, the proof is nil, and all keys are absent.
+	if op.Proof == nil {
+		return [][]byte{[]byte(nil)}, nil
+	}
+	root := op.Proof.ComputeRootHash()
+	if err := op.Proof.Verify(root); err != nil {
+		return nil, fmt.Errorf("computing root hash: %w", err)
+	}
+	if err := op.Proof.VerifyAbsence(op.key); err != nil {
+		return nil, fmt.Errorf("verifying absence: %w", err)
+	}
+	return [][]byte{root}, nil
+}
+
+func (op AbsenceOp) GetKey() []byte {
+	return op.key
+}
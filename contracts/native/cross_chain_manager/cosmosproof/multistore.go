@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cosmosproof
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ProofOpMultiStore is the type of the substore hash in the multistore of cosmos-sdk.
+const ProofOpMultiStore = "multistore"
+
+type CommitID struct {
+	Version int64
+	Hash    []byte
+}
+
+type StoreCore struct {
+	CommitID CommitID
+}
+
+// StoreInfo is the leaf of the simple merkle tree of the multistore.
+type StoreInfo struct {
+	Name string
+	Core StoreCore
+}
+
+// MultiStoreProof is the commit info of all the substores.
+type MultiStoreProof struct {
+	StoreInfos []StoreInfo
+}
+
+// ComputeRootHash returns the app hash, the simple map root of the substore commit hashes by name.
+func (proof *MultiStoreProof) ComputeRootHash() []byte {
+	names := make([]string, len(proof.StoreInfos))
+	hashes := make([][]byte, len(proof.StoreInfos))
+	for i, si := range proof.StoreInfos {
+		names[i] = si.Name
+		hashes[i] = sum(si.Core.CommitID.Hash)
+	}
+	return simpleHashFromPairs(names, hashes)
+}
+
+// MultiStoreProofOp proves the hash of the substore in the multistore.
+type MultiStoreProofOp struct {
+	// Encoded in ProofOp.Key
+	key []byte
+
+	// To encode in ProofOp.Data.
+	Proof *MultiStoreProof `json:"proof"`
+}
+
+func MultiStoreProofOpDecoder(pop ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpMultiStore {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpMultiStore)
+	}
+	var op MultiStoreProofOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into MultiStoreProofOp: %v", err)
+	}
+	if op.Proof == nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into MultiStoreProofOp: proof is nil")
+	}
+	return MultiStoreProofOp{key: pop.Key, Proof: op.Proof}, nil
+}
+
+// Run returns the app hash if the value is the commit hash of the substore.
+func (op MultiStoreProofOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("value size is not 1")
+	}
+	value := args[0]
+	root := op.Proof.ComputeRootHash()
+	for _, si := range op.Proof.StoreInfos {
+		if si.Name == string(op.key) {
+			if bytes.Equal(value, si.Core.CommitID.Hash) {
+				return [][]byte{root}, nil
+			}
+			return nil, fmt.Errorf("hash mismatch for substore %v: %X vs %X", si.Name, si.Core.CommitID.Hash, value)
+		}
+	}
+	return nil, fmt.Errorf("key %v not found in multistore proof", op.key)
+}
+
+func (op MultiStoreProofOp) GetKey() []byte {
+	return op.key
+}
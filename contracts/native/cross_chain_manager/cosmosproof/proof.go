@@ -0,0 +1,185 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package cosmosproof is the minimal proof runtime to verify the merkle proofs of cosmos chains,
+// it's the same as `rootmulti.DefaultProofRuntime()` of cosmos-sdk v0.39 with the `simple:v`,
+// `iavl:v`, `iavl:a` and `multistore` operators. The runtime is kept inside the native contracts,
+// so that the upgrades of cosmos-sdk, iavl and tendermint for the source chains never change the
+// proof verification in the consensus path.
+//
+// The code is ported from tendermint v0.33.7 (crypto/merkle), iavl v0.14.0 and cosmos-sdk
+// v0.39.1 (store/rootmulti), which are licensed under the Apache License 2.0. The amino encoding
+// of the proofs is unchanged.
+package cosmosproof
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	amino "github.com/tendermint/go-amino"
+)
+
+var cdc = amino.NewCodec()
+
+// ProofOp is an operation of the proof, the amino encoding is the same as `merkle.ProofOp`.
+type ProofOp struct {
+	Type string
+	Key  []byte
+	Data []byte
+}
+
+// Proof is the amino encoded proof of relayers, the same as `merkle.Proof`.
+type Proof struct {
+	Ops []ProofOp
+}
+
+// ProofOperator runs a layer of the proof, it takes the value or the root hash of the previous
+// layer and returns the root hash of the layer.
+type ProofOperator interface {
+	Run([][]byte) ([][]byte, error)
+	GetKey() []byte
+}
+
+// ProofOperators are the decoded layers of a proof, from the leaf to the root.
+type ProofOperators []ProofOperator
+
+// Verify runs the operators in order, the key of each operator must match the key path from the
+// end, and the key path must be consumed all.
+func (poz ProofOperators) Verify(root []byte, keypath string, args [][]byte) error {
+	keys, err := KeyPathToKeys(keypath)
+	if err != nil {
+		return err
+	}
+	if len(poz) == 0 {
+		return fmt.Errorf("proof has no operation")
+	}
+	for i, op := range poz {
+		key := op.GetKey()
+		if len(key) != 0 {
+			if len(keys) == 0 {
+				return fmt.Errorf("key path has insufficient # of parts: expected no more keys but got %+v", string(key))
+			}
+			lastKey := keys[len(keys)-1]
+			if !bytes.Equal(lastKey, key) {
+				return fmt.Errorf("key mismatch on operation #%d: expected %+v but got %+v", i, string(lastKey), string(key))
+			}
+			keys = keys[:len(keys)-1]
+		}
+		if args, err = op.Run(args); err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(root, args[0]) {
+		return fmt.Errorf("calculated root hash is invalid: expected %X but got %X", root, args[0])
+	}
+	if len(keys) != 0 {
+		return fmt.Errorf("keypath not consumed all")
+	}
+	return nil
+}
+
+// OpDecoder decodes the operator of a proof type.
+type OpDecoder func(ProofOp) (ProofOperator, error)
+
+type ProofRuntime struct {
+	decoders map[string]OpDecoder
+}
+
+func NewProofRuntime() *ProofRuntime {
+	return &ProofRuntime{decoders: make(map[string]OpDecoder)}
+}
+
+func (prt *ProofRuntime) RegisterOpDecoder(typ string, dec OpDecoder) {
+	if _, ok := prt.decoders[typ]; ok {
+		panic("already registered for type " + typ)
+	}
+	prt.decoders[typ] = dec
+}
+
+func (prt *ProofRuntime) DecodeProof(proof *Proof) (ProofOperators, error) {
+	poz := make(ProofOperators, 0, len(proof.Ops))
+	for _, pop := range proof.Ops {
+		decoder := prt.decoders[pop.Type]
+		if decoder == nil {
+			return nil, fmt.Errorf("unrecognized proof type %v", pop.Type)
+		}
+		operator, err := decoder(pop)
+		if err != nil {
+			return nil, fmt.Errorf("decoding a proof operator: %v", err)
+		}
+		poz = append(poz, operator)
+	}
+	return poz, nil
+}
+
+// VerifyValue verifies the value at the key path against the root.
+func (prt *ProofRuntime) VerifyValue(proof *Proof, root []byte, keypath string, value []byte) error {
+	return prt.verify(proof, root, keypath, [][]byte{value})
+}
+
+// VerifyAbsence verifies there is no value at the key path against the root.
+func (prt *ProofRuntime) VerifyAbsence(proof *Proof, root []byte, keypath string) error {
+	return prt.verify(proof, root, keypath, nil)
+}
+
+func (prt *ProofRuntime) verify(proof *Proof, root []byte, keypath string, args [][]byte) error {
+	poz, err := prt.DecodeProof(proof)
+	if err != nil {
+		return fmt.Errorf("decoding proof: %v", err)
+	}
+	return poz.Verify(root, keypath, args)
+}
+
+// DefaultProofRuntime returns the runtime of the multistore proofs of cosmos-sdk.
+func DefaultProofRuntime() *ProofRuntime {
+	prt := NewProofRuntime()
+	prt.RegisterOpDecoder(ProofOpSimpleValue, SimpleValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpIAVLValue, ValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpIAVLAbsence, AbsenceOpDecoder)
+	prt.RegisterOpDecoder(ProofOpMultiStore, MultiStoreProofOpDecoder)
+	return prt
+}
+
+// KeyPathToKeys decodes the key path, e.g. `/ccm/x:0102`, the path must begin with a `/`, and a
+// part is hex encoded if it's prefixed by `x:`, url encoded otherwise.
+func KeyPathToKeys(path string) ([][]byte, error) {
+	if path == "" || path[0] != '/' {
+		return nil, fmt.Errorf("key path string must start with a forward slash '/'")
+	}
+	parts := strings.Split(path[1:], "/")
+	keys := make([][]byte, len(parts))
+	for i, part := range parts {
+		if strings.HasPrefix(part, "x:") {
+			key, err := hex.DecodeString(part[2:])
+			if err != nil {
+				return nil, fmt.Errorf("decoding hex-encoded part #%d: /%s: %v", i, part, err)
+			}
+			keys[i] = key
+		} else {
+			key, err := url.PathUnescape(part)
+			if err != nil {
+				return nil, fmt.Errorf("decoding url-encoded part #%d: /%s: %v", i, part, err)
+			}
+			keys[i] = []byte(key)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cosmosproof
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// upstreamCase is a proof generated by cosmos-sdk, verified by both runtimes.
+type upstreamCase struct {
+	name    string
+	proof   []byte
+	root    []byte
+	keypath string
+	value   []byte // nil for absence
+}
+
+func newUpstreamCases(t *testing.T) []*upstreamCase {
+	keys := []*storetypes.KVStoreKey{storetypes.NewKVStoreKey("acc"), storetypes.NewKVStoreKey("ccm"), storetypes.NewKVStoreKey("evm")}
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	assert.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 20; i++ {
+		ms.GetKVStore(keys[1]).Set([]byte{byte(i*2 + 2)}, []byte(fmt.Sprintf("value-%d", i)))
+	}
+	ms.GetKVStore(keys[0]).Set([]byte("a"), []byte("b"))
+	commit := ms.Commit()
+
+	cdc := codec.New()
+	var cases []*upstreamCase
+	query := func(name string, key []byte, value []byte) {
+		res := ms.Query(abci.RequestQuery{Path: "/ccm/key", Data: key, Height: commit.Version, Prove: true})
+		assert.Zero(t, res.Code, res.Log)
+		proof, err := cdc.MarshalBinaryBare(res.Proof)
+		assert.NoError(t, err)
+		kp := merkle.KeyPath{}.AppendKey([]byte("ccm"), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex)
+		cases = append(cases, &upstreamCase{name: name, proof: proof, root: commit.Hash, keypath: kp.String(), value: value})
+	}
+	query("first", []byte{2}, []byte("value-0"))
+	query("middle", []byte{22}, []byte("value-10"))
+	query("last", []byte{40}, []byte("value-19"))
+	query("absent before all", []byte{1}, nil)
+	query("absent between", []byte{23}, nil)
+	query("absent after all", []byte{99}, nil)
+
+	// the simple value proof of tendermint
+	root, proofs, _ := merkle.SimpleProofsFromMap(map[string][]byte{"x": []byte("1"), "y": []byte("2"), "z": []byte("3")})
+	simple, err := cdc.MarshalBinaryBare(&merkle.Proof{Ops: []merkle.ProofOp{merkle.NewSimpleValueOp([]byte("y"), proofs["y"]).ProofOp()}})
+	assert.NoError(t, err)
+	cases = append(cases, &upstreamCase{name: "simple", proof: simple, root: root, keypath: "/y", value: []byte("2")})
+	return cases
+}
+
+func verifyUpstream(c *upstreamCase, value []byte, root []byte) error {
+	proof := new(merkle.Proof)
+	if err := codec.New().UnmarshalBinaryBare(c.proof, proof); err != nil {
+		return err
+	}
+	if value == nil {
+		return rootmulti.DefaultProofRuntime().VerifyAbsence(proof, root, c.keypath)
+	}
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof, root, c.keypath, value)
+}
+
+func verify(c *upstreamCase, value []byte, root []byte) error {
+	proof := new(Proof)
+	if err := cdc.UnmarshalBinaryBare(c.proof, proof); err != nil {
+		return err
+	}
+	if value == nil {
+		return DefaultProofRuntime().VerifyAbsence(proof, root, c.keypath)
+	}
+	return DefaultProofRuntime().VerifyValue(proof, root, c.keypath, value)
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmosproof -run TestUpstreamProofs
+func TestUpstreamProofs(t *testing.T) {
+	for _, c := range newUpstreamCases(t) {
+		assert.NoError(t, verifyUpstream(c, c.value, c.root), c.name)
+		assert.NoError(t, verify(c, c.value, c.root), c.name)
+
+		// the runtimes agree on the tampered roots and values
+		root := append([]byte{}, c.root...)
+		root[0] ^= 1
+		assert.Error(t, verifyUpstream(c, c.value, root), c.name)
+		assert.Error(t, verify(c, c.value, root), c.name)
+		if c.value != nil {
+			value := append(c.value, 0)
+			assert.Error(t, verifyUpstream(c, value, c.root), c.name)
+			assert.Error(t, verify(c, value, c.root), c.name)
+		}
+	}
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmosproof -run TestMalformedProofs
+func TestMalformedProofs(t *testing.T) {
+	prt := DefaultProofRuntime()
+	assert.Error(t, prt.VerifyAbsence(&Proof{}, nil, "/ccm/x:02"))
+	assert.Error(t, prt.VerifyValue(&Proof{Ops: []ProofOp{{Type: "unknown"}}}, nil, "/ccm", nil))
+	assert.Error(t, prt.VerifyValue(&Proof{Ops: []ProofOp{{Type: ProofOpIAVLValue, Data: []byte{1}}}}, nil, "/ccm", nil))
+
+	c := newUpstreamCases(t)[0]
+	proof := new(Proof)
+	assert.NoError(t, cdc.UnmarshalBinaryBare(c.proof, proof))
+	assert.Error(t, prt.VerifyValue(proof, c.root, "ccm/x:02", c.value))
+	assert.Error(t, prt.VerifyValue(proof, c.root, "/evm/x:02", c.value))
+	assert.Error(t, prt.VerifyValue(proof, c.root, "/x:02", c.value))
+	assert.Error(t, prt.VerifyValue(proof, c.root, "/a/ccm/x:02", c.value))
+	assert.NoError(t, prt.VerifyValue(proof, c.root, "/ccm/x:02", c.value))
+}
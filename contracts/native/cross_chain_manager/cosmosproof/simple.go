@@ -0,0 +1,195 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cosmosproof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	amino "github.com/tendermint/go-amino"
+)
+
+// ProofOpSimpleValue is the type of the value in a simple merkle tree of tendermint.
+const ProofOpSimpleValue = "simple:v"
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+)
+
+func leafHash(leaf []byte) []byte {
+	h := sha256.Sum256(append(leafPrefix, leaf...))
+	return h[:]
+}
+
+func innerHash(left []byte, right []byte) []byte {
+	h := sha256.Sum256(append(innerPrefix, append(left, right...)...))
+	return h[:]
+}
+
+func sum(bz []byte) []byte {
+	h := sha256.Sum256(bz)
+	return h[:]
+}
+
+// getSplitPoint returns the largest power of 2 less than length.
+func getSplitPoint(length int) int {
+	if length < 1 {
+		panic("Trying to split a tree with size < 1")
+	}
+	k := 1 << uint(bits.Len(uint(length))-1)
+	if k == length {
+		k >>= 1
+	}
+	return k
+}
+
+func simpleHashFromByteSlices(items [][]byte) []byte {
+	switch len(items) {
+	case 0:
+		return nil
+	case 1:
+		return leafHash(items[0])
+	default:
+		k := getSplitPoint(len(items))
+		return innerHash(simpleHashFromByteSlices(items[:k]), simpleHashFromByteSlices(items[k:]))
+	}
+}
+
+// kvPair is a leaf of the simple map, the value is the hash of the value set.
+type kvPair struct {
+	key   []byte
+	value []byte
+}
+
+func (kv kvPair) bytes() []byte {
+	var b bytes.Buffer
+	if err := amino.EncodeByteSlice(&b, kv.key); err != nil {
+		panic(err)
+	}
+	if err := amino.EncodeByteSlice(&b, kv.value); err != nil {
+		panic(err)
+	}
+	return b.Bytes()
+}
+
+// simpleHashFromPairs returns the root of the simple map, the pairs are sorted by key and the
+// value of a duplicated key is overridden by the later one, the same as `merkle.SimpleHashFromMap`.
+func simpleHashFromPairs(keys []string, values [][]byte) []byte {
+	index := make(map[string]int, len(keys))
+	kvs := make([]kvPair, 0, len(keys))
+	for i, key := range keys {
+		kv := kvPair{key: []byte(key), value: sum(values[i])}
+		if j, ok := index[key]; ok {
+			kvs[j] = kv
+			continue
+		}
+		index[key] = len(kvs)
+		kvs = append(kvs, kv)
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return bytes.Compare(kvs[i].key, kvs[j].key) < 0
+	})
+	items := make([][]byte, len(kvs))
+	for i, kv := range kvs {
+		items[i] = kv.bytes()
+	}
+	return simpleHashFromByteSlices(items)
+}
+
+// SimpleProof is the inclusion proof of a simple merkle tree.
+type SimpleProof struct {
+	Total    int      `json:"total"`     // Total number of items.
+	Index    int      `json:"index"`     // Index of item to prove.
+	LeafHash []byte   `json:"leaf_hash"` // Hash of item value.
+	Aunts    [][]byte `json:"aunts"`     // Hashes from leaf's sibling to a root's child.
+}
+
+// ComputeRootHash returns the root hash of the leaf hash, or nil if the proof is malformed.
+func (sp *SimpleProof) ComputeRootHash() []byte {
+	return computeHashFromAunts(sp.Index, sp.Total, sp.LeafHash, sp.Aunts)
+}
+
+func computeHashFromAunts(index int, total int, leafHash []byte, innerHashes [][]byte) []byte {
+	if index >= total || index < 0 || total <= 0 {
+		return nil
+	}
+	if total == 1 {
+		if len(innerHashes) != 0 {
+			return nil
+		}
+		return leafHash
+	}
+	if len(innerHashes) == 0 {
+		return nil
+	}
+	numLeft := getSplitPoint(total)
+	if index < numLeft {
+		leftHash := computeHashFromAunts(index, numLeft, leafHash, innerHashes[:len(innerHashes)-1])
+		if leftHash == nil {
+			return nil
+		}
+		return innerHash(leftHash, innerHashes[len(innerHashes)-1])
+	}
+	rightHash := computeHashFromAunts(index-numLeft, total-numLeft, leafHash, innerHashes[:len(innerHashes)-1])
+	if rightHash == nil {
+		return nil
+	}
+	return innerHash(innerHashes[len(innerHashes)-1], rightHash)
+}
+
+// SimpleValueOp proves the value of the key in a simple map.
+type SimpleValueOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data
+	Proof *SimpleProof `json:"simple_proof"`
+}
+
+func SimpleValueOpDecoder(pop ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpSimpleValue {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpSimpleValue)
+	}
+	var op SimpleValueOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into SimpleValueOp: %v", err)
+	}
+	if op.Proof == nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into SimpleValueOp: proof is nil")
+	}
+	return SimpleValueOp{key: pop.Key, Proof: op.Proof}, nil
+}
+
+func (op SimpleValueOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected 1 arg, got %v", len(args))
+	}
+	kvhash := leafHash(kvPair{key: op.key, value: sum(args[0])}.bytes())
+	if !bytes.Equal(kvhash, op.Proof.LeafHash) {
+		return nil, fmt.Errorf("leaf hash mismatch: want %X got %X", op.Proof.LeafHash, kvhash)
+	}
+	return [][]byte{op.Proof.ComputeRootHash()}, nil
+}
+
+func (op SimpleValueOp) GetKey() []byte {
+	return op.key
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// DeliveryReceiptMethod is the method of the cross chain message which the destination chain
+// sends back to the cross chain manager contract once a zion originated message is executed,
+// the args of the receipt is the tx hash of the merkle value of the delivered message.
+const DeliveryReceiptMethod = "deliveryReceipt"
+
+// ConfirmDelivery verifies the delivery receipt from the destination chain with its chain handler,
+// and marks the outbound message delivered, or failed by the receipt of `DeliveryFailureMethod`.
+// `confirmDelivery` shares the inputs of `importOuterTransfer`, so that the chain handlers are able
+// to read the params from the payload as they are. the callback of sender is notified in sandbox.
+func ConfirmDelivery(native *native.NativeContract) ([]byte, error) {
+	if !native.ContractRef().IsCrossChainV2() {
+		return nil, fmt.Errorf("ConfirmDelivery, cross chain v2 not activated")
+	}
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.EntranceParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodConfirmDelivery, params, ctx.Payload); err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidParam, "ConfirmDelivery, unpack params error: %v", err)
+	}
+
+	txParam, err := verifySourceTx(native, params)
+	if err != nil {
+		return nil, scom.AsImportError(err)
+	}
+	if !bytes.Equal(txParam.ToContractAddress, this[:]) || (txParam.Method != DeliveryReceiptMethod && txParam.Method != DeliveryFailureMethod) {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "ConfirmDelivery, cross chain message is not a delivery receipt")
+	}
+
+	chainID := params.SourceChainID
+	seq, found, err := GetOutboundSequence(native, chainID, txParam.Args)
+	if err != nil {
+		return nil, fmt.Errorf("ConfirmDelivery, GetOutboundSequence error: %v", err)
+	}
+	if !found {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "ConfirmDelivery, outbound message %x to chain %d not found", txParam.Args, chainID)
+	}
+	state, err := GetOutboundState(native, chainID, seq)
+	if err != nil {
+		return nil, fmt.Errorf("ConfirmDelivery, GetOutboundState error: %v", err)
+	}
+	if state != StateAccepted {
+		return nil, scom.NewImportError(scom.ErrCodeTxAlreadyDone, "ConfirmDelivery, outbound message %d to chain %d is already %s", seq, chainID, state)
+	}
+	outbound, err := getOutboundTxParam(native, chainID, seq)
+	if err != nil {
+		return nil, fmt.Errorf("ConfirmDelivery, %v", err)
+	}
+
+	event := scom.NOTIFY_DELIVERY_EVENT
+	state = StateDelivered
+	if txParam.Method == DeliveryFailureMethod {
+		putOutboundHeight(native, scom.DELIVERY_FAILED, chainID, seq)
+		event, state = scom.NOTIFY_DELIVERY_FAILED_EVENT, StateFailed
+	} else {
+		PutDelivery(native, chainID, seq)
+	}
+	if err := native.AddCrossChainNotify(scom.ABI, []string{event}, chainID, seq, txParam.Args); err != nil {
+		return nil, fmt.Errorf("ConfirmDelivery, AddNotify error: %v", err)
+	}
+	if err := notifyOutbound(native, chainID, seq, outbound, state, true); err != nil {
+		return nil, fmt.Errorf("ConfirmDelivery, %v", err)
+	}
+	if err := updateBatch(native, chainID, seq, state); err != nil {
+		return nil, fmt.Errorf("ConfirmDelivery, %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodConfirmDelivery, true)
+}
+
+// PutDelivery marks the outbound message delivered at the current block height.
+func PutDelivery(native *native.NativeContract, chainID, seq uint64) {
+	putOutboundHeight(native, scom.DELIVERED, chainID, seq)
+}
+
+// GetDelivery returns whether the outbound message is delivered and the block height of the confirmation.
+func GetDelivery(native *native.NativeContract, chainID, seq uint64) (bool, uint64, error) {
+	delivered, height, err := getOutboundHeight(native, scom.DELIVERED, chainID, seq)
+	if err != nil {
+		return false, 0, fmt.Errorf("GetDelivery, %v", err)
+	}
+	return delivered, height, nil
+}
+
+// DeliveryStatus is the delivery status of an outbound message.
+type DeliveryStatus struct {
+	Message     *scom.OutboundMessage
+	Delivered   bool
+	BlockHeight uint64 // block height of the delivery confirmation
+	State       LifecycleState
+}
+
+// GetDeliveryStatus reads the delivery status of the outbound message from the given state,
+// nil is returned if the message doesn't exist.
+func GetDeliveryStatus(db *state.StateDB, chainID, seq uint64) (*DeliveryStatus, error) {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big0, common.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	msg, err := GetOutbound(s, chainID, seq)
+	if err != nil || msg == nil {
+		return nil, err
+	}
+	delivered, height, err := GetDelivery(s, chainID, seq)
+	if err != nil {
+		return nil, err
+	}
+	state, err := GetOutboundState(s, chainID, seq)
+	if err != nil {
+		return nil, err
+	}
+	return &DeliveryStatus{Message: msg, Delivered: delivered, BlockHeight: height, State: state}, nil
+}
+
+// GetDeliveryBacklog reads the size of outbound queue of the target chain from the given state,
+// and counts the messages neither confirmed delivered nor failed yet among the latest `limit`
+// ones, which are the messages that relayers keep retrying.
+func GetDeliveryBacklog(db *state.StateDB, chainID, limit uint64) (total, undelivered uint64, err error) {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big0, common.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	if total, err = GetOutboundCount(s, chainID); err != nil {
+		return 0, 0, err
+	}
+	for seq := total; seq > 0 && total-seq < limit; seq-- {
+		delivered, _, err := GetDelivery(s, chainID, seq-1)
+		if err != nil {
+			return 0, 0, err
+		}
+		failed, _, err := getOutboundHeight(s, scom.DELIVERY_FAILED, chainID, seq-1)
+		if err != nil {
+			return 0, 0, err
+		}
+		if !delivered && !failed {
+			undelivered++
+		}
+	}
+	return total, undelivered, nil
+}
@@ -19,8 +19,11 @@ package cross_chain_manager
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/bsc"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmos"
@@ -30,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/polygon"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/quorum"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/zilliqa"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -48,25 +52,96 @@ const (
 var (
 	this     = native.NativeContractAddrMap[native.NativeCrossChain]
 	gasTable = map[string]uint64{
-		scom.MethodContractName:        0,
-		scom.MethodImportOuterTransfer: 0,
-		scom.MethodMultiSign:           100000,
-		scom.MethodBlackChain:          0,
-		scom.MethodWhiteChain:          0,
+		scom.MethodContractName:         0,
+		scom.MethodImportOuterTransfer:  0,
+		scom.MethodMultiSign:            100000,
+		scom.MethodBlackChain:           0,
+		scom.MethodWhiteChain:           0,
+		scom.MethodSetCheckpointConfig:  100000,
+		scom.MethodSubmitCheckpoint:     100000,
+		scom.MethodCheckpointConfig:     0,
+		scom.MethodCheckpoint:           0,
+		scom.MethodConfirmDelivery:      0,
+		scom.MethodSetSourceAllowlist:   100000,
+		scom.MethodSourceAllowlist:      0,
+		scom.MethodSetEntranceWhitelist: 100000,
+		scom.MethodEntranceWhitelist:    0,
+		scom.MethodSetOutboundCallback:  30000,
+		scom.MethodOutboundCallback:     0,
+		scom.MethodRefundOutbound:       30000,
+		scom.MethodOutboundState:        0,
+		scom.MethodRefundBatch:          30000,
+		scom.MethodBatch:                0,
+		scom.MethodSetDualVerification:  100000,
+		scom.MethodDualVerification:     0,
+		scom.MethodAttest:               30000,
+		scom.MethodAttestations:         0,
+		scom.MethodSetProofMaxAge:       100000,
+		scom.MethodProofMaxAge:          0,
+		scom.MethodApproveStaleProof:    100000,
+		scom.MethodSetCodeHashPin:       100000,
+		scom.MethodCodeHashPin:          0,
+		scom.MethodImportRoot:           0,
+		scom.MethodImportProof:          0,
 	}
+
+	// the bounds of arguments checked before dispatching, the handlers still validate the values
+	hashRule      = &native.ArgRule{NonZero: true}
+	hashBytesRule = &native.ArgRule{NonZero: true, MaxBytes: common.HashLength}
+	bytesRule     = &native.ArgRule{NonZero: true}
+	addressRule   = &native.ArgRule{NonZero: true}
 )
 
 func InitCrossChainManager() {
+	native.RegisterABI(native.NativeCrossChain, "CrossChainManager", cross_chain_manager_abi.CrossChainManagerABI)
 	native.Contracts[this] = RegisterCrossChainManagerContract
+	native.RegisterCallerGuard(this, checkEntranceCaller)
+	native.RegisterCallerGuard(utils.HeaderSyncContractAddress, checkEntranceCaller)
 }
 
 func RegisterCrossChainManagerContract(s *native.NativeContract) {
 	s.Prepare(scom.ABI, gasTable)
 
-	s.Register(scom.MethodContractName, Name)
+	s.RegisterQuery(scom.MethodContractName, Name)
 	s.Register(scom.MethodImportOuterTransfer, ImportOuterTransfer)
+	s.Register(scom.MethodConfirmDelivery, ConfirmDelivery)
 	s.Register(scom.MethodBlackChain, BlackChain)
 	s.Register(scom.MethodWhiteChain, WhiteChain)
+	s.Register(scom.MethodSetCheckpointConfig, SetCheckpointConfig)
+	s.Register(scom.MethodSubmitCheckpoint, SubmitCheckpoint)
+	s.RegisterQuery(scom.MethodCheckpointConfig, CheckpointConfig)
+	s.RegisterQuery(scom.MethodCheckpoint, Checkpoint)
+	s.Register(scom.MethodSetSourceAllowlist, SetSourceAllowlist)
+	s.RegisterQuery(scom.MethodSourceAllowlist, SourceAllowlist)
+	s.Register(scom.MethodSetEntranceWhitelist, SetEntranceWhitelist)
+	s.RegisterQuery(scom.MethodEntranceWhitelist, EntranceWhitelist)
+	s.Register(scom.MethodSetOutboundCallback, SetOutboundCallback)
+	s.RegisterQuery(scom.MethodOutboundCallback, OutboundCallback)
+	s.Register(scom.MethodRefundOutbound, RefundOutbound)
+	s.RegisterQuery(scom.MethodOutboundState, OutboundState)
+	s.Register(scom.MethodRefundBatch, RefundBatch)
+	s.RegisterQuery(scom.MethodBatch, Batch)
+	s.Register(scom.MethodSetDualVerification, SetDualVerification)
+	s.RegisterQuery(scom.MethodDualVerification, DualVerification)
+	s.Register(scom.MethodAttest, Attest)
+	s.RegisterQuery(scom.MethodAttestations, Attestations)
+	s.Register(scom.MethodSetProofMaxAge, SetProofMaxAge)
+	s.RegisterQuery(scom.MethodProofMaxAge, ProofMaxAge)
+	s.Register(scom.MethodApproveStaleProof, ApproveStaleProof)
+	s.Register(scom.MethodSetCodeHashPin, SetCodeHashPin)
+	s.RegisterQuery(scom.MethodCodeHashPin, CodeHashPin)
+	s.RegisterQuery(scom.MethodImportRoot, ImportRoot)
+	s.RegisterQuery(scom.MethodImportProof, ImportProof)
+
+	s.RegisterRules(scom.MethodImportOuterTransfer, native.MethodRules{"Extra": bytesRule})
+	s.RegisterRules(scom.MethodConfirmDelivery, native.MethodRules{"Extra": bytesRule})
+	s.RegisterRules(scom.MethodSubmitCheckpoint, native.MethodRules{"BlockHash": hashBytesRule, "StateRoot": hashBytesRule})
+	s.RegisterRules(scom.MethodOutboundCallback, native.MethodRules{"Sender": addressRule})
+	s.RegisterRules(scom.MethodRefundBatch, native.MethodRules{"BatchID": hashRule})
+	s.RegisterRules(scom.MethodBatch, native.MethodRules{"BatchID": hashRule})
+	s.RegisterRules(scom.MethodAttest, native.MethodRules{"MessageHash": hashRule})
+	s.RegisterRules(scom.MethodAttestations, native.MethodRules{"MessageHash": hashRule})
+	s.RegisterRules(scom.MethodApproveStaleProof, native.MethodRules{"CrossChainID": bytesRule})
 }
 
 func GetChainHandler(router uint64) (scom.ChainHandler, error) {
@@ -97,69 +172,211 @@ func Name(s *native.NativeContract) ([]byte, error) {
 }
 
 func ImportOuterTransfer(native *native.NativeContract) ([]byte, error) {
-	ctx := native.ContractRef().CurrentContext()
-	params := &scom.EntranceParam{}
-	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	chainID, height := importSource(native)
+	shadow, err := side_chain_manager.IsShadowMode(native, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, IsShadowMode error: %v", err)
+	}
+	if shadow {
+		return shadowImport(native, chainID)
+	}
+	snapshot := native.StateDB().Snapshot()
+	err = importOuterTransfer(native)
+	markImport(chainID, err)
+	logImport(native, chainID, err)
+	if mismatch := new(scom.CodeHashMismatchError); errors.As(err, &mismatch) {
+		native.StateDB().RevertToSnapshot(snapshot)
+		return pauseOnCodeHashMismatch(native, height, mismatch)
+	}
+	if err != nil {
 		return nil, err
 	}
+	return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
+}
 
-	chainID := params.SourceChainID
-	blacked, err := CheckIfChainBlacked(native, chainID)
+// shadowImport verifies the import of the chain in shadow mode without executing it: the state
+// changed by the verification, e.g. the done tx, is reverted, so that the message is imported
+// again once the shadow mode ends. the result is metered, logged and emitted as an event, and the
+// import never fails, so that the verification failures are recorded on chain as well.
+func shadowImport(native *native.NativeContract, chainID uint64) ([]byte, error) {
+	snapshot := native.StateDB().Snapshot()
+	_, txParam, err := verifyImport(native)
+	native.StateDB().RevertToSnapshot(snapshot)
+	markShadowImport(chainID, err)
+
+	logger := utils.NewLogger(utils.LogModuleCrossChain, "chainID", chainID, "txHash", native.ContractRef().TxHash())
+	var crossChainID []byte
 	if err != nil {
-		return nil, fmt.Errorf("ImportExTransfer, CheckIfChainBlacked error: %v", err)
+		logger.Info("Shadow import failed", "code", scom.ErrorCodeOf(err), "err", err)
+	} else {
+		crossChainID = txParam.CrossChainID
+		logger.Info("Shadow import verified", "crossChainID", hex.EncodeToString(crossChainID))
 	}
-	if blacked {
-		return nil, fmt.Errorf("ImportExTransfer, source chain is blacked")
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_SHADOW_IMPORT_EVENT}, chainID, crossChainID, err == nil); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, AddNotify error: %v", err)
 	}
+	return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
+}
 
-	//check if chainid exist
-	sideChain, err := side_chain_manager.GetSideChain(native, chainID)
+// importSource returns the source chain and the proof height of `importOuterTransfer`, the input
+// failed to decode is reported under chain 0.
+func importSource(native *native.NativeContract) (uint64, uint64) {
+	params, err := scom.UnpackEntranceParam(native.ContractRef().CurrentContext().Payload)
 	if err != nil {
-		return nil, fmt.Errorf("ImportExTransfer, side_chain_manager.GetSideChain error: %v", err)
+		return 0, 0
 	}
-	if sideChain == nil {
-		return nil, fmt.Errorf("ImportExTransfer, side chain %d is not registered", chainID)
+	return params.SourceChainID, uint64(params.Height)
+}
+
+// logImport logs the result of `importOuterTransfer` tagged by the source chain, so that the
+// imports of a single chain are raised by e.g. `--log.tag cross_chain/2=4`.
+func logImport(native *native.NativeContract, chainID uint64, err error) {
+	logger := utils.NewLogger(utils.LogModuleCrossChain, "chainID", chainID, "txHash", native.ContractRef().TxHash())
+	if err != nil {
+		logger.Debug("Import outer transfer failed", "code", scom.ErrorCodeOf(err), "err", err)
+		return
 	}
+	logger.Trace("Import outer transfer succeeded")
+}
 
-	handler, err := GetChainHandler(sideChain.Router)
+func importOuterTransfer(native *native.NativeContract) error {
+	params, txParam, err := verifyImport(native)
 	if err != nil {
-		return nil, err
+		return scom.AsImportError(err)
+	}
+
+	// messages targeting zion are executed locally, and the others are relayed
+	if isLocalChain(native, txParam.ToChainID) {
+		if err := ExecuteInbound(native, params.SourceChainID, txParam); err != nil {
+			return scom.AsImportError(err)
+		}
+	} else {
+		//NOTE, you need to store the tx in this
+		if err := MakeTransaction(native, txParam, params.SourceChainID); err != nil {
+			return err
+		}
+	}
+	return recordImport(native, params.SourceChainID, txParam)
+}
+
+// verifyImport verifies the cross chain message of `importOuterTransfer`, which includes the
+// header lookup, proof verifying and done tx checking, and returns the target chain tx param.
+func verifyImport(native *native.NativeContract) (*scom.EntranceParam, *scom.MakeTxParam, error) {
+	params, err := scom.UnpackEntranceParam(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return nil, nil, err
 	}
+
 	//1. verify tx
-	txParam, err := handler.MakeDepositProposal(native)
+	txParam, err := verifySourceTx(native, params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	//2. make target chain tx
 	targetid := txParam.ToChainID
-	blacked, err = CheckIfChainBlacked(native, targetid)
+	blacked, err := CheckIfChainBlacked(native, targetid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ImportExTransfer, CheckIfChainBlacked error: %v", err)
+	}
+	if blacked {
+		return nil, nil, scom.NewImportError(scom.ErrCodeChainBlacked, "ImportExTransfer, target chain is blacked")
+	}
+	if isLocalChain(native, targetid) {
+		if !native.ContractRef().IsCrossChainV2() {
+			return nil, nil, scom.NewImportError(scom.ErrCodeUnsupportedChain, "ImportExTransfer, messages to zion are not supported before cross chain v2")
+		}
+		return params, txParam, nil
+	}
+
+	//check if chainid exist
+	sideChain, err := side_chain_manager.GetSideChain(native, targetid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ImportExTransfer, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, nil, scom.NewImportError(scom.ErrCodeChainNotRegistered, "ImportExTransfer, side chain %d is not registered", targetid)
+	}
+	if sideChain.Router == utils.BTC_ROUTER {
+		return nil, nil, scom.NewImportError(scom.ErrCodeUnsupportedChain, "btc is not supported")
+	}
+	return params, txParam, nil
+}
+
+// verifySourceTx verifies the cross chain message from the source chain with the chain handler,
+// and checks the source contract of the message against the allowlist of the source chain. the
+// message must be bound to zion since cross chain v2, and is also required to be attested if the
+// dual verification of source chain is enabled, and the proof must not be older than the max age
+// of source chain unless approved.
+func verifySourceTx(native *native.NativeContract, params *scom.EntranceParam) (*scom.MakeTxParam, error) {
+	chainID := params.SourceChainID
+	blacked, err := CheckIfChainBlacked(native, chainID)
 	if err != nil {
 		return nil, fmt.Errorf("ImportExTransfer, CheckIfChainBlacked error: %v", err)
 	}
 	if blacked {
-		return nil, fmt.Errorf("ImportExTransfer, target chain is blacked")
+		return nil, scom.NewImportError(scom.ErrCodeChainBlacked, "ImportExTransfer, source chain is blacked")
 	}
 
 	//check if chainid exist
-	sideChain, err = side_chain_manager.GetSideChain(native, targetid)
+	sideChain, err := side_chain_manager.GetSideChain(native, chainID)
 	if err != nil {
 		return nil, fmt.Errorf("ImportExTransfer, side_chain_manager.GetSideChain error: %v", err)
 	}
 	if sideChain == nil {
-		return nil, fmt.Errorf("ImportExTransfer, side chain %d is not registered", targetid)
+		return nil, scom.NewImportError(scom.ErrCodeChainNotRegistered, "ImportExTransfer, side chain %d is not registered", chainID)
 	}
-	if sideChain.Router == utils.BTC_ROUTER {
-		return nil, fmt.Errorf("btc is not supported")
+
+	handler, err := GetChainHandler(sideChain.Router)
+	if err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeUnsupportedChain, "ImportExTransfer, %v", err)
+	}
+	start := time.Now() // nativecheck:ignore metrics only, not part of the state
+	txParam, err := handler.MakeDepositProposal(native)
+	updateVerifyTimer(chainID, start)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRelayChain(native, txParam); err != nil {
+		return nil, err
 	}
 
-	//NOTE, you need to store the tx in this
-	err = MakeTransaction(native, txParam, chainID)
+	// the proof only shows the message is emitted by the CCMC, any contract is able to call it
+	allowlist, err := GetSourceAllowlist(native, chainID)
 	if err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, GetSourceAllowlist error: %v", err)
+	}
+	if !allowlist.Allows(txParam.FromContractAddress) {
+		return nil, scom.NewImportError(scom.ErrCodeSourceNotAllowed, "ImportExTransfer, source contract %x of chain %d is not allowed",
+			txParam.FromContractAddress, chainID)
+	}
+	if err := checkAttestation(native, chainID, txParam); err != nil {
 		return nil, err
 	}
+	if err := checkProofAge(native, chainID, uint64(params.Height), txParam); err != nil {
+		return nil, err
+	}
+	return txParam, nil
+}
 
-	return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
+// checkRelayChain requires the message to embed the chain id of zion since cross chain v2, so
+// that the message sent to another relay chain by the same CCMC is not able to be replayed.
+func checkRelayChain(native *native.NativeContract, txParam *scom.MakeTxParam) error {
+	if !native.ContractRef().IsCrossChainV2() {
+		return nil
+	}
+	localID, ok := LocalChainID(native)
+	if !ok {
+		return nil
+	}
+	if txParam.RelayChainID == 0 {
+		return scom.NewImportError(scom.ErrCodeWrongRelayChain, "ImportExTransfer, message is not bound to relay chain")
+	}
+	if txParam.RelayChainID != localID {
+		return scom.NewImportError(scom.ErrCodeWrongRelayChain, "ImportExTransfer, message is bound to relay chain %d, not %d",
+			txParam.RelayChainID, localID)
+	}
+	return nil
 }
 
 func MakeTransaction(service *native.NativeContract, params *scom.MakeTxParam, fromChainID uint64) error {
@@ -180,6 +397,22 @@ func MakeTransaction(service *native.NativeContract, params *scom.MakeTxParam, f
 	chainIDBytes := utils.GetUint64Bytes(params.ToChainID)
 	key := hex.EncodeToString(utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(scom.REQUEST), chainIDBytes, merkleValue.TxHash))
 	scom.NotifyMakeProof(service, hex.EncodeToString(sink.Bytes()), key)
+
+	// the outbound queue and the lifecycle callbacks are introduced by cross chain v2
+	if !service.ContractRef().IsCrossChainV2() {
+		return nil
+	}
+	seq, err := PushOutbound(service, params.ToChainID, merkleValue.TxHash, &scom.OutboundMessage{
+		Height:      service.ContractRef().BlockHeight().Uint64(),
+		Key:         key,
+		MerkleValue: sink.Bytes(),
+	})
+	if err != nil {
+		return fmt.Errorf("MakeTransaction, pushOutbound error:%s", err)
+	}
+	if err := notifyOutbound(service, params.ToChainID, seq, params, StateAccepted, true); err != nil {
+		return fmt.Errorf("MakeTransaction, %v", err)
+	}
 	return nil
 }
 
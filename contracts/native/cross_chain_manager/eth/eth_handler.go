@@ -23,7 +23,6 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
-	"github.com/ethereum/go-ethereum/contracts/native/utils"
 )
 
 type ETHHandler struct {
@@ -34,23 +33,22 @@ func NewETHHandler() *ETHHandler {
 }
 
 func (this *ETHHandler) MakeDepositProposal(service *native.NativeContract) (*scom.MakeTxParam, error) {
-	ctx := service.ContractRef().CurrentContext()
-	params := &scom.EntranceParam{}
-	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := scom.UnpackEntranceParam(service.ContractRef().CurrentContext().Payload)
+	if err != nil {
 		return nil, err
 	}
 
-	sideChain, err := side_chain_manager.GetSideChain(service, params.SourceChainID)
+	sideChain, err := side_chain_manager.GetSideChainAt(service, params.SourceChainID, uint64(params.Height))
 	if err != nil {
-		return nil, fmt.Errorf("eth MakeDepositProposal, side_chain_manager.GetSideChain error: %v", err)
+		return nil, fmt.Errorf("eth MakeDepositProposal, side_chain_manager.GetSideChainAt error: %v", err)
 	}
 
 	value, err := verifyFromEthTx(service, params.Proof, params.Extra, params.SourceChainID, params.Height, sideChain)
 	if err != nil {
-		return nil, fmt.Errorf("eth MakeDepositProposal, verifyFromEthTx error: %s", err)
+		return nil, fmt.Errorf("eth MakeDepositProposal, verifyFromEthTx error: %w", err)
 	}
 	if err := scom.CheckDoneTx(service, value.CrossChainID, params.SourceChainID); err != nil {
-		return nil, fmt.Errorf("eth MakeDepositProposal, check done transaction error:%s", err)
+		return nil, fmt.Errorf("eth MakeDepositProposal, check done transaction error:%w", err)
 	}
 	if err := scom.PutDoneTx(service, value.CrossChainID, params.SourceChainID); err != nil {
 		return nil, fmt.Errorf("eth MakeDepositProposal, PutDoneTx error:%s", err)
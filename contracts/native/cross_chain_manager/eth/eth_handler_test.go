@@ -0,0 +1,139 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package eth
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/handlertest"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hscom "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	hseth "github.com/ethereum/go-ethereum/contracts/native/header_sync/eth"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// go test -run ^$ -bench . -benchmem github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/eth
+
+const (
+	benchChainID = uint64(2)
+	benchHeight  = uint32(100)
+)
+
+var benchCCMC = common.HexToAddress("0x1234")
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	hscom.ABI = hscom.GetABI()
+	side_chain_manager.ABI = side_chain_manager.GetABI()
+	os.Exit(m.Run())
+}
+
+type benchFixture struct {
+	env      *handlertest.Env
+	payloads [][]byte // importOuterTransfer input
+}
+
+// syncSource registers the source chain and syncs the header of the source state as genesis.
+func syncSource(t testing.TB, env *handlertest.Env, src *handlertest.EVMSource) {
+	env.RegisterSideChain(t, &side_chain_manager.SideChain{
+		ChainId:      benchChainID,
+		Router:       utils.ETH_ROUTER,
+		Name:         "eth",
+		BlocksToWait: 1,
+		CCMCAddress:  src.CCMC.Bytes(),
+	})
+	header, _ := json.Marshal(&hseth.Header{
+		Root:       src.Root,
+		Difficulty: big.NewInt(1),
+		Number:     big.NewInt(int64(benchHeight)),
+		Extra:      []byte{},
+	})
+	payload, err := utils.PackMethod(hscom.ABI, hscom.MethodSyncGenesisHeader, benchChainID, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hseth.NewETHHandler().SyncGenesisHeader(env.Contract(env.Validator, payload)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newBenchFixture prepares a synced source chain header, whose state contains the cross chain
+// messages of ccmc with the proofs.
+func newBenchFixture(b *testing.B, msgs int) *benchFixture {
+	src := handlertest.NewEVMSource(b, benchCCMC, 3, msgs)
+	f := &benchFixture{env: handlertest.NewEnv(b)}
+	syncSource(b, f.env, src)
+	for i, extra := range src.Extras {
+		payload, err := utils.PackMethod(scom.ABI, scom.MethodImportOuterTransfer, benchChainID, benchHeight, src.Proof(b, i), []byte{}, extra, []byte{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		f.payloads = append(f.payloads, payload)
+	}
+	return f
+}
+
+func benchmarkMakeDepositProposal(b *testing.B, cached bool) {
+	f := newBenchFixture(b, 16)
+	handler := NewETHHandler()
+	relayer := common.HexToAddress("0x3")
+	if cached {
+		if _, err := handler.MakeDepositProposal(f.env.Contract(relayer, f.payloads[0])); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		snapshot := f.env.DB.Snapshot()
+		if _, err := handler.MakeDepositProposal(f.env.Contract(relayer, f.payloads[1+i%(len(f.payloads)-1)])); err != nil {
+			b.Fatal(err)
+		}
+		f.env.DB.RevertToSnapshot(snapshot)
+	}
+}
+
+func BenchmarkMakeDepositProposal(b *testing.B) {
+	benchmarkMakeDepositProposal(b, false)
+}
+
+func BenchmarkMakeDepositProposalCached(b *testing.B) {
+	benchmarkMakeDepositProposal(b, true)
+}
+
+func TestHandlerConformance(t *testing.T) {
+	handlertest.TestHandlerSuite(t, func(t *testing.T) *handlertest.Fixture {
+		src := handlertest.NewEVMSource(t, benchCCMC, 3, 2)
+		env := handlertest.NewEnv(t)
+		syncSource(t, env, src)
+		return &handlertest.Fixture{
+			Env:     env,
+			Handler: NewETHHandler(),
+			Valid: &scom.EntranceParam{SourceChainID: benchChainID, Height: benchHeight,
+				Proof: src.Proof(t, 0), Extra: src.Extras[0]},
+			Message: src.Messages[0],
+			Unconfirmed: &scom.EntranceParam{SourceChainID: benchChainID, Height: benchHeight + 1,
+				Proof: src.Proof(t, 1), Extra: src.Extras[1]},
+		}
+	})
+}
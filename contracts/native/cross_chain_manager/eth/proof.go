@@ -18,13 +18,13 @@ package eth
 
 import (
 	"bytes"
-	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
 	"strings"
 
 	ethcomm "github.com/ethereum/go-ethereum/common"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -36,11 +36,7 @@ type Proof struct {
 	Args         []byte
 }
 
-type StorageProof struct {
-	Key   string   `json:"key"`
-	Value string   `json:"value"`
-	Proof []string `json:"proof"`
-}
+type StorageProof = scom.StorageProof
 
 type ETHProof struct {
 	Address       string         `json:"address"`
@@ -74,12 +70,12 @@ func (this *ETHProof) String() string {
 
 func MappingKeyAt(position1 string, position2 string) ([]byte, error) {
 
-	p1, err := hex.DecodeString(position1)
+	p1, err := scom.DecodeHex(position1)
 	if err != nil {
 		return nil, err
 	}
 
-	p2, err := hex.DecodeString(position2)
+	p2, err := scom.DecodeHex(position2)
 
 	if err != nil {
 		return nil, err
@@ -17,14 +17,7 @@
 package eth
 
 import (
-	"math/big"
-
-	"github.com/ethereum/go-ethereum/common"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 )
 
-type ProofAccount struct {
-	Nounce   *big.Int
-	Balance  *big.Int
-	Storage  common.Hash
-	Codehash common.Hash
-}
+type ProofAccount = scom.ProofAccount
@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// SetProofMaxAge validators set the max age of the proofs imported from the side chain, it takes
+// effect after the consensus signs reached quorum. a proof anchored to a header more than max age
+// blocks behind the latest synced header is rejected, unless the message is approved by
+// `approveStaleProof`, which prevents the replay of ancient proofs, e.g. signed by a compromised
+// validator set before the pruning policy changed. zero max age disables the check.
+func SetProofMaxAge(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetProofMaxAgeParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetProofMaxAge, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChain(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetProofMaxAge, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SetProofMaxAge, side chain %d is not registered", params.ChainID)
+	}
+
+	maxAge, err := GetProofMaxAge(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetProofMaxAge, GetProofMaxAge error: %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(maxAge.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSetProofMaxAge, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetProofMaxAge, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetProofMaxAge, true)
+	}
+
+	PutProofMaxAge(native, params.ChainID, &scom.ProofMaxAge{
+		MaxAge: params.MaxAge,
+		Nonce:  maxAge.Nonce + 1,
+	})
+	if err := native.AddNotify(scom.ABI, []string{scom.NOTIFY_PROOF_MAX_AGE_EVENT}, params.ChainID, params.MaxAge); err != nil {
+		return nil, fmt.Errorf("SetProofMaxAge, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetProofMaxAge, true)
+}
+
+func ProofMaxAge(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BlackChainParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodProofMaxAge, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	maxAge, err := GetProofMaxAge(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ProofMaxAge, GetProofMaxAge error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodProofMaxAge, maxAge.MaxAge)
+}
+
+// ApproveStaleProof validators approve the message of side chain to be imported with an expired
+// proof, it takes effect after the consensus signs reached quorum. the approval is consumed by the
+// import.
+func ApproveStaleProof(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.ApproveStaleProofParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodApproveStaleProof, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if len(params.CrossChainID) == 0 {
+		return nil, fmt.Errorf("ApproveStaleProof, cross chain id is empty")
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodApproveStaleProof, ctx.Payload, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("ApproveStaleProof, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodApproveStaleProof, true)
+	}
+
+	native.GetCacheDB().Put(staleProofKey(params.ChainID, params.CrossChainID), cstates.GenRawStorageItem([]byte{1}))
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_STALE_PROOF_EVENT}, params.ChainID, params.CrossChainID); err != nil {
+		return nil, fmt.Errorf("ApproveStaleProof, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodApproveStaleProof, true)
+}
+
+// checkProofAge rejects the proof at the height if it's older than the max age of source chain
+// and the message is not approved. the age is measured against the latest synced header, and the
+// check is skipped if the headers of the chain are never synced.
+func checkProofAge(native *native.NativeContract, chainID uint64, height uint64, txParam *scom.MakeTxParam) error {
+	maxAge, err := GetProofMaxAge(native, chainID)
+	if err != nil {
+		return fmt.Errorf("checkProofAge, GetProofMaxAge error: %v", err)
+	}
+	if maxAge.MaxAge == 0 {
+		return nil
+	}
+	status, err := hscommon.GetSyncStatus(native, chainID)
+	if err != nil {
+		return fmt.Errorf("checkProofAge, GetSyncStatus error: %v", err)
+	}
+	if status == nil || !maxAge.Expired(height, status.Height) {
+		return nil
+	}
+
+	key := staleProofKey(chainID, txParam.CrossChainID)
+	approved, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return fmt.Errorf("checkProofAge, get stale proof approval error: %v", err)
+	}
+	if approved == nil {
+		return scom.NewImportError(scom.ErrCodeProofExpired, "checkProofAge, proof at height %d of chain %d is older than %d blocks behind %d",
+			height, chainID, maxAge.MaxAge, status.Height)
+	}
+	native.GetCacheDB().Delete(key)
+	return nil
+}
+
+func staleProofKey(chainID uint64, crossChainID []byte) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(scom.STALE_PROOF), utils.GetUint64Bytes(chainID), crossChainID)
+}
+
+func PutProofMaxAge(native *native.NativeContract, chainID uint64, maxAge *scom.ProofMaxAge) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	maxAge.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.PROOF_MAX_AGE), utils.GetUint64Bytes(chainID)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetProofMaxAge returns a zero max age which disables the check if it's never set.
+func GetProofMaxAge(native *native.NativeContract, chainID uint64) (*scom.ProofMaxAge, error) {
+	contract := utils.CrossChainManagerContractAddress
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.PROOF_MAX_AGE), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("GetProofMaxAge, get max age store error: %v", err)
+	}
+	maxAge := new(scom.ProofMaxAge)
+	if store == nil {
+		return maxAge, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetProofMaxAge, deserialize from raw storage item err:%v", err)
+	}
+	if err := maxAge.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetProofMaxAge, deserialize max age error: %v", err)
+	}
+	return maxAge, nil
+}
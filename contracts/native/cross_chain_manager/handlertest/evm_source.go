@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package handlertest
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	polycomm "github.com/polynetwork/poly/common"
+)
+
+// EVMSource is the state of an evm compatible source chain, the cross chain manager contract
+// stores the hash of i-th message at slot i, and the messages are proved by storage proofs.
+type EVMSource struct {
+	CCMC     common.Address
+	Root     common.Hash
+	Messages []*scom.MakeTxParam
+	Extras   [][]byte // serialized messages
+
+	state *state.StateDB
+}
+
+// NewEVMSource creates the source chain state with the messages to chain `toChainID`.
+func NewEVMSource(t testing.TB, ccmc common.Address, toChainID uint64, msgs int) *EVMSource {
+	src := &EVMSource{CCMC: ccmc}
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	db.SetNonce(ccmc, 1)
+	db.SetCode(ccmc, []byte{0x1})
+	for i := 0; i < msgs; i++ {
+		msg := &scom.MakeTxParam{
+			TxHash:              crypto.Keccak256([]byte{byte(i)}),
+			CrossChainID:        crypto.Keccak256([]byte{byte(i), 1}),
+			FromContractAddress: common.HexToAddress("0x1").Bytes(),
+			ToChainID:           toChainID,
+			ToContractAddress:   common.HexToAddress("0x2").Bytes(),
+			Method:              "unlock",
+			Args:                make([]byte, 100),
+		}
+		sink := polycomm.NewZeroCopySink(nil)
+		msg.Serialization(sink)
+		src.Messages = append(src.Messages, msg)
+		src.Extras = append(src.Extras, sink.Bytes())
+		db.SetState(ccmc, common.BigToHash(big.NewInt(int64(i))), crypto.Keccak256Hash(sink.Bytes()))
+	}
+	root, err := db.Commit(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.Root = root
+	src.state, _ = state.New(root, db.Database(), nil)
+	return src
+}
+
+// Proof returns the json encoded storage proof of the i-th message.
+func (s *EVMSource) Proof(t testing.TB, i int) []byte {
+	acctProof, err := s.state.GetProof(s.CCMC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := common.BigToHash(big.NewInt(int64(i)))
+	storageProof, err := s.state.GetStorageProof(s.CCMC, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, _ := json.Marshal(&scom.AccountProof{
+		Address:       s.CCMC.Hex(),
+		Balance:       "0x0",
+		CodeHash:      s.state.GetCodeHash(s.CCMC).Hex(),
+		Nonce:         "0x1",
+		StorageHash:   s.state.StorageTrie(s.CCMC).Hash().Hex(),
+		AccountProof:  hexNodes(acctProof),
+		StorageProofs: []scom.StorageProof{{Key: key.Hex(), Proof: hexNodes(storageProof)}},
+	})
+	return proof
+}
+
+func hexNodes(nodes [][]byte) []string {
+	list := make([]string, len(nodes))
+	for i, node := range nodes {
+		list[i] = hexutil.Encode(node)
+	}
+	return list
+}
@@ -0,0 +1,232 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package handlertest provides the conformance suite every cross chain handler must pass, so
+// that new handlers ship with the same safety baseline as the existing ones.
+package handlertest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	polycomm "github.com/polynetwork/poly/common"
+)
+
+// Env is the zion state the fixtures are prepared in, the single validator of the genesis
+// epoch approves the governance methods, e.g. `syncGenesisHeader`.
+type Env struct {
+	DB        *state.StateDB
+	Validator common.Address
+}
+
+// NewEnv creates an empty zion state with the genesis epoch stored.
+func NewEnv(t testing.TB) *Env {
+	key, _ := crypto.GenerateKey()
+	env := &Env{Validator: crypto.PubkeyToAddress(key.PublicKey)}
+	env.DB, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if _, err := node_manager.StoreGenesisEpoch(env.DB, &node_manager.Peers{List: []*node_manager.PeerInfo{peerInfo(key)}}); err != nil {
+		t.Fatal(err)
+	}
+	return env
+}
+
+func peerInfo(key *ecdsa.PrivateKey) *node_manager.PeerInfo {
+	return &node_manager.PeerInfo{
+		PubKey:  hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)),
+		Address: crypto.PubkeyToAddress(key.PublicKey),
+	}
+}
+
+// Contract returns the native contract calling to the cross chain manager with the payload.
+func (e *Env) Contract(sender common.Address, payload []byte) *native.NativeContract {
+	ref := native.NewContractRef(e.DB, sender, sender, big.NewInt(1), common.EmptyHash, 100000000000000000, nil)
+	ref.PushContext(&native.Context{Caller: sender, ContractAddress: utils.CrossChainManagerContractAddress, Payload: payload})
+	return native.NewNativeContract(e.DB, ref)
+}
+
+// RegisterSideChain stores the side chain as if it had been approved.
+func (e *Env) RegisterSideChain(t testing.TB, chain *side_chain_manager.SideChain) {
+	if err := side_chain_manager.PutSideChain(e.Contract(e.Validator, nil), chain); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// MigrateCCMC moves the CCMC of the chain to the address since the source height, which is
+// approved by the single validator.
+func (e *Env) MigrateCCMC(t testing.TB, chainID uint64, ccmc []byte, height uint64) {
+	payload, err := utils.PackMethod(side_chain_manager.ABI, side_chain_manager.MethodMigrateCCMC, chainID, ccmc, height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := side_chain_manager.MigrateCCMC(e.Contract(e.Validator, payload)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Fixture is a source chain prepared for the handler under test, whose headers and state are
+// synced into the environment.
+type Fixture struct {
+	Env     *Env
+	Handler scom.ChainHandler
+
+	// Valid imports Message with the proof against a confirmed header.
+	Valid   *scom.EntranceParam
+	Message *scom.MakeTxParam
+
+	// Unconfirmed imports a message with the proof against a header which is either not
+	// synced or not confirmed yet.
+	Unconfirmed *scom.EntranceParam
+}
+
+func (f *Fixture) importMessage(t *testing.T, param *scom.EntranceParam) (*scom.MakeTxParam, error) {
+	payload, err := utils.PackMethodWithStruct(scom.ABI, scom.MethodImportOuterTransfer, param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.importPayload(payload)
+}
+
+func (f *Fixture) importPayload(payload []byte) (*scom.MakeTxParam, error) {
+	return f.Handler.MakeDepositProposal(f.Env.Contract(common.HexToAddress("0xfee"), payload))
+}
+
+func copyParam(param *scom.EntranceParam) *scom.EntranceParam {
+	cpy := *param
+	cpy.Proof = common.CopyBytes(param.Proof)
+	cpy.Extra = common.CopyBytes(param.Extra)
+	cpy.HeaderOrCrossChainMsg = common.CopyBytes(param.HeaderOrCrossChainMsg)
+	return &cpy
+}
+
+// expectCode checks the error of the import is categorized as one of the codes.
+func expectCode(t *testing.T, err error, codes ...scom.ErrorCode) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("import succeeded, expect error of %v", codes)
+	}
+	got := scom.ErrorCodeOf(err)
+	for _, code := range codes {
+		if got == code {
+			return
+		}
+	}
+	t.Fatalf("error code %v, expect %v: %v", got, codes, err)
+}
+
+// TestHandlerSuite runs the conformance tests against the handler, every test runs with a
+// fresh fixture.
+func TestHandlerSuite(t *testing.T, newFixture func(t *testing.T) *Fixture) {
+	t.Run("ValidImport", func(t *testing.T) {
+		f := newFixture(t)
+		msg, err := f.importMessage(t, f.Valid)
+		if err != nil {
+			t.Fatalf("import failed: %v", err)
+		}
+		if !bytes.Equal(serialize(msg), serialize(f.Message)) {
+			t.Fatalf("message mismatch, got %+v, expect %+v", msg, f.Message)
+		}
+	})
+
+	t.Run("ReplayedImport", func(t *testing.T) {
+		f := newFixture(t)
+		if _, err := f.importMessage(t, f.Valid); err != nil {
+			t.Fatalf("import failed: %v", err)
+		}
+		_, err := f.importMessage(t, f.Valid)
+		expectCode(t, err, scom.ErrCodeTxAlreadyDone)
+	})
+
+	t.Run("UnconfirmedHeight", func(t *testing.T) {
+		f := newFixture(t)
+		_, err := f.importMessage(t, f.Unconfirmed)
+		expectCode(t, err, scom.ErrCodeHeaderNotSynced)
+	})
+
+	t.Run("WrongContractAddress", func(t *testing.T) {
+		f := newFixture(t)
+		chain, err := side_chain_manager.GetSideChain(f.Env.Contract(f.Env.Validator, nil), f.Valid.SourceChainID)
+		if err != nil || chain == nil {
+			t.Fatalf("side chain %d not registered: %v", f.Valid.SourceChainID, err)
+		}
+		chain.CCMCAddress = common.HexToAddress("0xbad").Bytes()
+		f.Env.RegisterSideChain(t, chain)
+		_, err = f.importMessage(t, f.Valid)
+		expectCode(t, err, scom.ErrCodeInvalidProof)
+	})
+
+	t.Run("MigratedContractAddress", func(t *testing.T) {
+		// the proofs of the blocks before the cutover are verified against the old address
+		f := newFixture(t)
+		f.Env.MigrateCCMC(t, f.Valid.SourceChainID, common.HexToAddress("0xbad").Bytes(), uint64(f.Valid.Height)+1)
+		if _, err := f.importMessage(t, f.Valid); err != nil {
+			t.Fatalf("import before cutover failed: %v", err)
+		}
+
+		f = newFixture(t)
+		f.Env.MigrateCCMC(t, f.Valid.SourceChainID, common.HexToAddress("0xbad").Bytes(), uint64(f.Valid.Height))
+		_, err := f.importMessage(t, f.Valid)
+		expectCode(t, err, scom.ErrCodeInvalidProof)
+	})
+
+	t.Run("MalformedProof", func(t *testing.T) {
+		malform := map[string]func(p *scom.EntranceParam){
+			"empty":     func(p *scom.EntranceParam) { p.Proof = nil },
+			"truncated": func(p *scom.EntranceParam) { p.Proof = p.Proof[:len(p.Proof)/2] },
+			"garbage":   func(p *scom.EntranceParam) { p.Proof = bytes.Repeat([]byte{0xff}, len(p.Proof)) },
+			"tampered":  func(p *scom.EntranceParam) { p.Extra = append(p.Extra, 0) },
+		}
+		for _, name := range []string{"empty", "truncated", "garbage", "tampered"} {
+			t.Run(name, func(t *testing.T) {
+				f := newFixture(t)
+				param := copyParam(f.Valid)
+				malform[name](param)
+				_, err := f.importMessage(t, param)
+				expectCode(t, err, scom.ErrCodeInvalidProof, scom.ErrCodeInvalidParam)
+			})
+		}
+	})
+
+	t.Run("OversizedPayload", func(t *testing.T) {
+		f := newFixture(t)
+		param := copyParam(f.Valid)
+		param.HeaderOrCrossChainMsg = make([]byte, scom.MaxImportPayloadSize)
+		_, err := f.importMessage(t, param)
+		expectCode(t, err, scom.ErrCodeInvalidParam)
+	})
+}
+
+func serialize(msg *scom.MakeTxParam) []byte {
+	if msg == nil {
+		return nil
+	}
+	sink := polycomm.NewZeroCopySink(nil)
+	msg.Serialization(sink)
+	return sink.Bytes()
+}
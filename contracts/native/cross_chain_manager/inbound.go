@@ -0,0 +1,85 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// InboundHandler executes the cross chain message targeting a native contract on zion itself,
+// e.g: unlocking the bridged native token. the message has been verified and marked done before
+// the handler invoked, and the import fails if the handler returns error.
+type InboundHandler func(s *native.NativeContract, fromChainID uint64, txParam *scom.MakeTxParam) error
+
+var inboundHandlers = make(map[common.Address]InboundHandler)
+
+// RegisterInboundHandler record the inbound message handler of the native contract, it should be
+// called in the contract's init function.
+func RegisterInboundHandler(contract common.Address, handler InboundHandler) {
+	if _, ok := inboundHandlers[contract]; ok {
+		panic(fmt.Sprintf("inbound handler of %s registered twice", contract.Hex()))
+	}
+	inboundHandlers[contract] = handler
+}
+
+// LocalChainID returns the cross chain id of zion, which is the chain id in chain config, it's
+// not available if the native call is made without chain config.
+func LocalChainID(s *native.NativeContract) (uint64, bool) {
+	config := s.ContractRef().ChainConfig()
+	if config == nil || config.ChainID == nil || !config.ChainID.IsUint64() {
+		return 0, false
+	}
+	return config.ChainID.Uint64(), true
+}
+
+func isLocalChain(s *native.NativeContract, chainID uint64) bool {
+	id, ok := LocalChainID(s)
+	return ok && id == chainID
+}
+
+// ExecuteInbound dispatches the message targeting zion to the handler of the target contract,
+// the handler runs in context of the target contract so that the events are emitted by it. since
+// cross chain v2 the handler is bounded by the gas limit specified by the source, so the message
+// is rejected if the handler runs out of it.
+func ExecuteInbound(s *native.NativeContract, fromChainID uint64, txParam *scom.MakeTxParam) error {
+	if len(txParam.ToContractAddress) != common.AddressLength {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "ExecuteInbound, invalid target contract %x", txParam.ToContractAddress)
+	}
+	handler, ok := inboundHandlers[common.BytesToAddress(txParam.ToContractAddress)]
+	if !ok {
+		return scom.NewImportError(scom.ErrCodeUnsupportedChain, "ExecuteInbound, target contract %x not supported", txParam.ToContractAddress)
+	}
+	ref := s.ContractRef()
+	ref.PushContext(&native.Context{
+		Caller:          utils.CrossChainManagerContractAddress,
+		ContractAddress: common.BytesToAddress(txParam.ToContractAddress),
+		Payload:         txParam.Args,
+	})
+	defer ref.PopContext()
+	if txParam.GasLimit == 0 || !ref.IsCrossChainV2() {
+		return handler(s, fromChainID, txParam)
+	}
+	return ref.LimitGas(txParam.GasLimit, func() error {
+		return handler(s, fromChainID, txParam)
+	})
+}
@@ -0,0 +1,330 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// DeliveryFailureMethod is the method of the cross chain message which the destination chain
+// sends back once a zion originated message is rejected and will never be executed, e.g: its
+// execution reverted or it timed out. the args is the tx hash of merkle value as the receipt
+// of `DeliveryReceiptMethod`.
+const DeliveryFailureMethod = "deliveryFailure"
+
+// MethodOnCrossChainLifecycle is the method of outbound callbacks, which is called with the
+// target chain id, the sequence number and the cross chain id of the message, and its new state.
+const MethodOnCrossChainLifecycle = "onCrossChainLifecycle"
+
+// MethodOnCrossChainBatch is the method of outbound callbacks called with the batch id and the
+// new state of the batch.
+const MethodOnCrossChainBatch = "onCrossChainBatch"
+
+// MaxOutboundCallbackGas is the upper bound of the gas supplied to an outbound callback.
+const MaxOutboundCallbackGas = uint64(2000000)
+
+const outboundCallbackABIJson = `[
+	{"type":"function","name":"` + MethodOnCrossChainLifecycle + `","inputs":[{"internalType":"uint64","name":"toChainID","type":"uint64"},{"internalType":"uint64","name":"sequence","type":"uint64"},{"internalType":"bytes","name":"crossChainID","type":"bytes"},{"internalType":"uint8","name":"state","type":"uint8"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodOnCrossChainBatch + `","inputs":[{"internalType":"bytes32","name":"batchID","type":"bytes32"},{"internalType":"uint8","name":"state","type":"uint8"}],"outputs":[],"stateMutability":"nonpayable"}
+]`
+
+// OutboundCallbackABI is the interface implemented by the outbound callbacks.
+var OutboundCallbackABI *abi.ABI
+
+func init() {
+	ab, err := abi.JSON(strings.NewReader(outboundCallbackABIJson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load outbound callback abi json string: [%v]", err))
+	}
+	OutboundCallbackABI = &ab
+}
+
+// LifecycleState is the state of an outbound message, which transits from accepted to either
+// delivered or failed by the receipts of the target chain, and a failed message is refunded
+// once.
+type LifecycleState uint8
+
+const (
+	StateUnknown   LifecycleState = iota // message not found
+	StateAccepted                        // queued for relaying
+	StateDelivered                       // executed on the target chain
+	StateFailed                          // rejected by the target chain
+	StateRefunded                        // failed and compensated by the sender
+)
+
+var lifecycleStateNames = map[LifecycleState]string{
+	StateUnknown:   "unknown",
+	StateAccepted:  "accepted",
+	StateDelivered: "delivered",
+	StateFailed:    "failed",
+	StateRefunded:  "refunded",
+}
+
+func (s LifecycleState) String() string {
+	if name, ok := lifecycleStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("LifecycleState(%d)", uint8(s))
+}
+
+// SetOutboundCallback the contract sending cross chain messages registers the evm contract which
+// is notified of the state transitions of its outbound messages, the zero address removes it.
+func SetOutboundCallback(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetOutboundCallbackParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetOutboundCallback, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sender := native.ContractRef().MsgSender()
+	if params.Callback == common.EmptyAddress {
+		native.GetCacheDB().Delete(outboundCallbackKey(sender))
+		params.GasLimit = 0
+	} else {
+		if params.GasLimit == 0 || params.GasLimit > MaxOutboundCallbackGas {
+			return nil, fmt.Errorf("SetOutboundCallback, invalid gas limit %d", params.GasLimit)
+		}
+		if native.StateDB().GetCodeSize(params.Callback) == 0 {
+			return nil, fmt.Errorf("SetOutboundCallback, callback %s is not a contract", params.Callback.Hex())
+		}
+		PutOutboundCallback(native, sender, &scom.OutboundCallback{Callback: params.Callback, GasLimit: params.GasLimit})
+	}
+	if err := native.AddNotify(scom.ABI, []string{scom.NOTIFY_CALLBACK_CHANGED_EVENT}, sender, params.Callback, params.GasLimit); err != nil {
+		return nil, fmt.Errorf("SetOutboundCallback, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetOutboundCallback, true)
+}
+
+func OutboundCallback(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.OutboundCallbackParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodOutboundCallback, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	callback, err := GetOutboundCallback(native, params.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("OutboundCallback, GetOutboundCallback error: %v", err)
+	}
+	if callback == nil {
+		callback = new(scom.OutboundCallback)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodOutboundCallback, callback.Callback, callback.GasLimit)
+}
+
+func OutboundState(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.OutboundParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodOutboundState, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	state, err := GetOutboundState(native, params.ToChainID, params.Sequence)
+	if err != nil {
+		return nil, fmt.Errorf("OutboundState, GetOutboundState error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodOutboundState, uint8(state))
+}
+
+// RefundOutbound anyone moves the failed message to refunded, and the callback of sender runs
+// its compensation, e.g: paying the locked asset back. unlike the other transitions, the refund
+// fails with the callback, so that it could be retried. the messages of a batch are refunded by
+// `refundBatch` as a whole.
+func RefundOutbound(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.OutboundParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodRefundOutbound, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	state, err := GetOutboundState(native, params.ToChainID, params.Sequence)
+	if err != nil {
+		return nil, fmt.Errorf("RefundOutbound, GetOutboundState error: %v", err)
+	}
+	if state != StateFailed {
+		return nil, fmt.Errorf("RefundOutbound, outbound message %d to chain %d is %s", params.Sequence, params.ToChainID, state)
+	}
+	if batchID, err := getBatchIndex(native, params.ToChainID, params.Sequence); err != nil {
+		return nil, fmt.Errorf("RefundOutbound, getBatchIndex error: %v", err)
+	} else if batchID != nil {
+		return nil, fmt.Errorf("RefundOutbound, outbound message %d to chain %d is refunded by batch %s", params.Sequence, params.ToChainID, batchID.Hex())
+	}
+	txParam, err := getOutboundTxParam(native, params.ToChainID, params.Sequence)
+	if err != nil {
+		return nil, fmt.Errorf("RefundOutbound, %v", err)
+	}
+	putOutboundHeight(native, scom.REFUNDED, params.ToChainID, params.Sequence)
+
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_REFUNDED_EVENT}, params.ToChainID, params.Sequence); err != nil {
+		return nil, fmt.Errorf("RefundOutbound, AddNotify error: %v", err)
+	}
+	if err := notifyOutbound(native, params.ToChainID, params.Sequence, txParam, StateRefunded, false); err != nil {
+		return nil, fmt.Errorf("RefundOutbound, %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodRefundOutbound, true)
+}
+
+// notifyOutbound calls the callback registered by the sender of the outbound message with the
+// new state, see `callOutboundCallback`.
+func notifyOutbound(native *native.NativeContract, chainID, seq uint64, txParam *scom.MakeTxParam, state LifecycleState, sandboxed bool) error {
+	if len(txParam.FromContractAddress) != common.AddressLength {
+		return nil
+	}
+	sender := common.BytesToAddress(txParam.FromContractAddress)
+	invoked, success, err := callOutboundCallback(native, sender, sandboxed, MethodOnCrossChainLifecycle,
+		chainID, seq, txParam.CrossChainID, uint8(state))
+	if err != nil || !invoked {
+		return err
+	}
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_CALLBACK_INVOKED_EVENT}, sender, chainID, seq, uint8(state), success); err != nil {
+		return fmt.Errorf("notifyOutbound, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// callOutboundCallback calls the method of the callback registered by the sender, invoked is false
+// if there is no callback. the callback is sandboxed if `sandboxed` is set: its failure reverts the
+// callback only and success is false, otherwise the failure is returned. the gas left should cover
+// the gas limit of the callback, so that the callback is never skipped for lack of gas.
+func callOutboundCallback(native *native.NativeContract, sender common.Address, sandboxed bool, method string, args ...interface{}) (invoked, success bool, err error) {
+	callback, err := GetOutboundCallback(native, sender)
+	if err != nil {
+		return false, false, fmt.Errorf("callOutboundCallback, GetOutboundCallback error: %v", err)
+	}
+	if callback == nil {
+		return false, false, nil
+	}
+	if gasLeft := native.ContractRef().GasLeft(); gasLeft < callback.GasLimit {
+		return false, false, fmt.Errorf("callOutboundCallback, gas left %d not enough for callback gas limit %d", gasLeft, callback.GasLimit)
+	}
+	input, err := utils.PackMethod(OutboundCallbackABI, method, args...)
+	if err != nil {
+		return false, false, fmt.Errorf("callOutboundCallback, pack callback input error: %v", err)
+	}
+	if _, err := native.ContractRef().EVMCall(this, callback.Callback, input, callback.GasLimit); err != nil {
+		logger := utils.NewLogger(utils.LogModuleCrossChain, "txHash", native.ContractRef().TxHash())
+		logger.Debug("Outbound callback failed", "sender", sender, "callback", callback.Callback, "method", method, "err", err)
+		if !sandboxed {
+			return true, false, fmt.Errorf("callOutboundCallback, callback error: %v", err)
+		}
+		return true, false, nil
+	}
+	return true, true, nil
+}
+
+// GetOutboundState returns the lifecycle state of the outbound message, `StateUnknown` if the
+// message doesn't exist.
+func GetOutboundState(native *native.NativeContract, chainID, seq uint64) (LifecycleState, error) {
+	msg, err := GetOutbound(native, chainID, seq)
+	if err != nil || msg == nil {
+		return StateUnknown, err
+	}
+	for _, v := range []struct {
+		prefix string
+		state  LifecycleState
+	}{
+		{scom.REFUNDED, StateRefunded},
+		{scom.DELIVERY_FAILED, StateFailed},
+		{scom.DELIVERED, StateDelivered},
+	} {
+		found, _, err := getOutboundHeight(native, v.prefix, chainID, seq)
+		if err != nil {
+			return StateUnknown, err
+		}
+		if found {
+			return v.state, nil
+		}
+	}
+	return StateAccepted, nil
+}
+
+// getOutboundTxParam decodes the cross chain message of the outbound message.
+func getOutboundTxParam(native *native.NativeContract, chainID, seq uint64) (*scom.MakeTxParam, error) {
+	msg, err := GetOutbound(native, chainID, seq)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return nil, fmt.Errorf("outbound message %d to chain %d not found", seq, chainID)
+	}
+	merkleValue := new(scom.ToMerkleValue)
+	if err := merkleValue.Deserialization(polycomm.NewZeroCopySource(msg.MerkleValue)); err != nil {
+		return nil, fmt.Errorf("deserialize merkle value of outbound message %d error: %v", seq, err)
+	}
+	return merkleValue.MakeTxParam, nil
+}
+
+func PutOutboundCallback(native *native.NativeContract, sender common.Address, callback *scom.OutboundCallback) {
+	sink := polycomm.NewZeroCopySink(nil)
+	callback.Serialization(sink)
+	native.GetCacheDB().Put(outboundCallbackKey(sender), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetOutboundCallback returns nil if the sender never registered the callback.
+func GetOutboundCallback(native *native.NativeContract, sender common.Address) (*scom.OutboundCallback, error) {
+	store, err := native.GetCacheDB().Get(outboundCallbackKey(sender))
+	if err != nil {
+		return nil, fmt.Errorf("GetOutboundCallback, get callback store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetOutboundCallback, deserialize from raw storage item err:%v", err)
+	}
+	callback := new(scom.OutboundCallback)
+	if err := callback.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetOutboundCallback, deserialize callback error: %v", err)
+	}
+	return callback, nil
+}
+
+func outboundCallbackKey(sender common.Address) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(scom.OUTBOUND_CALLBACK), sender.Bytes())
+}
+
+// putOutboundHeight records the block height of the state transition of outbound message, the
+// prefix is one of `DELIVERED`, `DELIVERY_FAILED` and `REFUNDED`.
+func putOutboundHeight(native *native.NativeContract, prefix string, chainID, seq uint64) {
+	contract := utils.CrossChainManagerContractAddress
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(prefix), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(seq)),
+		cstates.GenRawStorageItem(utils.GetUint64Bytes(native.ContractRef().BlockHeight().Uint64())))
+}
+
+func getOutboundHeight(native *native.NativeContract, prefix string, chainID, seq uint64) (bool, uint64, error) {
+	contract := utils.CrossChainManagerContractAddress
+	heightStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(prefix),
+		utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(seq)))
+	if err != nil {
+		return false, 0, fmt.Errorf("get %s height store error: %v", prefix, err)
+	}
+	if heightStore == nil {
+		return false, 0, nil
+	}
+	heightBytes, err := cstates.GetValueFromRawStorageItem(heightStore)
+	if err != nil {
+		return false, 0, fmt.Errorf("deserialize %s height from raw storage item err:%v", prefix, err)
+	}
+	return true, utils.GetBytesUint64(heightBytes), nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// the metrics are named by the source chain id, e.g. `native/crosschain/2/import/success`, as the
+// registry has no label. imports are metered whenever the node executes them, which includes the
+// calls and gas estimations of relayers.
+
+func chainMetricName(chainID uint64, name string) string {
+	return fmt.Sprintf("native/crosschain/%d/%s", chainID, name)
+}
+
+// markImport counts the result of `importOuterTransfer`, failures are counted by the error
+// code.
+func markImport(chainID uint64, err error) {
+	if err == nil {
+		metrics.GetOrRegisterCounter(chainMetricName(chainID, "import/success"), nil).Inc(1)
+		return
+	}
+	reason := strings.Replace(scom.ErrorCodeOf(err).String(), " ", "_", -1)
+	metrics.GetOrRegisterCounter(chainMetricName(chainID, "import/failure/"+reason), nil).Inc(1)
+}
+
+// markShadowImport counts the verification result of the imports in shadow mode, failures are
+// counted by the error code.
+func markShadowImport(chainID uint64, err error) {
+	if err == nil {
+		metrics.GetOrRegisterCounter(chainMetricName(chainID, "shadow/success"), nil).Inc(1)
+		return
+	}
+	reason := strings.Replace(scom.ErrorCodeOf(err).String(), " ", "_", -1)
+	metrics.GetOrRegisterCounter(chainMetricName(chainID, "shadow/failure/"+reason), nil).Inc(1)
+}
+
+// updateVerifyTimer records the time spent on the proof verification of the source chain.
+func updateVerifyTimer(chainID uint64, start time.Time) {
+	metrics.GetOrRegisterTimer(chainMetricName(chainID, "verify"), nil).UpdateSince(start)
+}
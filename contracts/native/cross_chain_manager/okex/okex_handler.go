@@ -20,16 +20,15 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmosproof"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync/cosmos"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/okex"
-	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/polynetwork/poly/common"
-	"github.com/tendermint/tendermint/crypto/merkle"
 )
 
 type OKHandler struct{}
@@ -48,15 +47,17 @@ var (
 )
 
 func (this *OKHandler) MakeDepositProposal(service *native.NativeContract) (*scom.MakeTxParam, error) {
-	ctx := service.ContractRef().CurrentContext()
-	params := &scom.EntranceParam{}
-	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := scom.UnpackEntranceParam(service.ContractRef().CurrentContext().Payload)
+	if err != nil {
 		return nil, err
 	}
 	info, err := okex.GetEpochSwitchInfo(service, params.SourceChainID)
 	if err != nil {
 		return nil, fmt.Errorf("okex MakeDepositProposal, failed to get epoch switching height: %v", err)
 	}
+	if err := cosmos.CheckTrustAt(service.ContractRef(), info.HeaderTime); err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeTrustExpired, "okex MakeDepositProposal, %v", err)
+	}
 	if info.Height > int64(params.Height) {
 		return nil, fmt.Errorf("okex MakeDepositProposal, the height %d of header is lower than epoch "+
 			"switching height %d", params.Height, info.Height)
@@ -74,31 +75,26 @@ func (this *OKHandler) MakeDepositProposal(service *native.NativeContract) (*sco
 		return nil, fmt.Errorf("okex MakeDepositProposal, "+
 			"height of your header is %d not equal to %d in parameter", myHeader.Header.Height, params.Height)
 	}
-	if err = okex.VerifyCosmosHeader(&myHeader, info); err != nil {
+	if err = okex.VerifyCosmosHeader(service, &myHeader, info); err != nil {
 		return nil, fmt.Errorf("okex MakeDepositProposal, failed to verify okex header: %v", err)
 	}
 	if !bytes.Equal(myHeader.Header.ValidatorsHash, myHeader.Header.NextValidatorsHash) &&
 		myHeader.Header.Height > info.Height {
-		okex.PutEpochSwitchInfo(service, params.SourceChainID, &okex.CosmosEpochSwitchInfo{
-			Height:             myHeader.Header.Height,
-			BlockHash:          myHeader.Header.Hash(),
-			NextValidatorsHash: myHeader.Header.NextValidatorsHash,
-			ChainID:            myHeader.Header.ChainID,
-		})
+		okex.PutEpochSwitchInfo(service, params.SourceChainID, okex.NewEpochSwitchInfo(&myHeader.Header))
 	}
 
 	var proofValue CosmosProofValue
 	if err = cdc.UnmarshalBinaryBare(params.Extra, &proofValue); err != nil {
 		return nil, fmt.Errorf("okex MakeDepositProposal, unmarshal proof value err: %v", err)
 	}
-	var proof merkle.Proof
+	var proof cosmosproof.Proof
 	err = cdc.UnmarshalBinaryBare(params.Proof, &proof)
 	if err != nil {
 		return nil, fmt.Errorf("okex MakeDepositProposal, unmarshal proof err: %v", err)
 	}
-	sideChain, err := side_chain_manager.GetSideChain(service, params.SourceChainID)
+	sideChain, err := side_chain_manager.GetSideChainAt(service, params.SourceChainID, uint64(params.Height))
 	if err != nil {
-		return nil, fmt.Errorf("okex MakeDepositProposal, side_chain_manager.GetSideChain error: %v", err)
+		return nil, fmt.Errorf("okex MakeDepositProposal, side_chain_manager.GetSideChainAt error: %v", err)
 	}
 	if len(proof.Ops) != 2 {
 		return nil, fmt.Errorf("proof size wrong")
@@ -116,7 +112,7 @@ func (this *OKHandler) MakeDepositProposal(service *native.NativeContract) (*sco
 		return nil, fmt.Errorf("Cosmos MakeDepositProposal, Kp is nil")
 	}
 
-	prt := rootmulti.DefaultProofRuntime()
+	prt := cosmosproof.DefaultProofRuntime()
 	err = prt.VerifyValue(&proof, myHeader.Header.AppHash, proofValue.Kp, ethcrypto.Keccak256(proofValue.Value))
 	if err != nil {
 		return nil, fmt.Errorf("Cosmos MakeDepositProposal, proof error: %s", err)
@@ -0,0 +1,139 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// PushOutbound appends the message to the outbound queue of the target chain, the messages
+// are indexed by the sequence number which starts from 0, and by the tx hash of the merkle value.
+// the sequence number of the message is returned.
+func PushOutbound(native *native.NativeContract, chainID uint64, txHash []byte, msg *scom.OutboundMessage) (uint64, error) {
+	contract := utils.CrossChainManagerContractAddress
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	seq, err := GetOutboundCount(native, chainID)
+	if err != nil {
+		return 0, err
+	}
+	sink := polycomm.NewZeroCopySink(nil)
+	msg.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.OUTBOUND), chainIDBytes, utils.GetUint64Bytes(seq)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.OUTBOUND_COUNT), chainIDBytes),
+		cstates.GenRawStorageItem(utils.GetUint64Bytes(seq+1)))
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.OUTBOUND_INDEX), chainIDBytes, txHash),
+		cstates.GenRawStorageItem(utils.GetUint64Bytes(seq)))
+	return seq, nil
+}
+
+// GetOutboundSequence returns the sequence number of the outbound message by the tx hash of its
+// merkle value, found is false if there is no such message.
+func GetOutboundSequence(native *native.NativeContract, chainID uint64, txHash []byte) (seq uint64, found bool, err error) {
+	contract := utils.CrossChainManagerContractAddress
+	seqStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.OUTBOUND_INDEX), utils.GetUint64Bytes(chainID), txHash))
+	if err != nil {
+		return 0, false, fmt.Errorf("GetOutboundSequence, get seqStore error: %v", err)
+	}
+	if seqStore == nil {
+		return 0, false, nil
+	}
+	seqBytes, err := cstates.GetValueFromRawStorageItem(seqStore)
+	if err != nil {
+		return 0, false, fmt.Errorf("GetOutboundSequence, deserialize from raw storage item err:%v", err)
+	}
+	return utils.GetBytesUint64(seqBytes), true, nil
+}
+
+// GetOutboundCount returns the number of messages in the outbound queue of the target chain.
+func GetOutboundCount(native *native.NativeContract, chainID uint64) (uint64, error) {
+	contract := utils.CrossChainManagerContractAddress
+	countStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.OUTBOUND_COUNT), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return 0, fmt.Errorf("GetOutboundCount, get countStore error: %v", err)
+	}
+	if countStore == nil {
+		return 0, nil
+	}
+	countBytes, err := cstates.GetValueFromRawStorageItem(countStore)
+	if err != nil {
+		return 0, fmt.Errorf("GetOutboundCount, deserialize from raw storage item err:%v", err)
+	}
+	return utils.GetBytesUint64(countBytes), nil
+}
+
+// GetOutbound returns the message of the sequence number, or nil if it doesn't exist.
+func GetOutbound(native *native.NativeContract, chainID, seq uint64) (*scom.OutboundMessage, error) {
+	contract := utils.CrossChainManagerContractAddress
+	msgStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.OUTBOUND),
+		utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(seq)))
+	if err != nil {
+		return nil, fmt.Errorf("GetOutbound, get msgStore error: %v", err)
+	}
+	if msgStore == nil {
+		return nil, nil
+	}
+	msgBytes, err := cstates.GetValueFromRawStorageItem(msgStore)
+	if err != nil {
+		return nil, fmt.Errorf("GetOutbound, deserialize from raw storage item err:%v", err)
+	}
+	msg := new(scom.OutboundMessage)
+	if err := msg.Deserialization(polycomm.NewZeroCopySource(msgBytes)); err != nil {
+		return nil, fmt.Errorf("GetOutbound, deserialize message error: %v", err)
+	}
+	return msg, nil
+}
+
+// GetOutboundRange reads the messages with sequence number in [from, to] of the target chain
+// from the given state, the range is truncated to the end of the queue. The queue size is
+// returned as well so that the caller knows where the backlog ends.
+func GetOutboundRange(db *state.StateDB, chainID, from, to uint64) ([]*scom.OutboundMessage, uint64, error) {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big0, common.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	count, err := GetOutboundCount(s, chainID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if from >= count || from > to {
+		return nil, count, nil
+	}
+	if to >= count {
+		to = count - 1
+	}
+	msgs := make([]*scom.OutboundMessage, 0, to-from+1)
+	for seq := from; seq <= to; seq++ {
+		msg, err := GetOutbound(s, chainID, seq)
+		if err != nil {
+			return nil, 0, err
+		}
+		if msg == nil {
+			return nil, 0, fmt.Errorf("GetOutboundRange, message %d of chain %d is missing", seq, chainID)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, count, nil
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/eth/types"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/quorum"
-	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	pcom "github.com/polynetwork/poly/common"
 )
 
@@ -37,15 +36,14 @@ func NewQuorumHandler() *QuorumHandler {
 }
 
 func (this *QuorumHandler) MakeDepositProposal(ns *native.NativeContract) (*common.MakeTxParam, error) {
-	ctx := ns.ContractRef().CurrentContext()
-	params := &common.EntranceParam{}
-	if err := utils.UnpackMethod(common.ABI, common.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := common.UnpackEntranceParam(ns.ContractRef().CurrentContext().Payload)
+	if err != nil {
 		return nil, err
 	}
 
-	sideChain, err := side_chain_manager.GetSideChain(ns, params.SourceChainID)
+	sideChain, err := side_chain_manager.GetSideChainAt(ns, params.SourceChainID, uint64(params.Height))
 	if err != nil {
-		return nil, fmt.Errorf("Quorum MakeDepositProposal, side_chain_manager.GetSideChain error: %v", err)
+		return nil, fmt.Errorf("Quorum MakeDepositProposal, side_chain_manager.GetSideChainAt error: %v", err)
 	}
 	if sideChain == nil {
 		return nil, errors.New("Quorum MakeDepositProposal, side chain not found")
@@ -53,10 +51,10 @@ func (this *QuorumHandler) MakeDepositProposal(ns *native.NativeContract) (*comm
 
 	val := &common.MakeTxParam{}
 	if err := val.Deserialization(pcom.NewZeroCopySource(params.Extra)); err != nil {
-		return nil, fmt.Errorf("Quorum MakeDepositProposal, failed to deserialize MakeTxParam: %v", err)
+		return nil, common.NewImportError(common.ErrCodeInvalidProof, "Quorum MakeDepositProposal, failed to deserialize MakeTxParam: %v", err)
 	}
 	if err := common.CheckDoneTx(ns, val.CrossChainID, params.SourceChainID); err != nil {
-		return nil, fmt.Errorf("Quorum MakeDepositProposal, check done transaction error: %v", err)
+		return nil, fmt.Errorf("Quorum MakeDepositProposal, check done transaction error: %w", err)
 	}
 	if err := common.PutDoneTx(ns, val.CrossChainID, params.SourceChainID); err != nil {
 		return nil, fmt.Errorf("Quorum MakeDepositProposal, PutDoneTx error: %v", err)
@@ -64,7 +62,7 @@ func (this *QuorumHandler) MakeDepositProposal(ns *native.NativeContract) (*comm
 
 	header := &types.Header{}
 	if err := json.Unmarshal(params.HeaderOrCrossChainMsg, header); err != nil {
-		return nil, fmt.Errorf("Quorum MakeDepositProposal, deserialize header err: %v", err)
+		return nil, common.NewImportError(common.ErrCodeInvalidParam, "Quorum MakeDepositProposal, deserialize header err: %v", err)
 	}
 	valh, err := quorum.GetCurrentValHeight(ns, params.SourceChainID)
 	if err != nil {
@@ -78,11 +76,11 @@ func (this *QuorumHandler) MakeDepositProposal(ns *native.NativeContract) (*comm
 		return nil, fmt.Errorf("Quorum MakeDepositProposal, failed to get quorum validators: %v", err)
 	}
 	if _, err := quorum.VerifyQuorumHeader(vs, header, false); err != nil {
-		return nil, fmt.Errorf("Quorum MakeDepositProposal, failed to verify quorum header %s: %v", header.Hash().String(), err)
+		return nil, common.NewImportError(common.ErrCodeInvalidProof, "Quorum MakeDepositProposal, failed to verify quorum header %s: %v", header.Hash().String(), err)
 	}
 
 	if err := verifyFromQuorumTx(params.Proof, params.Extra, header, sideChain); err != nil {
-		return nil, fmt.Errorf("Quorum MakeDepositProposal, verifyFromEthTx error: %s", err)
+		return nil, fmt.Errorf("Quorum MakeDepositProposal, verifyFromEthTx error: %w", err)
 	}
 
 	return val, nil
@@ -18,8 +18,8 @@ package quorum
 
 import (
 	"encoding/json"
-	"fmt"
 
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	eth2 "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/eth"
 	cmanager "github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/eth/types"
@@ -28,20 +28,20 @@ import (
 func verifyFromQuorumTx(proof, extra []byte, hdr *types.Header, sideChain *cmanager.SideChain) error {
 	ethProof := new(eth2.ETHProof)
 	if err := json.Unmarshal(proof, ethProof); err != nil {
-		return fmt.Errorf("VerifyFromEthProof, unmarshal proof error:%s", err)
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromEthProof, unmarshal proof error:%s", err)
 	}
 	if len(ethProof.StorageProofs) != 1 {
-		return fmt.Errorf("VerifyFromEthProof, incorrect proof format")
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromEthProof, incorrect proof format")
 	}
 	proofResult, err := eth2.VerifyMerkleProofLegacy(ethProof, hdr, sideChain.CCMCAddress)
 	if err != nil {
-		return fmt.Errorf("VerifyFromEthProof, verifyMerkleProof error:%v", err)
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromEthProof, verifyMerkleProof error:%v", err)
 	}
 	if proofResult == nil {
-		return fmt.Errorf("VerifyFromEthProof, verifyMerkleProof failed!")
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromEthProof, verifyMerkleProof failed!")
 	}
-	if !eth2.CheckProofResult(proofResult, extra) {
-		return fmt.Errorf("VerifyFromEthProof, verify proof value hash failed, proof result:%x, extra:%x", proofResult, extra)
+	if err := scom.CheckProofResult(proofResult, extra); err != nil {
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromEthProof, %v", err)
 	}
 	return nil
 }
@@ -0,0 +1,152 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// messageHash returns the hash of the cross chain message, which identifies the message in the
+// attestations and the import receipts.
+func messageHash(txParam *scom.MakeTxParam) common.Hash {
+	sink := polycomm.NewZeroCopySink(nil)
+	txParam.Serialization(sink)
+	return common.BytesToHash(crypto.Keccak256(sink.Bytes()))
+}
+
+// recordImport appends the receipt of the imported message to the import receipts of the block
+// since the import root fork, the merkle root of the block is updated with the frontier at each
+// import, and the index of the leaf is emitted for the relayers to query the proof.
+func recordImport(native *native.NativeContract, fromChainID uint64, txParam *scom.MakeTxParam) error {
+	if !native.ContractRef().IsImportRoot() {
+		return nil
+	}
+	height := native.ContractRef().BlockHeight().Uint64()
+	receipts, err := GetImportReceipts(native, height)
+	if err != nil {
+		return fmt.Errorf("recordImport, GetImportReceipts error: %v", err)
+	}
+	hash := messageHash(txParam)
+	leaf := scom.ImportReceiptLeaf(fromChainID, hash)
+	index := receipts.Append(leaf)
+	putImportLeaf(native, height, index, leaf)
+	PutImportReceipts(native, height, receipts)
+	if err := native.AddCrossChainNotify(scom.ABI, []string{scom.NOTIFY_MESSAGE_IMPORTED}, fromChainID, hash, index, leaf); err != nil {
+		return fmt.Errorf("recordImport, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+func ImportRoot(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.ImportRootParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportRoot, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	receipts, err := GetImportReceipts(native, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("ImportRoot, GetImportReceipts error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodImportRoot, receipts.Root, receipts.Count)
+}
+
+func ImportProof(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.ImportProofParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportProof, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	receipts, err := GetImportReceipts(native, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("ImportProof, GetImportReceipts error: %v", err)
+	}
+	// the proof is built on query from the leaves of the block
+	leaves := make([]common.Hash, receipts.Count)
+	for i := range leaves {
+		if leaves[i], err = getImportLeaf(native, params.Height, uint64(i)); err != nil {
+			return nil, fmt.Errorf("ImportProof, getImportLeaf error: %v", err)
+		}
+	}
+	proof, err := scom.ImportReceiptProof(leaves, params.Index)
+	if err != nil {
+		return nil, fmt.Errorf("ImportProof, block %d: %v", params.Height, err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodImportProof, leaves[params.Index], proof)
+}
+
+func importLeafKey(height, index uint64) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(scom.IMPORT_LEAF),
+		utils.GetUint64Bytes(height), utils.GetUint64Bytes(index))
+}
+
+func putImportLeaf(native *native.NativeContract, height, index uint64, leaf common.Hash) {
+	native.GetCacheDB().Put(importLeafKey(height, index), cstates.GenRawStorageItem(leaf.Bytes()))
+}
+
+func getImportLeaf(native *native.NativeContract, height, index uint64) (common.Hash, error) {
+	store, err := native.GetCacheDB().Get(importLeafKey(height, index))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("getImportLeaf, get import leaf store error: %v", err)
+	}
+	if store == nil {
+		return common.Hash{}, fmt.Errorf("getImportLeaf, leaf %d of block %d not found", index, height)
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("getImportLeaf, deserialize from raw storage item err:%v", err)
+	}
+	return common.BytesToHash(raw), nil
+}
+
+func PutImportReceipts(native *native.NativeContract, height uint64, receipts *scom.ImportReceipts) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	receipts.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.IMPORT_RECEIPTS), utils.GetUint64Bytes(height)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetImportReceipts returns the empty receipts if no message is imported in the block.
+func GetImportReceipts(native *native.NativeContract, height uint64) (*scom.ImportReceipts, error) {
+	contract := utils.CrossChainManagerContractAddress
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(scom.IMPORT_RECEIPTS), utils.GetUint64Bytes(height)))
+	if err != nil {
+		return nil, fmt.Errorf("GetImportReceipts, get import receipts store error: %v", err)
+	}
+	receipts := new(scom.ImportReceipts)
+	if store == nil {
+		return receipts, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetImportReceipts, deserialize from raw storage item err:%v", err)
+	}
+	if err := receipts.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetImportReceipts, deserialize import receipts error: %v", err)
+	}
+	return receipts, nil
+}
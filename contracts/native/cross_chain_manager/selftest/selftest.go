@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package selftest runs the proof verifiers of the cross chain handlers against the embedded
+// known good fixtures, so that a regression of the verifiers or their dependencies, e.g. a version
+// bump of amino or the merkle proof libraries, is caught before the node imports any message.
+package selftest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmos"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/zilliqa"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// EVMFixture is the storage proof of a message in the CCMC of an evm compatible chain, in the
+// format of `eth_getProof`.
+type EVMFixture struct {
+	Root     common.Hash       `json:"root"`
+	Contract hexutil.Bytes     `json:"contract"`
+	Proof    scom.AccountProof `json:"proof"`
+	Extra    hexutil.Bytes     `json:"extra"`
+}
+
+// CosmosFixture is the amino encoded multistore proof of a message against the app hash.
+type CosmosFixture struct {
+	AppHash hexutil.Bytes `json:"appHash"`
+	Proof   hexutil.Bytes `json:"proof"`
+	Extra   hexutil.Bytes `json:"extra"`
+}
+
+// ZilliqaFixture is the account and storage proof of a zilliqa contract, and the value stored.
+type ZilliqaFixture struct {
+	Root     hexutil.Bytes    `json:"root"`
+	Contract hexutil.Bytes    `json:"contract"`
+	Proof    zilliqa.ZILProof `json:"proof"`
+	Value    string           `json:"value"`
+}
+
+// Result is the self-test result of a router.
+type Result struct {
+	Router  uint64
+	Fixture string
+	Err     error
+}
+
+type check struct {
+	fixture string
+	routers []uint64
+	verify  func(raw []byte) error
+}
+
+// the evm compatible routers share the storage proof verification of `scom.AccountProof`.
+var checks = []*check{
+	{
+		fixture: "evm.json",
+		routers: []uint64{utils.ETH_ROUTER, utils.BSC_ROUTER, utils.HECO_ROUTER, utils.QUORUM_ROUTER, utils.MSC_ROUTER, utils.POLYGON_BOR_ROUTER},
+		verify:  verifyEVM,
+	},
+	{
+		fixture: "cosmos.json",
+		routers: []uint64{utils.COSMOS_ROUTER},
+		verify:  verifyCosmos,
+	},
+	{
+		fixture: "zilliqa.json",
+		routers: []uint64{utils.ZILLIQA_ROUTER},
+		verify:  verifyZilliqa,
+	},
+}
+
+// Run verifies the fixtures and returns the result of every router covered, the routers of the
+// same fixture share the result.
+func Run() []*Result {
+	var results []*Result
+	for _, c := range checks {
+		raw, err := fixtures.ReadFile("fixtures/" + c.fixture)
+		if err == nil {
+			err = c.verify(raw)
+		}
+		for _, router := range c.routers {
+			results = append(results, &Result{Router: router, Fixture: c.fixture, Err: err})
+		}
+	}
+	return results
+}
+
+// Verify runs the self-test and returns an error listing the failed fixtures if any.
+func Verify() error {
+	var failed []string
+	seen := make(map[string]bool)
+	for _, r := range Run() {
+		if r.Err != nil && !seen[r.Fixture] {
+			seen[r.Fixture] = true
+			failed = append(failed, fmt.Sprintf("%s: %v", r.Fixture, r.Err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("proof verifier self-test failed, %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func verifyEVM(raw []byte) error {
+	f := new(EVMFixture)
+	if err := json.Unmarshal(raw, f); err != nil {
+		return err
+	}
+	values, err := f.Proof.Verify(f.Root, f.Contract)
+	if err != nil {
+		return err
+	}
+	if len(values) != 1 {
+		return fmt.Errorf("%d storage values proved, expect 1", len(values))
+	}
+	if err := scom.CheckProofResult(values[0], f.Extra); err != nil {
+		return err
+	}
+	return new(scom.MakeTxParam).Deserialization(polycomm.NewZeroCopySource(f.Extra))
+}
+
+func verifyCosmos(raw []byte) error {
+	f := new(CosmosFixture)
+	if err := json.Unmarshal(raw, f); err != nil {
+		return err
+	}
+	_, err := cosmos.VerifyProof(f.Proof, f.Extra, f.AppHash)
+	return err
+}
+
+func verifyZilliqa(raw []byte) error {
+	f := new(ZilliqaFixture)
+	if err := json.Unmarshal(raw, f); err != nil {
+		return err
+	}
+	value, err := zilliqa.VerifyMerkleProof(&f.Proof, f.Root, f.Contract)
+	if err != nil {
+		return err
+	}
+	if string(value) != f.Value {
+		return fmt.Errorf("proved value %q mismatches %q", value, f.Value)
+	}
+	return nil
+}
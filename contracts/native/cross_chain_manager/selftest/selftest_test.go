@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package selftest
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/cosmos"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	polycomm "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var writeFixturesFlag = flag.Bool("write-fixtures", false, "Overwrite the generated fixtures in fixtures/")
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/selftest -run TestSelfTest
+func TestSelfTest(t *testing.T) {
+	if *writeFixturesFlag {
+		writeFixture(t, "evm.json", newEVMFixture(t))
+		writeFixture(t, "cosmos.json", newCosmosFixture(t))
+		t.Skip("fixtures written, they are embedded and verified by the next run")
+	}
+	results := Run()
+	assert.NotEmpty(t, results)
+	for _, r := range results {
+		assert.NoError(t, r.Err, "router %d, fixture %s", r.Router, r.Fixture)
+	}
+	assert.NoError(t, Verify())
+}
+
+// the fixtures must fail once tampered, or the self-test would pass with broken verifiers.
+func TestTamperedFixtures(t *testing.T) {
+	for _, c := range checks {
+		raw, err := fixtures.ReadFile("fixtures/" + c.fixture)
+		assert.NoError(t, err)
+		fields := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal(raw, &fields))
+		for _, name := range []string{"root", "appHash"} {
+			if _, ok := fields[name]; ok {
+				fields[name] = hexutil.Encode(crypto.Keccak256([]byte(name)))
+			}
+		}
+		tampered, _ := json.Marshal(fields)
+		assert.Error(t, c.verify(tampered), c.fixture)
+	}
+}
+
+func testMessage() []byte {
+	msg := &scom.MakeTxParam{
+		TxHash:              crypto.Keccak256([]byte("selftest")),
+		CrossChainID:        crypto.Keccak256([]byte("selftest"), []byte{1}),
+		FromContractAddress: common.HexToAddress("0x1").Bytes(),
+		ToChainID:           1,
+		ToContractAddress:   common.HexToAddress("0x2").Bytes(),
+		Method:              "unlock",
+		Args:                []byte("selftest"),
+	}
+	sink := polycomm.NewZeroCopySink(nil)
+	msg.Serialization(sink)
+	return sink.Bytes()
+}
+
+func newEVMFixture(t *testing.T) *EVMFixture {
+	ccmc := common.HexToAddress("0xcc3c")
+	extra := testMessage()
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	db.SetNonce(ccmc, 1)
+	db.SetCode(ccmc, []byte{0x1})
+	key := common.BigToHash(big.NewInt(1))
+	db.SetState(ccmc, key, crypto.Keccak256Hash(extra))
+	root, err := db.Commit(false)
+	assert.NoError(t, err)
+	db, _ = state.New(root, db.Database(), nil)
+
+	acctProof, err := db.GetProof(ccmc)
+	assert.NoError(t, err)
+	storageProof, err := db.GetStorageProof(ccmc, key)
+	assert.NoError(t, err)
+	return &EVMFixture{
+		Root:     root,
+		Contract: ccmc.Bytes(),
+		Proof: scom.AccountProof{
+			Address:       ccmc.Hex(),
+			Balance:       "0x0",
+			CodeHash:      db.GetCodeHash(ccmc).Hex(),
+			Nonce:         "0x1",
+			StorageHash:   db.StorageTrie(ccmc).Hash().Hex(),
+			AccountProof:  hexNodes(acctProof),
+			StorageProofs: []scom.StorageProof{{Key: key.Hex(), Proof: hexNodes(storageProof)}},
+		},
+		Extra: extra,
+	}
+}
+
+func newCosmosFixture(t *testing.T) *CosmosFixture {
+	storeKey := storetypes.NewKVStoreKey("ccm")
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	assert.NoError(t, ms.LoadLatestVersion())
+
+	extra := testMessage()
+	key := crypto.Keccak256(extra)
+	ms.GetKVStore(storeKey).Set(key, extra)
+	commit := ms.Commit()
+	res := ms.Query(abci.RequestQuery{Path: "/ccm/key", Data: key, Height: commit.Version, Prove: true})
+	assert.Zero(t, res.Code, res.Log)
+
+	cdc := codec.New()
+	proof, err := cdc.MarshalBinaryBare(res.Proof)
+	assert.NoError(t, err)
+	kp := merkle.KeyPath{}.AppendKey([]byte("ccm"), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex)
+	value, err := cdc.MarshalBinaryBare(&cosmos.CosmosProofValue{Kp: kp.String(), Value: extra})
+	assert.NoError(t, err)
+	return &CosmosFixture{AppHash: commit.Hash, Proof: proof, Extra: value}
+}
+
+func writeFixture(t *testing.T, name string, fixture interface{}) {
+	enc, err := json.MarshalIndent(fixture, "", "  ")
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join("fixtures", name), append(enc, '\n'), 0644))
+}
+
+func hexNodes(nodes [][]byte) []string {
+	list := make([]string, len(nodes))
+	for i, node := range nodes {
+		list[i] = hexutil.Encode(node)
+	}
+	return list
+}
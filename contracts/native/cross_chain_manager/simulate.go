@@ -0,0 +1,49 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// SimulateImport runs the verification of `importOuterTransfer` against the given state without
+// making the target chain transaction, so that relayers are able to find out the failing imports
+// before paying gas. the chain handlers modify the state, so the caller should pass in a copy.
+func SimulateImport(db *state.StateDB, blockHeight *big.Int, params *scom.EntranceParam) (*scom.MakeTxParam, error) {
+	payload, err := utils.PackMethodWithStruct(scom.ABI, scom.MethodImportOuterTransfer, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, blockHeight, common.EmptyHash, 0, nil)
+	ref.PushContext(&native.Context{
+		Caller:          common.EmptyAddress,
+		ContractAddress: this,
+		Payload:         payload,
+	})
+	defer ref.PopContext()
+
+	_, txParam, err := verifyImport(native.NewNativeContract(db, ref))
+	return txParam, err
+}
@@ -3,8 +3,10 @@ package cross_chain_manager
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
 	cstates "github.com/polynetwork/poly/core/states"
 )
 
@@ -33,3 +35,9 @@ func CheckIfChainBlacked(native *native.NativeContract, chainID uint64) (bool, e
 	}
 	return true, nil
 }
+
+// IsChainBlacked reads whether the chain is blacked from the given state.
+func IsChainBlacked(db *state.StateDB, chainID uint64) (bool, error) {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big0, common.EmptyHash, 0, nil)
+	return CheckIfChainBlacked(native.NewNativeContract(db, ref), chainID)
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package cross_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+const ENTRANCE_WHITELIST = "entranceWhitelist"
+
+// ErrCallerNotWhitelisted is returned by the dispatcher if the caller of entrance is not in the
+// whitelist of the permissioned side chain.
+var ErrCallerNotWhitelisted = fmt.Errorf("caller is not in the entrance whitelist")
+
+// SetEntranceWhitelist validators switch the permissioned mode of the side chain and replace the
+// callers allowed to call `importOuterTransfer`, `syncBlockHeader` and `syncCrossChainMsg` of the
+// chain, it takes effect after the consensus signs reached quorum.
+func SetEntranceWhitelist(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.SetEntranceWhitelistParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetEntranceWhitelist, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChain(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetEntranceWhitelist, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SetEntranceWhitelist, side chain %d is not registered", params.ChainID)
+	}
+	if params.Enabled && len(params.Callers) == 0 {
+		return nil, fmt.Errorf("SetEntranceWhitelist, the whitelist of permissioned chain is empty")
+	}
+	for i, c := range params.Callers {
+		if c == common.EmptyAddress {
+			return nil, fmt.Errorf("SetEntranceWhitelist, caller %d is empty", i)
+		}
+		for _, prev := range params.Callers[:i] {
+			if prev == c {
+				return nil, fmt.Errorf("SetEntranceWhitelist, duplicated caller %s", c.Hex())
+			}
+		}
+	}
+
+	whitelist, err := GetEntranceWhitelist(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetEntranceWhitelist, GetEntranceWhitelist error: %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(whitelist.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, scom.MethodSetEntranceWhitelist, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetEntranceWhitelist, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetEntranceWhitelist, true)
+	}
+
+	PutEntranceWhitelist(native, params.ChainID, &scom.EntranceWhitelist{
+		Enabled: params.Enabled,
+		Callers: params.Callers,
+		Nonce:   whitelist.Nonce + 1,
+	})
+	callers := params.Callers
+	if callers == nil {
+		callers = []common.Address{}
+	}
+	if err := native.AddNotify(scom.ABI, []string{scom.NOTIFY_WHITELIST_EVENT}, params.ChainID, params.Enabled, callers); err != nil {
+		return nil, fmt.Errorf("SetEntranceWhitelist, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetEntranceWhitelist, true)
+}
+
+func EntranceWhitelist(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &scom.BlackChainParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodEntranceWhitelist, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	whitelist, err := GetEntranceWhitelist(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("EntranceWhitelist, GetEntranceWhitelist error: %v", err)
+	}
+	callers := whitelist.Callers
+	if callers == nil {
+		callers = []common.Address{}
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodEntranceWhitelist, whitelist.Enabled, callers)
+}
+
+func PutEntranceWhitelist(native *native.NativeContract, chainID uint64, whitelist *scom.EntranceWhitelist) {
+	contract := utils.CrossChainManagerContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	whitelist.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(ENTRANCE_WHITELIST), utils.GetUint64Bytes(chainID)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetEntranceWhitelist returns a disabled whitelist which allows any caller if it's never set.
+func GetEntranceWhitelist(native *native.NativeContract, chainID uint64) (*scom.EntranceWhitelist, error) {
+	contract := utils.CrossChainManagerContractAddress
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(ENTRANCE_WHITELIST), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("GetEntranceWhitelist, get whitelist store error: %v", err)
+	}
+	whitelist := new(scom.EntranceWhitelist)
+	if store == nil {
+		return whitelist, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetEntranceWhitelist, deserialize from raw storage item err:%v", err)
+	}
+	if err := whitelist.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetEntranceWhitelist, deserialize whitelist error: %v", err)
+	}
+	return whitelist, nil
+}
+
+// checkEntranceCaller is the caller guard of the cross chain manager and header sync, which
+// rejects the entrance calls of permissioned chains from the callers out of the whitelist. the
+// malformed input is left to the method itself.
+func checkEntranceCaller(native *native.NativeContract) error {
+	ctx := native.ContractRef().CurrentContext()
+	var chainID uint64
+	switch ctx.ContractAddress {
+	case utils.CrossChainManagerContractAddress:
+		method, err := scom.ABI.MethodById(ctx.Payload)
+		if err != nil || method.Name != scom.MethodImportOuterTransfer {
+			return nil
+		}
+		params, err := scom.UnpackEntranceParam(ctx.Payload)
+		if err != nil {
+			return nil
+		}
+		chainID = params.SourceChainID
+	case utils.HeaderSyncContractAddress:
+		method, err := hscommon.ABI.MethodById(ctx.Payload)
+		if err != nil {
+			return nil
+		}
+		switch method.Name {
+		case hscommon.MethodSyncBlockHeader:
+			params := &hscommon.SyncBlockHeaderParam{}
+			if err := utils.UnpackMethod(hscommon.ABI, method.Name, params, ctx.Payload); err != nil {
+				return nil
+			}
+			chainID = params.ChainID
+		case hscommon.MethodSyncCrossChainMsg:
+			params := &hscommon.SyncCrossChainMsgParam{}
+			if err := utils.UnpackMethod(hscommon.ABI, method.Name, params, ctx.Payload); err != nil {
+				return nil
+			}
+			chainID = params.ChainID
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	whitelist, err := GetEntranceWhitelist(native, chainID)
+	if err != nil {
+		return err
+	}
+	if !whitelist.Allows(ctx.Caller) {
+		return ErrCallerNotWhitelisted
+	}
+	return nil
+}
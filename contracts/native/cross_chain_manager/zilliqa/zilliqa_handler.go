@@ -31,7 +31,6 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
-	"github.com/ethereum/go-ethereum/contracts/native/utils"
 )
 
 // Handler ...
@@ -45,24 +44,23 @@ func NewHandler() *Handler {
 
 // MakeDepositProposal ...
 func (h *Handler) MakeDepositProposal(service *native.NativeContract) (*scom.MakeTxParam, error) {
-	ctx := service.ContractRef().CurrentContext()
-	params := &scom.EntranceParam{}
-	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := scom.UnpackEntranceParam(service.ContractRef().CurrentContext().Payload)
+	if err != nil {
 		return nil, err
 	}
 
-	sideChain, err := side_chain_manager.GetSideChain(service, params.SourceChainID)
+	sideChain, err := side_chain_manager.GetSideChainAt(service, params.SourceChainID, uint64(params.Height))
 	if err != nil {
-		return nil, fmt.Errorf("zilliqa MakeDepositProposal, side_chain_manager.GetSideChain error: %v", err)
+		return nil, fmt.Errorf("zilliqa MakeDepositProposal, side_chain_manager.GetSideChainAt error: %v", err)
 	}
 
 	value, err := verifyFromTx(service, params.Proof, params.Extra, params.SourceChainID, params.Height, sideChain)
 	if err != nil {
-		return nil, fmt.Errorf("zil MakeDepositProposal, verifyFromZILTx error: %s", err)
+		return nil, fmt.Errorf("zil MakeDepositProposal, verifyFromZILTx error: %w", err)
 	}
 
 	if err := scom.CheckDoneTx(service, value.CrossChainID, params.SourceChainID); err != nil {
-		return nil, fmt.Errorf("zil MakeDepositProposal, check done transaction error:%s", err)
+		return nil, fmt.Errorf("zil MakeDepositProposal, check done transaction error:%w", err)
 	}
 	if err := scom.PutDoneTx(service, value.CrossChainID, params.SourceChainID); err != nil {
 		return nil, fmt.Errorf("zil MakeDepositProposal, PutDoneTx error:%s", err)
@@ -86,37 +84,60 @@ type StorageProof struct {
 func verifyFromTx(native *native.NativeContract, proof, extra []byte, fromChainID uint64, height uint32, sideChain *side_chain_manager.SideChain) (param *scom.MakeTxParam, err error) {
 	bestHeader, err := zilliqa.GetCurrentTxHeader(native, fromChainID)
 	if err != nil {
-		return nil, fmt.Errorf("VerifyFromZilProof, get current header fail, error:%s", err)
+		return nil, scom.NewImportError(scom.ErrCodeHeaderNotSynced, "VerifyFromZilProof, get current header fail, error:%s", err)
 	}
 
 	bestHeight := uint32(bestHeader.BlockHeader.BlockNum)
 	if bestHeight < height {
-		return nil, fmt.Errorf("VerifyFromZilProof, transaction is not confirmed, current height: %d, input height: %d", bestHeight, height)
+		return nil, scom.NewImportError(scom.ErrCodeHeaderNotSynced, "VerifyFromZilProof, transaction is not confirmed, current height: %d, input height: %d", bestHeight, height)
 	}
 	blockData, err := zilliqa.GetTxHeaderByHeight(native, uint64(height), fromChainID)
 	if err != nil {
-		return nil, fmt.Errorf("VerifyFromZilProof, get header by height, height:%d, error:%s", height, err)
+		return nil, scom.NewImportError(scom.ErrCodeHeaderNotSynced, "VerifyFromZilProof, get header by height, height:%d, error:%s", height, err)
 	}
 
 	var zilProof ZILProof
 	err = json.Unmarshal(proof, &zilProof)
 	if err != nil {
-		return nil, fmt.Errorf("VerifyFromZilProof, unmarshal proof error:%s", err)
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromZilProof, unmarshal proof error:%s", err)
+	}
+
+	root := blockData.BlockHeader.HashSet.StateRootHash[:]
+	proofResult, err := VerifyMerkleProof(&zilProof, root, sideChain.CCMCAddress)
+	if err != nil {
+		return nil, err
 	}
 
+	if !checkProofResult(proofResult, extra) {
+		return nil, fmt.Errorf("verifyMerkleProof, check state proof result failed proof result: %s, extra: %s", util.EncodeHex(proofResult), util.EncodeHex(extra))
+	}
+
+	data := common.NewZeroCopySource(extra)
+	txParam := new(scom.MakeTxParam)
+	if err := txParam.Deserialization(data); err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromZilProof, deserialize merkleValue error:%s", err)
+	}
+	return txParam, nil
+}
+
+// VerifyMerkleProof verifies the account proof of the contract against the state root, and the
+// storage proof against the storage root of the account, it returns the proved storage value.
+func VerifyMerkleProof(zilProof *ZILProof, root, contract []byte) ([]byte, error) {
 	if len(zilProof.StorageProofs) != 1 {
-		return nil, fmt.Errorf("VerifyFromZilProof, incorrect proof format")
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromZilProof, incorrect proof format")
 	}
 
 	var pf [][]byte
-	for _, p := range zilProof.AccountProof {
-		bytes := util.DecodeHex(p)
+	for i, p := range zilProof.AccountProof {
+		bytes, err := scom.DecodeHex(p)
+		if err != nil {
+			return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromZilProof, invalid account proof node %d: %v", i, err)
+		}
 		pf = append(pf, bytes)
 	}
 
 	db := mpt.NewFromProof(pf)
-	root := blockData.BlockHeader.HashSet.StateRootHash[:]
-	key := strings.TrimPrefix(util.EncodeHex(sideChain.CCMCAddress), "0x")
+	key := strings.TrimPrefix(util.EncodeHex(contract), "0x")
 	accountBaseBytes, err := mpt.Verify([]byte(key), db, root)
 	if err != nil {
 		return nil, fmt.Errorf("verifyMerkleProof, verify account proof error:%s, key is %s proof is: %+v, root is %s", err, key, zilProof.AccountProof, util.EncodeHex(root))
@@ -128,13 +149,19 @@ func verifyFromTx(native *native.NativeContract, proof, extra []byte, fromChainI
 	}
 
 	var proof2 [][]byte
-	for _, p := range zilProof.StorageProofs[0].Proof {
-		bytes := util.DecodeHex(p)
+	for i, p := range zilProof.StorageProofs[0].Proof {
+		bytes, err := scom.DecodeHex(p)
+		if err != nil {
+			return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromZilProof, invalid storage proof node %d: %v", i, err)
+		}
 		proof2 = append(proof2, bytes)
 	}
 
 	db2 := mpt.NewFromProof(proof2)
-	storageKey := util.DecodeHex(string(zilProof.StorageProofs[0].Key))
+	storageKey, err := scom.DecodeHex(string(zilProof.StorageProofs[0].Key))
+	if err != nil {
+		return nil, scom.NewImportError(scom.ErrCodeInvalidProof, "VerifyFromZilProof, invalid storage key: %v", err)
+	}
 	hashedStorageKey := util.Sha256(storageKey)
 	proofResult, err := mpt.Verify([]byte((util.EncodeHex(hashedStorageKey))), db2, accountBase.StorageRoot)
 	if err != nil {
@@ -147,16 +174,7 @@ func verifyFromTx(native *native.NativeContract, proof, extra []byte, fromChainI
 			util.EncodeHex(storageKey), zilProof.AccountProof, zilProof.StorageProofs[0].Proof, util.EncodeHex(accountBaseBytes), util.EncodeHex(accountBase.StorageRoot))
 	}
 
-	if !checkProofResult(proofResult, extra) {
-		return nil, fmt.Errorf("verifyMerkleProof, check state proof result failed proof result: %s, extra: %s", util.EncodeHex(proofResult), util.EncodeHex(extra))
-	}
-
-	data := common.NewZeroCopySource(extra)
-	txParam := new(scom.MakeTxParam)
-	if err := txParam.Deserialization(data); err != nil {
-		return nil, fmt.Errorf("VerifyFromZilProof, deserialize merkleValue error:%s", err)
-	}
-	return txParam, nil
+	return proofResult, nil
 }
 
 func checkProofResult(result, value []byte) bool {
@@ -0,0 +1,177 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	ErrNotNativeABI    = errors.New("no registered abi of the native contract")
+	ErrUnknownMethod   = errors.New("unknown native contract method")
+	ErrAmbiguousMethod = errors.New("method id matches multiple native contracts, the contract address is required")
+)
+
+// DecodedCall is a native contract call decoded with the registered abi.
+type DecodedCall struct {
+	Contract common.Address         `json:"contract"`
+	Name     string                 `json:"name"` // native contract name, e.g: `node_manager`
+	Method   string                 `json:"method"`
+	Args     map[string]interface{} `json:"args"`
+}
+
+// DecodedEvent is a native contract event log decoded with the registered abi.
+type DecodedEvent struct {
+	Contract common.Address         `json:"contract"`
+	Name     string                 `json:"name"`
+	Event    string                 `json:"event"`
+	Args     map[string]interface{} `json:"args"`
+}
+
+// DecodeCall decodes the calldata of native contract method. the contract is looked up by the
+// method id if the address is nil, which fails if more than one contract has the method id.
+func DecodeCall(to *common.Address, data []byte) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata too short: %d bytes", len(data))
+	}
+	var entries []*ContractABI
+	if to != nil {
+		entry, ok := ABIByAddress(*to)
+		if !ok {
+			return nil, ErrNotNativeABI
+		}
+		entries = append(entries, entry)
+	} else {
+		entries = RegisteredABIs()
+	}
+
+	var decoded *DecodedCall
+	for _, entry := range entries {
+		ab, err := abi.JSON(strings.NewReader(entry.JSON))
+		if err != nil {
+			return nil, fmt.Errorf("invalid abi of %s: %v", entry.Name, err)
+		}
+		method, err := ab.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		args := make(map[string]interface{})
+		if err := method.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+			if to != nil {
+				return nil, fmt.Errorf("decode %s.%s failed: %v", entry.Name, method.Name, err)
+			}
+			continue
+		}
+		if decoded != nil {
+			return nil, ErrAmbiguousMethod
+		}
+		decoded = &DecodedCall{
+			Contract: entry.Address,
+			Name:     entry.Name,
+			Method:   method.Name,
+			Args:     displayArgs(args),
+		}
+	}
+	if decoded == nil {
+		return nil, ErrUnknownMethod
+	}
+	return decoded, nil
+}
+
+// DecodeLog decodes the event log emitted by native contract, it returns nil without error if
+// the log is not emitted by a registered native contract.
+func DecodeLog(log *types.Log) (*DecodedEvent, error) {
+	entry, ok := ABIByAddress(log.Address)
+	if !ok || len(log.Topics) == 0 {
+		return nil, nil
+	}
+	ab, err := abi.JSON(strings.NewReader(entry.JSON))
+	if err != nil {
+		return nil, fmt.Errorf("invalid abi of %s: %v", entry.Name, err)
+	}
+	event, err := ab.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+	args := make(map[string]interface{})
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(args, log.Data); err != nil {
+		return nil, fmt.Errorf("decode event %s.%s failed: %v", entry.Name, event.Name, err)
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("decode event %s.%s topics failed: %v", entry.Name, event.Name, err)
+	}
+	return &DecodedEvent{
+		Contract: entry.Address,
+		Name:     entry.Name,
+		Event:    event.Name,
+		Args:     displayArgs(args),
+	}, nil
+}
+
+// displayArgs converts the byte values to hex and the big integers to decimal strings, so that
+// the decoded arguments are readable in json.
+func displayArgs(args map[string]interface{}) map[string]interface{} {
+	for k, v := range args {
+		args[k] = displayValue(v)
+	}
+	return args
+}
+
+func displayValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *big.Int:
+		return value.String()
+	case []byte:
+		return hexutil.Bytes(value)
+	case common.Address, common.Hash:
+		return value
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Bytes(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = displayValue(rv.Index(i).Interface())
+		}
+		return list
+	}
+	return v
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDecodeABIJSON = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"To","type":"address"},{"name":"Amount","type":"uint256"},{"name":"Memo","type":"bytes"}],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"Transferred","inputs":[{"name":"To","type":"address","indexed":true},{"name":"Amount","type":"uint256","indexed":false}],"anonymous":false}
+]`
+
+func TestDecodeCallAndLog(t *testing.T) {
+	RegisterABI(NativeExtra18, "Test", testDecodeABIJSON)
+	defer delete(abiRegistry, NativeExtra18)
+	ab, err := abi.JSON(strings.NewReader(testDecodeABIJSON))
+	assert.NoError(t, err)
+
+	to := common.HexToAddress("0x01")
+	input, err := ab.Pack("transfer", to, big.NewInt(100), []byte{0xca, 0xfe})
+	assert.NoError(t, err)
+
+	// decode by the contract address, and by the method id without address
+	for _, addr := range []*common.Address{&testCallerA, nil} {
+		call, err := DecodeCall(addr, input)
+		assert.NoError(t, err)
+		assert.Equal(t, testCallerA, call.Contract)
+		assert.Equal(t, "transfer", call.Method)
+		assert.Equal(t, to, call.Args["To"])
+		assert.Equal(t, "100", call.Args["Amount"])
+		assert.Equal(t, hexutil.Bytes{0xca, 0xfe}, call.Args["Memo"])
+	}
+	_, err = DecodeCall(&testCalleeB, input)
+	assert.Equal(t, ErrNotNativeABI, err)
+	_, err = DecodeCall(&testCallerA, []byte{1, 2, 3, 4})
+	assert.Equal(t, ErrUnknownMethod, err)
+
+	data, err := ab.Events["Transferred"].Inputs.NonIndexed().Pack(big.NewInt(100))
+	assert.NoError(t, err)
+	log := &types.Log{
+		Address: testCallerA,
+		Topics:  []common.Hash{ab.Events["Transferred"].ID, common.BytesToHash(to.Bytes())},
+		Data:    data,
+	}
+	event, err := DecodeLog(log)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transferred", event.Event)
+	assert.Equal(t, to, event.Args["To"])
+	assert.Equal(t, "100", event.Args["Amount"])
+
+	// logs of the other contracts are skipped
+	log.Address = testCalleeB
+	event, err = DecodeLog(log)
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
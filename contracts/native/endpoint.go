@@ -18,14 +18,17 @@
 package native
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
 )
 
-// support native functions to evm functions.
-type EVMHandler func(caller, addr common.Address, input []byte) ([]byte, uint64, error)
+// support native functions to evm functions, the handler returns the gas left over of the
+// supplied gas.
+type EVMHandler func(caller, addr common.Address, input []byte, gas uint64) ([]byte, uint64, error)
 
 type ContractRef struct {
 	contexts []*Context
@@ -37,6 +40,11 @@ type ContractRef struct {
 	caller      common.Address
 	evmHandler  EVMHandler
 	gasLeft     uint64
+	systemTx    bool
+	config      *params.ChainConfig
+	value       *big.Int
+	blockTime   uint64
+	outer       *ContractRef // the native call which the evm contract making this call is called back from
 }
 
 func NewContractRef(
@@ -79,11 +87,21 @@ func (s *ContractRef) NativeCall(
 	return
 }
 
-func (s *ContractRef) EVMCall(caller, contractAddr common.Address, input []byte) ([]byte, uint64, error) {
+// EVMCall calls the evm contract with the gas supplied, which is charged from the gas left of
+// the native call, and the gas left over by the evm contract is refunded.
+func (s *ContractRef) EVMCall(caller, contractAddr common.Address, input []byte, gas uint64) ([]byte, error) {
 	if s.evmHandler == nil {
-		return nil, 0, nil
+		return nil, ErrEVMUnavailable
 	}
-	return s.evmHandler(caller, contractAddr, input)
+	if !s.UseGas(gas) {
+		return nil, fmt.Errorf("gasLeft not enough, need %d, got %d", gas, s.gasLeft)
+	}
+	ret, leftOverGas, err := s.evmHandler(caller, contractAddr, input, gas)
+	if leftOverGas > gas {
+		leftOverGas = gas
+	}
+	s.gasLeft += leftOverGas
+	return ret, err
 }
 
 func (s *ContractRef) StateDB() *state.StateDB {
@@ -112,8 +130,184 @@ func (s *ContractRef) GasLeft() uint64 {
 	return s.gasLeft
 }
 
+// SetSystemTx marks the native call as the system transaction of the block proposer.
+func (s *ContractRef) SetSystemTx() {
+	s.systemTx = true
+}
+
+// IsSystemTx returns true if the native call is made by the system transaction of the block
+// proposer, which is checked by every node to be exactly the one expected by consensus.
+func (s *ContractRef) IsSystemTx() bool {
+	return s.systemTx
+}
+
+// SetChainConfig sets the chain config consulted by the native handlers for the activation of
+// behavior changes.
+func (s *ContractRef) SetChainConfig(config *params.ChainConfig) {
+	s.config = config
+}
+
+// SetBlockTime sets the timestamp of the block executing the native call.
+func (s *ContractRef) SetBlockTime(time uint64) {
+	s.blockTime = time
+}
+
+// BlockTime returns the unix timestamp of the block, which is the only clock available for the
+// native handlers. it's 0 if the native call is made out of block processing, e.g: in queries.
+func (s *ContractRef) BlockTime() uint64 {
+	return s.blockTime
+}
+
+// SetValue sets the native token transferred to the entry contract with the call.
+func (s *ContractRef) SetValue(value *big.Int) {
+	s.value = value
+}
+
+// Value implement solidity grammar `msg.value`, it's only available in the entry context, since
+// the nested native calls never transfer native token.
+func (s *ContractRef) Value() *big.Int {
+	if s.value == nil || len(s.contexts) > 1 {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(s.value)
+}
+
+// ChainConfig returns the chain config of the native call, which is nil if not set.
+func (s *ContractRef) ChainConfig() *params.ChainConfig {
+	return s.config
+}
+
+// IsGovV2 returns true if the governance v2 fork is activated at the block. all of the native
+// forks are taken as activated if the native call is made without chain config, e.g: in tests.
+func (s *ContractRef) IsGovV2() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsGovV2(s.blockHeight)
+}
+
+// IsCrossChainV2 returns true if the cross chain v2 fork is activated at the block.
+func (s *ContractRef) IsCrossChainV2() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsCrossChainV2(s.blockHeight)
+}
+
+// IsStorageV2 returns true if the storage v2 fork is activated at the block.
+func (s *ContractRef) IsStorageV2() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsStorageV2(s.blockHeight)
+}
+
+// IsCrossChainBloom returns true if the cross chain bloom fork is activated at the block.
+func (s *ContractRef) IsCrossChainBloom() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsCrossChainBloom(s.blockHeight)
+}
+
+// IsImportRoot returns true if the import root fork is activated at the block.
+func (s *ContractRef) IsImportRoot() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsImportRoot(s.blockHeight)
+}
+
+// IsEpochHashV1 returns true if the epoch hash v1 fork is activated at the block.
+func (s *ContractRef) IsEpochHashV1() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsEpochHashV1(s.blockHeight)
+}
+
+// IsEpochHashV2 returns true if the epoch hash v2 fork is activated at the block.
+func (s *ContractRef) IsEpochHashV2() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsEpochHashV2(s.blockHeight)
+}
+
+// IsAccessControl returns true if the access control fork is activated at the block.
+func (s *ContractRef) IsAccessControl() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsAccessControl(s.blockHeight)
+}
+
+// IsInputRules returns true if the input rules fork is activated at the block.
+func (s *ContractRef) IsInputRules() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsInputRules(s.blockHeight)
+}
+
+// IsLightClientV2 returns true if the light client v2 fork is activated at the block.
+func (s *ContractRef) IsLightClientV2() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsLightClientV2(s.blockHeight)
+}
+
+// IsStorageRefund returns true if the storage refund fork is activated at the block.
+func (s *ContractRef) IsStorageRefund() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsStorageRefund(s.blockHeight)
+}
+
+// IsStorageRootCache returns true if the storage root cache fork is activated at the block.
+func (s *ContractRef) IsStorageRootCache() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsStorageRootCache(s.blockHeight)
+}
+
+// IsReentrancyGuard returns true if the reentrancy guard fork is activated at the block.
+func (s *ContractRef) IsReentrancyGuard() bool {
+	if s == nil || s.config == nil {
+		return true
+	}
+	return s.config.IsReentrancyGuard(s.blockHeight)
+}
+
+// UseGas charges gas from the gas left, it returns false if the gas left is not enough.
+func (s *ContractRef) UseGas(gas uint64) bool {
+	if s.gasLeft < gas {
+		return false
+	}
+	s.gasLeft -= gas
+	return true
+}
+
+// LimitGas runs the function with the gas left capped to the limit, the gas used by the function
+// is charged from the gas left as usual, and the gas reserved is given back afterwards.
+func (s *ContractRef) LimitGas(limit uint64, fn func() error) error {
+	if limit >= s.gasLeft {
+		return fn()
+	}
+	reserved := s.gasLeft - limit
+	s.gasLeft = limit
+	err := fn()
+	s.gasLeft += reserved
+	return err
+}
+
 const (
 	MAX_EXECUTE_CONTEXT = 128
+	// MAX_NATIVE_CALL_DEPTH limit the depth of native contracts calling each other
+	MAX_NATIVE_CALL_DEPTH = 8
 )
 
 type Context struct {
@@ -158,6 +352,37 @@ func (s *ContractRef) EntryContext() *Context {
 	return s.contexts[0]
 }
 
+// SetOuter links the native call in progress which the evm contract making this call is called
+// back from, e.g: native -> evm -> native.
+func (s *ContractRef) SetOuter(outer *ContractRef) {
+	s.outer = outer
+}
+
+// IsReentrant return true if the current contract has been entered by the previous contexts, and
+// since the reentrancy guard fork, by the contexts of the outer native calls through evm as well.
+func (s *ContractRef) IsReentrant() bool {
+	if len(s.contexts) < 1 {
+		return false
+	}
+	cur := s.contexts[len(s.contexts)-1].ContractAddress
+	for _, ctx := range s.contexts[:len(s.contexts)-1] {
+		if ctx.ContractAddress == cur {
+			return true
+		}
+	}
+	if !s.IsReentrancyGuard() {
+		return false
+	}
+	for outer := s.outer; outer != nil; outer = outer.outer {
+		for _, ctx := range outer.contexts {
+			if ctx.ContractAddress == cur {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (s *ContractRef) CheckContexts() bool {
 	if len(s.contexts) == 0 {
 		return false
@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package escrow
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "escrow"
+
+const (
+	MethodContractName = "name"
+	MethodLock         = "lock"
+	MethodRefund       = "refund"
+	MethodSwap         = "swap"
+
+	EventLocked   = "locked"
+	EventReleased = "released"
+	EventRefunded = "refunded"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodLock + `","inputs":[{"internalType":"bytes32","name":"HashLock","type":"bytes32"},{"internalType":"uint64","name":"Timelock","type":"uint64"},{"internalType":"address","name":"Recipient","type":"address"},{"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"internalType":"bytes","name":"SourceContract","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"payable"},
+	{"type":"function","name":"` + MethodRefund + `","inputs":[{"internalType":"bytes32","name":"HashLock","type":"bytes32"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSwap + `","inputs":[{"internalType":"bytes32","name":"HashLock","type":"bytes32"}],"outputs":[{"internalType":"address","name":"Sender","type":"address"},{"internalType":"address","name":"Recipient","type":"address"},{"internalType":"uint256","name":"Amount","type":"uint256"},{"internalType":"uint64","name":"Timelock","type":"uint64"},{"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"internalType":"bytes","name":"SourceContract","type":"bytes"},{"internalType":"uint8","name":"State","type":"uint8"}],"stateMutability":"view"},
+	{"type":"event","name":"` + EventLocked + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"HashLock","type":"bytes32"},{"indexed":false,"internalType":"address","name":"Sender","type":"address"},{"indexed":false,"internalType":"address","name":"Recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"},{"indexed":false,"internalType":"uint64","name":"Timelock","type":"uint64"}]},
+	{"type":"event","name":"` + EventReleased + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"HashLock","type":"bytes32"},{"indexed":false,"internalType":"bytes32","name":"Preimage","type":"bytes32"},{"indexed":false,"internalType":"address","name":"Recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]},
+	{"type":"event","name":"` + EventRefunded + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"HashLock","type":"bytes32"},{"indexed":false,"internalType":"address","name":"Sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.EscrowContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+type MethodLockInput struct {
+	HashLock       common.Hash
+	Timelock       uint64
+	Recipient      common.Address
+	SourceChainID  uint64
+	SourceContract []byte
+}
+
+func (m *MethodLockInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodLock, m.HashLock, m.Timelock, m.Recipient, m.SourceChainID, m.SourceContract)
+}
+func (m *MethodLockInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodLock, m, payload)
+}
+
+// MethodHashLockInput is shared by `refund` and `swap`.
+type MethodHashLockInput struct {
+	HashLock common.Hash
+}
+
+func (m *MethodHashLockInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.HashLock)
+}
+func (m *MethodHashLockInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodSwapOutput struct {
+	Sender         common.Address
+	Recipient      common.Address
+	Amount         *big.Int
+	Timelock       uint64
+	SourceChainID  uint64
+	SourceContract []byte
+	State          uint8
+}
+
+func (m *MethodSwapOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSwap, m.Sender, m.Recipient, m.Amount, m.Timelock, m.SourceChainID, m.SourceContract, m.State)
+}
+func (m *MethodSwapOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSwap, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitLocked(s *native.NativeContract, hashLock common.Hash, swap *Swap) error {
+	return s.AddNotify(ABI, []string{EventLocked}, hashLock, swap.Sender, swap.Recipient, swap.Amount, swap.Timelock)
+}
+
+func emitReleased(s *native.NativeContract, hashLock, preimage common.Hash, swap *Swap) error {
+	return s.AddNotify(ABI, []string{EventReleased}, hashLock, preimage, swap.Recipient, swap.Amount)
+}
+
+func emitRefunded(s *native.NativeContract, hashLock common.Hash, swap *Swap) error {
+	return s.AddNotify(ABI, []string{EventRefunded}, hashLock, swap.Sender, swap.Amount)
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package escrow
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodLock:         100000,
+		MethodRefund:       30000,
+		MethodSwap:         0,
+	}
+)
+
+func InitEscrow() {
+	InitABI()
+	native.RegisterABI(native.NativeEscrow, "Escrow", abijson)
+	cross_chain_manager.RegisterInboundHandler(this, Release)
+	native.Contracts[this] = RegisterEscrowContract
+}
+
+func RegisterEscrowContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.Register(MethodLock, Lock)
+	s.Register(MethodRefund, Refund)
+	s.RegisterQuery(MethodSwap, GetSwap)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+// Lock locks the native token transferred with the call under the hash lock, the fund is released
+// to the recipient once the counterparty contract on the source chain reveals the preimage through
+// the bridge before the timelock height, or refunded to the sender after that. the hash lock is
+// the id of the swap and used only once.
+func Lock(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodLockInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Recipient == common.EmptyAddress || len(input.SourceContract) == 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if localID, ok := cross_chain_manager.LocalChainID(s); ok && localID == input.SourceChainID {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	amount := s.ContractRef().Value()
+	if amount.Sign() <= 0 {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	if input.Timelock <= s.ContractRef().BlockHeight().Uint64() {
+		return utils.ByteFailed, ErrInvalidTimelock
+	}
+	swap, err := getSwap(s, input.HashLock)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if swap != nil {
+		return utils.ByteFailed, ErrSwapExists
+	}
+
+	swap = &Swap{
+		Sender:         s.ContractRef().MsgSender(),
+		Recipient:      input.Recipient,
+		Amount:         amount,
+		Timelock:       input.Timelock,
+		SourceChainID:  input.SourceChainID,
+		SourceContract: input.SourceContract,
+		State:          SwapLocked,
+	}
+	if err := setSwap(s, input.HashLock, swap); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitLocked(s, input.HashLock, swap); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodLock)
+}
+
+// Release implements the cross_chain_manager.InboundHandler, it releases the swap to the recipient
+// with the preimage revealed by the counterparty contract. the message is verified by the bridge,
+// and the preimage by its hash lock, so that the fund is never released without the preimage even
+// if the source chain is verified by attestation only. the import fails if the swap is not
+// releasable, the counterparty contract should only reveal the preimage it claimed with.
+func Release(s *native.NativeContract, fromChainID uint64, txParam *scom.MakeTxParam) error {
+	if txParam.Method != MethodReveal || len(txParam.Args) != common.HashLength {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "escrow, invalid reveal message %s", txParam.Method)
+	}
+	preimage := common.BytesToHash(txParam.Args)
+	lock := hashLock(preimage)
+	swap, err := getSwap(s, lock)
+	if err != nil {
+		return ErrStorage
+	}
+	if swap == nil {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "escrow, %v: %s", ErrSwapNotFound, lock.Hex())
+	}
+	if swap.SourceChainID != fromChainID || !bytes.Equal(swap.SourceContract, txParam.FromContractAddress) {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "escrow, preimage of %s revealed by unknown contract %x of chain %d",
+			lock.Hex(), txParam.FromContractAddress, fromChainID)
+	}
+	if swap.State != SwapLocked {
+		return scom.NewImportError(scom.ErrCodeTxAlreadyDone, "escrow, swap %s is %s", lock.Hex(), swap.State)
+	}
+	if s.ContractRef().BlockHeight().Uint64() >= swap.Timelock {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "escrow, swap %s expired at %d", lock.Hex(), swap.Timelock)
+	}
+
+	swap.State = SwapReleased
+	if err := setSwap(s, lock, swap); err != nil {
+		return ErrStorage
+	}
+	s.StateDB().SubBalance(this, swap.Amount)
+	s.StateDB().AddBalance(swap.Recipient, swap.Amount)
+	if err := emitReleased(s, lock, preimage, swap); err != nil {
+		return ErrEmitLog
+	}
+	return nil
+}
+
+// Refund anyone refunds the swap to the sender once the timelock height is reached, the preimage
+// revealed after that is rejected.
+func Refund(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodHashLockInput)
+	if err := input.Decode(MethodRefund, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	swap, err := getSwap(s, input.HashLock)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if swap == nil {
+		return utils.ByteFailed, ErrSwapNotFound
+	}
+	if swap.State != SwapLocked {
+		return utils.ByteFailed, ErrSwapFinalized
+	}
+	if s.ContractRef().BlockHeight().Uint64() < swap.Timelock {
+		return utils.ByteFailed, ErrNotExpired
+	}
+
+	swap.State = SwapRefunded
+	if err := setSwap(s, input.HashLock, swap); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	s.StateDB().SubBalance(this, swap.Amount)
+	s.StateDB().AddBalance(swap.Sender, swap.Amount)
+	if err := emitRefunded(s, input.HashLock, swap); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodRefund)
+}
+
+func GetSwap(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodHashLockInput)
+	if err := input.Decode(MethodSwap, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	swap, err := getSwap(s, input.HashLock)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	output := &MethodSwapOutput{Amount: common.Big0}
+	if swap != nil {
+		output = &MethodSwapOutput{
+			Sender:         swap.Sender,
+			Recipient:      swap.Recipient,
+			Amount:         swap.Amount,
+			Timelock:       swap.Timelock,
+			SourceChainID:  swap.SourceChainID,
+			SourceContract: swap.SourceContract,
+			State:          uint8(swap.State),
+		}
+	}
+	return output.Encode()
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package escrow
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSupplyGas = uint64(10000000)
+	testChainID   = uint64(2)
+	testTimelock  = uint64(100)
+)
+
+var (
+	testStateDB      *state.StateDB
+	testConfig       = &params.ChainConfig{ChainID: big.NewInt(1000), CrossChainV2Block: big.NewInt(0)}
+	testCounterparty = common.HexToAddress("0x1001").Bytes()
+	testPreimage     = common.HexToHash("0x5ec7e7")
+)
+
+func TestMain(m *testing.M) {
+	InitEscrow()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+}
+
+func newTestRef(origin common.Address, height uint64, value *big.Int) *native.ContractRef {
+	ref := native.NewContractRef(testStateDB, origin, origin, new(big.Int).SetUint64(height), common.HexToHash("0x1"), testSupplyGas, nil)
+	ref.SetChainConfig(testConfig)
+	ref.SetValue(value)
+	return ref
+}
+
+// invoke transfers the value to the contract as evm does before the native call, and reverts the
+// state on failure.
+func invoke(origin common.Address, height uint64, payload []byte, value *big.Int) ([]byte, error) {
+	snapshot := testStateDB.Snapshot()
+	if value != nil {
+		testStateDB.SubBalance(origin, value)
+		testStateDB.AddBalance(this, value)
+	}
+	ret, _, err := newTestRef(origin, height, value).NativeCall(origin, this, payload)
+	if err != nil {
+		testStateDB.RevertToSnapshot(snapshot)
+	}
+	return ret, err
+}
+
+func lock(sender, recipient common.Address, amount *big.Int) error {
+	payload, _ := (&MethodLockInput{
+		HashLock:       hashLock(testPreimage),
+		Timelock:       testTimelock,
+		Recipient:      recipient,
+		SourceChainID:  testChainID,
+		SourceContract: testCounterparty,
+	}).Encode()
+	_, err := invoke(sender, 1, payload, amount)
+	return err
+}
+
+// reveal imports the reveal message in context of the escrow as the cross chain manager does.
+func reveal(height, chainID uint64, from []byte, preimage common.Hash) error {
+	ref := newTestRef(common.EmptyAddress, height, nil)
+	ref.PushContext(&native.Context{Caller: utils.CrossChainManagerContractAddress, ContractAddress: this})
+	snapshot := testStateDB.Snapshot()
+	err := Release(native.NewNativeContract(testStateDB, ref), chainID, &scom.MakeTxParam{
+		FromContractAddress: from,
+		ToContractAddress:   this.Bytes(),
+		Method:              MethodReveal,
+		Args:                preimage.Bytes(),
+	})
+	if err != nil {
+		testStateDB.RevertToSnapshot(snapshot)
+	}
+	return err
+}
+
+func getTestSwap(t *testing.T) *MethodSwapOutput {
+	payload, _ := (&MethodHashLockInput{HashLock: hashLock(testPreimage)}).Encode(MethodSwap)
+	enc, err := invoke(common.EmptyAddress, 1, payload, nil)
+	assert.NoError(t, err)
+	output := new(MethodSwapOutput)
+	assert.NoError(t, output.Decode(enc))
+	return output
+}
+
+func TestLock(t *testing.T) {
+	resetTestContext()
+	alice, bob := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+	testStateDB.AddBalance(alice, big.NewInt(1000))
+
+	assert.Equal(t, ErrInvalidAmount, lock(alice, bob, nil))
+	assert.Equal(t, ErrInvalidInput, lock(alice, common.EmptyAddress, big.NewInt(100)))
+	assert.NoError(t, lock(alice, bob, big.NewInt(100)))
+	assert.Equal(t, big.NewInt(100), testStateDB.GetBalance(this))
+	assert.Equal(t, &MethodSwapOutput{
+		Sender:         alice,
+		Recipient:      bob,
+		Amount:         big.NewInt(100),
+		Timelock:       testTimelock,
+		SourceChainID:  testChainID,
+		SourceContract: testCounterparty,
+		State:          uint8(SwapLocked),
+	}, getTestSwap(t))
+
+	// the hash lock is used once
+	assert.Equal(t, ErrSwapExists, lock(alice, bob, big.NewInt(100)))
+	assert.Equal(t, big.NewInt(900), testStateDB.GetBalance(alice))
+}
+
+func TestRelease(t *testing.T) {
+	resetTestContext()
+	alice, bob := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+	testStateDB.AddBalance(alice, big.NewInt(1000))
+	assert.NoError(t, lock(alice, bob, big.NewInt(100)))
+
+	// only the preimage revealed by the counterparty contract in time releases the swap
+	assert.Error(t, reveal(2, testChainID, testCounterparty, common.HexToHash("0x1")))
+	assert.Error(t, reveal(2, testChainID+1, testCounterparty, testPreimage))
+	assert.Error(t, reveal(2, testChainID, common.HexToAddress("0x1002").Bytes(), testPreimage))
+	assert.Error(t, reveal(testTimelock, testChainID, testCounterparty, testPreimage))
+	assert.NoError(t, reveal(2, testChainID, testCounterparty, testPreimage))
+	assert.Equal(t, big.NewInt(100), testStateDB.GetBalance(bob))
+	assert.Equal(t, uint8(SwapReleased), getTestSwap(t).State)
+
+	// the released swap is final
+	assert.Error(t, reveal(2, testChainID, testCounterparty, testPreimage))
+	refund, _ := (&MethodHashLockInput{HashLock: hashLock(testPreimage)}).Encode(MethodRefund)
+	_, err := invoke(alice, testTimelock, refund, nil)
+	assert.Equal(t, ErrSwapFinalized, err)
+	assert.Zero(t, testStateDB.GetBalance(this).Sign())
+}
+
+func TestRefund(t *testing.T) {
+	resetTestContext()
+	alice, bob := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+	testStateDB.AddBalance(alice, big.NewInt(1000))
+	assert.NoError(t, lock(alice, bob, big.NewInt(100)))
+
+	refund, _ := (&MethodHashLockInput{HashLock: hashLock(testPreimage)}).Encode(MethodRefund)
+	_, err := invoke(bob, testTimelock-1, refund, nil)
+	assert.Equal(t, ErrNotExpired, err)
+
+	// anyone refunds the expired swap to the sender
+	_, err = invoke(bob, testTimelock, refund, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), testStateDB.GetBalance(alice))
+	assert.Equal(t, uint8(SwapRefunded), getTestSwap(t).State)
+	_, err = invoke(bob, testTimelock, refund, nil)
+	assert.Equal(t, ErrSwapFinalized, err)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package escrow
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_SWAP = "st_swap"
+)
+
+// getSwap returns nil if the hash lock was never used.
+func getSwap(s *native.NativeContract, hashLock common.Hash) (*Swap, error) {
+	value, err := s.GetCacheDB().Get(swapKey(hashLock))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	swap := new(Swap)
+	if err := rlp.DecodeBytes(value, swap); err != nil {
+		return nil, err
+	}
+	return swap, nil
+}
+
+// setSwap stores the swap, the finalized swaps are kept so that a hash lock is never reused.
+func setSwap(s *native.NativeContract, hashLock common.Hash, swap *Swap) error {
+	value, err := rlp.EncodeToBytes(swap)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(swapKey(hashLock), value)
+	return nil
+}
+
+func swapKey(hashLock common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SWAP), hashLock.Bytes())
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package escrow
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MethodReveal is the method of the cross chain messages revealing the preimage of a hash lock,
+// the args of the message is the 32 bytes preimage.
+const MethodReveal = "reveal"
+
+// SwapState is the state of the swap, the swap is final once released or refunded.
+type SwapState uint8
+
+const (
+	SwapUnknown SwapState = iota
+	SwapLocked
+	SwapReleased
+	SwapRefunded
+)
+
+func (s SwapState) String() string {
+	switch s {
+	case SwapLocked:
+		return "locked"
+	case SwapReleased:
+		return "released"
+	case SwapRefunded:
+		return "refunded"
+	default:
+		return "unknown"
+	}
+}
+
+// Swap is the fund locked with a hash lock. it's released to the recipient if the preimage is
+// revealed by the counterparty contract on the source chain before the timelock height, and
+// refunded to the sender after that.
+type Swap struct {
+	Sender         common.Address
+	Recipient      common.Address
+	Amount         *big.Int
+	Timelock       uint64
+	SourceChainID  uint64
+	SourceContract []byte
+	State          SwapState
+}
+
+// hashLock is sha256 of the preimage, which is compatible with the htlc of bitcoin and the
+// common htlc contracts of evm chains.
+func hashLock(preimage common.Hash) common.Hash {
+	return sha256.Sum256(preimage.Bytes())
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FinalizeHook is invoked once per block by the system contract in the system transaction of the
+// block proposer. the hook runs with the context of its native contract and the system contract
+// as caller, so native calls made by the hook are authorized as calls from the contract itself.
+type FinalizeHook func(s *NativeContract) error
+
+type finalizeHook struct {
+	gas  uint64
+	hook FinalizeHook
+}
+
+var finalizeHooks = make(map[common.Address]*finalizeHook)
+
+// RegisterFinalizeHook record the block finalization hook of the native contract with the gas
+// charged for each invocation, it should be called in the contract's init function, and panic if
+// the contract not exist.
+func RegisterFinalizeHook(name string, gas uint64, hook FinalizeHook) {
+	addr, ok := NativeContractAddrMap[name]
+	if !ok {
+		panic(fmt.Sprintf("native contract %s not exist", name))
+	}
+	finalizeHooks[addr] = &finalizeHook{gas: gas, hook: hook}
+}
+
+// FinalizeHooks returns the native contracts which registered finalize hook in ascending order of
+// address, which is the order the hooks are invoked in.
+func FinalizeHooks() []common.Address {
+	list := make([]common.Address, 0, len(finalizeHooks))
+	for addr := range finalizeHooks {
+		list = append(list, addr)
+	}
+	sortAddresses(list)
+	return list
+}
+
+// RunFinalizeHook invokes the finalize hook of the native contract with the current contract as
+// caller. the gas of the hook is charged from the gas left of the caller before the invocation,
+// which is shared with the native calls made by the hook, and the state modification of the hook
+// will be reverted if it returns error.
+func (s *NativeContract) RunFinalizeHook(addr common.Address) error {
+	h, ok := finalizeHooks[addr]
+	if !ok {
+		return fmt.Errorf("failed to find finalize hook: [%x]", addr)
+	}
+	ctx := s.ref.CurrentContext()
+	if ctx == nil {
+		return fmt.Errorf("context error")
+	}
+	if len(s.ref.contexts) >= MAX_NATIVE_CALL_DEPTH {
+		return ErrNativeCallDepth
+	}
+
+	if !s.ref.UseGas(h.gas) {
+		return fmt.Errorf("gasLeft not enough, need %d, got %d", h.gas, s.ref.gasLeft)
+	}
+
+	s.ref.PushContext(&Context{Caller: ctx.ContractAddress, ContractAddress: addr})
+	defer s.ref.PopContext()
+
+	snapshot := s.db.Snapshot()
+	if err := h.hook(NewNativeContract(s.db, s.ref)); err != nil {
+		s.db.RevertToSnapshot(snapshot)
+		return err
+	}
+	return nil
+}
+
+func sortAddresses(list []common.Address) {
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i][:], list[j][:]) < 0
+	})
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunFinalizeHook(t *testing.T) {
+	defer func() { finalizeHooks = make(map[common.Address]*finalizeHook) }()
+
+	const hookGas uint64 = 100
+	RegisterFinalizeHook(NativeExtra19, hookGas, func(s *NativeContract) error {
+		ctx := s.ContractRef().CurrentContext()
+		assert.Equal(t, utils.SystemContractAddress, ctx.Caller)
+		assert.Equal(t, testCalleeB, ctx.ContractAddress)
+
+		input, err := utils.PackMethod(testABI, "write")
+		assert.NoError(t, err)
+		_, err = s.CallNative(testCallerA, input)
+		return err
+	})
+	RegisterFinalizeHook(NativeExtra18, 0, func(s *NativeContract) error {
+		s.GetCacheDB().Put(utils.ConcatKey(testCalleeB, testStoreKey), []byte{1})
+		return errors.New("hook failed")
+	})
+
+	// hooks run in ascending order of contract address
+	assert.Equal(t, []common.Address{testCalleeB, testCallerA}, FinalizeHooks())
+
+	db, ref := newTestRef(t, hookGas+testWriteGas)
+	ref.PushContext(&Context{Caller: ref.caller, ContractAddress: utils.SystemContractAddress})
+	s := NewNativeContract(db, ref)
+
+	assert.NoError(t, s.RunFinalizeHook(testCalleeB))
+	assert.True(t, written(db, testCallerA))
+	assert.Equal(t, uint64(0), ref.GasLeft(), "gas of hook should be charged")
+	assert.Equal(t, 1, len(ref.contexts))
+
+	// hook is not invoked without enough gas
+	db, ref = newTestRef(t, hookGas-1)
+	ref.PushContext(&Context{Caller: ref.caller, ContractAddress: utils.SystemContractAddress})
+	s = NewNativeContract(db, ref)
+	assert.Error(t, s.RunFinalizeHook(testCalleeB))
+	assert.False(t, written(db, testCallerA))
+	assert.Equal(t, hookGas-1, ref.GasLeft())
+	assert.Equal(t, 1, len(ref.contexts))
+
+	// state of the failed hook is reverted
+	assert.Error(t, s.RunFinalizeHook(testCallerA))
+	assert.False(t, written(db, testCalleeB))
+
+	assert.Error(t, s.RunFinalizeHook(common.HexToAddress("0x1")))
+}
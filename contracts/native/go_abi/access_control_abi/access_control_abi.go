@@ -0,0 +1,600 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package access_control_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodHasRole = "hasRole"
+
+	MethodName = "name"
+
+	MethodRoleMembers = "roleMembers"
+
+	MethodGrantRole = "grantRole"
+
+	MethodRevokeRole = "revokeRole"
+)
+
+// AccessControlABI is the input ABI used to generate the binding from.
+const AccessControlABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"Role\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"Role\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"Role\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"roleMembers\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"Role\",\"type\":\"uint8\"}],\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"Members\",\"type\":\"address[]\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"roleGranted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Role\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"}]},{\"type\":\"event\",\"name\":\"roleRevoked\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Role\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"}]}]"
+
+// AccessControlFuncSigs maps the 4-byte function signature to its string representation.
+var AccessControlFuncSigs = map[string]string{
+	"61919bc1": "grantRole(address,uint8,address)",
+	"67125a1b": "hasRole(address,uint8,address)",
+	"06fdde03": "name()",
+	"df30958a": "revokeRole(address,uint8,address)",
+	"a6f6a87b": "roleMembers(address,uint8)",
+}
+
+// AccessControl is an auto generated Go binding around an Ethereum contract.
+type AccessControl struct {
+	AccessControlCaller     // Read-only binding to the contract
+	AccessControlTransactor // Write-only binding to the contract
+	AccessControlFilterer   // Log filterer for contract events
+}
+
+// AccessControlCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AccessControlCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccessControlTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AccessControlTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccessControlFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AccessControlFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccessControlSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AccessControlSession struct {
+	Contract     *AccessControl    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AccessControlCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AccessControlCallerSession struct {
+	Contract *AccessControlCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// AccessControlTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AccessControlTransactorSession struct {
+	Contract     *AccessControlTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// AccessControlRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AccessControlRaw struct {
+	Contract *AccessControl // Generic contract binding to access the raw methods on
+}
+
+// AccessControlCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AccessControlCallerRaw struct {
+	Contract *AccessControlCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AccessControlTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AccessControlTransactorRaw struct {
+	Contract *AccessControlTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAccessControl creates a new instance of AccessControl, bound to a specific deployed contract.
+func NewAccessControl(address common.Address, backend bind.ContractBackend) (*AccessControl, error) {
+	contract, err := bindAccessControl(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControl{AccessControlCaller: AccessControlCaller{contract: contract}, AccessControlTransactor: AccessControlTransactor{contract: contract}, AccessControlFilterer: AccessControlFilterer{contract: contract}}, nil
+}
+
+// NewAccessControlCaller creates a new read-only instance of AccessControl, bound to a specific deployed contract.
+func NewAccessControlCaller(address common.Address, caller bind.ContractCaller) (*AccessControlCaller, error) {
+	contract, err := bindAccessControl(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlCaller{contract: contract}, nil
+}
+
+// NewAccessControlTransactor creates a new write-only instance of AccessControl, bound to a specific deployed contract.
+func NewAccessControlTransactor(address common.Address, transactor bind.ContractTransactor) (*AccessControlTransactor, error) {
+	contract, err := bindAccessControl(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlTransactor{contract: contract}, nil
+}
+
+// NewAccessControlFilterer creates a new log filterer instance of AccessControl, bound to a specific deployed contract.
+func NewAccessControlFilterer(address common.Address, filterer bind.ContractFilterer) (*AccessControlFilterer, error) {
+	contract, err := bindAccessControl(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlFilterer{contract: contract}, nil
+}
+
+// bindAccessControl binds a generic wrapper to an already deployed contract.
+func bindAccessControl(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(AccessControlABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AccessControl *AccessControlRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AccessControl.Contract.AccessControlCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AccessControl *AccessControlRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AccessControl.Contract.AccessControlTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AccessControl *AccessControlRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AccessControl.Contract.AccessControlTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AccessControl *AccessControlCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AccessControl.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AccessControl *AccessControlTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AccessControl.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AccessControl *AccessControlTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AccessControl.Contract.contract.Transact(opts, method, params...)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x67125a1b.
+//
+// Solidity: function hasRole(address Contract, uint8 Role, address Account) view returns(bool Success)
+func (_AccessControl *AccessControlCaller) HasRole(opts *bind.CallOpts, Contract common.Address, Role uint8, Account common.Address) (bool, error) {
+	var out []interface{}
+	err := _AccessControl.contract.Call(opts, &out, "hasRole", Contract, Role, Account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x67125a1b.
+//
+// Solidity: function hasRole(address Contract, uint8 Role, address Account) view returns(bool Success)
+func (_AccessControl *AccessControlSession) HasRole(Contract common.Address, Role uint8, Account common.Address) (bool, error) {
+	return _AccessControl.Contract.HasRole(&_AccessControl.CallOpts, Contract, Role, Account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x67125a1b.
+//
+// Solidity: function hasRole(address Contract, uint8 Role, address Account) view returns(bool Success)
+func (_AccessControl *AccessControlCallerSession) HasRole(Contract common.Address, Role uint8, Account common.Address) (bool, error) {
+	return _AccessControl.Contract.HasRole(&_AccessControl.CallOpts, Contract, Role, Account)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_AccessControl *AccessControlCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _AccessControl.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_AccessControl *AccessControlSession) Name() (string, error) {
+	return _AccessControl.Contract.Name(&_AccessControl.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_AccessControl *AccessControlCallerSession) Name() (string, error) {
+	return _AccessControl.Contract.Name(&_AccessControl.CallOpts)
+}
+
+// RoleMembers is a free data retrieval call binding the contract method 0xa6f6a87b.
+//
+// Solidity: function roleMembers(address Contract, uint8 Role) view returns(address[] Members)
+func (_AccessControl *AccessControlCaller) RoleMembers(opts *bind.CallOpts, Contract common.Address, Role uint8) ([]common.Address, error) {
+	var out []interface{}
+	err := _AccessControl.contract.Call(opts, &out, "roleMembers", Contract, Role)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// RoleMembers is a free data retrieval call binding the contract method 0xa6f6a87b.
+//
+// Solidity: function roleMembers(address Contract, uint8 Role) view returns(address[] Members)
+func (_AccessControl *AccessControlSession) RoleMembers(Contract common.Address, Role uint8) ([]common.Address, error) {
+	return _AccessControl.Contract.RoleMembers(&_AccessControl.CallOpts, Contract, Role)
+}
+
+// RoleMembers is a free data retrieval call binding the contract method 0xa6f6a87b.
+//
+// Solidity: function roleMembers(address Contract, uint8 Role) view returns(address[] Members)
+func (_AccessControl *AccessControlCallerSession) RoleMembers(Contract common.Address, Role uint8) ([]common.Address, error) {
+	return _AccessControl.Contract.RoleMembers(&_AccessControl.CallOpts, Contract, Role)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x61919bc1.
+//
+// Solidity: function grantRole(address Contract, uint8 Role, address Account) returns(bool Success)
+func (_AccessControl *AccessControlTransactor) GrantRole(opts *bind.TransactOpts, Contract common.Address, Role uint8, Account common.Address) (*types.Transaction, error) {
+	return _AccessControl.contract.Transact(opts, "grantRole", Contract, Role, Account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x61919bc1.
+//
+// Solidity: function grantRole(address Contract, uint8 Role, address Account) returns(bool Success)
+func (_AccessControl *AccessControlSession) GrantRole(Contract common.Address, Role uint8, Account common.Address) (*types.Transaction, error) {
+	return _AccessControl.Contract.GrantRole(&_AccessControl.TransactOpts, Contract, Role, Account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x61919bc1.
+//
+// Solidity: function grantRole(address Contract, uint8 Role, address Account) returns(bool Success)
+func (_AccessControl *AccessControlTransactorSession) GrantRole(Contract common.Address, Role uint8, Account common.Address) (*types.Transaction, error) {
+	return _AccessControl.Contract.GrantRole(&_AccessControl.TransactOpts, Contract, Role, Account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xdf30958a.
+//
+// Solidity: function revokeRole(address Contract, uint8 Role, address Account) returns(bool Success)
+func (_AccessControl *AccessControlTransactor) RevokeRole(opts *bind.TransactOpts, Contract common.Address, Role uint8, Account common.Address) (*types.Transaction, error) {
+	return _AccessControl.contract.Transact(opts, "revokeRole", Contract, Role, Account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xdf30958a.
+//
+// Solidity: function revokeRole(address Contract, uint8 Role, address Account) returns(bool Success)
+func (_AccessControl *AccessControlSession) RevokeRole(Contract common.Address, Role uint8, Account common.Address) (*types.Transaction, error) {
+	return _AccessControl.Contract.RevokeRole(&_AccessControl.TransactOpts, Contract, Role, Account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xdf30958a.
+//
+// Solidity: function revokeRole(address Contract, uint8 Role, address Account) returns(bool Success)
+func (_AccessControl *AccessControlTransactorSession) RevokeRole(Contract common.Address, Role uint8, Account common.Address) (*types.Transaction, error) {
+	return _AccessControl.Contract.RevokeRole(&_AccessControl.TransactOpts, Contract, Role, Account)
+}
+
+// AccessControlRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the AccessControl contract.
+type AccessControlRoleGrantedIterator struct {
+	Event *AccessControlRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlRoleGranted represents a RoleGranted event raised by the AccessControl contract.
+type AccessControlRoleGranted struct {
+	Contract common.Address
+	Role     uint8
+	Account  common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x5ee49fb26568edb0cf7af9884b029cb7b79d65d3be5e7ba6b6b233e3d2ab35e0.
+//
+// Solidity: event roleGranted(address Contract, uint8 Role, address Account)
+func (_AccessControl *AccessControlFilterer) FilterRoleGranted(opts *bind.FilterOpts) (*AccessControlRoleGrantedIterator, error) {
+
+	logs, sub, err := _AccessControl.contract.FilterLogs(opts, "roleGranted")
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlRoleGrantedIterator{contract: _AccessControl.contract, event: "roleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x5ee49fb26568edb0cf7af9884b029cb7b79d65d3be5e7ba6b6b233e3d2ab35e0.
+//
+// Solidity: event roleGranted(address Contract, uint8 Role, address Account)
+func (_AccessControl *AccessControlFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *AccessControlRoleGranted) (event.Subscription, error) {
+
+	logs, sub, err := _AccessControl.contract.WatchLogs(opts, "roleGranted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlRoleGranted)
+				if err := _AccessControl.contract.UnpackLog(event, "roleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x5ee49fb26568edb0cf7af9884b029cb7b79d65d3be5e7ba6b6b233e3d2ab35e0.
+//
+// Solidity: event roleGranted(address Contract, uint8 Role, address Account)
+func (_AccessControl *AccessControlFilterer) ParseRoleGranted(log types.Log) (*AccessControlRoleGranted, error) {
+	event := new(AccessControlRoleGranted)
+	if err := _AccessControl.contract.UnpackLog(event, "roleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the AccessControl contract.
+type AccessControlRoleRevokedIterator struct {
+	Event *AccessControlRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlRoleRevoked represents a RoleRevoked event raised by the AccessControl contract.
+type AccessControlRoleRevoked struct {
+	Contract common.Address
+	Role     uint8
+	Account  common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6942645273dcc4d4c8c2a262c13cdc6cf3f0b5b3a30465830078e87c58970d4.
+//
+// Solidity: event roleRevoked(address Contract, uint8 Role, address Account)
+func (_AccessControl *AccessControlFilterer) FilterRoleRevoked(opts *bind.FilterOpts) (*AccessControlRoleRevokedIterator, error) {
+
+	logs, sub, err := _AccessControl.contract.FilterLogs(opts, "roleRevoked")
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlRoleRevokedIterator{contract: _AccessControl.contract, event: "roleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6942645273dcc4d4c8c2a262c13cdc6cf3f0b5b3a30465830078e87c58970d4.
+//
+// Solidity: event roleRevoked(address Contract, uint8 Role, address Account)
+func (_AccessControl *AccessControlFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *AccessControlRoleRevoked) (event.Subscription, error) {
+
+	logs, sub, err := _AccessControl.contract.WatchLogs(opts, "roleRevoked")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlRoleRevoked)
+				if err := _AccessControl.contract.UnpackLog(event, "roleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6942645273dcc4d4c8c2a262c13cdc6cf3f0b5b3a30465830078e87c58970d4.
+//
+// Solidity: event roleRevoked(address Contract, uint8 Role, address Account)
+func (_AccessControl *AccessControlFilterer) ParseRoleRevoked(log types.Log) (*AccessControlRoleRevoked, error) {
+	event := new(AccessControlRoleRevoked)
+	if err := _AccessControl.contract.UnpackLog(event, "roleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,618 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package accounting_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodCheckInvariant = "checkInvariant"
+
+	MethodLedger = "ledger"
+
+	MethodName = "name"
+
+	MethodReconcile = "reconcile"
+)
+
+// AccountingABI is the input ABI used to generate the binding from.
+const AccountingABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ledger\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Asset\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"Locked\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Minted\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"checkInvariant\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Asset\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Holds\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"Locked\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Minted\",\"type\":\"uint256\"},{\"internalType\":\"uint64[]\",\"name\":\"Violations\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"reconcile\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Asset\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"Locked\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Minted\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"conservationViolated\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Asset\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Op\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Locked\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Minted\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"reconciled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Asset\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Locked\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Minted\",\"type\":\"uint256\"}]}]"
+
+// AccountingFuncSigs maps the 4-byte function signature to its string representation.
+var AccountingFuncSigs = map[string]string{
+	"26533220": "checkInvariant(address)",
+	"0a074d4b": "ledger(address,uint64)",
+	"06fdde03": "name()",
+	"0f8f37eb": "reconcile(address,uint64,uint256,uint256)",
+}
+
+// Accounting is an auto generated Go binding around an Ethereum contract.
+type Accounting struct {
+	AccountingCaller     // Read-only binding to the contract
+	AccountingTransactor // Write-only binding to the contract
+	AccountingFilterer   // Log filterer for contract events
+}
+
+// AccountingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AccountingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccountingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AccountingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccountingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AccountingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccountingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AccountingSession struct {
+	Contract     *Accounting       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AccountingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AccountingCallerSession struct {
+	Contract *AccountingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// AccountingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AccountingTransactorSession struct {
+	Contract     *AccountingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// AccountingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AccountingRaw struct {
+	Contract *Accounting // Generic contract binding to access the raw methods on
+}
+
+// AccountingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AccountingCallerRaw struct {
+	Contract *AccountingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AccountingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AccountingTransactorRaw struct {
+	Contract *AccountingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAccounting creates a new instance of Accounting, bound to a specific deployed contract.
+func NewAccounting(address common.Address, backend bind.ContractBackend) (*Accounting, error) {
+	contract, err := bindAccounting(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Accounting{AccountingCaller: AccountingCaller{contract: contract}, AccountingTransactor: AccountingTransactor{contract: contract}, AccountingFilterer: AccountingFilterer{contract: contract}}, nil
+}
+
+// NewAccountingCaller creates a new read-only instance of Accounting, bound to a specific deployed contract.
+func NewAccountingCaller(address common.Address, caller bind.ContractCaller) (*AccountingCaller, error) {
+	contract, err := bindAccounting(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountingCaller{contract: contract}, nil
+}
+
+// NewAccountingTransactor creates a new write-only instance of Accounting, bound to a specific deployed contract.
+func NewAccountingTransactor(address common.Address, transactor bind.ContractTransactor) (*AccountingTransactor, error) {
+	contract, err := bindAccounting(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountingTransactor{contract: contract}, nil
+}
+
+// NewAccountingFilterer creates a new log filterer instance of Accounting, bound to a specific deployed contract.
+func NewAccountingFilterer(address common.Address, filterer bind.ContractFilterer) (*AccountingFilterer, error) {
+	contract, err := bindAccounting(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountingFilterer{contract: contract}, nil
+}
+
+// bindAccounting binds a generic wrapper to an already deployed contract.
+func bindAccounting(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(AccountingABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Accounting *AccountingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Accounting.Contract.AccountingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Accounting *AccountingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Accounting.Contract.AccountingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Accounting *AccountingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Accounting.Contract.AccountingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Accounting *AccountingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Accounting.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Accounting *AccountingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Accounting.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Accounting *AccountingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Accounting.Contract.contract.Transact(opts, method, params...)
+}
+
+// CheckInvariant is a free data retrieval call binding the contract method 0x26533220.
+//
+// Solidity: function checkInvariant(address Asset) view returns(bool Holds, uint256 Locked, uint256 Minted, uint64[] Violations)
+func (_Accounting *AccountingCaller) CheckInvariant(opts *bind.CallOpts, Asset common.Address) (struct {
+	Holds      bool
+	Locked     *big.Int
+	Minted     *big.Int
+	Violations []uint64
+}, error) {
+	var out []interface{}
+	err := _Accounting.contract.Call(opts, &out, "checkInvariant", Asset)
+
+	outstruct := new(struct {
+		Holds      bool
+		Locked     *big.Int
+		Minted     *big.Int
+		Violations []uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Holds = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Locked = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Minted = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Violations = *abi.ConvertType(out[3], new([]uint64)).(*[]uint64)
+
+	return *outstruct, err
+
+}
+
+// CheckInvariant is a free data retrieval call binding the contract method 0x26533220.
+//
+// Solidity: function checkInvariant(address Asset) view returns(bool Holds, uint256 Locked, uint256 Minted, uint64[] Violations)
+func (_Accounting *AccountingSession) CheckInvariant(Asset common.Address) (struct {
+	Holds      bool
+	Locked     *big.Int
+	Minted     *big.Int
+	Violations []uint64
+}, error) {
+	return _Accounting.Contract.CheckInvariant(&_Accounting.CallOpts, Asset)
+}
+
+// CheckInvariant is a free data retrieval call binding the contract method 0x26533220.
+//
+// Solidity: function checkInvariant(address Asset) view returns(bool Holds, uint256 Locked, uint256 Minted, uint64[] Violations)
+func (_Accounting *AccountingCallerSession) CheckInvariant(Asset common.Address) (struct {
+	Holds      bool
+	Locked     *big.Int
+	Minted     *big.Int
+	Violations []uint64
+}, error) {
+	return _Accounting.Contract.CheckInvariant(&_Accounting.CallOpts, Asset)
+}
+
+// Ledger is a free data retrieval call binding the contract method 0x0a074d4b.
+//
+// Solidity: function ledger(address Asset, uint64 ChainID) view returns(uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingCaller) Ledger(opts *bind.CallOpts, Asset common.Address, ChainID uint64) (struct {
+	Locked *big.Int
+	Minted *big.Int
+}, error) {
+	var out []interface{}
+	err := _Accounting.contract.Call(opts, &out, "ledger", Asset, ChainID)
+
+	outstruct := new(struct {
+		Locked *big.Int
+		Minted *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Locked = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Minted = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Ledger is a free data retrieval call binding the contract method 0x0a074d4b.
+//
+// Solidity: function ledger(address Asset, uint64 ChainID) view returns(uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingSession) Ledger(Asset common.Address, ChainID uint64) (struct {
+	Locked *big.Int
+	Minted *big.Int
+}, error) {
+	return _Accounting.Contract.Ledger(&_Accounting.CallOpts, Asset, ChainID)
+}
+
+// Ledger is a free data retrieval call binding the contract method 0x0a074d4b.
+//
+// Solidity: function ledger(address Asset, uint64 ChainID) view returns(uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingCallerSession) Ledger(Asset common.Address, ChainID uint64) (struct {
+	Locked *big.Int
+	Minted *big.Int
+}, error) {
+	return _Accounting.Contract.Ledger(&_Accounting.CallOpts, Asset, ChainID)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Accounting *AccountingCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Accounting.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Accounting *AccountingSession) Name() (string, error) {
+	return _Accounting.Contract.Name(&_Accounting.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Accounting *AccountingCallerSession) Name() (string, error) {
+	return _Accounting.Contract.Name(&_Accounting.CallOpts)
+}
+
+// Reconcile is a paid mutator transaction binding the contract method 0x0f8f37eb.
+//
+// Solidity: function reconcile(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted) returns(bool Success)
+func (_Accounting *AccountingTransactor) Reconcile(opts *bind.TransactOpts, Asset common.Address, ChainID uint64, Locked *big.Int, Minted *big.Int) (*types.Transaction, error) {
+	return _Accounting.contract.Transact(opts, "reconcile", Asset, ChainID, Locked, Minted)
+}
+
+// Reconcile is a paid mutator transaction binding the contract method 0x0f8f37eb.
+//
+// Solidity: function reconcile(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted) returns(bool Success)
+func (_Accounting *AccountingSession) Reconcile(Asset common.Address, ChainID uint64, Locked *big.Int, Minted *big.Int) (*types.Transaction, error) {
+	return _Accounting.Contract.Reconcile(&_Accounting.TransactOpts, Asset, ChainID, Locked, Minted)
+}
+
+// Reconcile is a paid mutator transaction binding the contract method 0x0f8f37eb.
+//
+// Solidity: function reconcile(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted) returns(bool Success)
+func (_Accounting *AccountingTransactorSession) Reconcile(Asset common.Address, ChainID uint64, Locked *big.Int, Minted *big.Int) (*types.Transaction, error) {
+	return _Accounting.Contract.Reconcile(&_Accounting.TransactOpts, Asset, ChainID, Locked, Minted)
+}
+
+// AccountingConservationViolatedIterator is returned from FilterConservationViolated and is used to iterate over the raw logs and unpacked data for ConservationViolated events raised by the Accounting contract.
+type AccountingConservationViolatedIterator struct {
+	Event *AccountingConservationViolated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccountingConservationViolatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccountingConservationViolated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccountingConservationViolated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccountingConservationViolatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccountingConservationViolatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccountingConservationViolated represents a ConservationViolated event raised by the Accounting contract.
+type AccountingConservationViolated struct {
+	Asset   common.Address
+	ChainID uint64
+	Op      uint8
+	Amount  *big.Int
+	Locked  *big.Int
+	Minted  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterConservationViolated is a free log retrieval operation binding the contract event 0x8efe4f4b3d3b177d76c1b2787fe2b5c4953ee00fdbe5a615ca2fc11de6cdf175.
+//
+// Solidity: event conservationViolated(address Asset, uint64 ChainID, uint8 Op, uint256 Amount, uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingFilterer) FilterConservationViolated(opts *bind.FilterOpts) (*AccountingConservationViolatedIterator, error) {
+
+	logs, sub, err := _Accounting.contract.FilterLogs(opts, "conservationViolated")
+	if err != nil {
+		return nil, err
+	}
+	return &AccountingConservationViolatedIterator{contract: _Accounting.contract, event: "conservationViolated", logs: logs, sub: sub}, nil
+}
+
+// WatchConservationViolated is a free log subscription operation binding the contract event 0x8efe4f4b3d3b177d76c1b2787fe2b5c4953ee00fdbe5a615ca2fc11de6cdf175.
+//
+// Solidity: event conservationViolated(address Asset, uint64 ChainID, uint8 Op, uint256 Amount, uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingFilterer) WatchConservationViolated(opts *bind.WatchOpts, sink chan<- *AccountingConservationViolated) (event.Subscription, error) {
+
+	logs, sub, err := _Accounting.contract.WatchLogs(opts, "conservationViolated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccountingConservationViolated)
+				if err := _Accounting.contract.UnpackLog(event, "conservationViolated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConservationViolated is a log parse operation binding the contract event 0x8efe4f4b3d3b177d76c1b2787fe2b5c4953ee00fdbe5a615ca2fc11de6cdf175.
+//
+// Solidity: event conservationViolated(address Asset, uint64 ChainID, uint8 Op, uint256 Amount, uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingFilterer) ParseConservationViolated(log types.Log) (*AccountingConservationViolated, error) {
+	event := new(AccountingConservationViolated)
+	if err := _Accounting.contract.UnpackLog(event, "conservationViolated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccountingReconciledIterator is returned from FilterReconciled and is used to iterate over the raw logs and unpacked data for Reconciled events raised by the Accounting contract.
+type AccountingReconciledIterator struct {
+	Event *AccountingReconciled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccountingReconciledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccountingReconciled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccountingReconciled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccountingReconciledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccountingReconciledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccountingReconciled represents a Reconciled event raised by the Accounting contract.
+type AccountingReconciled struct {
+	Asset   common.Address
+	ChainID uint64
+	Locked  *big.Int
+	Minted  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterReconciled is a free log retrieval operation binding the contract event 0x4d7ea7ba68b6710a44d4a5a4b83129e3c772958f8eddabd3486e4a641bd96742.
+//
+// Solidity: event reconciled(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingFilterer) FilterReconciled(opts *bind.FilterOpts) (*AccountingReconciledIterator, error) {
+
+	logs, sub, err := _Accounting.contract.FilterLogs(opts, "reconciled")
+	if err != nil {
+		return nil, err
+	}
+	return &AccountingReconciledIterator{contract: _Accounting.contract, event: "reconciled", logs: logs, sub: sub}, nil
+}
+
+// WatchReconciled is a free log subscription operation binding the contract event 0x4d7ea7ba68b6710a44d4a5a4b83129e3c772958f8eddabd3486e4a641bd96742.
+//
+// Solidity: event reconciled(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingFilterer) WatchReconciled(opts *bind.WatchOpts, sink chan<- *AccountingReconciled) (event.Subscription, error) {
+
+	logs, sub, err := _Accounting.contract.WatchLogs(opts, "reconciled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccountingReconciled)
+				if err := _Accounting.contract.UnpackLog(event, "reconciled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReconciled is a log parse operation binding the contract event 0x4d7ea7ba68b6710a44d4a5a4b83129e3c772958f8eddabd3486e4a641bd96742.
+//
+// Solidity: event reconciled(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted)
+func (_Accounting *AccountingFilterer) ParseReconciled(log types.Log) (*AccountingReconciled, error) {
+	event := new(AccountingReconciled)
+	if err := _Accounting.contract.UnpackLog(event, "reconciled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,280 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package audit_log_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodName = "name"
+
+	MethodRecordCount = "recordCount"
+
+	MethodRecords = "records"
+)
+
+// AuditLogABI is the input ABI used to generate the binding from.
+const AuditLogABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"recordCount\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Count\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"records\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Start\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Limit\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Records\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"}]"
+
+// AuditLogFuncSigs maps the 4-byte function signature to its string representation.
+var AuditLogFuncSigs = map[string]string{
+	"06fdde03": "name()",
+	"900407bc": "recordCount()",
+	"4a639d5d": "records(uint64,uint64)",
+}
+
+// AuditLog is an auto generated Go binding around an Ethereum contract.
+type AuditLog struct {
+	AuditLogCaller     // Read-only binding to the contract
+	AuditLogTransactor // Write-only binding to the contract
+	AuditLogFilterer   // Log filterer for contract events
+}
+
+// AuditLogCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AuditLogCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuditLogTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AuditLogTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuditLogFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AuditLogFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuditLogSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AuditLogSession struct {
+	Contract     *AuditLog         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AuditLogCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AuditLogCallerSession struct {
+	Contract *AuditLogCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// AuditLogTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AuditLogTransactorSession struct {
+	Contract     *AuditLogTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// AuditLogRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AuditLogRaw struct {
+	Contract *AuditLog // Generic contract binding to access the raw methods on
+}
+
+// AuditLogCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AuditLogCallerRaw struct {
+	Contract *AuditLogCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AuditLogTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AuditLogTransactorRaw struct {
+	Contract *AuditLogTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAuditLog creates a new instance of AuditLog, bound to a specific deployed contract.
+func NewAuditLog(address common.Address, backend bind.ContractBackend) (*AuditLog, error) {
+	contract, err := bindAuditLog(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{AuditLogCaller: AuditLogCaller{contract: contract}, AuditLogTransactor: AuditLogTransactor{contract: contract}, AuditLogFilterer: AuditLogFilterer{contract: contract}}, nil
+}
+
+// NewAuditLogCaller creates a new read-only instance of AuditLog, bound to a specific deployed contract.
+func NewAuditLogCaller(address common.Address, caller bind.ContractCaller) (*AuditLogCaller, error) {
+	contract, err := bindAuditLog(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLogCaller{contract: contract}, nil
+}
+
+// NewAuditLogTransactor creates a new write-only instance of AuditLog, bound to a specific deployed contract.
+func NewAuditLogTransactor(address common.Address, transactor bind.ContractTransactor) (*AuditLogTransactor, error) {
+	contract, err := bindAuditLog(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLogTransactor{contract: contract}, nil
+}
+
+// NewAuditLogFilterer creates a new log filterer instance of AuditLog, bound to a specific deployed contract.
+func NewAuditLogFilterer(address common.Address, filterer bind.ContractFilterer) (*AuditLogFilterer, error) {
+	contract, err := bindAuditLog(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLogFilterer{contract: contract}, nil
+}
+
+// bindAuditLog binds a generic wrapper to an already deployed contract.
+func bindAuditLog(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(AuditLogABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuditLog *AuditLogRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuditLog.Contract.AuditLogCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuditLog *AuditLogRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuditLog.Contract.AuditLogTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuditLog *AuditLogRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuditLog.Contract.AuditLogTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuditLog *AuditLogCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuditLog.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuditLog *AuditLogTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuditLog.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuditLog *AuditLogTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuditLog.Contract.contract.Transact(opts, method, params...)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_AuditLog *AuditLogCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _AuditLog.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_AuditLog *AuditLogSession) Name() (string, error) {
+	return _AuditLog.Contract.Name(&_AuditLog.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_AuditLog *AuditLogCallerSession) Name() (string, error) {
+	return _AuditLog.Contract.Name(&_AuditLog.CallOpts)
+}
+
+// RecordCount is a free data retrieval call binding the contract method 0x900407bc.
+//
+// Solidity: function recordCount() view returns(uint64 Count)
+func (_AuditLog *AuditLogCaller) RecordCount(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _AuditLog.contract.Call(opts, &out, "recordCount")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// RecordCount is a free data retrieval call binding the contract method 0x900407bc.
+//
+// Solidity: function recordCount() view returns(uint64 Count)
+func (_AuditLog *AuditLogSession) RecordCount() (uint64, error) {
+	return _AuditLog.Contract.RecordCount(&_AuditLog.CallOpts)
+}
+
+// RecordCount is a free data retrieval call binding the contract method 0x900407bc.
+//
+// Solidity: function recordCount() view returns(uint64 Count)
+func (_AuditLog *AuditLogCallerSession) RecordCount() (uint64, error) {
+	return _AuditLog.Contract.RecordCount(&_AuditLog.CallOpts)
+}
+
+// Records is a free data retrieval call binding the contract method 0x4a639d5d.
+//
+// Solidity: function records(uint64 Start, uint64 Limit) view returns(bytes Records)
+func (_AuditLog *AuditLogCaller) Records(opts *bind.CallOpts, Start uint64, Limit uint64) ([]byte, error) {
+	var out []interface{}
+	err := _AuditLog.contract.Call(opts, &out, "records", Start, Limit)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Records is a free data retrieval call binding the contract method 0x4a639d5d.
+//
+// Solidity: function records(uint64 Start, uint64 Limit) view returns(bytes Records)
+func (_AuditLog *AuditLogSession) Records(Start uint64, Limit uint64) ([]byte, error) {
+	return _AuditLog.Contract.Records(&_AuditLog.CallOpts, Start, Limit)
+}
+
+// Records is a free data retrieval call binding the contract method 0x4a639d5d.
+//
+// Solidity: function records(uint64 Start, uint64 Limit) view returns(bytes Records)
+func (_AuditLog *AuditLogCallerSession) Records(Start uint64, Limit uint64) ([]byte, error) {
+	return _AuditLog.Contract.Records(&_AuditLog.CallOpts, Start, Limit)
+}
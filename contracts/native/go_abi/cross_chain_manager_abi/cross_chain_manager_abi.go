@@ -27,44 +27,105 @@ var (
 )
 
 var (
+	MethodAttestations = "attestations"
+
+	MethodBatch = "batch"
+
+	MethodCheckpoint = "checkpoint"
+
+	MethodCheckpointConfig = "checkpointConfig"
+
+	MethodCodeHashPin = "codeHashPin"
+
+	MethodDualVerification = "dualVerification"
+
+	MethodEntranceWhitelist = "entranceWhitelist"
+
+	MethodImportProof = "importProof"
+
+	MethodImportRoot = "importRoot"
+
+	MethodOutboundCallback = "outboundCallback"
+
+	MethodOutboundState = "outboundState"
+
+	MethodProofMaxAge = "proofMaxAge"
+
+	MethodSourceAllowlist = "sourceAllowlist"
+
 	MethodBlackChain = "BlackChain"
 
 	MethodMultiSign = "MultiSign"
 
 	MethodWhiteChain = "WhiteChain"
 
+	MethodApproveStaleProof = "approveStaleProof"
+
+	MethodAttest = "attest"
+
+	MethodConfirmDelivery = "confirmDelivery"
+
 	MethodImportOuterTransfer = "importOuterTransfer"
 
 	MethodName = "name"
+
+	MethodRefundBatch = "refundBatch"
+
+	MethodRefundOutbound = "refundOutbound"
+
+	MethodSetCheckpointConfig = "setCheckpointConfig"
+
+	MethodSetCodeHashPin = "setCodeHashPin"
+
+	MethodSetDualVerification = "setDualVerification"
+
+	MethodSetEntranceWhitelist = "setEntranceWhitelist"
+
+	MethodSetOutboundCallback = "setOutboundCallback"
+
+	MethodSetProofMaxAge = "setProofMaxAge"
+
+	MethodSetSourceAllowlist = "setSourceAllowlist"
+
+	MethodSubmitCheckpoint = "submitCheckpoint"
 )
 
 // CrossChainManagerABI is the input ABI used to generate the binding from.
-const CrossChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"MultiSign\",\"type\":\"bytes\"}],\"name\":\"btcTxMultiSignEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"FromChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"buf\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"FromTxHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"RedeemKey\",\"type\":\"string\"}],\"name\":\"btcTxToRelayEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rk\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"buf\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64[]\",\"name\":\"amts\",\"type\":\"uint64[]\"}],\"name\":\"makeBtcTxEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleValueHex\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"makeProof\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"BlackChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"RedeemKey\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"Address\",\"type\":\"string\"},{\"internalType\":\"bytes[]\",\"name\":\"Signs\",\"type\":\"bytes[]\"}],\"name\":\"MultiSign\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"WhiteChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"RelayerAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"HeaderOrCrossChainMsg\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const CrossChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"MultiSign\",\"type\":\"bytes\"}],\"name\":\"btcTxMultiSignEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"FromChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"buf\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"FromTxHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"RedeemKey\",\"type\":\"string\"}],\"name\":\"btcTxToRelayEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"BlockHash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"StateRoot\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"EpochHash\",\"type\":\"bytes\"}],\"name\":\"checkpointMade\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"}],\"name\":\"deliveryConfirmed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rk\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"buf\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64[]\",\"name\":\"amts\",\"type\":\"uint64[]\"}],\"name\":\"makeBtcTxEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleValueHex\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"makeProof\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Enabled\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"Callers\",\"type\":\"address[]\"}],\"name\":\"entranceWhitelistChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"}],\"name\":\"deliveryFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"}],\"name\":\"outboundRefunded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"GasLimit\",\"type\":\"uint64\"}],\"name\":\"outboundCallbackChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"State\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"name\":\"outboundCallbackInvoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"BatchID\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"State\",\"type\":\"uint8\"}],\"name\":\"batchStateChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"BatchID\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"State\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"name\":\"batchCallbackInvoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"CrossChainID\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Verified\",\"type\":\"bool\"}],\"name\":\"importShadowed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"Attestors\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"name\":\"dualVerificationChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"MessageHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Attestor\",\"type\":\"address\"}],\"name\":\"messageAttested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"MaxAge\",\"type\":\"uint64\"}],\"name\":\"proofMaxAgeChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"CrossChainID\",\"type\":\"bytes\"}],\"name\":\"staleProofApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"CodeHash\",\"type\":\"bytes32\"}],\"name\":\"codeHashPinChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"Expected\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"Actual\",\"type\":\"bytes32\"}],\"name\":\"codeHashMismatched\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"FromChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"MessageHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Index\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"Leaf\",\"type\":\"bytes32\"}],\"name\":\"messageImported\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"BlackChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"RedeemKey\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"Address\",\"type\":\"string\"},{\"internalType\":\"bytes[]\",\"name\":\"Signs\",\"type\":\"bytes[]\"}],\"name\":\"MultiSign\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"WhiteChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"checkpoint\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Checkpoint\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"Contracts\",\"type\":\"bytes[]\"}],\"name\":\"setSourceAllowlist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"sourceAllowlist\",\"outputs\":[{\"internalType\":\"bytes[]\",\"name\":\"Contracts\",\"type\":\"bytes[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"checkpointConfig\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Interval\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"AnchorChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"AnchorContract\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"RelayerAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"HeaderOrCrossChainMsg\",\"type\":\"bytes\"}],\"name\":\"confirmDelivery\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"RelayerAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"HeaderOrCrossChainMsg\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Interval\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"AnchorChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"AnchorContract\",\"type\":\"bytes\"}],\"name\":\"setCheckpointConfig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"BlockHash\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"StateRoot\",\"type\":\"bytes\"}],\"name\":\"submitCheckpoint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"Enabled\",\"type\":\"bool\"},{\"internalType\":\"address[]\",\"name\":\"Callers\",\"type\":\"address[]\"}],\"name\":\"setEntranceWhitelist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"entranceWhitelist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Enabled\",\"type\":\"bool\"},{\"internalType\":\"address[]\",\"name\":\"Callers\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"GasLimit\",\"type\":\"uint64\"}],\"name\":\"setOutboundCallback\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"}],\"name\":\"outboundCallback\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"GasLimit\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"}],\"name\":\"refundOutbound\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"}],\"name\":\"outboundState\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"State\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"BatchID\",\"type\":\"bytes32\"}],\"name\":\"refundBatch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"BatchID\",\"type\":\"bytes32\"}],\"name\":\"batch\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"State\",\"type\":\"uint8\"},{\"internalType\":\"uint64[]\",\"name\":\"ToChainIDs\",\"type\":\"uint64[]\"},{\"internalType\":\"uint64[]\",\"name\":\"Sequences\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"address[]\",\"name\":\"Attestors\",\"type\":\"address[]\"},{\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"name\":\"setDualVerification\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"dualVerification\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"Attestors\",\"type\":\"address[]\"},{\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"MessageHash\",\"type\":\"bytes32\"}],\"name\":\"attest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"MessageHash\",\"type\":\"bytes32\"}],\"name\":\"attestations\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"Attestors\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MaxAge\",\"type\":\"uint64\"}],\"name\":\"setProofMaxAge\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"proofMaxAge\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"MaxAge\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"CrossChainID\",\"type\":\"bytes\"}],\"name\":\"approveStaleProof\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"CodeHash\",\"type\":\"bytes32\"}],\"name\":\"setCodeHashPin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"codeHashPin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"CodeHash\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"name\":\"importRoot\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"Root\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"Count\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Index\",\"type\":\"uint64\"}],\"name\":\"importProof\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"Leaf\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"Proof\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 // CrossChainManagerFuncSigs maps the 4-byte function signature to its string representation.
 var CrossChainManagerFuncSigs = map[string]string{
 	"8a449f03": "BlackChain(uint64)",
 	"48c79d9d": "MultiSign(uint64,string,bytes,string,bytes[])",
 	"99d0e87a": "WhiteChain(uint64)",
+	"684fb1f9": "approveStaleProof(uint64,bytes)",
+	"99a4f846": "attest(uint64,bytes32)",
+	"edeebd0f": "attestations(uint64,bytes32)",
+	"fddaa065": "batch(bytes32)",
+	"c2c4c5c1": "checkpoint()",
+	"39e64e33": "checkpointConfig()",
+	"306dae71": "codeHashPin(uint64)",
+	"323d727b": "confirmDelivery(uint64,uint32,bytes,bytes,bytes,bytes)",
+	"6e38bd2a": "dualVerification(uint64)",
+	"80b1b762": "entranceWhitelist(uint64)",
 	"5b60b01e": "importOuterTransfer(uint64,uint32,bytes,bytes,bytes,bytes)",
+	"d78fb3d0": "importProof(uint64,uint64)",
+	"1896016a": "importRoot(uint64)",
 	"06fdde03": "name()",
-}
-
-// CrossChainManagerBin is the compiled bytecode used for deploying new contracts.
-var CrossChainManagerBin = "0x608060405234801561001057600080fd5b50610475806100206000396000f3fe608060405234801561001057600080fd5b50600436106100575760003560e01c806306fdde031461005c57806348c79d9d146100745780635b60b01e1461009d5780638a449f03146100b757806399d0e87a146100b7575b600080fd5b606060405161006b91906103a3565b60405180910390f35b61008d61008236600461018e565b600095945050505050565b604051901515815260200161006b565b61008d6100ab3660046102d6565b60009695505050505050565b61008d6100c536600461016c565b50600090565b600082601f8301126100dc57600080fd5b813567ffffffffffffffff8111156100f6576100f6610429565b610109601f8201601f19166020016103f8565b81815284602083860101111561011e57600080fd5b816020850160208301376000918101602001919091529392505050565b803563ffffffff8116811461014f57600080fd5b919050565b803567ffffffffffffffff8116811461014f57600080fd5b60006020828403121561017e57600080fd5b61018782610154565b9392505050565b600080600080600060a086880312156101a657600080fd5b6101af86610154565b945060208087013567ffffffffffffffff808211156101cd57600080fd5b6101d98a838b016100cb565b965060408901359150808211156101ef57600080fd5b6101fb8a838b016100cb565b9550606089013591508082111561021157600080fd5b61021d8a838b016100cb565b9450608089013591508082111561023357600080fd5b818901915089601f83011261024757600080fd5b81358181111561025957610259610429565b8060051b6102688582016103f8565b8281528581019085870183870188018f101561028357600080fd5b600093505b848410156102c157858135111561029e57600080fd5b6102ad8f8983358a01016100cb565b835260019390930192918701918701610288565b50809750505050505050509295509295909350565b60008060008060008060c087890312156102ef57600080fd5b6102f887610154565b95506103066020880161013b565b9450604087013567ffffffffffffffff8082111561032357600080fd5b61032f8a838b016100cb565b9550606089013591508082111561034557600080fd5b6103518a838b016100cb565b9450608089013591508082111561036757600080fd5b6103738a838b016100cb565b935060a089013591508082111561038957600080fd5b5061039689828a016100cb565b9150509295509295509295565b600060208083528351808285015260005b818110156103d0578581018301518582016040015282016103b4565b818111156103e2576000604083870101525b50601f01601f1916929092016040019392505050565b604051601f8201601f1916810167ffffffffffffffff8111828210171561042157610421610429565b604052919050565b634e487b7160e01b600052604160045260246000fdfea2646970667358221220cb47f48504debcee7fac8555b2c134ff9fdff419dfbc5f0c487127571e51b34d64736f6c63430008060033"
-
-// DeployCrossChainManager deploys a new Ethereum contract, binding an instance of CrossChainManager to it.
-func DeployCrossChainManager(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *CrossChainManager, error) {
-	parsed, err := abi.JSON(strings.NewReader(CrossChainManagerABI))
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(CrossChainManagerBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &CrossChainManager{CrossChainManagerCaller: CrossChainManagerCaller{contract: contract}, CrossChainManagerTransactor: CrossChainManagerTransactor{contract: contract}, CrossChainManagerFilterer: CrossChainManagerFilterer{contract: contract}}, nil
+	"db72849a": "outboundCallback(address)",
+	"fd568ebc": "outboundState(uint64,uint64)",
+	"2906106e": "proofMaxAge(uint64)",
+	"b222367b": "refundBatch(bytes32)",
+	"bbf9a9da": "refundOutbound(uint64,uint64)",
+	"36ec5ff0": "setCheckpointConfig(uint64,uint64,bytes)",
+	"e9d03da0": "setCodeHashPin(uint64,bytes32)",
+	"17895082": "setDualVerification(uint64,address[],uint64)",
+	"277c0332": "setEntranceWhitelist(uint64,bool,address[])",
+	"fef25605": "setOutboundCallback(address,uint64)",
+	"f6bfd9ac": "setProofMaxAge(uint64,uint64)",
+	"7bc3a8ac": "setSourceAllowlist(uint64,bytes[])",
+	"42195455": "sourceAllowlist(uint64)",
+	"40a888c3": "submitCheckpoint(uint64,bytes,bytes)",
 }
 
 // CrossChainManager is an auto generated Go binding around an Ethereum contract.
@@ -209,6 +270,522 @@ func (_CrossChainManager *CrossChainManagerTransactorRaw) Transact(opts *bind.Tr
 	return _CrossChainManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// Attestations is a free data retrieval call binding the contract method 0xedeebd0f.
+//
+// Solidity: function attestations(uint64 ChainID, bytes32 MessageHash) view returns(address[] Attestors)
+func (_CrossChainManager *CrossChainManagerCaller) Attestations(opts *bind.CallOpts, ChainID uint64, MessageHash [32]byte) ([]common.Address, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "attestations", ChainID, MessageHash)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// Attestations is a free data retrieval call binding the contract method 0xedeebd0f.
+//
+// Solidity: function attestations(uint64 ChainID, bytes32 MessageHash) view returns(address[] Attestors)
+func (_CrossChainManager *CrossChainManagerSession) Attestations(ChainID uint64, MessageHash [32]byte) ([]common.Address, error) {
+	return _CrossChainManager.Contract.Attestations(&_CrossChainManager.CallOpts, ChainID, MessageHash)
+}
+
+// Attestations is a free data retrieval call binding the contract method 0xedeebd0f.
+//
+// Solidity: function attestations(uint64 ChainID, bytes32 MessageHash) view returns(address[] Attestors)
+func (_CrossChainManager *CrossChainManagerCallerSession) Attestations(ChainID uint64, MessageHash [32]byte) ([]common.Address, error) {
+	return _CrossChainManager.Contract.Attestations(&_CrossChainManager.CallOpts, ChainID, MessageHash)
+}
+
+// Batch is a free data retrieval call binding the contract method 0xfddaa065.
+//
+// Solidity: function batch(bytes32 BatchID) view returns(address Sender, uint8 State, uint64[] ToChainIDs, uint64[] Sequences)
+func (_CrossChainManager *CrossChainManagerCaller) Batch(opts *bind.CallOpts, BatchID [32]byte) (struct {
+	Sender     common.Address
+	State      uint8
+	ToChainIDs []uint64
+	Sequences  []uint64
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "batch", BatchID)
+
+	outstruct := new(struct {
+		Sender     common.Address
+		State      uint8
+		ToChainIDs []uint64
+		Sequences  []uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Sender = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.State = *abi.ConvertType(out[1], new(uint8)).(*uint8)
+	outstruct.ToChainIDs = *abi.ConvertType(out[2], new([]uint64)).(*[]uint64)
+	outstruct.Sequences = *abi.ConvertType(out[3], new([]uint64)).(*[]uint64)
+
+	return *outstruct, err
+
+}
+
+// Batch is a free data retrieval call binding the contract method 0xfddaa065.
+//
+// Solidity: function batch(bytes32 BatchID) view returns(address Sender, uint8 State, uint64[] ToChainIDs, uint64[] Sequences)
+func (_CrossChainManager *CrossChainManagerSession) Batch(BatchID [32]byte) (struct {
+	Sender     common.Address
+	State      uint8
+	ToChainIDs []uint64
+	Sequences  []uint64
+}, error) {
+	return _CrossChainManager.Contract.Batch(&_CrossChainManager.CallOpts, BatchID)
+}
+
+// Batch is a free data retrieval call binding the contract method 0xfddaa065.
+//
+// Solidity: function batch(bytes32 BatchID) view returns(address Sender, uint8 State, uint64[] ToChainIDs, uint64[] Sequences)
+func (_CrossChainManager *CrossChainManagerCallerSession) Batch(BatchID [32]byte) (struct {
+	Sender     common.Address
+	State      uint8
+	ToChainIDs []uint64
+	Sequences  []uint64
+}, error) {
+	return _CrossChainManager.Contract.Batch(&_CrossChainManager.CallOpts, BatchID)
+}
+
+// Checkpoint is a free data retrieval call binding the contract method 0xc2c4c5c1.
+//
+// Solidity: function checkpoint() view returns(bytes Checkpoint)
+func (_CrossChainManager *CrossChainManagerCaller) Checkpoint(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "checkpoint")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Checkpoint is a free data retrieval call binding the contract method 0xc2c4c5c1.
+//
+// Solidity: function checkpoint() view returns(bytes Checkpoint)
+func (_CrossChainManager *CrossChainManagerSession) Checkpoint() ([]byte, error) {
+	return _CrossChainManager.Contract.Checkpoint(&_CrossChainManager.CallOpts)
+}
+
+// Checkpoint is a free data retrieval call binding the contract method 0xc2c4c5c1.
+//
+// Solidity: function checkpoint() view returns(bytes Checkpoint)
+func (_CrossChainManager *CrossChainManagerCallerSession) Checkpoint() ([]byte, error) {
+	return _CrossChainManager.Contract.Checkpoint(&_CrossChainManager.CallOpts)
+}
+
+// CheckpointConfig is a free data retrieval call binding the contract method 0x39e64e33.
+//
+// Solidity: function checkpointConfig() view returns(uint64 Interval, uint64 AnchorChainID, bytes AnchorContract)
+func (_CrossChainManager *CrossChainManagerCaller) CheckpointConfig(opts *bind.CallOpts) (struct {
+	Interval       uint64
+	AnchorChainID  uint64
+	AnchorContract []byte
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "checkpointConfig")
+
+	outstruct := new(struct {
+		Interval       uint64
+		AnchorChainID  uint64
+		AnchorContract []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Interval = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.AnchorChainID = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.AnchorContract = *abi.ConvertType(out[2], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// CheckpointConfig is a free data retrieval call binding the contract method 0x39e64e33.
+//
+// Solidity: function checkpointConfig() view returns(uint64 Interval, uint64 AnchorChainID, bytes AnchorContract)
+func (_CrossChainManager *CrossChainManagerSession) CheckpointConfig() (struct {
+	Interval       uint64
+	AnchorChainID  uint64
+	AnchorContract []byte
+}, error) {
+	return _CrossChainManager.Contract.CheckpointConfig(&_CrossChainManager.CallOpts)
+}
+
+// CheckpointConfig is a free data retrieval call binding the contract method 0x39e64e33.
+//
+// Solidity: function checkpointConfig() view returns(uint64 Interval, uint64 AnchorChainID, bytes AnchorContract)
+func (_CrossChainManager *CrossChainManagerCallerSession) CheckpointConfig() (struct {
+	Interval       uint64
+	AnchorChainID  uint64
+	AnchorContract []byte
+}, error) {
+	return _CrossChainManager.Contract.CheckpointConfig(&_CrossChainManager.CallOpts)
+}
+
+// CodeHashPin is a free data retrieval call binding the contract method 0x306dae71.
+//
+// Solidity: function codeHashPin(uint64 ChainID) view returns(bytes32 CodeHash)
+func (_CrossChainManager *CrossChainManagerCaller) CodeHashPin(opts *bind.CallOpts, ChainID uint64) ([32]byte, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "codeHashPin", ChainID)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// CodeHashPin is a free data retrieval call binding the contract method 0x306dae71.
+//
+// Solidity: function codeHashPin(uint64 ChainID) view returns(bytes32 CodeHash)
+func (_CrossChainManager *CrossChainManagerSession) CodeHashPin(ChainID uint64) ([32]byte, error) {
+	return _CrossChainManager.Contract.CodeHashPin(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// CodeHashPin is a free data retrieval call binding the contract method 0x306dae71.
+//
+// Solidity: function codeHashPin(uint64 ChainID) view returns(bytes32 CodeHash)
+func (_CrossChainManager *CrossChainManagerCallerSession) CodeHashPin(ChainID uint64) ([32]byte, error) {
+	return _CrossChainManager.Contract.CodeHashPin(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// DualVerification is a free data retrieval call binding the contract method 0x6e38bd2a.
+//
+// Solidity: function dualVerification(uint64 ChainID) view returns(address[] Attestors, uint64 Threshold)
+func (_CrossChainManager *CrossChainManagerCaller) DualVerification(opts *bind.CallOpts, ChainID uint64) (struct {
+	Attestors []common.Address
+	Threshold uint64
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "dualVerification", ChainID)
+
+	outstruct := new(struct {
+		Attestors []common.Address
+		Threshold uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Attestors = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Threshold = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// DualVerification is a free data retrieval call binding the contract method 0x6e38bd2a.
+//
+// Solidity: function dualVerification(uint64 ChainID) view returns(address[] Attestors, uint64 Threshold)
+func (_CrossChainManager *CrossChainManagerSession) DualVerification(ChainID uint64) (struct {
+	Attestors []common.Address
+	Threshold uint64
+}, error) {
+	return _CrossChainManager.Contract.DualVerification(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// DualVerification is a free data retrieval call binding the contract method 0x6e38bd2a.
+//
+// Solidity: function dualVerification(uint64 ChainID) view returns(address[] Attestors, uint64 Threshold)
+func (_CrossChainManager *CrossChainManagerCallerSession) DualVerification(ChainID uint64) (struct {
+	Attestors []common.Address
+	Threshold uint64
+}, error) {
+	return _CrossChainManager.Contract.DualVerification(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// EntranceWhitelist is a free data retrieval call binding the contract method 0x80b1b762.
+//
+// Solidity: function entranceWhitelist(uint64 ChainID) view returns(bool Enabled, address[] Callers)
+func (_CrossChainManager *CrossChainManagerCaller) EntranceWhitelist(opts *bind.CallOpts, ChainID uint64) (struct {
+	Enabled bool
+	Callers []common.Address
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "entranceWhitelist", ChainID)
+
+	outstruct := new(struct {
+		Enabled bool
+		Callers []common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Callers = *abi.ConvertType(out[1], new([]common.Address)).(*[]common.Address)
+
+	return *outstruct, err
+
+}
+
+// EntranceWhitelist is a free data retrieval call binding the contract method 0x80b1b762.
+//
+// Solidity: function entranceWhitelist(uint64 ChainID) view returns(bool Enabled, address[] Callers)
+func (_CrossChainManager *CrossChainManagerSession) EntranceWhitelist(ChainID uint64) (struct {
+	Enabled bool
+	Callers []common.Address
+}, error) {
+	return _CrossChainManager.Contract.EntranceWhitelist(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// EntranceWhitelist is a free data retrieval call binding the contract method 0x80b1b762.
+//
+// Solidity: function entranceWhitelist(uint64 ChainID) view returns(bool Enabled, address[] Callers)
+func (_CrossChainManager *CrossChainManagerCallerSession) EntranceWhitelist(ChainID uint64) (struct {
+	Enabled bool
+	Callers []common.Address
+}, error) {
+	return _CrossChainManager.Contract.EntranceWhitelist(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// ImportProof is a free data retrieval call binding the contract method 0xd78fb3d0.
+//
+// Solidity: function importProof(uint64 Height, uint64 Index) view returns(bytes32 Leaf, bytes32[] Proof)
+func (_CrossChainManager *CrossChainManagerCaller) ImportProof(opts *bind.CallOpts, Height uint64, Index uint64) (struct {
+	Leaf  [32]byte
+	Proof [][32]byte
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "importProof", Height, Index)
+
+	outstruct := new(struct {
+		Leaf  [32]byte
+		Proof [][32]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Leaf = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.Proof = *abi.ConvertType(out[1], new([][32]byte)).(*[][32]byte)
+
+	return *outstruct, err
+
+}
+
+// ImportProof is a free data retrieval call binding the contract method 0xd78fb3d0.
+//
+// Solidity: function importProof(uint64 Height, uint64 Index) view returns(bytes32 Leaf, bytes32[] Proof)
+func (_CrossChainManager *CrossChainManagerSession) ImportProof(Height uint64, Index uint64) (struct {
+	Leaf  [32]byte
+	Proof [][32]byte
+}, error) {
+	return _CrossChainManager.Contract.ImportProof(&_CrossChainManager.CallOpts, Height, Index)
+}
+
+// ImportProof is a free data retrieval call binding the contract method 0xd78fb3d0.
+//
+// Solidity: function importProof(uint64 Height, uint64 Index) view returns(bytes32 Leaf, bytes32[] Proof)
+func (_CrossChainManager *CrossChainManagerCallerSession) ImportProof(Height uint64, Index uint64) (struct {
+	Leaf  [32]byte
+	Proof [][32]byte
+}, error) {
+	return _CrossChainManager.Contract.ImportProof(&_CrossChainManager.CallOpts, Height, Index)
+}
+
+// ImportRoot is a free data retrieval call binding the contract method 0x1896016a.
+//
+// Solidity: function importRoot(uint64 Height) view returns(bytes32 Root, uint64 Count)
+func (_CrossChainManager *CrossChainManagerCaller) ImportRoot(opts *bind.CallOpts, Height uint64) (struct {
+	Root  [32]byte
+	Count uint64
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "importRoot", Height)
+
+	outstruct := new(struct {
+		Root  [32]byte
+		Count uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Root = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.Count = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// ImportRoot is a free data retrieval call binding the contract method 0x1896016a.
+//
+// Solidity: function importRoot(uint64 Height) view returns(bytes32 Root, uint64 Count)
+func (_CrossChainManager *CrossChainManagerSession) ImportRoot(Height uint64) (struct {
+	Root  [32]byte
+	Count uint64
+}, error) {
+	return _CrossChainManager.Contract.ImportRoot(&_CrossChainManager.CallOpts, Height)
+}
+
+// ImportRoot is a free data retrieval call binding the contract method 0x1896016a.
+//
+// Solidity: function importRoot(uint64 Height) view returns(bytes32 Root, uint64 Count)
+func (_CrossChainManager *CrossChainManagerCallerSession) ImportRoot(Height uint64) (struct {
+	Root  [32]byte
+	Count uint64
+}, error) {
+	return _CrossChainManager.Contract.ImportRoot(&_CrossChainManager.CallOpts, Height)
+}
+
+// OutboundCallback is a free data retrieval call binding the contract method 0xdb72849a.
+//
+// Solidity: function outboundCallback(address Sender) view returns(address Callback, uint64 GasLimit)
+func (_CrossChainManager *CrossChainManagerCaller) OutboundCallback(opts *bind.CallOpts, Sender common.Address) (struct {
+	Callback common.Address
+	GasLimit uint64
+}, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "outboundCallback", Sender)
+
+	outstruct := new(struct {
+		Callback common.Address
+		GasLimit uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Callback = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.GasLimit = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// OutboundCallback is a free data retrieval call binding the contract method 0xdb72849a.
+//
+// Solidity: function outboundCallback(address Sender) view returns(address Callback, uint64 GasLimit)
+func (_CrossChainManager *CrossChainManagerSession) OutboundCallback(Sender common.Address) (struct {
+	Callback common.Address
+	GasLimit uint64
+}, error) {
+	return _CrossChainManager.Contract.OutboundCallback(&_CrossChainManager.CallOpts, Sender)
+}
+
+// OutboundCallback is a free data retrieval call binding the contract method 0xdb72849a.
+//
+// Solidity: function outboundCallback(address Sender) view returns(address Callback, uint64 GasLimit)
+func (_CrossChainManager *CrossChainManagerCallerSession) OutboundCallback(Sender common.Address) (struct {
+	Callback common.Address
+	GasLimit uint64
+}, error) {
+	return _CrossChainManager.Contract.OutboundCallback(&_CrossChainManager.CallOpts, Sender)
+}
+
+// OutboundState is a free data retrieval call binding the contract method 0xfd568ebc.
+//
+// Solidity: function outboundState(uint64 ToChainID, uint64 Sequence) view returns(uint8 State)
+func (_CrossChainManager *CrossChainManagerCaller) OutboundState(opts *bind.CallOpts, ToChainID uint64, Sequence uint64) (uint8, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "outboundState", ToChainID, Sequence)
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// OutboundState is a free data retrieval call binding the contract method 0xfd568ebc.
+//
+// Solidity: function outboundState(uint64 ToChainID, uint64 Sequence) view returns(uint8 State)
+func (_CrossChainManager *CrossChainManagerSession) OutboundState(ToChainID uint64, Sequence uint64) (uint8, error) {
+	return _CrossChainManager.Contract.OutboundState(&_CrossChainManager.CallOpts, ToChainID, Sequence)
+}
+
+// OutboundState is a free data retrieval call binding the contract method 0xfd568ebc.
+//
+// Solidity: function outboundState(uint64 ToChainID, uint64 Sequence) view returns(uint8 State)
+func (_CrossChainManager *CrossChainManagerCallerSession) OutboundState(ToChainID uint64, Sequence uint64) (uint8, error) {
+	return _CrossChainManager.Contract.OutboundState(&_CrossChainManager.CallOpts, ToChainID, Sequence)
+}
+
+// ProofMaxAge is a free data retrieval call binding the contract method 0x2906106e.
+//
+// Solidity: function proofMaxAge(uint64 ChainID) view returns(uint64 MaxAge)
+func (_CrossChainManager *CrossChainManagerCaller) ProofMaxAge(opts *bind.CallOpts, ChainID uint64) (uint64, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "proofMaxAge", ChainID)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// ProofMaxAge is a free data retrieval call binding the contract method 0x2906106e.
+//
+// Solidity: function proofMaxAge(uint64 ChainID) view returns(uint64 MaxAge)
+func (_CrossChainManager *CrossChainManagerSession) ProofMaxAge(ChainID uint64) (uint64, error) {
+	return _CrossChainManager.Contract.ProofMaxAge(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// ProofMaxAge is a free data retrieval call binding the contract method 0x2906106e.
+//
+// Solidity: function proofMaxAge(uint64 ChainID) view returns(uint64 MaxAge)
+func (_CrossChainManager *CrossChainManagerCallerSession) ProofMaxAge(ChainID uint64) (uint64, error) {
+	return _CrossChainManager.Contract.ProofMaxAge(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// SourceAllowlist is a free data retrieval call binding the contract method 0x42195455.
+//
+// Solidity: function sourceAllowlist(uint64 ChainID) view returns(bytes[] Contracts)
+func (_CrossChainManager *CrossChainManagerCaller) SourceAllowlist(opts *bind.CallOpts, ChainID uint64) ([][]byte, error) {
+	var out []interface{}
+	err := _CrossChainManager.contract.Call(opts, &out, "sourceAllowlist", ChainID)
+
+	if err != nil {
+		return *new([][]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][]byte)).(*[][]byte)
+
+	return out0, err
+
+}
+
+// SourceAllowlist is a free data retrieval call binding the contract method 0x42195455.
+//
+// Solidity: function sourceAllowlist(uint64 ChainID) view returns(bytes[] Contracts)
+func (_CrossChainManager *CrossChainManagerSession) SourceAllowlist(ChainID uint64) ([][]byte, error) {
+	return _CrossChainManager.Contract.SourceAllowlist(&_CrossChainManager.CallOpts, ChainID)
+}
+
+// SourceAllowlist is a free data retrieval call binding the contract method 0x42195455.
+//
+// Solidity: function sourceAllowlist(uint64 ChainID) view returns(bytes[] Contracts)
+func (_CrossChainManager *CrossChainManagerCallerSession) SourceAllowlist(ChainID uint64) ([][]byte, error) {
+	return _CrossChainManager.Contract.SourceAllowlist(&_CrossChainManager.CallOpts, ChainID)
+}
+
 // BlackChain is a paid mutator transaction binding the contract method 0x8a449f03.
 //
 // Solidity: function BlackChain(uint64 ChainID) returns(bool success)
@@ -251,72 +828,2659 @@ func (_CrossChainManager *CrossChainManagerTransactorSession) MultiSign(ChainID
 	return _CrossChainManager.Contract.MultiSign(&_CrossChainManager.TransactOpts, ChainID, RedeemKey, TxHash, Address, Signs)
 }
 
-// WhiteChain is a paid mutator transaction binding the contract method 0x99d0e87a.
+// WhiteChain is a paid mutator transaction binding the contract method 0x99d0e87a.
+//
+// Solidity: function WhiteChain(uint64 ChainID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) WhiteChain(opts *bind.TransactOpts, ChainID uint64) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "WhiteChain", ChainID)
+}
+
+// WhiteChain is a paid mutator transaction binding the contract method 0x99d0e87a.
+//
+// Solidity: function WhiteChain(uint64 ChainID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) WhiteChain(ChainID uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.WhiteChain(&_CrossChainManager.TransactOpts, ChainID)
+}
+
+// WhiteChain is a paid mutator transaction binding the contract method 0x99d0e87a.
+//
+// Solidity: function WhiteChain(uint64 ChainID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) WhiteChain(ChainID uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.WhiteChain(&_CrossChainManager.TransactOpts, ChainID)
+}
+
+// ApproveStaleProof is a paid mutator transaction binding the contract method 0x684fb1f9.
+//
+// Solidity: function approveStaleProof(uint64 ChainID, bytes CrossChainID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) ApproveStaleProof(opts *bind.TransactOpts, ChainID uint64, CrossChainID []byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "approveStaleProof", ChainID, CrossChainID)
+}
+
+// ApproveStaleProof is a paid mutator transaction binding the contract method 0x684fb1f9.
+//
+// Solidity: function approveStaleProof(uint64 ChainID, bytes CrossChainID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) ApproveStaleProof(ChainID uint64, CrossChainID []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.ApproveStaleProof(&_CrossChainManager.TransactOpts, ChainID, CrossChainID)
+}
+
+// ApproveStaleProof is a paid mutator transaction binding the contract method 0x684fb1f9.
+//
+// Solidity: function approveStaleProof(uint64 ChainID, bytes CrossChainID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) ApproveStaleProof(ChainID uint64, CrossChainID []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.ApproveStaleProof(&_CrossChainManager.TransactOpts, ChainID, CrossChainID)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0x99a4f846.
+//
+// Solidity: function attest(uint64 ChainID, bytes32 MessageHash) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) Attest(opts *bind.TransactOpts, ChainID uint64, MessageHash [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "attest", ChainID, MessageHash)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0x99a4f846.
+//
+// Solidity: function attest(uint64 ChainID, bytes32 MessageHash) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) Attest(ChainID uint64, MessageHash [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.Attest(&_CrossChainManager.TransactOpts, ChainID, MessageHash)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0x99a4f846.
+//
+// Solidity: function attest(uint64 ChainID, bytes32 MessageHash) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) Attest(ChainID uint64, MessageHash [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.Attest(&_CrossChainManager.TransactOpts, ChainID, MessageHash)
+}
+
+// ConfirmDelivery is a paid mutator transaction binding the contract method 0x323d727b.
+//
+// Solidity: function confirmDelivery(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) ConfirmDelivery(opts *bind.TransactOpts, SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "confirmDelivery", SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+}
+
+// ConfirmDelivery is a paid mutator transaction binding the contract method 0x323d727b.
+//
+// Solidity: function confirmDelivery(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) ConfirmDelivery(SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.ConfirmDelivery(&_CrossChainManager.TransactOpts, SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+}
+
+// ConfirmDelivery is a paid mutator transaction binding the contract method 0x323d727b.
+//
+// Solidity: function confirmDelivery(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) ConfirmDelivery(SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.ConfirmDelivery(&_CrossChainManager.TransactOpts, SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+}
+
+// ImportOuterTransfer is a paid mutator transaction binding the contract method 0x5b60b01e.
+//
+// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) ImportOuterTransfer(opts *bind.TransactOpts, SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "importOuterTransfer", SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+}
+
+// ImportOuterTransfer is a paid mutator transaction binding the contract method 0x5b60b01e.
+//
+// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) ImportOuterTransfer(SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.ImportOuterTransfer(&_CrossChainManager.TransactOpts, SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+}
+
+// ImportOuterTransfer is a paid mutator transaction binding the contract method 0x5b60b01e.
+//
+// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) ImportOuterTransfer(SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.ImportOuterTransfer(&_CrossChainManager.TransactOpts, SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+}
+
+// Name is a paid mutator transaction binding the contract method 0x06fdde03.
+//
+// Solidity: function name() returns(string Name)
+func (_CrossChainManager *CrossChainManagerTransactor) Name(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "name")
+}
+
+// Name is a paid mutator transaction binding the contract method 0x06fdde03.
+//
+// Solidity: function name() returns(string Name)
+func (_CrossChainManager *CrossChainManagerSession) Name() (*types.Transaction, error) {
+	return _CrossChainManager.Contract.Name(&_CrossChainManager.TransactOpts)
+}
+
+// Name is a paid mutator transaction binding the contract method 0x06fdde03.
+//
+// Solidity: function name() returns(string Name)
+func (_CrossChainManager *CrossChainManagerTransactorSession) Name() (*types.Transaction, error) {
+	return _CrossChainManager.Contract.Name(&_CrossChainManager.TransactOpts)
+}
+
+// RefundBatch is a paid mutator transaction binding the contract method 0xb222367b.
+//
+// Solidity: function refundBatch(bytes32 BatchID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) RefundBatch(opts *bind.TransactOpts, BatchID [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "refundBatch", BatchID)
+}
+
+// RefundBatch is a paid mutator transaction binding the contract method 0xb222367b.
+//
+// Solidity: function refundBatch(bytes32 BatchID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) RefundBatch(BatchID [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.RefundBatch(&_CrossChainManager.TransactOpts, BatchID)
+}
+
+// RefundBatch is a paid mutator transaction binding the contract method 0xb222367b.
+//
+// Solidity: function refundBatch(bytes32 BatchID) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) RefundBatch(BatchID [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.RefundBatch(&_CrossChainManager.TransactOpts, BatchID)
+}
+
+// RefundOutbound is a paid mutator transaction binding the contract method 0xbbf9a9da.
+//
+// Solidity: function refundOutbound(uint64 ToChainID, uint64 Sequence) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) RefundOutbound(opts *bind.TransactOpts, ToChainID uint64, Sequence uint64) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "refundOutbound", ToChainID, Sequence)
+}
+
+// RefundOutbound is a paid mutator transaction binding the contract method 0xbbf9a9da.
+//
+// Solidity: function refundOutbound(uint64 ToChainID, uint64 Sequence) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) RefundOutbound(ToChainID uint64, Sequence uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.RefundOutbound(&_CrossChainManager.TransactOpts, ToChainID, Sequence)
+}
+
+// RefundOutbound is a paid mutator transaction binding the contract method 0xbbf9a9da.
+//
+// Solidity: function refundOutbound(uint64 ToChainID, uint64 Sequence) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) RefundOutbound(ToChainID uint64, Sequence uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.RefundOutbound(&_CrossChainManager.TransactOpts, ToChainID, Sequence)
+}
+
+// SetCheckpointConfig is a paid mutator transaction binding the contract method 0x36ec5ff0.
+//
+// Solidity: function setCheckpointConfig(uint64 Interval, uint64 AnchorChainID, bytes AnchorContract) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetCheckpointConfig(opts *bind.TransactOpts, Interval uint64, AnchorChainID uint64, AnchorContract []byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setCheckpointConfig", Interval, AnchorChainID, AnchorContract)
+}
+
+// SetCheckpointConfig is a paid mutator transaction binding the contract method 0x36ec5ff0.
+//
+// Solidity: function setCheckpointConfig(uint64 Interval, uint64 AnchorChainID, bytes AnchorContract) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetCheckpointConfig(Interval uint64, AnchorChainID uint64, AnchorContract []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetCheckpointConfig(&_CrossChainManager.TransactOpts, Interval, AnchorChainID, AnchorContract)
+}
+
+// SetCheckpointConfig is a paid mutator transaction binding the contract method 0x36ec5ff0.
+//
+// Solidity: function setCheckpointConfig(uint64 Interval, uint64 AnchorChainID, bytes AnchorContract) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetCheckpointConfig(Interval uint64, AnchorChainID uint64, AnchorContract []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetCheckpointConfig(&_CrossChainManager.TransactOpts, Interval, AnchorChainID, AnchorContract)
+}
+
+// SetCodeHashPin is a paid mutator transaction binding the contract method 0xe9d03da0.
+//
+// Solidity: function setCodeHashPin(uint64 ChainID, bytes32 CodeHash) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetCodeHashPin(opts *bind.TransactOpts, ChainID uint64, CodeHash [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setCodeHashPin", ChainID, CodeHash)
+}
+
+// SetCodeHashPin is a paid mutator transaction binding the contract method 0xe9d03da0.
+//
+// Solidity: function setCodeHashPin(uint64 ChainID, bytes32 CodeHash) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetCodeHashPin(ChainID uint64, CodeHash [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetCodeHashPin(&_CrossChainManager.TransactOpts, ChainID, CodeHash)
+}
+
+// SetCodeHashPin is a paid mutator transaction binding the contract method 0xe9d03da0.
+//
+// Solidity: function setCodeHashPin(uint64 ChainID, bytes32 CodeHash) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetCodeHashPin(ChainID uint64, CodeHash [32]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetCodeHashPin(&_CrossChainManager.TransactOpts, ChainID, CodeHash)
+}
+
+// SetDualVerification is a paid mutator transaction binding the contract method 0x17895082.
+//
+// Solidity: function setDualVerification(uint64 ChainID, address[] Attestors, uint64 Threshold) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetDualVerification(opts *bind.TransactOpts, ChainID uint64, Attestors []common.Address, Threshold uint64) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setDualVerification", ChainID, Attestors, Threshold)
+}
+
+// SetDualVerification is a paid mutator transaction binding the contract method 0x17895082.
+//
+// Solidity: function setDualVerification(uint64 ChainID, address[] Attestors, uint64 Threshold) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetDualVerification(ChainID uint64, Attestors []common.Address, Threshold uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetDualVerification(&_CrossChainManager.TransactOpts, ChainID, Attestors, Threshold)
+}
+
+// SetDualVerification is a paid mutator transaction binding the contract method 0x17895082.
+//
+// Solidity: function setDualVerification(uint64 ChainID, address[] Attestors, uint64 Threshold) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetDualVerification(ChainID uint64, Attestors []common.Address, Threshold uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetDualVerification(&_CrossChainManager.TransactOpts, ChainID, Attestors, Threshold)
+}
+
+// SetEntranceWhitelist is a paid mutator transaction binding the contract method 0x277c0332.
+//
+// Solidity: function setEntranceWhitelist(uint64 ChainID, bool Enabled, address[] Callers) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetEntranceWhitelist(opts *bind.TransactOpts, ChainID uint64, Enabled bool, Callers []common.Address) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setEntranceWhitelist", ChainID, Enabled, Callers)
+}
+
+// SetEntranceWhitelist is a paid mutator transaction binding the contract method 0x277c0332.
+//
+// Solidity: function setEntranceWhitelist(uint64 ChainID, bool Enabled, address[] Callers) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetEntranceWhitelist(ChainID uint64, Enabled bool, Callers []common.Address) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetEntranceWhitelist(&_CrossChainManager.TransactOpts, ChainID, Enabled, Callers)
+}
+
+// SetEntranceWhitelist is a paid mutator transaction binding the contract method 0x277c0332.
+//
+// Solidity: function setEntranceWhitelist(uint64 ChainID, bool Enabled, address[] Callers) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetEntranceWhitelist(ChainID uint64, Enabled bool, Callers []common.Address) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetEntranceWhitelist(&_CrossChainManager.TransactOpts, ChainID, Enabled, Callers)
+}
+
+// SetOutboundCallback is a paid mutator transaction binding the contract method 0xfef25605.
+//
+// Solidity: function setOutboundCallback(address Callback, uint64 GasLimit) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetOutboundCallback(opts *bind.TransactOpts, Callback common.Address, GasLimit uint64) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setOutboundCallback", Callback, GasLimit)
+}
+
+// SetOutboundCallback is a paid mutator transaction binding the contract method 0xfef25605.
+//
+// Solidity: function setOutboundCallback(address Callback, uint64 GasLimit) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetOutboundCallback(Callback common.Address, GasLimit uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetOutboundCallback(&_CrossChainManager.TransactOpts, Callback, GasLimit)
+}
+
+// SetOutboundCallback is a paid mutator transaction binding the contract method 0xfef25605.
+//
+// Solidity: function setOutboundCallback(address Callback, uint64 GasLimit) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetOutboundCallback(Callback common.Address, GasLimit uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetOutboundCallback(&_CrossChainManager.TransactOpts, Callback, GasLimit)
+}
+
+// SetProofMaxAge is a paid mutator transaction binding the contract method 0xf6bfd9ac.
+//
+// Solidity: function setProofMaxAge(uint64 ChainID, uint64 MaxAge) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetProofMaxAge(opts *bind.TransactOpts, ChainID uint64, MaxAge uint64) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setProofMaxAge", ChainID, MaxAge)
+}
+
+// SetProofMaxAge is a paid mutator transaction binding the contract method 0xf6bfd9ac.
+//
+// Solidity: function setProofMaxAge(uint64 ChainID, uint64 MaxAge) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetProofMaxAge(ChainID uint64, MaxAge uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetProofMaxAge(&_CrossChainManager.TransactOpts, ChainID, MaxAge)
+}
+
+// SetProofMaxAge is a paid mutator transaction binding the contract method 0xf6bfd9ac.
+//
+// Solidity: function setProofMaxAge(uint64 ChainID, uint64 MaxAge) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetProofMaxAge(ChainID uint64, MaxAge uint64) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetProofMaxAge(&_CrossChainManager.TransactOpts, ChainID, MaxAge)
+}
+
+// SetSourceAllowlist is a paid mutator transaction binding the contract method 0x7bc3a8ac.
+//
+// Solidity: function setSourceAllowlist(uint64 ChainID, bytes[] Contracts) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SetSourceAllowlist(opts *bind.TransactOpts, ChainID uint64, Contracts [][]byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "setSourceAllowlist", ChainID, Contracts)
+}
+
+// SetSourceAllowlist is a paid mutator transaction binding the contract method 0x7bc3a8ac.
+//
+// Solidity: function setSourceAllowlist(uint64 ChainID, bytes[] Contracts) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SetSourceAllowlist(ChainID uint64, Contracts [][]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetSourceAllowlist(&_CrossChainManager.TransactOpts, ChainID, Contracts)
+}
+
+// SetSourceAllowlist is a paid mutator transaction binding the contract method 0x7bc3a8ac.
+//
+// Solidity: function setSourceAllowlist(uint64 ChainID, bytes[] Contracts) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SetSourceAllowlist(ChainID uint64, Contracts [][]byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SetSourceAllowlist(&_CrossChainManager.TransactOpts, ChainID, Contracts)
+}
+
+// SubmitCheckpoint is a paid mutator transaction binding the contract method 0x40a888c3.
+//
+// Solidity: function submitCheckpoint(uint64 Height, bytes BlockHash, bytes StateRoot) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactor) SubmitCheckpoint(opts *bind.TransactOpts, Height uint64, BlockHash []byte, StateRoot []byte) (*types.Transaction, error) {
+	return _CrossChainManager.contract.Transact(opts, "submitCheckpoint", Height, BlockHash, StateRoot)
+}
+
+// SubmitCheckpoint is a paid mutator transaction binding the contract method 0x40a888c3.
+//
+// Solidity: function submitCheckpoint(uint64 Height, bytes BlockHash, bytes StateRoot) returns(bool success)
+func (_CrossChainManager *CrossChainManagerSession) SubmitCheckpoint(Height uint64, BlockHash []byte, StateRoot []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SubmitCheckpoint(&_CrossChainManager.TransactOpts, Height, BlockHash, StateRoot)
+}
+
+// SubmitCheckpoint is a paid mutator transaction binding the contract method 0x40a888c3.
+//
+// Solidity: function submitCheckpoint(uint64 Height, bytes BlockHash, bytes StateRoot) returns(bool success)
+func (_CrossChainManager *CrossChainManagerTransactorSession) SubmitCheckpoint(Height uint64, BlockHash []byte, StateRoot []byte) (*types.Transaction, error) {
+	return _CrossChainManager.Contract.SubmitCheckpoint(&_CrossChainManager.TransactOpts, Height, BlockHash, StateRoot)
+}
+
+// CrossChainManagerBatchCallbackInvokedIterator is returned from FilterBatchCallbackInvoked and is used to iterate over the raw logs and unpacked data for BatchCallbackInvoked events raised by the CrossChainManager contract.
+type CrossChainManagerBatchCallbackInvokedIterator struct {
+	Event *CrossChainManagerBatchCallbackInvoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerBatchCallbackInvokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerBatchCallbackInvoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerBatchCallbackInvoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerBatchCallbackInvokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerBatchCallbackInvokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerBatchCallbackInvoked represents a BatchCallbackInvoked event raised by the CrossChainManager contract.
+type CrossChainManagerBatchCallbackInvoked struct {
+	Sender  common.Address
+	BatchID [32]byte
+	State   uint8
+	Success bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchCallbackInvoked is a free log retrieval operation binding the contract event 0x0557d3b1a853581ce7bc29e7d64168cb44f9f33c63774be0d90c0ed1b88bf71e.
+//
+// Solidity: event batchCallbackInvoked(address Sender, bytes32 BatchID, uint8 State, bool Success)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterBatchCallbackInvoked(opts *bind.FilterOpts) (*CrossChainManagerBatchCallbackInvokedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "batchCallbackInvoked")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerBatchCallbackInvokedIterator{contract: _CrossChainManager.contract, event: "batchCallbackInvoked", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchCallbackInvoked is a free log subscription operation binding the contract event 0x0557d3b1a853581ce7bc29e7d64168cb44f9f33c63774be0d90c0ed1b88bf71e.
+//
+// Solidity: event batchCallbackInvoked(address Sender, bytes32 BatchID, uint8 State, bool Success)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchBatchCallbackInvoked(opts *bind.WatchOpts, sink chan<- *CrossChainManagerBatchCallbackInvoked) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "batchCallbackInvoked")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerBatchCallbackInvoked)
+				if err := _CrossChainManager.contract.UnpackLog(event, "batchCallbackInvoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchCallbackInvoked is a log parse operation binding the contract event 0x0557d3b1a853581ce7bc29e7d64168cb44f9f33c63774be0d90c0ed1b88bf71e.
+//
+// Solidity: event batchCallbackInvoked(address Sender, bytes32 BatchID, uint8 State, bool Success)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseBatchCallbackInvoked(log types.Log) (*CrossChainManagerBatchCallbackInvoked, error) {
+	event := new(CrossChainManagerBatchCallbackInvoked)
+	if err := _CrossChainManager.contract.UnpackLog(event, "batchCallbackInvoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerBatchStateChangedIterator is returned from FilterBatchStateChanged and is used to iterate over the raw logs and unpacked data for BatchStateChanged events raised by the CrossChainManager contract.
+type CrossChainManagerBatchStateChangedIterator struct {
+	Event *CrossChainManagerBatchStateChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerBatchStateChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerBatchStateChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerBatchStateChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerBatchStateChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerBatchStateChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerBatchStateChanged represents a BatchStateChanged event raised by the CrossChainManager contract.
+type CrossChainManagerBatchStateChanged struct {
+	BatchID [32]byte
+	State   uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchStateChanged is a free log retrieval operation binding the contract event 0x517dd24310007e9bf6f36810037ee3b622a526693a3e14d06de405e351334dc9.
+//
+// Solidity: event batchStateChanged(bytes32 BatchID, uint8 State)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterBatchStateChanged(opts *bind.FilterOpts) (*CrossChainManagerBatchStateChangedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "batchStateChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerBatchStateChangedIterator{contract: _CrossChainManager.contract, event: "batchStateChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchStateChanged is a free log subscription operation binding the contract event 0x517dd24310007e9bf6f36810037ee3b622a526693a3e14d06de405e351334dc9.
+//
+// Solidity: event batchStateChanged(bytes32 BatchID, uint8 State)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchBatchStateChanged(opts *bind.WatchOpts, sink chan<- *CrossChainManagerBatchStateChanged) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "batchStateChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerBatchStateChanged)
+				if err := _CrossChainManager.contract.UnpackLog(event, "batchStateChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchStateChanged is a log parse operation binding the contract event 0x517dd24310007e9bf6f36810037ee3b622a526693a3e14d06de405e351334dc9.
+//
+// Solidity: event batchStateChanged(bytes32 BatchID, uint8 State)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseBatchStateChanged(log types.Log) (*CrossChainManagerBatchStateChanged, error) {
+	event := new(CrossChainManagerBatchStateChanged)
+	if err := _CrossChainManager.contract.UnpackLog(event, "batchStateChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerBtcTxMultiSignEventIterator is returned from FilterBtcTxMultiSignEvent and is used to iterate over the raw logs and unpacked data for BtcTxMultiSignEvent events raised by the CrossChainManager contract.
+type CrossChainManagerBtcTxMultiSignEventIterator struct {
+	Event *CrossChainManagerBtcTxMultiSignEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerBtcTxMultiSignEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerBtcTxMultiSignEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerBtcTxMultiSignEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerBtcTxMultiSignEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerBtcTxMultiSignEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerBtcTxMultiSignEvent represents a BtcTxMultiSignEvent event raised by the CrossChainManager contract.
+type CrossChainManagerBtcTxMultiSignEvent struct {
+	TxHash    []byte
+	MultiSign []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBtcTxMultiSignEvent is a free log retrieval operation binding the contract event 0x62fb550ff7fa48f759b0e56ea24757e77b5612d11efbcbdbed9545982cfe1770.
+//
+// Solidity: event btcTxMultiSignEvent(bytes TxHash, bytes MultiSign)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterBtcTxMultiSignEvent(opts *bind.FilterOpts) (*CrossChainManagerBtcTxMultiSignEventIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "btcTxMultiSignEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerBtcTxMultiSignEventIterator{contract: _CrossChainManager.contract, event: "btcTxMultiSignEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchBtcTxMultiSignEvent is a free log subscription operation binding the contract event 0x62fb550ff7fa48f759b0e56ea24757e77b5612d11efbcbdbed9545982cfe1770.
+//
+// Solidity: event btcTxMultiSignEvent(bytes TxHash, bytes MultiSign)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxMultiSignEvent(opts *bind.WatchOpts, sink chan<- *CrossChainManagerBtcTxMultiSignEvent) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "btcTxMultiSignEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerBtcTxMultiSignEvent)
+				if err := _CrossChainManager.contract.UnpackLog(event, "btcTxMultiSignEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBtcTxMultiSignEvent is a log parse operation binding the contract event 0x62fb550ff7fa48f759b0e56ea24757e77b5612d11efbcbdbed9545982cfe1770.
+//
+// Solidity: event btcTxMultiSignEvent(bytes TxHash, bytes MultiSign)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseBtcTxMultiSignEvent(log types.Log) (*CrossChainManagerBtcTxMultiSignEvent, error) {
+	event := new(CrossChainManagerBtcTxMultiSignEvent)
+	if err := _CrossChainManager.contract.UnpackLog(event, "btcTxMultiSignEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerBtcTxToRelayEventIterator is returned from FilterBtcTxToRelayEvent and is used to iterate over the raw logs and unpacked data for BtcTxToRelayEvent events raised by the CrossChainManager contract.
+type CrossChainManagerBtcTxToRelayEventIterator struct {
+	Event *CrossChainManagerBtcTxToRelayEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerBtcTxToRelayEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerBtcTxToRelayEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerBtcTxToRelayEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerBtcTxToRelayEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerBtcTxToRelayEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerBtcTxToRelayEvent represents a BtcTxToRelayEvent event raised by the CrossChainManager contract.
+type CrossChainManagerBtcTxToRelayEvent struct {
+	FromChainID uint64
+	ChainID     uint64
+	Buf         string
+	FromTxHash  string
+	RedeemKey   string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBtcTxToRelayEvent is a free log retrieval operation binding the contract event 0x59c070ab5215dda625f463061a0ad421505b2cca1066a8597411c72a5ecac51b.
+//
+// Solidity: event btcTxToRelayEvent(uint64 FromChainID, uint64 ChainID, string buf, string FromTxHash, string RedeemKey)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterBtcTxToRelayEvent(opts *bind.FilterOpts) (*CrossChainManagerBtcTxToRelayEventIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "btcTxToRelayEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerBtcTxToRelayEventIterator{contract: _CrossChainManager.contract, event: "btcTxToRelayEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchBtcTxToRelayEvent is a free log subscription operation binding the contract event 0x59c070ab5215dda625f463061a0ad421505b2cca1066a8597411c72a5ecac51b.
+//
+// Solidity: event btcTxToRelayEvent(uint64 FromChainID, uint64 ChainID, string buf, string FromTxHash, string RedeemKey)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxToRelayEvent(opts *bind.WatchOpts, sink chan<- *CrossChainManagerBtcTxToRelayEvent) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "btcTxToRelayEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerBtcTxToRelayEvent)
+				if err := _CrossChainManager.contract.UnpackLog(event, "btcTxToRelayEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBtcTxToRelayEvent is a log parse operation binding the contract event 0x59c070ab5215dda625f463061a0ad421505b2cca1066a8597411c72a5ecac51b.
+//
+// Solidity: event btcTxToRelayEvent(uint64 FromChainID, uint64 ChainID, string buf, string FromTxHash, string RedeemKey)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseBtcTxToRelayEvent(log types.Log) (*CrossChainManagerBtcTxToRelayEvent, error) {
+	event := new(CrossChainManagerBtcTxToRelayEvent)
+	if err := _CrossChainManager.contract.UnpackLog(event, "btcTxToRelayEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerCheckpointMadeIterator is returned from FilterCheckpointMade and is used to iterate over the raw logs and unpacked data for CheckpointMade events raised by the CrossChainManager contract.
+type CrossChainManagerCheckpointMadeIterator struct {
+	Event *CrossChainManagerCheckpointMade // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerCheckpointMadeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerCheckpointMade)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerCheckpointMade)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerCheckpointMadeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerCheckpointMadeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerCheckpointMade represents a CheckpointMade event raised by the CrossChainManager contract.
+type CrossChainManagerCheckpointMade struct {
+	Height    uint64
+	BlockHash []byte
+	StateRoot []byte
+	EpochHash []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterCheckpointMade is a free log retrieval operation binding the contract event 0xcc32c22ea9309a9ac3a877e6bc34fec1c19db498cf8aa6f94b0ad1b0ac06d729.
+//
+// Solidity: event checkpointMade(uint64 Height, bytes BlockHash, bytes StateRoot, bytes EpochHash)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterCheckpointMade(opts *bind.FilterOpts) (*CrossChainManagerCheckpointMadeIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "checkpointMade")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerCheckpointMadeIterator{contract: _CrossChainManager.contract, event: "checkpointMade", logs: logs, sub: sub}, nil
+}
+
+// WatchCheckpointMade is a free log subscription operation binding the contract event 0xcc32c22ea9309a9ac3a877e6bc34fec1c19db498cf8aa6f94b0ad1b0ac06d729.
+//
+// Solidity: event checkpointMade(uint64 Height, bytes BlockHash, bytes StateRoot, bytes EpochHash)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchCheckpointMade(opts *bind.WatchOpts, sink chan<- *CrossChainManagerCheckpointMade) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "checkpointMade")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerCheckpointMade)
+				if err := _CrossChainManager.contract.UnpackLog(event, "checkpointMade", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCheckpointMade is a log parse operation binding the contract event 0xcc32c22ea9309a9ac3a877e6bc34fec1c19db498cf8aa6f94b0ad1b0ac06d729.
+//
+// Solidity: event checkpointMade(uint64 Height, bytes BlockHash, bytes StateRoot, bytes EpochHash)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseCheckpointMade(log types.Log) (*CrossChainManagerCheckpointMade, error) {
+	event := new(CrossChainManagerCheckpointMade)
+	if err := _CrossChainManager.contract.UnpackLog(event, "checkpointMade", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerCodeHashMismatchedIterator is returned from FilterCodeHashMismatched and is used to iterate over the raw logs and unpacked data for CodeHashMismatched events raised by the CrossChainManager contract.
+type CrossChainManagerCodeHashMismatchedIterator struct {
+	Event *CrossChainManagerCodeHashMismatched // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerCodeHashMismatchedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerCodeHashMismatched)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerCodeHashMismatched)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerCodeHashMismatchedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerCodeHashMismatchedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerCodeHashMismatched represents a CodeHashMismatched event raised by the CrossChainManager contract.
+type CrossChainManagerCodeHashMismatched struct {
+	ChainID  uint64
+	Expected [32]byte
+	Actual   [32]byte
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterCodeHashMismatched is a free log retrieval operation binding the contract event 0x3048fedc3e2f34843ceb658d9fd7b01b647bf06d5ed04d021115b2837d43d9b5.
+//
+// Solidity: event codeHashMismatched(uint64 ChainID, bytes32 Expected, bytes32 Actual)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterCodeHashMismatched(opts *bind.FilterOpts) (*CrossChainManagerCodeHashMismatchedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "codeHashMismatched")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerCodeHashMismatchedIterator{contract: _CrossChainManager.contract, event: "codeHashMismatched", logs: logs, sub: sub}, nil
+}
+
+// WatchCodeHashMismatched is a free log subscription operation binding the contract event 0x3048fedc3e2f34843ceb658d9fd7b01b647bf06d5ed04d021115b2837d43d9b5.
+//
+// Solidity: event codeHashMismatched(uint64 ChainID, bytes32 Expected, bytes32 Actual)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchCodeHashMismatched(opts *bind.WatchOpts, sink chan<- *CrossChainManagerCodeHashMismatched) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "codeHashMismatched")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerCodeHashMismatched)
+				if err := _CrossChainManager.contract.UnpackLog(event, "codeHashMismatched", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCodeHashMismatched is a log parse operation binding the contract event 0x3048fedc3e2f34843ceb658d9fd7b01b647bf06d5ed04d021115b2837d43d9b5.
+//
+// Solidity: event codeHashMismatched(uint64 ChainID, bytes32 Expected, bytes32 Actual)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseCodeHashMismatched(log types.Log) (*CrossChainManagerCodeHashMismatched, error) {
+	event := new(CrossChainManagerCodeHashMismatched)
+	if err := _CrossChainManager.contract.UnpackLog(event, "codeHashMismatched", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerCodeHashPinChangedIterator is returned from FilterCodeHashPinChanged and is used to iterate over the raw logs and unpacked data for CodeHashPinChanged events raised by the CrossChainManager contract.
+type CrossChainManagerCodeHashPinChangedIterator struct {
+	Event *CrossChainManagerCodeHashPinChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerCodeHashPinChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerCodeHashPinChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerCodeHashPinChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerCodeHashPinChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerCodeHashPinChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerCodeHashPinChanged represents a CodeHashPinChanged event raised by the CrossChainManager contract.
+type CrossChainManagerCodeHashPinChanged struct {
+	ChainID  uint64
+	CodeHash [32]byte
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterCodeHashPinChanged is a free log retrieval operation binding the contract event 0x37b138e7b6657536449b2690ecbd3c8286cc5ec84b6bfecc253c0adcced934f0.
+//
+// Solidity: event codeHashPinChanged(uint64 ChainID, bytes32 CodeHash)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterCodeHashPinChanged(opts *bind.FilterOpts) (*CrossChainManagerCodeHashPinChangedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "codeHashPinChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerCodeHashPinChangedIterator{contract: _CrossChainManager.contract, event: "codeHashPinChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchCodeHashPinChanged is a free log subscription operation binding the contract event 0x37b138e7b6657536449b2690ecbd3c8286cc5ec84b6bfecc253c0adcced934f0.
+//
+// Solidity: event codeHashPinChanged(uint64 ChainID, bytes32 CodeHash)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchCodeHashPinChanged(opts *bind.WatchOpts, sink chan<- *CrossChainManagerCodeHashPinChanged) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "codeHashPinChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerCodeHashPinChanged)
+				if err := _CrossChainManager.contract.UnpackLog(event, "codeHashPinChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCodeHashPinChanged is a log parse operation binding the contract event 0x37b138e7b6657536449b2690ecbd3c8286cc5ec84b6bfecc253c0adcced934f0.
+//
+// Solidity: event codeHashPinChanged(uint64 ChainID, bytes32 CodeHash)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseCodeHashPinChanged(log types.Log) (*CrossChainManagerCodeHashPinChanged, error) {
+	event := new(CrossChainManagerCodeHashPinChanged)
+	if err := _CrossChainManager.contract.UnpackLog(event, "codeHashPinChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerDeliveryConfirmedIterator is returned from FilterDeliveryConfirmed and is used to iterate over the raw logs and unpacked data for DeliveryConfirmed events raised by the CrossChainManager contract.
+type CrossChainManagerDeliveryConfirmedIterator struct {
+	Event *CrossChainManagerDeliveryConfirmed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerDeliveryConfirmedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerDeliveryConfirmed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerDeliveryConfirmed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerDeliveryConfirmedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerDeliveryConfirmedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerDeliveryConfirmed represents a DeliveryConfirmed event raised by the CrossChainManager contract.
+type CrossChainManagerDeliveryConfirmed struct {
+	ToChainID uint64
+	Sequence  uint64
+	TxHash    []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeliveryConfirmed is a free log retrieval operation binding the contract event 0x256e031db96d4dc6ba2a9dc8b8a81592ccce3c21a68ba0a31e6154ce4d425be2.
+//
+// Solidity: event deliveryConfirmed(uint64 ToChainID, uint64 Sequence, bytes TxHash)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterDeliveryConfirmed(opts *bind.FilterOpts) (*CrossChainManagerDeliveryConfirmedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "deliveryConfirmed")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerDeliveryConfirmedIterator{contract: _CrossChainManager.contract, event: "deliveryConfirmed", logs: logs, sub: sub}, nil
+}
+
+// WatchDeliveryConfirmed is a free log subscription operation binding the contract event 0x256e031db96d4dc6ba2a9dc8b8a81592ccce3c21a68ba0a31e6154ce4d425be2.
+//
+// Solidity: event deliveryConfirmed(uint64 ToChainID, uint64 Sequence, bytes TxHash)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchDeliveryConfirmed(opts *bind.WatchOpts, sink chan<- *CrossChainManagerDeliveryConfirmed) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "deliveryConfirmed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerDeliveryConfirmed)
+				if err := _CrossChainManager.contract.UnpackLog(event, "deliveryConfirmed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeliveryConfirmed is a log parse operation binding the contract event 0x256e031db96d4dc6ba2a9dc8b8a81592ccce3c21a68ba0a31e6154ce4d425be2.
+//
+// Solidity: event deliveryConfirmed(uint64 ToChainID, uint64 Sequence, bytes TxHash)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseDeliveryConfirmed(log types.Log) (*CrossChainManagerDeliveryConfirmed, error) {
+	event := new(CrossChainManagerDeliveryConfirmed)
+	if err := _CrossChainManager.contract.UnpackLog(event, "deliveryConfirmed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerDeliveryFailedIterator is returned from FilterDeliveryFailed and is used to iterate over the raw logs and unpacked data for DeliveryFailed events raised by the CrossChainManager contract.
+type CrossChainManagerDeliveryFailedIterator struct {
+	Event *CrossChainManagerDeliveryFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerDeliveryFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerDeliveryFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerDeliveryFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerDeliveryFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerDeliveryFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerDeliveryFailed represents a DeliveryFailed event raised by the CrossChainManager contract.
+type CrossChainManagerDeliveryFailed struct {
+	ToChainID uint64
+	Sequence  uint64
+	TxHash    []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeliveryFailed is a free log retrieval operation binding the contract event 0x2baaed50c5f9af1f84a955fdb97478c8fec9c8e9563a40aa6323fe9dc771cd07.
+//
+// Solidity: event deliveryFailed(uint64 ToChainID, uint64 Sequence, bytes TxHash)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterDeliveryFailed(opts *bind.FilterOpts) (*CrossChainManagerDeliveryFailedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "deliveryFailed")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerDeliveryFailedIterator{contract: _CrossChainManager.contract, event: "deliveryFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchDeliveryFailed is a free log subscription operation binding the contract event 0x2baaed50c5f9af1f84a955fdb97478c8fec9c8e9563a40aa6323fe9dc771cd07.
+//
+// Solidity: event deliveryFailed(uint64 ToChainID, uint64 Sequence, bytes TxHash)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchDeliveryFailed(opts *bind.WatchOpts, sink chan<- *CrossChainManagerDeliveryFailed) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "deliveryFailed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerDeliveryFailed)
+				if err := _CrossChainManager.contract.UnpackLog(event, "deliveryFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeliveryFailed is a log parse operation binding the contract event 0x2baaed50c5f9af1f84a955fdb97478c8fec9c8e9563a40aa6323fe9dc771cd07.
+//
+// Solidity: event deliveryFailed(uint64 ToChainID, uint64 Sequence, bytes TxHash)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseDeliveryFailed(log types.Log) (*CrossChainManagerDeliveryFailed, error) {
+	event := new(CrossChainManagerDeliveryFailed)
+	if err := _CrossChainManager.contract.UnpackLog(event, "deliveryFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerDualVerificationChangedIterator is returned from FilterDualVerificationChanged and is used to iterate over the raw logs and unpacked data for DualVerificationChanged events raised by the CrossChainManager contract.
+type CrossChainManagerDualVerificationChangedIterator struct {
+	Event *CrossChainManagerDualVerificationChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerDualVerificationChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerDualVerificationChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerDualVerificationChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerDualVerificationChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerDualVerificationChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerDualVerificationChanged represents a DualVerificationChanged event raised by the CrossChainManager contract.
+type CrossChainManagerDualVerificationChanged struct {
+	ChainID   uint64
+	Attestors []common.Address
+	Threshold uint64
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDualVerificationChanged is a free log retrieval operation binding the contract event 0xcf5ef315bbf2814df5a53ddc8c24a4596f48a6eb83a2f466a16bf992d9f52c9b.
+//
+// Solidity: event dualVerificationChanged(uint64 ChainID, address[] Attestors, uint64 Threshold)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterDualVerificationChanged(opts *bind.FilterOpts) (*CrossChainManagerDualVerificationChangedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "dualVerificationChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerDualVerificationChangedIterator{contract: _CrossChainManager.contract, event: "dualVerificationChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchDualVerificationChanged is a free log subscription operation binding the contract event 0xcf5ef315bbf2814df5a53ddc8c24a4596f48a6eb83a2f466a16bf992d9f52c9b.
+//
+// Solidity: event dualVerificationChanged(uint64 ChainID, address[] Attestors, uint64 Threshold)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchDualVerificationChanged(opts *bind.WatchOpts, sink chan<- *CrossChainManagerDualVerificationChanged) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "dualVerificationChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerDualVerificationChanged)
+				if err := _CrossChainManager.contract.UnpackLog(event, "dualVerificationChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDualVerificationChanged is a log parse operation binding the contract event 0xcf5ef315bbf2814df5a53ddc8c24a4596f48a6eb83a2f466a16bf992d9f52c9b.
+//
+// Solidity: event dualVerificationChanged(uint64 ChainID, address[] Attestors, uint64 Threshold)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseDualVerificationChanged(log types.Log) (*CrossChainManagerDualVerificationChanged, error) {
+	event := new(CrossChainManagerDualVerificationChanged)
+	if err := _CrossChainManager.contract.UnpackLog(event, "dualVerificationChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerEntranceWhitelistChangedIterator is returned from FilterEntranceWhitelistChanged and is used to iterate over the raw logs and unpacked data for EntranceWhitelistChanged events raised by the CrossChainManager contract.
+type CrossChainManagerEntranceWhitelistChangedIterator struct {
+	Event *CrossChainManagerEntranceWhitelistChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerEntranceWhitelistChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerEntranceWhitelistChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerEntranceWhitelistChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerEntranceWhitelistChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerEntranceWhitelistChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerEntranceWhitelistChanged represents a EntranceWhitelistChanged event raised by the CrossChainManager contract.
+type CrossChainManagerEntranceWhitelistChanged struct {
+	ChainID uint64
+	Enabled bool
+	Callers []common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterEntranceWhitelistChanged is a free log retrieval operation binding the contract event 0x0dbe790ec45f3550ae6bbd7abe6e8baa052943f282773175eb6ca12a5f75fe3e.
+//
+// Solidity: event entranceWhitelistChanged(uint64 ChainID, bool Enabled, address[] Callers)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterEntranceWhitelistChanged(opts *bind.FilterOpts) (*CrossChainManagerEntranceWhitelistChangedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "entranceWhitelistChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerEntranceWhitelistChangedIterator{contract: _CrossChainManager.contract, event: "entranceWhitelistChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEntranceWhitelistChanged is a free log subscription operation binding the contract event 0x0dbe790ec45f3550ae6bbd7abe6e8baa052943f282773175eb6ca12a5f75fe3e.
+//
+// Solidity: event entranceWhitelistChanged(uint64 ChainID, bool Enabled, address[] Callers)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchEntranceWhitelistChanged(opts *bind.WatchOpts, sink chan<- *CrossChainManagerEntranceWhitelistChanged) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "entranceWhitelistChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerEntranceWhitelistChanged)
+				if err := _CrossChainManager.contract.UnpackLog(event, "entranceWhitelistChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEntranceWhitelistChanged is a log parse operation binding the contract event 0x0dbe790ec45f3550ae6bbd7abe6e8baa052943f282773175eb6ca12a5f75fe3e.
+//
+// Solidity: event entranceWhitelistChanged(uint64 ChainID, bool Enabled, address[] Callers)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseEntranceWhitelistChanged(log types.Log) (*CrossChainManagerEntranceWhitelistChanged, error) {
+	event := new(CrossChainManagerEntranceWhitelistChanged)
+	if err := _CrossChainManager.contract.UnpackLog(event, "entranceWhitelistChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerImportShadowedIterator is returned from FilterImportShadowed and is used to iterate over the raw logs and unpacked data for ImportShadowed events raised by the CrossChainManager contract.
+type CrossChainManagerImportShadowedIterator struct {
+	Event *CrossChainManagerImportShadowed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerImportShadowedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerImportShadowed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerImportShadowed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerImportShadowedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerImportShadowedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerImportShadowed represents a ImportShadowed event raised by the CrossChainManager contract.
+type CrossChainManagerImportShadowed struct {
+	SourceChainID uint64
+	CrossChainID  []byte
+	Verified      bool
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterImportShadowed is a free log retrieval operation binding the contract event 0xe05cb92407874924fe6cca2f7db5cee99af22c0954dd13ab09380ede41222d1e.
+//
+// Solidity: event importShadowed(uint64 SourceChainID, bytes CrossChainID, bool Verified)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterImportShadowed(opts *bind.FilterOpts) (*CrossChainManagerImportShadowedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "importShadowed")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerImportShadowedIterator{contract: _CrossChainManager.contract, event: "importShadowed", logs: logs, sub: sub}, nil
+}
+
+// WatchImportShadowed is a free log subscription operation binding the contract event 0xe05cb92407874924fe6cca2f7db5cee99af22c0954dd13ab09380ede41222d1e.
+//
+// Solidity: event importShadowed(uint64 SourceChainID, bytes CrossChainID, bool Verified)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchImportShadowed(opts *bind.WatchOpts, sink chan<- *CrossChainManagerImportShadowed) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "importShadowed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerImportShadowed)
+				if err := _CrossChainManager.contract.UnpackLog(event, "importShadowed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseImportShadowed is a log parse operation binding the contract event 0xe05cb92407874924fe6cca2f7db5cee99af22c0954dd13ab09380ede41222d1e.
+//
+// Solidity: event importShadowed(uint64 SourceChainID, bytes CrossChainID, bool Verified)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseImportShadowed(log types.Log) (*CrossChainManagerImportShadowed, error) {
+	event := new(CrossChainManagerImportShadowed)
+	if err := _CrossChainManager.contract.UnpackLog(event, "importShadowed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerMakeBtcTxEventIterator is returned from FilterMakeBtcTxEvent and is used to iterate over the raw logs and unpacked data for MakeBtcTxEvent events raised by the CrossChainManager contract.
+type CrossChainManagerMakeBtcTxEventIterator struct {
+	Event *CrossChainManagerMakeBtcTxEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerMakeBtcTxEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerMakeBtcTxEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerMakeBtcTxEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerMakeBtcTxEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerMakeBtcTxEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerMakeBtcTxEvent represents a MakeBtcTxEvent event raised by the CrossChainManager contract.
+type CrossChainManagerMakeBtcTxEvent struct {
+	Rk   string
+	Buf  string
+	Amts []uint64
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterMakeBtcTxEvent is a free log retrieval operation binding the contract event 0xa00d721fd040a2b479d1adf886244de04bdbb3e3e310dc75f1036e2602726234.
+//
+// Solidity: event makeBtcTxEvent(string rk, string buf, uint64[] amts)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterMakeBtcTxEvent(opts *bind.FilterOpts) (*CrossChainManagerMakeBtcTxEventIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "makeBtcTxEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerMakeBtcTxEventIterator{contract: _CrossChainManager.contract, event: "makeBtcTxEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchMakeBtcTxEvent is a free log subscription operation binding the contract event 0xa00d721fd040a2b479d1adf886244de04bdbb3e3e310dc75f1036e2602726234.
+//
+// Solidity: event makeBtcTxEvent(string rk, string buf, uint64[] amts)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeBtcTxEvent(opts *bind.WatchOpts, sink chan<- *CrossChainManagerMakeBtcTxEvent) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "makeBtcTxEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerMakeBtcTxEvent)
+				if err := _CrossChainManager.contract.UnpackLog(event, "makeBtcTxEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMakeBtcTxEvent is a log parse operation binding the contract event 0xa00d721fd040a2b479d1adf886244de04bdbb3e3e310dc75f1036e2602726234.
+//
+// Solidity: event makeBtcTxEvent(string rk, string buf, uint64[] amts)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseMakeBtcTxEvent(log types.Log) (*CrossChainManagerMakeBtcTxEvent, error) {
+	event := new(CrossChainManagerMakeBtcTxEvent)
+	if err := _CrossChainManager.contract.UnpackLog(event, "makeBtcTxEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerMakeProofIterator is returned from FilterMakeProof and is used to iterate over the raw logs and unpacked data for MakeProof events raised by the CrossChainManager contract.
+type CrossChainManagerMakeProofIterator struct {
+	Event *CrossChainManagerMakeProof // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerMakeProofIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerMakeProof)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerMakeProof)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerMakeProofIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerMakeProofIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerMakeProof represents a MakeProof event raised by the CrossChainManager contract.
+type CrossChainManagerMakeProof struct {
+	MerkleValueHex string
+	BlockHeight    uint64
+	Key            string
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterMakeProof is a free log retrieval operation binding the contract event 0x25680d41ae78d1188140c6547c9b1890e26bbfa2e0c5b5f1d81aef8985f4d49d.
+//
+// Solidity: event makeProof(string merkleValueHex, uint64 BlockHeight, string key)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterMakeProof(opts *bind.FilterOpts) (*CrossChainManagerMakeProofIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "makeProof")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerMakeProofIterator{contract: _CrossChainManager.contract, event: "makeProof", logs: logs, sub: sub}, nil
+}
+
+// WatchMakeProof is a free log subscription operation binding the contract event 0x25680d41ae78d1188140c6547c9b1890e26bbfa2e0c5b5f1d81aef8985f4d49d.
+//
+// Solidity: event makeProof(string merkleValueHex, uint64 BlockHeight, string key)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeProof(opts *bind.WatchOpts, sink chan<- *CrossChainManagerMakeProof) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "makeProof")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerMakeProof)
+				if err := _CrossChainManager.contract.UnpackLog(event, "makeProof", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMakeProof is a log parse operation binding the contract event 0x25680d41ae78d1188140c6547c9b1890e26bbfa2e0c5b5f1d81aef8985f4d49d.
+//
+// Solidity: event makeProof(string merkleValueHex, uint64 BlockHeight, string key)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseMakeProof(log types.Log) (*CrossChainManagerMakeProof, error) {
+	event := new(CrossChainManagerMakeProof)
+	if err := _CrossChainManager.contract.UnpackLog(event, "makeProof", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerMessageAttestedIterator is returned from FilterMessageAttested and is used to iterate over the raw logs and unpacked data for MessageAttested events raised by the CrossChainManager contract.
+type CrossChainManagerMessageAttestedIterator struct {
+	Event *CrossChainManagerMessageAttested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerMessageAttestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerMessageAttested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerMessageAttested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerMessageAttestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerMessageAttestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerMessageAttested represents a MessageAttested event raised by the CrossChainManager contract.
+type CrossChainManagerMessageAttested struct {
+	ChainID     uint64
+	MessageHash [32]byte
+	Attestor    common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageAttested is a free log retrieval operation binding the contract event 0xc4d45d017672bcede35fedba7efd065eb38647c2d6f65ff815112bd5b3521ee6.
+//
+// Solidity: event messageAttested(uint64 ChainID, bytes32 MessageHash, address Attestor)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterMessageAttested(opts *bind.FilterOpts) (*CrossChainManagerMessageAttestedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "messageAttested")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerMessageAttestedIterator{contract: _CrossChainManager.contract, event: "messageAttested", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageAttested is a free log subscription operation binding the contract event 0xc4d45d017672bcede35fedba7efd065eb38647c2d6f65ff815112bd5b3521ee6.
+//
+// Solidity: event messageAttested(uint64 ChainID, bytes32 MessageHash, address Attestor)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchMessageAttested(opts *bind.WatchOpts, sink chan<- *CrossChainManagerMessageAttested) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "messageAttested")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerMessageAttested)
+				if err := _CrossChainManager.contract.UnpackLog(event, "messageAttested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageAttested is a log parse operation binding the contract event 0xc4d45d017672bcede35fedba7efd065eb38647c2d6f65ff815112bd5b3521ee6.
+//
+// Solidity: event messageAttested(uint64 ChainID, bytes32 MessageHash, address Attestor)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseMessageAttested(log types.Log) (*CrossChainManagerMessageAttested, error) {
+	event := new(CrossChainManagerMessageAttested)
+	if err := _CrossChainManager.contract.UnpackLog(event, "messageAttested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CrossChainManagerMessageImportedIterator is returned from FilterMessageImported and is used to iterate over the raw logs and unpacked data for MessageImported events raised by the CrossChainManager contract.
+type CrossChainManagerMessageImportedIterator struct {
+	Event *CrossChainManagerMessageImported // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerMessageImportedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerMessageImported)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerMessageImported)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerMessageImportedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerMessageImportedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossChainManagerMessageImported represents a MessageImported event raised by the CrossChainManager contract.
+type CrossChainManagerMessageImported struct {
+	FromChainID uint64
+	MessageHash [32]byte
+	Index       uint64
+	Leaf        [32]byte
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageImported is a free log retrieval operation binding the contract event 0xaca3d68bd13812851f43e9756a250f16278f5f5ab7d9fbd642b080a7849429b6.
+//
+// Solidity: event messageImported(uint64 FromChainID, bytes32 MessageHash, uint64 Index, bytes32 Leaf)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterMessageImported(opts *bind.FilterOpts) (*CrossChainManagerMessageImportedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "messageImported")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerMessageImportedIterator{contract: _CrossChainManager.contract, event: "messageImported", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageImported is a free log subscription operation binding the contract event 0xaca3d68bd13812851f43e9756a250f16278f5f5ab7d9fbd642b080a7849429b6.
+//
+// Solidity: event messageImported(uint64 FromChainID, bytes32 MessageHash, uint64 Index, bytes32 Leaf)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchMessageImported(opts *bind.WatchOpts, sink chan<- *CrossChainManagerMessageImported) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "messageImported")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerMessageImported)
+				if err := _CrossChainManager.contract.UnpackLog(event, "messageImported", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageImported is a log parse operation binding the contract event 0xaca3d68bd13812851f43e9756a250f16278f5f5ab7d9fbd642b080a7849429b6.
 //
-// Solidity: function WhiteChain(uint64 ChainID) returns(bool success)
-func (_CrossChainManager *CrossChainManagerTransactor) WhiteChain(opts *bind.TransactOpts, ChainID uint64) (*types.Transaction, error) {
-	return _CrossChainManager.contract.Transact(opts, "WhiteChain", ChainID)
+// Solidity: event messageImported(uint64 FromChainID, bytes32 MessageHash, uint64 Index, bytes32 Leaf)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseMessageImported(log types.Log) (*CrossChainManagerMessageImported, error) {
+	event := new(CrossChainManagerMessageImported)
+	if err := _CrossChainManager.contract.UnpackLog(event, "messageImported", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// WhiteChain is a paid mutator transaction binding the contract method 0x99d0e87a.
-//
-// Solidity: function WhiteChain(uint64 ChainID) returns(bool success)
-func (_CrossChainManager *CrossChainManagerSession) WhiteChain(ChainID uint64) (*types.Transaction, error) {
-	return _CrossChainManager.Contract.WhiteChain(&_CrossChainManager.TransactOpts, ChainID)
+// CrossChainManagerOutboundCallbackChangedIterator is returned from FilterOutboundCallbackChanged and is used to iterate over the raw logs and unpacked data for OutboundCallbackChanged events raised by the CrossChainManager contract.
+type CrossChainManagerOutboundCallbackChangedIterator struct {
+	Event *CrossChainManagerOutboundCallbackChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
 }
 
-// WhiteChain is a paid mutator transaction binding the contract method 0x99d0e87a.
-//
-// Solidity: function WhiteChain(uint64 ChainID) returns(bool success)
-func (_CrossChainManager *CrossChainManagerTransactorSession) WhiteChain(ChainID uint64) (*types.Transaction, error) {
-	return _CrossChainManager.Contract.WhiteChain(&_CrossChainManager.TransactOpts, ChainID)
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossChainManagerOutboundCallbackChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossChainManagerOutboundCallbackChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossChainManagerOutboundCallbackChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
 }
 
-// ImportOuterTransfer is a paid mutator transaction binding the contract method 0x5b60b01e.
-//
-// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
-func (_CrossChainManager *CrossChainManagerTransactor) ImportOuterTransfer(opts *bind.TransactOpts, SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
-	return _CrossChainManager.contract.Transact(opts, "importOuterTransfer", SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossChainManagerOutboundCallbackChangedIterator) Error() error {
+	return it.fail
 }
 
-// ImportOuterTransfer is a paid mutator transaction binding the contract method 0x5b60b01e.
-//
-// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
-func (_CrossChainManager *CrossChainManagerSession) ImportOuterTransfer(SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
-	return _CrossChainManager.Contract.ImportOuterTransfer(&_CrossChainManager.TransactOpts, SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossChainManagerOutboundCallbackChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// ImportOuterTransfer is a paid mutator transaction binding the contract method 0x5b60b01e.
-//
-// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes RelayerAddress, bytes Extra, bytes HeaderOrCrossChainMsg) returns(bool success)
-func (_CrossChainManager *CrossChainManagerTransactorSession) ImportOuterTransfer(SourceChainID uint64, Height uint32, Proof []byte, RelayerAddress []byte, Extra []byte, HeaderOrCrossChainMsg []byte) (*types.Transaction, error) {
-	return _CrossChainManager.Contract.ImportOuterTransfer(&_CrossChainManager.TransactOpts, SourceChainID, Height, Proof, RelayerAddress, Extra, HeaderOrCrossChainMsg)
+// CrossChainManagerOutboundCallbackChanged represents a OutboundCallbackChanged event raised by the CrossChainManager contract.
+type CrossChainManagerOutboundCallbackChanged struct {
+	Sender   common.Address
+	Callback common.Address
+	GasLimit uint64
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
+// FilterOutboundCallbackChanged is a free log retrieval operation binding the contract event 0x69e9ccde7b0a82110cbe4a5c56204bd2616adffa12b45bd933bb305a71d02a74.
 //
-// Solidity: function name() returns(string Name)
-func (_CrossChainManager *CrossChainManagerTransactor) Name(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _CrossChainManager.contract.Transact(opts, "name")
+// Solidity: event outboundCallbackChanged(address Sender, address Callback, uint64 GasLimit)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterOutboundCallbackChanged(opts *bind.FilterOpts) (*CrossChainManagerOutboundCallbackChangedIterator, error) {
+
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "outboundCallbackChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &CrossChainManagerOutboundCallbackChangedIterator{contract: _CrossChainManager.contract, event: "outboundCallbackChanged", logs: logs, sub: sub}, nil
 }
 
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
+// WatchOutboundCallbackChanged is a free log subscription operation binding the contract event 0x69e9ccde7b0a82110cbe4a5c56204bd2616adffa12b45bd933bb305a71d02a74.
 //
-// Solidity: function name() returns(string Name)
-func (_CrossChainManager *CrossChainManagerSession) Name() (*types.Transaction, error) {
-	return _CrossChainManager.Contract.Name(&_CrossChainManager.TransactOpts)
+// Solidity: event outboundCallbackChanged(address Sender, address Callback, uint64 GasLimit)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchOutboundCallbackChanged(opts *bind.WatchOpts, sink chan<- *CrossChainManagerOutboundCallbackChanged) (event.Subscription, error) {
+
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "outboundCallbackChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossChainManagerOutboundCallbackChanged)
+				if err := _CrossChainManager.contract.UnpackLog(event, "outboundCallbackChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
+// ParseOutboundCallbackChanged is a log parse operation binding the contract event 0x69e9ccde7b0a82110cbe4a5c56204bd2616adffa12b45bd933bb305a71d02a74.
 //
-// Solidity: function name() returns(string Name)
-func (_CrossChainManager *CrossChainManagerTransactorSession) Name() (*types.Transaction, error) {
-	return _CrossChainManager.Contract.Name(&_CrossChainManager.TransactOpts)
+// Solidity: event outboundCallbackChanged(address Sender, address Callback, uint64 GasLimit)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseOutboundCallbackChanged(log types.Log) (*CrossChainManagerOutboundCallbackChanged, error) {
+	event := new(CrossChainManagerOutboundCallbackChanged)
+	if err := _CrossChainManager.contract.UnpackLog(event, "outboundCallbackChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// CrossChainManagerBtcTxMultiSignEventIterator is returned from FilterBtcTxMultiSignEvent and is used to iterate over the raw logs and unpacked data for BtcTxMultiSignEvent events raised by the CrossChainManager contract.
-type CrossChainManagerBtcTxMultiSignEventIterator struct {
-	Event *CrossChainManagerBtcTxMultiSignEvent // Event containing the contract specifics and raw log
+// CrossChainManagerOutboundCallbackInvokedIterator is returned from FilterOutboundCallbackInvoked and is used to iterate over the raw logs and unpacked data for OutboundCallbackInvoked events raised by the CrossChainManager contract.
+type CrossChainManagerOutboundCallbackInvokedIterator struct {
+	Event *CrossChainManagerOutboundCallbackInvoked // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -330,7 +3494,7 @@ type CrossChainManagerBtcTxMultiSignEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *CrossChainManagerBtcTxMultiSignEventIterator) Next() bool {
+func (it *CrossChainManagerOutboundCallbackInvokedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -339,7 +3503,7 @@ func (it *CrossChainManagerBtcTxMultiSignEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(CrossChainManagerBtcTxMultiSignEvent)
+			it.Event = new(CrossChainManagerOutboundCallbackInvoked)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -354,7 +3518,7 @@ func (it *CrossChainManagerBtcTxMultiSignEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(CrossChainManagerBtcTxMultiSignEvent)
+		it.Event = new(CrossChainManagerOutboundCallbackInvoked)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -370,42 +3534,45 @@ func (it *CrossChainManagerBtcTxMultiSignEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *CrossChainManagerBtcTxMultiSignEventIterator) Error() error {
+func (it *CrossChainManagerOutboundCallbackInvokedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *CrossChainManagerBtcTxMultiSignEventIterator) Close() error {
+func (it *CrossChainManagerOutboundCallbackInvokedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// CrossChainManagerBtcTxMultiSignEvent represents a BtcTxMultiSignEvent event raised by the CrossChainManager contract.
-type CrossChainManagerBtcTxMultiSignEvent struct {
-	TxHash    []byte
-	MultiSign []byte
+// CrossChainManagerOutboundCallbackInvoked represents a OutboundCallbackInvoked event raised by the CrossChainManager contract.
+type CrossChainManagerOutboundCallbackInvoked struct {
+	Sender    common.Address
+	ToChainID uint64
+	Sequence  uint64
+	State     uint8
+	Success   bool
 	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterBtcTxMultiSignEvent is a free log retrieval operation binding the contract event 0x62fb550ff7fa48f759b0e56ea24757e77b5612d11efbcbdbed9545982cfe1770.
+// FilterOutboundCallbackInvoked is a free log retrieval operation binding the contract event 0x5f97b78412775cbabfd376663d3a9d642436193505804ccccaed12f4f8632054.
 //
-// Solidity: event btcTxMultiSignEvent(bytes TxHash, bytes MultiSign)
-func (_CrossChainManager *CrossChainManagerFilterer) FilterBtcTxMultiSignEvent(opts *bind.FilterOpts) (*CrossChainManagerBtcTxMultiSignEventIterator, error) {
+// Solidity: event outboundCallbackInvoked(address Sender, uint64 ToChainID, uint64 Sequence, uint8 State, bool Success)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterOutboundCallbackInvoked(opts *bind.FilterOpts) (*CrossChainManagerOutboundCallbackInvokedIterator, error) {
 
-	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "btcTxMultiSignEvent")
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "outboundCallbackInvoked")
 	if err != nil {
 		return nil, err
 	}
-	return &CrossChainManagerBtcTxMultiSignEventIterator{contract: _CrossChainManager.contract, event: "btcTxMultiSignEvent", logs: logs, sub: sub}, nil
+	return &CrossChainManagerOutboundCallbackInvokedIterator{contract: _CrossChainManager.contract, event: "outboundCallbackInvoked", logs: logs, sub: sub}, nil
 }
 
-// WatchBtcTxMultiSignEvent is a free log subscription operation binding the contract event 0x62fb550ff7fa48f759b0e56ea24757e77b5612d11efbcbdbed9545982cfe1770.
+// WatchOutboundCallbackInvoked is a free log subscription operation binding the contract event 0x5f97b78412775cbabfd376663d3a9d642436193505804ccccaed12f4f8632054.
 //
-// Solidity: event btcTxMultiSignEvent(bytes TxHash, bytes MultiSign)
-func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxMultiSignEvent(opts *bind.WatchOpts, sink chan<- *CrossChainManagerBtcTxMultiSignEvent) (event.Subscription, error) {
+// Solidity: event outboundCallbackInvoked(address Sender, uint64 ToChainID, uint64 Sequence, uint8 State, bool Success)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchOutboundCallbackInvoked(opts *bind.WatchOpts, sink chan<- *CrossChainManagerOutboundCallbackInvoked) (event.Subscription, error) {
 
-	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "btcTxMultiSignEvent")
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "outboundCallbackInvoked")
 	if err != nil {
 		return nil, err
 	}
@@ -415,8 +3582,8 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxMultiSignEvent(op
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(CrossChainManagerBtcTxMultiSignEvent)
-				if err := _CrossChainManager.contract.UnpackLog(event, "btcTxMultiSignEvent", log); err != nil {
+				event := new(CrossChainManagerOutboundCallbackInvoked)
+				if err := _CrossChainManager.contract.UnpackLog(event, "outboundCallbackInvoked", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -437,21 +3604,21 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxMultiSignEvent(op
 	}), nil
 }
 
-// ParseBtcTxMultiSignEvent is a log parse operation binding the contract event 0x62fb550ff7fa48f759b0e56ea24757e77b5612d11efbcbdbed9545982cfe1770.
+// ParseOutboundCallbackInvoked is a log parse operation binding the contract event 0x5f97b78412775cbabfd376663d3a9d642436193505804ccccaed12f4f8632054.
 //
-// Solidity: event btcTxMultiSignEvent(bytes TxHash, bytes MultiSign)
-func (_CrossChainManager *CrossChainManagerFilterer) ParseBtcTxMultiSignEvent(log types.Log) (*CrossChainManagerBtcTxMultiSignEvent, error) {
-	event := new(CrossChainManagerBtcTxMultiSignEvent)
-	if err := _CrossChainManager.contract.UnpackLog(event, "btcTxMultiSignEvent", log); err != nil {
+// Solidity: event outboundCallbackInvoked(address Sender, uint64 ToChainID, uint64 Sequence, uint8 State, bool Success)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseOutboundCallbackInvoked(log types.Log) (*CrossChainManagerOutboundCallbackInvoked, error) {
+	event := new(CrossChainManagerOutboundCallbackInvoked)
+	if err := _CrossChainManager.contract.UnpackLog(event, "outboundCallbackInvoked", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// CrossChainManagerBtcTxToRelayEventIterator is returned from FilterBtcTxToRelayEvent and is used to iterate over the raw logs and unpacked data for BtcTxToRelayEvent events raised by the CrossChainManager contract.
-type CrossChainManagerBtcTxToRelayEventIterator struct {
-	Event *CrossChainManagerBtcTxToRelayEvent // Event containing the contract specifics and raw log
+// CrossChainManagerOutboundRefundedIterator is returned from FilterOutboundRefunded and is used to iterate over the raw logs and unpacked data for OutboundRefunded events raised by the CrossChainManager contract.
+type CrossChainManagerOutboundRefundedIterator struct {
+	Event *CrossChainManagerOutboundRefunded // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -465,7 +3632,7 @@ type CrossChainManagerBtcTxToRelayEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *CrossChainManagerBtcTxToRelayEventIterator) Next() bool {
+func (it *CrossChainManagerOutboundRefundedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -474,7 +3641,7 @@ func (it *CrossChainManagerBtcTxToRelayEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(CrossChainManagerBtcTxToRelayEvent)
+			it.Event = new(CrossChainManagerOutboundRefunded)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -489,7 +3656,7 @@ func (it *CrossChainManagerBtcTxToRelayEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(CrossChainManagerBtcTxToRelayEvent)
+		it.Event = new(CrossChainManagerOutboundRefunded)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -505,45 +3672,42 @@ func (it *CrossChainManagerBtcTxToRelayEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *CrossChainManagerBtcTxToRelayEventIterator) Error() error {
+func (it *CrossChainManagerOutboundRefundedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *CrossChainManagerBtcTxToRelayEventIterator) Close() error {
+func (it *CrossChainManagerOutboundRefundedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// CrossChainManagerBtcTxToRelayEvent represents a BtcTxToRelayEvent event raised by the CrossChainManager contract.
-type CrossChainManagerBtcTxToRelayEvent struct {
-	FromChainID uint64
-	ChainID     uint64
-	Buf         string
-	FromTxHash  string
-	RedeemKey   string
-	Raw         types.Log // Blockchain specific contextual infos
+// CrossChainManagerOutboundRefunded represents a OutboundRefunded event raised by the CrossChainManager contract.
+type CrossChainManagerOutboundRefunded struct {
+	ToChainID uint64
+	Sequence  uint64
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterBtcTxToRelayEvent is a free log retrieval operation binding the contract event 0x59c070ab5215dda625f463061a0ad421505b2cca1066a8597411c72a5ecac51b.
+// FilterOutboundRefunded is a free log retrieval operation binding the contract event 0x6027fa687a99f0ecb54863ca391fe4c59a5a14acbbc6c018b824d3660b98dc60.
 //
-// Solidity: event btcTxToRelayEvent(uint64 FromChainID, uint64 ChainID, string buf, string FromTxHash, string RedeemKey)
-func (_CrossChainManager *CrossChainManagerFilterer) FilterBtcTxToRelayEvent(opts *bind.FilterOpts) (*CrossChainManagerBtcTxToRelayEventIterator, error) {
+// Solidity: event outboundRefunded(uint64 ToChainID, uint64 Sequence)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterOutboundRefunded(opts *bind.FilterOpts) (*CrossChainManagerOutboundRefundedIterator, error) {
 
-	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "btcTxToRelayEvent")
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "outboundRefunded")
 	if err != nil {
 		return nil, err
 	}
-	return &CrossChainManagerBtcTxToRelayEventIterator{contract: _CrossChainManager.contract, event: "btcTxToRelayEvent", logs: logs, sub: sub}, nil
+	return &CrossChainManagerOutboundRefundedIterator{contract: _CrossChainManager.contract, event: "outboundRefunded", logs: logs, sub: sub}, nil
 }
 
-// WatchBtcTxToRelayEvent is a free log subscription operation binding the contract event 0x59c070ab5215dda625f463061a0ad421505b2cca1066a8597411c72a5ecac51b.
+// WatchOutboundRefunded is a free log subscription operation binding the contract event 0x6027fa687a99f0ecb54863ca391fe4c59a5a14acbbc6c018b824d3660b98dc60.
 //
-// Solidity: event btcTxToRelayEvent(uint64 FromChainID, uint64 ChainID, string buf, string FromTxHash, string RedeemKey)
-func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxToRelayEvent(opts *bind.WatchOpts, sink chan<- *CrossChainManagerBtcTxToRelayEvent) (event.Subscription, error) {
+// Solidity: event outboundRefunded(uint64 ToChainID, uint64 Sequence)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchOutboundRefunded(opts *bind.WatchOpts, sink chan<- *CrossChainManagerOutboundRefunded) (event.Subscription, error) {
 
-	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "btcTxToRelayEvent")
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "outboundRefunded")
 	if err != nil {
 		return nil, err
 	}
@@ -553,8 +3717,8 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxToRelayEvent(opts
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(CrossChainManagerBtcTxToRelayEvent)
-				if err := _CrossChainManager.contract.UnpackLog(event, "btcTxToRelayEvent", log); err != nil {
+				event := new(CrossChainManagerOutboundRefunded)
+				if err := _CrossChainManager.contract.UnpackLog(event, "outboundRefunded", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -575,21 +3739,21 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchBtcTxToRelayEvent(opts
 	}), nil
 }
 
-// ParseBtcTxToRelayEvent is a log parse operation binding the contract event 0x59c070ab5215dda625f463061a0ad421505b2cca1066a8597411c72a5ecac51b.
+// ParseOutboundRefunded is a log parse operation binding the contract event 0x6027fa687a99f0ecb54863ca391fe4c59a5a14acbbc6c018b824d3660b98dc60.
 //
-// Solidity: event btcTxToRelayEvent(uint64 FromChainID, uint64 ChainID, string buf, string FromTxHash, string RedeemKey)
-func (_CrossChainManager *CrossChainManagerFilterer) ParseBtcTxToRelayEvent(log types.Log) (*CrossChainManagerBtcTxToRelayEvent, error) {
-	event := new(CrossChainManagerBtcTxToRelayEvent)
-	if err := _CrossChainManager.contract.UnpackLog(event, "btcTxToRelayEvent", log); err != nil {
+// Solidity: event outboundRefunded(uint64 ToChainID, uint64 Sequence)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseOutboundRefunded(log types.Log) (*CrossChainManagerOutboundRefunded, error) {
+	event := new(CrossChainManagerOutboundRefunded)
+	if err := _CrossChainManager.contract.UnpackLog(event, "outboundRefunded", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// CrossChainManagerMakeBtcTxEventIterator is returned from FilterMakeBtcTxEvent and is used to iterate over the raw logs and unpacked data for MakeBtcTxEvent events raised by the CrossChainManager contract.
-type CrossChainManagerMakeBtcTxEventIterator struct {
-	Event *CrossChainManagerMakeBtcTxEvent // Event containing the contract specifics and raw log
+// CrossChainManagerProofMaxAgeChangedIterator is returned from FilterProofMaxAgeChanged and is used to iterate over the raw logs and unpacked data for ProofMaxAgeChanged events raised by the CrossChainManager contract.
+type CrossChainManagerProofMaxAgeChangedIterator struct {
+	Event *CrossChainManagerProofMaxAgeChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -603,7 +3767,7 @@ type CrossChainManagerMakeBtcTxEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *CrossChainManagerMakeBtcTxEventIterator) Next() bool {
+func (it *CrossChainManagerProofMaxAgeChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -612,7 +3776,7 @@ func (it *CrossChainManagerMakeBtcTxEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(CrossChainManagerMakeBtcTxEvent)
+			it.Event = new(CrossChainManagerProofMaxAgeChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -627,7 +3791,7 @@ func (it *CrossChainManagerMakeBtcTxEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(CrossChainManagerMakeBtcTxEvent)
+		it.Event = new(CrossChainManagerProofMaxAgeChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -643,43 +3807,42 @@ func (it *CrossChainManagerMakeBtcTxEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *CrossChainManagerMakeBtcTxEventIterator) Error() error {
+func (it *CrossChainManagerProofMaxAgeChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *CrossChainManagerMakeBtcTxEventIterator) Close() error {
+func (it *CrossChainManagerProofMaxAgeChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// CrossChainManagerMakeBtcTxEvent represents a MakeBtcTxEvent event raised by the CrossChainManager contract.
-type CrossChainManagerMakeBtcTxEvent struct {
-	Rk   string
-	Buf  string
-	Amts []uint64
-	Raw  types.Log // Blockchain specific contextual infos
+// CrossChainManagerProofMaxAgeChanged represents a ProofMaxAgeChanged event raised by the CrossChainManager contract.
+type CrossChainManagerProofMaxAgeChanged struct {
+	ChainID uint64
+	MaxAge  uint64
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterMakeBtcTxEvent is a free log retrieval operation binding the contract event 0xa00d721fd040a2b479d1adf886244de04bdbb3e3e310dc75f1036e2602726234.
+// FilterProofMaxAgeChanged is a free log retrieval operation binding the contract event 0xd5bad61748d1eb30cad3e914827ac565a1ba26327e0e15c5ca85ac1ad5c09de7.
 //
-// Solidity: event makeBtcTxEvent(string rk, string buf, uint64[] amts)
-func (_CrossChainManager *CrossChainManagerFilterer) FilterMakeBtcTxEvent(opts *bind.FilterOpts) (*CrossChainManagerMakeBtcTxEventIterator, error) {
+// Solidity: event proofMaxAgeChanged(uint64 ChainID, uint64 MaxAge)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterProofMaxAgeChanged(opts *bind.FilterOpts) (*CrossChainManagerProofMaxAgeChangedIterator, error) {
 
-	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "makeBtcTxEvent")
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "proofMaxAgeChanged")
 	if err != nil {
 		return nil, err
 	}
-	return &CrossChainManagerMakeBtcTxEventIterator{contract: _CrossChainManager.contract, event: "makeBtcTxEvent", logs: logs, sub: sub}, nil
+	return &CrossChainManagerProofMaxAgeChangedIterator{contract: _CrossChainManager.contract, event: "proofMaxAgeChanged", logs: logs, sub: sub}, nil
 }
 
-// WatchMakeBtcTxEvent is a free log subscription operation binding the contract event 0xa00d721fd040a2b479d1adf886244de04bdbb3e3e310dc75f1036e2602726234.
+// WatchProofMaxAgeChanged is a free log subscription operation binding the contract event 0xd5bad61748d1eb30cad3e914827ac565a1ba26327e0e15c5ca85ac1ad5c09de7.
 //
-// Solidity: event makeBtcTxEvent(string rk, string buf, uint64[] amts)
-func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeBtcTxEvent(opts *bind.WatchOpts, sink chan<- *CrossChainManagerMakeBtcTxEvent) (event.Subscription, error) {
+// Solidity: event proofMaxAgeChanged(uint64 ChainID, uint64 MaxAge)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchProofMaxAgeChanged(opts *bind.WatchOpts, sink chan<- *CrossChainManagerProofMaxAgeChanged) (event.Subscription, error) {
 
-	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "makeBtcTxEvent")
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "proofMaxAgeChanged")
 	if err != nil {
 		return nil, err
 	}
@@ -689,8 +3852,8 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeBtcTxEvent(opts *b
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(CrossChainManagerMakeBtcTxEvent)
-				if err := _CrossChainManager.contract.UnpackLog(event, "makeBtcTxEvent", log); err != nil {
+				event := new(CrossChainManagerProofMaxAgeChanged)
+				if err := _CrossChainManager.contract.UnpackLog(event, "proofMaxAgeChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -711,21 +3874,21 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeBtcTxEvent(opts *b
 	}), nil
 }
 
-// ParseMakeBtcTxEvent is a log parse operation binding the contract event 0xa00d721fd040a2b479d1adf886244de04bdbb3e3e310dc75f1036e2602726234.
+// ParseProofMaxAgeChanged is a log parse operation binding the contract event 0xd5bad61748d1eb30cad3e914827ac565a1ba26327e0e15c5ca85ac1ad5c09de7.
 //
-// Solidity: event makeBtcTxEvent(string rk, string buf, uint64[] amts)
-func (_CrossChainManager *CrossChainManagerFilterer) ParseMakeBtcTxEvent(log types.Log) (*CrossChainManagerMakeBtcTxEvent, error) {
-	event := new(CrossChainManagerMakeBtcTxEvent)
-	if err := _CrossChainManager.contract.UnpackLog(event, "makeBtcTxEvent", log); err != nil {
+// Solidity: event proofMaxAgeChanged(uint64 ChainID, uint64 MaxAge)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseProofMaxAgeChanged(log types.Log) (*CrossChainManagerProofMaxAgeChanged, error) {
+	event := new(CrossChainManagerProofMaxAgeChanged)
+	if err := _CrossChainManager.contract.UnpackLog(event, "proofMaxAgeChanged", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// CrossChainManagerMakeProofIterator is returned from FilterMakeProof and is used to iterate over the raw logs and unpacked data for MakeProof events raised by the CrossChainManager contract.
-type CrossChainManagerMakeProofIterator struct {
-	Event *CrossChainManagerMakeProof // Event containing the contract specifics and raw log
+// CrossChainManagerStaleProofApprovedIterator is returned from FilterStaleProofApproved and is used to iterate over the raw logs and unpacked data for StaleProofApproved events raised by the CrossChainManager contract.
+type CrossChainManagerStaleProofApprovedIterator struct {
+	Event *CrossChainManagerStaleProofApproved // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -739,7 +3902,7 @@ type CrossChainManagerMakeProofIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *CrossChainManagerMakeProofIterator) Next() bool {
+func (it *CrossChainManagerStaleProofApprovedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -748,7 +3911,7 @@ func (it *CrossChainManagerMakeProofIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(CrossChainManagerMakeProof)
+			it.Event = new(CrossChainManagerStaleProofApproved)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -763,7 +3926,7 @@ func (it *CrossChainManagerMakeProofIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(CrossChainManagerMakeProof)
+		it.Event = new(CrossChainManagerStaleProofApproved)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -779,43 +3942,42 @@ func (it *CrossChainManagerMakeProofIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *CrossChainManagerMakeProofIterator) Error() error {
+func (it *CrossChainManagerStaleProofApprovedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *CrossChainManagerMakeProofIterator) Close() error {
+func (it *CrossChainManagerStaleProofApprovedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// CrossChainManagerMakeProof represents a MakeProof event raised by the CrossChainManager contract.
-type CrossChainManagerMakeProof struct {
-	MerkleValueHex string
-	BlockHeight    uint64
-	Key            string
-	Raw            types.Log // Blockchain specific contextual infos
+// CrossChainManagerStaleProofApproved represents a StaleProofApproved event raised by the CrossChainManager contract.
+type CrossChainManagerStaleProofApproved struct {
+	ChainID      uint64
+	CrossChainID []byte
+	Raw          types.Log // Blockchain specific contextual infos
 }
 
-// FilterMakeProof is a free log retrieval operation binding the contract event 0x25680d41ae78d1188140c6547c9b1890e26bbfa2e0c5b5f1d81aef8985f4d49d.
+// FilterStaleProofApproved is a free log retrieval operation binding the contract event 0x715dfa638736255a50da3545054fb16cf37c6d861e81b382048bf4ccff89a870.
 //
-// Solidity: event makeProof(string merkleValueHex, uint64 BlockHeight, string key)
-func (_CrossChainManager *CrossChainManagerFilterer) FilterMakeProof(opts *bind.FilterOpts) (*CrossChainManagerMakeProofIterator, error) {
+// Solidity: event staleProofApproved(uint64 ChainID, bytes CrossChainID)
+func (_CrossChainManager *CrossChainManagerFilterer) FilterStaleProofApproved(opts *bind.FilterOpts) (*CrossChainManagerStaleProofApprovedIterator, error) {
 
-	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "makeProof")
+	logs, sub, err := _CrossChainManager.contract.FilterLogs(opts, "staleProofApproved")
 	if err != nil {
 		return nil, err
 	}
-	return &CrossChainManagerMakeProofIterator{contract: _CrossChainManager.contract, event: "makeProof", logs: logs, sub: sub}, nil
+	return &CrossChainManagerStaleProofApprovedIterator{contract: _CrossChainManager.contract, event: "staleProofApproved", logs: logs, sub: sub}, nil
 }
 
-// WatchMakeProof is a free log subscription operation binding the contract event 0x25680d41ae78d1188140c6547c9b1890e26bbfa2e0c5b5f1d81aef8985f4d49d.
+// WatchStaleProofApproved is a free log subscription operation binding the contract event 0x715dfa638736255a50da3545054fb16cf37c6d861e81b382048bf4ccff89a870.
 //
-// Solidity: event makeProof(string merkleValueHex, uint64 BlockHeight, string key)
-func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeProof(opts *bind.WatchOpts, sink chan<- *CrossChainManagerMakeProof) (event.Subscription, error) {
+// Solidity: event staleProofApproved(uint64 ChainID, bytes CrossChainID)
+func (_CrossChainManager *CrossChainManagerFilterer) WatchStaleProofApproved(opts *bind.WatchOpts, sink chan<- *CrossChainManagerStaleProofApproved) (event.Subscription, error) {
 
-	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "makeProof")
+	logs, sub, err := _CrossChainManager.contract.WatchLogs(opts, "staleProofApproved")
 	if err != nil {
 		return nil, err
 	}
@@ -825,8 +3987,8 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeProof(opts *bind.W
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(CrossChainManagerMakeProof)
-				if err := _CrossChainManager.contract.UnpackLog(event, "makeProof", log); err != nil {
+				event := new(CrossChainManagerStaleProofApproved)
+				if err := _CrossChainManager.contract.UnpackLog(event, "staleProofApproved", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -847,12 +4009,12 @@ func (_CrossChainManager *CrossChainManagerFilterer) WatchMakeProof(opts *bind.W
 	}), nil
 }
 
-// ParseMakeProof is a log parse operation binding the contract event 0x25680d41ae78d1188140c6547c9b1890e26bbfa2e0c5b5f1d81aef8985f4d49d.
+// ParseStaleProofApproved is a log parse operation binding the contract event 0x715dfa638736255a50da3545054fb16cf37c6d861e81b382048bf4ccff89a870.
 //
-// Solidity: event makeProof(string merkleValueHex, uint64 BlockHeight, string key)
-func (_CrossChainManager *CrossChainManagerFilterer) ParseMakeProof(log types.Log) (*CrossChainManagerMakeProof, error) {
-	event := new(CrossChainManagerMakeProof)
-	if err := _CrossChainManager.contract.UnpackLog(event, "makeProof", log); err != nil {
+// Solidity: event staleProofApproved(uint64 ChainID, bytes CrossChainID)
+func (_CrossChainManager *CrossChainManagerFilterer) ParseStaleProofApproved(log types.Log) (*CrossChainManagerStaleProofApproved, error) {
+	event := new(CrossChainManagerStaleProofApproved)
+	if err := _CrossChainManager.contract.UnpackLog(event, "staleProofApproved", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -0,0 +1,661 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package data_oracle_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodData = "data"
+
+	MethodFeed = "feed"
+
+	MethodLatest = "latest"
+
+	MethodName = "name"
+
+	MethodSetFeed = "setFeed"
+
+	MethodSubmit = "submit"
+)
+
+// DataOracleABI is the input ABI used to generate the binding from.
+const DataOracleABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feed\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Enabled\",\"type\":\"bool\"},{\"internalType\":\"uint64\",\"name\":\"Latest\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"data\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Value\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"latest\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Value\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setFeed\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"Enabled\",\"type\":\"bool\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"submit\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Value\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"feedUpdated\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Enabled\",\"type\":\"bool\"}]},{\"type\":\"event\",\"name\":\"dataFinalized\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Value\",\"type\":\"bytes\"}]}]"
+
+// DataOracleFuncSigs maps the 4-byte function signature to its string representation.
+var DataOracleFuncSigs = map[string]string{
+	"8c79c48d": "data(string,uint64)",
+	"e73ade8e": "feed(string)",
+	"95305680": "latest(string)",
+	"06fdde03": "name()",
+	"bd9421c5": "setFeed(string,bool)",
+	"e6013fe0": "submit(string,uint64,bytes)",
+}
+
+// DataOracle is an auto generated Go binding around an Ethereum contract.
+type DataOracle struct {
+	DataOracleCaller     // Read-only binding to the contract
+	DataOracleTransactor // Write-only binding to the contract
+	DataOracleFilterer   // Log filterer for contract events
+}
+
+// DataOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DataOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DataOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DataOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DataOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DataOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DataOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DataOracleSession struct {
+	Contract     *DataOracle       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DataOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DataOracleCallerSession struct {
+	Contract *DataOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// DataOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DataOracleTransactorSession struct {
+	Contract     *DataOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// DataOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DataOracleRaw struct {
+	Contract *DataOracle // Generic contract binding to access the raw methods on
+}
+
+// DataOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DataOracleCallerRaw struct {
+	Contract *DataOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DataOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DataOracleTransactorRaw struct {
+	Contract *DataOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDataOracle creates a new instance of DataOracle, bound to a specific deployed contract.
+func NewDataOracle(address common.Address, backend bind.ContractBackend) (*DataOracle, error) {
+	contract, err := bindDataOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DataOracle{DataOracleCaller: DataOracleCaller{contract: contract}, DataOracleTransactor: DataOracleTransactor{contract: contract}, DataOracleFilterer: DataOracleFilterer{contract: contract}}, nil
+}
+
+// NewDataOracleCaller creates a new read-only instance of DataOracle, bound to a specific deployed contract.
+func NewDataOracleCaller(address common.Address, caller bind.ContractCaller) (*DataOracleCaller, error) {
+	contract, err := bindDataOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DataOracleCaller{contract: contract}, nil
+}
+
+// NewDataOracleTransactor creates a new write-only instance of DataOracle, bound to a specific deployed contract.
+func NewDataOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*DataOracleTransactor, error) {
+	contract, err := bindDataOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DataOracleTransactor{contract: contract}, nil
+}
+
+// NewDataOracleFilterer creates a new log filterer instance of DataOracle, bound to a specific deployed contract.
+func NewDataOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*DataOracleFilterer, error) {
+	contract, err := bindDataOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DataOracleFilterer{contract: contract}, nil
+}
+
+// bindDataOracle binds a generic wrapper to an already deployed contract.
+func bindDataOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DataOracleABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DataOracle *DataOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DataOracle.Contract.DataOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DataOracle *DataOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DataOracle.Contract.DataOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DataOracle *DataOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DataOracle.Contract.DataOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DataOracle *DataOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DataOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DataOracle *DataOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DataOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DataOracle *DataOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DataOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// Data is a free data retrieval call binding the contract method 0x8c79c48d.
+//
+// Solidity: function data(string FeedID, uint64 Height) view returns(bytes Value)
+func (_DataOracle *DataOracleCaller) Data(opts *bind.CallOpts, FeedID string, Height uint64) ([]byte, error) {
+	var out []interface{}
+	err := _DataOracle.contract.Call(opts, &out, "data", FeedID, Height)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Data is a free data retrieval call binding the contract method 0x8c79c48d.
+//
+// Solidity: function data(string FeedID, uint64 Height) view returns(bytes Value)
+func (_DataOracle *DataOracleSession) Data(FeedID string, Height uint64) ([]byte, error) {
+	return _DataOracle.Contract.Data(&_DataOracle.CallOpts, FeedID, Height)
+}
+
+// Data is a free data retrieval call binding the contract method 0x8c79c48d.
+//
+// Solidity: function data(string FeedID, uint64 Height) view returns(bytes Value)
+func (_DataOracle *DataOracleCallerSession) Data(FeedID string, Height uint64) ([]byte, error) {
+	return _DataOracle.Contract.Data(&_DataOracle.CallOpts, FeedID, Height)
+}
+
+// Feed is a free data retrieval call binding the contract method 0xe73ade8e.
+//
+// Solidity: function feed(string FeedID) view returns(bool Enabled, uint64 Latest)
+func (_DataOracle *DataOracleCaller) Feed(opts *bind.CallOpts, FeedID string) (struct {
+	Enabled bool
+	Latest  uint64
+}, error) {
+	var out []interface{}
+	err := _DataOracle.contract.Call(opts, &out, "feed", FeedID)
+
+	outstruct := new(struct {
+		Enabled bool
+		Latest  uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Latest = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// Feed is a free data retrieval call binding the contract method 0xe73ade8e.
+//
+// Solidity: function feed(string FeedID) view returns(bool Enabled, uint64 Latest)
+func (_DataOracle *DataOracleSession) Feed(FeedID string) (struct {
+	Enabled bool
+	Latest  uint64
+}, error) {
+	return _DataOracle.Contract.Feed(&_DataOracle.CallOpts, FeedID)
+}
+
+// Feed is a free data retrieval call binding the contract method 0xe73ade8e.
+//
+// Solidity: function feed(string FeedID) view returns(bool Enabled, uint64 Latest)
+func (_DataOracle *DataOracleCallerSession) Feed(FeedID string) (struct {
+	Enabled bool
+	Latest  uint64
+}, error) {
+	return _DataOracle.Contract.Feed(&_DataOracle.CallOpts, FeedID)
+}
+
+// Latest is a free data retrieval call binding the contract method 0x95305680.
+//
+// Solidity: function latest(string FeedID) view returns(uint64 Height, bytes Value)
+func (_DataOracle *DataOracleCaller) Latest(opts *bind.CallOpts, FeedID string) (struct {
+	Height uint64
+	Value  []byte
+}, error) {
+	var out []interface{}
+	err := _DataOracle.contract.Call(opts, &out, "latest", FeedID)
+
+	outstruct := new(struct {
+		Height uint64
+		Value  []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Height = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Value = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// Latest is a free data retrieval call binding the contract method 0x95305680.
+//
+// Solidity: function latest(string FeedID) view returns(uint64 Height, bytes Value)
+func (_DataOracle *DataOracleSession) Latest(FeedID string) (struct {
+	Height uint64
+	Value  []byte
+}, error) {
+	return _DataOracle.Contract.Latest(&_DataOracle.CallOpts, FeedID)
+}
+
+// Latest is a free data retrieval call binding the contract method 0x95305680.
+//
+// Solidity: function latest(string FeedID) view returns(uint64 Height, bytes Value)
+func (_DataOracle *DataOracleCallerSession) Latest(FeedID string) (struct {
+	Height uint64
+	Value  []byte
+}, error) {
+	return _DataOracle.Contract.Latest(&_DataOracle.CallOpts, FeedID)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_DataOracle *DataOracleCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _DataOracle.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_DataOracle *DataOracleSession) Name() (string, error) {
+	return _DataOracle.Contract.Name(&_DataOracle.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_DataOracle *DataOracleCallerSession) Name() (string, error) {
+	return _DataOracle.Contract.Name(&_DataOracle.CallOpts)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xbd9421c5.
+//
+// Solidity: function setFeed(string FeedID, bool Enabled) returns(bool Success)
+func (_DataOracle *DataOracleTransactor) SetFeed(opts *bind.TransactOpts, FeedID string, Enabled bool) (*types.Transaction, error) {
+	return _DataOracle.contract.Transact(opts, "setFeed", FeedID, Enabled)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xbd9421c5.
+//
+// Solidity: function setFeed(string FeedID, bool Enabled) returns(bool Success)
+func (_DataOracle *DataOracleSession) SetFeed(FeedID string, Enabled bool) (*types.Transaction, error) {
+	return _DataOracle.Contract.SetFeed(&_DataOracle.TransactOpts, FeedID, Enabled)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xbd9421c5.
+//
+// Solidity: function setFeed(string FeedID, bool Enabled) returns(bool Success)
+func (_DataOracle *DataOracleTransactorSession) SetFeed(FeedID string, Enabled bool) (*types.Transaction, error) {
+	return _DataOracle.Contract.SetFeed(&_DataOracle.TransactOpts, FeedID, Enabled)
+}
+
+// Submit is a paid mutator transaction binding the contract method 0xe6013fe0.
+//
+// Solidity: function submit(string FeedID, uint64 Height, bytes Value) returns(bool Success)
+func (_DataOracle *DataOracleTransactor) Submit(opts *bind.TransactOpts, FeedID string, Height uint64, Value []byte) (*types.Transaction, error) {
+	return _DataOracle.contract.Transact(opts, "submit", FeedID, Height, Value)
+}
+
+// Submit is a paid mutator transaction binding the contract method 0xe6013fe0.
+//
+// Solidity: function submit(string FeedID, uint64 Height, bytes Value) returns(bool Success)
+func (_DataOracle *DataOracleSession) Submit(FeedID string, Height uint64, Value []byte) (*types.Transaction, error) {
+	return _DataOracle.Contract.Submit(&_DataOracle.TransactOpts, FeedID, Height, Value)
+}
+
+// Submit is a paid mutator transaction binding the contract method 0xe6013fe0.
+//
+// Solidity: function submit(string FeedID, uint64 Height, bytes Value) returns(bool Success)
+func (_DataOracle *DataOracleTransactorSession) Submit(FeedID string, Height uint64, Value []byte) (*types.Transaction, error) {
+	return _DataOracle.Contract.Submit(&_DataOracle.TransactOpts, FeedID, Height, Value)
+}
+
+// DataOracleDataFinalizedIterator is returned from FilterDataFinalized and is used to iterate over the raw logs and unpacked data for DataFinalized events raised by the DataOracle contract.
+type DataOracleDataFinalizedIterator struct {
+	Event *DataOracleDataFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DataOracleDataFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DataOracleDataFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DataOracleDataFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DataOracleDataFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DataOracleDataFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DataOracleDataFinalized represents a DataFinalized event raised by the DataOracle contract.
+type DataOracleDataFinalized struct {
+	FeedID string
+	Height uint64
+	Value  []byte
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDataFinalized is a free log retrieval operation binding the contract event 0xa37c23aba399288b5222b62bc1c66cd6632596473b2449acc402502301ac382f.
+//
+// Solidity: event dataFinalized(string FeedID, uint64 Height, bytes Value)
+func (_DataOracle *DataOracleFilterer) FilterDataFinalized(opts *bind.FilterOpts) (*DataOracleDataFinalizedIterator, error) {
+
+	logs, sub, err := _DataOracle.contract.FilterLogs(opts, "dataFinalized")
+	if err != nil {
+		return nil, err
+	}
+	return &DataOracleDataFinalizedIterator{contract: _DataOracle.contract, event: "dataFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchDataFinalized is a free log subscription operation binding the contract event 0xa37c23aba399288b5222b62bc1c66cd6632596473b2449acc402502301ac382f.
+//
+// Solidity: event dataFinalized(string FeedID, uint64 Height, bytes Value)
+func (_DataOracle *DataOracleFilterer) WatchDataFinalized(opts *bind.WatchOpts, sink chan<- *DataOracleDataFinalized) (event.Subscription, error) {
+
+	logs, sub, err := _DataOracle.contract.WatchLogs(opts, "dataFinalized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DataOracleDataFinalized)
+				if err := _DataOracle.contract.UnpackLog(event, "dataFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDataFinalized is a log parse operation binding the contract event 0xa37c23aba399288b5222b62bc1c66cd6632596473b2449acc402502301ac382f.
+//
+// Solidity: event dataFinalized(string FeedID, uint64 Height, bytes Value)
+func (_DataOracle *DataOracleFilterer) ParseDataFinalized(log types.Log) (*DataOracleDataFinalized, error) {
+	event := new(DataOracleDataFinalized)
+	if err := _DataOracle.contract.UnpackLog(event, "dataFinalized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DataOracleFeedUpdatedIterator is returned from FilterFeedUpdated and is used to iterate over the raw logs and unpacked data for FeedUpdated events raised by the DataOracle contract.
+type DataOracleFeedUpdatedIterator struct {
+	Event *DataOracleFeedUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DataOracleFeedUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DataOracleFeedUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DataOracleFeedUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DataOracleFeedUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DataOracleFeedUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DataOracleFeedUpdated represents a FeedUpdated event raised by the DataOracle contract.
+type DataOracleFeedUpdated struct {
+	FeedID  string
+	Enabled bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeedUpdated is a free log retrieval operation binding the contract event 0x3f72c0618527b75eae2d3b06a6d12ff6399eb483a2da195e317fb1f30dbb5300.
+//
+// Solidity: event feedUpdated(string FeedID, bool Enabled)
+func (_DataOracle *DataOracleFilterer) FilterFeedUpdated(opts *bind.FilterOpts) (*DataOracleFeedUpdatedIterator, error) {
+
+	logs, sub, err := _DataOracle.contract.FilterLogs(opts, "feedUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &DataOracleFeedUpdatedIterator{contract: _DataOracle.contract, event: "feedUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFeedUpdated is a free log subscription operation binding the contract event 0x3f72c0618527b75eae2d3b06a6d12ff6399eb483a2da195e317fb1f30dbb5300.
+//
+// Solidity: event feedUpdated(string FeedID, bool Enabled)
+func (_DataOracle *DataOracleFilterer) WatchFeedUpdated(opts *bind.WatchOpts, sink chan<- *DataOracleFeedUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _DataOracle.contract.WatchLogs(opts, "feedUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DataOracleFeedUpdated)
+				if err := _DataOracle.contract.UnpackLog(event, "feedUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeedUpdated is a log parse operation binding the contract event 0x3f72c0618527b75eae2d3b06a6d12ff6399eb483a2da195e317fb1f30dbb5300.
+//
+// Solidity: event feedUpdated(string FeedID, bool Enabled)
+func (_DataOracle *DataOracleFilterer) ParseFeedUpdated(log types.Log) (*DataOracleFeedUpdated, error) {
+	event := new(DataOracleFeedUpdated)
+	if err := _DataOracle.contract.UnpackLog(event, "feedUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
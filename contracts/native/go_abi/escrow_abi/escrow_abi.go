@@ -0,0 +1,744 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package escrow_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodName = "name"
+
+	MethodSwap = "swap"
+
+	MethodLock = "lock"
+
+	MethodRefund = "refund"
+)
+
+// EscrowABI is the input ABI used to generate the binding from.
+const EscrowABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lock\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"HashLock\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"Timelock\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Recipient\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"SourceContract\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"refund\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"HashLock\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"swap\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"HashLock\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"Recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"Timelock\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"SourceContract\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"State\",\"type\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"locked\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"HashLock\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Timelock\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"released\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"HashLock\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"Preimage\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"refunded\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"HashLock\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]}]"
+
+// EscrowFuncSigs maps the 4-byte function signature to its string representation.
+var EscrowFuncSigs = map[string]string{
+	"3ee4da82": "lock(bytes32,uint64,address,uint64,bytes)",
+	"06fdde03": "name()",
+	"7249fbb6": "refund(bytes32)",
+	"76467cbd": "swap(bytes32)",
+}
+
+// Escrow is an auto generated Go binding around an Ethereum contract.
+type Escrow struct {
+	EscrowCaller     // Read-only binding to the contract
+	EscrowTransactor // Write-only binding to the contract
+	EscrowFilterer   // Log filterer for contract events
+}
+
+// EscrowCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EscrowCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EscrowTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EscrowFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EscrowSession struct {
+	Contract     *Escrow           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EscrowCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EscrowCallerSession struct {
+	Contract *EscrowCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// EscrowTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EscrowTransactorSession struct {
+	Contract     *EscrowTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EscrowRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EscrowRaw struct {
+	Contract *Escrow // Generic contract binding to access the raw methods on
+}
+
+// EscrowCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EscrowCallerRaw struct {
+	Contract *EscrowCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EscrowTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EscrowTransactorRaw struct {
+	Contract *EscrowTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEscrow creates a new instance of Escrow, bound to a specific deployed contract.
+func NewEscrow(address common.Address, backend bind.ContractBackend) (*Escrow, error) {
+	contract, err := bindEscrow(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Escrow{EscrowCaller: EscrowCaller{contract: contract}, EscrowTransactor: EscrowTransactor{contract: contract}, EscrowFilterer: EscrowFilterer{contract: contract}}, nil
+}
+
+// NewEscrowCaller creates a new read-only instance of Escrow, bound to a specific deployed contract.
+func NewEscrowCaller(address common.Address, caller bind.ContractCaller) (*EscrowCaller, error) {
+	contract, err := bindEscrow(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowCaller{contract: contract}, nil
+}
+
+// NewEscrowTransactor creates a new write-only instance of Escrow, bound to a specific deployed contract.
+func NewEscrowTransactor(address common.Address, transactor bind.ContractTransactor) (*EscrowTransactor, error) {
+	contract, err := bindEscrow(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowTransactor{contract: contract}, nil
+}
+
+// NewEscrowFilterer creates a new log filterer instance of Escrow, bound to a specific deployed contract.
+func NewEscrowFilterer(address common.Address, filterer bind.ContractFilterer) (*EscrowFilterer, error) {
+	contract, err := bindEscrow(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowFilterer{contract: contract}, nil
+}
+
+// bindEscrow binds a generic wrapper to an already deployed contract.
+func bindEscrow(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(EscrowABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Escrow *EscrowRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Escrow.Contract.EscrowCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Escrow *EscrowRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Escrow.Contract.EscrowTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Escrow *EscrowRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Escrow.Contract.EscrowTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Escrow *EscrowCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Escrow.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Escrow *EscrowTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Escrow.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Escrow *EscrowTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Escrow.Contract.contract.Transact(opts, method, params...)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Escrow *EscrowCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Escrow.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Escrow *EscrowSession) Name() (string, error) {
+	return _Escrow.Contract.Name(&_Escrow.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Escrow *EscrowCallerSession) Name() (string, error) {
+	return _Escrow.Contract.Name(&_Escrow.CallOpts)
+}
+
+// Swap is a free data retrieval call binding the contract method 0x76467cbd.
+//
+// Solidity: function swap(bytes32 HashLock) view returns(address Sender, address Recipient, uint256 Amount, uint64 Timelock, uint64 SourceChainID, bytes SourceContract, uint8 State)
+func (_Escrow *EscrowCaller) Swap(opts *bind.CallOpts, HashLock [32]byte) (struct {
+	Sender         common.Address
+	Recipient      common.Address
+	Amount         *big.Int
+	Timelock       uint64
+	SourceChainID  uint64
+	SourceContract []byte
+	State          uint8
+}, error) {
+	var out []interface{}
+	err := _Escrow.contract.Call(opts, &out, "swap", HashLock)
+
+	outstruct := new(struct {
+		Sender         common.Address
+		Recipient      common.Address
+		Amount         *big.Int
+		Timelock       uint64
+		SourceChainID  uint64
+		SourceContract []byte
+		State          uint8
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Sender = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Recipient = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Timelock = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+	outstruct.SourceChainID = *abi.ConvertType(out[4], new(uint64)).(*uint64)
+	outstruct.SourceContract = *abi.ConvertType(out[5], new([]byte)).(*[]byte)
+	outstruct.State = *abi.ConvertType(out[6], new(uint8)).(*uint8)
+
+	return *outstruct, err
+
+}
+
+// Swap is a free data retrieval call binding the contract method 0x76467cbd.
+//
+// Solidity: function swap(bytes32 HashLock) view returns(address Sender, address Recipient, uint256 Amount, uint64 Timelock, uint64 SourceChainID, bytes SourceContract, uint8 State)
+func (_Escrow *EscrowSession) Swap(HashLock [32]byte) (struct {
+	Sender         common.Address
+	Recipient      common.Address
+	Amount         *big.Int
+	Timelock       uint64
+	SourceChainID  uint64
+	SourceContract []byte
+	State          uint8
+}, error) {
+	return _Escrow.Contract.Swap(&_Escrow.CallOpts, HashLock)
+}
+
+// Swap is a free data retrieval call binding the contract method 0x76467cbd.
+//
+// Solidity: function swap(bytes32 HashLock) view returns(address Sender, address Recipient, uint256 Amount, uint64 Timelock, uint64 SourceChainID, bytes SourceContract, uint8 State)
+func (_Escrow *EscrowCallerSession) Swap(HashLock [32]byte) (struct {
+	Sender         common.Address
+	Recipient      common.Address
+	Amount         *big.Int
+	Timelock       uint64
+	SourceChainID  uint64
+	SourceContract []byte
+	State          uint8
+}, error) {
+	return _Escrow.Contract.Swap(&_Escrow.CallOpts, HashLock)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x3ee4da82.
+//
+// Solidity: function lock(bytes32 HashLock, uint64 Timelock, address Recipient, uint64 SourceChainID, bytes SourceContract) payable returns(bool Success)
+func (_Escrow *EscrowTransactor) Lock(opts *bind.TransactOpts, HashLock [32]byte, Timelock uint64, Recipient common.Address, SourceChainID uint64, SourceContract []byte) (*types.Transaction, error) {
+	return _Escrow.contract.Transact(opts, "lock", HashLock, Timelock, Recipient, SourceChainID, SourceContract)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x3ee4da82.
+//
+// Solidity: function lock(bytes32 HashLock, uint64 Timelock, address Recipient, uint64 SourceChainID, bytes SourceContract) payable returns(bool Success)
+func (_Escrow *EscrowSession) Lock(HashLock [32]byte, Timelock uint64, Recipient common.Address, SourceChainID uint64, SourceContract []byte) (*types.Transaction, error) {
+	return _Escrow.Contract.Lock(&_Escrow.TransactOpts, HashLock, Timelock, Recipient, SourceChainID, SourceContract)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x3ee4da82.
+//
+// Solidity: function lock(bytes32 HashLock, uint64 Timelock, address Recipient, uint64 SourceChainID, bytes SourceContract) payable returns(bool Success)
+func (_Escrow *EscrowTransactorSession) Lock(HashLock [32]byte, Timelock uint64, Recipient common.Address, SourceChainID uint64, SourceContract []byte) (*types.Transaction, error) {
+	return _Escrow.Contract.Lock(&_Escrow.TransactOpts, HashLock, Timelock, Recipient, SourceChainID, SourceContract)
+}
+
+// Refund is a paid mutator transaction binding the contract method 0x7249fbb6.
+//
+// Solidity: function refund(bytes32 HashLock) returns(bool Success)
+func (_Escrow *EscrowTransactor) Refund(opts *bind.TransactOpts, HashLock [32]byte) (*types.Transaction, error) {
+	return _Escrow.contract.Transact(opts, "refund", HashLock)
+}
+
+// Refund is a paid mutator transaction binding the contract method 0x7249fbb6.
+//
+// Solidity: function refund(bytes32 HashLock) returns(bool Success)
+func (_Escrow *EscrowSession) Refund(HashLock [32]byte) (*types.Transaction, error) {
+	return _Escrow.Contract.Refund(&_Escrow.TransactOpts, HashLock)
+}
+
+// Refund is a paid mutator transaction binding the contract method 0x7249fbb6.
+//
+// Solidity: function refund(bytes32 HashLock) returns(bool Success)
+func (_Escrow *EscrowTransactorSession) Refund(HashLock [32]byte) (*types.Transaction, error) {
+	return _Escrow.Contract.Refund(&_Escrow.TransactOpts, HashLock)
+}
+
+// EscrowLockedIterator is returned from FilterLocked and is used to iterate over the raw logs and unpacked data for Locked events raised by the Escrow contract.
+type EscrowLockedIterator struct {
+	Event *EscrowLocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EscrowLockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EscrowLocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EscrowLocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EscrowLockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EscrowLockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EscrowLocked represents a Locked event raised by the Escrow contract.
+type EscrowLocked struct {
+	HashLock  [32]byte
+	Sender    common.Address
+	Recipient common.Address
+	Amount    *big.Int
+	Timelock  uint64
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLocked is a free log retrieval operation binding the contract event 0x249daa3fdf3296938124bcff7f14bd42b1db384eb1c6339edd6084bb35e6970b.
+//
+// Solidity: event locked(bytes32 HashLock, address Sender, address Recipient, uint256 Amount, uint64 Timelock)
+func (_Escrow *EscrowFilterer) FilterLocked(opts *bind.FilterOpts) (*EscrowLockedIterator, error) {
+
+	logs, sub, err := _Escrow.contract.FilterLogs(opts, "locked")
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowLockedIterator{contract: _Escrow.contract, event: "locked", logs: logs, sub: sub}, nil
+}
+
+// WatchLocked is a free log subscription operation binding the contract event 0x249daa3fdf3296938124bcff7f14bd42b1db384eb1c6339edd6084bb35e6970b.
+//
+// Solidity: event locked(bytes32 HashLock, address Sender, address Recipient, uint256 Amount, uint64 Timelock)
+func (_Escrow *EscrowFilterer) WatchLocked(opts *bind.WatchOpts, sink chan<- *EscrowLocked) (event.Subscription, error) {
+
+	logs, sub, err := _Escrow.contract.WatchLogs(opts, "locked")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EscrowLocked)
+				if err := _Escrow.contract.UnpackLog(event, "locked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLocked is a log parse operation binding the contract event 0x249daa3fdf3296938124bcff7f14bd42b1db384eb1c6339edd6084bb35e6970b.
+//
+// Solidity: event locked(bytes32 HashLock, address Sender, address Recipient, uint256 Amount, uint64 Timelock)
+func (_Escrow *EscrowFilterer) ParseLocked(log types.Log) (*EscrowLocked, error) {
+	event := new(EscrowLocked)
+	if err := _Escrow.contract.UnpackLog(event, "locked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EscrowRefundedIterator is returned from FilterRefunded and is used to iterate over the raw logs and unpacked data for Refunded events raised by the Escrow contract.
+type EscrowRefundedIterator struct {
+	Event *EscrowRefunded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EscrowRefundedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EscrowRefunded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EscrowRefunded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EscrowRefundedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EscrowRefundedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EscrowRefunded represents a Refunded event raised by the Escrow contract.
+type EscrowRefunded struct {
+	HashLock [32]byte
+	Sender   common.Address
+	Amount   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRefunded is a free log retrieval operation binding the contract event 0x81f1cfe993bfd5893e69fa5fb437850f301944a7a3b26bafb794935b703ad556.
+//
+// Solidity: event refunded(bytes32 HashLock, address Sender, uint256 Amount)
+func (_Escrow *EscrowFilterer) FilterRefunded(opts *bind.FilterOpts) (*EscrowRefundedIterator, error) {
+
+	logs, sub, err := _Escrow.contract.FilterLogs(opts, "refunded")
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowRefundedIterator{contract: _Escrow.contract, event: "refunded", logs: logs, sub: sub}, nil
+}
+
+// WatchRefunded is a free log subscription operation binding the contract event 0x81f1cfe993bfd5893e69fa5fb437850f301944a7a3b26bafb794935b703ad556.
+//
+// Solidity: event refunded(bytes32 HashLock, address Sender, uint256 Amount)
+func (_Escrow *EscrowFilterer) WatchRefunded(opts *bind.WatchOpts, sink chan<- *EscrowRefunded) (event.Subscription, error) {
+
+	logs, sub, err := _Escrow.contract.WatchLogs(opts, "refunded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EscrowRefunded)
+				if err := _Escrow.contract.UnpackLog(event, "refunded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRefunded is a log parse operation binding the contract event 0x81f1cfe993bfd5893e69fa5fb437850f301944a7a3b26bafb794935b703ad556.
+//
+// Solidity: event refunded(bytes32 HashLock, address Sender, uint256 Amount)
+func (_Escrow *EscrowFilterer) ParseRefunded(log types.Log) (*EscrowRefunded, error) {
+	event := new(EscrowRefunded)
+	if err := _Escrow.contract.UnpackLog(event, "refunded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EscrowReleasedIterator is returned from FilterReleased and is used to iterate over the raw logs and unpacked data for Released events raised by the Escrow contract.
+type EscrowReleasedIterator struct {
+	Event *EscrowReleased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EscrowReleasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EscrowReleased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EscrowReleased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EscrowReleasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EscrowReleasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EscrowReleased represents a Released event raised by the Escrow contract.
+type EscrowReleased struct {
+	HashLock  [32]byte
+	Preimage  [32]byte
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterReleased is a free log retrieval operation binding the contract event 0x9dad46f003c52c89951812cdd837a22c8d20b15a81500eba4323b5b552292dd8.
+//
+// Solidity: event released(bytes32 HashLock, bytes32 Preimage, address Recipient, uint256 Amount)
+func (_Escrow *EscrowFilterer) FilterReleased(opts *bind.FilterOpts) (*EscrowReleasedIterator, error) {
+
+	logs, sub, err := _Escrow.contract.FilterLogs(opts, "released")
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowReleasedIterator{contract: _Escrow.contract, event: "released", logs: logs, sub: sub}, nil
+}
+
+// WatchReleased is a free log subscription operation binding the contract event 0x9dad46f003c52c89951812cdd837a22c8d20b15a81500eba4323b5b552292dd8.
+//
+// Solidity: event released(bytes32 HashLock, bytes32 Preimage, address Recipient, uint256 Amount)
+func (_Escrow *EscrowFilterer) WatchReleased(opts *bind.WatchOpts, sink chan<- *EscrowReleased) (event.Subscription, error) {
+
+	logs, sub, err := _Escrow.contract.WatchLogs(opts, "released")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EscrowReleased)
+				if err := _Escrow.contract.UnpackLog(event, "released", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReleased is a log parse operation binding the contract event 0x9dad46f003c52c89951812cdd837a22c8d20b15a81500eba4323b5b552292dd8.
+//
+// Solidity: event released(bytes32 HashLock, bytes32 Preimage, address Recipient, uint256 Amount)
+func (_Escrow *EscrowFilterer) ParseReleased(log types.Log) (*EscrowReleased, error) {
+	event := new(EscrowReleased)
+	if err := _Escrow.contract.UnpackLog(event, "released", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,449 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package governance_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodAddValidator = "addValidator"
+
+	MethodEpoch = "epoch"
+
+	MethodName = "name"
+
+	MethodValidators = "validators"
+)
+
+// GovernanceABI is the input ABI used to generate the binding from.
+const GovernanceABI = "[{\"type\":\"function\",\"constant\":true,\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"_name\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\"},{\"type\":\"function\",\"constant\":true,\"name\":\"epoch\",\"inputs\":[],\"outputs\":[{\"name\":\"_epoch\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\"},{\"type\":\"function\",\"constant\":true,\"name\":\"addValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"succeed\",\"type\":\"bool\"}]},{\"type\":\"function\",\"constant\":true,\"name\":\"validators\",\"inputs\":[],\"outputs\":[{\"name\":\"list\",\"type\":\"address[]\"}]},{\"type\":\"event\",\"anonymous\":false,\"name\":\"addValidator\",\"inputs\":[{\"indexed\":false,\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"succeed\",\"type\":\"bool\"}]}]"
+
+// GovernanceFuncSigs maps the 4-byte function signature to its string representation.
+var GovernanceFuncSigs = map[string]string{
+	"4d238c8e": "addValidator(address)",
+	"900cf0cf": "epoch()",
+	"06fdde03": "name()",
+	"ca1e7819": "validators()",
+}
+
+// Governance is an auto generated Go binding around an Ethereum contract.
+type Governance struct {
+	GovernanceCaller     // Read-only binding to the contract
+	GovernanceTransactor // Write-only binding to the contract
+	GovernanceFilterer   // Log filterer for contract events
+}
+
+// GovernanceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovernanceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovernanceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovernanceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovernanceSession struct {
+	Contract     *Governance       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovernanceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovernanceCallerSession struct {
+	Contract *GovernanceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// GovernanceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovernanceTransactorSession struct {
+	Contract     *GovernanceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// GovernanceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovernanceRaw struct {
+	Contract *Governance // Generic contract binding to access the raw methods on
+}
+
+// GovernanceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovernanceCallerRaw struct {
+	Contract *GovernanceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovernanceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovernanceTransactorRaw struct {
+	Contract *GovernanceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGovernance creates a new instance of Governance, bound to a specific deployed contract.
+func NewGovernance(address common.Address, backend bind.ContractBackend) (*Governance, error) {
+	contract, err := bindGovernance(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Governance{GovernanceCaller: GovernanceCaller{contract: contract}, GovernanceTransactor: GovernanceTransactor{contract: contract}, GovernanceFilterer: GovernanceFilterer{contract: contract}}, nil
+}
+
+// NewGovernanceCaller creates a new read-only instance of Governance, bound to a specific deployed contract.
+func NewGovernanceCaller(address common.Address, caller bind.ContractCaller) (*GovernanceCaller, error) {
+	contract, err := bindGovernance(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceCaller{contract: contract}, nil
+}
+
+// NewGovernanceTransactor creates a new write-only instance of Governance, bound to a specific deployed contract.
+func NewGovernanceTransactor(address common.Address, transactor bind.ContractTransactor) (*GovernanceTransactor, error) {
+	contract, err := bindGovernance(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceTransactor{contract: contract}, nil
+}
+
+// NewGovernanceFilterer creates a new log filterer instance of Governance, bound to a specific deployed contract.
+func NewGovernanceFilterer(address common.Address, filterer bind.ContractFilterer) (*GovernanceFilterer, error) {
+	contract, err := bindGovernance(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceFilterer{contract: contract}, nil
+}
+
+// bindGovernance binds a generic wrapper to an already deployed contract.
+func bindGovernance(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovernanceABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Governance *GovernanceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Governance.Contract.GovernanceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Governance *GovernanceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Governance.Contract.GovernanceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Governance *GovernanceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Governance.Contract.GovernanceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Governance *GovernanceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Governance.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Governance *GovernanceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Governance.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Governance *GovernanceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Governance.Contract.contract.Transact(opts, method, params...)
+}
+
+// AddValidator is a free data retrieval call binding the contract method 0x4d238c8e.
+//
+// Solidity: function addValidator(address validator) returns(bool succeed)
+func (_Governance *GovernanceCaller) AddValidator(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "addValidator", validator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AddValidator is a free data retrieval call binding the contract method 0x4d238c8e.
+//
+// Solidity: function addValidator(address validator) returns(bool succeed)
+func (_Governance *GovernanceSession) AddValidator(validator common.Address) (bool, error) {
+	return _Governance.Contract.AddValidator(&_Governance.CallOpts, validator)
+}
+
+// AddValidator is a free data retrieval call binding the contract method 0x4d238c8e.
+//
+// Solidity: function addValidator(address validator) returns(bool succeed)
+func (_Governance *GovernanceCallerSession) AddValidator(validator common.Address) (bool, error) {
+	return _Governance.Contract.AddValidator(&_Governance.CallOpts, validator)
+}
+
+// Epoch is a free data retrieval call binding the contract method 0x900cf0cf.
+//
+// Solidity: function epoch() view returns(uint256 _epoch)
+func (_Governance *GovernanceCaller) Epoch(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "epoch")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Epoch is a free data retrieval call binding the contract method 0x900cf0cf.
+//
+// Solidity: function epoch() view returns(uint256 _epoch)
+func (_Governance *GovernanceSession) Epoch() (*big.Int, error) {
+	return _Governance.Contract.Epoch(&_Governance.CallOpts)
+}
+
+// Epoch is a free data retrieval call binding the contract method 0x900cf0cf.
+//
+// Solidity: function epoch() view returns(uint256 _epoch)
+func (_Governance *GovernanceCallerSession) Epoch() (*big.Int, error) {
+	return _Governance.Contract.Epoch(&_Governance.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string _name)
+func (_Governance *GovernanceCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string _name)
+func (_Governance *GovernanceSession) Name() (string, error) {
+	return _Governance.Contract.Name(&_Governance.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string _name)
+func (_Governance *GovernanceCallerSession) Name() (string, error) {
+	return _Governance.Contract.Name(&_Governance.CallOpts)
+}
+
+// Validators is a free data retrieval call binding the contract method 0xca1e7819.
+//
+// Solidity: function validators() returns(address[] list)
+func (_Governance *GovernanceCaller) Validators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "validators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// Validators is a free data retrieval call binding the contract method 0xca1e7819.
+//
+// Solidity: function validators() returns(address[] list)
+func (_Governance *GovernanceSession) Validators() ([]common.Address, error) {
+	return _Governance.Contract.Validators(&_Governance.CallOpts)
+}
+
+// Validators is a free data retrieval call binding the contract method 0xca1e7819.
+//
+// Solidity: function validators() returns(address[] list)
+func (_Governance *GovernanceCallerSession) Validators() ([]common.Address, error) {
+	return _Governance.Contract.Validators(&_Governance.CallOpts)
+}
+
+// GovernanceAddValidatorIterator is returned from FilterAddValidator and is used to iterate over the raw logs and unpacked data for AddValidator events raised by the Governance contract.
+type GovernanceAddValidatorIterator struct {
+	Event *GovernanceAddValidator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceAddValidatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceAddValidator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceAddValidator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceAddValidatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceAddValidatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceAddValidator represents a AddValidator event raised by the Governance contract.
+type GovernanceAddValidator struct {
+	Validator common.Address
+	Succeed   bool
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddValidator is a free log retrieval operation binding the contract event 0x21a3fb852da872148235dd8fd66a76e47bbaf2051ca78c7d2583996482766b32.
+//
+// Solidity: event addValidator(address validator, bool succeed)
+func (_Governance *GovernanceFilterer) FilterAddValidator(opts *bind.FilterOpts) (*GovernanceAddValidatorIterator, error) {
+
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "addValidator")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceAddValidatorIterator{contract: _Governance.contract, event: "addValidator", logs: logs, sub: sub}, nil
+}
+
+// WatchAddValidator is a free log subscription operation binding the contract event 0x21a3fb852da872148235dd8fd66a76e47bbaf2051ca78c7d2583996482766b32.
+//
+// Solidity: event addValidator(address validator, bool succeed)
+func (_Governance *GovernanceFilterer) WatchAddValidator(opts *bind.WatchOpts, sink chan<- *GovernanceAddValidator) (event.Subscription, error) {
+
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "addValidator")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceAddValidator)
+				if err := _Governance.contract.UnpackLog(event, "addValidator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddValidator is a log parse operation binding the contract event 0x21a3fb852da872148235dd8fd66a76e47bbaf2051ca78c7d2583996482766b32.
+//
+// Solidity: event addValidator(address validator, bool succeed)
+func (_Governance *GovernanceFilterer) ParseAddValidator(log types.Log) (*GovernanceAddValidator, error) {
+	event := new(GovernanceAddValidator)
+	if err := _Governance.contract.UnpackLog(event, "addValidator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -27,8 +27,12 @@ var (
 )
 
 var (
+	MethodLightClientArchive = "lightClientArchive"
+
 	MethodName = "name"
 
+	MethodRebootstrapHeader = "rebootstrapHeader"
+
 	MethodSyncBlockHeader = "syncBlockHeader"
 
 	MethodSyncCrossChainMsg = "syncCrossChainMsg"
@@ -37,33 +41,18 @@ var (
 )
 
 // HeaderSyncABI is the input ABI used to generate the binding from.
-const HeaderSyncABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"BlockHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"NextValidatorsHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"InfoChainID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"}],\"name\":\"OKEpochSwitchInfoEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"blockHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"BlockHeight\",\"type\":\"uint256\"}],\"name\":\"syncHeader\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"bytes[]\",\"name\":\"Headers\",\"type\":\"bytes[]\"}],\"name\":\"syncBlockHeader\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"bytes[]\",\"name\":\"CrossChainMsgs\",\"type\":\"bytes[]\"}],\"name\":\"syncCrossChainMsg\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"GenesisHeader\",\"type\":\"bytes\"}],\"name\":\"syncGenesisHeader\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const HeaderSyncABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"BlockHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"NextValidatorsHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"InfoChainID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"}],\"name\":\"OKEpochSwitchInfoEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Generation\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"BlockHeight\",\"type\":\"uint256\"}],\"name\":\"RebootstrapHeaderEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"blockHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"BlockHeight\",\"type\":\"uint256\"}],\"name\":\"syncHeader\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Generation\",\"type\":\"uint64\"}],\"name\":\"lightClientArchive\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Header\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Previous\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"bytes[]\",\"name\":\"Headers\",\"type\":\"bytes[]\"}],\"name\":\"syncBlockHeader\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"bytes[]\",\"name\":\"CrossChainMsgs\",\"type\":\"bytes[]\"}],\"name\":\"syncCrossChainMsg\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Header\",\"type\":\"bytes\"}],\"name\":\"rebootstrapHeader\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"GenesisHeader\",\"type\":\"bytes\"}],\"name\":\"syncGenesisHeader\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // HeaderSyncFuncSigs maps the 4-byte function signature to its string representation.
 var HeaderSyncFuncSigs = map[string]string{
+	"4e5703d8": "lightClientArchive(uint64,uint64)",
 	"06fdde03": "name()",
+	"adb46f23": "rebootstrapHeader(uint64,bytes)",
 	"72ce6700": "syncBlockHeader(uint64,address,bytes[])",
 	"21b5cff5": "syncCrossChainMsg(uint64,address,bytes[])",
 	"b5ace618": "syncGenesisHeader(uint64,bytes)",
 }
 
-// HeaderSyncBin is the compiled bytecode used for deploying new contracts.
-var HeaderSyncBin = "0x608060405234801561001057600080fd5b5061034b806100206000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c806306fdde031461005157806321b5cff51461006957806372ce670014610069578063b5ace61814610090575b600080fd5b60606040516100609190610279565b60405180910390f35b610080610077366004610133565b60009392505050565b6040519015158152602001610060565b61008061009e36600461022b565b600092915050565b600082601f8301126100b757600080fd5b813567ffffffffffffffff8111156100d1576100d16102ff565b6100e4601f8201601f19166020016102ce565b8181528460208386010111156100f957600080fd5b816020850160208301376000918101602001919091529392505050565b803567ffffffffffffffff8116811461012e57600080fd5b919050565b60008060006060848603121561014857600080fd5b61015184610116565b92506020848101356001600160a01b038116811461016e57600080fd5b9250604085013567ffffffffffffffff8082111561018b57600080fd5b818701915087601f83011261019f57600080fd5b8135818111156101b1576101b16102ff565b8060051b6101c08582016102ce565b8281528581019085870183870188018d10156101db57600080fd5b60009350835b85811015610218578135878111156101f7578586fd5b6102058f8b838c01016100a6565b85525092880192908801906001016101e1565b5050809750505050505050509250925092565b6000806040838503121561023e57600080fd5b61024783610116565b9150602083013567ffffffffffffffff81111561026357600080fd5b61026f858286016100a6565b9150509250929050565b600060208083528351808285015260005b818110156102a65785810183015185820160400152820161028a565b818111156102b8576000604083870101525b50601f01601f1916929092016040019392505050565b604051601f8201601f1916810167ffffffffffffffff811182821017156102f7576102f76102ff565b604052919050565b634e487b7160e01b600052604160045260246000fdfea26469706673582212204231d0ee59fc1838c92d148dc40fade4e810e808d3b6d562c1891ea89e6b013a64736f6c63430008060033"
-
-// DeployHeaderSync deploys a new Ethereum contract, binding an instance of HeaderSync to it.
-func DeployHeaderSync(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *HeaderSync, error) {
-	parsed, err := abi.JSON(strings.NewReader(HeaderSyncABI))
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(HeaderSyncBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &HeaderSync{HeaderSyncCaller: HeaderSyncCaller{contract: contract}, HeaderSyncTransactor: HeaderSyncTransactor{contract: contract}, HeaderSyncFilterer: HeaderSyncFilterer{contract: contract}}, nil
-}
-
 // HeaderSync is an auto generated Go binding around an Ethereum contract.
 type HeaderSync struct {
 	HeaderSyncCaller     // Read-only binding to the contract
@@ -206,6 +195,56 @@ func (_HeaderSync *HeaderSyncTransactorRaw) Transact(opts *bind.TransactOpts, me
 	return _HeaderSync.Contract.contract.Transact(opts, method, params...)
 }
 
+// LightClientArchive is a free data retrieval call binding the contract method 0x4e5703d8.
+//
+// Solidity: function lightClientArchive(uint64 ChainID, uint64 Generation) view returns(uint64 Height, bytes Header, bytes Previous)
+func (_HeaderSync *HeaderSyncCaller) LightClientArchive(opts *bind.CallOpts, ChainID uint64, Generation uint64) (struct {
+	Height   uint64
+	Header   []byte
+	Previous []byte
+}, error) {
+	var out []interface{}
+	err := _HeaderSync.contract.Call(opts, &out, "lightClientArchive", ChainID, Generation)
+
+	outstruct := new(struct {
+		Height   uint64
+		Header   []byte
+		Previous []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Height = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Header = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.Previous = *abi.ConvertType(out[2], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// LightClientArchive is a free data retrieval call binding the contract method 0x4e5703d8.
+//
+// Solidity: function lightClientArchive(uint64 ChainID, uint64 Generation) view returns(uint64 Height, bytes Header, bytes Previous)
+func (_HeaderSync *HeaderSyncSession) LightClientArchive(ChainID uint64, Generation uint64) (struct {
+	Height   uint64
+	Header   []byte
+	Previous []byte
+}, error) {
+	return _HeaderSync.Contract.LightClientArchive(&_HeaderSync.CallOpts, ChainID, Generation)
+}
+
+// LightClientArchive is a free data retrieval call binding the contract method 0x4e5703d8.
+//
+// Solidity: function lightClientArchive(uint64 ChainID, uint64 Generation) view returns(uint64 Height, bytes Header, bytes Previous)
+func (_HeaderSync *HeaderSyncCallerSession) LightClientArchive(ChainID uint64, Generation uint64) (struct {
+	Height   uint64
+	Header   []byte
+	Previous []byte
+}, error) {
+	return _HeaderSync.Contract.LightClientArchive(&_HeaderSync.CallOpts, ChainID, Generation)
+}
+
 // Name is a paid mutator transaction binding the contract method 0x06fdde03.
 //
 // Solidity: function name() returns(string Name)
@@ -227,6 +266,27 @@ func (_HeaderSync *HeaderSyncTransactorSession) Name() (*types.Transaction, erro
 	return _HeaderSync.Contract.Name(&_HeaderSync.TransactOpts)
 }
 
+// RebootstrapHeader is a paid mutator transaction binding the contract method 0xadb46f23.
+//
+// Solidity: function rebootstrapHeader(uint64 ChainID, bytes Header) returns(bool success)
+func (_HeaderSync *HeaderSyncTransactor) RebootstrapHeader(opts *bind.TransactOpts, ChainID uint64, Header []byte) (*types.Transaction, error) {
+	return _HeaderSync.contract.Transact(opts, "rebootstrapHeader", ChainID, Header)
+}
+
+// RebootstrapHeader is a paid mutator transaction binding the contract method 0xadb46f23.
+//
+// Solidity: function rebootstrapHeader(uint64 ChainID, bytes Header) returns(bool success)
+func (_HeaderSync *HeaderSyncSession) RebootstrapHeader(ChainID uint64, Header []byte) (*types.Transaction, error) {
+	return _HeaderSync.Contract.RebootstrapHeader(&_HeaderSync.TransactOpts, ChainID, Header)
+}
+
+// RebootstrapHeader is a paid mutator transaction binding the contract method 0xadb46f23.
+//
+// Solidity: function rebootstrapHeader(uint64 ChainID, bytes Header) returns(bool success)
+func (_HeaderSync *HeaderSyncTransactorSession) RebootstrapHeader(ChainID uint64, Header []byte) (*types.Transaction, error) {
+	return _HeaderSync.Contract.RebootstrapHeader(&_HeaderSync.TransactOpts, ChainID, Header)
+}
+
 // SyncBlockHeader is a paid mutator transaction binding the contract method 0x72ce6700.
 //
 // Solidity: function syncBlockHeader(uint64 ChainID, address Address, bytes[] Headers) returns(bool success)
@@ -429,6 +489,142 @@ func (_HeaderSync *HeaderSyncFilterer) ParseOKEpochSwitchInfoEvent(log types.Log
 	return event, nil
 }
 
+// HeaderSyncRebootstrapHeaderEventIterator is returned from FilterRebootstrapHeaderEvent and is used to iterate over the raw logs and unpacked data for RebootstrapHeaderEvent events raised by the HeaderSync contract.
+type HeaderSyncRebootstrapHeaderEventIterator struct {
+	Event *HeaderSyncRebootstrapHeaderEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *HeaderSyncRebootstrapHeaderEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(HeaderSyncRebootstrapHeaderEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(HeaderSyncRebootstrapHeaderEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *HeaderSyncRebootstrapHeaderEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *HeaderSyncRebootstrapHeaderEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// HeaderSyncRebootstrapHeaderEvent represents a RebootstrapHeaderEvent event raised by the HeaderSync contract.
+type HeaderSyncRebootstrapHeaderEvent struct {
+	ChainID     uint64
+	Generation  uint64
+	BlockHeight *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterRebootstrapHeaderEvent is a free log retrieval operation binding the contract event 0x1374a1fcb08c69a78fb5cae2b3addc90f4318c123d3cc5cfff8034ec0c25ccb1.
+//
+// Solidity: event RebootstrapHeaderEvent(uint64 ChainID, uint64 Generation, uint256 BlockHeight)
+func (_HeaderSync *HeaderSyncFilterer) FilterRebootstrapHeaderEvent(opts *bind.FilterOpts) (*HeaderSyncRebootstrapHeaderEventIterator, error) {
+
+	logs, sub, err := _HeaderSync.contract.FilterLogs(opts, "RebootstrapHeaderEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &HeaderSyncRebootstrapHeaderEventIterator{contract: _HeaderSync.contract, event: "RebootstrapHeaderEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchRebootstrapHeaderEvent is a free log subscription operation binding the contract event 0x1374a1fcb08c69a78fb5cae2b3addc90f4318c123d3cc5cfff8034ec0c25ccb1.
+//
+// Solidity: event RebootstrapHeaderEvent(uint64 ChainID, uint64 Generation, uint256 BlockHeight)
+func (_HeaderSync *HeaderSyncFilterer) WatchRebootstrapHeaderEvent(opts *bind.WatchOpts, sink chan<- *HeaderSyncRebootstrapHeaderEvent) (event.Subscription, error) {
+
+	logs, sub, err := _HeaderSync.contract.WatchLogs(opts, "RebootstrapHeaderEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(HeaderSyncRebootstrapHeaderEvent)
+				if err := _HeaderSync.contract.UnpackLog(event, "RebootstrapHeaderEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRebootstrapHeaderEvent is a log parse operation binding the contract event 0x1374a1fcb08c69a78fb5cae2b3addc90f4318c123d3cc5cfff8034ec0c25ccb1.
+//
+// Solidity: event RebootstrapHeaderEvent(uint64 ChainID, uint64 Generation, uint256 BlockHeight)
+func (_HeaderSync *HeaderSyncFilterer) ParseRebootstrapHeaderEvent(log types.Log) (*HeaderSyncRebootstrapHeaderEvent, error) {
+	event := new(HeaderSyncRebootstrapHeaderEvent)
+	if err := _HeaderSync.contract.UnpackLog(event, "RebootstrapHeaderEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // HeaderSyncSyncHeaderIterator is returned from FilterSyncHeader and is used to iterate over the raw logs and unpacked data for SyncHeader events raised by the HeaderSync contract.
 type HeaderSyncSyncHeaderIterator struct {
 	Event *HeaderSyncSyncHeader // Event containing the contract specifics and raw log
@@ -0,0 +1,564 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maintenance_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodIsDisabled = "isDisabled"
+
+	MethodName = "name"
+
+	MethodDisableMethod = "disableMethod"
+
+	MethodEnableMethod = "enableMethod"
+)
+
+// MaintenanceABI is the input ABI used to generate the binding from.
+const MaintenanceABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"disableMethod\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"Method\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"enableMethod\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"Method\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isDisabled\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"Method\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"methodDisabled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Method\",\"type\":\"string\"}]},{\"type\":\"event\",\"name\":\"methodEnabled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Method\",\"type\":\"string\"}]}]"
+
+// MaintenanceFuncSigs maps the 4-byte function signature to its string representation.
+var MaintenanceFuncSigs = map[string]string{
+	"a5c18ac8": "disableMethod(address,string)",
+	"7a063069": "enableMethod(address,string)",
+	"a686b7b9": "isDisabled(address,string)",
+	"06fdde03": "name()",
+}
+
+// Maintenance is an auto generated Go binding around an Ethereum contract.
+type Maintenance struct {
+	MaintenanceCaller     // Read-only binding to the contract
+	MaintenanceTransactor // Write-only binding to the contract
+	MaintenanceFilterer   // Log filterer for contract events
+}
+
+// MaintenanceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MaintenanceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MaintenanceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MaintenanceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MaintenanceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MaintenanceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MaintenanceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MaintenanceSession struct {
+	Contract     *Maintenance      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MaintenanceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MaintenanceCallerSession struct {
+	Contract *MaintenanceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// MaintenanceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MaintenanceTransactorSession struct {
+	Contract     *MaintenanceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// MaintenanceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MaintenanceRaw struct {
+	Contract *Maintenance // Generic contract binding to access the raw methods on
+}
+
+// MaintenanceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MaintenanceCallerRaw struct {
+	Contract *MaintenanceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MaintenanceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MaintenanceTransactorRaw struct {
+	Contract *MaintenanceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMaintenance creates a new instance of Maintenance, bound to a specific deployed contract.
+func NewMaintenance(address common.Address, backend bind.ContractBackend) (*Maintenance, error) {
+	contract, err := bindMaintenance(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Maintenance{MaintenanceCaller: MaintenanceCaller{contract: contract}, MaintenanceTransactor: MaintenanceTransactor{contract: contract}, MaintenanceFilterer: MaintenanceFilterer{contract: contract}}, nil
+}
+
+// NewMaintenanceCaller creates a new read-only instance of Maintenance, bound to a specific deployed contract.
+func NewMaintenanceCaller(address common.Address, caller bind.ContractCaller) (*MaintenanceCaller, error) {
+	contract, err := bindMaintenance(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MaintenanceCaller{contract: contract}, nil
+}
+
+// NewMaintenanceTransactor creates a new write-only instance of Maintenance, bound to a specific deployed contract.
+func NewMaintenanceTransactor(address common.Address, transactor bind.ContractTransactor) (*MaintenanceTransactor, error) {
+	contract, err := bindMaintenance(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MaintenanceTransactor{contract: contract}, nil
+}
+
+// NewMaintenanceFilterer creates a new log filterer instance of Maintenance, bound to a specific deployed contract.
+func NewMaintenanceFilterer(address common.Address, filterer bind.ContractFilterer) (*MaintenanceFilterer, error) {
+	contract, err := bindMaintenance(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MaintenanceFilterer{contract: contract}, nil
+}
+
+// bindMaintenance binds a generic wrapper to an already deployed contract.
+func bindMaintenance(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(MaintenanceABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Maintenance *MaintenanceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Maintenance.Contract.MaintenanceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Maintenance *MaintenanceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Maintenance.Contract.MaintenanceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Maintenance *MaintenanceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Maintenance.Contract.MaintenanceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Maintenance *MaintenanceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Maintenance.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Maintenance *MaintenanceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Maintenance.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Maintenance *MaintenanceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Maintenance.Contract.contract.Transact(opts, method, params...)
+}
+
+// IsDisabled is a free data retrieval call binding the contract method 0xa686b7b9.
+//
+// Solidity: function isDisabled(address Contract, string Method) view returns(bool Success)
+func (_Maintenance *MaintenanceCaller) IsDisabled(opts *bind.CallOpts, Contract common.Address, Method string) (bool, error) {
+	var out []interface{}
+	err := _Maintenance.contract.Call(opts, &out, "isDisabled", Contract, Method)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsDisabled is a free data retrieval call binding the contract method 0xa686b7b9.
+//
+// Solidity: function isDisabled(address Contract, string Method) view returns(bool Success)
+func (_Maintenance *MaintenanceSession) IsDisabled(Contract common.Address, Method string) (bool, error) {
+	return _Maintenance.Contract.IsDisabled(&_Maintenance.CallOpts, Contract, Method)
+}
+
+// IsDisabled is a free data retrieval call binding the contract method 0xa686b7b9.
+//
+// Solidity: function isDisabled(address Contract, string Method) view returns(bool Success)
+func (_Maintenance *MaintenanceCallerSession) IsDisabled(Contract common.Address, Method string) (bool, error) {
+	return _Maintenance.Contract.IsDisabled(&_Maintenance.CallOpts, Contract, Method)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Maintenance *MaintenanceCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Maintenance.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Maintenance *MaintenanceSession) Name() (string, error) {
+	return _Maintenance.Contract.Name(&_Maintenance.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Maintenance *MaintenanceCallerSession) Name() (string, error) {
+	return _Maintenance.Contract.Name(&_Maintenance.CallOpts)
+}
+
+// DisableMethod is a paid mutator transaction binding the contract method 0xa5c18ac8.
+//
+// Solidity: function disableMethod(address Contract, string Method) returns(bool Success)
+func (_Maintenance *MaintenanceTransactor) DisableMethod(opts *bind.TransactOpts, Contract common.Address, Method string) (*types.Transaction, error) {
+	return _Maintenance.contract.Transact(opts, "disableMethod", Contract, Method)
+}
+
+// DisableMethod is a paid mutator transaction binding the contract method 0xa5c18ac8.
+//
+// Solidity: function disableMethod(address Contract, string Method) returns(bool Success)
+func (_Maintenance *MaintenanceSession) DisableMethod(Contract common.Address, Method string) (*types.Transaction, error) {
+	return _Maintenance.Contract.DisableMethod(&_Maintenance.TransactOpts, Contract, Method)
+}
+
+// DisableMethod is a paid mutator transaction binding the contract method 0xa5c18ac8.
+//
+// Solidity: function disableMethod(address Contract, string Method) returns(bool Success)
+func (_Maintenance *MaintenanceTransactorSession) DisableMethod(Contract common.Address, Method string) (*types.Transaction, error) {
+	return _Maintenance.Contract.DisableMethod(&_Maintenance.TransactOpts, Contract, Method)
+}
+
+// EnableMethod is a paid mutator transaction binding the contract method 0x7a063069.
+//
+// Solidity: function enableMethod(address Contract, string Method) returns(bool Success)
+func (_Maintenance *MaintenanceTransactor) EnableMethod(opts *bind.TransactOpts, Contract common.Address, Method string) (*types.Transaction, error) {
+	return _Maintenance.contract.Transact(opts, "enableMethod", Contract, Method)
+}
+
+// EnableMethod is a paid mutator transaction binding the contract method 0x7a063069.
+//
+// Solidity: function enableMethod(address Contract, string Method) returns(bool Success)
+func (_Maintenance *MaintenanceSession) EnableMethod(Contract common.Address, Method string) (*types.Transaction, error) {
+	return _Maintenance.Contract.EnableMethod(&_Maintenance.TransactOpts, Contract, Method)
+}
+
+// EnableMethod is a paid mutator transaction binding the contract method 0x7a063069.
+//
+// Solidity: function enableMethod(address Contract, string Method) returns(bool Success)
+func (_Maintenance *MaintenanceTransactorSession) EnableMethod(Contract common.Address, Method string) (*types.Transaction, error) {
+	return _Maintenance.Contract.EnableMethod(&_Maintenance.TransactOpts, Contract, Method)
+}
+
+// MaintenanceMethodDisabledIterator is returned from FilterMethodDisabled and is used to iterate over the raw logs and unpacked data for MethodDisabled events raised by the Maintenance contract.
+type MaintenanceMethodDisabledIterator struct {
+	Event *MaintenanceMethodDisabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaintenanceMethodDisabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaintenanceMethodDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaintenanceMethodDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaintenanceMethodDisabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaintenanceMethodDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaintenanceMethodDisabled represents a MethodDisabled event raised by the Maintenance contract.
+type MaintenanceMethodDisabled struct {
+	Contract common.Address
+	Method   string
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterMethodDisabled is a free log retrieval operation binding the contract event 0x095bb277a38953833dc57a2435048ed8fb7ebe226c711dfebd0f5b2f541406bc.
+//
+// Solidity: event methodDisabled(address Contract, string Method)
+func (_Maintenance *MaintenanceFilterer) FilterMethodDisabled(opts *bind.FilterOpts) (*MaintenanceMethodDisabledIterator, error) {
+
+	logs, sub, err := _Maintenance.contract.FilterLogs(opts, "methodDisabled")
+	if err != nil {
+		return nil, err
+	}
+	return &MaintenanceMethodDisabledIterator{contract: _Maintenance.contract, event: "methodDisabled", logs: logs, sub: sub}, nil
+}
+
+// WatchMethodDisabled is a free log subscription operation binding the contract event 0x095bb277a38953833dc57a2435048ed8fb7ebe226c711dfebd0f5b2f541406bc.
+//
+// Solidity: event methodDisabled(address Contract, string Method)
+func (_Maintenance *MaintenanceFilterer) WatchMethodDisabled(opts *bind.WatchOpts, sink chan<- *MaintenanceMethodDisabled) (event.Subscription, error) {
+
+	logs, sub, err := _Maintenance.contract.WatchLogs(opts, "methodDisabled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaintenanceMethodDisabled)
+				if err := _Maintenance.contract.UnpackLog(event, "methodDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMethodDisabled is a log parse operation binding the contract event 0x095bb277a38953833dc57a2435048ed8fb7ebe226c711dfebd0f5b2f541406bc.
+//
+// Solidity: event methodDisabled(address Contract, string Method)
+func (_Maintenance *MaintenanceFilterer) ParseMethodDisabled(log types.Log) (*MaintenanceMethodDisabled, error) {
+	event := new(MaintenanceMethodDisabled)
+	if err := _Maintenance.contract.UnpackLog(event, "methodDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaintenanceMethodEnabledIterator is returned from FilterMethodEnabled and is used to iterate over the raw logs and unpacked data for MethodEnabled events raised by the Maintenance contract.
+type MaintenanceMethodEnabledIterator struct {
+	Event *MaintenanceMethodEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaintenanceMethodEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaintenanceMethodEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaintenanceMethodEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaintenanceMethodEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaintenanceMethodEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaintenanceMethodEnabled represents a MethodEnabled event raised by the Maintenance contract.
+type MaintenanceMethodEnabled struct {
+	Contract common.Address
+	Method   string
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterMethodEnabled is a free log retrieval operation binding the contract event 0x840b05815eb9c2ef62f263aba5fe59bf3dff750b5f56aa1fc1ab9c89f4604286.
+//
+// Solidity: event methodEnabled(address Contract, string Method)
+func (_Maintenance *MaintenanceFilterer) FilterMethodEnabled(opts *bind.FilterOpts) (*MaintenanceMethodEnabledIterator, error) {
+
+	logs, sub, err := _Maintenance.contract.FilterLogs(opts, "methodEnabled")
+	if err != nil {
+		return nil, err
+	}
+	return &MaintenanceMethodEnabledIterator{contract: _Maintenance.contract, event: "methodEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchMethodEnabled is a free log subscription operation binding the contract event 0x840b05815eb9c2ef62f263aba5fe59bf3dff750b5f56aa1fc1ab9c89f4604286.
+//
+// Solidity: event methodEnabled(address Contract, string Method)
+func (_Maintenance *MaintenanceFilterer) WatchMethodEnabled(opts *bind.WatchOpts, sink chan<- *MaintenanceMethodEnabled) (event.Subscription, error) {
+
+	logs, sub, err := _Maintenance.contract.WatchLogs(opts, "methodEnabled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaintenanceMethodEnabled)
+				if err := _Maintenance.contract.UnpackLog(event, "methodEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMethodEnabled is a log parse operation binding the contract event 0x840b05815eb9c2ef62f263aba5fe59bf3dff750b5f56aa1fc1ab9c89f4604286.
+//
+// Solidity: event methodEnabled(address Contract, string Method)
+func (_Maintenance *MaintenanceFilterer) ParseMethodEnabled(log types.Log) (*MaintenanceMethodEnabled, error) {
+	event := new(MaintenanceMethodEnabled)
+	if err := _Maintenance.contract.UnpackLog(event, "methodEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
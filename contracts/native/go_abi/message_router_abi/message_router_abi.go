@@ -0,0 +1,1068 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package message_router_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodName = "name"
+
+	MethodRoute = "route"
+
+	MethodRegisterRoute = "registerRoute"
+
+	MethodRemoveRoute = "removeRoute"
+
+	MethodRetryMessage = "retryMessage"
+
+	MethodSendBatch = "sendBatch"
+
+	MethodSendMessage = "sendMessage"
+)
+
+// MessageRouterABI is the input ABI used to generate the binding from.
+const MessageRouterABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"registerRoute\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"SourceApp\",\"type\":\"bytes\"},{\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"GasLimit\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"removeRoute\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"SourceApp\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"route\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"SourceApp\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"Owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"GasLimit\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"retryMessage\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"sendMessage\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"ToContract\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Message\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"sendBatch\",\"inputs\":[{\"internalType\":\"uint64[]\",\"name\":\"ToChainIDs\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"ToContracts\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes[]\",\"name\":\"Messages\",\"type\":\"bytes[]\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"BatchID\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"routeRegistered\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"SourceApp\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"GasLimit\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"routeRemoved\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"SourceApp\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"messageRouted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"SourceApp\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Callback\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}]},{\"type\":\"event\",\"name\":\"messageSent\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"ToContract\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Sequence\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"batchSent\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"BatchID\",\"type\":\"bytes32\"}]}]"
+
+// MessageRouterFuncSigs maps the 4-byte function signature to its string representation.
+var MessageRouterFuncSigs = map[string]string{
+	"06fdde03": "name()",
+	"33bde539": "registerRoute(uint64,bytes,address,uint64)",
+	"faf38193": "removeRoute(uint64,bytes)",
+	"cd3e1266": "retryMessage(bytes32)",
+	"438aa9fb": "route(uint64,bytes)",
+	"406cd78a": "sendBatch(uint64[],bytes[],bytes[])",
+	"b0be6151": "sendMessage(uint64,bytes,bytes)",
+}
+
+// MessageRouter is an auto generated Go binding around an Ethereum contract.
+type MessageRouter struct {
+	MessageRouterCaller     // Read-only binding to the contract
+	MessageRouterTransactor // Write-only binding to the contract
+	MessageRouterFilterer   // Log filterer for contract events
+}
+
+// MessageRouterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MessageRouterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageRouterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MessageRouterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageRouterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MessageRouterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageRouterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MessageRouterSession struct {
+	Contract     *MessageRouter    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MessageRouterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MessageRouterCallerSession struct {
+	Contract *MessageRouterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// MessageRouterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MessageRouterTransactorSession struct {
+	Contract     *MessageRouterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// MessageRouterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MessageRouterRaw struct {
+	Contract *MessageRouter // Generic contract binding to access the raw methods on
+}
+
+// MessageRouterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MessageRouterCallerRaw struct {
+	Contract *MessageRouterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MessageRouterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MessageRouterTransactorRaw struct {
+	Contract *MessageRouterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMessageRouter creates a new instance of MessageRouter, bound to a specific deployed contract.
+func NewMessageRouter(address common.Address, backend bind.ContractBackend) (*MessageRouter, error) {
+	contract, err := bindMessageRouter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouter{MessageRouterCaller: MessageRouterCaller{contract: contract}, MessageRouterTransactor: MessageRouterTransactor{contract: contract}, MessageRouterFilterer: MessageRouterFilterer{contract: contract}}, nil
+}
+
+// NewMessageRouterCaller creates a new read-only instance of MessageRouter, bound to a specific deployed contract.
+func NewMessageRouterCaller(address common.Address, caller bind.ContractCaller) (*MessageRouterCaller, error) {
+	contract, err := bindMessageRouter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterCaller{contract: contract}, nil
+}
+
+// NewMessageRouterTransactor creates a new write-only instance of MessageRouter, bound to a specific deployed contract.
+func NewMessageRouterTransactor(address common.Address, transactor bind.ContractTransactor) (*MessageRouterTransactor, error) {
+	contract, err := bindMessageRouter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterTransactor{contract: contract}, nil
+}
+
+// NewMessageRouterFilterer creates a new log filterer instance of MessageRouter, bound to a specific deployed contract.
+func NewMessageRouterFilterer(address common.Address, filterer bind.ContractFilterer) (*MessageRouterFilterer, error) {
+	contract, err := bindMessageRouter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterFilterer{contract: contract}, nil
+}
+
+// bindMessageRouter binds a generic wrapper to an already deployed contract.
+func bindMessageRouter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(MessageRouterABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MessageRouter *MessageRouterRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MessageRouter.Contract.MessageRouterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MessageRouter *MessageRouterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MessageRouter.Contract.MessageRouterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MessageRouter *MessageRouterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MessageRouter.Contract.MessageRouterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MessageRouter *MessageRouterCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MessageRouter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MessageRouter *MessageRouterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MessageRouter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MessageRouter *MessageRouterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MessageRouter.Contract.contract.Transact(opts, method, params...)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_MessageRouter *MessageRouterCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _MessageRouter.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_MessageRouter *MessageRouterSession) Name() (string, error) {
+	return _MessageRouter.Contract.Name(&_MessageRouter.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_MessageRouter *MessageRouterCallerSession) Name() (string, error) {
+	return _MessageRouter.Contract.Name(&_MessageRouter.CallOpts)
+}
+
+// Route is a free data retrieval call binding the contract method 0x438aa9fb.
+//
+// Solidity: function route(uint64 SourceChainID, bytes SourceApp) view returns(address Owner, address Callback, uint64 GasLimit)
+func (_MessageRouter *MessageRouterCaller) Route(opts *bind.CallOpts, SourceChainID uint64, SourceApp []byte) (struct {
+	Owner    common.Address
+	Callback common.Address
+	GasLimit uint64
+}, error) {
+	var out []interface{}
+	err := _MessageRouter.contract.Call(opts, &out, "route", SourceChainID, SourceApp)
+
+	outstruct := new(struct {
+		Owner    common.Address
+		Callback common.Address
+		GasLimit uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Owner = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Callback = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.GasLimit = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// Route is a free data retrieval call binding the contract method 0x438aa9fb.
+//
+// Solidity: function route(uint64 SourceChainID, bytes SourceApp) view returns(address Owner, address Callback, uint64 GasLimit)
+func (_MessageRouter *MessageRouterSession) Route(SourceChainID uint64, SourceApp []byte) (struct {
+	Owner    common.Address
+	Callback common.Address
+	GasLimit uint64
+}, error) {
+	return _MessageRouter.Contract.Route(&_MessageRouter.CallOpts, SourceChainID, SourceApp)
+}
+
+// Route is a free data retrieval call binding the contract method 0x438aa9fb.
+//
+// Solidity: function route(uint64 SourceChainID, bytes SourceApp) view returns(address Owner, address Callback, uint64 GasLimit)
+func (_MessageRouter *MessageRouterCallerSession) Route(SourceChainID uint64, SourceApp []byte) (struct {
+	Owner    common.Address
+	Callback common.Address
+	GasLimit uint64
+}, error) {
+	return _MessageRouter.Contract.Route(&_MessageRouter.CallOpts, SourceChainID, SourceApp)
+}
+
+// RegisterRoute is a paid mutator transaction binding the contract method 0x33bde539.
+//
+// Solidity: function registerRoute(uint64 SourceChainID, bytes SourceApp, address Callback, uint64 GasLimit) returns(bool Success)
+func (_MessageRouter *MessageRouterTransactor) RegisterRoute(opts *bind.TransactOpts, SourceChainID uint64, SourceApp []byte, Callback common.Address, GasLimit uint64) (*types.Transaction, error) {
+	return _MessageRouter.contract.Transact(opts, "registerRoute", SourceChainID, SourceApp, Callback, GasLimit)
+}
+
+// RegisterRoute is a paid mutator transaction binding the contract method 0x33bde539.
+//
+// Solidity: function registerRoute(uint64 SourceChainID, bytes SourceApp, address Callback, uint64 GasLimit) returns(bool Success)
+func (_MessageRouter *MessageRouterSession) RegisterRoute(SourceChainID uint64, SourceApp []byte, Callback common.Address, GasLimit uint64) (*types.Transaction, error) {
+	return _MessageRouter.Contract.RegisterRoute(&_MessageRouter.TransactOpts, SourceChainID, SourceApp, Callback, GasLimit)
+}
+
+// RegisterRoute is a paid mutator transaction binding the contract method 0x33bde539.
+//
+// Solidity: function registerRoute(uint64 SourceChainID, bytes SourceApp, address Callback, uint64 GasLimit) returns(bool Success)
+func (_MessageRouter *MessageRouterTransactorSession) RegisterRoute(SourceChainID uint64, SourceApp []byte, Callback common.Address, GasLimit uint64) (*types.Transaction, error) {
+	return _MessageRouter.Contract.RegisterRoute(&_MessageRouter.TransactOpts, SourceChainID, SourceApp, Callback, GasLimit)
+}
+
+// RemoveRoute is a paid mutator transaction binding the contract method 0xfaf38193.
+//
+// Solidity: function removeRoute(uint64 SourceChainID, bytes SourceApp) returns(bool Success)
+func (_MessageRouter *MessageRouterTransactor) RemoveRoute(opts *bind.TransactOpts, SourceChainID uint64, SourceApp []byte) (*types.Transaction, error) {
+	return _MessageRouter.contract.Transact(opts, "removeRoute", SourceChainID, SourceApp)
+}
+
+// RemoveRoute is a paid mutator transaction binding the contract method 0xfaf38193.
+//
+// Solidity: function removeRoute(uint64 SourceChainID, bytes SourceApp) returns(bool Success)
+func (_MessageRouter *MessageRouterSession) RemoveRoute(SourceChainID uint64, SourceApp []byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.RemoveRoute(&_MessageRouter.TransactOpts, SourceChainID, SourceApp)
+}
+
+// RemoveRoute is a paid mutator transaction binding the contract method 0xfaf38193.
+//
+// Solidity: function removeRoute(uint64 SourceChainID, bytes SourceApp) returns(bool Success)
+func (_MessageRouter *MessageRouterTransactorSession) RemoveRoute(SourceChainID uint64, SourceApp []byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.RemoveRoute(&_MessageRouter.TransactOpts, SourceChainID, SourceApp)
+}
+
+// RetryMessage is a paid mutator transaction binding the contract method 0xcd3e1266.
+//
+// Solidity: function retryMessage(bytes32 Hash) returns(bool Success)
+func (_MessageRouter *MessageRouterTransactor) RetryMessage(opts *bind.TransactOpts, Hash [32]byte) (*types.Transaction, error) {
+	return _MessageRouter.contract.Transact(opts, "retryMessage", Hash)
+}
+
+// RetryMessage is a paid mutator transaction binding the contract method 0xcd3e1266.
+//
+// Solidity: function retryMessage(bytes32 Hash) returns(bool Success)
+func (_MessageRouter *MessageRouterSession) RetryMessage(Hash [32]byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.RetryMessage(&_MessageRouter.TransactOpts, Hash)
+}
+
+// RetryMessage is a paid mutator transaction binding the contract method 0xcd3e1266.
+//
+// Solidity: function retryMessage(bytes32 Hash) returns(bool Success)
+func (_MessageRouter *MessageRouterTransactorSession) RetryMessage(Hash [32]byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.RetryMessage(&_MessageRouter.TransactOpts, Hash)
+}
+
+// SendBatch is a paid mutator transaction binding the contract method 0x406cd78a.
+//
+// Solidity: function sendBatch(uint64[] ToChainIDs, bytes[] ToContracts, bytes[] Messages) returns(bytes32 BatchID)
+func (_MessageRouter *MessageRouterTransactor) SendBatch(opts *bind.TransactOpts, ToChainIDs []uint64, ToContracts [][]byte, Messages [][]byte) (*types.Transaction, error) {
+	return _MessageRouter.contract.Transact(opts, "sendBatch", ToChainIDs, ToContracts, Messages)
+}
+
+// SendBatch is a paid mutator transaction binding the contract method 0x406cd78a.
+//
+// Solidity: function sendBatch(uint64[] ToChainIDs, bytes[] ToContracts, bytes[] Messages) returns(bytes32 BatchID)
+func (_MessageRouter *MessageRouterSession) SendBatch(ToChainIDs []uint64, ToContracts [][]byte, Messages [][]byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.SendBatch(&_MessageRouter.TransactOpts, ToChainIDs, ToContracts, Messages)
+}
+
+// SendBatch is a paid mutator transaction binding the contract method 0x406cd78a.
+//
+// Solidity: function sendBatch(uint64[] ToChainIDs, bytes[] ToContracts, bytes[] Messages) returns(bytes32 BatchID)
+func (_MessageRouter *MessageRouterTransactorSession) SendBatch(ToChainIDs []uint64, ToContracts [][]byte, Messages [][]byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.SendBatch(&_MessageRouter.TransactOpts, ToChainIDs, ToContracts, Messages)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0xb0be6151.
+//
+// Solidity: function sendMessage(uint64 ToChainID, bytes ToContract, bytes Message) returns(uint64 Sequence)
+func (_MessageRouter *MessageRouterTransactor) SendMessage(opts *bind.TransactOpts, ToChainID uint64, ToContract []byte, Message []byte) (*types.Transaction, error) {
+	return _MessageRouter.contract.Transact(opts, "sendMessage", ToChainID, ToContract, Message)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0xb0be6151.
+//
+// Solidity: function sendMessage(uint64 ToChainID, bytes ToContract, bytes Message) returns(uint64 Sequence)
+func (_MessageRouter *MessageRouterSession) SendMessage(ToChainID uint64, ToContract []byte, Message []byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.SendMessage(&_MessageRouter.TransactOpts, ToChainID, ToContract, Message)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0xb0be6151.
+//
+// Solidity: function sendMessage(uint64 ToChainID, bytes ToContract, bytes Message) returns(uint64 Sequence)
+func (_MessageRouter *MessageRouterTransactorSession) SendMessage(ToChainID uint64, ToContract []byte, Message []byte) (*types.Transaction, error) {
+	return _MessageRouter.Contract.SendMessage(&_MessageRouter.TransactOpts, ToChainID, ToContract, Message)
+}
+
+// MessageRouterBatchSentIterator is returned from FilterBatchSent and is used to iterate over the raw logs and unpacked data for BatchSent events raised by the MessageRouter contract.
+type MessageRouterBatchSentIterator struct {
+	Event *MessageRouterBatchSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageRouterBatchSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageRouterBatchSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageRouterBatchSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageRouterBatchSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageRouterBatchSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageRouterBatchSent represents a BatchSent event raised by the MessageRouter contract.
+type MessageRouterBatchSent struct {
+	Sender  common.Address
+	BatchID [32]byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchSent is a free log retrieval operation binding the contract event 0xd8f233922a0f040537933e7aa6c62ee14ae7f015e0f10e0d7697acaf17ca0761.
+//
+// Solidity: event batchSent(address Sender, bytes32 BatchID)
+func (_MessageRouter *MessageRouterFilterer) FilterBatchSent(opts *bind.FilterOpts) (*MessageRouterBatchSentIterator, error) {
+
+	logs, sub, err := _MessageRouter.contract.FilterLogs(opts, "batchSent")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterBatchSentIterator{contract: _MessageRouter.contract, event: "batchSent", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchSent is a free log subscription operation binding the contract event 0xd8f233922a0f040537933e7aa6c62ee14ae7f015e0f10e0d7697acaf17ca0761.
+//
+// Solidity: event batchSent(address Sender, bytes32 BatchID)
+func (_MessageRouter *MessageRouterFilterer) WatchBatchSent(opts *bind.WatchOpts, sink chan<- *MessageRouterBatchSent) (event.Subscription, error) {
+
+	logs, sub, err := _MessageRouter.contract.WatchLogs(opts, "batchSent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageRouterBatchSent)
+				if err := _MessageRouter.contract.UnpackLog(event, "batchSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchSent is a log parse operation binding the contract event 0xd8f233922a0f040537933e7aa6c62ee14ae7f015e0f10e0d7697acaf17ca0761.
+//
+// Solidity: event batchSent(address Sender, bytes32 BatchID)
+func (_MessageRouter *MessageRouterFilterer) ParseBatchSent(log types.Log) (*MessageRouterBatchSent, error) {
+	event := new(MessageRouterBatchSent)
+	if err := _MessageRouter.contract.UnpackLog(event, "batchSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MessageRouterMessageRoutedIterator is returned from FilterMessageRouted and is used to iterate over the raw logs and unpacked data for MessageRouted events raised by the MessageRouter contract.
+type MessageRouterMessageRoutedIterator struct {
+	Event *MessageRouterMessageRouted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageRouterMessageRoutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageRouterMessageRouted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageRouterMessageRouted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageRouterMessageRoutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageRouterMessageRoutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageRouterMessageRouted represents a MessageRouted event raised by the MessageRouter contract.
+type MessageRouterMessageRouted struct {
+	SourceChainID uint64
+	SourceApp     []byte
+	Callback      common.Address
+	Hash          [32]byte
+	Success       bool
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageRouted is a free log retrieval operation binding the contract event 0x315aeb89abced148ba24a91f648108d1f6bb9d6049264ae058a4e5d22fb22a08.
+//
+// Solidity: event messageRouted(uint64 SourceChainID, bytes SourceApp, address Callback, bytes32 Hash, bool Success)
+func (_MessageRouter *MessageRouterFilterer) FilterMessageRouted(opts *bind.FilterOpts) (*MessageRouterMessageRoutedIterator, error) {
+
+	logs, sub, err := _MessageRouter.contract.FilterLogs(opts, "messageRouted")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterMessageRoutedIterator{contract: _MessageRouter.contract, event: "messageRouted", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageRouted is a free log subscription operation binding the contract event 0x315aeb89abced148ba24a91f648108d1f6bb9d6049264ae058a4e5d22fb22a08.
+//
+// Solidity: event messageRouted(uint64 SourceChainID, bytes SourceApp, address Callback, bytes32 Hash, bool Success)
+func (_MessageRouter *MessageRouterFilterer) WatchMessageRouted(opts *bind.WatchOpts, sink chan<- *MessageRouterMessageRouted) (event.Subscription, error) {
+
+	logs, sub, err := _MessageRouter.contract.WatchLogs(opts, "messageRouted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageRouterMessageRouted)
+				if err := _MessageRouter.contract.UnpackLog(event, "messageRouted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageRouted is a log parse operation binding the contract event 0x315aeb89abced148ba24a91f648108d1f6bb9d6049264ae058a4e5d22fb22a08.
+//
+// Solidity: event messageRouted(uint64 SourceChainID, bytes SourceApp, address Callback, bytes32 Hash, bool Success)
+func (_MessageRouter *MessageRouterFilterer) ParseMessageRouted(log types.Log) (*MessageRouterMessageRouted, error) {
+	event := new(MessageRouterMessageRouted)
+	if err := _MessageRouter.contract.UnpackLog(event, "messageRouted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MessageRouterMessageSentIterator is returned from FilterMessageSent and is used to iterate over the raw logs and unpacked data for MessageSent events raised by the MessageRouter contract.
+type MessageRouterMessageSentIterator struct {
+	Event *MessageRouterMessageSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageRouterMessageSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageRouterMessageSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageRouterMessageSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageRouterMessageSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageRouterMessageSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageRouterMessageSent represents a MessageSent event raised by the MessageRouter contract.
+type MessageRouterMessageSent struct {
+	Sender     common.Address
+	ToChainID  uint64
+	ToContract []byte
+	Sequence   uint64
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageSent is a free log retrieval operation binding the contract event 0x9804a99bc1234b279870931a4e286b74f7dfaaa99ef5ecbb400d014be9b4cc4e.
+//
+// Solidity: event messageSent(address Sender, uint64 ToChainID, bytes ToContract, uint64 Sequence)
+func (_MessageRouter *MessageRouterFilterer) FilterMessageSent(opts *bind.FilterOpts) (*MessageRouterMessageSentIterator, error) {
+
+	logs, sub, err := _MessageRouter.contract.FilterLogs(opts, "messageSent")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterMessageSentIterator{contract: _MessageRouter.contract, event: "messageSent", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageSent is a free log subscription operation binding the contract event 0x9804a99bc1234b279870931a4e286b74f7dfaaa99ef5ecbb400d014be9b4cc4e.
+//
+// Solidity: event messageSent(address Sender, uint64 ToChainID, bytes ToContract, uint64 Sequence)
+func (_MessageRouter *MessageRouterFilterer) WatchMessageSent(opts *bind.WatchOpts, sink chan<- *MessageRouterMessageSent) (event.Subscription, error) {
+
+	logs, sub, err := _MessageRouter.contract.WatchLogs(opts, "messageSent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageRouterMessageSent)
+				if err := _MessageRouter.contract.UnpackLog(event, "messageSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageSent is a log parse operation binding the contract event 0x9804a99bc1234b279870931a4e286b74f7dfaaa99ef5ecbb400d014be9b4cc4e.
+//
+// Solidity: event messageSent(address Sender, uint64 ToChainID, bytes ToContract, uint64 Sequence)
+func (_MessageRouter *MessageRouterFilterer) ParseMessageSent(log types.Log) (*MessageRouterMessageSent, error) {
+	event := new(MessageRouterMessageSent)
+	if err := _MessageRouter.contract.UnpackLog(event, "messageSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MessageRouterRouteRegisteredIterator is returned from FilterRouteRegistered and is used to iterate over the raw logs and unpacked data for RouteRegistered events raised by the MessageRouter contract.
+type MessageRouterRouteRegisteredIterator struct {
+	Event *MessageRouterRouteRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageRouterRouteRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageRouterRouteRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageRouterRouteRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageRouterRouteRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageRouterRouteRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageRouterRouteRegistered represents a RouteRegistered event raised by the MessageRouter contract.
+type MessageRouterRouteRegistered struct {
+	SourceChainID uint64
+	SourceApp     []byte
+	Owner         common.Address
+	Callback      common.Address
+	GasLimit      uint64
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterRouteRegistered is a free log retrieval operation binding the contract event 0x64b4afc56ab64d0b998d4c1a86d62a925c98cee6935690d688da7650d56a61a4.
+//
+// Solidity: event routeRegistered(uint64 SourceChainID, bytes SourceApp, address Owner, address Callback, uint64 GasLimit)
+func (_MessageRouter *MessageRouterFilterer) FilterRouteRegistered(opts *bind.FilterOpts) (*MessageRouterRouteRegisteredIterator, error) {
+
+	logs, sub, err := _MessageRouter.contract.FilterLogs(opts, "routeRegistered")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterRouteRegisteredIterator{contract: _MessageRouter.contract, event: "routeRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchRouteRegistered is a free log subscription operation binding the contract event 0x64b4afc56ab64d0b998d4c1a86d62a925c98cee6935690d688da7650d56a61a4.
+//
+// Solidity: event routeRegistered(uint64 SourceChainID, bytes SourceApp, address Owner, address Callback, uint64 GasLimit)
+func (_MessageRouter *MessageRouterFilterer) WatchRouteRegistered(opts *bind.WatchOpts, sink chan<- *MessageRouterRouteRegistered) (event.Subscription, error) {
+
+	logs, sub, err := _MessageRouter.contract.WatchLogs(opts, "routeRegistered")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageRouterRouteRegistered)
+				if err := _MessageRouter.contract.UnpackLog(event, "routeRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRouteRegistered is a log parse operation binding the contract event 0x64b4afc56ab64d0b998d4c1a86d62a925c98cee6935690d688da7650d56a61a4.
+//
+// Solidity: event routeRegistered(uint64 SourceChainID, bytes SourceApp, address Owner, address Callback, uint64 GasLimit)
+func (_MessageRouter *MessageRouterFilterer) ParseRouteRegistered(log types.Log) (*MessageRouterRouteRegistered, error) {
+	event := new(MessageRouterRouteRegistered)
+	if err := _MessageRouter.contract.UnpackLog(event, "routeRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MessageRouterRouteRemovedIterator is returned from FilterRouteRemoved and is used to iterate over the raw logs and unpacked data for RouteRemoved events raised by the MessageRouter contract.
+type MessageRouterRouteRemovedIterator struct {
+	Event *MessageRouterRouteRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageRouterRouteRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageRouterRouteRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageRouterRouteRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageRouterRouteRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageRouterRouteRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageRouterRouteRemoved represents a RouteRemoved event raised by the MessageRouter contract.
+type MessageRouterRouteRemoved struct {
+	SourceChainID uint64
+	SourceApp     []byte
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterRouteRemoved is a free log retrieval operation binding the contract event 0xf42f9af990d977b70585d17cf75fb7fcf848c3c278d5e81923e1a37a986b2481.
+//
+// Solidity: event routeRemoved(uint64 SourceChainID, bytes SourceApp)
+func (_MessageRouter *MessageRouterFilterer) FilterRouteRemoved(opts *bind.FilterOpts) (*MessageRouterRouteRemovedIterator, error) {
+
+	logs, sub, err := _MessageRouter.contract.FilterLogs(opts, "routeRemoved")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageRouterRouteRemovedIterator{contract: _MessageRouter.contract, event: "routeRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchRouteRemoved is a free log subscription operation binding the contract event 0xf42f9af990d977b70585d17cf75fb7fcf848c3c278d5e81923e1a37a986b2481.
+//
+// Solidity: event routeRemoved(uint64 SourceChainID, bytes SourceApp)
+func (_MessageRouter *MessageRouterFilterer) WatchRouteRemoved(opts *bind.WatchOpts, sink chan<- *MessageRouterRouteRemoved) (event.Subscription, error) {
+
+	logs, sub, err := _MessageRouter.contract.WatchLogs(opts, "routeRemoved")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageRouterRouteRemoved)
+				if err := _MessageRouter.contract.UnpackLog(event, "routeRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRouteRemoved is a log parse operation binding the contract event 0xf42f9af990d977b70585d17cf75fb7fcf848c3c278d5e81923e1a37a986b2481.
+//
+// Solidity: event routeRemoved(uint64 SourceChainID, bytes SourceApp)
+func (_MessageRouter *MessageRouterFilterer) ParseRouteRemoved(log types.Log) (*MessageRouterRouteRemoved, error) {
+	event := new(MessageRouterRouteRemoved)
+	if err := _MessageRouter.contract.UnpackLog(event, "routeRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -27,21 +27,21 @@ var (
 )
 
 var (
-	MethodApproveRegisterStateValidator = "approveRegisterStateValidator"
-
-	MethodApproveRemoveStateValidator = "approveRemoveStateValidator"
-
 	MethodGetCurrentStateValidator = "getCurrentStateValidator"
 
 	MethodName = "name"
 
+	MethodApproveRegisterStateValidator = "approveRegisterStateValidator"
+
+	MethodApproveRemoveStateValidator = "approveRemoveStateValidator"
+
 	MethodRegisterStateValidator = "registerStateValidator"
 
 	MethodRemoveStateValidator = "removeStateValidator"
 )
 
 // Neo3StateManagerABI is the input ABI used to generate the binding from.
-const Neo3StateManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"name\":\"evtApproveRegisterStateValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"name\":\"evtApproveRemoveStateValidator\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveRegisterStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveRemoveStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentStateValidator\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Validator\",\"type\":\"bytes\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"StateValidators\",\"type\":\"string[]\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"registerStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"StateValidators\",\"type\":\"string[]\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"removeStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const Neo3StateManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"name\":\"approveRegisterStateValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"name\":\"approveRemoveStateValidator\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveRegisterStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveRemoveStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentStateValidator\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Validator\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"StateValidators\",\"type\":\"string[]\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"registerStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"StateValidators\",\"type\":\"string[]\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"removeStateValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // Neo3StateManagerFuncSigs maps the 4-byte function signature to its string representation.
 var Neo3StateManagerFuncSigs = map[string]string{
@@ -53,23 +53,6 @@ var Neo3StateManagerFuncSigs = map[string]string{
 	"d62c2f61": "removeStateValidator(string[],address)",
 }
 
-// Neo3StateManagerBin is the compiled bytecode used for deploying new contracts.
-var Neo3StateManagerBin = "0x608060405234801561001057600080fd5b50610321806100206000396000f3fe608060405234801561001057600080fd5b50600436106100625760003560e01c806306fdde03146100675780633473fd551461007f578063770fa9ad14610067578063ca1c4d1b1461007f578063d62c2f61146100a5578063f7531edd146100a5575b600080fd5b6060604051610076919061028a565b60405180910390f35b61009561008d3660046101fb565b600092915050565b6040519015158152602001610076565b61009561008d3660046100cf565b80356001600160a01b03811681146100ca57600080fd5b919050565b60008060408084860312156100e357600080fd5b833567ffffffffffffffff808211156100fb57600080fd5b818601915086601f83011261010f57600080fd5b8135602082821115610123576101236102d5565b8160051b6101328282016102a4565b8381528281019086840183880185018d101561014d57600080fd5b600093505b858410156101dc5780358781111561016957600080fd5b8801603f81018e1361017a57600080fd5b858101358881111561018e5761018e6102d5565b6101a0601f8201601f191688016102a4565b8181528f8c8385010111156101b457600080fd5b818c840189830137600091810188019190915284525060019390930192918401918401610152565b5098506101ed9150508882016100b3565b955050505050509250929050565b6000806040838503121561020e57600080fd5b823567ffffffffffffffff8116811461022657600080fd5b9150610234602084016100b3565b90509250929050565b6000815180845260005b8181101561026357602081850181015186830182015201610247565b81811115610275576000602083870101525b50601f01601f19169290920160200192915050565b60208152600061029d602083018461023d565b9392505050565b604051601f8201601f1916810167ffffffffffffffff811182821017156102cd576102cd6102d5565b604052919050565b634e487b7160e01b600052604160045260246000fdfea26469706673582212202c6e8dc15980e12ec1b93cc0fd811e701b6b90ebd3fd4b8985934601617ca04e64736f6c63430008060033"
-
-// DeployNeo3StateManager deploys a new Ethereum contract, binding an instance of Neo3StateManager to it.
-func DeployNeo3StateManager(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Neo3StateManager, error) {
-	parsed, err := abi.JSON(strings.NewReader(Neo3StateManagerABI))
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(Neo3StateManagerBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &Neo3StateManager{Neo3StateManagerCaller: Neo3StateManagerCaller{contract: contract}, Neo3StateManagerTransactor: Neo3StateManagerTransactor{contract: contract}, Neo3StateManagerFilterer: Neo3StateManagerFilterer{contract: contract}}, nil
-}
-
 // Neo3StateManager is an auto generated Go binding around an Ethereum contract.
 type Neo3StateManager struct {
 	Neo3StateManagerCaller     // Read-only binding to the contract
@@ -212,6 +195,68 @@ func (_Neo3StateManager *Neo3StateManagerTransactorRaw) Transact(opts *bind.Tran
 	return _Neo3StateManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetCurrentStateValidator is a free data retrieval call binding the contract method 0x770fa9ad.
+//
+// Solidity: function getCurrentStateValidator() view returns(bytes Validator)
+func (_Neo3StateManager *Neo3StateManagerCaller) GetCurrentStateValidator(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _Neo3StateManager.contract.Call(opts, &out, "getCurrentStateValidator")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetCurrentStateValidator is a free data retrieval call binding the contract method 0x770fa9ad.
+//
+// Solidity: function getCurrentStateValidator() view returns(bytes Validator)
+func (_Neo3StateManager *Neo3StateManagerSession) GetCurrentStateValidator() ([]byte, error) {
+	return _Neo3StateManager.Contract.GetCurrentStateValidator(&_Neo3StateManager.CallOpts)
+}
+
+// GetCurrentStateValidator is a free data retrieval call binding the contract method 0x770fa9ad.
+//
+// Solidity: function getCurrentStateValidator() view returns(bytes Validator)
+func (_Neo3StateManager *Neo3StateManagerCallerSession) GetCurrentStateValidator() ([]byte, error) {
+	return _Neo3StateManager.Contract.GetCurrentStateValidator(&_Neo3StateManager.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Neo3StateManager *Neo3StateManagerCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Neo3StateManager.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Neo3StateManager *Neo3StateManagerSession) Name() (string, error) {
+	return _Neo3StateManager.Contract.Name(&_Neo3StateManager.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Neo3StateManager *Neo3StateManagerCallerSession) Name() (string, error) {
+	return _Neo3StateManager.Contract.Name(&_Neo3StateManager.CallOpts)
+}
+
 // ApproveRegisterStateValidator is a paid mutator transaction binding the contract method 0xca1c4d1b.
 //
 // Solidity: function approveRegisterStateValidator(uint64 ID, address Address) returns(bool success)
@@ -254,48 +299,6 @@ func (_Neo3StateManager *Neo3StateManagerTransactorSession) ApproveRemoveStateVa
 	return _Neo3StateManager.Contract.ApproveRemoveStateValidator(&_Neo3StateManager.TransactOpts, ID, Address)
 }
 
-// GetCurrentStateValidator is a paid mutator transaction binding the contract method 0x770fa9ad.
-//
-// Solidity: function getCurrentStateValidator() returns(bytes Validator)
-func (_Neo3StateManager *Neo3StateManagerTransactor) GetCurrentStateValidator(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Neo3StateManager.contract.Transact(opts, "getCurrentStateValidator")
-}
-
-// GetCurrentStateValidator is a paid mutator transaction binding the contract method 0x770fa9ad.
-//
-// Solidity: function getCurrentStateValidator() returns(bytes Validator)
-func (_Neo3StateManager *Neo3StateManagerSession) GetCurrentStateValidator() (*types.Transaction, error) {
-	return _Neo3StateManager.Contract.GetCurrentStateValidator(&_Neo3StateManager.TransactOpts)
-}
-
-// GetCurrentStateValidator is a paid mutator transaction binding the contract method 0x770fa9ad.
-//
-// Solidity: function getCurrentStateValidator() returns(bytes Validator)
-func (_Neo3StateManager *Neo3StateManagerTransactorSession) GetCurrentStateValidator() (*types.Transaction, error) {
-	return _Neo3StateManager.Contract.GetCurrentStateValidator(&_Neo3StateManager.TransactOpts)
-}
-
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
-//
-// Solidity: function name() returns(string Name)
-func (_Neo3StateManager *Neo3StateManagerTransactor) Name(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Neo3StateManager.contract.Transact(opts, "name")
-}
-
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
-//
-// Solidity: function name() returns(string Name)
-func (_Neo3StateManager *Neo3StateManagerSession) Name() (*types.Transaction, error) {
-	return _Neo3StateManager.Contract.Name(&_Neo3StateManager.TransactOpts)
-}
-
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
-//
-// Solidity: function name() returns(string Name)
-func (_Neo3StateManager *Neo3StateManagerTransactorSession) Name() (*types.Transaction, error) {
-	return _Neo3StateManager.Contract.Name(&_Neo3StateManager.TransactOpts)
-}
-
 // RegisterStateValidator is a paid mutator transaction binding the contract method 0xf7531edd.
 //
 // Solidity: function registerStateValidator(string[] StateValidators, address Address) returns(bool success)
@@ -411,24 +414,24 @@ type Neo3StateManagerApproveRegisterStateValidator struct {
 	Raw types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproveRegisterStateValidator is a free log retrieval operation binding the contract event 0xa2d1b53f6d5ea7964c14d81acde072ef679b771780c18a93206cd3319c6621d6.
+// FilterApproveRegisterStateValidator is a free log retrieval operation binding the contract event 0xda05aee5432ef1a8400cb9eab9c8ac12a1ed0b4351418b2171d966f4d30b739a.
 //
-// Solidity: event evtApproveRegisterStateValidator(uint64 ID)
+// Solidity: event approveRegisterStateValidator(uint64 ID)
 func (_Neo3StateManager *Neo3StateManagerFilterer) FilterApproveRegisterStateValidator(opts *bind.FilterOpts) (*Neo3StateManagerApproveRegisterStateValidatorIterator, error) {
 
-	logs, sub, err := _Neo3StateManager.contract.FilterLogs(opts, "evtApproveRegisterStateValidator")
+	logs, sub, err := _Neo3StateManager.contract.FilterLogs(opts, "approveRegisterStateValidator")
 	if err != nil {
 		return nil, err
 	}
-	return &Neo3StateManagerApproveRegisterStateValidatorIterator{contract: _Neo3StateManager.contract, event: "evtApproveRegisterStateValidator", logs: logs, sub: sub}, nil
+	return &Neo3StateManagerApproveRegisterStateValidatorIterator{contract: _Neo3StateManager.contract, event: "approveRegisterStateValidator", logs: logs, sub: sub}, nil
 }
 
-// WatchApproveRegisterStateValidator is a free log subscription operation binding the contract event 0xa2d1b53f6d5ea7964c14d81acde072ef679b771780c18a93206cd3319c6621d6.
+// WatchApproveRegisterStateValidator is a free log subscription operation binding the contract event 0xda05aee5432ef1a8400cb9eab9c8ac12a1ed0b4351418b2171d966f4d30b739a.
 //
-// Solidity: event evtApproveRegisterStateValidator(uint64 ID)
+// Solidity: event approveRegisterStateValidator(uint64 ID)
 func (_Neo3StateManager *Neo3StateManagerFilterer) WatchApproveRegisterStateValidator(opts *bind.WatchOpts, sink chan<- *Neo3StateManagerApproveRegisterStateValidator) (event.Subscription, error) {
 
-	logs, sub, err := _Neo3StateManager.contract.WatchLogs(opts, "evtApproveRegisterStateValidator")
+	logs, sub, err := _Neo3StateManager.contract.WatchLogs(opts, "approveRegisterStateValidator")
 	if err != nil {
 		return nil, err
 	}
@@ -439,7 +442,7 @@ func (_Neo3StateManager *Neo3StateManagerFilterer) WatchApproveRegisterStateVali
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
 				event := new(Neo3StateManagerApproveRegisterStateValidator)
-				if err := _Neo3StateManager.contract.UnpackLog(event, "evtApproveRegisterStateValidator", log); err != nil {
+				if err := _Neo3StateManager.contract.UnpackLog(event, "approveRegisterStateValidator", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -460,12 +463,12 @@ func (_Neo3StateManager *Neo3StateManagerFilterer) WatchApproveRegisterStateVali
 	}), nil
 }
 
-// ParseApproveRegisterStateValidator is a log parse operation binding the contract event 0xa2d1b53f6d5ea7964c14d81acde072ef679b771780c18a93206cd3319c6621d6.
+// ParseApproveRegisterStateValidator is a log parse operation binding the contract event 0xda05aee5432ef1a8400cb9eab9c8ac12a1ed0b4351418b2171d966f4d30b739a.
 //
-// Solidity: event evtApproveRegisterStateValidator(uint64 ID)
+// Solidity: event approveRegisterStateValidator(uint64 ID)
 func (_Neo3StateManager *Neo3StateManagerFilterer) ParseApproveRegisterStateValidator(log types.Log) (*Neo3StateManagerApproveRegisterStateValidator, error) {
 	event := new(Neo3StateManagerApproveRegisterStateValidator)
-	if err := _Neo3StateManager.contract.UnpackLog(event, "evtApproveRegisterStateValidator", log); err != nil {
+	if err := _Neo3StateManager.contract.UnpackLog(event, "approveRegisterStateValidator", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -545,24 +548,24 @@ type Neo3StateManagerApproveRemoveStateValidator struct {
 	Raw types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproveRemoveStateValidator is a free log retrieval operation binding the contract event 0x32050489af2e3d6571e4c66ba0ca36ccf2b8feed7a7059d23aab90998d263604.
+// FilterApproveRemoveStateValidator is a free log retrieval operation binding the contract event 0x270564863a2e000c0560e10a0e43386587eca0cbd52e205f241d2d7341355275.
 //
-// Solidity: event evtApproveRemoveStateValidator(uint64 ID)
+// Solidity: event approveRemoveStateValidator(uint64 ID)
 func (_Neo3StateManager *Neo3StateManagerFilterer) FilterApproveRemoveStateValidator(opts *bind.FilterOpts) (*Neo3StateManagerApproveRemoveStateValidatorIterator, error) {
 
-	logs, sub, err := _Neo3StateManager.contract.FilterLogs(opts, "evtApproveRemoveStateValidator")
+	logs, sub, err := _Neo3StateManager.contract.FilterLogs(opts, "approveRemoveStateValidator")
 	if err != nil {
 		return nil, err
 	}
-	return &Neo3StateManagerApproveRemoveStateValidatorIterator{contract: _Neo3StateManager.contract, event: "evtApproveRemoveStateValidator", logs: logs, sub: sub}, nil
+	return &Neo3StateManagerApproveRemoveStateValidatorIterator{contract: _Neo3StateManager.contract, event: "approveRemoveStateValidator", logs: logs, sub: sub}, nil
 }
 
-// WatchApproveRemoveStateValidator is a free log subscription operation binding the contract event 0x32050489af2e3d6571e4c66ba0ca36ccf2b8feed7a7059d23aab90998d263604.
+// WatchApproveRemoveStateValidator is a free log subscription operation binding the contract event 0x270564863a2e000c0560e10a0e43386587eca0cbd52e205f241d2d7341355275.
 //
-// Solidity: event evtApproveRemoveStateValidator(uint64 ID)
+// Solidity: event approveRemoveStateValidator(uint64 ID)
 func (_Neo3StateManager *Neo3StateManagerFilterer) WatchApproveRemoveStateValidator(opts *bind.WatchOpts, sink chan<- *Neo3StateManagerApproveRemoveStateValidator) (event.Subscription, error) {
 
-	logs, sub, err := _Neo3StateManager.contract.WatchLogs(opts, "evtApproveRemoveStateValidator")
+	logs, sub, err := _Neo3StateManager.contract.WatchLogs(opts, "approveRemoveStateValidator")
 	if err != nil {
 		return nil, err
 	}
@@ -573,7 +576,7 @@ func (_Neo3StateManager *Neo3StateManagerFilterer) WatchApproveRemoveStateValida
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
 				event := new(Neo3StateManagerApproveRemoveStateValidator)
-				if err := _Neo3StateManager.contract.UnpackLog(event, "evtApproveRemoveStateValidator", log); err != nil {
+				if err := _Neo3StateManager.contract.UnpackLog(event, "approveRemoveStateValidator", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -594,12 +597,12 @@ func (_Neo3StateManager *Neo3StateManagerFilterer) WatchApproveRemoveStateValida
 	}), nil
 }
 
-// ParseApproveRemoveStateValidator is a log parse operation binding the contract event 0x32050489af2e3d6571e4c66ba0ca36ccf2b8feed7a7059d23aab90998d263604.
+// ParseApproveRemoveStateValidator is a log parse operation binding the contract event 0x270564863a2e000c0560e10a0e43386587eca0cbd52e205f241d2d7341355275.
 //
-// Solidity: event evtApproveRemoveStateValidator(uint64 ID)
+// Solidity: event approveRemoveStateValidator(uint64 ID)
 func (_Neo3StateManager *Neo3StateManagerFilterer) ParseApproveRemoveStateValidator(log types.Log) (*Neo3StateManagerApproveRemoveStateValidator, error) {
 	event := new(Neo3StateManagerApproveRemoveStateValidator)
-	if err := _Neo3StateManager.contract.UnpackLog(event, "evtApproveRemoveStateValidator", log); err != nil {
+	if err := _Neo3StateManager.contract.UnpackLog(event, "approveRemoveStateValidator", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -26,80 +26,130 @@ var (
 	_ = event.NewSubscription
 )
 
-// node_managerConfiguration is an auto generated low-level Go binding around an user-defined struct.
-type node_managerConfiguration struct {
-	BlockMsgDelay        uint32
-	HashMsgDelay         uint32
-	PeerHandshakeTimeout uint32
-	MaxBlockChangeView   uint32
-}
+var (
+	MethodEpoch = "epoch"
 
-// node_managerUpdateConfigParam is an auto generated low-level Go binding around an user-defined struct.
-type node_managerUpdateConfigParam struct {
-	Config node_managerConfiguration
-}
+	MethodEpochChangeSigns = "epochChangeSigns"
 
-// node_managerVBFTPeerInfo is an auto generated low-level Go binding around an user-defined struct.
-type node_managerVBFTPeerInfo struct {
-	Index      uint32
-	PeerPubkey string
-	Address    string
-}
+	MethodEpochPeers = "epochPeers"
 
-var (
-	MethodApproveCandidate = "approveCandidate"
+	MethodEpochSeed = "epochSeed"
+
+	MethodFeeSplit = "feeSplit"
+
+	MethodGroupEpoch = "groupEpoch"
+
+	MethodGroupProof = "groupProof"
 
-	MethodBlackNode = "blackNode"
+	MethodGroupQuorumRule = "groupQuorumRule"
 
-	MethodCommitDpos = "commitDpos"
+	MethodGroups = "groups"
 
-	MethodInitConfig = "initConfig"
+	MethodMisconduct = "misconduct"
 
 	MethodName = "name"
 
-	MethodQuitNode = "quitNode"
+	MethodNextEpoch = "nextEpoch"
+
+	MethodPayouts = "payouts"
+
+	MethodPeersLimit = "peersLimit"
+
+	MethodProof = "proof"
+
+	MethodProposalActions = "proposalActions"
+
+	MethodProposals = "proposals"
+
+	MethodQuorumRule = "quorumRule"
+
+	MethodSeed = "seed"
+
+	MethodVotingSeal = "votingSeal"
 
-	MethodRegisterCandidate = "registerCandidate"
+	MethodVrfKey = "vrfKey"
 
-	MethodUnRegisterCandidate = "unRegisterCandidate"
+	MethodVrfOutput = "vrfOutput"
 
-	MethodUpdateConfig = "updateConfig"
+	MethodCommitVote = "commitVote"
 
-	MethodWhiteNode = "whiteNode"
+	MethodEject = "eject"
+
+	MethodPropose = "propose"
+
+	MethodProposeGroup = "proposeGroup"
+
+	MethodProposeWithActions = "proposeWithActions"
+
+	MethodRegisterVrfKey = "registerVrfKey"
+
+	MethodRevealVote = "revealVote"
+
+	MethodSealVoting = "sealVoting"
+
+	MethodSetFeeSplit = "setFeeSplit"
+
+	MethodSetGroupQuorumRule = "setGroupQuorumRule"
+
+	MethodSetPeersLimit = "setPeersLimit"
+
+	MethodSetQuorumRule = "setQuorumRule"
+
+	MethodSignEpochChange = "signEpochChange"
+
+	MethodSubmitVrf = "submitVrf"
+
+	MethodSweepExpired = "sweepExpired"
+
+	MethodVote = "vote"
+
+	MethodVoteGroup = "voteGroup"
 )
 
 // NodeManagerABI is the input ABI used to generate the binding from.
-const NodeManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"signs\",\"type\":\"uint64\"}],\"name\":\"CheckConsensusSignsEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Pubkey\",\"type\":\"string\"}],\"name\":\"evtApproveCandidate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"PubkeyList\",\"type\":\"string[]\"}],\"name\":\"evtBlackNode\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"evtCommitDpos\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Pubkey\",\"type\":\"string\"}],\"name\":\"evtQuitNode\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Pubkey\",\"type\":\"string\"}],\"name\":\"evtRegisterCandidate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Pubkey\",\"type\":\"string\"}],\"name\":\"evtUnRegisterCandidate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"BlockMsgDelay\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"HashMsgDelay\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"PeerHandshakeTimeout\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"MaxBlockChangeView\",\"type\":\"uint32\"}],\"indexed\":false,\"internalType\":\"structnode_manager.Configuration\",\"name\":\"Config\",\"type\":\"tuple\"}],\"name\":\"evtUpdateConfig\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Pubkey\",\"type\":\"string\"}],\"name\":\"evtWhiteNode\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"PeerPubkey\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveCandidate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"PeerPubkeyList\",\"type\":\"string[]\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"blackNode\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"commitDpos\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"BlockMsgDelay\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"HashMsgDelay\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"PeerHandshakeTimeout\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"MaxBlockChangeView\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"VrfValue\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"VrfProof\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"Index\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"PeerPubkey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"Address\",\"type\":\"string\"}],\"internalType\":\"structnode_manager.VBFTPeerInfo\",\"name\":\"Peers\",\"type\":\"tuple\"}],\"name\":\"initConfig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"PeerPubkey\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"quitNode\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"PeerPubkey\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"registerCandidate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"PeerPubkey\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"unRegisterCandidate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"BlockMsgDelay\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"HashMsgDelay\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"PeerHandshakeTimeout\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"MaxBlockChangeView\",\"type\":\"uint32\"}],\"internalType\":\"structnode_manager.Configuration\",\"name\":\"Config\",\"type\":\"tuple\"}],\"internalType\":\"structnode_manager.UpdateConfigParam\",\"name\":\"ConfigParam\",\"type\":\"tuple\"}],\"name\":\"updateConfig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"PeerPubkey\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"whiteNode\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const NodeManagerABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"propose\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"StartHeight\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Peers\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vote\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"epoch\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nextEpoch\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proposals\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Proposals\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proof\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"peersLimit\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Target\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MaxChange\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"quorumRule\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Numerator\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Denominator\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"Strict\",\"type\":\"bool\"},{\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"seed\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Seed\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"epochSeed\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Seed\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"registerVrfKey\",\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"PubKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Output\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"submitVrf\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Output\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vrfKey\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"PubKey\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vrfOutput\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Output\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"payouts\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"StartEpoch\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"EndEpoch\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Payouts\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feeSplit\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"BurnRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"TreasuryRate\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Treasury\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setFeeSplit\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"BurnRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"TreasuryRate\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Treasury\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"proposeWithActions\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"StartHeight\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Peers\",\"type\":\"bytes\"},{\"internalType\":\"bytes[]\",\"name\":\"Actions\",\"type\":\"bytes[]\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"proposalActions\",\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bytes[]\",\"name\":\"Actions\",\"type\":\"bytes[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sealVoting\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"CommitPeriod\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"RevealPeriod\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"votingSeal\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"CommitEnd\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"RevealEnd\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"Tallied\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"commitVote\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Commitment\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revealVote\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Salt\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"eject\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"Evidence\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"misconduct\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"StartEpoch\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"EndEpoch\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Reports\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sweepExpired\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"proposeGroup\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"StartHeight\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Peers\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"voteGroup\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"groupEpoch\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"groupProof\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"groups\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"Names\",\"type\":\"string[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"groupQuorumRule\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Numerator\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Denominator\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"Strict\",\"type\":\"bool\"},{\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setGroupQuorumRule\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"Numerator\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Denominator\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"Strict\",\"type\":\"bool\"},{\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"signEpochChange\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"epochChangeSigns\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Digest\",\"type\":\"bytes\"},{\"internalType\":\"bytes[]\",\"name\":\"Signatures\",\"type\":\"bytes[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"epochPeers\",\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"Start\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Limit\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Total\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Peers\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setPeersLimit\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Target\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MaxChange\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setQuorumRule\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Numerator\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Denominator\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"Strict\",\"type\":\"bool\"},{\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"proposed\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"voted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"VotedNumber\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"GroupSize\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"epochChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"NextEpoch\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"proposalRejected\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Votes\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Winner\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"consensusSigned\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Method\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Input\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Size\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"peersLimitChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Target\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"MaxChange\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"quorumRuleChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Numerator\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Denominator\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Strict\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"vrfSubmitted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Output\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"feeSplitChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BurnRate\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"TreasuryRate\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Treasury\",\"type\":\"address\"}]},{\"type\":\"event\",\"name\":\"actionsExecuted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Actions\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"votingSealed\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"CommitEnd\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"RevealEnd\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"voteCommitted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Voter\",\"type\":\"address\"}]},{\"type\":\"event\",\"name\":\"votingTallied\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Winner\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"validatorEjected\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"StartHeight\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"misconductRecorded\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Kind\",\"type\":\"uint8\"}]},{\"type\":\"event\",\"name\":\"expirySwept\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Entries\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Reclaimed\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"groupProposed\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"groupVoted\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Hash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"VotedNumber\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"GroupSize\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"groupEpochChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Epoch\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"NextEpoch\",\"type\":\"bytes\"}]},{\"type\":\"event\",\"name\":\"groupQuorumRuleChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Numerator\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Denominator\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"Strict\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Threshold\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"epochChangeSigned\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"EpochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"SignedNumber\",\"type\":\"uint64\"}]}]"
 
 // NodeManagerFuncSigs maps the 4-byte function signature to its string representation.
 var NodeManagerFuncSigs = map[string]string{
-	"2437d889": "approveCandidate(string,address)",
-	"1c21bf1b": "blackNode(string[],address)",
-	"39f097d5": "commitDpos()",
-	"5bc58f4f": "initConfig(uint32,uint32,uint32,uint32,string,string,(uint32,string,string))",
+	"4486bc09": "commitVote(uint64,bytes)",
+	"f559ab66": "eject(address,bytes)",
+	"900cf0cf": "epoch()",
+	"7429700c": "epochChangeSigns(uint64)",
+	"01eaa31a": "epochPeers(bytes,uint64,uint64)",
+	"b3564b8b": "epochSeed(uint64)",
+	"6373ea69": "feeSplit()",
+	"76bc595a": "groupEpoch(string)",
+	"2883bb2f": "groupProof(string,uint64)",
+	"544d9f1f": "groupQuorumRule(string)",
+	"5bf89d9e": "groups()",
+	"1ff88604": "misconduct(address,uint64,uint64)",
 	"06fdde03": "name()",
-	"5506c5bf": "quitNode(string,address)",
-	"7a9b6fb9": "registerCandidate(string,address)",
-	"e7472e0c": "unRegisterCandidate(string,address)",
-	"b8e3e81b": "updateConfig(((uint32,uint32,uint32,uint32)))",
-	"8141608b": "whiteNode(string,address)",
-}
-
-// NodeManagerBin is the compiled bytecode used for deploying new contracts.
-var NodeManagerBin = "0x608060405234801561001057600080fd5b506105b5806100206000396000f3fe608060405234801561001057600080fd5b506004361061009e5760003560e01c80635bc58f4f116100665780635bc58f4f146100f65780637a9b6fb9146100e15780638141608b146100e1578063b8e3e81b14610111578063e7472e0c146100e157600080fd5b806306fdde03146100a35780631c21bf1b146100bb5780632437d889146100e157806339f097d5146100ef5780635506c5bf146100e1575b600080fd5b60606040516100b291906104ba565b60405180910390f35b6100d16100c93660046101c5565b600092915050565b60405190151581526020016100b2565b6100d16100c936600461029f565b60006100d1565b6100d161010436600461038a565b6000979650505050505050565b6100d161011f3660046102ed565b50600090565b80356001600160a01b038116811461013c57600080fd5b919050565b600082601f83011261015257600080fd5b813567ffffffffffffffff81111561016c5761016c610569565b61017f601f8201601f1916602001610538565b81815284602083860101111561019457600080fd5b816020850160208301376000918101602001919091529392505050565b803563ffffffff8116811461013c57600080fd5b600080604083850312156101d857600080fd5b823567ffffffffffffffff808211156101f057600080fd5b818501915085601f83011261020457600080fd5b813560208282111561021857610218610569565b8160051b610227828201610538565b8381528281019086840183880185018c101561024257600080fd5b60009350835b8681101561027f5781358881111561025e578586fd5b61026c8e88838d0101610141565b8552509285019290850190600101610248565b5050809850505050610292818801610125565b9450505050509250929050565b600080604083850312156102b257600080fd5b823567ffffffffffffffff8111156102c957600080fd5b6102d585828601610141565b9250506102e460208401610125565b90509250929050565b6000608082840312156102ff57600080fd5b6040516020810167ffffffffffffffff828210818311171561032357610323610569565b8160405260a08301828110828211171561033f5761033f610569565b6040525061034c846101b1565b815261035a602085016101b1565b604083015261036b604085016101b1565b606083015261037c606085016101b1565b608083015281529392505050565b600080600080600080600060e0888a0312156103a557600080fd5b6103ae886101b1565b96506103bc602089016101b1565b95506103ca604089016101b1565b94506103d8606089016101b1565b9350608088013567ffffffffffffffff808211156103f557600080fd5b6104018b838c01610141565b945060a08a013591508082111561041757600080fd5b6104238b838c01610141565b935060c08a013591508082111561043957600080fd5b908901906060828c03121561044d57600080fd5b61045561050f565b61045e836101b1565b815260208301358281111561047257600080fd5b61047e8d828601610141565b60208301525060408301358281111561049657600080fd5b6104a28d828601610141565b60408301525080935050505092959891949750929550565b600060208083528351808285015260005b818110156104e7578581018301518582016040015282016104cb565b818111156104f9576000604083870101525b50601f01601f1916929092016040019392505050565b6040516060810167ffffffffffffffff8111828210171561053257610532610569565b60405290565b604051601f8201601f1916810167ffffffffffffffff8111828210171561056157610561610569565b604052919050565b634e487b7160e01b600052604160045260246000fdfea264697066735822122088c676cde9d59b570f4911b54e947a82df34b5d31c1586d7a4785f913ed4fe9164736f6c63430008060033"
-
-// DeployNodeManager deploys a new Ethereum contract, binding an instance of NodeManager to it.
-func DeployNodeManager(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *NodeManager, error) {
-	parsed, err := abi.JSON(strings.NewReader(NodeManagerABI))
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(NodeManagerBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &NodeManager{NodeManagerCaller: NodeManagerCaller{contract: contract}, NodeManagerTransactor: NodeManagerTransactor{contract: contract}, NodeManagerFilterer: NodeManagerFilterer{contract: contract}}, nil
+	"aea0e78b": "nextEpoch()",
+	"d592c8e0": "payouts(address,uint64,uint64)",
+	"fb11136e": "peersLimit()",
+	"faf924cf": "proof()",
+	"6215af84": "proposalActions(bytes)",
+	"31c5eec8": "proposals(uint64)",
+	"bcc12328": "propose(uint64,bytes)",
+	"bb56c490": "proposeGroup(string,uint64,bytes)",
+	"e24a4e3c": "proposeWithActions(uint64,bytes,bytes[])",
+	"5cbcfeaa": "quorumRule()",
+	"44cce719": "registerVrfKey(bytes,bytes,bytes)",
+	"c7c6efc4": "revealVote(uint64,bytes,bytes)",
+	"44026376": "sealVoting(uint64,uint64,uint64)",
+	"7d94792a": "seed()",
+	"4a105a4f": "setFeeSplit(uint64,uint64,address)",
+	"8be10a03": "setGroupQuorumRule(string,uint64,uint64,bool,uint64)",
+	"e950b066": "setPeersLimit(uint64,uint64)",
+	"080d640a": "setQuorumRule(uint64,uint64,bool,uint64)",
+	"a722a953": "signEpochChange(uint64,bytes)",
+	"05f18c70": "submitVrf(uint64,bytes,bytes)",
+	"223b97c6": "sweepExpired(uint64)",
+	"08c16dbb": "vote(uint64,bytes)",
+	"097c0ea6": "voteGroup(string,uint64,bytes)",
+	"37b927a6": "votingSeal(uint64)",
+	"4123453e": "vrfKey(address)",
+	"bfb9b84d": "vrfOutput(uint64,address)",
 }
 
 // NodeManager is an auto generated Go binding around an Ethereum contract.
@@ -233,230 +283,2821 @@ func (_NodeManager *NodeManagerCallerRaw) Call(opts *bind.CallOpts, result *[]in
 	return _NodeManager.Contract.contract.Call(opts, result, method, params...)
 }
 
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_NodeManager *NodeManagerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _NodeManager.Contract.contract.Transfer(opts)
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NodeManager *NodeManagerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NodeManager.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NodeManager *NodeManagerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NodeManager.Contract.contract.Transact(opts, method, params...)
+}
+
+// Epoch is a free data retrieval call binding the contract method 0x900cf0cf.
+//
+// Solidity: function epoch() view returns(bytes Epoch)
+func (_NodeManager *NodeManagerCaller) Epoch(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "epoch")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Epoch is a free data retrieval call binding the contract method 0x900cf0cf.
+//
+// Solidity: function epoch() view returns(bytes Epoch)
+func (_NodeManager *NodeManagerSession) Epoch() ([]byte, error) {
+	return _NodeManager.Contract.Epoch(&_NodeManager.CallOpts)
+}
+
+// Epoch is a free data retrieval call binding the contract method 0x900cf0cf.
+//
+// Solidity: function epoch() view returns(bytes Epoch)
+func (_NodeManager *NodeManagerCallerSession) Epoch() ([]byte, error) {
+	return _NodeManager.Contract.Epoch(&_NodeManager.CallOpts)
+}
+
+// EpochChangeSigns is a free data retrieval call binding the contract method 0x7429700c.
+//
+// Solidity: function epochChangeSigns(uint64 EpochID) view returns(bytes Digest, bytes[] Signatures)
+func (_NodeManager *NodeManagerCaller) EpochChangeSigns(opts *bind.CallOpts, EpochID uint64) (struct {
+	Digest     []byte
+	Signatures [][]byte
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "epochChangeSigns", EpochID)
+
+	outstruct := new(struct {
+		Digest     []byte
+		Signatures [][]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Digest = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	outstruct.Signatures = *abi.ConvertType(out[1], new([][]byte)).(*[][]byte)
+
+	return *outstruct, err
+
+}
+
+// EpochChangeSigns is a free data retrieval call binding the contract method 0x7429700c.
+//
+// Solidity: function epochChangeSigns(uint64 EpochID) view returns(bytes Digest, bytes[] Signatures)
+func (_NodeManager *NodeManagerSession) EpochChangeSigns(EpochID uint64) (struct {
+	Digest     []byte
+	Signatures [][]byte
+}, error) {
+	return _NodeManager.Contract.EpochChangeSigns(&_NodeManager.CallOpts, EpochID)
+}
+
+// EpochChangeSigns is a free data retrieval call binding the contract method 0x7429700c.
+//
+// Solidity: function epochChangeSigns(uint64 EpochID) view returns(bytes Digest, bytes[] Signatures)
+func (_NodeManager *NodeManagerCallerSession) EpochChangeSigns(EpochID uint64) (struct {
+	Digest     []byte
+	Signatures [][]byte
+}, error) {
+	return _NodeManager.Contract.EpochChangeSigns(&_NodeManager.CallOpts, EpochID)
+}
+
+// EpochPeers is a free data retrieval call binding the contract method 0x01eaa31a.
+//
+// Solidity: function epochPeers(bytes Hash, uint64 Start, uint64 Limit) view returns(uint64 Total, bytes Peers)
+func (_NodeManager *NodeManagerCaller) EpochPeers(opts *bind.CallOpts, Hash []byte, Start uint64, Limit uint64) (struct {
+	Total uint64
+	Peers []byte
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "epochPeers", Hash, Start, Limit)
+
+	outstruct := new(struct {
+		Total uint64
+		Peers []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Total = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Peers = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// EpochPeers is a free data retrieval call binding the contract method 0x01eaa31a.
+//
+// Solidity: function epochPeers(bytes Hash, uint64 Start, uint64 Limit) view returns(uint64 Total, bytes Peers)
+func (_NodeManager *NodeManagerSession) EpochPeers(Hash []byte, Start uint64, Limit uint64) (struct {
+	Total uint64
+	Peers []byte
+}, error) {
+	return _NodeManager.Contract.EpochPeers(&_NodeManager.CallOpts, Hash, Start, Limit)
+}
+
+// EpochPeers is a free data retrieval call binding the contract method 0x01eaa31a.
+//
+// Solidity: function epochPeers(bytes Hash, uint64 Start, uint64 Limit) view returns(uint64 Total, bytes Peers)
+func (_NodeManager *NodeManagerCallerSession) EpochPeers(Hash []byte, Start uint64, Limit uint64) (struct {
+	Total uint64
+	Peers []byte
+}, error) {
+	return _NodeManager.Contract.EpochPeers(&_NodeManager.CallOpts, Hash, Start, Limit)
+}
+
+// EpochSeed is a free data retrieval call binding the contract method 0xb3564b8b.
+//
+// Solidity: function epochSeed(uint64 EpochID) view returns(bytes Seed)
+func (_NodeManager *NodeManagerCaller) EpochSeed(opts *bind.CallOpts, EpochID uint64) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "epochSeed", EpochID)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// EpochSeed is a free data retrieval call binding the contract method 0xb3564b8b.
+//
+// Solidity: function epochSeed(uint64 EpochID) view returns(bytes Seed)
+func (_NodeManager *NodeManagerSession) EpochSeed(EpochID uint64) ([]byte, error) {
+	return _NodeManager.Contract.EpochSeed(&_NodeManager.CallOpts, EpochID)
+}
+
+// EpochSeed is a free data retrieval call binding the contract method 0xb3564b8b.
+//
+// Solidity: function epochSeed(uint64 EpochID) view returns(bytes Seed)
+func (_NodeManager *NodeManagerCallerSession) EpochSeed(EpochID uint64) ([]byte, error) {
+	return _NodeManager.Contract.EpochSeed(&_NodeManager.CallOpts, EpochID)
+}
+
+// FeeSplit is a free data retrieval call binding the contract method 0x6373ea69.
+//
+// Solidity: function feeSplit() view returns(uint64 BurnRate, uint64 TreasuryRate, address Treasury)
+func (_NodeManager *NodeManagerCaller) FeeSplit(opts *bind.CallOpts) (struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "feeSplit")
+
+	outstruct := new(struct {
+		BurnRate     uint64
+		TreasuryRate uint64
+		Treasury     common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.BurnRate = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.TreasuryRate = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.Treasury = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// FeeSplit is a free data retrieval call binding the contract method 0x6373ea69.
+//
+// Solidity: function feeSplit() view returns(uint64 BurnRate, uint64 TreasuryRate, address Treasury)
+func (_NodeManager *NodeManagerSession) FeeSplit() (struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+}, error) {
+	return _NodeManager.Contract.FeeSplit(&_NodeManager.CallOpts)
+}
+
+// FeeSplit is a free data retrieval call binding the contract method 0x6373ea69.
+//
+// Solidity: function feeSplit() view returns(uint64 BurnRate, uint64 TreasuryRate, address Treasury)
+func (_NodeManager *NodeManagerCallerSession) FeeSplit() (struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+}, error) {
+	return _NodeManager.Contract.FeeSplit(&_NodeManager.CallOpts)
+}
+
+// GroupEpoch is a free data retrieval call binding the contract method 0x76bc595a.
+//
+// Solidity: function groupEpoch(string Name) view returns(bytes Epoch)
+func (_NodeManager *NodeManagerCaller) GroupEpoch(opts *bind.CallOpts, Name string) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "groupEpoch", Name)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GroupEpoch is a free data retrieval call binding the contract method 0x76bc595a.
+//
+// Solidity: function groupEpoch(string Name) view returns(bytes Epoch)
+func (_NodeManager *NodeManagerSession) GroupEpoch(Name string) ([]byte, error) {
+	return _NodeManager.Contract.GroupEpoch(&_NodeManager.CallOpts, Name)
+}
+
+// GroupEpoch is a free data retrieval call binding the contract method 0x76bc595a.
+//
+// Solidity: function groupEpoch(string Name) view returns(bytes Epoch)
+func (_NodeManager *NodeManagerCallerSession) GroupEpoch(Name string) ([]byte, error) {
+	return _NodeManager.Contract.GroupEpoch(&_NodeManager.CallOpts, Name)
+}
+
+// GroupProof is a free data retrieval call binding the contract method 0x2883bb2f.
+//
+// Solidity: function groupProof(string Name, uint64 EpochID) view returns(bytes Hash)
+func (_NodeManager *NodeManagerCaller) GroupProof(opts *bind.CallOpts, Name string, EpochID uint64) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "groupProof", Name, EpochID)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GroupProof is a free data retrieval call binding the contract method 0x2883bb2f.
+//
+// Solidity: function groupProof(string Name, uint64 EpochID) view returns(bytes Hash)
+func (_NodeManager *NodeManagerSession) GroupProof(Name string, EpochID uint64) ([]byte, error) {
+	return _NodeManager.Contract.GroupProof(&_NodeManager.CallOpts, Name, EpochID)
+}
+
+// GroupProof is a free data retrieval call binding the contract method 0x2883bb2f.
+//
+// Solidity: function groupProof(string Name, uint64 EpochID) view returns(bytes Hash)
+func (_NodeManager *NodeManagerCallerSession) GroupProof(Name string, EpochID uint64) ([]byte, error) {
+	return _NodeManager.Contract.GroupProof(&_NodeManager.CallOpts, Name, EpochID)
+}
+
+// GroupQuorumRule is a free data retrieval call binding the contract method 0x544d9f1f.
+//
+// Solidity: function groupQuorumRule(string Name) view returns(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerCaller) GroupQuorumRule(opts *bind.CallOpts, Name string) (struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "groupQuorumRule", Name)
+
+	outstruct := new(struct {
+		Numerator   uint64
+		Denominator uint64
+		Strict      bool
+		Threshold   uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Numerator = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Denominator = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.Strict = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.Threshold = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GroupQuorumRule is a free data retrieval call binding the contract method 0x544d9f1f.
+//
+// Solidity: function groupQuorumRule(string Name) view returns(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerSession) GroupQuorumRule(Name string) (struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}, error) {
+	return _NodeManager.Contract.GroupQuorumRule(&_NodeManager.CallOpts, Name)
+}
+
+// GroupQuorumRule is a free data retrieval call binding the contract method 0x544d9f1f.
+//
+// Solidity: function groupQuorumRule(string Name) view returns(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerCallerSession) GroupQuorumRule(Name string) (struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}, error) {
+	return _NodeManager.Contract.GroupQuorumRule(&_NodeManager.CallOpts, Name)
+}
+
+// Groups is a free data retrieval call binding the contract method 0x5bf89d9e.
+//
+// Solidity: function groups() view returns(string[] Names)
+func (_NodeManager *NodeManagerCaller) Groups(opts *bind.CallOpts) ([]string, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "groups")
+
+	if err != nil {
+		return *new([]string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]string)).(*[]string)
+
+	return out0, err
+
+}
+
+// Groups is a free data retrieval call binding the contract method 0x5bf89d9e.
+//
+// Solidity: function groups() view returns(string[] Names)
+func (_NodeManager *NodeManagerSession) Groups() ([]string, error) {
+	return _NodeManager.Contract.Groups(&_NodeManager.CallOpts)
+}
+
+// Groups is a free data retrieval call binding the contract method 0x5bf89d9e.
+//
+// Solidity: function groups() view returns(string[] Names)
+func (_NodeManager *NodeManagerCallerSession) Groups() ([]string, error) {
+	return _NodeManager.Contract.Groups(&_NodeManager.CallOpts)
+}
+
+// Misconduct is a free data retrieval call binding the contract method 0x1ff88604.
+//
+// Solidity: function misconduct(address Validator, uint64 StartEpoch, uint64 EndEpoch) view returns(bytes Reports)
+func (_NodeManager *NodeManagerCaller) Misconduct(opts *bind.CallOpts, Validator common.Address, StartEpoch uint64, EndEpoch uint64) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "misconduct", Validator, StartEpoch, EndEpoch)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Misconduct is a free data retrieval call binding the contract method 0x1ff88604.
+//
+// Solidity: function misconduct(address Validator, uint64 StartEpoch, uint64 EndEpoch) view returns(bytes Reports)
+func (_NodeManager *NodeManagerSession) Misconduct(Validator common.Address, StartEpoch uint64, EndEpoch uint64) ([]byte, error) {
+	return _NodeManager.Contract.Misconduct(&_NodeManager.CallOpts, Validator, StartEpoch, EndEpoch)
+}
+
+// Misconduct is a free data retrieval call binding the contract method 0x1ff88604.
+//
+// Solidity: function misconduct(address Validator, uint64 StartEpoch, uint64 EndEpoch) view returns(bytes Reports)
+func (_NodeManager *NodeManagerCallerSession) Misconduct(Validator common.Address, StartEpoch uint64, EndEpoch uint64) ([]byte, error) {
+	return _NodeManager.Contract.Misconduct(&_NodeManager.CallOpts, Validator, StartEpoch, EndEpoch)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_NodeManager *NodeManagerCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_NodeManager *NodeManagerSession) Name() (string, error) {
+	return _NodeManager.Contract.Name(&_NodeManager.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_NodeManager *NodeManagerCallerSession) Name() (string, error) {
+	return _NodeManager.Contract.Name(&_NodeManager.CallOpts)
+}
+
+// NextEpoch is a free data retrieval call binding the contract method 0xaea0e78b.
+//
+// Solidity: function nextEpoch() view returns(bytes Epoch)
+func (_NodeManager *NodeManagerCaller) NextEpoch(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "nextEpoch")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// NextEpoch is a free data retrieval call binding the contract method 0xaea0e78b.
+//
+// Solidity: function nextEpoch() view returns(bytes Epoch)
+func (_NodeManager *NodeManagerSession) NextEpoch() ([]byte, error) {
+	return _NodeManager.Contract.NextEpoch(&_NodeManager.CallOpts)
+}
+
+// NextEpoch is a free data retrieval call binding the contract method 0xaea0e78b.
+//
+// Solidity: function nextEpoch() view returns(bytes Epoch)
+func (_NodeManager *NodeManagerCallerSession) NextEpoch() ([]byte, error) {
+	return _NodeManager.Contract.NextEpoch(&_NodeManager.CallOpts)
+}
+
+// Payouts is a free data retrieval call binding the contract method 0xd592c8e0.
+//
+// Solidity: function payouts(address Validator, uint64 StartEpoch, uint64 EndEpoch) view returns(bytes Payouts)
+func (_NodeManager *NodeManagerCaller) Payouts(opts *bind.CallOpts, Validator common.Address, StartEpoch uint64, EndEpoch uint64) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "payouts", Validator, StartEpoch, EndEpoch)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Payouts is a free data retrieval call binding the contract method 0xd592c8e0.
+//
+// Solidity: function payouts(address Validator, uint64 StartEpoch, uint64 EndEpoch) view returns(bytes Payouts)
+func (_NodeManager *NodeManagerSession) Payouts(Validator common.Address, StartEpoch uint64, EndEpoch uint64) ([]byte, error) {
+	return _NodeManager.Contract.Payouts(&_NodeManager.CallOpts, Validator, StartEpoch, EndEpoch)
+}
+
+// Payouts is a free data retrieval call binding the contract method 0xd592c8e0.
+//
+// Solidity: function payouts(address Validator, uint64 StartEpoch, uint64 EndEpoch) view returns(bytes Payouts)
+func (_NodeManager *NodeManagerCallerSession) Payouts(Validator common.Address, StartEpoch uint64, EndEpoch uint64) ([]byte, error) {
+	return _NodeManager.Contract.Payouts(&_NodeManager.CallOpts, Validator, StartEpoch, EndEpoch)
+}
+
+// PeersLimit is a free data retrieval call binding the contract method 0xfb11136e.
+//
+// Solidity: function peersLimit() view returns(uint64 Target, uint64 MaxChange)
+func (_NodeManager *NodeManagerCaller) PeersLimit(opts *bind.CallOpts) (struct {
+	Target    uint64
+	MaxChange uint64
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "peersLimit")
+
+	outstruct := new(struct {
+		Target    uint64
+		MaxChange uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Target = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.MaxChange = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// PeersLimit is a free data retrieval call binding the contract method 0xfb11136e.
+//
+// Solidity: function peersLimit() view returns(uint64 Target, uint64 MaxChange)
+func (_NodeManager *NodeManagerSession) PeersLimit() (struct {
+	Target    uint64
+	MaxChange uint64
+}, error) {
+	return _NodeManager.Contract.PeersLimit(&_NodeManager.CallOpts)
+}
+
+// PeersLimit is a free data retrieval call binding the contract method 0xfb11136e.
+//
+// Solidity: function peersLimit() view returns(uint64 Target, uint64 MaxChange)
+func (_NodeManager *NodeManagerCallerSession) PeersLimit() (struct {
+	Target    uint64
+	MaxChange uint64
+}, error) {
+	return _NodeManager.Contract.PeersLimit(&_NodeManager.CallOpts)
+}
+
+// Proof is a free data retrieval call binding the contract method 0xfaf924cf.
+//
+// Solidity: function proof() view returns(bytes Hash)
+func (_NodeManager *NodeManagerCaller) Proof(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "proof")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Proof is a free data retrieval call binding the contract method 0xfaf924cf.
+//
+// Solidity: function proof() view returns(bytes Hash)
+func (_NodeManager *NodeManagerSession) Proof() ([]byte, error) {
+	return _NodeManager.Contract.Proof(&_NodeManager.CallOpts)
+}
+
+// Proof is a free data retrieval call binding the contract method 0xfaf924cf.
+//
+// Solidity: function proof() view returns(bytes Hash)
+func (_NodeManager *NodeManagerCallerSession) Proof() ([]byte, error) {
+	return _NodeManager.Contract.Proof(&_NodeManager.CallOpts)
+}
+
+// ProposalActions is a free data retrieval call binding the contract method 0x6215af84.
+//
+// Solidity: function proposalActions(bytes Hash) view returns(bytes[] Actions)
+func (_NodeManager *NodeManagerCaller) ProposalActions(opts *bind.CallOpts, Hash []byte) ([][]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "proposalActions", Hash)
+
+	if err != nil {
+		return *new([][]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][]byte)).(*[][]byte)
+
+	return out0, err
+
+}
+
+// ProposalActions is a free data retrieval call binding the contract method 0x6215af84.
+//
+// Solidity: function proposalActions(bytes Hash) view returns(bytes[] Actions)
+func (_NodeManager *NodeManagerSession) ProposalActions(Hash []byte) ([][]byte, error) {
+	return _NodeManager.Contract.ProposalActions(&_NodeManager.CallOpts, Hash)
+}
+
+// ProposalActions is a free data retrieval call binding the contract method 0x6215af84.
+//
+// Solidity: function proposalActions(bytes Hash) view returns(bytes[] Actions)
+func (_NodeManager *NodeManagerCallerSession) ProposalActions(Hash []byte) ([][]byte, error) {
+	return _NodeManager.Contract.ProposalActions(&_NodeManager.CallOpts, Hash)
+}
+
+// Proposals is a free data retrieval call binding the contract method 0x31c5eec8.
+//
+// Solidity: function proposals(uint64 EpochID) view returns(bytes Proposals)
+func (_NodeManager *NodeManagerCaller) Proposals(opts *bind.CallOpts, EpochID uint64) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "proposals", EpochID)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Proposals is a free data retrieval call binding the contract method 0x31c5eec8.
+//
+// Solidity: function proposals(uint64 EpochID) view returns(bytes Proposals)
+func (_NodeManager *NodeManagerSession) Proposals(EpochID uint64) ([]byte, error) {
+	return _NodeManager.Contract.Proposals(&_NodeManager.CallOpts, EpochID)
+}
+
+// Proposals is a free data retrieval call binding the contract method 0x31c5eec8.
+//
+// Solidity: function proposals(uint64 EpochID) view returns(bytes Proposals)
+func (_NodeManager *NodeManagerCallerSession) Proposals(EpochID uint64) ([]byte, error) {
+	return _NodeManager.Contract.Proposals(&_NodeManager.CallOpts, EpochID)
+}
+
+// QuorumRule is a free data retrieval call binding the contract method 0x5cbcfeaa.
+//
+// Solidity: function quorumRule() view returns(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerCaller) QuorumRule(opts *bind.CallOpts) (struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "quorumRule")
+
+	outstruct := new(struct {
+		Numerator   uint64
+		Denominator uint64
+		Strict      bool
+		Threshold   uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Numerator = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Denominator = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.Strict = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.Threshold = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// QuorumRule is a free data retrieval call binding the contract method 0x5cbcfeaa.
+//
+// Solidity: function quorumRule() view returns(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerSession) QuorumRule() (struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}, error) {
+	return _NodeManager.Contract.QuorumRule(&_NodeManager.CallOpts)
+}
+
+// QuorumRule is a free data retrieval call binding the contract method 0x5cbcfeaa.
+//
+// Solidity: function quorumRule() view returns(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerCallerSession) QuorumRule() (struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}, error) {
+	return _NodeManager.Contract.QuorumRule(&_NodeManager.CallOpts)
+}
+
+// Seed is a free data retrieval call binding the contract method 0x7d94792a.
+//
+// Solidity: function seed() view returns(bytes Seed)
+func (_NodeManager *NodeManagerCaller) Seed(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "seed")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Seed is a free data retrieval call binding the contract method 0x7d94792a.
+//
+// Solidity: function seed() view returns(bytes Seed)
+func (_NodeManager *NodeManagerSession) Seed() ([]byte, error) {
+	return _NodeManager.Contract.Seed(&_NodeManager.CallOpts)
+}
+
+// Seed is a free data retrieval call binding the contract method 0x7d94792a.
+//
+// Solidity: function seed() view returns(bytes Seed)
+func (_NodeManager *NodeManagerCallerSession) Seed() ([]byte, error) {
+	return _NodeManager.Contract.Seed(&_NodeManager.CallOpts)
+}
+
+// VotingSeal is a free data retrieval call binding the contract method 0x37b927a6.
+//
+// Solidity: function votingSeal(uint64 EpochID) view returns(uint64 CommitEnd, uint64 RevealEnd, bool Tallied)
+func (_NodeManager *NodeManagerCaller) VotingSeal(opts *bind.CallOpts, EpochID uint64) (struct {
+	CommitEnd uint64
+	RevealEnd uint64
+	Tallied   bool
+}, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "votingSeal", EpochID)
+
+	outstruct := new(struct {
+		CommitEnd uint64
+		RevealEnd uint64
+		Tallied   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.CommitEnd = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.RevealEnd = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.Tallied = *abi.ConvertType(out[2], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// VotingSeal is a free data retrieval call binding the contract method 0x37b927a6.
+//
+// Solidity: function votingSeal(uint64 EpochID) view returns(uint64 CommitEnd, uint64 RevealEnd, bool Tallied)
+func (_NodeManager *NodeManagerSession) VotingSeal(EpochID uint64) (struct {
+	CommitEnd uint64
+	RevealEnd uint64
+	Tallied   bool
+}, error) {
+	return _NodeManager.Contract.VotingSeal(&_NodeManager.CallOpts, EpochID)
+}
+
+// VotingSeal is a free data retrieval call binding the contract method 0x37b927a6.
+//
+// Solidity: function votingSeal(uint64 EpochID) view returns(uint64 CommitEnd, uint64 RevealEnd, bool Tallied)
+func (_NodeManager *NodeManagerCallerSession) VotingSeal(EpochID uint64) (struct {
+	CommitEnd uint64
+	RevealEnd uint64
+	Tallied   bool
+}, error) {
+	return _NodeManager.Contract.VotingSeal(&_NodeManager.CallOpts, EpochID)
+}
+
+// VrfKey is a free data retrieval call binding the contract method 0x4123453e.
+//
+// Solidity: function vrfKey(address Validator) view returns(bytes PubKey)
+func (_NodeManager *NodeManagerCaller) VrfKey(opts *bind.CallOpts, Validator common.Address) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "vrfKey", Validator)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// VrfKey is a free data retrieval call binding the contract method 0x4123453e.
+//
+// Solidity: function vrfKey(address Validator) view returns(bytes PubKey)
+func (_NodeManager *NodeManagerSession) VrfKey(Validator common.Address) ([]byte, error) {
+	return _NodeManager.Contract.VrfKey(&_NodeManager.CallOpts, Validator)
+}
+
+// VrfKey is a free data retrieval call binding the contract method 0x4123453e.
+//
+// Solidity: function vrfKey(address Validator) view returns(bytes PubKey)
+func (_NodeManager *NodeManagerCallerSession) VrfKey(Validator common.Address) ([]byte, error) {
+	return _NodeManager.Contract.VrfKey(&_NodeManager.CallOpts, Validator)
+}
+
+// VrfOutput is a free data retrieval call binding the contract method 0xbfb9b84d.
+//
+// Solidity: function vrfOutput(uint64 EpochID, address Validator) view returns(bytes Output)
+func (_NodeManager *NodeManagerCaller) VrfOutput(opts *bind.CallOpts, EpochID uint64, Validator common.Address) ([]byte, error) {
+	var out []interface{}
+	err := _NodeManager.contract.Call(opts, &out, "vrfOutput", EpochID, Validator)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// VrfOutput is a free data retrieval call binding the contract method 0xbfb9b84d.
+//
+// Solidity: function vrfOutput(uint64 EpochID, address Validator) view returns(bytes Output)
+func (_NodeManager *NodeManagerSession) VrfOutput(EpochID uint64, Validator common.Address) ([]byte, error) {
+	return _NodeManager.Contract.VrfOutput(&_NodeManager.CallOpts, EpochID, Validator)
+}
+
+// VrfOutput is a free data retrieval call binding the contract method 0xbfb9b84d.
+//
+// Solidity: function vrfOutput(uint64 EpochID, address Validator) view returns(bytes Output)
+func (_NodeManager *NodeManagerCallerSession) VrfOutput(EpochID uint64, Validator common.Address) ([]byte, error) {
+	return _NodeManager.Contract.VrfOutput(&_NodeManager.CallOpts, EpochID, Validator)
+}
+
+// CommitVote is a paid mutator transaction binding the contract method 0x4486bc09.
+//
+// Solidity: function commitVote(uint64 EpochID, bytes Commitment) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) CommitVote(opts *bind.TransactOpts, EpochID uint64, Commitment []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "commitVote", EpochID, Commitment)
+}
+
+// CommitVote is a paid mutator transaction binding the contract method 0x4486bc09.
+//
+// Solidity: function commitVote(uint64 EpochID, bytes Commitment) returns(bool Success)
+func (_NodeManager *NodeManagerSession) CommitVote(EpochID uint64, Commitment []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.CommitVote(&_NodeManager.TransactOpts, EpochID, Commitment)
+}
+
+// CommitVote is a paid mutator transaction binding the contract method 0x4486bc09.
+//
+// Solidity: function commitVote(uint64 EpochID, bytes Commitment) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) CommitVote(EpochID uint64, Commitment []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.CommitVote(&_NodeManager.TransactOpts, EpochID, Commitment)
+}
+
+// Eject is a paid mutator transaction binding the contract method 0xf559ab66.
+//
+// Solidity: function eject(address Validator, bytes Evidence) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) Eject(opts *bind.TransactOpts, Validator common.Address, Evidence []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "eject", Validator, Evidence)
+}
+
+// Eject is a paid mutator transaction binding the contract method 0xf559ab66.
+//
+// Solidity: function eject(address Validator, bytes Evidence) returns(bool Success)
+func (_NodeManager *NodeManagerSession) Eject(Validator common.Address, Evidence []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.Eject(&_NodeManager.TransactOpts, Validator, Evidence)
+}
+
+// Eject is a paid mutator transaction binding the contract method 0xf559ab66.
+//
+// Solidity: function eject(address Validator, bytes Evidence) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) Eject(Validator common.Address, Evidence []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.Eject(&_NodeManager.TransactOpts, Validator, Evidence)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0xbcc12328.
+//
+// Solidity: function propose(uint64 StartHeight, bytes Peers) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) Propose(opts *bind.TransactOpts, StartHeight uint64, Peers []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "propose", StartHeight, Peers)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0xbcc12328.
+//
+// Solidity: function propose(uint64 StartHeight, bytes Peers) returns(bool Success)
+func (_NodeManager *NodeManagerSession) Propose(StartHeight uint64, Peers []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.Propose(&_NodeManager.TransactOpts, StartHeight, Peers)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0xbcc12328.
+//
+// Solidity: function propose(uint64 StartHeight, bytes Peers) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) Propose(StartHeight uint64, Peers []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.Propose(&_NodeManager.TransactOpts, StartHeight, Peers)
+}
+
+// ProposeGroup is a paid mutator transaction binding the contract method 0xbb56c490.
+//
+// Solidity: function proposeGroup(string Name, uint64 StartHeight, bytes Peers) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) ProposeGroup(opts *bind.TransactOpts, Name string, StartHeight uint64, Peers []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "proposeGroup", Name, StartHeight, Peers)
+}
+
+// ProposeGroup is a paid mutator transaction binding the contract method 0xbb56c490.
+//
+// Solidity: function proposeGroup(string Name, uint64 StartHeight, bytes Peers) returns(bool Success)
+func (_NodeManager *NodeManagerSession) ProposeGroup(Name string, StartHeight uint64, Peers []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.ProposeGroup(&_NodeManager.TransactOpts, Name, StartHeight, Peers)
+}
+
+// ProposeGroup is a paid mutator transaction binding the contract method 0xbb56c490.
+//
+// Solidity: function proposeGroup(string Name, uint64 StartHeight, bytes Peers) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) ProposeGroup(Name string, StartHeight uint64, Peers []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.ProposeGroup(&_NodeManager.TransactOpts, Name, StartHeight, Peers)
+}
+
+// ProposeWithActions is a paid mutator transaction binding the contract method 0xe24a4e3c.
+//
+// Solidity: function proposeWithActions(uint64 StartHeight, bytes Peers, bytes[] Actions) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) ProposeWithActions(opts *bind.TransactOpts, StartHeight uint64, Peers []byte, Actions [][]byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "proposeWithActions", StartHeight, Peers, Actions)
+}
+
+// ProposeWithActions is a paid mutator transaction binding the contract method 0xe24a4e3c.
+//
+// Solidity: function proposeWithActions(uint64 StartHeight, bytes Peers, bytes[] Actions) returns(bool Success)
+func (_NodeManager *NodeManagerSession) ProposeWithActions(StartHeight uint64, Peers []byte, Actions [][]byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.ProposeWithActions(&_NodeManager.TransactOpts, StartHeight, Peers, Actions)
+}
+
+// ProposeWithActions is a paid mutator transaction binding the contract method 0xe24a4e3c.
+//
+// Solidity: function proposeWithActions(uint64 StartHeight, bytes Peers, bytes[] Actions) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) ProposeWithActions(StartHeight uint64, Peers []byte, Actions [][]byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.ProposeWithActions(&_NodeManager.TransactOpts, StartHeight, Peers, Actions)
+}
+
+// RegisterVrfKey is a paid mutator transaction binding the contract method 0x44cce719.
+//
+// Solidity: function registerVrfKey(bytes PubKey, bytes Output, bytes Proof) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) RegisterVrfKey(opts *bind.TransactOpts, PubKey []byte, Output []byte, Proof []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "registerVrfKey", PubKey, Output, Proof)
+}
+
+// RegisterVrfKey is a paid mutator transaction binding the contract method 0x44cce719.
+//
+// Solidity: function registerVrfKey(bytes PubKey, bytes Output, bytes Proof) returns(bool Success)
+func (_NodeManager *NodeManagerSession) RegisterVrfKey(PubKey []byte, Output []byte, Proof []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.RegisterVrfKey(&_NodeManager.TransactOpts, PubKey, Output, Proof)
+}
+
+// RegisterVrfKey is a paid mutator transaction binding the contract method 0x44cce719.
+//
+// Solidity: function registerVrfKey(bytes PubKey, bytes Output, bytes Proof) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) RegisterVrfKey(PubKey []byte, Output []byte, Proof []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.RegisterVrfKey(&_NodeManager.TransactOpts, PubKey, Output, Proof)
+}
+
+// RevealVote is a paid mutator transaction binding the contract method 0xc7c6efc4.
+//
+// Solidity: function revealVote(uint64 EpochID, bytes Hash, bytes Salt) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) RevealVote(opts *bind.TransactOpts, EpochID uint64, Hash []byte, Salt []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "revealVote", EpochID, Hash, Salt)
+}
+
+// RevealVote is a paid mutator transaction binding the contract method 0xc7c6efc4.
+//
+// Solidity: function revealVote(uint64 EpochID, bytes Hash, bytes Salt) returns(bool Success)
+func (_NodeManager *NodeManagerSession) RevealVote(EpochID uint64, Hash []byte, Salt []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.RevealVote(&_NodeManager.TransactOpts, EpochID, Hash, Salt)
+}
+
+// RevealVote is a paid mutator transaction binding the contract method 0xc7c6efc4.
+//
+// Solidity: function revealVote(uint64 EpochID, bytes Hash, bytes Salt) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) RevealVote(EpochID uint64, Hash []byte, Salt []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.RevealVote(&_NodeManager.TransactOpts, EpochID, Hash, Salt)
+}
+
+// SealVoting is a paid mutator transaction binding the contract method 0x44026376.
+//
+// Solidity: function sealVoting(uint64 EpochID, uint64 CommitPeriod, uint64 RevealPeriod) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SealVoting(opts *bind.TransactOpts, EpochID uint64, CommitPeriod uint64, RevealPeriod uint64) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "sealVoting", EpochID, CommitPeriod, RevealPeriod)
+}
+
+// SealVoting is a paid mutator transaction binding the contract method 0x44026376.
+//
+// Solidity: function sealVoting(uint64 EpochID, uint64 CommitPeriod, uint64 RevealPeriod) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SealVoting(EpochID uint64, CommitPeriod uint64, RevealPeriod uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SealVoting(&_NodeManager.TransactOpts, EpochID, CommitPeriod, RevealPeriod)
+}
+
+// SealVoting is a paid mutator transaction binding the contract method 0x44026376.
+//
+// Solidity: function sealVoting(uint64 EpochID, uint64 CommitPeriod, uint64 RevealPeriod) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SealVoting(EpochID uint64, CommitPeriod uint64, RevealPeriod uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SealVoting(&_NodeManager.TransactOpts, EpochID, CommitPeriod, RevealPeriod)
+}
+
+// SetFeeSplit is a paid mutator transaction binding the contract method 0x4a105a4f.
+//
+// Solidity: function setFeeSplit(uint64 BurnRate, uint64 TreasuryRate, address Treasury) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SetFeeSplit(opts *bind.TransactOpts, BurnRate uint64, TreasuryRate uint64, Treasury common.Address) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "setFeeSplit", BurnRate, TreasuryRate, Treasury)
+}
+
+// SetFeeSplit is a paid mutator transaction binding the contract method 0x4a105a4f.
+//
+// Solidity: function setFeeSplit(uint64 BurnRate, uint64 TreasuryRate, address Treasury) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SetFeeSplit(BurnRate uint64, TreasuryRate uint64, Treasury common.Address) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetFeeSplit(&_NodeManager.TransactOpts, BurnRate, TreasuryRate, Treasury)
+}
+
+// SetFeeSplit is a paid mutator transaction binding the contract method 0x4a105a4f.
+//
+// Solidity: function setFeeSplit(uint64 BurnRate, uint64 TreasuryRate, address Treasury) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SetFeeSplit(BurnRate uint64, TreasuryRate uint64, Treasury common.Address) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetFeeSplit(&_NodeManager.TransactOpts, BurnRate, TreasuryRate, Treasury)
+}
+
+// SetGroupQuorumRule is a paid mutator transaction binding the contract method 0x8be10a03.
+//
+// Solidity: function setGroupQuorumRule(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SetGroupQuorumRule(opts *bind.TransactOpts, Name string, Numerator uint64, Denominator uint64, Strict bool, Threshold uint64) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "setGroupQuorumRule", Name, Numerator, Denominator, Strict, Threshold)
+}
+
+// SetGroupQuorumRule is a paid mutator transaction binding the contract method 0x8be10a03.
+//
+// Solidity: function setGroupQuorumRule(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SetGroupQuorumRule(Name string, Numerator uint64, Denominator uint64, Strict bool, Threshold uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetGroupQuorumRule(&_NodeManager.TransactOpts, Name, Numerator, Denominator, Strict, Threshold)
+}
+
+// SetGroupQuorumRule is a paid mutator transaction binding the contract method 0x8be10a03.
+//
+// Solidity: function setGroupQuorumRule(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SetGroupQuorumRule(Name string, Numerator uint64, Denominator uint64, Strict bool, Threshold uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetGroupQuorumRule(&_NodeManager.TransactOpts, Name, Numerator, Denominator, Strict, Threshold)
+}
+
+// SetPeersLimit is a paid mutator transaction binding the contract method 0xe950b066.
+//
+// Solidity: function setPeersLimit(uint64 Target, uint64 MaxChange) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SetPeersLimit(opts *bind.TransactOpts, Target uint64, MaxChange uint64) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "setPeersLimit", Target, MaxChange)
+}
+
+// SetPeersLimit is a paid mutator transaction binding the contract method 0xe950b066.
+//
+// Solidity: function setPeersLimit(uint64 Target, uint64 MaxChange) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SetPeersLimit(Target uint64, MaxChange uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetPeersLimit(&_NodeManager.TransactOpts, Target, MaxChange)
+}
+
+// SetPeersLimit is a paid mutator transaction binding the contract method 0xe950b066.
+//
+// Solidity: function setPeersLimit(uint64 Target, uint64 MaxChange) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SetPeersLimit(Target uint64, MaxChange uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetPeersLimit(&_NodeManager.TransactOpts, Target, MaxChange)
+}
+
+// SetQuorumRule is a paid mutator transaction binding the contract method 0x080d640a.
+//
+// Solidity: function setQuorumRule(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SetQuorumRule(opts *bind.TransactOpts, Numerator uint64, Denominator uint64, Strict bool, Threshold uint64) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "setQuorumRule", Numerator, Denominator, Strict, Threshold)
+}
+
+// SetQuorumRule is a paid mutator transaction binding the contract method 0x080d640a.
+//
+// Solidity: function setQuorumRule(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SetQuorumRule(Numerator uint64, Denominator uint64, Strict bool, Threshold uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetQuorumRule(&_NodeManager.TransactOpts, Numerator, Denominator, Strict, Threshold)
+}
+
+// SetQuorumRule is a paid mutator transaction binding the contract method 0x080d640a.
+//
+// Solidity: function setQuorumRule(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SetQuorumRule(Numerator uint64, Denominator uint64, Strict bool, Threshold uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetQuorumRule(&_NodeManager.TransactOpts, Numerator, Denominator, Strict, Threshold)
+}
+
+// SignEpochChange is a paid mutator transaction binding the contract method 0xa722a953.
+//
+// Solidity: function signEpochChange(uint64 EpochID, bytes Signature) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SignEpochChange(opts *bind.TransactOpts, EpochID uint64, Signature []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "signEpochChange", EpochID, Signature)
+}
+
+// SignEpochChange is a paid mutator transaction binding the contract method 0xa722a953.
+//
+// Solidity: function signEpochChange(uint64 EpochID, bytes Signature) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SignEpochChange(EpochID uint64, Signature []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.SignEpochChange(&_NodeManager.TransactOpts, EpochID, Signature)
+}
+
+// SignEpochChange is a paid mutator transaction binding the contract method 0xa722a953.
+//
+// Solidity: function signEpochChange(uint64 EpochID, bytes Signature) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SignEpochChange(EpochID uint64, Signature []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.SignEpochChange(&_NodeManager.TransactOpts, EpochID, Signature)
+}
+
+// SubmitVrf is a paid mutator transaction binding the contract method 0x05f18c70.
+//
+// Solidity: function submitVrf(uint64 EpochID, bytes Output, bytes Proof) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SubmitVrf(opts *bind.TransactOpts, EpochID uint64, Output []byte, Proof []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "submitVrf", EpochID, Output, Proof)
+}
+
+// SubmitVrf is a paid mutator transaction binding the contract method 0x05f18c70.
+//
+// Solidity: function submitVrf(uint64 EpochID, bytes Output, bytes Proof) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SubmitVrf(EpochID uint64, Output []byte, Proof []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.SubmitVrf(&_NodeManager.TransactOpts, EpochID, Output, Proof)
+}
+
+// SubmitVrf is a paid mutator transaction binding the contract method 0x05f18c70.
+//
+// Solidity: function submitVrf(uint64 EpochID, bytes Output, bytes Proof) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SubmitVrf(EpochID uint64, Output []byte, Proof []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.SubmitVrf(&_NodeManager.TransactOpts, EpochID, Output, Proof)
+}
+
+// SweepExpired is a paid mutator transaction binding the contract method 0x223b97c6.
+//
+// Solidity: function sweepExpired(uint64 Height) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) SweepExpired(opts *bind.TransactOpts, Height uint64) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "sweepExpired", Height)
+}
+
+// SweepExpired is a paid mutator transaction binding the contract method 0x223b97c6.
+//
+// Solidity: function sweepExpired(uint64 Height) returns(bool Success)
+func (_NodeManager *NodeManagerSession) SweepExpired(Height uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SweepExpired(&_NodeManager.TransactOpts, Height)
+}
+
+// SweepExpired is a paid mutator transaction binding the contract method 0x223b97c6.
+//
+// Solidity: function sweepExpired(uint64 Height) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) SweepExpired(Height uint64) (*types.Transaction, error) {
+	return _NodeManager.Contract.SweepExpired(&_NodeManager.TransactOpts, Height)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x08c16dbb.
+//
+// Solidity: function vote(uint64 EpochID, bytes Hash) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) Vote(opts *bind.TransactOpts, EpochID uint64, Hash []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "vote", EpochID, Hash)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x08c16dbb.
+//
+// Solidity: function vote(uint64 EpochID, bytes Hash) returns(bool Success)
+func (_NodeManager *NodeManagerSession) Vote(EpochID uint64, Hash []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.Vote(&_NodeManager.TransactOpts, EpochID, Hash)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x08c16dbb.
+//
+// Solidity: function vote(uint64 EpochID, bytes Hash) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) Vote(EpochID uint64, Hash []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.Vote(&_NodeManager.TransactOpts, EpochID, Hash)
+}
+
+// VoteGroup is a paid mutator transaction binding the contract method 0x097c0ea6.
+//
+// Solidity: function voteGroup(string Name, uint64 EpochID, bytes Hash) returns(bool Success)
+func (_NodeManager *NodeManagerTransactor) VoteGroup(opts *bind.TransactOpts, Name string, EpochID uint64, Hash []byte) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "voteGroup", Name, EpochID, Hash)
+}
+
+// VoteGroup is a paid mutator transaction binding the contract method 0x097c0ea6.
+//
+// Solidity: function voteGroup(string Name, uint64 EpochID, bytes Hash) returns(bool Success)
+func (_NodeManager *NodeManagerSession) VoteGroup(Name string, EpochID uint64, Hash []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.VoteGroup(&_NodeManager.TransactOpts, Name, EpochID, Hash)
+}
+
+// VoteGroup is a paid mutator transaction binding the contract method 0x097c0ea6.
+//
+// Solidity: function voteGroup(string Name, uint64 EpochID, bytes Hash) returns(bool Success)
+func (_NodeManager *NodeManagerTransactorSession) VoteGroup(Name string, EpochID uint64, Hash []byte) (*types.Transaction, error) {
+	return _NodeManager.Contract.VoteGroup(&_NodeManager.TransactOpts, Name, EpochID, Hash)
+}
+
+// NodeManagerActionsExecutedIterator is returned from FilterActionsExecuted and is used to iterate over the raw logs and unpacked data for ActionsExecuted events raised by the NodeManager contract.
+type NodeManagerActionsExecutedIterator struct {
+	Event *NodeManagerActionsExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerActionsExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerActionsExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerActionsExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerActionsExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerActionsExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerActionsExecuted represents a ActionsExecuted event raised by the NodeManager contract.
+type NodeManagerActionsExecuted struct {
+	EpochID uint64
+	Hash    []byte
+	Actions uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterActionsExecuted is a free log retrieval operation binding the contract event 0x286e08b5b5b01227aaa50f251e33694bb85d276abfb34d2ab36f373a9a9ca8c7.
+//
+// Solidity: event actionsExecuted(uint64 EpochID, bytes Hash, uint64 Actions)
+func (_NodeManager *NodeManagerFilterer) FilterActionsExecuted(opts *bind.FilterOpts) (*NodeManagerActionsExecutedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "actionsExecuted")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerActionsExecutedIterator{contract: _NodeManager.contract, event: "actionsExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchActionsExecuted is a free log subscription operation binding the contract event 0x286e08b5b5b01227aaa50f251e33694bb85d276abfb34d2ab36f373a9a9ca8c7.
+//
+// Solidity: event actionsExecuted(uint64 EpochID, bytes Hash, uint64 Actions)
+func (_NodeManager *NodeManagerFilterer) WatchActionsExecuted(opts *bind.WatchOpts, sink chan<- *NodeManagerActionsExecuted) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "actionsExecuted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerActionsExecuted)
+				if err := _NodeManager.contract.UnpackLog(event, "actionsExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseActionsExecuted is a log parse operation binding the contract event 0x286e08b5b5b01227aaa50f251e33694bb85d276abfb34d2ab36f373a9a9ca8c7.
+//
+// Solidity: event actionsExecuted(uint64 EpochID, bytes Hash, uint64 Actions)
+func (_NodeManager *NodeManagerFilterer) ParseActionsExecuted(log types.Log) (*NodeManagerActionsExecuted, error) {
+	event := new(NodeManagerActionsExecuted)
+	if err := _NodeManager.contract.UnpackLog(event, "actionsExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerConsensusSignedIterator is returned from FilterConsensusSigned and is used to iterate over the raw logs and unpacked data for ConsensusSigned events raised by the NodeManager contract.
+type NodeManagerConsensusSignedIterator struct {
+	Event *NodeManagerConsensusSigned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerConsensusSignedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerConsensusSigned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerConsensusSigned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerConsensusSignedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerConsensusSignedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerConsensusSigned represents a ConsensusSigned event raised by the NodeManager contract.
+type NodeManagerConsensusSigned struct {
+	Method string
+	Input  []byte
+	Signer common.Address
+	Size   uint64
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusSigned is a free log retrieval operation binding the contract event 0x9a1a4b436e35c874d1db72c5d92aa8b3733c85a0dca3dab287ffd7862680eee8.
+//
+// Solidity: event consensusSigned(string Method, bytes Input, address Signer, uint64 Size)
+func (_NodeManager *NodeManagerFilterer) FilterConsensusSigned(opts *bind.FilterOpts) (*NodeManagerConsensusSignedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "consensusSigned")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerConsensusSignedIterator{contract: _NodeManager.contract, event: "consensusSigned", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusSigned is a free log subscription operation binding the contract event 0x9a1a4b436e35c874d1db72c5d92aa8b3733c85a0dca3dab287ffd7862680eee8.
+//
+// Solidity: event consensusSigned(string Method, bytes Input, address Signer, uint64 Size)
+func (_NodeManager *NodeManagerFilterer) WatchConsensusSigned(opts *bind.WatchOpts, sink chan<- *NodeManagerConsensusSigned) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "consensusSigned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerConsensusSigned)
+				if err := _NodeManager.contract.UnpackLog(event, "consensusSigned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusSigned is a log parse operation binding the contract event 0x9a1a4b436e35c874d1db72c5d92aa8b3733c85a0dca3dab287ffd7862680eee8.
+//
+// Solidity: event consensusSigned(string Method, bytes Input, address Signer, uint64 Size)
+func (_NodeManager *NodeManagerFilterer) ParseConsensusSigned(log types.Log) (*NodeManagerConsensusSigned, error) {
+	event := new(NodeManagerConsensusSigned)
+	if err := _NodeManager.contract.UnpackLog(event, "consensusSigned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerEpochChangeSignedIterator is returned from FilterEpochChangeSigned and is used to iterate over the raw logs and unpacked data for EpochChangeSigned events raised by the NodeManager contract.
+type NodeManagerEpochChangeSignedIterator struct {
+	Event *NodeManagerEpochChangeSigned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerEpochChangeSignedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerEpochChangeSigned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerEpochChangeSigned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerEpochChangeSignedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerEpochChangeSignedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerEpochChangeSigned represents a EpochChangeSigned event raised by the NodeManager contract.
+type NodeManagerEpochChangeSigned struct {
+	EpochID      uint64
+	Validator    common.Address
+	SignedNumber uint64
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochChangeSigned is a free log retrieval operation binding the contract event 0xb4d6a565fa862d5aad998a36672af767ce794be64153108833461d8be63fa875.
+//
+// Solidity: event epochChangeSigned(uint64 EpochID, address Validator, uint64 SignedNumber)
+func (_NodeManager *NodeManagerFilterer) FilterEpochChangeSigned(opts *bind.FilterOpts) (*NodeManagerEpochChangeSignedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "epochChangeSigned")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerEpochChangeSignedIterator{contract: _NodeManager.contract, event: "epochChangeSigned", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochChangeSigned is a free log subscription operation binding the contract event 0xb4d6a565fa862d5aad998a36672af767ce794be64153108833461d8be63fa875.
+//
+// Solidity: event epochChangeSigned(uint64 EpochID, address Validator, uint64 SignedNumber)
+func (_NodeManager *NodeManagerFilterer) WatchEpochChangeSigned(opts *bind.WatchOpts, sink chan<- *NodeManagerEpochChangeSigned) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "epochChangeSigned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerEpochChangeSigned)
+				if err := _NodeManager.contract.UnpackLog(event, "epochChangeSigned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochChangeSigned is a log parse operation binding the contract event 0xb4d6a565fa862d5aad998a36672af767ce794be64153108833461d8be63fa875.
+//
+// Solidity: event epochChangeSigned(uint64 EpochID, address Validator, uint64 SignedNumber)
+func (_NodeManager *NodeManagerFilterer) ParseEpochChangeSigned(log types.Log) (*NodeManagerEpochChangeSigned, error) {
+	event := new(NodeManagerEpochChangeSigned)
+	if err := _NodeManager.contract.UnpackLog(event, "epochChangeSigned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerEpochChangedIterator is returned from FilterEpochChanged and is used to iterate over the raw logs and unpacked data for EpochChanged events raised by the NodeManager contract.
+type NodeManagerEpochChangedIterator struct {
+	Event *NodeManagerEpochChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerEpochChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerEpochChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerEpochChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerEpochChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerEpochChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerEpochChanged represents a EpochChanged event raised by the NodeManager contract.
+type NodeManagerEpochChanged struct {
+	Epoch     []byte
+	NextEpoch []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochChanged is a free log retrieval operation binding the contract event 0xe522a8e3a261977038a83e47cc411457e90756d52ea38c69d876f294ebf01879.
+//
+// Solidity: event epochChanged(bytes Epoch, bytes NextEpoch)
+func (_NodeManager *NodeManagerFilterer) FilterEpochChanged(opts *bind.FilterOpts) (*NodeManagerEpochChangedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "epochChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerEpochChangedIterator{contract: _NodeManager.contract, event: "epochChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochChanged is a free log subscription operation binding the contract event 0xe522a8e3a261977038a83e47cc411457e90756d52ea38c69d876f294ebf01879.
+//
+// Solidity: event epochChanged(bytes Epoch, bytes NextEpoch)
+func (_NodeManager *NodeManagerFilterer) WatchEpochChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerEpochChanged) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "epochChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerEpochChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "epochChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochChanged is a log parse operation binding the contract event 0xe522a8e3a261977038a83e47cc411457e90756d52ea38c69d876f294ebf01879.
+//
+// Solidity: event epochChanged(bytes Epoch, bytes NextEpoch)
+func (_NodeManager *NodeManagerFilterer) ParseEpochChanged(log types.Log) (*NodeManagerEpochChanged, error) {
+	event := new(NodeManagerEpochChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "epochChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerExpirySweptIterator is returned from FilterExpirySwept and is used to iterate over the raw logs and unpacked data for ExpirySwept events raised by the NodeManager contract.
+type NodeManagerExpirySweptIterator struct {
+	Event *NodeManagerExpirySwept // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerExpirySweptIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerExpirySwept)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerExpirySwept)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerExpirySweptIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerExpirySweptIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerExpirySwept represents a ExpirySwept event raised by the NodeManager contract.
+type NodeManagerExpirySwept struct {
+	Height    uint64
+	Entries   uint64
+	Reclaimed uint64
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterExpirySwept is a free log retrieval operation binding the contract event 0x6a68363e9fdab95d1c8ac75c2081a2af92111e93c8d6c430b9472ee0663518fc.
+//
+// Solidity: event expirySwept(uint64 Height, uint64 Entries, uint64 Reclaimed)
+func (_NodeManager *NodeManagerFilterer) FilterExpirySwept(opts *bind.FilterOpts) (*NodeManagerExpirySweptIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "expirySwept")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerExpirySweptIterator{contract: _NodeManager.contract, event: "expirySwept", logs: logs, sub: sub}, nil
+}
+
+// WatchExpirySwept is a free log subscription operation binding the contract event 0x6a68363e9fdab95d1c8ac75c2081a2af92111e93c8d6c430b9472ee0663518fc.
+//
+// Solidity: event expirySwept(uint64 Height, uint64 Entries, uint64 Reclaimed)
+func (_NodeManager *NodeManagerFilterer) WatchExpirySwept(opts *bind.WatchOpts, sink chan<- *NodeManagerExpirySwept) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "expirySwept")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerExpirySwept)
+				if err := _NodeManager.contract.UnpackLog(event, "expirySwept", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExpirySwept is a log parse operation binding the contract event 0x6a68363e9fdab95d1c8ac75c2081a2af92111e93c8d6c430b9472ee0663518fc.
+//
+// Solidity: event expirySwept(uint64 Height, uint64 Entries, uint64 Reclaimed)
+func (_NodeManager *NodeManagerFilterer) ParseExpirySwept(log types.Log) (*NodeManagerExpirySwept, error) {
+	event := new(NodeManagerExpirySwept)
+	if err := _NodeManager.contract.UnpackLog(event, "expirySwept", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerFeeSplitChangedIterator is returned from FilterFeeSplitChanged and is used to iterate over the raw logs and unpacked data for FeeSplitChanged events raised by the NodeManager contract.
+type NodeManagerFeeSplitChangedIterator struct {
+	Event *NodeManagerFeeSplitChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerFeeSplitChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerFeeSplitChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerFeeSplitChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerFeeSplitChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerFeeSplitChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerFeeSplitChanged represents a FeeSplitChanged event raised by the NodeManager contract.
+type NodeManagerFeeSplitChanged struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeSplitChanged is a free log retrieval operation binding the contract event 0xf31140953b3eef314203980ae6ea8961261b5c30e84ce0d15070259fcaf90cc9.
+//
+// Solidity: event feeSplitChanged(uint64 BurnRate, uint64 TreasuryRate, address Treasury)
+func (_NodeManager *NodeManagerFilterer) FilterFeeSplitChanged(opts *bind.FilterOpts) (*NodeManagerFeeSplitChangedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "feeSplitChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerFeeSplitChangedIterator{contract: _NodeManager.contract, event: "feeSplitChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeSplitChanged is a free log subscription operation binding the contract event 0xf31140953b3eef314203980ae6ea8961261b5c30e84ce0d15070259fcaf90cc9.
+//
+// Solidity: event feeSplitChanged(uint64 BurnRate, uint64 TreasuryRate, address Treasury)
+func (_NodeManager *NodeManagerFilterer) WatchFeeSplitChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerFeeSplitChanged) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "feeSplitChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerFeeSplitChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "feeSplitChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeSplitChanged is a log parse operation binding the contract event 0xf31140953b3eef314203980ae6ea8961261b5c30e84ce0d15070259fcaf90cc9.
+//
+// Solidity: event feeSplitChanged(uint64 BurnRate, uint64 TreasuryRate, address Treasury)
+func (_NodeManager *NodeManagerFilterer) ParseFeeSplitChanged(log types.Log) (*NodeManagerFeeSplitChanged, error) {
+	event := new(NodeManagerFeeSplitChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "feeSplitChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerGroupEpochChangedIterator is returned from FilterGroupEpochChanged and is used to iterate over the raw logs and unpacked data for GroupEpochChanged events raised by the NodeManager contract.
+type NodeManagerGroupEpochChangedIterator struct {
+	Event *NodeManagerGroupEpochChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerGroupEpochChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerGroupEpochChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerGroupEpochChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerGroupEpochChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerGroupEpochChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerGroupEpochChanged represents a GroupEpochChanged event raised by the NodeManager contract.
+type NodeManagerGroupEpochChanged struct {
+	Name      string
+	Epoch     []byte
+	NextEpoch []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterGroupEpochChanged is a free log retrieval operation binding the contract event 0x1c5fbf9905503c0a84854411cf8ecb8b859ef153c1a372b35c2fae8293b7d5b6.
+//
+// Solidity: event groupEpochChanged(string Name, bytes Epoch, bytes NextEpoch)
+func (_NodeManager *NodeManagerFilterer) FilterGroupEpochChanged(opts *bind.FilterOpts) (*NodeManagerGroupEpochChangedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "groupEpochChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerGroupEpochChangedIterator{contract: _NodeManager.contract, event: "groupEpochChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchGroupEpochChanged is a free log subscription operation binding the contract event 0x1c5fbf9905503c0a84854411cf8ecb8b859ef153c1a372b35c2fae8293b7d5b6.
+//
+// Solidity: event groupEpochChanged(string Name, bytes Epoch, bytes NextEpoch)
+func (_NodeManager *NodeManagerFilterer) WatchGroupEpochChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerGroupEpochChanged) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "groupEpochChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerGroupEpochChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "groupEpochChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGroupEpochChanged is a log parse operation binding the contract event 0x1c5fbf9905503c0a84854411cf8ecb8b859ef153c1a372b35c2fae8293b7d5b6.
+//
+// Solidity: event groupEpochChanged(string Name, bytes Epoch, bytes NextEpoch)
+func (_NodeManager *NodeManagerFilterer) ParseGroupEpochChanged(log types.Log) (*NodeManagerGroupEpochChanged, error) {
+	event := new(NodeManagerGroupEpochChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "groupEpochChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerGroupProposedIterator is returned from FilterGroupProposed and is used to iterate over the raw logs and unpacked data for GroupProposed events raised by the NodeManager contract.
+type NodeManagerGroupProposedIterator struct {
+	Event *NodeManagerGroupProposed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerGroupProposedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerGroupProposed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerGroupProposed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerGroupProposedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerGroupProposedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerGroupProposed represents a GroupProposed event raised by the NodeManager contract.
+type NodeManagerGroupProposed struct {
+	Name  string
+	Epoch []byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterGroupProposed is a free log retrieval operation binding the contract event 0x9fd9e24cffdf62a0160c75cffd8bb1c7b13aa443776f8259599f70c8ddc47286.
+//
+// Solidity: event groupProposed(string Name, bytes Epoch)
+func (_NodeManager *NodeManagerFilterer) FilterGroupProposed(opts *bind.FilterOpts) (*NodeManagerGroupProposedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "groupProposed")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerGroupProposedIterator{contract: _NodeManager.contract, event: "groupProposed", logs: logs, sub: sub}, nil
+}
+
+// WatchGroupProposed is a free log subscription operation binding the contract event 0x9fd9e24cffdf62a0160c75cffd8bb1c7b13aa443776f8259599f70c8ddc47286.
+//
+// Solidity: event groupProposed(string Name, bytes Epoch)
+func (_NodeManager *NodeManagerFilterer) WatchGroupProposed(opts *bind.WatchOpts, sink chan<- *NodeManagerGroupProposed) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "groupProposed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerGroupProposed)
+				if err := _NodeManager.contract.UnpackLog(event, "groupProposed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGroupProposed is a log parse operation binding the contract event 0x9fd9e24cffdf62a0160c75cffd8bb1c7b13aa443776f8259599f70c8ddc47286.
+//
+// Solidity: event groupProposed(string Name, bytes Epoch)
+func (_NodeManager *NodeManagerFilterer) ParseGroupProposed(log types.Log) (*NodeManagerGroupProposed, error) {
+	event := new(NodeManagerGroupProposed)
+	if err := _NodeManager.contract.UnpackLog(event, "groupProposed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerGroupQuorumRuleChangedIterator is returned from FilterGroupQuorumRuleChanged and is used to iterate over the raw logs and unpacked data for GroupQuorumRuleChanged events raised by the NodeManager contract.
+type NodeManagerGroupQuorumRuleChangedIterator struct {
+	Event *NodeManagerGroupQuorumRuleChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerGroupQuorumRuleChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerGroupQuorumRuleChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerGroupQuorumRuleChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerGroupQuorumRuleChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerGroupQuorumRuleChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerGroupQuorumRuleChanged represents a GroupQuorumRuleChanged event raised by the NodeManager contract.
+type NodeManagerGroupQuorumRuleChanged struct {
+	Name        string
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterGroupQuorumRuleChanged is a free log retrieval operation binding the contract event 0xda83e1a51dcd1dc65bef60c4ddea45b9f1dcdba091f6d026159cb92da1edf838.
+//
+// Solidity: event groupQuorumRuleChanged(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerFilterer) FilterGroupQuorumRuleChanged(opts *bind.FilterOpts) (*NodeManagerGroupQuorumRuleChangedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "groupQuorumRuleChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerGroupQuorumRuleChangedIterator{contract: _NodeManager.contract, event: "groupQuorumRuleChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchGroupQuorumRuleChanged is a free log subscription operation binding the contract event 0xda83e1a51dcd1dc65bef60c4ddea45b9f1dcdba091f6d026159cb92da1edf838.
+//
+// Solidity: event groupQuorumRuleChanged(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerFilterer) WatchGroupQuorumRuleChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerGroupQuorumRuleChanged) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "groupQuorumRuleChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerGroupQuorumRuleChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "groupQuorumRuleChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGroupQuorumRuleChanged is a log parse operation binding the contract event 0xda83e1a51dcd1dc65bef60c4ddea45b9f1dcdba091f6d026159cb92da1edf838.
+//
+// Solidity: event groupQuorumRuleChanged(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerFilterer) ParseGroupQuorumRuleChanged(log types.Log) (*NodeManagerGroupQuorumRuleChanged, error) {
+	event := new(NodeManagerGroupQuorumRuleChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "groupQuorumRuleChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NodeManagerGroupVotedIterator is returned from FilterGroupVoted and is used to iterate over the raw logs and unpacked data for GroupVoted events raised by the NodeManager contract.
+type NodeManagerGroupVotedIterator struct {
+	Event *NodeManagerGroupVoted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerGroupVotedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerGroupVoted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerGroupVoted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerGroupVotedIterator) Error() error {
+	return it.fail
 }
 
-// Transact invokes the (paid) contract method with params as input values.
-func (_NodeManager *NodeManagerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _NodeManager.Contract.contract.Transact(opts, method, params...)
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerGroupVotedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// ApproveCandidate is a paid mutator transaction binding the contract method 0x2437d889.
-//
-// Solidity: function approveCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) ApproveCandidate(opts *bind.TransactOpts, PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "approveCandidate", PeerPubkey, Address)
+// NodeManagerGroupVoted represents a GroupVoted event raised by the NodeManager contract.
+type NodeManagerGroupVoted struct {
+	Name        string
+	EpochID     uint64
+	Hash        []byte
+	VotedNumber uint64
+	GroupSize   uint64
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// ApproveCandidate is a paid mutator transaction binding the contract method 0x2437d889.
+// FilterGroupVoted is a free log retrieval operation binding the contract event 0x4df1befbccf864ea0cde398ea20de3828dced95318778df5dc7ceb619eab3846.
 //
-// Solidity: function approveCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerSession) ApproveCandidate(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.ApproveCandidate(&_NodeManager.TransactOpts, PeerPubkey, Address)
-}
+// Solidity: event groupVoted(string Name, uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize)
+func (_NodeManager *NodeManagerFilterer) FilterGroupVoted(opts *bind.FilterOpts) (*NodeManagerGroupVotedIterator, error) {
 
-// ApproveCandidate is a paid mutator transaction binding the contract method 0x2437d889.
-//
-// Solidity: function approveCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) ApproveCandidate(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.ApproveCandidate(&_NodeManager.TransactOpts, PeerPubkey, Address)
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "groupVoted")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerGroupVotedIterator{contract: _NodeManager.contract, event: "groupVoted", logs: logs, sub: sub}, nil
 }
 
-// BlackNode is a paid mutator transaction binding the contract method 0x1c21bf1b.
+// WatchGroupVoted is a free log subscription operation binding the contract event 0x4df1befbccf864ea0cde398ea20de3828dced95318778df5dc7ceb619eab3846.
 //
-// Solidity: function blackNode(string[] PeerPubkeyList, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) BlackNode(opts *bind.TransactOpts, PeerPubkeyList []string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "blackNode", PeerPubkeyList, Address)
-}
+// Solidity: event groupVoted(string Name, uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize)
+func (_NodeManager *NodeManagerFilterer) WatchGroupVoted(opts *bind.WatchOpts, sink chan<- *NodeManagerGroupVoted) (event.Subscription, error) {
 
-// BlackNode is a paid mutator transaction binding the contract method 0x1c21bf1b.
-//
-// Solidity: function blackNode(string[] PeerPubkeyList, address Address) returns(bool success)
-func (_NodeManager *NodeManagerSession) BlackNode(PeerPubkeyList []string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.BlackNode(&_NodeManager.TransactOpts, PeerPubkeyList, Address)
-}
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "groupVoted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerGroupVoted)
+				if err := _NodeManager.contract.UnpackLog(event, "groupVoted", log); err != nil {
+					return err
+				}
+				event.Raw = log
 
-// BlackNode is a paid mutator transaction binding the contract method 0x1c21bf1b.
-//
-// Solidity: function blackNode(string[] PeerPubkeyList, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) BlackNode(PeerPubkeyList []string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.BlackNode(&_NodeManager.TransactOpts, PeerPubkeyList, Address)
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// CommitDpos is a paid mutator transaction binding the contract method 0x39f097d5.
+// ParseGroupVoted is a log parse operation binding the contract event 0x4df1befbccf864ea0cde398ea20de3828dced95318778df5dc7ceb619eab3846.
 //
-// Solidity: function commitDpos() returns(bool success)
-func (_NodeManager *NodeManagerTransactor) CommitDpos(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "commitDpos")
+// Solidity: event groupVoted(string Name, uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize)
+func (_NodeManager *NodeManagerFilterer) ParseGroupVoted(log types.Log) (*NodeManagerGroupVoted, error) {
+	event := new(NodeManagerGroupVoted)
+	if err := _NodeManager.contract.UnpackLog(event, "groupVoted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// CommitDpos is a paid mutator transaction binding the contract method 0x39f097d5.
-//
-// Solidity: function commitDpos() returns(bool success)
-func (_NodeManager *NodeManagerSession) CommitDpos() (*types.Transaction, error) {
-	return _NodeManager.Contract.CommitDpos(&_NodeManager.TransactOpts)
-}
+// NodeManagerMisconductRecordedIterator is returned from FilterMisconductRecorded and is used to iterate over the raw logs and unpacked data for MisconductRecorded events raised by the NodeManager contract.
+type NodeManagerMisconductRecordedIterator struct {
+	Event *NodeManagerMisconductRecorded // Event containing the contract specifics and raw log
 
-// CommitDpos is a paid mutator transaction binding the contract method 0x39f097d5.
-//
-// Solidity: function commitDpos() returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) CommitDpos() (*types.Transaction, error) {
-	return _NodeManager.Contract.CommitDpos(&_NodeManager.TransactOpts)
-}
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
 
-// InitConfig is a paid mutator transaction binding the contract method 0x5bc58f4f.
-//
-// Solidity: function initConfig(uint32 BlockMsgDelay, uint32 HashMsgDelay, uint32 PeerHandshakeTimeout, uint32 MaxBlockChangeView, string VrfValue, string VrfProof, (uint32,string,string) Peers) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) InitConfig(opts *bind.TransactOpts, BlockMsgDelay uint32, HashMsgDelay uint32, PeerHandshakeTimeout uint32, MaxBlockChangeView uint32, VrfValue string, VrfProof string, Peers node_managerVBFTPeerInfo) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "initConfig", BlockMsgDelay, HashMsgDelay, PeerHandshakeTimeout, MaxBlockChangeView, VrfValue, VrfProof, Peers)
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
 }
 
-// InitConfig is a paid mutator transaction binding the contract method 0x5bc58f4f.
-//
-// Solidity: function initConfig(uint32 BlockMsgDelay, uint32 HashMsgDelay, uint32 PeerHandshakeTimeout, uint32 MaxBlockChangeView, string VrfValue, string VrfProof, (uint32,string,string) Peers) returns(bool success)
-func (_NodeManager *NodeManagerSession) InitConfig(BlockMsgDelay uint32, HashMsgDelay uint32, PeerHandshakeTimeout uint32, MaxBlockChangeView uint32, VrfValue string, VrfProof string, Peers node_managerVBFTPeerInfo) (*types.Transaction, error) {
-	return _NodeManager.Contract.InitConfig(&_NodeManager.TransactOpts, BlockMsgDelay, HashMsgDelay, PeerHandshakeTimeout, MaxBlockChangeView, VrfValue, VrfProof, Peers)
-}
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerMisconductRecordedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerMisconductRecorded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
 
-// InitConfig is a paid mutator transaction binding the contract method 0x5bc58f4f.
-//
-// Solidity: function initConfig(uint32 BlockMsgDelay, uint32 HashMsgDelay, uint32 PeerHandshakeTimeout, uint32 MaxBlockChangeView, string VrfValue, string VrfProof, (uint32,string,string) Peers) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) InitConfig(BlockMsgDelay uint32, HashMsgDelay uint32, PeerHandshakeTimeout uint32, MaxBlockChangeView uint32, VrfValue string, VrfProof string, Peers node_managerVBFTPeerInfo) (*types.Transaction, error) {
-	return _NodeManager.Contract.InitConfig(&_NodeManager.TransactOpts, BlockMsgDelay, HashMsgDelay, PeerHandshakeTimeout, MaxBlockChangeView, VrfValue, VrfProof, Peers)
-}
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerMisconductRecorded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
 
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
-//
-// Solidity: function name() returns(string Name)
-func (_NodeManager *NodeManagerTransactor) Name(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "name")
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
 }
 
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
-//
-// Solidity: function name() returns(string Name)
-func (_NodeManager *NodeManagerSession) Name() (*types.Transaction, error) {
-	return _NodeManager.Contract.Name(&_NodeManager.TransactOpts)
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerMisconductRecordedIterator) Error() error {
+	return it.fail
 }
 
-// Name is a paid mutator transaction binding the contract method 0x06fdde03.
-//
-// Solidity: function name() returns(string Name)
-func (_NodeManager *NodeManagerTransactorSession) Name() (*types.Transaction, error) {
-	return _NodeManager.Contract.Name(&_NodeManager.TransactOpts)
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerMisconductRecordedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// QuitNode is a paid mutator transaction binding the contract method 0x5506c5bf.
-//
-// Solidity: function quitNode(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) QuitNode(opts *bind.TransactOpts, PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "quitNode", PeerPubkey, Address)
+// NodeManagerMisconductRecorded represents a MisconductRecorded event raised by the NodeManager contract.
+type NodeManagerMisconductRecorded struct {
+	EpochID   uint64
+	Validator common.Address
+	Kind      uint8
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// QuitNode is a paid mutator transaction binding the contract method 0x5506c5bf.
+// FilterMisconductRecorded is a free log retrieval operation binding the contract event 0xba596449114e31c286c76e5cf4322ad44c89974926dea0a235f8ddff9052b030.
 //
-// Solidity: function quitNode(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerSession) QuitNode(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.QuitNode(&_NodeManager.TransactOpts, PeerPubkey, Address)
-}
+// Solidity: event misconductRecorded(uint64 EpochID, address Validator, uint8 Kind)
+func (_NodeManager *NodeManagerFilterer) FilterMisconductRecorded(opts *bind.FilterOpts) (*NodeManagerMisconductRecordedIterator, error) {
 
-// QuitNode is a paid mutator transaction binding the contract method 0x5506c5bf.
-//
-// Solidity: function quitNode(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) QuitNode(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.QuitNode(&_NodeManager.TransactOpts, PeerPubkey, Address)
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "misconductRecorded")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerMisconductRecordedIterator{contract: _NodeManager.contract, event: "misconductRecorded", logs: logs, sub: sub}, nil
 }
 
-// RegisterCandidate is a paid mutator transaction binding the contract method 0x7a9b6fb9.
+// WatchMisconductRecorded is a free log subscription operation binding the contract event 0xba596449114e31c286c76e5cf4322ad44c89974926dea0a235f8ddff9052b030.
 //
-// Solidity: function registerCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) RegisterCandidate(opts *bind.TransactOpts, PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "registerCandidate", PeerPubkey, Address)
-}
+// Solidity: event misconductRecorded(uint64 EpochID, address Validator, uint8 Kind)
+func (_NodeManager *NodeManagerFilterer) WatchMisconductRecorded(opts *bind.WatchOpts, sink chan<- *NodeManagerMisconductRecorded) (event.Subscription, error) {
 
-// RegisterCandidate is a paid mutator transaction binding the contract method 0x7a9b6fb9.
-//
-// Solidity: function registerCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerSession) RegisterCandidate(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.RegisterCandidate(&_NodeManager.TransactOpts, PeerPubkey, Address)
-}
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "misconductRecorded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerMisconductRecorded)
+				if err := _NodeManager.contract.UnpackLog(event, "misconductRecorded", log); err != nil {
+					return err
+				}
+				event.Raw = log
 
-// RegisterCandidate is a paid mutator transaction binding the contract method 0x7a9b6fb9.
-//
-// Solidity: function registerCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) RegisterCandidate(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.RegisterCandidate(&_NodeManager.TransactOpts, PeerPubkey, Address)
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// UnRegisterCandidate is a paid mutator transaction binding the contract method 0xe7472e0c.
+// ParseMisconductRecorded is a log parse operation binding the contract event 0xba596449114e31c286c76e5cf4322ad44c89974926dea0a235f8ddff9052b030.
 //
-// Solidity: function unRegisterCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) UnRegisterCandidate(opts *bind.TransactOpts, PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "unRegisterCandidate", PeerPubkey, Address)
+// Solidity: event misconductRecorded(uint64 EpochID, address Validator, uint8 Kind)
+func (_NodeManager *NodeManagerFilterer) ParseMisconductRecorded(log types.Log) (*NodeManagerMisconductRecorded, error) {
+	event := new(NodeManagerMisconductRecorded)
+	if err := _NodeManager.contract.UnpackLog(event, "misconductRecorded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// UnRegisterCandidate is a paid mutator transaction binding the contract method 0xe7472e0c.
-//
-// Solidity: function unRegisterCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerSession) UnRegisterCandidate(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.UnRegisterCandidate(&_NodeManager.TransactOpts, PeerPubkey, Address)
+// NodeManagerPeersLimitChangedIterator is returned from FilterPeersLimitChanged and is used to iterate over the raw logs and unpacked data for PeersLimitChanged events raised by the NodeManager contract.
+type NodeManagerPeersLimitChangedIterator struct {
+	Event *NodeManagerPeersLimitChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
 }
 
-// UnRegisterCandidate is a paid mutator transaction binding the contract method 0xe7472e0c.
-//
-// Solidity: function unRegisterCandidate(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) UnRegisterCandidate(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.UnRegisterCandidate(&_NodeManager.TransactOpts, PeerPubkey, Address)
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerPeersLimitChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerPeersLimitChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerPeersLimitChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
 }
 
-// UpdateConfig is a paid mutator transaction binding the contract method 0xb8e3e81b.
-//
-// Solidity: function updateConfig(((uint32,uint32,uint32,uint32)) ConfigParam) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) UpdateConfig(opts *bind.TransactOpts, ConfigParam node_managerUpdateConfigParam) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "updateConfig", ConfigParam)
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerPeersLimitChangedIterator) Error() error {
+	return it.fail
 }
 
-// UpdateConfig is a paid mutator transaction binding the contract method 0xb8e3e81b.
-//
-// Solidity: function updateConfig(((uint32,uint32,uint32,uint32)) ConfigParam) returns(bool success)
-func (_NodeManager *NodeManagerSession) UpdateConfig(ConfigParam node_managerUpdateConfigParam) (*types.Transaction, error) {
-	return _NodeManager.Contract.UpdateConfig(&_NodeManager.TransactOpts, ConfigParam)
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerPeersLimitChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// UpdateConfig is a paid mutator transaction binding the contract method 0xb8e3e81b.
-//
-// Solidity: function updateConfig(((uint32,uint32,uint32,uint32)) ConfigParam) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) UpdateConfig(ConfigParam node_managerUpdateConfigParam) (*types.Transaction, error) {
-	return _NodeManager.Contract.UpdateConfig(&_NodeManager.TransactOpts, ConfigParam)
+// NodeManagerPeersLimitChanged represents a PeersLimitChanged event raised by the NodeManager contract.
+type NodeManagerPeersLimitChanged struct {
+	Target    uint64
+	MaxChange uint64
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// WhiteNode is a paid mutator transaction binding the contract method 0x8141608b.
+// FilterPeersLimitChanged is a free log retrieval operation binding the contract event 0x3b5ee9e2605e87de5e7362d1d68d2c5b51fce2390c10585d1f675a7a70ffd39b.
 //
-// Solidity: function whiteNode(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactor) WhiteNode(opts *bind.TransactOpts, PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.contract.Transact(opts, "whiteNode", PeerPubkey, Address)
+// Solidity: event peersLimitChanged(uint64 Target, uint64 MaxChange)
+func (_NodeManager *NodeManagerFilterer) FilterPeersLimitChanged(opts *bind.FilterOpts) (*NodeManagerPeersLimitChangedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "peersLimitChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerPeersLimitChangedIterator{contract: _NodeManager.contract, event: "peersLimitChanged", logs: logs, sub: sub}, nil
 }
 
-// WhiteNode is a paid mutator transaction binding the contract method 0x8141608b.
+// WatchPeersLimitChanged is a free log subscription operation binding the contract event 0x3b5ee9e2605e87de5e7362d1d68d2c5b51fce2390c10585d1f675a7a70ffd39b.
 //
-// Solidity: function whiteNode(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerSession) WhiteNode(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.WhiteNode(&_NodeManager.TransactOpts, PeerPubkey, Address)
+// Solidity: event peersLimitChanged(uint64 Target, uint64 MaxChange)
+func (_NodeManager *NodeManagerFilterer) WatchPeersLimitChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerPeersLimitChanged) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "peersLimitChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerPeersLimitChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "peersLimitChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// WhiteNode is a paid mutator transaction binding the contract method 0x8141608b.
+// ParsePeersLimitChanged is a log parse operation binding the contract event 0x3b5ee9e2605e87de5e7362d1d68d2c5b51fce2390c10585d1f675a7a70ffd39b.
 //
-// Solidity: function whiteNode(string PeerPubkey, address Address) returns(bool success)
-func (_NodeManager *NodeManagerTransactorSession) WhiteNode(PeerPubkey string, Address common.Address) (*types.Transaction, error) {
-	return _NodeManager.Contract.WhiteNode(&_NodeManager.TransactOpts, PeerPubkey, Address)
+// Solidity: event peersLimitChanged(uint64 Target, uint64 MaxChange)
+func (_NodeManager *NodeManagerFilterer) ParsePeersLimitChanged(log types.Log) (*NodeManagerPeersLimitChanged, error) {
+	event := new(NodeManagerPeersLimitChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "peersLimitChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// NodeManagerCheckConsensusSignsEventIterator is returned from FilterCheckConsensusSignsEvent and is used to iterate over the raw logs and unpacked data for CheckConsensusSignsEvent events raised by the NodeManager contract.
-type NodeManagerCheckConsensusSignsEventIterator struct {
-	Event *NodeManagerCheckConsensusSignsEvent // Event containing the contract specifics and raw log
+// NodeManagerProposalRejectedIterator is returned from FilterProposalRejected and is used to iterate over the raw logs and unpacked data for ProposalRejected events raised by the NodeManager contract.
+type NodeManagerProposalRejectedIterator struct {
+	Event *NodeManagerProposalRejected // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -470,7 +3111,7 @@ type NodeManagerCheckConsensusSignsEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerCheckConsensusSignsEventIterator) Next() bool {
+func (it *NodeManagerProposalRejectedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -479,7 +3120,7 @@ func (it *NodeManagerCheckConsensusSignsEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerCheckConsensusSignsEvent)
+			it.Event = new(NodeManagerProposalRejected)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -494,7 +3135,7 @@ func (it *NodeManagerCheckConsensusSignsEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerCheckConsensusSignsEvent)
+		it.Event = new(NodeManagerProposalRejected)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -510,41 +3151,44 @@ func (it *NodeManagerCheckConsensusSignsEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerCheckConsensusSignsEventIterator) Error() error {
+func (it *NodeManagerProposalRejectedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerCheckConsensusSignsEventIterator) Close() error {
+func (it *NodeManagerProposalRejectedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerCheckConsensusSignsEvent represents a CheckConsensusSignsEvent event raised by the NodeManager contract.
-type NodeManagerCheckConsensusSignsEvent struct {
-	Signs uint64
-	Raw   types.Log // Blockchain specific contextual infos
+// NodeManagerProposalRejected represents a ProposalRejected event raised by the NodeManager contract.
+type NodeManagerProposalRejected struct {
+	EpochID uint64
+	Hash    []byte
+	Votes   uint64
+	Winner  []byte
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterCheckConsensusSignsEvent is a free log retrieval operation binding the contract event 0x11a255420a0713251f48807b7c1efabe8e912db6332ec448cd07bf4ca381c0d5.
+// FilterProposalRejected is a free log retrieval operation binding the contract event 0x2da6d9bb64824b1e9c608d07e86a154f198e2c514109b19ba9516940546238c4.
 //
-// Solidity: event CheckConsensusSignsEvent(uint64 signs)
-func (_NodeManager *NodeManagerFilterer) FilterCheckConsensusSignsEvent(opts *bind.FilterOpts) (*NodeManagerCheckConsensusSignsEventIterator, error) {
+// Solidity: event proposalRejected(uint64 EpochID, bytes Hash, uint64 Votes, bytes Winner)
+func (_NodeManager *NodeManagerFilterer) FilterProposalRejected(opts *bind.FilterOpts) (*NodeManagerProposalRejectedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "CheckConsensusSignsEvent")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "proposalRejected")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerCheckConsensusSignsEventIterator{contract: _NodeManager.contract, event: "CheckConsensusSignsEvent", logs: logs, sub: sub}, nil
+	return &NodeManagerProposalRejectedIterator{contract: _NodeManager.contract, event: "proposalRejected", logs: logs, sub: sub}, nil
 }
 
-// WatchCheckConsensusSignsEvent is a free log subscription operation binding the contract event 0x11a255420a0713251f48807b7c1efabe8e912db6332ec448cd07bf4ca381c0d5.
+// WatchProposalRejected is a free log subscription operation binding the contract event 0x2da6d9bb64824b1e9c608d07e86a154f198e2c514109b19ba9516940546238c4.
 //
-// Solidity: event CheckConsensusSignsEvent(uint64 signs)
-func (_NodeManager *NodeManagerFilterer) WatchCheckConsensusSignsEvent(opts *bind.WatchOpts, sink chan<- *NodeManagerCheckConsensusSignsEvent) (event.Subscription, error) {
+// Solidity: event proposalRejected(uint64 EpochID, bytes Hash, uint64 Votes, bytes Winner)
+func (_NodeManager *NodeManagerFilterer) WatchProposalRejected(opts *bind.WatchOpts, sink chan<- *NodeManagerProposalRejected) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "CheckConsensusSignsEvent")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "proposalRejected")
 	if err != nil {
 		return nil, err
 	}
@@ -554,8 +3198,8 @@ func (_NodeManager *NodeManagerFilterer) WatchCheckConsensusSignsEvent(opts *bin
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerCheckConsensusSignsEvent)
-				if err := _NodeManager.contract.UnpackLog(event, "CheckConsensusSignsEvent", log); err != nil {
+				event := new(NodeManagerProposalRejected)
+				if err := _NodeManager.contract.UnpackLog(event, "proposalRejected", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -576,21 +3220,21 @@ func (_NodeManager *NodeManagerFilterer) WatchCheckConsensusSignsEvent(opts *bin
 	}), nil
 }
 
-// ParseCheckConsensusSignsEvent is a log parse operation binding the contract event 0x11a255420a0713251f48807b7c1efabe8e912db6332ec448cd07bf4ca381c0d5.
+// ParseProposalRejected is a log parse operation binding the contract event 0x2da6d9bb64824b1e9c608d07e86a154f198e2c514109b19ba9516940546238c4.
 //
-// Solidity: event CheckConsensusSignsEvent(uint64 signs)
-func (_NodeManager *NodeManagerFilterer) ParseCheckConsensusSignsEvent(log types.Log) (*NodeManagerCheckConsensusSignsEvent, error) {
-	event := new(NodeManagerCheckConsensusSignsEvent)
-	if err := _NodeManager.contract.UnpackLog(event, "CheckConsensusSignsEvent", log); err != nil {
+// Solidity: event proposalRejected(uint64 EpochID, bytes Hash, uint64 Votes, bytes Winner)
+func (_NodeManager *NodeManagerFilterer) ParseProposalRejected(log types.Log) (*NodeManagerProposalRejected, error) {
+	event := new(NodeManagerProposalRejected)
+	if err := _NodeManager.contract.UnpackLog(event, "proposalRejected", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerApproveCandidateIterator is returned from FilterApproveCandidate and is used to iterate over the raw logs and unpacked data for ApproveCandidate events raised by the NodeManager contract.
-type NodeManagerApproveCandidateIterator struct {
-	Event *NodeManagerApproveCandidate // Event containing the contract specifics and raw log
+// NodeManagerProposedIterator is returned from FilterProposed and is used to iterate over the raw logs and unpacked data for Proposed events raised by the NodeManager contract.
+type NodeManagerProposedIterator struct {
+	Event *NodeManagerProposed // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -604,7 +3248,7 @@ type NodeManagerApproveCandidateIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerApproveCandidateIterator) Next() bool {
+func (it *NodeManagerProposedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -613,7 +3257,7 @@ func (it *NodeManagerApproveCandidateIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerApproveCandidate)
+			it.Event = new(NodeManagerProposed)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -628,7 +3272,7 @@ func (it *NodeManagerApproveCandidateIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerApproveCandidate)
+		it.Event = new(NodeManagerProposed)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -644,41 +3288,41 @@ func (it *NodeManagerApproveCandidateIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerApproveCandidateIterator) Error() error {
+func (it *NodeManagerProposedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerApproveCandidateIterator) Close() error {
+func (it *NodeManagerProposedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerApproveCandidate represents a ApproveCandidate event raised by the NodeManager contract.
-type NodeManagerApproveCandidate struct {
-	Pubkey string
-	Raw    types.Log // Blockchain specific contextual infos
+// NodeManagerProposed represents a Proposed event raised by the NodeManager contract.
+type NodeManagerProposed struct {
+	Epoch []byte
+	Raw   types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproveCandidate is a free log retrieval operation binding the contract event 0x902caf6ae405aec1d7aa7994a0c27c467dc04d5efc4b94188aeb483cdbce2276.
+// FilterProposed is a free log retrieval operation binding the contract event 0xdc73c3e10ea70317dc841b9cdf7058197d6ab8a151956f21fc28f3f25bd593d0.
 //
-// Solidity: event evtApproveCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) FilterApproveCandidate(opts *bind.FilterOpts) (*NodeManagerApproveCandidateIterator, error) {
+// Solidity: event proposed(bytes Epoch)
+func (_NodeManager *NodeManagerFilterer) FilterProposed(opts *bind.FilterOpts) (*NodeManagerProposedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtApproveCandidate")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "proposed")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerApproveCandidateIterator{contract: _NodeManager.contract, event: "evtApproveCandidate", logs: logs, sub: sub}, nil
+	return &NodeManagerProposedIterator{contract: _NodeManager.contract, event: "proposed", logs: logs, sub: sub}, nil
 }
 
-// WatchApproveCandidate is a free log subscription operation binding the contract event 0x902caf6ae405aec1d7aa7994a0c27c467dc04d5efc4b94188aeb483cdbce2276.
+// WatchProposed is a free log subscription operation binding the contract event 0xdc73c3e10ea70317dc841b9cdf7058197d6ab8a151956f21fc28f3f25bd593d0.
 //
-// Solidity: event evtApproveCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) WatchApproveCandidate(opts *bind.WatchOpts, sink chan<- *NodeManagerApproveCandidate) (event.Subscription, error) {
+// Solidity: event proposed(bytes Epoch)
+func (_NodeManager *NodeManagerFilterer) WatchProposed(opts *bind.WatchOpts, sink chan<- *NodeManagerProposed) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtApproveCandidate")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "proposed")
 	if err != nil {
 		return nil, err
 	}
@@ -688,8 +3332,8 @@ func (_NodeManager *NodeManagerFilterer) WatchApproveCandidate(opts *bind.WatchO
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerApproveCandidate)
-				if err := _NodeManager.contract.UnpackLog(event, "evtApproveCandidate", log); err != nil {
+				event := new(NodeManagerProposed)
+				if err := _NodeManager.contract.UnpackLog(event, "proposed", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -710,21 +3354,21 @@ func (_NodeManager *NodeManagerFilterer) WatchApproveCandidate(opts *bind.WatchO
 	}), nil
 }
 
-// ParseApproveCandidate is a log parse operation binding the contract event 0x902caf6ae405aec1d7aa7994a0c27c467dc04d5efc4b94188aeb483cdbce2276.
+// ParseProposed is a log parse operation binding the contract event 0xdc73c3e10ea70317dc841b9cdf7058197d6ab8a151956f21fc28f3f25bd593d0.
 //
-// Solidity: event evtApproveCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) ParseApproveCandidate(log types.Log) (*NodeManagerApproveCandidate, error) {
-	event := new(NodeManagerApproveCandidate)
-	if err := _NodeManager.contract.UnpackLog(event, "evtApproveCandidate", log); err != nil {
+// Solidity: event proposed(bytes Epoch)
+func (_NodeManager *NodeManagerFilterer) ParseProposed(log types.Log) (*NodeManagerProposed, error) {
+	event := new(NodeManagerProposed)
+	if err := _NodeManager.contract.UnpackLog(event, "proposed", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerBlackNodeIterator is returned from FilterBlackNode and is used to iterate over the raw logs and unpacked data for BlackNode events raised by the NodeManager contract.
-type NodeManagerBlackNodeIterator struct {
-	Event *NodeManagerBlackNode // Event containing the contract specifics and raw log
+// NodeManagerQuorumRuleChangedIterator is returned from FilterQuorumRuleChanged and is used to iterate over the raw logs and unpacked data for QuorumRuleChanged events raised by the NodeManager contract.
+type NodeManagerQuorumRuleChangedIterator struct {
+	Event *NodeManagerQuorumRuleChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -738,7 +3382,7 @@ type NodeManagerBlackNodeIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerBlackNodeIterator) Next() bool {
+func (it *NodeManagerQuorumRuleChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -747,7 +3391,7 @@ func (it *NodeManagerBlackNodeIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerBlackNode)
+			it.Event = new(NodeManagerQuorumRuleChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -762,7 +3406,7 @@ func (it *NodeManagerBlackNodeIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerBlackNode)
+		it.Event = new(NodeManagerQuorumRuleChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -778,41 +3422,44 @@ func (it *NodeManagerBlackNodeIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerBlackNodeIterator) Error() error {
+func (it *NodeManagerQuorumRuleChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerBlackNodeIterator) Close() error {
+func (it *NodeManagerQuorumRuleChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerBlackNode represents a BlackNode event raised by the NodeManager contract.
-type NodeManagerBlackNode struct {
-	PubkeyList []string
-	Raw        types.Log // Blockchain specific contextual infos
+// NodeManagerQuorumRuleChanged represents a QuorumRuleChanged event raised by the NodeManager contract.
+type NodeManagerQuorumRuleChanged struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterBlackNode is a free log retrieval operation binding the contract event 0x0812f652fc317d5144576e96dd27a9260a90bdd876cc69afbd2405d989ec9eb5.
+// FilterQuorumRuleChanged is a free log retrieval operation binding the contract event 0x4a1c95deaa241f86a1682a787760abb6579f510990852bd0f79683cf79570f64.
 //
-// Solidity: event evtBlackNode(string[] PubkeyList)
-func (_NodeManager *NodeManagerFilterer) FilterBlackNode(opts *bind.FilterOpts) (*NodeManagerBlackNodeIterator, error) {
+// Solidity: event quorumRuleChanged(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerFilterer) FilterQuorumRuleChanged(opts *bind.FilterOpts) (*NodeManagerQuorumRuleChangedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtBlackNode")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "quorumRuleChanged")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerBlackNodeIterator{contract: _NodeManager.contract, event: "evtBlackNode", logs: logs, sub: sub}, nil
+	return &NodeManagerQuorumRuleChangedIterator{contract: _NodeManager.contract, event: "quorumRuleChanged", logs: logs, sub: sub}, nil
 }
 
-// WatchBlackNode is a free log subscription operation binding the contract event 0x0812f652fc317d5144576e96dd27a9260a90bdd876cc69afbd2405d989ec9eb5.
+// WatchQuorumRuleChanged is a free log subscription operation binding the contract event 0x4a1c95deaa241f86a1682a787760abb6579f510990852bd0f79683cf79570f64.
 //
-// Solidity: event evtBlackNode(string[] PubkeyList)
-func (_NodeManager *NodeManagerFilterer) WatchBlackNode(opts *bind.WatchOpts, sink chan<- *NodeManagerBlackNode) (event.Subscription, error) {
+// Solidity: event quorumRuleChanged(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerFilterer) WatchQuorumRuleChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerQuorumRuleChanged) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtBlackNode")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "quorumRuleChanged")
 	if err != nil {
 		return nil, err
 	}
@@ -822,8 +3469,8 @@ func (_NodeManager *NodeManagerFilterer) WatchBlackNode(opts *bind.WatchOpts, si
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerBlackNode)
-				if err := _NodeManager.contract.UnpackLog(event, "evtBlackNode", log); err != nil {
+				event := new(NodeManagerQuorumRuleChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "quorumRuleChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -844,21 +3491,21 @@ func (_NodeManager *NodeManagerFilterer) WatchBlackNode(opts *bind.WatchOpts, si
 	}), nil
 }
 
-// ParseBlackNode is a log parse operation binding the contract event 0x0812f652fc317d5144576e96dd27a9260a90bdd876cc69afbd2405d989ec9eb5.
+// ParseQuorumRuleChanged is a log parse operation binding the contract event 0x4a1c95deaa241f86a1682a787760abb6579f510990852bd0f79683cf79570f64.
 //
-// Solidity: event evtBlackNode(string[] PubkeyList)
-func (_NodeManager *NodeManagerFilterer) ParseBlackNode(log types.Log) (*NodeManagerBlackNode, error) {
-	event := new(NodeManagerBlackNode)
-	if err := _NodeManager.contract.UnpackLog(event, "evtBlackNode", log); err != nil {
+// Solidity: event quorumRuleChanged(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold)
+func (_NodeManager *NodeManagerFilterer) ParseQuorumRuleChanged(log types.Log) (*NodeManagerQuorumRuleChanged, error) {
+	event := new(NodeManagerQuorumRuleChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "quorumRuleChanged", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerCommitDposIterator is returned from FilterCommitDpos and is used to iterate over the raw logs and unpacked data for CommitDpos events raised by the NodeManager contract.
-type NodeManagerCommitDposIterator struct {
-	Event *NodeManagerCommitDpos // Event containing the contract specifics and raw log
+// NodeManagerValidatorEjectedIterator is returned from FilterValidatorEjected and is used to iterate over the raw logs and unpacked data for ValidatorEjected events raised by the NodeManager contract.
+type NodeManagerValidatorEjectedIterator struct {
+	Event *NodeManagerValidatorEjected // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -872,7 +3519,7 @@ type NodeManagerCommitDposIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerCommitDposIterator) Next() bool {
+func (it *NodeManagerValidatorEjectedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -881,7 +3528,7 @@ func (it *NodeManagerCommitDposIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerCommitDpos)
+			it.Event = new(NodeManagerValidatorEjected)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -896,7 +3543,7 @@ func (it *NodeManagerCommitDposIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerCommitDpos)
+		it.Event = new(NodeManagerValidatorEjected)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -912,40 +3559,43 @@ func (it *NodeManagerCommitDposIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerCommitDposIterator) Error() error {
+func (it *NodeManagerValidatorEjectedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerCommitDposIterator) Close() error {
+func (it *NodeManagerValidatorEjectedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerCommitDpos represents a CommitDpos event raised by the NodeManager contract.
-type NodeManagerCommitDpos struct {
-	Raw types.Log // Blockchain specific contextual infos
+// NodeManagerValidatorEjected represents a ValidatorEjected event raised by the NodeManager contract.
+type NodeManagerValidatorEjected struct {
+	EpochID     uint64
+	Validator   common.Address
+	StartHeight uint64
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterCommitDpos is a free log retrieval operation binding the contract event 0x4a1167039259b24583f23f1b361b44243af5c995970a48c79605418fd6319af7.
+// FilterValidatorEjected is a free log retrieval operation binding the contract event 0xba324aa3a24b6111518facaa69e84cfbdc4e5177e41b0af8d34fa5846ce66ba0.
 //
-// Solidity: event evtCommitDpos()
-func (_NodeManager *NodeManagerFilterer) FilterCommitDpos(opts *bind.FilterOpts) (*NodeManagerCommitDposIterator, error) {
+// Solidity: event validatorEjected(uint64 EpochID, address Validator, uint64 StartHeight)
+func (_NodeManager *NodeManagerFilterer) FilterValidatorEjected(opts *bind.FilterOpts) (*NodeManagerValidatorEjectedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtCommitDpos")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "validatorEjected")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerCommitDposIterator{contract: _NodeManager.contract, event: "evtCommitDpos", logs: logs, sub: sub}, nil
+	return &NodeManagerValidatorEjectedIterator{contract: _NodeManager.contract, event: "validatorEjected", logs: logs, sub: sub}, nil
 }
 
-// WatchCommitDpos is a free log subscription operation binding the contract event 0x4a1167039259b24583f23f1b361b44243af5c995970a48c79605418fd6319af7.
+// WatchValidatorEjected is a free log subscription operation binding the contract event 0xba324aa3a24b6111518facaa69e84cfbdc4e5177e41b0af8d34fa5846ce66ba0.
 //
-// Solidity: event evtCommitDpos()
-func (_NodeManager *NodeManagerFilterer) WatchCommitDpos(opts *bind.WatchOpts, sink chan<- *NodeManagerCommitDpos) (event.Subscription, error) {
+// Solidity: event validatorEjected(uint64 EpochID, address Validator, uint64 StartHeight)
+func (_NodeManager *NodeManagerFilterer) WatchValidatorEjected(opts *bind.WatchOpts, sink chan<- *NodeManagerValidatorEjected) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtCommitDpos")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "validatorEjected")
 	if err != nil {
 		return nil, err
 	}
@@ -955,8 +3605,8 @@ func (_NodeManager *NodeManagerFilterer) WatchCommitDpos(opts *bind.WatchOpts, s
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerCommitDpos)
-				if err := _NodeManager.contract.UnpackLog(event, "evtCommitDpos", log); err != nil {
+				event := new(NodeManagerValidatorEjected)
+				if err := _NodeManager.contract.UnpackLog(event, "validatorEjected", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -977,21 +3627,21 @@ func (_NodeManager *NodeManagerFilterer) WatchCommitDpos(opts *bind.WatchOpts, s
 	}), nil
 }
 
-// ParseCommitDpos is a log parse operation binding the contract event 0x4a1167039259b24583f23f1b361b44243af5c995970a48c79605418fd6319af7.
+// ParseValidatorEjected is a log parse operation binding the contract event 0xba324aa3a24b6111518facaa69e84cfbdc4e5177e41b0af8d34fa5846ce66ba0.
 //
-// Solidity: event evtCommitDpos()
-func (_NodeManager *NodeManagerFilterer) ParseCommitDpos(log types.Log) (*NodeManagerCommitDpos, error) {
-	event := new(NodeManagerCommitDpos)
-	if err := _NodeManager.contract.UnpackLog(event, "evtCommitDpos", log); err != nil {
+// Solidity: event validatorEjected(uint64 EpochID, address Validator, uint64 StartHeight)
+func (_NodeManager *NodeManagerFilterer) ParseValidatorEjected(log types.Log) (*NodeManagerValidatorEjected, error) {
+	event := new(NodeManagerValidatorEjected)
+	if err := _NodeManager.contract.UnpackLog(event, "validatorEjected", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerQuitNodeIterator is returned from FilterQuitNode and is used to iterate over the raw logs and unpacked data for QuitNode events raised by the NodeManager contract.
-type NodeManagerQuitNodeIterator struct {
-	Event *NodeManagerQuitNode // Event containing the contract specifics and raw log
+// NodeManagerVoteCommittedIterator is returned from FilterVoteCommitted and is used to iterate over the raw logs and unpacked data for VoteCommitted events raised by the NodeManager contract.
+type NodeManagerVoteCommittedIterator struct {
+	Event *NodeManagerVoteCommitted // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1005,7 +3655,7 @@ type NodeManagerQuitNodeIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerQuitNodeIterator) Next() bool {
+func (it *NodeManagerVoteCommittedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1014,7 +3664,7 @@ func (it *NodeManagerQuitNodeIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerQuitNode)
+			it.Event = new(NodeManagerVoteCommitted)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1029,7 +3679,7 @@ func (it *NodeManagerQuitNodeIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerQuitNode)
+		it.Event = new(NodeManagerVoteCommitted)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1045,41 +3695,42 @@ func (it *NodeManagerQuitNodeIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerQuitNodeIterator) Error() error {
+func (it *NodeManagerVoteCommittedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerQuitNodeIterator) Close() error {
+func (it *NodeManagerVoteCommittedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerQuitNode represents a QuitNode event raised by the NodeManager contract.
-type NodeManagerQuitNode struct {
-	Pubkey string
-	Raw    types.Log // Blockchain specific contextual infos
+// NodeManagerVoteCommitted represents a VoteCommitted event raised by the NodeManager contract.
+type NodeManagerVoteCommitted struct {
+	EpochID uint64
+	Voter   common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterQuitNode is a free log retrieval operation binding the contract event 0xd9960f1ffee6726984338fbb6b8243175d18b2a9b984b9b6c84f6b7d312b8aa8.
+// FilterVoteCommitted is a free log retrieval operation binding the contract event 0xece0840bf521b84d3252c0c39c69f03829593866941be56ec91c4a29b9aa8bdb.
 //
-// Solidity: event evtQuitNode(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) FilterQuitNode(opts *bind.FilterOpts) (*NodeManagerQuitNodeIterator, error) {
+// Solidity: event voteCommitted(uint64 EpochID, address Voter)
+func (_NodeManager *NodeManagerFilterer) FilterVoteCommitted(opts *bind.FilterOpts) (*NodeManagerVoteCommittedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtQuitNode")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "voteCommitted")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerQuitNodeIterator{contract: _NodeManager.contract, event: "evtQuitNode", logs: logs, sub: sub}, nil
+	return &NodeManagerVoteCommittedIterator{contract: _NodeManager.contract, event: "voteCommitted", logs: logs, sub: sub}, nil
 }
 
-// WatchQuitNode is a free log subscription operation binding the contract event 0xd9960f1ffee6726984338fbb6b8243175d18b2a9b984b9b6c84f6b7d312b8aa8.
+// WatchVoteCommitted is a free log subscription operation binding the contract event 0xece0840bf521b84d3252c0c39c69f03829593866941be56ec91c4a29b9aa8bdb.
 //
-// Solidity: event evtQuitNode(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) WatchQuitNode(opts *bind.WatchOpts, sink chan<- *NodeManagerQuitNode) (event.Subscription, error) {
+// Solidity: event voteCommitted(uint64 EpochID, address Voter)
+func (_NodeManager *NodeManagerFilterer) WatchVoteCommitted(opts *bind.WatchOpts, sink chan<- *NodeManagerVoteCommitted) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtQuitNode")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "voteCommitted")
 	if err != nil {
 		return nil, err
 	}
@@ -1089,8 +3740,8 @@ func (_NodeManager *NodeManagerFilterer) WatchQuitNode(opts *bind.WatchOpts, sin
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerQuitNode)
-				if err := _NodeManager.contract.UnpackLog(event, "evtQuitNode", log); err != nil {
+				event := new(NodeManagerVoteCommitted)
+				if err := _NodeManager.contract.UnpackLog(event, "voteCommitted", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1111,21 +3762,21 @@ func (_NodeManager *NodeManagerFilterer) WatchQuitNode(opts *bind.WatchOpts, sin
 	}), nil
 }
 
-// ParseQuitNode is a log parse operation binding the contract event 0xd9960f1ffee6726984338fbb6b8243175d18b2a9b984b9b6c84f6b7d312b8aa8.
+// ParseVoteCommitted is a log parse operation binding the contract event 0xece0840bf521b84d3252c0c39c69f03829593866941be56ec91c4a29b9aa8bdb.
 //
-// Solidity: event evtQuitNode(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) ParseQuitNode(log types.Log) (*NodeManagerQuitNode, error) {
-	event := new(NodeManagerQuitNode)
-	if err := _NodeManager.contract.UnpackLog(event, "evtQuitNode", log); err != nil {
+// Solidity: event voteCommitted(uint64 EpochID, address Voter)
+func (_NodeManager *NodeManagerFilterer) ParseVoteCommitted(log types.Log) (*NodeManagerVoteCommitted, error) {
+	event := new(NodeManagerVoteCommitted)
+	if err := _NodeManager.contract.UnpackLog(event, "voteCommitted", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerRegisterCandidateIterator is returned from FilterRegisterCandidate and is used to iterate over the raw logs and unpacked data for RegisterCandidate events raised by the NodeManager contract.
-type NodeManagerRegisterCandidateIterator struct {
-	Event *NodeManagerRegisterCandidate // Event containing the contract specifics and raw log
+// NodeManagerVotedIterator is returned from FilterVoted and is used to iterate over the raw logs and unpacked data for Voted events raised by the NodeManager contract.
+type NodeManagerVotedIterator struct {
+	Event *NodeManagerVoted // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1139,7 +3790,7 @@ type NodeManagerRegisterCandidateIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerRegisterCandidateIterator) Next() bool {
+func (it *NodeManagerVotedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1148,7 +3799,7 @@ func (it *NodeManagerRegisterCandidateIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerRegisterCandidate)
+			it.Event = new(NodeManagerVoted)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1163,7 +3814,7 @@ func (it *NodeManagerRegisterCandidateIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerRegisterCandidate)
+		it.Event = new(NodeManagerVoted)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1179,41 +3830,44 @@ func (it *NodeManagerRegisterCandidateIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerRegisterCandidateIterator) Error() error {
+func (it *NodeManagerVotedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerRegisterCandidateIterator) Close() error {
+func (it *NodeManagerVotedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerRegisterCandidate represents a RegisterCandidate event raised by the NodeManager contract.
-type NodeManagerRegisterCandidate struct {
-	Pubkey string
-	Raw    types.Log // Blockchain specific contextual infos
+// NodeManagerVoted represents a Voted event raised by the NodeManager contract.
+type NodeManagerVoted struct {
+	EpochID     uint64
+	Hash        []byte
+	VotedNumber uint64
+	GroupSize   uint64
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterRegisterCandidate is a free log retrieval operation binding the contract event 0x9e86b25c573201cdd348c36cc051e55aeeb2fdf6f054c4d52dd100a475350626.
+// FilterVoted is a free log retrieval operation binding the contract event 0x4ca47172786ff35798e771dd28b019ed6992d98dad96a86115c65f7a19050134.
 //
-// Solidity: event evtRegisterCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) FilterRegisterCandidate(opts *bind.FilterOpts) (*NodeManagerRegisterCandidateIterator, error) {
+// Solidity: event voted(uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize)
+func (_NodeManager *NodeManagerFilterer) FilterVoted(opts *bind.FilterOpts) (*NodeManagerVotedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtRegisterCandidate")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "voted")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerRegisterCandidateIterator{contract: _NodeManager.contract, event: "evtRegisterCandidate", logs: logs, sub: sub}, nil
+	return &NodeManagerVotedIterator{contract: _NodeManager.contract, event: "voted", logs: logs, sub: sub}, nil
 }
 
-// WatchRegisterCandidate is a free log subscription operation binding the contract event 0x9e86b25c573201cdd348c36cc051e55aeeb2fdf6f054c4d52dd100a475350626.
+// WatchVoted is a free log subscription operation binding the contract event 0x4ca47172786ff35798e771dd28b019ed6992d98dad96a86115c65f7a19050134.
 //
-// Solidity: event evtRegisterCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) WatchRegisterCandidate(opts *bind.WatchOpts, sink chan<- *NodeManagerRegisterCandidate) (event.Subscription, error) {
+// Solidity: event voted(uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize)
+func (_NodeManager *NodeManagerFilterer) WatchVoted(opts *bind.WatchOpts, sink chan<- *NodeManagerVoted) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtRegisterCandidate")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "voted")
 	if err != nil {
 		return nil, err
 	}
@@ -1223,8 +3877,8 @@ func (_NodeManager *NodeManagerFilterer) WatchRegisterCandidate(opts *bind.Watch
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerRegisterCandidate)
-				if err := _NodeManager.contract.UnpackLog(event, "evtRegisterCandidate", log); err != nil {
+				event := new(NodeManagerVoted)
+				if err := _NodeManager.contract.UnpackLog(event, "voted", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1245,21 +3899,21 @@ func (_NodeManager *NodeManagerFilterer) WatchRegisterCandidate(opts *bind.Watch
 	}), nil
 }
 
-// ParseRegisterCandidate is a log parse operation binding the contract event 0x9e86b25c573201cdd348c36cc051e55aeeb2fdf6f054c4d52dd100a475350626.
+// ParseVoted is a log parse operation binding the contract event 0x4ca47172786ff35798e771dd28b019ed6992d98dad96a86115c65f7a19050134.
 //
-// Solidity: event evtRegisterCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) ParseRegisterCandidate(log types.Log) (*NodeManagerRegisterCandidate, error) {
-	event := new(NodeManagerRegisterCandidate)
-	if err := _NodeManager.contract.UnpackLog(event, "evtRegisterCandidate", log); err != nil {
+// Solidity: event voted(uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize)
+func (_NodeManager *NodeManagerFilterer) ParseVoted(log types.Log) (*NodeManagerVoted, error) {
+	event := new(NodeManagerVoted)
+	if err := _NodeManager.contract.UnpackLog(event, "voted", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerUnRegisterCandidateIterator is returned from FilterUnRegisterCandidate and is used to iterate over the raw logs and unpacked data for UnRegisterCandidate events raised by the NodeManager contract.
-type NodeManagerUnRegisterCandidateIterator struct {
-	Event *NodeManagerUnRegisterCandidate // Event containing the contract specifics and raw log
+// NodeManagerVotingSealedIterator is returned from FilterVotingSealed and is used to iterate over the raw logs and unpacked data for VotingSealed events raised by the NodeManager contract.
+type NodeManagerVotingSealedIterator struct {
+	Event *NodeManagerVotingSealed // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1273,7 +3927,7 @@ type NodeManagerUnRegisterCandidateIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerUnRegisterCandidateIterator) Next() bool {
+func (it *NodeManagerVotingSealedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1282,7 +3936,7 @@ func (it *NodeManagerUnRegisterCandidateIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerUnRegisterCandidate)
+			it.Event = new(NodeManagerVotingSealed)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1297,7 +3951,7 @@ func (it *NodeManagerUnRegisterCandidateIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerUnRegisterCandidate)
+		it.Event = new(NodeManagerVotingSealed)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1313,41 +3967,43 @@ func (it *NodeManagerUnRegisterCandidateIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerUnRegisterCandidateIterator) Error() error {
+func (it *NodeManagerVotingSealedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerUnRegisterCandidateIterator) Close() error {
+func (it *NodeManagerVotingSealedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerUnRegisterCandidate represents a UnRegisterCandidate event raised by the NodeManager contract.
-type NodeManagerUnRegisterCandidate struct {
-	Pubkey string
-	Raw    types.Log // Blockchain specific contextual infos
+// NodeManagerVotingSealed represents a VotingSealed event raised by the NodeManager contract.
+type NodeManagerVotingSealed struct {
+	EpochID   uint64
+	CommitEnd uint64
+	RevealEnd uint64
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterUnRegisterCandidate is a free log retrieval operation binding the contract event 0xb472901c46184dd851d1cc49bfb0c8a2e148ae4d8ae9961de16ec1451df3c557.
+// FilterVotingSealed is a free log retrieval operation binding the contract event 0xce8e868655db954eca67fe95f80cca02e556e42ef79cf8c7550f7a2e5cbe10a3.
 //
-// Solidity: event evtUnRegisterCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) FilterUnRegisterCandidate(opts *bind.FilterOpts) (*NodeManagerUnRegisterCandidateIterator, error) {
+// Solidity: event votingSealed(uint64 EpochID, uint64 CommitEnd, uint64 RevealEnd)
+func (_NodeManager *NodeManagerFilterer) FilterVotingSealed(opts *bind.FilterOpts) (*NodeManagerVotingSealedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtUnRegisterCandidate")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "votingSealed")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerUnRegisterCandidateIterator{contract: _NodeManager.contract, event: "evtUnRegisterCandidate", logs: logs, sub: sub}, nil
+	return &NodeManagerVotingSealedIterator{contract: _NodeManager.contract, event: "votingSealed", logs: logs, sub: sub}, nil
 }
 
-// WatchUnRegisterCandidate is a free log subscription operation binding the contract event 0xb472901c46184dd851d1cc49bfb0c8a2e148ae4d8ae9961de16ec1451df3c557.
+// WatchVotingSealed is a free log subscription operation binding the contract event 0xce8e868655db954eca67fe95f80cca02e556e42ef79cf8c7550f7a2e5cbe10a3.
 //
-// Solidity: event evtUnRegisterCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) WatchUnRegisterCandidate(opts *bind.WatchOpts, sink chan<- *NodeManagerUnRegisterCandidate) (event.Subscription, error) {
+// Solidity: event votingSealed(uint64 EpochID, uint64 CommitEnd, uint64 RevealEnd)
+func (_NodeManager *NodeManagerFilterer) WatchVotingSealed(opts *bind.WatchOpts, sink chan<- *NodeManagerVotingSealed) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtUnRegisterCandidate")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "votingSealed")
 	if err != nil {
 		return nil, err
 	}
@@ -1357,8 +4013,8 @@ func (_NodeManager *NodeManagerFilterer) WatchUnRegisterCandidate(opts *bind.Wat
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerUnRegisterCandidate)
-				if err := _NodeManager.contract.UnpackLog(event, "evtUnRegisterCandidate", log); err != nil {
+				event := new(NodeManagerVotingSealed)
+				if err := _NodeManager.contract.UnpackLog(event, "votingSealed", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1379,21 +4035,21 @@ func (_NodeManager *NodeManagerFilterer) WatchUnRegisterCandidate(opts *bind.Wat
 	}), nil
 }
 
-// ParseUnRegisterCandidate is a log parse operation binding the contract event 0xb472901c46184dd851d1cc49bfb0c8a2e148ae4d8ae9961de16ec1451df3c557.
+// ParseVotingSealed is a log parse operation binding the contract event 0xce8e868655db954eca67fe95f80cca02e556e42ef79cf8c7550f7a2e5cbe10a3.
 //
-// Solidity: event evtUnRegisterCandidate(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) ParseUnRegisterCandidate(log types.Log) (*NodeManagerUnRegisterCandidate, error) {
-	event := new(NodeManagerUnRegisterCandidate)
-	if err := _NodeManager.contract.UnpackLog(event, "evtUnRegisterCandidate", log); err != nil {
+// Solidity: event votingSealed(uint64 EpochID, uint64 CommitEnd, uint64 RevealEnd)
+func (_NodeManager *NodeManagerFilterer) ParseVotingSealed(log types.Log) (*NodeManagerVotingSealed, error) {
+	event := new(NodeManagerVotingSealed)
+	if err := _NodeManager.contract.UnpackLog(event, "votingSealed", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerUpdateConfigIterator is returned from FilterUpdateConfig and is used to iterate over the raw logs and unpacked data for UpdateConfig events raised by the NodeManager contract.
-type NodeManagerUpdateConfigIterator struct {
-	Event *NodeManagerUpdateConfig // Event containing the contract specifics and raw log
+// NodeManagerVotingTalliedIterator is returned from FilterVotingTallied and is used to iterate over the raw logs and unpacked data for VotingTallied events raised by the NodeManager contract.
+type NodeManagerVotingTalliedIterator struct {
+	Event *NodeManagerVotingTallied // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1407,7 +4063,7 @@ type NodeManagerUpdateConfigIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerUpdateConfigIterator) Next() bool {
+func (it *NodeManagerVotingTalliedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1416,7 +4072,7 @@ func (it *NodeManagerUpdateConfigIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerUpdateConfig)
+			it.Event = new(NodeManagerVotingTallied)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1431,7 +4087,7 @@ func (it *NodeManagerUpdateConfigIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerUpdateConfig)
+		it.Event = new(NodeManagerVotingTallied)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1447,41 +4103,42 @@ func (it *NodeManagerUpdateConfigIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerUpdateConfigIterator) Error() error {
+func (it *NodeManagerVotingTalliedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerUpdateConfigIterator) Close() error {
+func (it *NodeManagerVotingTalliedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerUpdateConfig represents a UpdateConfig event raised by the NodeManager contract.
-type NodeManagerUpdateConfig struct {
-	Config node_managerConfiguration
-	Raw    types.Log // Blockchain specific contextual infos
+// NodeManagerVotingTallied represents a VotingTallied event raised by the NodeManager contract.
+type NodeManagerVotingTallied struct {
+	EpochID uint64
+	Winner  []byte
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterUpdateConfig is a free log retrieval operation binding the contract event 0x0ea859a9f788215a0a769be70546414d2c2bd5c6eac02eb711b7c47d0472bd76.
+// FilterVotingTallied is a free log retrieval operation binding the contract event 0x894350eb47a1a01b7f5b08dc2b5522e2737ce3a3998c2570855b75c7ce5c9f47.
 //
-// Solidity: event evtUpdateConfig((uint32,uint32,uint32,uint32) Config)
-func (_NodeManager *NodeManagerFilterer) FilterUpdateConfig(opts *bind.FilterOpts) (*NodeManagerUpdateConfigIterator, error) {
+// Solidity: event votingTallied(uint64 EpochID, bytes Winner)
+func (_NodeManager *NodeManagerFilterer) FilterVotingTallied(opts *bind.FilterOpts) (*NodeManagerVotingTalliedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtUpdateConfig")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "votingTallied")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerUpdateConfigIterator{contract: _NodeManager.contract, event: "evtUpdateConfig", logs: logs, sub: sub}, nil
+	return &NodeManagerVotingTalliedIterator{contract: _NodeManager.contract, event: "votingTallied", logs: logs, sub: sub}, nil
 }
 
-// WatchUpdateConfig is a free log subscription operation binding the contract event 0x0ea859a9f788215a0a769be70546414d2c2bd5c6eac02eb711b7c47d0472bd76.
+// WatchVotingTallied is a free log subscription operation binding the contract event 0x894350eb47a1a01b7f5b08dc2b5522e2737ce3a3998c2570855b75c7ce5c9f47.
 //
-// Solidity: event evtUpdateConfig((uint32,uint32,uint32,uint32) Config)
-func (_NodeManager *NodeManagerFilterer) WatchUpdateConfig(opts *bind.WatchOpts, sink chan<- *NodeManagerUpdateConfig) (event.Subscription, error) {
+// Solidity: event votingTallied(uint64 EpochID, bytes Winner)
+func (_NodeManager *NodeManagerFilterer) WatchVotingTallied(opts *bind.WatchOpts, sink chan<- *NodeManagerVotingTallied) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtUpdateConfig")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "votingTallied")
 	if err != nil {
 		return nil, err
 	}
@@ -1491,8 +4148,8 @@ func (_NodeManager *NodeManagerFilterer) WatchUpdateConfig(opts *bind.WatchOpts,
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerUpdateConfig)
-				if err := _NodeManager.contract.UnpackLog(event, "evtUpdateConfig", log); err != nil {
+				event := new(NodeManagerVotingTallied)
+				if err := _NodeManager.contract.UnpackLog(event, "votingTallied", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1513,21 +4170,21 @@ func (_NodeManager *NodeManagerFilterer) WatchUpdateConfig(opts *bind.WatchOpts,
 	}), nil
 }
 
-// ParseUpdateConfig is a log parse operation binding the contract event 0x0ea859a9f788215a0a769be70546414d2c2bd5c6eac02eb711b7c47d0472bd76.
+// ParseVotingTallied is a log parse operation binding the contract event 0x894350eb47a1a01b7f5b08dc2b5522e2737ce3a3998c2570855b75c7ce5c9f47.
 //
-// Solidity: event evtUpdateConfig((uint32,uint32,uint32,uint32) Config)
-func (_NodeManager *NodeManagerFilterer) ParseUpdateConfig(log types.Log) (*NodeManagerUpdateConfig, error) {
-	event := new(NodeManagerUpdateConfig)
-	if err := _NodeManager.contract.UnpackLog(event, "evtUpdateConfig", log); err != nil {
+// Solidity: event votingTallied(uint64 EpochID, bytes Winner)
+func (_NodeManager *NodeManagerFilterer) ParseVotingTallied(log types.Log) (*NodeManagerVotingTallied, error) {
+	event := new(NodeManagerVotingTallied)
+	if err := _NodeManager.contract.UnpackLog(event, "votingTallied", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NodeManagerWhiteNodeIterator is returned from FilterWhiteNode and is used to iterate over the raw logs and unpacked data for WhiteNode events raised by the NodeManager contract.
-type NodeManagerWhiteNodeIterator struct {
-	Event *NodeManagerWhiteNode // Event containing the contract specifics and raw log
+// NodeManagerVrfSubmittedIterator is returned from FilterVrfSubmitted and is used to iterate over the raw logs and unpacked data for VrfSubmitted events raised by the NodeManager contract.
+type NodeManagerVrfSubmittedIterator struct {
+	Event *NodeManagerVrfSubmitted // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1541,7 +4198,7 @@ type NodeManagerWhiteNodeIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NodeManagerWhiteNodeIterator) Next() bool {
+func (it *NodeManagerVrfSubmittedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1550,7 +4207,7 @@ func (it *NodeManagerWhiteNodeIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NodeManagerWhiteNode)
+			it.Event = new(NodeManagerVrfSubmitted)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1565,7 +4222,7 @@ func (it *NodeManagerWhiteNodeIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NodeManagerWhiteNode)
+		it.Event = new(NodeManagerVrfSubmitted)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1581,41 +4238,43 @@ func (it *NodeManagerWhiteNodeIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NodeManagerWhiteNodeIterator) Error() error {
+func (it *NodeManagerVrfSubmittedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NodeManagerWhiteNodeIterator) Close() error {
+func (it *NodeManagerVrfSubmittedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NodeManagerWhiteNode represents a WhiteNode event raised by the NodeManager contract.
-type NodeManagerWhiteNode struct {
-	Pubkey string
-	Raw    types.Log // Blockchain specific contextual infos
+// NodeManagerVrfSubmitted represents a VrfSubmitted event raised by the NodeManager contract.
+type NodeManagerVrfSubmitted struct {
+	EpochID   uint64
+	Validator common.Address
+	Output    []byte
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterWhiteNode is a free log retrieval operation binding the contract event 0xb7d8dbc21c076ae2b1b72557e8ef8ad0a55e17e2eba9a97d5f132cae3e533cd1.
+// FilterVrfSubmitted is a free log retrieval operation binding the contract event 0xa4d329d786e72b458b5d93f5e114443f8446c7f96c025cec83c10b567e820b2a.
 //
-// Solidity: event evtWhiteNode(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) FilterWhiteNode(opts *bind.FilterOpts) (*NodeManagerWhiteNodeIterator, error) {
+// Solidity: event vrfSubmitted(uint64 EpochID, address Validator, bytes Output)
+func (_NodeManager *NodeManagerFilterer) FilterVrfSubmitted(opts *bind.FilterOpts) (*NodeManagerVrfSubmittedIterator, error) {
 
-	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "evtWhiteNode")
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "vrfSubmitted")
 	if err != nil {
 		return nil, err
 	}
-	return &NodeManagerWhiteNodeIterator{contract: _NodeManager.contract, event: "evtWhiteNode", logs: logs, sub: sub}, nil
+	return &NodeManagerVrfSubmittedIterator{contract: _NodeManager.contract, event: "vrfSubmitted", logs: logs, sub: sub}, nil
 }
 
-// WatchWhiteNode is a free log subscription operation binding the contract event 0xb7d8dbc21c076ae2b1b72557e8ef8ad0a55e17e2eba9a97d5f132cae3e533cd1.
+// WatchVrfSubmitted is a free log subscription operation binding the contract event 0xa4d329d786e72b458b5d93f5e114443f8446c7f96c025cec83c10b567e820b2a.
 //
-// Solidity: event evtWhiteNode(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) WatchWhiteNode(opts *bind.WatchOpts, sink chan<- *NodeManagerWhiteNode) (event.Subscription, error) {
+// Solidity: event vrfSubmitted(uint64 EpochID, address Validator, bytes Output)
+func (_NodeManager *NodeManagerFilterer) WatchVrfSubmitted(opts *bind.WatchOpts, sink chan<- *NodeManagerVrfSubmitted) (event.Subscription, error) {
 
-	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "evtWhiteNode")
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "vrfSubmitted")
 	if err != nil {
 		return nil, err
 	}
@@ -1625,8 +4284,8 @@ func (_NodeManager *NodeManagerFilterer) WatchWhiteNode(opts *bind.WatchOpts, si
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NodeManagerWhiteNode)
-				if err := _NodeManager.contract.UnpackLog(event, "evtWhiteNode", log); err != nil {
+				event := new(NodeManagerVrfSubmitted)
+				if err := _NodeManager.contract.UnpackLog(event, "vrfSubmitted", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1647,12 +4306,12 @@ func (_NodeManager *NodeManagerFilterer) WatchWhiteNode(opts *bind.WatchOpts, si
 	}), nil
 }
 
-// ParseWhiteNode is a log parse operation binding the contract event 0xb7d8dbc21c076ae2b1b72557e8ef8ad0a55e17e2eba9a97d5f132cae3e533cd1.
+// ParseVrfSubmitted is a log parse operation binding the contract event 0xa4d329d786e72b458b5d93f5e114443f8446c7f96c025cec83c10b567e820b2a.
 //
-// Solidity: event evtWhiteNode(string Pubkey)
-func (_NodeManager *NodeManagerFilterer) ParseWhiteNode(log types.Log) (*NodeManagerWhiteNode, error) {
-	event := new(NodeManagerWhiteNode)
-	if err := _NodeManager.contract.UnpackLog(event, "evtWhiteNode", log); err != nil {
+// Solidity: event vrfSubmitted(uint64 EpochID, address Validator, bytes Output)
+func (_NodeManager *NodeManagerFilterer) ParseVrfSubmitted(log types.Log) (*NodeManagerVrfSubmitted, error) {
+	event := new(NodeManagerVrfSubmitted)
+	if err := _NodeManager.contract.UnpackLog(event, "vrfSubmitted", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
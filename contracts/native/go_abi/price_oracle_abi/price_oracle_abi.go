@@ -0,0 +1,667 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package price_oracle_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodConvert = "convert"
+
+	MethodFeed = "feed"
+
+	MethodName = "name"
+
+	MethodPrice = "price"
+
+	MethodSetFeed = "setFeed"
+
+	MethodSubmitPrice = "submitPrice"
+)
+
+// PriceOracleABI is the input ABI used to generate the binding from.
+const PriceOracleABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feed\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"MaxAge\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MaxDeviation\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"price\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Round\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"Price\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"convert\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"Value\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setFeed\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"MaxAge\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MaxDeviation\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"submitPrice\",\"inputs\":[{\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"Round\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"Price\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"feedUpdated\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"MaxAge\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"MaxDeviation\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"priceUpdated\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"FeedID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Round\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Price\",\"type\":\"uint256\"}]}]"
+
+// PriceOracleFuncSigs maps the 4-byte function signature to its string representation.
+var PriceOracleFuncSigs = map[string]string{
+	"1181dfc5": "convert(string,uint256)",
+	"e73ade8e": "feed(string)",
+	"06fdde03": "name()",
+	"fe2c6198": "price(string)",
+	"ff5528bf": "setFeed(string,uint64,uint64)",
+	"41d7b3fe": "submitPrice(string,uint64,uint256)",
+}
+
+// PriceOracle is an auto generated Go binding around an Ethereum contract.
+type PriceOracle struct {
+	PriceOracleCaller     // Read-only binding to the contract
+	PriceOracleTransactor // Write-only binding to the contract
+	PriceOracleFilterer   // Log filterer for contract events
+}
+
+// PriceOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PriceOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PriceOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PriceOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PriceOracleSession struct {
+	Contract     *PriceOracle      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PriceOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PriceOracleCallerSession struct {
+	Contract *PriceOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// PriceOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PriceOracleTransactorSession struct {
+	Contract     *PriceOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// PriceOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PriceOracleRaw struct {
+	Contract *PriceOracle // Generic contract binding to access the raw methods on
+}
+
+// PriceOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PriceOracleCallerRaw struct {
+	Contract *PriceOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PriceOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PriceOracleTransactorRaw struct {
+	Contract *PriceOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPriceOracle creates a new instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracle(address common.Address, backend bind.ContractBackend) (*PriceOracle, error) {
+	contract, err := bindPriceOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracle{PriceOracleCaller: PriceOracleCaller{contract: contract}, PriceOracleTransactor: PriceOracleTransactor{contract: contract}, PriceOracleFilterer: PriceOracleFilterer{contract: contract}}, nil
+}
+
+// NewPriceOracleCaller creates a new read-only instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracleCaller(address common.Address, caller bind.ContractCaller) (*PriceOracleCaller, error) {
+	contract, err := bindPriceOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleCaller{contract: contract}, nil
+}
+
+// NewPriceOracleTransactor creates a new write-only instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*PriceOracleTransactor, error) {
+	contract, err := bindPriceOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleTransactor{contract: contract}, nil
+}
+
+// NewPriceOracleFilterer creates a new log filterer instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*PriceOracleFilterer, error) {
+	contract, err := bindPriceOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleFilterer{contract: contract}, nil
+}
+
+// bindPriceOracle binds a generic wrapper to an already deployed contract.
+func bindPriceOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(PriceOracleABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceOracle *PriceOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PriceOracle.Contract.PriceOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceOracle *PriceOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceOracle.Contract.PriceOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceOracle *PriceOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceOracle.Contract.PriceOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceOracle *PriceOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PriceOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceOracle *PriceOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceOracle *PriceOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// Convert is a free data retrieval call binding the contract method 0x1181dfc5.
+//
+// Solidity: function convert(string FeedID, uint256 Amount) view returns(uint256 Value)
+func (_PriceOracle *PriceOracleCaller) Convert(opts *bind.CallOpts, FeedID string, Amount *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "convert", FeedID, Amount)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Convert is a free data retrieval call binding the contract method 0x1181dfc5.
+//
+// Solidity: function convert(string FeedID, uint256 Amount) view returns(uint256 Value)
+func (_PriceOracle *PriceOracleSession) Convert(FeedID string, Amount *big.Int) (*big.Int, error) {
+	return _PriceOracle.Contract.Convert(&_PriceOracle.CallOpts, FeedID, Amount)
+}
+
+// Convert is a free data retrieval call binding the contract method 0x1181dfc5.
+//
+// Solidity: function convert(string FeedID, uint256 Amount) view returns(uint256 Value)
+func (_PriceOracle *PriceOracleCallerSession) Convert(FeedID string, Amount *big.Int) (*big.Int, error) {
+	return _PriceOracle.Contract.Convert(&_PriceOracle.CallOpts, FeedID, Amount)
+}
+
+// Feed is a free data retrieval call binding the contract method 0xe73ade8e.
+//
+// Solidity: function feed(string FeedID) view returns(uint64 MaxAge, uint64 MaxDeviation)
+func (_PriceOracle *PriceOracleCaller) Feed(opts *bind.CallOpts, FeedID string) (struct {
+	MaxAge       uint64
+	MaxDeviation uint64
+}, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "feed", FeedID)
+
+	outstruct := new(struct {
+		MaxAge       uint64
+		MaxDeviation uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.MaxAge = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.MaxDeviation = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// Feed is a free data retrieval call binding the contract method 0xe73ade8e.
+//
+// Solidity: function feed(string FeedID) view returns(uint64 MaxAge, uint64 MaxDeviation)
+func (_PriceOracle *PriceOracleSession) Feed(FeedID string) (struct {
+	MaxAge       uint64
+	MaxDeviation uint64
+}, error) {
+	return _PriceOracle.Contract.Feed(&_PriceOracle.CallOpts, FeedID)
+}
+
+// Feed is a free data retrieval call binding the contract method 0xe73ade8e.
+//
+// Solidity: function feed(string FeedID) view returns(uint64 MaxAge, uint64 MaxDeviation)
+func (_PriceOracle *PriceOracleCallerSession) Feed(FeedID string) (struct {
+	MaxAge       uint64
+	MaxDeviation uint64
+}, error) {
+	return _PriceOracle.Contract.Feed(&_PriceOracle.CallOpts, FeedID)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_PriceOracle *PriceOracleCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_PriceOracle *PriceOracleSession) Name() (string, error) {
+	return _PriceOracle.Contract.Name(&_PriceOracle.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_PriceOracle *PriceOracleCallerSession) Name() (string, error) {
+	return _PriceOracle.Contract.Name(&_PriceOracle.CallOpts)
+}
+
+// Price is a free data retrieval call binding the contract method 0xfe2c6198.
+//
+// Solidity: function price(string FeedID) view returns(uint64 Round, uint256 Price, uint64 Height)
+func (_PriceOracle *PriceOracleCaller) Price(opts *bind.CallOpts, FeedID string) (struct {
+	Round  uint64
+	Price  *big.Int
+	Height uint64
+}, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "price", FeedID)
+
+	outstruct := new(struct {
+		Round  uint64
+		Price  *big.Int
+		Height uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Round = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Price = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Height = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// Price is a free data retrieval call binding the contract method 0xfe2c6198.
+//
+// Solidity: function price(string FeedID) view returns(uint64 Round, uint256 Price, uint64 Height)
+func (_PriceOracle *PriceOracleSession) Price(FeedID string) (struct {
+	Round  uint64
+	Price  *big.Int
+	Height uint64
+}, error) {
+	return _PriceOracle.Contract.Price(&_PriceOracle.CallOpts, FeedID)
+}
+
+// Price is a free data retrieval call binding the contract method 0xfe2c6198.
+//
+// Solidity: function price(string FeedID) view returns(uint64 Round, uint256 Price, uint64 Height)
+func (_PriceOracle *PriceOracleCallerSession) Price(FeedID string) (struct {
+	Round  uint64
+	Price  *big.Int
+	Height uint64
+}, error) {
+	return _PriceOracle.Contract.Price(&_PriceOracle.CallOpts, FeedID)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xff5528bf.
+//
+// Solidity: function setFeed(string FeedID, uint64 MaxAge, uint64 MaxDeviation) returns(bool Success)
+func (_PriceOracle *PriceOracleTransactor) SetFeed(opts *bind.TransactOpts, FeedID string, MaxAge uint64, MaxDeviation uint64) (*types.Transaction, error) {
+	return _PriceOracle.contract.Transact(opts, "setFeed", FeedID, MaxAge, MaxDeviation)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xff5528bf.
+//
+// Solidity: function setFeed(string FeedID, uint64 MaxAge, uint64 MaxDeviation) returns(bool Success)
+func (_PriceOracle *PriceOracleSession) SetFeed(FeedID string, MaxAge uint64, MaxDeviation uint64) (*types.Transaction, error) {
+	return _PriceOracle.Contract.SetFeed(&_PriceOracle.TransactOpts, FeedID, MaxAge, MaxDeviation)
+}
+
+// SetFeed is a paid mutator transaction binding the contract method 0xff5528bf.
+//
+// Solidity: function setFeed(string FeedID, uint64 MaxAge, uint64 MaxDeviation) returns(bool Success)
+func (_PriceOracle *PriceOracleTransactorSession) SetFeed(FeedID string, MaxAge uint64, MaxDeviation uint64) (*types.Transaction, error) {
+	return _PriceOracle.Contract.SetFeed(&_PriceOracle.TransactOpts, FeedID, MaxAge, MaxDeviation)
+}
+
+// SubmitPrice is a paid mutator transaction binding the contract method 0x41d7b3fe.
+//
+// Solidity: function submitPrice(string FeedID, uint64 Round, uint256 Price) returns(bool Success)
+func (_PriceOracle *PriceOracleTransactor) SubmitPrice(opts *bind.TransactOpts, FeedID string, Round uint64, Price *big.Int) (*types.Transaction, error) {
+	return _PriceOracle.contract.Transact(opts, "submitPrice", FeedID, Round, Price)
+}
+
+// SubmitPrice is a paid mutator transaction binding the contract method 0x41d7b3fe.
+//
+// Solidity: function submitPrice(string FeedID, uint64 Round, uint256 Price) returns(bool Success)
+func (_PriceOracle *PriceOracleSession) SubmitPrice(FeedID string, Round uint64, Price *big.Int) (*types.Transaction, error) {
+	return _PriceOracle.Contract.SubmitPrice(&_PriceOracle.TransactOpts, FeedID, Round, Price)
+}
+
+// SubmitPrice is a paid mutator transaction binding the contract method 0x41d7b3fe.
+//
+// Solidity: function submitPrice(string FeedID, uint64 Round, uint256 Price) returns(bool Success)
+func (_PriceOracle *PriceOracleTransactorSession) SubmitPrice(FeedID string, Round uint64, Price *big.Int) (*types.Transaction, error) {
+	return _PriceOracle.Contract.SubmitPrice(&_PriceOracle.TransactOpts, FeedID, Round, Price)
+}
+
+// PriceOracleFeedUpdatedIterator is returned from FilterFeedUpdated and is used to iterate over the raw logs and unpacked data for FeedUpdated events raised by the PriceOracle contract.
+type PriceOracleFeedUpdatedIterator struct {
+	Event *PriceOracleFeedUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PriceOracleFeedUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PriceOracleFeedUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PriceOracleFeedUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PriceOracleFeedUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PriceOracleFeedUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PriceOracleFeedUpdated represents a FeedUpdated event raised by the PriceOracle contract.
+type PriceOracleFeedUpdated struct {
+	FeedID       string
+	MaxAge       uint64
+	MaxDeviation uint64
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeedUpdated is a free log retrieval operation binding the contract event 0x23db082e2663c8eb883eedb5336148d63ef2e5e797a1d7c2bd6386bccd814105.
+//
+// Solidity: event feedUpdated(string FeedID, uint64 MaxAge, uint64 MaxDeviation)
+func (_PriceOracle *PriceOracleFilterer) FilterFeedUpdated(opts *bind.FilterOpts) (*PriceOracleFeedUpdatedIterator, error) {
+
+	logs, sub, err := _PriceOracle.contract.FilterLogs(opts, "feedUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleFeedUpdatedIterator{contract: _PriceOracle.contract, event: "feedUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFeedUpdated is a free log subscription operation binding the contract event 0x23db082e2663c8eb883eedb5336148d63ef2e5e797a1d7c2bd6386bccd814105.
+//
+// Solidity: event feedUpdated(string FeedID, uint64 MaxAge, uint64 MaxDeviation)
+func (_PriceOracle *PriceOracleFilterer) WatchFeedUpdated(opts *bind.WatchOpts, sink chan<- *PriceOracleFeedUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _PriceOracle.contract.WatchLogs(opts, "feedUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PriceOracleFeedUpdated)
+				if err := _PriceOracle.contract.UnpackLog(event, "feedUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeedUpdated is a log parse operation binding the contract event 0x23db082e2663c8eb883eedb5336148d63ef2e5e797a1d7c2bd6386bccd814105.
+//
+// Solidity: event feedUpdated(string FeedID, uint64 MaxAge, uint64 MaxDeviation)
+func (_PriceOracle *PriceOracleFilterer) ParseFeedUpdated(log types.Log) (*PriceOracleFeedUpdated, error) {
+	event := new(PriceOracleFeedUpdated)
+	if err := _PriceOracle.contract.UnpackLog(event, "feedUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PriceOraclePriceUpdatedIterator is returned from FilterPriceUpdated and is used to iterate over the raw logs and unpacked data for PriceUpdated events raised by the PriceOracle contract.
+type PriceOraclePriceUpdatedIterator struct {
+	Event *PriceOraclePriceUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PriceOraclePriceUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PriceOraclePriceUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PriceOraclePriceUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PriceOraclePriceUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PriceOraclePriceUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PriceOraclePriceUpdated represents a PriceUpdated event raised by the PriceOracle contract.
+type PriceOraclePriceUpdated struct {
+	FeedID string
+	Round  uint64
+	Price  *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterPriceUpdated is a free log retrieval operation binding the contract event 0x1054ed9e124def6d527d66ea1d89de7f55d8169afd0bd093b0856eb059c4e06b.
+//
+// Solidity: event priceUpdated(string FeedID, uint64 Round, uint256 Price)
+func (_PriceOracle *PriceOracleFilterer) FilterPriceUpdated(opts *bind.FilterOpts) (*PriceOraclePriceUpdatedIterator, error) {
+
+	logs, sub, err := _PriceOracle.contract.FilterLogs(opts, "priceUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOraclePriceUpdatedIterator{contract: _PriceOracle.contract, event: "priceUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPriceUpdated is a free log subscription operation binding the contract event 0x1054ed9e124def6d527d66ea1d89de7f55d8169afd0bd093b0856eb059c4e06b.
+//
+// Solidity: event priceUpdated(string FeedID, uint64 Round, uint256 Price)
+func (_PriceOracle *PriceOracleFilterer) WatchPriceUpdated(opts *bind.WatchOpts, sink chan<- *PriceOraclePriceUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _PriceOracle.contract.WatchLogs(opts, "priceUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PriceOraclePriceUpdated)
+				if err := _PriceOracle.contract.UnpackLog(event, "priceUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePriceUpdated is a log parse operation binding the contract event 0x1054ed9e124def6d527d66ea1d89de7f55d8169afd0bd093b0856eb059c4e06b.
+//
+// Solidity: event priceUpdated(string FeedID, uint64 Round, uint256 Price)
+func (_PriceOracle *PriceOracleFilterer) ParsePriceUpdated(log types.Log) (*PriceOraclePriceUpdated, error) {
+	event := new(PriceOraclePriceUpdated)
+	if err := _PriceOracle.contract.UnpackLog(event, "priceUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
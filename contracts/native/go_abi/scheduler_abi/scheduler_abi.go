@@ -0,0 +1,622 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package scheduler_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodName = "name"
+
+	MethodPending = "pending"
+
+	MethodTask = "task"
+
+	MethodCancel = "cancel"
+
+	MethodSchedule = "schedule"
+)
+
+// SchedulerABI is the input ABI used to generate the binding from.
+const SchedulerABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"task\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"Target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"Payload\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"Status\",\"type\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pending\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"IDs\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"schedule\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Payload\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cancel\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"taskScheduled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Target\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"taskCanceled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}]}]"
+
+// SchedulerFuncSigs maps the 4-byte function signature to its string representation.
+var SchedulerFuncSigs = map[string]string{
+	"4c125e79": "cancel(uint64)",
+	"06fdde03": "name()",
+	"19a64c1e": "pending(uint64)",
+	"84853b2c": "schedule(uint64,bytes)",
+	"63cbcb4f": "task(uint64)",
+}
+
+// Scheduler is an auto generated Go binding around an Ethereum contract.
+type Scheduler struct {
+	SchedulerCaller     // Read-only binding to the contract
+	SchedulerTransactor // Write-only binding to the contract
+	SchedulerFilterer   // Log filterer for contract events
+}
+
+// SchedulerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SchedulerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchedulerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SchedulerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchedulerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SchedulerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchedulerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SchedulerSession struct {
+	Contract     *Scheduler        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SchedulerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SchedulerCallerSession struct {
+	Contract *SchedulerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// SchedulerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SchedulerTransactorSession struct {
+	Contract     *SchedulerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// SchedulerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SchedulerRaw struct {
+	Contract *Scheduler // Generic contract binding to access the raw methods on
+}
+
+// SchedulerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SchedulerCallerRaw struct {
+	Contract *SchedulerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SchedulerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SchedulerTransactorRaw struct {
+	Contract *SchedulerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewScheduler creates a new instance of Scheduler, bound to a specific deployed contract.
+func NewScheduler(address common.Address, backend bind.ContractBackend) (*Scheduler, error) {
+	contract, err := bindScheduler(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Scheduler{SchedulerCaller: SchedulerCaller{contract: contract}, SchedulerTransactor: SchedulerTransactor{contract: contract}, SchedulerFilterer: SchedulerFilterer{contract: contract}}, nil
+}
+
+// NewSchedulerCaller creates a new read-only instance of Scheduler, bound to a specific deployed contract.
+func NewSchedulerCaller(address common.Address, caller bind.ContractCaller) (*SchedulerCaller, error) {
+	contract, err := bindScheduler(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SchedulerCaller{contract: contract}, nil
+}
+
+// NewSchedulerTransactor creates a new write-only instance of Scheduler, bound to a specific deployed contract.
+func NewSchedulerTransactor(address common.Address, transactor bind.ContractTransactor) (*SchedulerTransactor, error) {
+	contract, err := bindScheduler(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SchedulerTransactor{contract: contract}, nil
+}
+
+// NewSchedulerFilterer creates a new log filterer instance of Scheduler, bound to a specific deployed contract.
+func NewSchedulerFilterer(address common.Address, filterer bind.ContractFilterer) (*SchedulerFilterer, error) {
+	contract, err := bindScheduler(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SchedulerFilterer{contract: contract}, nil
+}
+
+// bindScheduler binds a generic wrapper to an already deployed contract.
+func bindScheduler(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(SchedulerABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Scheduler *SchedulerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Scheduler.Contract.SchedulerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Scheduler *SchedulerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Scheduler.Contract.SchedulerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Scheduler *SchedulerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Scheduler.Contract.SchedulerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Scheduler *SchedulerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Scheduler.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Scheduler *SchedulerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Scheduler.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Scheduler *SchedulerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Scheduler.Contract.contract.Transact(opts, method, params...)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Scheduler *SchedulerCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Scheduler.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Scheduler *SchedulerSession) Name() (string, error) {
+	return _Scheduler.Contract.Name(&_Scheduler.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Scheduler *SchedulerCallerSession) Name() (string, error) {
+	return _Scheduler.Contract.Name(&_Scheduler.CallOpts)
+}
+
+// Pending is a free data retrieval call binding the contract method 0x19a64c1e.
+//
+// Solidity: function pending(uint64 Height) view returns(uint64[] IDs)
+func (_Scheduler *SchedulerCaller) Pending(opts *bind.CallOpts, Height uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _Scheduler.contract.Call(opts, &out, "pending", Height)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// Pending is a free data retrieval call binding the contract method 0x19a64c1e.
+//
+// Solidity: function pending(uint64 Height) view returns(uint64[] IDs)
+func (_Scheduler *SchedulerSession) Pending(Height uint64) ([]uint64, error) {
+	return _Scheduler.Contract.Pending(&_Scheduler.CallOpts, Height)
+}
+
+// Pending is a free data retrieval call binding the contract method 0x19a64c1e.
+//
+// Solidity: function pending(uint64 Height) view returns(uint64[] IDs)
+func (_Scheduler *SchedulerCallerSession) Pending(Height uint64) ([]uint64, error) {
+	return _Scheduler.Contract.Pending(&_Scheduler.CallOpts, Height)
+}
+
+// Task is a free data retrieval call binding the contract method 0x63cbcb4f.
+//
+// Solidity: function task(uint64 ID) view returns(address Target, bytes Payload, uint64 Height, uint8 Status)
+func (_Scheduler *SchedulerCaller) Task(opts *bind.CallOpts, ID uint64) (struct {
+	Target  common.Address
+	Payload []byte
+	Height  uint64
+	Status  uint8
+}, error) {
+	var out []interface{}
+	err := _Scheduler.contract.Call(opts, &out, "task", ID)
+
+	outstruct := new(struct {
+		Target  common.Address
+		Payload []byte
+		Height  uint64
+		Status  uint8
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Target = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Payload = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.Height = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+	outstruct.Status = *abi.ConvertType(out[3], new(uint8)).(*uint8)
+
+	return *outstruct, err
+
+}
+
+// Task is a free data retrieval call binding the contract method 0x63cbcb4f.
+//
+// Solidity: function task(uint64 ID) view returns(address Target, bytes Payload, uint64 Height, uint8 Status)
+func (_Scheduler *SchedulerSession) Task(ID uint64) (struct {
+	Target  common.Address
+	Payload []byte
+	Height  uint64
+	Status  uint8
+}, error) {
+	return _Scheduler.Contract.Task(&_Scheduler.CallOpts, ID)
+}
+
+// Task is a free data retrieval call binding the contract method 0x63cbcb4f.
+//
+// Solidity: function task(uint64 ID) view returns(address Target, bytes Payload, uint64 Height, uint8 Status)
+func (_Scheduler *SchedulerCallerSession) Task(ID uint64) (struct {
+	Target  common.Address
+	Payload []byte
+	Height  uint64
+	Status  uint8
+}, error) {
+	return _Scheduler.Contract.Task(&_Scheduler.CallOpts, ID)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x4c125e79.
+//
+// Solidity: function cancel(uint64 ID) returns(bool Success)
+func (_Scheduler *SchedulerTransactor) Cancel(opts *bind.TransactOpts, ID uint64) (*types.Transaction, error) {
+	return _Scheduler.contract.Transact(opts, "cancel", ID)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x4c125e79.
+//
+// Solidity: function cancel(uint64 ID) returns(bool Success)
+func (_Scheduler *SchedulerSession) Cancel(ID uint64) (*types.Transaction, error) {
+	return _Scheduler.Contract.Cancel(&_Scheduler.TransactOpts, ID)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x4c125e79.
+//
+// Solidity: function cancel(uint64 ID) returns(bool Success)
+func (_Scheduler *SchedulerTransactorSession) Cancel(ID uint64) (*types.Transaction, error) {
+	return _Scheduler.Contract.Cancel(&_Scheduler.TransactOpts, ID)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0x84853b2c.
+//
+// Solidity: function schedule(uint64 Height, bytes Payload) returns(uint64 ID)
+func (_Scheduler *SchedulerTransactor) Schedule(opts *bind.TransactOpts, Height uint64, Payload []byte) (*types.Transaction, error) {
+	return _Scheduler.contract.Transact(opts, "schedule", Height, Payload)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0x84853b2c.
+//
+// Solidity: function schedule(uint64 Height, bytes Payload) returns(uint64 ID)
+func (_Scheduler *SchedulerSession) Schedule(Height uint64, Payload []byte) (*types.Transaction, error) {
+	return _Scheduler.Contract.Schedule(&_Scheduler.TransactOpts, Height, Payload)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0x84853b2c.
+//
+// Solidity: function schedule(uint64 Height, bytes Payload) returns(uint64 ID)
+func (_Scheduler *SchedulerTransactorSession) Schedule(Height uint64, Payload []byte) (*types.Transaction, error) {
+	return _Scheduler.Contract.Schedule(&_Scheduler.TransactOpts, Height, Payload)
+}
+
+// SchedulerTaskCanceledIterator is returned from FilterTaskCanceled and is used to iterate over the raw logs and unpacked data for TaskCanceled events raised by the Scheduler contract.
+type SchedulerTaskCanceledIterator struct {
+	Event *SchedulerTaskCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SchedulerTaskCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SchedulerTaskCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SchedulerTaskCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SchedulerTaskCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SchedulerTaskCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SchedulerTaskCanceled represents a TaskCanceled event raised by the Scheduler contract.
+type SchedulerTaskCanceled struct {
+	ID  uint64
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterTaskCanceled is a free log retrieval operation binding the contract event 0xed978ee71d7d131598a0da47ad9d3d4386425d6af367f5b1683e130cf3749142.
+//
+// Solidity: event taskCanceled(uint64 ID)
+func (_Scheduler *SchedulerFilterer) FilterTaskCanceled(opts *bind.FilterOpts) (*SchedulerTaskCanceledIterator, error) {
+
+	logs, sub, err := _Scheduler.contract.FilterLogs(opts, "taskCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return &SchedulerTaskCanceledIterator{contract: _Scheduler.contract, event: "taskCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchTaskCanceled is a free log subscription operation binding the contract event 0xed978ee71d7d131598a0da47ad9d3d4386425d6af367f5b1683e130cf3749142.
+//
+// Solidity: event taskCanceled(uint64 ID)
+func (_Scheduler *SchedulerFilterer) WatchTaskCanceled(opts *bind.WatchOpts, sink chan<- *SchedulerTaskCanceled) (event.Subscription, error) {
+
+	logs, sub, err := _Scheduler.contract.WatchLogs(opts, "taskCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SchedulerTaskCanceled)
+				if err := _Scheduler.contract.UnpackLog(event, "taskCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTaskCanceled is a log parse operation binding the contract event 0xed978ee71d7d131598a0da47ad9d3d4386425d6af367f5b1683e130cf3749142.
+//
+// Solidity: event taskCanceled(uint64 ID)
+func (_Scheduler *SchedulerFilterer) ParseTaskCanceled(log types.Log) (*SchedulerTaskCanceled, error) {
+	event := new(SchedulerTaskCanceled)
+	if err := _Scheduler.contract.UnpackLog(event, "taskCanceled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SchedulerTaskScheduledIterator is returned from FilterTaskScheduled and is used to iterate over the raw logs and unpacked data for TaskScheduled events raised by the Scheduler contract.
+type SchedulerTaskScheduledIterator struct {
+	Event *SchedulerTaskScheduled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SchedulerTaskScheduledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SchedulerTaskScheduled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SchedulerTaskScheduled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SchedulerTaskScheduledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SchedulerTaskScheduledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SchedulerTaskScheduled represents a TaskScheduled event raised by the Scheduler contract.
+type SchedulerTaskScheduled struct {
+	ID     uint64
+	Target common.Address
+	Height uint64
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTaskScheduled is a free log retrieval operation binding the contract event 0x2fba7de5e7a3f73f0fd2f39b097a2a6e46dc428b07bfdb7d4cc2683ec297a9d5.
+//
+// Solidity: event taskScheduled(uint64 ID, address Target, uint64 Height)
+func (_Scheduler *SchedulerFilterer) FilterTaskScheduled(opts *bind.FilterOpts) (*SchedulerTaskScheduledIterator, error) {
+
+	logs, sub, err := _Scheduler.contract.FilterLogs(opts, "taskScheduled")
+	if err != nil {
+		return nil, err
+	}
+	return &SchedulerTaskScheduledIterator{contract: _Scheduler.contract, event: "taskScheduled", logs: logs, sub: sub}, nil
+}
+
+// WatchTaskScheduled is a free log subscription operation binding the contract event 0x2fba7de5e7a3f73f0fd2f39b097a2a6e46dc428b07bfdb7d4cc2683ec297a9d5.
+//
+// Solidity: event taskScheduled(uint64 ID, address Target, uint64 Height)
+func (_Scheduler *SchedulerFilterer) WatchTaskScheduled(opts *bind.WatchOpts, sink chan<- *SchedulerTaskScheduled) (event.Subscription, error) {
+
+	logs, sub, err := _Scheduler.contract.WatchLogs(opts, "taskScheduled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SchedulerTaskScheduled)
+				if err := _Scheduler.contract.UnpackLog(event, "taskScheduled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTaskScheduled is a log parse operation binding the contract event 0x2fba7de5e7a3f73f0fd2f39b097a2a6e46dc428b07bfdb7d4cc2683ec297a9d5.
+//
+// Solidity: event taskScheduled(uint64 ID, address Target, uint64 Height)
+func (_Scheduler *SchedulerFilterer) ParseTaskScheduled(log types.Log) (*SchedulerTaskScheduled, error) {
+	event := new(SchedulerTaskScheduled)
+	if err := _Scheduler.contract.UnpackLog(event, "taskScheduled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
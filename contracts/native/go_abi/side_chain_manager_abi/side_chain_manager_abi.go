@@ -34,12 +34,22 @@ type side_chain_managerBtcTxParamDetial struct {
 }
 
 var (
+	MethodCcmcAddressAt = "ccmcAddressAt"
+
+	MethodShadowMode = "shadowMode"
+
+	MethodSideChainsInRange = "sideChainsInRange"
+
 	MethodApproveQuitSideChain = "approveQuitSideChain"
 
 	MethodApproveRegisterSideChain = "approveRegisterSideChain"
 
 	MethodApproveUpdateSideChain = "approveUpdateSideChain"
 
+	MethodExecuteUpdateSideChain = "executeUpdateSideChain"
+
+	MethodMigrateCCMC = "migrateCCMC"
+
 	MethodName = "name"
 
 	MethodQuitSideChain = "quitSideChain"
@@ -50,42 +60,33 @@ var (
 
 	MethodSetBtcTxParam = "setBtcTxParam"
 
+	MethodSetShadowMode = "setShadowMode"
+
 	MethodUpdateSideChain = "updateSideChain"
 )
 
 // SideChainManagerABI is the input ABI used to generate the binding from.
-const SideChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtApproveQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtApproveRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtApproveUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rk\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ContractAddress\",\"type\":\"string\"}],\"name\":\"evtRegisterRedeem\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"}],\"name\":\"evtRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rk\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"RedeemChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"FeeRate\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"MinChange\",\"type\":\"uint64\"}],\"name\":\"evtSetBtcTxParam\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"}],\"name\":\"evtUpdateSideChain\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveQuitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveRegisterSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"quitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"RedeemChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"ContractChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Redeem\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"CVersion\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"ContractAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes[]\",\"name\":\"Signs\",\"type\":\"bytes[]\"}],\"name\":\"registerRedeem\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ExtraInfo\",\"type\":\"bytes\"}],\"name\":\"registerSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"Redeem\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"RedeemChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"Sigs\",\"type\":\"bytes[]\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"PVersion\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"FeeRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MinChange\",\"type\":\"uint64\"}],\"internalType\":\"structside_chain_manager.BtcTxParamDetial\",\"name\":\"Detial\",\"type\":\"tuple\"}],\"name\":\"setBtcTxParam\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ExtraInfo\",\"type\":\"bytes\"}],\"name\":\"updateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const SideChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtApproveQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtApproveRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtApproveUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"evtQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rk\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ContractAddress\",\"type\":\"string\"}],\"name\":\"evtRegisterRedeem\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"}],\"name\":\"evtRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rk\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"RedeemChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"FeeRate\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"MinChange\",\"type\":\"uint64\"}],\"name\":\"evtSetBtcTxParam\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"}],\"name\":\"evtUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Until\",\"type\":\"uint64\"}],\"name\":\"evtSetShadowMode\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"name\":\"evtMigrateCCMC\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveQuitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveRegisterSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"approveUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"SideChain\",\"type\":\"bytes\"}],\"name\":\"executeUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"Range\",\"type\":\"uint8\"}],\"name\":\"sideChainsInRange\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Start\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"End\",\"type\":\"uint64\"},{\"internalType\":\"uint64[]\",\"name\":\"ChainIds\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Chainid\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"}],\"name\":\"quitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"RedeemChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"ContractChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Redeem\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"CVersion\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"ContractAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes[]\",\"name\":\"Signs\",\"type\":\"bytes[]\"}],\"name\":\"registerRedeem\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ExtraInfo\",\"type\":\"bytes\"}],\"name\":\"registerSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"Redeem\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"RedeemChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"Sigs\",\"type\":\"bytes[]\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"PVersion\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"FeeRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"MinChange\",\"type\":\"uint64\"}],\"internalType\":\"structside_chain_manager.BtcTxParamDetial\",\"name\":\"Detial\",\"type\":\"tuple\"}],\"name\":\"setBtcTxParam\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"Address\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"BlocksToWait\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ExtraInfo\",\"type\":\"bytes\"}],\"name\":\"updateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Blocks\",\"type\":\"uint64\"}],\"name\":\"setShadowMode\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"shadowMode\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Until\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"name\":\"migrateCCMC\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"}],\"name\":\"ccmcAddressAt\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 // SideChainManagerFuncSigs maps the 4-byte function signature to its string representation.
 var SideChainManagerFuncSigs = map[string]string{
 	"6c8ac5c1": "approveQuitSideChain(uint64,address)",
 	"65764e16": "approveRegisterSideChain(uint64,address)",
 	"805b508e": "approveUpdateSideChain(uint64,address)",
+	"1c7b1d0d": "ccmcAddressAt(uint64,uint64)",
+	"cba6c0ee": "executeUpdateSideChain(bytes)",
+	"2fb58be7": "migrateCCMC(uint64,bytes,uint64)",
 	"06fdde03": "name()",
 	"7460736e": "quitSideChain(uint64,address)",
 	"33e1d41a": "registerRedeem(uint64,uint64,bytes,uint64,bytes,bytes[])",
 	"ab7a2037": "registerSideChain(address,uint64,uint64,string,uint64,bytes,bytes)",
 	"ee9891e3": "setBtcTxParam(bytes,uint64,bytes[],(uint64,uint64,uint64))",
+	"13c0a7bc": "setShadowMode(uint64,uint64)",
+	"8e8dc8af": "shadowMode(uint64)",
+	"6788a25b": "sideChainsInRange(uint8)",
 	"f7782f81": "updateSideChain(address,uint64,uint64,string,uint64,bytes,bytes)",
 }
 
-// SideChainManagerBin is the compiled bytecode used for deploying new contracts.
-var SideChainManagerBin = "0x608060405234801561001057600080fd5b50610608806100206000396000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c80637460736e116100665780637460736e146100da578063805b508e146100da578063ab7a2037146100f0578063ee9891e31461010b578063f7782f81146100f057600080fd5b806306fdde031461009857806333e1d41a146100b057806365764e16146100da5780636c8ac5c1146100da575b600080fd5b60606040516100a7919061050d565b60405180910390f35b6100ca6100be366004610454565b60009695505050505050565b60405190151581526020016100a7565b6100ca6100e8366004610421565b600092915050565b6100ca6100fe36600461027a565b6000979650505050505050565b6100ca61011936600461035c565b6000949350505050565b600067ffffffffffffffff83111561013d5761013d6105bc565b610150601f8401601f191660200161058b565b905082815283838301111561016457600080fd5b828260208301376000602084830101529392505050565b80356001600160a01b038116811461019257600080fd5b919050565b600082601f8301126101a857600080fd5b8135602067ffffffffffffffff808311156101c5576101c56105bc565b8260051b6101d483820161058b565b8481528381019087850183890186018a10156101ef57600080fd5b60009350835b8781101561022c5781358681111561020b578586fd5b6102198c89838e010161023b565b85525092860192908601906001016101f5565b50909998505050505050505050565b600082601f83011261024c57600080fd5b61025b83833560208501610123565b9392505050565b803567ffffffffffffffff8116811461019257600080fd5b600080600080600080600060e0888a03121561029557600080fd5b61029e8861017b565b96506102ac60208901610262565b95506102ba60408901610262565b9450606088013567ffffffffffffffff808211156102d757600080fd5b818a0191508a601f8301126102eb57600080fd5b6102fa8b833560208501610123565b955061030860808b01610262565b945060a08a013591508082111561031e57600080fd5b61032a8b838c0161023b565b935060c08a013591508082111561034057600080fd5b5061034d8a828b0161023b565b91505092959891949750929550565b60008060008084860360c081121561037357600080fd5b853567ffffffffffffffff8082111561038b57600080fd5b61039789838a0161023b565b96506103a560208901610262565b955060408801359150808211156103bb57600080fd5b506103c888828901610197565b9350506060605f19820112156103dd57600080fd5b506103e6610562565b6103f260608701610262565b815261040060808701610262565b602082015261041160a08701610262565b6040820152939692955090935050565b6000806040838503121561043457600080fd5b61043d83610262565b915061044b6020840161017b565b90509250929050565b60008060008060008060c0878903121561046d57600080fd5b61047687610262565b955061048460208801610262565b9450604087013567ffffffffffffffff808211156104a157600080fd5b6104ad8a838b0161023b565b95506104bb60608a01610262565b945060808901359150808211156104d157600080fd5b6104dd8a838b0161023b565b935060a08901359150808211156104f357600080fd5b5061050089828a01610197565b9150509295509295509295565b600060208083528351808285015260005b8181101561053a5785810183015185820160400152820161051e565b8181111561054c576000604083870101525b50601f01601f1916929092016040019392505050565b6040516060810167ffffffffffffffff81118282101715610585576105856105bc565b60405290565b604051601f8201601f1916810167ffffffffffffffff811182821017156105b4576105b46105bc565b604052919050565b634e487b7160e01b600052604160045260246000fdfea26469706673582212201e30dd90c8f8c2bc2a8d03860a8d81c9f32d74209e8ba33de259f3bdaaaa078064736f6c63430008060033"
-
-// DeploySideChainManager deploys a new Ethereum contract, binding an instance of SideChainManager to it.
-func DeploySideChainManager(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SideChainManager, error) {
-	parsed, err := abi.JSON(strings.NewReader(SideChainManagerABI))
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(SideChainManagerBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &SideChainManager{SideChainManagerCaller: SideChainManagerCaller{contract: contract}, SideChainManagerTransactor: SideChainManagerTransactor{contract: contract}, SideChainManagerFilterer: SideChainManagerFilterer{contract: contract}}, nil
-}
-
 // SideChainManager is an auto generated Go binding around an Ethereum contract.
 type SideChainManager struct {
 	SideChainManagerCaller     // Read-only binding to the contract
@@ -228,6 +229,118 @@ func (_SideChainManager *SideChainManagerTransactorRaw) Transact(opts *bind.Tran
 	return _SideChainManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// CcmcAddressAt is a free data retrieval call binding the contract method 0x1c7b1d0d.
+//
+// Solidity: function ccmcAddressAt(uint64 ChainId, uint64 Height) view returns(bytes CCMCAddress)
+func (_SideChainManager *SideChainManagerCaller) CcmcAddressAt(opts *bind.CallOpts, ChainId uint64, Height uint64) ([]byte, error) {
+	var out []interface{}
+	err := _SideChainManager.contract.Call(opts, &out, "ccmcAddressAt", ChainId, Height)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// CcmcAddressAt is a free data retrieval call binding the contract method 0x1c7b1d0d.
+//
+// Solidity: function ccmcAddressAt(uint64 ChainId, uint64 Height) view returns(bytes CCMCAddress)
+func (_SideChainManager *SideChainManagerSession) CcmcAddressAt(ChainId uint64, Height uint64) ([]byte, error) {
+	return _SideChainManager.Contract.CcmcAddressAt(&_SideChainManager.CallOpts, ChainId, Height)
+}
+
+// CcmcAddressAt is a free data retrieval call binding the contract method 0x1c7b1d0d.
+//
+// Solidity: function ccmcAddressAt(uint64 ChainId, uint64 Height) view returns(bytes CCMCAddress)
+func (_SideChainManager *SideChainManagerCallerSession) CcmcAddressAt(ChainId uint64, Height uint64) ([]byte, error) {
+	return _SideChainManager.Contract.CcmcAddressAt(&_SideChainManager.CallOpts, ChainId, Height)
+}
+
+// ShadowMode is a free data retrieval call binding the contract method 0x8e8dc8af.
+//
+// Solidity: function shadowMode(uint64 ChainId) view returns(uint64 Until)
+func (_SideChainManager *SideChainManagerCaller) ShadowMode(opts *bind.CallOpts, ChainId uint64) (uint64, error) {
+	var out []interface{}
+	err := _SideChainManager.contract.Call(opts, &out, "shadowMode", ChainId)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// ShadowMode is a free data retrieval call binding the contract method 0x8e8dc8af.
+//
+// Solidity: function shadowMode(uint64 ChainId) view returns(uint64 Until)
+func (_SideChainManager *SideChainManagerSession) ShadowMode(ChainId uint64) (uint64, error) {
+	return _SideChainManager.Contract.ShadowMode(&_SideChainManager.CallOpts, ChainId)
+}
+
+// ShadowMode is a free data retrieval call binding the contract method 0x8e8dc8af.
+//
+// Solidity: function shadowMode(uint64 ChainId) view returns(uint64 Until)
+func (_SideChainManager *SideChainManagerCallerSession) ShadowMode(ChainId uint64) (uint64, error) {
+	return _SideChainManager.Contract.ShadowMode(&_SideChainManager.CallOpts, ChainId)
+}
+
+// SideChainsInRange is a free data retrieval call binding the contract method 0x6788a25b.
+//
+// Solidity: function sideChainsInRange(uint8 Range) view returns(uint64 Start, uint64 End, uint64[] ChainIds)
+func (_SideChainManager *SideChainManagerCaller) SideChainsInRange(opts *bind.CallOpts, Range uint8) (struct {
+	Start    uint64
+	End      uint64
+	ChainIds []uint64
+}, error) {
+	var out []interface{}
+	err := _SideChainManager.contract.Call(opts, &out, "sideChainsInRange", Range)
+
+	outstruct := new(struct {
+		Start    uint64
+		End      uint64
+		ChainIds []uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Start = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.End = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.ChainIds = *abi.ConvertType(out[2], new([]uint64)).(*[]uint64)
+
+	return *outstruct, err
+
+}
+
+// SideChainsInRange is a free data retrieval call binding the contract method 0x6788a25b.
+//
+// Solidity: function sideChainsInRange(uint8 Range) view returns(uint64 Start, uint64 End, uint64[] ChainIds)
+func (_SideChainManager *SideChainManagerSession) SideChainsInRange(Range uint8) (struct {
+	Start    uint64
+	End      uint64
+	ChainIds []uint64
+}, error) {
+	return _SideChainManager.Contract.SideChainsInRange(&_SideChainManager.CallOpts, Range)
+}
+
+// SideChainsInRange is a free data retrieval call binding the contract method 0x6788a25b.
+//
+// Solidity: function sideChainsInRange(uint8 Range) view returns(uint64 Start, uint64 End, uint64[] ChainIds)
+func (_SideChainManager *SideChainManagerCallerSession) SideChainsInRange(Range uint8) (struct {
+	Start    uint64
+	End      uint64
+	ChainIds []uint64
+}, error) {
+	return _SideChainManager.Contract.SideChainsInRange(&_SideChainManager.CallOpts, Range)
+}
+
 // ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x6c8ac5c1.
 //
 // Solidity: function approveQuitSideChain(uint64 Chainid, address Address) returns(bool success)
@@ -291,6 +404,48 @@ func (_SideChainManager *SideChainManagerTransactorSession) ApproveUpdateSideCha
 	return _SideChainManager.Contract.ApproveUpdateSideChain(&_SideChainManager.TransactOpts, Chainid, Address)
 }
 
+// ExecuteUpdateSideChain is a paid mutator transaction binding the contract method 0xcba6c0ee.
+//
+// Solidity: function executeUpdateSideChain(bytes SideChain) returns(bool success)
+func (_SideChainManager *SideChainManagerTransactor) ExecuteUpdateSideChain(opts *bind.TransactOpts, SideChain []byte) (*types.Transaction, error) {
+	return _SideChainManager.contract.Transact(opts, "executeUpdateSideChain", SideChain)
+}
+
+// ExecuteUpdateSideChain is a paid mutator transaction binding the contract method 0xcba6c0ee.
+//
+// Solidity: function executeUpdateSideChain(bytes SideChain) returns(bool success)
+func (_SideChainManager *SideChainManagerSession) ExecuteUpdateSideChain(SideChain []byte) (*types.Transaction, error) {
+	return _SideChainManager.Contract.ExecuteUpdateSideChain(&_SideChainManager.TransactOpts, SideChain)
+}
+
+// ExecuteUpdateSideChain is a paid mutator transaction binding the contract method 0xcba6c0ee.
+//
+// Solidity: function executeUpdateSideChain(bytes SideChain) returns(bool success)
+func (_SideChainManager *SideChainManagerTransactorSession) ExecuteUpdateSideChain(SideChain []byte) (*types.Transaction, error) {
+	return _SideChainManager.Contract.ExecuteUpdateSideChain(&_SideChainManager.TransactOpts, SideChain)
+}
+
+// MigrateCCMC is a paid mutator transaction binding the contract method 0x2fb58be7.
+//
+// Solidity: function migrateCCMC(uint64 ChainId, bytes CCMCAddress, uint64 Height) returns(bool success)
+func (_SideChainManager *SideChainManagerTransactor) MigrateCCMC(opts *bind.TransactOpts, ChainId uint64, CCMCAddress []byte, Height uint64) (*types.Transaction, error) {
+	return _SideChainManager.contract.Transact(opts, "migrateCCMC", ChainId, CCMCAddress, Height)
+}
+
+// MigrateCCMC is a paid mutator transaction binding the contract method 0x2fb58be7.
+//
+// Solidity: function migrateCCMC(uint64 ChainId, bytes CCMCAddress, uint64 Height) returns(bool success)
+func (_SideChainManager *SideChainManagerSession) MigrateCCMC(ChainId uint64, CCMCAddress []byte, Height uint64) (*types.Transaction, error) {
+	return _SideChainManager.Contract.MigrateCCMC(&_SideChainManager.TransactOpts, ChainId, CCMCAddress, Height)
+}
+
+// MigrateCCMC is a paid mutator transaction binding the contract method 0x2fb58be7.
+//
+// Solidity: function migrateCCMC(uint64 ChainId, bytes CCMCAddress, uint64 Height) returns(bool success)
+func (_SideChainManager *SideChainManagerTransactorSession) MigrateCCMC(ChainId uint64, CCMCAddress []byte, Height uint64) (*types.Transaction, error) {
+	return _SideChainManager.Contract.MigrateCCMC(&_SideChainManager.TransactOpts, ChainId, CCMCAddress, Height)
+}
+
 // Name is a paid mutator transaction binding the contract method 0x06fdde03.
 //
 // Solidity: function name() returns(string Name)
@@ -396,6 +551,27 @@ func (_SideChainManager *SideChainManagerTransactorSession) SetBtcTxParam(Redeem
 	return _SideChainManager.Contract.SetBtcTxParam(&_SideChainManager.TransactOpts, Redeem, RedeemChainId, Sigs, Detial)
 }
 
+// SetShadowMode is a paid mutator transaction binding the contract method 0x13c0a7bc.
+//
+// Solidity: function setShadowMode(uint64 ChainId, uint64 Blocks) returns(bool success)
+func (_SideChainManager *SideChainManagerTransactor) SetShadowMode(opts *bind.TransactOpts, ChainId uint64, Blocks uint64) (*types.Transaction, error) {
+	return _SideChainManager.contract.Transact(opts, "setShadowMode", ChainId, Blocks)
+}
+
+// SetShadowMode is a paid mutator transaction binding the contract method 0x13c0a7bc.
+//
+// Solidity: function setShadowMode(uint64 ChainId, uint64 Blocks) returns(bool success)
+func (_SideChainManager *SideChainManagerSession) SetShadowMode(ChainId uint64, Blocks uint64) (*types.Transaction, error) {
+	return _SideChainManager.Contract.SetShadowMode(&_SideChainManager.TransactOpts, ChainId, Blocks)
+}
+
+// SetShadowMode is a paid mutator transaction binding the contract method 0x13c0a7bc.
+//
+// Solidity: function setShadowMode(uint64 ChainId, uint64 Blocks) returns(bool success)
+func (_SideChainManager *SideChainManagerTransactorSession) SetShadowMode(ChainId uint64, Blocks uint64) (*types.Transaction, error) {
+	return _SideChainManager.Contract.SetShadowMode(&_SideChainManager.TransactOpts, ChainId, Blocks)
+}
+
 // UpdateSideChain is a paid mutator transaction binding the contract method 0xf7782f81.
 //
 // Solidity: function updateSideChain(address Address, uint64 ChainId, uint64 Router, string Name, uint64 BlocksToWait, bytes CCMCAddress, bytes ExtraInfo) returns(bool success)
@@ -819,6 +995,142 @@ func (_SideChainManager *SideChainManagerFilterer) ParseApproveUpdateSideChain(l
 	return event, nil
 }
 
+// SideChainManagerMigrateCCMCIterator is returned from FilterMigrateCCMC and is used to iterate over the raw logs and unpacked data for MigrateCCMC events raised by the SideChainManager contract.
+type SideChainManagerMigrateCCMCIterator struct {
+	Event *SideChainManagerMigrateCCMC // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SideChainManagerMigrateCCMCIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SideChainManagerMigrateCCMC)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SideChainManagerMigrateCCMC)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SideChainManagerMigrateCCMCIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SideChainManagerMigrateCCMCIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SideChainManagerMigrateCCMC represents a MigrateCCMC event raised by the SideChainManager contract.
+type SideChainManagerMigrateCCMC struct {
+	ChainId     uint64
+	CCMCAddress []byte
+	Height      uint64
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterMigrateCCMC is a free log retrieval operation binding the contract event 0xfe02a6ec9cafb7ce17566fc6885e2eda39d818ba1c2fcef8208f3092944e3398.
+//
+// Solidity: event evtMigrateCCMC(uint64 ChainId, bytes CCMCAddress, uint64 Height)
+func (_SideChainManager *SideChainManagerFilterer) FilterMigrateCCMC(opts *bind.FilterOpts) (*SideChainManagerMigrateCCMCIterator, error) {
+
+	logs, sub, err := _SideChainManager.contract.FilterLogs(opts, "evtMigrateCCMC")
+	if err != nil {
+		return nil, err
+	}
+	return &SideChainManagerMigrateCCMCIterator{contract: _SideChainManager.contract, event: "evtMigrateCCMC", logs: logs, sub: sub}, nil
+}
+
+// WatchMigrateCCMC is a free log subscription operation binding the contract event 0xfe02a6ec9cafb7ce17566fc6885e2eda39d818ba1c2fcef8208f3092944e3398.
+//
+// Solidity: event evtMigrateCCMC(uint64 ChainId, bytes CCMCAddress, uint64 Height)
+func (_SideChainManager *SideChainManagerFilterer) WatchMigrateCCMC(opts *bind.WatchOpts, sink chan<- *SideChainManagerMigrateCCMC) (event.Subscription, error) {
+
+	logs, sub, err := _SideChainManager.contract.WatchLogs(opts, "evtMigrateCCMC")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SideChainManagerMigrateCCMC)
+				if err := _SideChainManager.contract.UnpackLog(event, "evtMigrateCCMC", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMigrateCCMC is a log parse operation binding the contract event 0xfe02a6ec9cafb7ce17566fc6885e2eda39d818ba1c2fcef8208f3092944e3398.
+//
+// Solidity: event evtMigrateCCMC(uint64 ChainId, bytes CCMCAddress, uint64 Height)
+func (_SideChainManager *SideChainManagerFilterer) ParseMigrateCCMC(log types.Log) (*SideChainManagerMigrateCCMC, error) {
+	event := new(SideChainManagerMigrateCCMC)
+	if err := _SideChainManager.contract.UnpackLog(event, "evtMigrateCCMC", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // SideChainManagerQuitSideChainIterator is returned from FilterQuitSideChain and is used to iterate over the raw logs and unpacked data for QuitSideChain events raised by the SideChainManager contract.
 type SideChainManagerQuitSideChainIterator struct {
 	Event *SideChainManagerQuitSideChain // Event containing the contract specifics and raw log
@@ -1362,6 +1674,141 @@ func (_SideChainManager *SideChainManagerFilterer) ParseSetBtcTxParam(log types.
 	return event, nil
 }
 
+// SideChainManagerSetShadowModeIterator is returned from FilterSetShadowMode and is used to iterate over the raw logs and unpacked data for SetShadowMode events raised by the SideChainManager contract.
+type SideChainManagerSetShadowModeIterator struct {
+	Event *SideChainManagerSetShadowMode // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SideChainManagerSetShadowModeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SideChainManagerSetShadowMode)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SideChainManagerSetShadowMode)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SideChainManagerSetShadowModeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SideChainManagerSetShadowModeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SideChainManagerSetShadowMode represents a SetShadowMode event raised by the SideChainManager contract.
+type SideChainManagerSetShadowMode struct {
+	ChainId uint64
+	Until   uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetShadowMode is a free log retrieval operation binding the contract event 0xd28ff828238f9147fc4e0540f42e25f8cc4b679e245d022c82ff329cb7715650.
+//
+// Solidity: event evtSetShadowMode(uint64 ChainId, uint64 Until)
+func (_SideChainManager *SideChainManagerFilterer) FilterSetShadowMode(opts *bind.FilterOpts) (*SideChainManagerSetShadowModeIterator, error) {
+
+	logs, sub, err := _SideChainManager.contract.FilterLogs(opts, "evtSetShadowMode")
+	if err != nil {
+		return nil, err
+	}
+	return &SideChainManagerSetShadowModeIterator{contract: _SideChainManager.contract, event: "evtSetShadowMode", logs: logs, sub: sub}, nil
+}
+
+// WatchSetShadowMode is a free log subscription operation binding the contract event 0xd28ff828238f9147fc4e0540f42e25f8cc4b679e245d022c82ff329cb7715650.
+//
+// Solidity: event evtSetShadowMode(uint64 ChainId, uint64 Until)
+func (_SideChainManager *SideChainManagerFilterer) WatchSetShadowMode(opts *bind.WatchOpts, sink chan<- *SideChainManagerSetShadowMode) (event.Subscription, error) {
+
+	logs, sub, err := _SideChainManager.contract.WatchLogs(opts, "evtSetShadowMode")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SideChainManagerSetShadowMode)
+				if err := _SideChainManager.contract.UnpackLog(event, "evtSetShadowMode", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetShadowMode is a log parse operation binding the contract event 0xd28ff828238f9147fc4e0540f42e25f8cc4b679e245d022c82ff329cb7715650.
+//
+// Solidity: event evtSetShadowMode(uint64 ChainId, uint64 Until)
+func (_SideChainManager *SideChainManagerFilterer) ParseSetShadowMode(log types.Log) (*SideChainManagerSetShadowMode, error) {
+	event := new(SideChainManagerSetShadowMode)
+	if err := _SideChainManager.contract.UnpackLog(event, "evtSetShadowMode", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // SideChainManagerUpdateSideChainIterator is returned from FilterUpdateSideChain and is used to iterate over the raw logs and unpacked data for UpdateSideChain events raised by the SideChainManager contract.
 type SideChainManagerUpdateSideChainIterator struct {
 	Event *SideChainManagerUpdateSideChain // Event containing the contract specifics and raw log
@@ -0,0 +1,372 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package system_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodName = "name"
+
+	MethodFinalize = "finalize"
+)
+
+// SystemABI is the input ABI used to generate the binding from.
+const SystemABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finalize\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"hookFailed\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Reason\",\"type\":\"string\"}]}]"
+
+// SystemFuncSigs maps the 4-byte function signature to its string representation.
+var SystemFuncSigs = map[string]string{
+	"4bb278f3": "finalize()",
+	"06fdde03": "name()",
+}
+
+// System is an auto generated Go binding around an Ethereum contract.
+type System struct {
+	SystemCaller     // Read-only binding to the contract
+	SystemTransactor // Write-only binding to the contract
+	SystemFilterer   // Log filterer for contract events
+}
+
+// SystemCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SystemCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SystemTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SystemTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SystemFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SystemFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SystemSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SystemSession struct {
+	Contract     *System           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SystemCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SystemCallerSession struct {
+	Contract *SystemCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// SystemTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SystemTransactorSession struct {
+	Contract     *SystemTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SystemRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SystemRaw struct {
+	Contract *System // Generic contract binding to access the raw methods on
+}
+
+// SystemCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SystemCallerRaw struct {
+	Contract *SystemCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SystemTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SystemTransactorRaw struct {
+	Contract *SystemTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSystem creates a new instance of System, bound to a specific deployed contract.
+func NewSystem(address common.Address, backend bind.ContractBackend) (*System, error) {
+	contract, err := bindSystem(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &System{SystemCaller: SystemCaller{contract: contract}, SystemTransactor: SystemTransactor{contract: contract}, SystemFilterer: SystemFilterer{contract: contract}}, nil
+}
+
+// NewSystemCaller creates a new read-only instance of System, bound to a specific deployed contract.
+func NewSystemCaller(address common.Address, caller bind.ContractCaller) (*SystemCaller, error) {
+	contract, err := bindSystem(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SystemCaller{contract: contract}, nil
+}
+
+// NewSystemTransactor creates a new write-only instance of System, bound to a specific deployed contract.
+func NewSystemTransactor(address common.Address, transactor bind.ContractTransactor) (*SystemTransactor, error) {
+	contract, err := bindSystem(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SystemTransactor{contract: contract}, nil
+}
+
+// NewSystemFilterer creates a new log filterer instance of System, bound to a specific deployed contract.
+func NewSystemFilterer(address common.Address, filterer bind.ContractFilterer) (*SystemFilterer, error) {
+	contract, err := bindSystem(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SystemFilterer{contract: contract}, nil
+}
+
+// bindSystem binds a generic wrapper to an already deployed contract.
+func bindSystem(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(SystemABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_System *SystemRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _System.Contract.SystemCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_System *SystemRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _System.Contract.SystemTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_System *SystemRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _System.Contract.SystemTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_System *SystemCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _System.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_System *SystemTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _System.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_System *SystemTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _System.Contract.contract.Transact(opts, method, params...)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_System *SystemCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _System.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_System *SystemSession) Name() (string, error) {
+	return _System.Contract.Name(&_System.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_System *SystemCallerSession) Name() (string, error) {
+	return _System.Contract.Name(&_System.CallOpts)
+}
+
+// Finalize is a paid mutator transaction binding the contract method 0x4bb278f3.
+//
+// Solidity: function finalize() returns(bool Success)
+func (_System *SystemTransactor) Finalize(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _System.contract.Transact(opts, "finalize")
+}
+
+// Finalize is a paid mutator transaction binding the contract method 0x4bb278f3.
+//
+// Solidity: function finalize() returns(bool Success)
+func (_System *SystemSession) Finalize() (*types.Transaction, error) {
+	return _System.Contract.Finalize(&_System.TransactOpts)
+}
+
+// Finalize is a paid mutator transaction binding the contract method 0x4bb278f3.
+//
+// Solidity: function finalize() returns(bool Success)
+func (_System *SystemTransactorSession) Finalize() (*types.Transaction, error) {
+	return _System.Contract.Finalize(&_System.TransactOpts)
+}
+
+// SystemHookFailedIterator is returned from FilterHookFailed and is used to iterate over the raw logs and unpacked data for HookFailed events raised by the System contract.
+type SystemHookFailedIterator struct {
+	Event *SystemHookFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SystemHookFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SystemHookFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SystemHookFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SystemHookFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SystemHookFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SystemHookFailed represents a HookFailed event raised by the System contract.
+type SystemHookFailed struct {
+	Contract common.Address
+	Height   uint64
+	Reason   string
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterHookFailed is a free log retrieval operation binding the contract event 0x3c3b5bf9c3fb23179f198dafb96600beea0d0facc5c4614cf08a183631eea8a1.
+//
+// Solidity: event hookFailed(address Contract, uint64 Height, string Reason)
+func (_System *SystemFilterer) FilterHookFailed(opts *bind.FilterOpts) (*SystemHookFailedIterator, error) {
+
+	logs, sub, err := _System.contract.FilterLogs(opts, "hookFailed")
+	if err != nil {
+		return nil, err
+	}
+	return &SystemHookFailedIterator{contract: _System.contract, event: "hookFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchHookFailed is a free log subscription operation binding the contract event 0x3c3b5bf9c3fb23179f198dafb96600beea0d0facc5c4614cf08a183631eea8a1.
+//
+// Solidity: event hookFailed(address Contract, uint64 Height, string Reason)
+func (_System *SystemFilterer) WatchHookFailed(opts *bind.WatchOpts, sink chan<- *SystemHookFailed) (event.Subscription, error) {
+
+	logs, sub, err := _System.contract.WatchLogs(opts, "hookFailed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SystemHookFailed)
+				if err := _System.contract.UnpackLog(event, "hookFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseHookFailed is a log parse operation binding the contract event 0x3c3b5bf9c3fb23179f198dafb96600beea0d0facc5c4614cf08a183631eea8a1.
+//
+// Solidity: event hookFailed(address Contract, uint64 Height, string Reason)
+func (_System *SystemFilterer) ParseHookFailed(log types.Log) (*SystemHookFailed, error) {
+	event := new(SystemHookFailed)
+	if err := _System.contract.UnpackLog(event, "hookFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
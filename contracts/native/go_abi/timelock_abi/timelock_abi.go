@@ -0,0 +1,938 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package timelock_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodAction = "action"
+
+	MethodDelay = "delay"
+
+	MethodName = "name"
+
+	MethodCancel = "cancel"
+
+	MethodChangeDelay = "changeDelay"
+
+	MethodExecute = "execute"
+
+	MethodSchedule = "schedule"
+)
+
+// TimelockABI is the input ABI used to generate the binding from.
+const TimelockABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"delay\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"Delay\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"action\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"Target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"Payload\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"Eta\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"Status\",\"type\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"schedule\",\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"Payload\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"execute\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cancel\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"changeDelay\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"Delay\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"scheduled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Target\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Eta\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"executed\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"canceled\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ID\",\"type\":\"uint64\"}]},{\"type\":\"event\",\"name\":\"delayChanged\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Delay\",\"type\":\"uint64\"}]}]"
+
+// TimelockFuncSigs maps the 4-byte function signature to its string representation.
+var TimelockFuncSigs = map[string]string{
+	"f5276cd5": "action(uint64)",
+	"4c125e79": "cancel(uint64)",
+	"60911aa5": "changeDelay(uint64)",
+	"6a42b8f8": "delay()",
+	"b590be77": "execute(uint64)",
+	"06fdde03": "name()",
+	"db26e34d": "schedule(bytes)",
+}
+
+// Timelock is an auto generated Go binding around an Ethereum contract.
+type Timelock struct {
+	TimelockCaller     // Read-only binding to the contract
+	TimelockTransactor // Write-only binding to the contract
+	TimelockFilterer   // Log filterer for contract events
+}
+
+// TimelockCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TimelockCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TimelockTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TimelockTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TimelockFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TimelockFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TimelockSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TimelockSession struct {
+	Contract     *Timelock         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TimelockCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TimelockCallerSession struct {
+	Contract *TimelockCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// TimelockTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TimelockTransactorSession struct {
+	Contract     *TimelockTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// TimelockRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TimelockRaw struct {
+	Contract *Timelock // Generic contract binding to access the raw methods on
+}
+
+// TimelockCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TimelockCallerRaw struct {
+	Contract *TimelockCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TimelockTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TimelockTransactorRaw struct {
+	Contract *TimelockTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTimelock creates a new instance of Timelock, bound to a specific deployed contract.
+func NewTimelock(address common.Address, backend bind.ContractBackend) (*Timelock, error) {
+	contract, err := bindTimelock(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Timelock{TimelockCaller: TimelockCaller{contract: contract}, TimelockTransactor: TimelockTransactor{contract: contract}, TimelockFilterer: TimelockFilterer{contract: contract}}, nil
+}
+
+// NewTimelockCaller creates a new read-only instance of Timelock, bound to a specific deployed contract.
+func NewTimelockCaller(address common.Address, caller bind.ContractCaller) (*TimelockCaller, error) {
+	contract, err := bindTimelock(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockCaller{contract: contract}, nil
+}
+
+// NewTimelockTransactor creates a new write-only instance of Timelock, bound to a specific deployed contract.
+func NewTimelockTransactor(address common.Address, transactor bind.ContractTransactor) (*TimelockTransactor, error) {
+	contract, err := bindTimelock(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockTransactor{contract: contract}, nil
+}
+
+// NewTimelockFilterer creates a new log filterer instance of Timelock, bound to a specific deployed contract.
+func NewTimelockFilterer(address common.Address, filterer bind.ContractFilterer) (*TimelockFilterer, error) {
+	contract, err := bindTimelock(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockFilterer{contract: contract}, nil
+}
+
+// bindTimelock binds a generic wrapper to an already deployed contract.
+func bindTimelock(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(TimelockABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Timelock *TimelockRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Timelock.Contract.TimelockCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Timelock *TimelockRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Timelock.Contract.TimelockTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Timelock *TimelockRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Timelock.Contract.TimelockTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Timelock *TimelockCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Timelock.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Timelock *TimelockTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Timelock.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Timelock *TimelockTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Timelock.Contract.contract.Transact(opts, method, params...)
+}
+
+// Action is a free data retrieval call binding the contract method 0xf5276cd5.
+//
+// Solidity: function action(uint64 ID) view returns(address Target, bytes Payload, uint64 Eta, uint8 Status)
+func (_Timelock *TimelockCaller) Action(opts *bind.CallOpts, ID uint64) (struct {
+	Target  common.Address
+	Payload []byte
+	Eta     uint64
+	Status  uint8
+}, error) {
+	var out []interface{}
+	err := _Timelock.contract.Call(opts, &out, "action", ID)
+
+	outstruct := new(struct {
+		Target  common.Address
+		Payload []byte
+		Eta     uint64
+		Status  uint8
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Target = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Payload = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.Eta = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+	outstruct.Status = *abi.ConvertType(out[3], new(uint8)).(*uint8)
+
+	return *outstruct, err
+
+}
+
+// Action is a free data retrieval call binding the contract method 0xf5276cd5.
+//
+// Solidity: function action(uint64 ID) view returns(address Target, bytes Payload, uint64 Eta, uint8 Status)
+func (_Timelock *TimelockSession) Action(ID uint64) (struct {
+	Target  common.Address
+	Payload []byte
+	Eta     uint64
+	Status  uint8
+}, error) {
+	return _Timelock.Contract.Action(&_Timelock.CallOpts, ID)
+}
+
+// Action is a free data retrieval call binding the contract method 0xf5276cd5.
+//
+// Solidity: function action(uint64 ID) view returns(address Target, bytes Payload, uint64 Eta, uint8 Status)
+func (_Timelock *TimelockCallerSession) Action(ID uint64) (struct {
+	Target  common.Address
+	Payload []byte
+	Eta     uint64
+	Status  uint8
+}, error) {
+	return _Timelock.Contract.Action(&_Timelock.CallOpts, ID)
+}
+
+// Delay is a free data retrieval call binding the contract method 0x6a42b8f8.
+//
+// Solidity: function delay() view returns(uint64 Delay)
+func (_Timelock *TimelockCaller) Delay(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Timelock.contract.Call(opts, &out, "delay")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Delay is a free data retrieval call binding the contract method 0x6a42b8f8.
+//
+// Solidity: function delay() view returns(uint64 Delay)
+func (_Timelock *TimelockSession) Delay() (uint64, error) {
+	return _Timelock.Contract.Delay(&_Timelock.CallOpts)
+}
+
+// Delay is a free data retrieval call binding the contract method 0x6a42b8f8.
+//
+// Solidity: function delay() view returns(uint64 Delay)
+func (_Timelock *TimelockCallerSession) Delay() (uint64, error) {
+	return _Timelock.Contract.Delay(&_Timelock.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Timelock *TimelockCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Timelock.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Timelock *TimelockSession) Name() (string, error) {
+	return _Timelock.Contract.Name(&_Timelock.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_Timelock *TimelockCallerSession) Name() (string, error) {
+	return _Timelock.Contract.Name(&_Timelock.CallOpts)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x4c125e79.
+//
+// Solidity: function cancel(uint64 ID) returns(bool Success)
+func (_Timelock *TimelockTransactor) Cancel(opts *bind.TransactOpts, ID uint64) (*types.Transaction, error) {
+	return _Timelock.contract.Transact(opts, "cancel", ID)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x4c125e79.
+//
+// Solidity: function cancel(uint64 ID) returns(bool Success)
+func (_Timelock *TimelockSession) Cancel(ID uint64) (*types.Transaction, error) {
+	return _Timelock.Contract.Cancel(&_Timelock.TransactOpts, ID)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x4c125e79.
+//
+// Solidity: function cancel(uint64 ID) returns(bool Success)
+func (_Timelock *TimelockTransactorSession) Cancel(ID uint64) (*types.Transaction, error) {
+	return _Timelock.Contract.Cancel(&_Timelock.TransactOpts, ID)
+}
+
+// ChangeDelay is a paid mutator transaction binding the contract method 0x60911aa5.
+//
+// Solidity: function changeDelay(uint64 Delay) returns(bool Success)
+func (_Timelock *TimelockTransactor) ChangeDelay(opts *bind.TransactOpts, Delay uint64) (*types.Transaction, error) {
+	return _Timelock.contract.Transact(opts, "changeDelay", Delay)
+}
+
+// ChangeDelay is a paid mutator transaction binding the contract method 0x60911aa5.
+//
+// Solidity: function changeDelay(uint64 Delay) returns(bool Success)
+func (_Timelock *TimelockSession) ChangeDelay(Delay uint64) (*types.Transaction, error) {
+	return _Timelock.Contract.ChangeDelay(&_Timelock.TransactOpts, Delay)
+}
+
+// ChangeDelay is a paid mutator transaction binding the contract method 0x60911aa5.
+//
+// Solidity: function changeDelay(uint64 Delay) returns(bool Success)
+func (_Timelock *TimelockTransactorSession) ChangeDelay(Delay uint64) (*types.Transaction, error) {
+	return _Timelock.Contract.ChangeDelay(&_Timelock.TransactOpts, Delay)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb590be77.
+//
+// Solidity: function execute(uint64 ID) returns(bool Success)
+func (_Timelock *TimelockTransactor) Execute(opts *bind.TransactOpts, ID uint64) (*types.Transaction, error) {
+	return _Timelock.contract.Transact(opts, "execute", ID)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb590be77.
+//
+// Solidity: function execute(uint64 ID) returns(bool Success)
+func (_Timelock *TimelockSession) Execute(ID uint64) (*types.Transaction, error) {
+	return _Timelock.Contract.Execute(&_Timelock.TransactOpts, ID)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb590be77.
+//
+// Solidity: function execute(uint64 ID) returns(bool Success)
+func (_Timelock *TimelockTransactorSession) Execute(ID uint64) (*types.Transaction, error) {
+	return _Timelock.Contract.Execute(&_Timelock.TransactOpts, ID)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0xdb26e34d.
+//
+// Solidity: function schedule(bytes Payload) returns(uint64 ID)
+func (_Timelock *TimelockTransactor) Schedule(opts *bind.TransactOpts, Payload []byte) (*types.Transaction, error) {
+	return _Timelock.contract.Transact(opts, "schedule", Payload)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0xdb26e34d.
+//
+// Solidity: function schedule(bytes Payload) returns(uint64 ID)
+func (_Timelock *TimelockSession) Schedule(Payload []byte) (*types.Transaction, error) {
+	return _Timelock.Contract.Schedule(&_Timelock.TransactOpts, Payload)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0xdb26e34d.
+//
+// Solidity: function schedule(bytes Payload) returns(uint64 ID)
+func (_Timelock *TimelockTransactorSession) Schedule(Payload []byte) (*types.Transaction, error) {
+	return _Timelock.Contract.Schedule(&_Timelock.TransactOpts, Payload)
+}
+
+// TimelockCanceledIterator is returned from FilterCanceled and is used to iterate over the raw logs and unpacked data for Canceled events raised by the Timelock contract.
+type TimelockCanceledIterator struct {
+	Event *TimelockCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockCanceled represents a Canceled event raised by the Timelock contract.
+type TimelockCanceled struct {
+	ID  uint64
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterCanceled is a free log retrieval operation binding the contract event 0xdc1ccc238b11aa6c72aa59212e84f328f4d925fa3b1f6c4ed9c39cc3390870ec.
+//
+// Solidity: event canceled(uint64 ID)
+func (_Timelock *TimelockFilterer) FilterCanceled(opts *bind.FilterOpts) (*TimelockCanceledIterator, error) {
+
+	logs, sub, err := _Timelock.contract.FilterLogs(opts, "canceled")
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockCanceledIterator{contract: _Timelock.contract, event: "canceled", logs: logs, sub: sub}, nil
+}
+
+// WatchCanceled is a free log subscription operation binding the contract event 0xdc1ccc238b11aa6c72aa59212e84f328f4d925fa3b1f6c4ed9c39cc3390870ec.
+//
+// Solidity: event canceled(uint64 ID)
+func (_Timelock *TimelockFilterer) WatchCanceled(opts *bind.WatchOpts, sink chan<- *TimelockCanceled) (event.Subscription, error) {
+
+	logs, sub, err := _Timelock.contract.WatchLogs(opts, "canceled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockCanceled)
+				if err := _Timelock.contract.UnpackLog(event, "canceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCanceled is a log parse operation binding the contract event 0xdc1ccc238b11aa6c72aa59212e84f328f4d925fa3b1f6c4ed9c39cc3390870ec.
+//
+// Solidity: event canceled(uint64 ID)
+func (_Timelock *TimelockFilterer) ParseCanceled(log types.Log) (*TimelockCanceled, error) {
+	event := new(TimelockCanceled)
+	if err := _Timelock.contract.UnpackLog(event, "canceled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockDelayChangedIterator is returned from FilterDelayChanged and is used to iterate over the raw logs and unpacked data for DelayChanged events raised by the Timelock contract.
+type TimelockDelayChangedIterator struct {
+	Event *TimelockDelayChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockDelayChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockDelayChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockDelayChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockDelayChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockDelayChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockDelayChanged represents a DelayChanged event raised by the Timelock contract.
+type TimelockDelayChanged struct {
+	Delay uint64
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterDelayChanged is a free log retrieval operation binding the contract event 0xc320fb43f61488e990894cbceac5c475b100b8dd3bba180b4b38f91859ca903c.
+//
+// Solidity: event delayChanged(uint64 Delay)
+func (_Timelock *TimelockFilterer) FilterDelayChanged(opts *bind.FilterOpts) (*TimelockDelayChangedIterator, error) {
+
+	logs, sub, err := _Timelock.contract.FilterLogs(opts, "delayChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockDelayChangedIterator{contract: _Timelock.contract, event: "delayChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchDelayChanged is a free log subscription operation binding the contract event 0xc320fb43f61488e990894cbceac5c475b100b8dd3bba180b4b38f91859ca903c.
+//
+// Solidity: event delayChanged(uint64 Delay)
+func (_Timelock *TimelockFilterer) WatchDelayChanged(opts *bind.WatchOpts, sink chan<- *TimelockDelayChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Timelock.contract.WatchLogs(opts, "delayChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockDelayChanged)
+				if err := _Timelock.contract.UnpackLog(event, "delayChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDelayChanged is a log parse operation binding the contract event 0xc320fb43f61488e990894cbceac5c475b100b8dd3bba180b4b38f91859ca903c.
+//
+// Solidity: event delayChanged(uint64 Delay)
+func (_Timelock *TimelockFilterer) ParseDelayChanged(log types.Log) (*TimelockDelayChanged, error) {
+	event := new(TimelockDelayChanged)
+	if err := _Timelock.contract.UnpackLog(event, "delayChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockExecutedIterator is returned from FilterExecuted and is used to iterate over the raw logs and unpacked data for Executed events raised by the Timelock contract.
+type TimelockExecutedIterator struct {
+	Event *TimelockExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockExecuted represents a Executed event raised by the Timelock contract.
+type TimelockExecuted struct {
+	ID  uint64
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecuted is a free log retrieval operation binding the contract event 0xaaa8c76829238b6596a5428ba0f04e4230797cb3725759ed982e5711a4ba5601.
+//
+// Solidity: event executed(uint64 ID)
+func (_Timelock *TimelockFilterer) FilterExecuted(opts *bind.FilterOpts) (*TimelockExecutedIterator, error) {
+
+	logs, sub, err := _Timelock.contract.FilterLogs(opts, "executed")
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockExecutedIterator{contract: _Timelock.contract, event: "executed", logs: logs, sub: sub}, nil
+}
+
+// WatchExecuted is a free log subscription operation binding the contract event 0xaaa8c76829238b6596a5428ba0f04e4230797cb3725759ed982e5711a4ba5601.
+//
+// Solidity: event executed(uint64 ID)
+func (_Timelock *TimelockFilterer) WatchExecuted(opts *bind.WatchOpts, sink chan<- *TimelockExecuted) (event.Subscription, error) {
+
+	logs, sub, err := _Timelock.contract.WatchLogs(opts, "executed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockExecuted)
+				if err := _Timelock.contract.UnpackLog(event, "executed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecuted is a log parse operation binding the contract event 0xaaa8c76829238b6596a5428ba0f04e4230797cb3725759ed982e5711a4ba5601.
+//
+// Solidity: event executed(uint64 ID)
+func (_Timelock *TimelockFilterer) ParseExecuted(log types.Log) (*TimelockExecuted, error) {
+	event := new(TimelockExecuted)
+	if err := _Timelock.contract.UnpackLog(event, "executed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockScheduledIterator is returned from FilterScheduled and is used to iterate over the raw logs and unpacked data for Scheduled events raised by the Timelock contract.
+type TimelockScheduledIterator struct {
+	Event *TimelockScheduled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockScheduledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockScheduled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockScheduled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockScheduledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockScheduledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockScheduled represents a Scheduled event raised by the Timelock contract.
+type TimelockScheduled struct {
+	ID     uint64
+	Target common.Address
+	Eta    uint64
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterScheduled is a free log retrieval operation binding the contract event 0x999e3cfd5610adcd64f6e096699694b5feab4b35f14971190b6ac1b3c036c9c8.
+//
+// Solidity: event scheduled(uint64 ID, address Target, uint64 Eta)
+func (_Timelock *TimelockFilterer) FilterScheduled(opts *bind.FilterOpts) (*TimelockScheduledIterator, error) {
+
+	logs, sub, err := _Timelock.contract.FilterLogs(opts, "scheduled")
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockScheduledIterator{contract: _Timelock.contract, event: "scheduled", logs: logs, sub: sub}, nil
+}
+
+// WatchScheduled is a free log subscription operation binding the contract event 0x999e3cfd5610adcd64f6e096699694b5feab4b35f14971190b6ac1b3c036c9c8.
+//
+// Solidity: event scheduled(uint64 ID, address Target, uint64 Eta)
+func (_Timelock *TimelockFilterer) WatchScheduled(opts *bind.WatchOpts, sink chan<- *TimelockScheduled) (event.Subscription, error) {
+
+	logs, sub, err := _Timelock.contract.WatchLogs(opts, "scheduled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockScheduled)
+				if err := _Timelock.contract.UnpackLog(event, "scheduled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseScheduled is a log parse operation binding the contract event 0x999e3cfd5610adcd64f6e096699694b5feab4b35f14971190b6ac1b3c036c9c8.
+//
+// Solidity: event scheduled(uint64 ID, address Target, uint64 Eta)
+func (_Timelock *TimelockFilterer) ParseScheduled(log types.Log) (*TimelockScheduled, error) {
+	event := new(TimelockScheduled)
+	if err := _Timelock.contract.UnpackLog(event, "scheduled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
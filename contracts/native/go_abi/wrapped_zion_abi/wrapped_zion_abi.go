@@ -0,0 +1,1365 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package wrapped_zion_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodBalanceOf = "balanceOf"
+
+	MethodBridged = "bridged"
+
+	MethodDecimals = "decimals"
+
+	MethodName = "name"
+
+	MethodProxy = "proxy"
+
+	MethodSymbol = "symbol"
+
+	MethodTotalSupply = "totalSupply"
+
+	MethodBindProxy = "bindProxy"
+
+	MethodDeposit = "deposit"
+
+	MethodLock = "lock"
+
+	MethodTransfer = "transfer"
+
+	MethodWithdraw = "withdraw"
+)
+
+// WrappedZionABI is the input ABI used to generate the binding from.
+const WrappedZionABI = "[{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"Symbol\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"Decimals\",\"type\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transfer\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"To\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lock\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"ToAddress\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"bindProxy\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Proxy\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Asset\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"Success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"proxy\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"Proxy\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Asset\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"bridged\",\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"transferred\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"From\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"To\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"deposited\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"withdrawn\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"locked\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"From\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ToChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"ToAddress\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"unlocked\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"FromChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"To\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"proxyBound\",\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Proxy\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Asset\",\"type\":\"bytes\"}]}]"
+
+// WrappedZionFuncSigs maps the 4-byte function signature to its string representation.
+var WrappedZionFuncSigs = map[string]string{
+	"70a08231": "balanceOf(address)",
+	"d30542a1": "bindProxy(uint64,bytes,bytes)",
+	"17f936c6": "bridged(uint64)",
+	"313ce567": "decimals()",
+	"d0e30db0": "deposit()",
+	"cccf1562": "lock(uint64,bytes,uint256)",
+	"06fdde03": "name()",
+	"e47d9dd3": "proxy(uint64)",
+	"95d89b41": "symbol()",
+	"18160ddd": "totalSupply()",
+	"a9059cbb": "transfer(address,uint256)",
+	"2e1a7d4d": "withdraw(uint256)",
+}
+
+// WrappedZion is an auto generated Go binding around an Ethereum contract.
+type WrappedZion struct {
+	WrappedZionCaller     // Read-only binding to the contract
+	WrappedZionTransactor // Write-only binding to the contract
+	WrappedZionFilterer   // Log filterer for contract events
+}
+
+// WrappedZionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type WrappedZionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WrappedZionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type WrappedZionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WrappedZionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type WrappedZionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WrappedZionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type WrappedZionSession struct {
+	Contract     *WrappedZion      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// WrappedZionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type WrappedZionCallerSession struct {
+	Contract *WrappedZionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// WrappedZionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type WrappedZionTransactorSession struct {
+	Contract     *WrappedZionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// WrappedZionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type WrappedZionRaw struct {
+	Contract *WrappedZion // Generic contract binding to access the raw methods on
+}
+
+// WrappedZionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type WrappedZionCallerRaw struct {
+	Contract *WrappedZionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// WrappedZionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type WrappedZionTransactorRaw struct {
+	Contract *WrappedZionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewWrappedZion creates a new instance of WrappedZion, bound to a specific deployed contract.
+func NewWrappedZion(address common.Address, backend bind.ContractBackend) (*WrappedZion, error) {
+	contract, err := bindWrappedZion(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZion{WrappedZionCaller: WrappedZionCaller{contract: contract}, WrappedZionTransactor: WrappedZionTransactor{contract: contract}, WrappedZionFilterer: WrappedZionFilterer{contract: contract}}, nil
+}
+
+// NewWrappedZionCaller creates a new read-only instance of WrappedZion, bound to a specific deployed contract.
+func NewWrappedZionCaller(address common.Address, caller bind.ContractCaller) (*WrappedZionCaller, error) {
+	contract, err := bindWrappedZion(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionCaller{contract: contract}, nil
+}
+
+// NewWrappedZionTransactor creates a new write-only instance of WrappedZion, bound to a specific deployed contract.
+func NewWrappedZionTransactor(address common.Address, transactor bind.ContractTransactor) (*WrappedZionTransactor, error) {
+	contract, err := bindWrappedZion(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionTransactor{contract: contract}, nil
+}
+
+// NewWrappedZionFilterer creates a new log filterer instance of WrappedZion, bound to a specific deployed contract.
+func NewWrappedZionFilterer(address common.Address, filterer bind.ContractFilterer) (*WrappedZionFilterer, error) {
+	contract, err := bindWrappedZion(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionFilterer{contract: contract}, nil
+}
+
+// bindWrappedZion binds a generic wrapper to an already deployed contract.
+func bindWrappedZion(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(WrappedZionABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WrappedZion *WrappedZionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WrappedZion.Contract.WrappedZionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WrappedZion *WrappedZionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WrappedZion.Contract.WrappedZionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WrappedZion *WrappedZionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WrappedZion.Contract.WrappedZionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WrappedZion *WrappedZionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WrappedZion.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WrappedZion *WrappedZionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WrappedZion.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WrappedZion *WrappedZionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WrappedZion.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address Account) view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionCaller) BalanceOf(opts *bind.CallOpts, Account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "balanceOf", Account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address Account) view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionSession) BalanceOf(Account common.Address) (*big.Int, error) {
+	return _WrappedZion.Contract.BalanceOf(&_WrappedZion.CallOpts, Account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address Account) view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionCallerSession) BalanceOf(Account common.Address) (*big.Int, error) {
+	return _WrappedZion.Contract.BalanceOf(&_WrappedZion.CallOpts, Account)
+}
+
+// Bridged is a free data retrieval call binding the contract method 0x17f936c6.
+//
+// Solidity: function bridged(uint64 ChainID) view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionCaller) Bridged(opts *bind.CallOpts, ChainID uint64) (*big.Int, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "bridged", ChainID)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Bridged is a free data retrieval call binding the contract method 0x17f936c6.
+//
+// Solidity: function bridged(uint64 ChainID) view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionSession) Bridged(ChainID uint64) (*big.Int, error) {
+	return _WrappedZion.Contract.Bridged(&_WrappedZion.CallOpts, ChainID)
+}
+
+// Bridged is a free data retrieval call binding the contract method 0x17f936c6.
+//
+// Solidity: function bridged(uint64 ChainID) view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionCallerSession) Bridged(ChainID uint64) (*big.Int, error) {
+	return _WrappedZion.Contract.Bridged(&_WrappedZion.CallOpts, ChainID)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8 Decimals)
+func (_WrappedZion *WrappedZionCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8 Decimals)
+func (_WrappedZion *WrappedZionSession) Decimals() (uint8, error) {
+	return _WrappedZion.Contract.Decimals(&_WrappedZion.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8 Decimals)
+func (_WrappedZion *WrappedZionCallerSession) Decimals() (uint8, error) {
+	return _WrappedZion.Contract.Decimals(&_WrappedZion.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_WrappedZion *WrappedZionCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_WrappedZion *WrappedZionSession) Name() (string, error) {
+	return _WrappedZion.Contract.Name(&_WrappedZion.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string Name)
+func (_WrappedZion *WrappedZionCallerSession) Name() (string, error) {
+	return _WrappedZion.Contract.Name(&_WrappedZion.CallOpts)
+}
+
+// Proxy is a free data retrieval call binding the contract method 0xe47d9dd3.
+//
+// Solidity: function proxy(uint64 ChainID) view returns(bytes Proxy, bytes Asset)
+func (_WrappedZion *WrappedZionCaller) Proxy(opts *bind.CallOpts, ChainID uint64) (struct {
+	Proxy []byte
+	Asset []byte
+}, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "proxy", ChainID)
+
+	outstruct := new(struct {
+		Proxy []byte
+		Asset []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Proxy = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	outstruct.Asset = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// Proxy is a free data retrieval call binding the contract method 0xe47d9dd3.
+//
+// Solidity: function proxy(uint64 ChainID) view returns(bytes Proxy, bytes Asset)
+func (_WrappedZion *WrappedZionSession) Proxy(ChainID uint64) (struct {
+	Proxy []byte
+	Asset []byte
+}, error) {
+	return _WrappedZion.Contract.Proxy(&_WrappedZion.CallOpts, ChainID)
+}
+
+// Proxy is a free data retrieval call binding the contract method 0xe47d9dd3.
+//
+// Solidity: function proxy(uint64 ChainID) view returns(bytes Proxy, bytes Asset)
+func (_WrappedZion *WrappedZionCallerSession) Proxy(ChainID uint64) (struct {
+	Proxy []byte
+	Asset []byte
+}, error) {
+	return _WrappedZion.Contract.Proxy(&_WrappedZion.CallOpts, ChainID)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string Symbol)
+func (_WrappedZion *WrappedZionCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string Symbol)
+func (_WrappedZion *WrappedZionSession) Symbol() (string, error) {
+	return _WrappedZion.Contract.Symbol(&_WrappedZion.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string Symbol)
+func (_WrappedZion *WrappedZionCallerSession) Symbol() (string, error) {
+	return _WrappedZion.Contract.Symbol(&_WrappedZion.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WrappedZion.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionSession) TotalSupply() (*big.Int, error) {
+	return _WrappedZion.Contract.TotalSupply(&_WrappedZion.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256 Amount)
+func (_WrappedZion *WrappedZionCallerSession) TotalSupply() (*big.Int, error) {
+	return _WrappedZion.Contract.TotalSupply(&_WrappedZion.CallOpts)
+}
+
+// BindProxy is a paid mutator transaction binding the contract method 0xd30542a1.
+//
+// Solidity: function bindProxy(uint64 ChainID, bytes Proxy, bytes Asset) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactor) BindProxy(opts *bind.TransactOpts, ChainID uint64, Proxy []byte, Asset []byte) (*types.Transaction, error) {
+	return _WrappedZion.contract.Transact(opts, "bindProxy", ChainID, Proxy, Asset)
+}
+
+// BindProxy is a paid mutator transaction binding the contract method 0xd30542a1.
+//
+// Solidity: function bindProxy(uint64 ChainID, bytes Proxy, bytes Asset) returns(bool Success)
+func (_WrappedZion *WrappedZionSession) BindProxy(ChainID uint64, Proxy []byte, Asset []byte) (*types.Transaction, error) {
+	return _WrappedZion.Contract.BindProxy(&_WrappedZion.TransactOpts, ChainID, Proxy, Asset)
+}
+
+// BindProxy is a paid mutator transaction binding the contract method 0xd30542a1.
+//
+// Solidity: function bindProxy(uint64 ChainID, bytes Proxy, bytes Asset) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactorSession) BindProxy(ChainID uint64, Proxy []byte, Asset []byte) (*types.Transaction, error) {
+	return _WrappedZion.Contract.BindProxy(&_WrappedZion.TransactOpts, ChainID, Proxy, Asset)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns(bool Success)
+func (_WrappedZion *WrappedZionTransactor) Deposit(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WrappedZion.contract.Transact(opts, "deposit")
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns(bool Success)
+func (_WrappedZion *WrappedZionSession) Deposit() (*types.Transaction, error) {
+	return _WrappedZion.Contract.Deposit(&_WrappedZion.TransactOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns(bool Success)
+func (_WrappedZion *WrappedZionTransactorSession) Deposit() (*types.Transaction, error) {
+	return _WrappedZion.Contract.Deposit(&_WrappedZion.TransactOpts)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0xcccf1562.
+//
+// Solidity: function lock(uint64 ToChainID, bytes ToAddress, uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactor) Lock(opts *bind.TransactOpts, ToChainID uint64, ToAddress []byte, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.contract.Transact(opts, "lock", ToChainID, ToAddress, Amount)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0xcccf1562.
+//
+// Solidity: function lock(uint64 ToChainID, bytes ToAddress, uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionSession) Lock(ToChainID uint64, ToAddress []byte, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.Contract.Lock(&_WrappedZion.TransactOpts, ToChainID, ToAddress, Amount)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0xcccf1562.
+//
+// Solidity: function lock(uint64 ToChainID, bytes ToAddress, uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactorSession) Lock(ToChainID uint64, ToAddress []byte, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.Contract.Lock(&_WrappedZion.TransactOpts, ToChainID, ToAddress, Amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address To, uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactor) Transfer(opts *bind.TransactOpts, To common.Address, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.contract.Transact(opts, "transfer", To, Amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address To, uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionSession) Transfer(To common.Address, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.Contract.Transfer(&_WrappedZion.TransactOpts, To, Amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address To, uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactorSession) Transfer(To common.Address, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.Contract.Transfer(&_WrappedZion.TransactOpts, To, Amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactor) Withdraw(opts *bind.TransactOpts, Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.contract.Transact(opts, "withdraw", Amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionSession) Withdraw(Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.Contract.Withdraw(&_WrappedZion.TransactOpts, Amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 Amount) returns(bool Success)
+func (_WrappedZion *WrappedZionTransactorSession) Withdraw(Amount *big.Int) (*types.Transaction, error) {
+	return _WrappedZion.Contract.Withdraw(&_WrappedZion.TransactOpts, Amount)
+}
+
+// WrappedZionDepositedIterator is returned from FilterDeposited and is used to iterate over the raw logs and unpacked data for Deposited events raised by the WrappedZion contract.
+type WrappedZionDepositedIterator struct {
+	Event *WrappedZionDeposited // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WrappedZionDepositedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WrappedZionDeposited)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WrappedZionDeposited)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WrappedZionDepositedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WrappedZionDepositedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WrappedZionDeposited represents a Deposited event raised by the WrappedZion contract.
+type WrappedZionDeposited struct {
+	Account common.Address
+	Amount  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposited is a free log retrieval operation binding the contract event 0x688e89c1496876b0d792df75671fbe827183660b170bb8983d82511a6a4b7d1b.
+//
+// Solidity: event deposited(address Account, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) FilterDeposited(opts *bind.FilterOpts) (*WrappedZionDepositedIterator, error) {
+
+	logs, sub, err := _WrappedZion.contract.FilterLogs(opts, "deposited")
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionDepositedIterator{contract: _WrappedZion.contract, event: "deposited", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposited is a free log subscription operation binding the contract event 0x688e89c1496876b0d792df75671fbe827183660b170bb8983d82511a6a4b7d1b.
+//
+// Solidity: event deposited(address Account, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) WatchDeposited(opts *bind.WatchOpts, sink chan<- *WrappedZionDeposited) (event.Subscription, error) {
+
+	logs, sub, err := _WrappedZion.contract.WatchLogs(opts, "deposited")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WrappedZionDeposited)
+				if err := _WrappedZion.contract.UnpackLog(event, "deposited", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposited is a log parse operation binding the contract event 0x688e89c1496876b0d792df75671fbe827183660b170bb8983d82511a6a4b7d1b.
+//
+// Solidity: event deposited(address Account, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) ParseDeposited(log types.Log) (*WrappedZionDeposited, error) {
+	event := new(WrappedZionDeposited)
+	if err := _WrappedZion.contract.UnpackLog(event, "deposited", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WrappedZionLockedIterator is returned from FilterLocked and is used to iterate over the raw logs and unpacked data for Locked events raised by the WrappedZion contract.
+type WrappedZionLockedIterator struct {
+	Event *WrappedZionLocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WrappedZionLockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WrappedZionLocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WrappedZionLocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WrappedZionLockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WrappedZionLockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WrappedZionLocked represents a Locked event raised by the WrappedZion contract.
+type WrappedZionLocked struct {
+	From      common.Address
+	ToChainID uint64
+	ToAddress []byte
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLocked is a free log retrieval operation binding the contract event 0xb015b441fe50f6aaeb0179c643ad79c74bfaf2d83b7b21aaaa7e5799cd37c7f5.
+//
+// Solidity: event locked(address From, uint64 ToChainID, bytes ToAddress, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) FilterLocked(opts *bind.FilterOpts) (*WrappedZionLockedIterator, error) {
+
+	logs, sub, err := _WrappedZion.contract.FilterLogs(opts, "locked")
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionLockedIterator{contract: _WrappedZion.contract, event: "locked", logs: logs, sub: sub}, nil
+}
+
+// WatchLocked is a free log subscription operation binding the contract event 0xb015b441fe50f6aaeb0179c643ad79c74bfaf2d83b7b21aaaa7e5799cd37c7f5.
+//
+// Solidity: event locked(address From, uint64 ToChainID, bytes ToAddress, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) WatchLocked(opts *bind.WatchOpts, sink chan<- *WrappedZionLocked) (event.Subscription, error) {
+
+	logs, sub, err := _WrappedZion.contract.WatchLogs(opts, "locked")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WrappedZionLocked)
+				if err := _WrappedZion.contract.UnpackLog(event, "locked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLocked is a log parse operation binding the contract event 0xb015b441fe50f6aaeb0179c643ad79c74bfaf2d83b7b21aaaa7e5799cd37c7f5.
+//
+// Solidity: event locked(address From, uint64 ToChainID, bytes ToAddress, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) ParseLocked(log types.Log) (*WrappedZionLocked, error) {
+	event := new(WrappedZionLocked)
+	if err := _WrappedZion.contract.UnpackLog(event, "locked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WrappedZionProxyBoundIterator is returned from FilterProxyBound and is used to iterate over the raw logs and unpacked data for ProxyBound events raised by the WrappedZion contract.
+type WrappedZionProxyBoundIterator struct {
+	Event *WrappedZionProxyBound // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WrappedZionProxyBoundIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WrappedZionProxyBound)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WrappedZionProxyBound)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WrappedZionProxyBoundIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WrappedZionProxyBoundIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WrappedZionProxyBound represents a ProxyBound event raised by the WrappedZion contract.
+type WrappedZionProxyBound struct {
+	ChainID uint64
+	Proxy   []byte
+	Asset   []byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterProxyBound is a free log retrieval operation binding the contract event 0xbbaa774c76695d37e6d1e532c1d4e5a683e137434c57aa5b7a56775a6126a281.
+//
+// Solidity: event proxyBound(uint64 ChainID, bytes Proxy, bytes Asset)
+func (_WrappedZion *WrappedZionFilterer) FilterProxyBound(opts *bind.FilterOpts) (*WrappedZionProxyBoundIterator, error) {
+
+	logs, sub, err := _WrappedZion.contract.FilterLogs(opts, "proxyBound")
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionProxyBoundIterator{contract: _WrappedZion.contract, event: "proxyBound", logs: logs, sub: sub}, nil
+}
+
+// WatchProxyBound is a free log subscription operation binding the contract event 0xbbaa774c76695d37e6d1e532c1d4e5a683e137434c57aa5b7a56775a6126a281.
+//
+// Solidity: event proxyBound(uint64 ChainID, bytes Proxy, bytes Asset)
+func (_WrappedZion *WrappedZionFilterer) WatchProxyBound(opts *bind.WatchOpts, sink chan<- *WrappedZionProxyBound) (event.Subscription, error) {
+
+	logs, sub, err := _WrappedZion.contract.WatchLogs(opts, "proxyBound")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WrappedZionProxyBound)
+				if err := _WrappedZion.contract.UnpackLog(event, "proxyBound", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProxyBound is a log parse operation binding the contract event 0xbbaa774c76695d37e6d1e532c1d4e5a683e137434c57aa5b7a56775a6126a281.
+//
+// Solidity: event proxyBound(uint64 ChainID, bytes Proxy, bytes Asset)
+func (_WrappedZion *WrappedZionFilterer) ParseProxyBound(log types.Log) (*WrappedZionProxyBound, error) {
+	event := new(WrappedZionProxyBound)
+	if err := _WrappedZion.contract.UnpackLog(event, "proxyBound", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WrappedZionTransferredIterator is returned from FilterTransferred and is used to iterate over the raw logs and unpacked data for Transferred events raised by the WrappedZion contract.
+type WrappedZionTransferredIterator struct {
+	Event *WrappedZionTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WrappedZionTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WrappedZionTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WrappedZionTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WrappedZionTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WrappedZionTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WrappedZionTransferred represents a Transferred event raised by the WrappedZion contract.
+type WrappedZionTransferred struct {
+	From   common.Address
+	To     common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferred is a free log retrieval operation binding the contract event 0x8baf29b4b5af24ec8170355f2819a9135840321dd4e65b88a080dfc3054fe99c.
+//
+// Solidity: event transferred(address From, address To, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) FilterTransferred(opts *bind.FilterOpts) (*WrappedZionTransferredIterator, error) {
+
+	logs, sub, err := _WrappedZion.contract.FilterLogs(opts, "transferred")
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionTransferredIterator{contract: _WrappedZion.contract, event: "transferred", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferred is a free log subscription operation binding the contract event 0x8baf29b4b5af24ec8170355f2819a9135840321dd4e65b88a080dfc3054fe99c.
+//
+// Solidity: event transferred(address From, address To, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) WatchTransferred(opts *bind.WatchOpts, sink chan<- *WrappedZionTransferred) (event.Subscription, error) {
+
+	logs, sub, err := _WrappedZion.contract.WatchLogs(opts, "transferred")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WrappedZionTransferred)
+				if err := _WrappedZion.contract.UnpackLog(event, "transferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferred is a log parse operation binding the contract event 0x8baf29b4b5af24ec8170355f2819a9135840321dd4e65b88a080dfc3054fe99c.
+//
+// Solidity: event transferred(address From, address To, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) ParseTransferred(log types.Log) (*WrappedZionTransferred, error) {
+	event := new(WrappedZionTransferred)
+	if err := _WrappedZion.contract.UnpackLog(event, "transferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WrappedZionUnlockedIterator is returned from FilterUnlocked and is used to iterate over the raw logs and unpacked data for Unlocked events raised by the WrappedZion contract.
+type WrappedZionUnlockedIterator struct {
+	Event *WrappedZionUnlocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WrappedZionUnlockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WrappedZionUnlocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WrappedZionUnlocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WrappedZionUnlockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WrappedZionUnlockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WrappedZionUnlocked represents a Unlocked event raised by the WrappedZion contract.
+type WrappedZionUnlocked struct {
+	FromChainID uint64
+	To          common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnlocked is a free log retrieval operation binding the contract event 0x4edb51abcd3b8b1e2e699500164236461be624a12fa2444064d4ef0e75406084.
+//
+// Solidity: event unlocked(uint64 FromChainID, address To, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) FilterUnlocked(opts *bind.FilterOpts) (*WrappedZionUnlockedIterator, error) {
+
+	logs, sub, err := _WrappedZion.contract.FilterLogs(opts, "unlocked")
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionUnlockedIterator{contract: _WrappedZion.contract, event: "unlocked", logs: logs, sub: sub}, nil
+}
+
+// WatchUnlocked is a free log subscription operation binding the contract event 0x4edb51abcd3b8b1e2e699500164236461be624a12fa2444064d4ef0e75406084.
+//
+// Solidity: event unlocked(uint64 FromChainID, address To, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) WatchUnlocked(opts *bind.WatchOpts, sink chan<- *WrappedZionUnlocked) (event.Subscription, error) {
+
+	logs, sub, err := _WrappedZion.contract.WatchLogs(opts, "unlocked")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WrappedZionUnlocked)
+				if err := _WrappedZion.contract.UnpackLog(event, "unlocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnlocked is a log parse operation binding the contract event 0x4edb51abcd3b8b1e2e699500164236461be624a12fa2444064d4ef0e75406084.
+//
+// Solidity: event unlocked(uint64 FromChainID, address To, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) ParseUnlocked(log types.Log) (*WrappedZionUnlocked, error) {
+	event := new(WrappedZionUnlocked)
+	if err := _WrappedZion.contract.UnpackLog(event, "unlocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WrappedZionWithdrawnIterator is returned from FilterWithdrawn and is used to iterate over the raw logs and unpacked data for Withdrawn events raised by the WrappedZion contract.
+type WrappedZionWithdrawnIterator struct {
+	Event *WrappedZionWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WrappedZionWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WrappedZionWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WrappedZionWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WrappedZionWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WrappedZionWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WrappedZionWithdrawn represents a Withdrawn event raised by the WrappedZion contract.
+type WrappedZionWithdrawn struct {
+	Account common.Address
+	Amount  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawn is a free log retrieval operation binding the contract event 0x6fb24f3ad0678f9d138e80b17293be051d87911eb34e9e60f0d1b9c3805e885a.
+//
+// Solidity: event withdrawn(address Account, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) FilterWithdrawn(opts *bind.FilterOpts) (*WrappedZionWithdrawnIterator, error) {
+
+	logs, sub, err := _WrappedZion.contract.FilterLogs(opts, "withdrawn")
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedZionWithdrawnIterator{contract: _WrappedZion.contract, event: "withdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawn is a free log subscription operation binding the contract event 0x6fb24f3ad0678f9d138e80b17293be051d87911eb34e9e60f0d1b9c3805e885a.
+//
+// Solidity: event withdrawn(address Account, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) WatchWithdrawn(opts *bind.WatchOpts, sink chan<- *WrappedZionWithdrawn) (event.Subscription, error) {
+
+	logs, sub, err := _WrappedZion.contract.WatchLogs(opts, "withdrawn")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WrappedZionWithdrawn)
+				if err := _WrappedZion.contract.UnpackLog(event, "withdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawn is a log parse operation binding the contract event 0x6fb24f3ad0678f9d138e80b17293be051d87911eb34e9e60f0d1b9c3805e885a.
+//
+// Solidity: event withdrawn(address Account, uint256 Amount)
+func (_WrappedZion *WrappedZionFilterer) ParseWithdrawn(log types.Log) (*WrappedZionWithdrawn, error) {
+	event := new(WrappedZionWithdrawn)
+	if err := _WrappedZion.contract.UnpackLog(event, "withdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package access_control
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "access control"
+
+const (
+	MethodContractName = "name"
+	MethodGrantRole    = "grantRole"
+	MethodRevokeRole   = "revokeRole"
+	MethodHasRole      = "hasRole"
+	MethodRoleMembers  = "roleMembers"
+
+	EventRoleGranted = "roleGranted"
+	EventRoleRevoked = "roleRevoked"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodGrantRole + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"uint8","name":"Role","type":"uint8"},{"internalType":"address","name":"Account","type":"address"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodRevokeRole + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"uint8","name":"Role","type":"uint8"},{"internalType":"address","name":"Account","type":"address"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodHasRole + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"uint8","name":"Role","type":"uint8"},{"internalType":"address","name":"Account","type":"address"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodRoleMembers + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"uint8","name":"Role","type":"uint8"}],"outputs":[{"internalType":"address[]","name":"Members","type":"address[]"}],"stateMutability":"view"},
+	{"type":"event","name":"` + EventRoleGranted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Contract","type":"address"},{"indexed":false,"internalType":"uint8","name":"Role","type":"uint8"},{"indexed":false,"internalType":"address","name":"Account","type":"address"}]},
+	{"type":"event","name":"` + EventRoleRevoked + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Contract","type":"address"},{"indexed":false,"internalType":"uint8","name":"Role","type":"uint8"},{"indexed":false,"internalType":"address","name":"Account","type":"address"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.AccessControlContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+// MethodRoleInput is shared by `grantRole`, `revokeRole` and `hasRole`.
+type MethodRoleInput struct {
+	Contract common.Address
+	Role     Role
+	Account  common.Address
+}
+
+func (m *MethodRoleInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.Contract, uint8(m.Role), m.Account)
+}
+func (m *MethodRoleInput) Decode(method string, payload []byte) error {
+	var data struct {
+		Contract common.Address
+		Role     uint8
+		Account  common.Address
+	}
+	if err := utils.UnpackMethod(ABI, method, &data, payload); err != nil {
+		return err
+	}
+	m.Contract = data.Contract
+	m.Role = Role(data.Role)
+	m.Account = data.Account
+	return nil
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+type MethodRoleMembersInput struct {
+	Contract common.Address
+	Role     Role
+}
+
+func (m *MethodRoleMembersInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRoleMembers, m.Contract, uint8(m.Role))
+}
+func (m *MethodRoleMembersInput) Decode(payload []byte) error {
+	var data struct {
+		Contract common.Address
+		Role     uint8
+	}
+	if err := utils.UnpackMethod(ABI, MethodRoleMembers, &data, payload); err != nil {
+		return err
+	}
+	m.Contract = data.Contract
+	m.Role = Role(data.Role)
+	return nil
+}
+
+type MethodRoleMembersOutput struct {
+	Members []common.Address
+}
+
+func (m *MethodRoleMembersOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodRoleMembers, m.Members)
+}
+func (m *MethodRoleMembersOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodRoleMembers, m, payload)
+}
+
+func emitRoleChanged(s *native.NativeContract, event string, contract common.Address, role Role, account common.Address) error {
+	return s.AddNotify(ABI, []string{event}, contract, uint8(role), account)
+}
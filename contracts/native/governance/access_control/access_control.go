@@ -0,0 +1,219 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package access_control
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodGrantRole:    30000,
+		MethodRevokeRole:   30000,
+		MethodHasRole:      0,
+		MethodRoleMembers:  0,
+	}
+)
+
+func InitAccessControl() {
+	InitABI()
+	native.RegisterABI(native.NativeAccessControl, "AccessControl", abijson)
+	native.Contracts[this] = RegisterAccessControlContract
+	node_manager.RelayerChecker = func(s *native.NativeContract, contract, account common.Address) bool {
+		return HasRole(s, contract, RoleRelayer, account)
+	}
+}
+
+func RegisterAccessControlContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.Register(MethodGrantRole, GrantRole)
+	s.Register(MethodRevokeRole, RevokeRole)
+	s.RegisterQuery(MethodHasRole, HasRoleQuery)
+	s.RegisterQuery(MethodRoleMembers, RoleMembers)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+// GrantRole validators grant role of a native contract to an account, the role takes effect
+// as soon as the consensus signs of the same input reached quorum.
+func GrantRole(s *native.NativeContract) ([]byte, error) {
+	input, err := decodeRoleInput(s, MethodGrantRole)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+
+	members, err := getRoleMembers(s, input.Contract, input.Role)
+	if err != nil {
+		log.Trace("grantRole", "get role members failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if contains(members, input.Account) {
+		return utils.ByteFailed, ErrRoleGranted
+	}
+
+	if err := changeRole(s, MethodGrantRole, EventRoleGranted, input, append(members, input.Account)); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodGrantRole)
+}
+
+// RevokeRole validators revoke role of a native contract from an account, same as `GrantRole`
+// the role is removed after the consensus signs reached quorum.
+func RevokeRole(s *native.NativeContract) ([]byte, error) {
+	input, err := decodeRoleInput(s, MethodRevokeRole)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+
+	members, err := getRoleMembers(s, input.Contract, input.Role)
+	if err != nil {
+		log.Trace("revokeRole", "get role members failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if !contains(members, input.Account) {
+		return utils.ByteFailed, ErrRoleNotGranted
+	}
+
+	dst := make([]common.Address, 0, len(members))
+	for _, v := range members {
+		if v != input.Account {
+			dst = append(dst, v)
+		}
+	}
+	if err := changeRole(s, MethodRevokeRole, EventRoleRevoked, input, dst); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodRevokeRole)
+}
+
+// changeRole collects the consensus sign of the caller and replace the role members
+// with `members` after quorum reached.
+func changeRole(s *native.NativeContract, method, event string, input *MethodRoleInput, members []common.Address) error {
+	nonce, err := getRoleNonce(s, input.Contract, input.Role)
+	if err != nil {
+		log.Trace(method, "get role nonce failed", err)
+		return ErrStorage
+	}
+	sign := append(utils.GetUint64Bytes(nonce), s.ContractRef().CurrentContext().Payload...)
+	ok, err := node_manager.CheckConsensusSigns(s, method, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	if err := setRoleMembers(s, input.Contract, input.Role, members); err != nil {
+		log.Trace(method, "store role members failed", err)
+		return ErrStorage
+	}
+	setRoleNonce(s, input.Contract, input.Role, nonce+1)
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, method, s.ContractRef().MsgSender(), s.ContractRef().CurrentContext().Payload); err != nil {
+		return ErrStorage
+	}
+	if err := emitRoleChanged(s, event, input.Contract, input.Role, input.Account); err != nil {
+		log.Trace(method, "emit event failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+func HasRoleQuery(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRoleInput)
+	if err := input.Decode(MethodHasRole, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	output := &MethodBoolOutput{Success: HasRole(s, input.Contract, input.Role, input.Account)}
+	return output.Encode(MethodHasRole)
+}
+
+func RoleMembers(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRoleMembersInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	members, err := getRoleMembers(s, input.Contract, input.Role)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if members == nil {
+		members = []common.Address{}
+	}
+	return (&MethodRoleMembersOutput{Members: members}).Encode()
+}
+
+// HasRole returns true if the account holds the role of native contract.
+func HasRole(s *native.NativeContract, contract common.Address, role Role, account common.Address) bool {
+	members, err := getRoleMembers(s, contract, role)
+	if err != nil {
+		return false
+	}
+	return contains(members, account)
+}
+
+// ValidateRole checks that the tx origin holds the role of the current native contract,
+// it replaces the hand written `origin == owner` checks inside native contract methods.
+func ValidateRole(s *native.NativeContract, role Role) error {
+	ref := s.ContractRef()
+	if !role.Valid() {
+		return ErrInvalidRole
+	}
+	if !HasRole(s, ref.CurrentContext().ContractAddress, role, ref.TxOrigin()) {
+		log.Trace("validateRole", "role", role.String(), "account", ref.TxOrigin().Hex())
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func decodeRoleInput(s *native.NativeContract, method string) (*MethodRoleInput, error) {
+	input := new(MethodRoleInput)
+	if err := input.Decode(method, s.ContractRef().CurrentContext().Payload); err != nil {
+		log.Trace(method, "decode input failed", err)
+		return nil, ErrInvalidInput
+	}
+	if !input.Role.Valid() {
+		return nil, ErrInvalidRole
+	}
+	if !native.IsNativeContract(input.Contract) {
+		return nil, ErrInvalidContract
+	}
+	if input.Account == common.EmptyAddress {
+		return nil, ErrInvalidAccount
+	}
+	return input, nil
+}
+
+func contains(list []common.Address, addr common.Address) bool {
+	for _, v := range list {
+		if v == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package access_control
+
+import (
+	"crypto/rand"
+	"math/big"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(100000000000000000)
+)
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	InitAccessControl()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+}
+
+func generateNativeContractRef(origin common.Address, blockNum int) *native.ContractRef {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	hash := common.BytesToHash(token)
+	return native.NewContractRef(testStateDB, origin, origin, big.NewInt(int64(blockNum)), hash, testSupplyGas, nil)
+}
+
+func invoke(origin common.Address, payload []byte) ([]byte, error) {
+	ref := generateNativeContractRef(origin, 1)
+	ret, _, err := ref.NativeCall(origin, this, payload)
+	return ret, err
+}
+
+// sign the payload with genesis validators in range of [from, to)
+func sign(t *testing.T, method string, input *MethodRoleInput, from, to int) {
+	payload, err := input.Encode(method)
+	assert.NoError(t, err)
+	for i := from; i < to; i++ {
+		_, err := invoke(testGenesisEpoch.Peers.List[i].Address, payload)
+		assert.NoError(t, err)
+	}
+}
+
+func roleMembers(t *testing.T, contract common.Address, role Role) []common.Address {
+	payload, err := (&MethodRoleMembersInput{Contract: contract, Role: role}).Encode()
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, payload)
+	assert.NoError(t, err)
+	output := new(MethodRoleMembersOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output.Members
+}
+
+func TestGrantAndRevokeRole(t *testing.T) {
+	resetTestContext()
+
+	account := common.HexToAddress("0x123")
+	input := &MethodRoleInput{Contract: utils.RelayerManagerContractAddress, Role: RoleRelayer, Account: account}
+	quorum := testGenesisEpoch.QuorumSize()
+	s := native.NewNativeContract(testStateDB, generateNativeContractRef(account, 1))
+
+	// role is not granted before quorum
+	sign(t, MethodGrantRole, input, 0, quorum-1)
+	assert.False(t, HasRole(s, input.Contract, input.Role, account))
+	assert.Empty(t, roleMembers(t, input.Contract, input.Role))
+
+	sign(t, MethodGrantRole, input, quorum-1, quorum)
+	assert.True(t, HasRole(s, input.Contract, input.Role, account))
+	assert.False(t, HasRole(s, input.Contract, RoleOwner, account))
+	assert.False(t, HasRole(s, utils.NodeManagerContractAddress, input.Role, account))
+	assert.Equal(t, []common.Address{account}, roleMembers(t, input.Contract, input.Role))
+
+	// granted twice
+	payload, err := input.Encode(MethodGrantRole)
+	assert.NoError(t, err)
+	_, err = invoke(testGenesisEpoch.Peers.List[quorum].Address, payload)
+	assert.Equal(t, ErrRoleGranted, err)
+
+	sign(t, MethodRevokeRole, input, 0, quorum)
+	assert.False(t, HasRole(s, input.Contract, input.Role, account))
+	assert.Empty(t, roleMembers(t, input.Contract, input.Role))
+
+	payload, err = input.Encode(MethodRevokeRole)
+	assert.NoError(t, err)
+	_, err = invoke(testGenesisEpoch.Peers.List[0].Address, payload)
+	assert.Equal(t, ErrRoleNotGranted, err)
+
+	// signs of the first grant can not be replayed
+	sign(t, MethodGrantRole, input, 0, 1)
+	assert.False(t, HasRole(s, input.Contract, input.Role, account))
+	sign(t, MethodGrantRole, input, 1, quorum)
+	assert.True(t, HasRole(s, input.Contract, input.Role, account))
+}
+
+func TestGrantRoleInvalidInput(t *testing.T) {
+	resetTestContext()
+
+	validator := testGenesisEpoch.Peers.List[0].Address
+	cases := []struct {
+		Input  *MethodRoleInput
+		Origin common.Address
+		Expect error
+	}{
+		{&MethodRoleInput{Contract: utils.RelayerManagerContractAddress, Role: 0, Account: common.HexToAddress("0x1")}, validator, ErrInvalidRole},
+		{&MethodRoleInput{Contract: utils.RelayerManagerContractAddress, Role: RoleRelayer + 1, Account: common.HexToAddress("0x1")}, validator, ErrInvalidRole},
+		{&MethodRoleInput{Contract: common.HexToAddress("0x1"), Role: RoleOwner, Account: common.HexToAddress("0x1")}, validator, ErrInvalidContract},
+		{&MethodRoleInput{Contract: utils.RelayerManagerContractAddress, Role: RoleOwner, Account: common.EmptyAddress}, validator, ErrInvalidAccount},
+		{&MethodRoleInput{Contract: utils.RelayerManagerContractAddress, Role: RoleOwner, Account: common.HexToAddress("0x1")}, common.HexToAddress("0x2"), node_manager.ErrInvalidAuthority},
+	}
+	for _, c := range cases {
+		payload, err := c.Input.Encode(MethodGrantRole)
+		assert.NoError(t, err)
+		_, err = invoke(c.Origin, payload)
+		assert.Equal(t, c.Expect, err)
+	}
+}
+
+func TestValidateRole(t *testing.T) {
+	resetTestContext()
+
+	operator := common.HexToAddress("0x123")
+	sign(t, MethodGrantRole, &MethodRoleInput{Contract: utils.SideChainManagerContractAddress, Role: RoleOperator, Account: operator}, 0, testGenesisEpoch.QuorumSize())
+
+	check := func(origin, contract common.Address, role Role) error {
+		ref := generateNativeContractRef(origin, 1)
+		ref.PushContext(&native.Context{ContractAddress: contract, Caller: origin})
+		return ValidateRole(native.NewNativeContract(testStateDB, ref), role)
+	}
+	assert.NoError(t, check(operator, utils.SideChainManagerContractAddress, RoleOperator))
+	assert.Equal(t, ErrPermissionDenied, check(operator, utils.SideChainManagerContractAddress, RoleOwner))
+	assert.Equal(t, ErrPermissionDenied, check(operator, utils.RelayerManagerContractAddress, RoleOperator))
+	assert.Equal(t, ErrPermissionDenied, check(common.HexToAddress("0x1"), utils.SideChainManagerContractAddress, RoleOperator))
+	assert.Equal(t, ErrInvalidRole, check(operator, utils.SideChainManagerContractAddress, 0))
+}
+
+func TestRelayerOfNodeManager(t *testing.T) {
+	resetTestContext()
+
+	validator := testGenesisEpoch.Peers.List[0].Address
+	relayer := common.HexToAddress("0x123")
+	pk, _ := crypto.GenerateKey()
+	peers := testGenesisEpoch.Peers.Copy()
+	peers.List = append(peers.List, &node_manager.PeerInfo{
+		PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+		Address: crypto.PubkeyToAddress(pk.PublicKey),
+	})
+	sort.Sort(peers)
+	blockNum := 9
+	payload, err := (&node_manager.MethodProposeInput{StartHeight: uint64(blockNum) + node_manager.MinEpochValidPeriod + 1, Peers: peers}).Encode()
+	assert.NoError(t, err)
+	propose := func(config *params.ChainConfig) error {
+		ref := generateNativeContractRef(validator, blockNum)
+		ref.SetChainConfig(config)
+		_, _, err := ref.NativeCall(relayer, utils.NodeManagerContractAddress, payload)
+		return err
+	}
+
+	// the validator is not able to call through a contract without the relayer role
+	assert.Equal(t, node_manager.ErrInvalidAuthority, propose(nil))
+
+	sign(t, MethodGrantRole, &MethodRoleInput{Contract: utils.NodeManagerContractAddress, Role: RoleRelayer, Account: relayer}, 0, testGenesisEpoch.QuorumSize())
+	assert.Equal(t, node_manager.ErrInvalidAuthority, propose(&params.ChainConfig{AccessControlBlock: big.NewInt(100)}))
+	assert.NoError(t, propose(nil))
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package access_control
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_ROLE       = "st_role"
+	SKP_ROLE_NONCE = "st_role_nonce"
+)
+
+func getRoleMembers(s *native.NativeContract, contract common.Address, role Role) ([]common.Address, error) {
+	value, err := s.GetCacheDB().Get(roleKey(contract, role))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var data *node_manager.AddressList
+	if err := rlp.DecodeBytes(value, &data); err != nil {
+		return nil, err
+	}
+	return data.List, nil
+}
+
+func setRoleMembers(s *native.NativeContract, contract common.Address, role Role, list []common.Address) error {
+	key := roleKey(contract, role)
+	if len(list) == 0 {
+		s.GetCacheDB().Delete(key)
+		return nil
+	}
+
+	value, err := rlp.EncodeToBytes(&node_manager.AddressList{List: list})
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(key, value)
+	return nil
+}
+
+// getRoleNonce returns the change counter of role members, it's bound into consensus signs
+// so that signs of a past change can not be replayed after the members reverted.
+func getRoleNonce(s *native.NativeContract, contract common.Address, role Role) (uint64, error) {
+	value, err := s.GetCacheDB().Get(roleNonceKey(contract, role))
+	if err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(value), nil
+}
+
+func setRoleNonce(s *native.NativeContract, contract common.Address, role Role, nonce uint64) {
+	s.GetCacheDB().Put(roleNonceKey(contract, role), utils.GetUint64Bytes(nonce))
+}
+
+func roleKey(contract common.Address, role Role) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ROLE), contract.Bytes(), []byte{byte(role)})
+}
+
+func roleNonceKey(contract common.Address, role Role) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ROLE_NONCE), contract.Bytes(), []byte{byte(role)})
+}
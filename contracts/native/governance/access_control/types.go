@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package access_control
+
+import "fmt"
+
+// Role is the permission level an account holds on a native contract, roles are
+// granted per contract so that being the operator of one contract says nothing
+// about another.
+type Role uint8
+
+const (
+	RoleOwner Role = iota + 1
+	RoleOperator
+	RolePauser
+	RoleRelayer
+)
+
+func (r Role) Valid() bool {
+	return r >= RoleOwner && r <= RoleRelayer
+}
+
+func (r Role) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleOperator:
+		return "operator"
+	case RolePauser:
+		return "pauser"
+	case RoleRelayer:
+		return "relayer"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(r))
+	}
+}
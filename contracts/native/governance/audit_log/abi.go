@@ -0,0 +1,111 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit_log
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const contractName = "audit log"
+
+const (
+	MethodContractName = "name"
+	MethodRecordCount  = "recordCount"
+	MethodRecords      = "records"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodRecordCount + `","inputs":[],"outputs":[{"internalType":"uint64","name":"Count","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodRecords + `","inputs":[{"internalType":"uint64","name":"Start","type":"uint64"},{"internalType":"uint64","name":"Limit","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Records","type":"bytes"}],"stateMutability":"view"}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.AuditLogContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+type MethodRecordCountOutput struct {
+	Count uint64
+}
+
+func (m *MethodRecordCountOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodRecordCount, m.Count)
+}
+func (m *MethodRecordCountOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodRecordCount, m, payload)
+}
+
+type MethodRecordsInput struct {
+	Start uint64
+	Limit uint64
+}
+
+func (m *MethodRecordsInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRecords, m.Start, m.Limit)
+}
+func (m *MethodRecordsInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodRecords, m, payload)
+}
+
+type MethodRecordsOutput struct {
+	Records []*Record
+}
+
+func (m *MethodRecordsOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Records)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodRecords, enc)
+}
+func (m *MethodRecordsOutput) Decode(payload []byte) error {
+	var data struct {
+		Records []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodRecords, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Records, &m.Records)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit_log
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodRecordCount:  0,
+		MethodRecords:      0,
+	}
+)
+
+// MaxPageSize is the max number of records returned by one `records` query.
+const MaxPageSize uint64 = 100
+
+func InitAuditLog() {
+	InitABI()
+	native.RegisterABI(native.NativeAuditLog, "AuditLog", abijson)
+	native.Contracts[this] = RegisterAuditLogContract
+}
+
+func RegisterAuditLogContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodRecordCount, RecordCount)
+	s.RegisterQuery(MethodRecords, Records)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+func RecordCount(s *native.NativeContract) ([]byte, error) {
+	count, err := getRecordCount(s)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	return (&MethodRecordCountOutput{Count: count}).Encode()
+}
+
+// Records returns at most `Limit` records with id starting from `Start`, the page is
+// capped by `MaxPageSize` and is empty if `Start` is beyond the latest record.
+func Records(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRecordsInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	count, err := getRecordCount(s)
+	if err != nil {
+		return nil, ErrStorage
+	}
+
+	start, limit := input.Start, input.Limit
+	if start == 0 {
+		start = 1
+	}
+	if limit == 0 || limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	list := make([]*Record, 0)
+	for id := start; id <= count && id < start+limit; id++ {
+		record, err := getRecord(s, id)
+		if err != nil {
+			log.Trace("records", "get record failed", err, "id", id)
+			return nil, ErrStorage
+		}
+		list = append(list, record)
+	}
+	return (&MethodRecordsOutput{Records: list}).Encode()
+}
+
+// AddRecord appends a governance mutation of the current native contract to the audit log, the
+// log is kept since governance v2.
+func AddRecord(s *native.NativeContract, kind Kind, method string, actor common.Address, data []byte) error {
+	ref := s.ContractRef()
+	if !ref.IsGovV2() {
+		return nil
+	}
+	record := &Record{
+		Kind:     kind,
+		Contract: ref.CurrentContext().ContractAddress,
+		Method:   method,
+		Actor:    actor,
+		Data:     data,
+		Height:   ref.BlockHeight().Uint64(),
+		TxHash:   ref.TxHash(),
+	}
+	if err := appendRecord(s, record); err != nil {
+		log.Trace("record", "append audit record failed", err)
+		return ErrStorage
+	}
+	return nil
+}
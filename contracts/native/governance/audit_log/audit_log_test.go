@@ -0,0 +1,127 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit_log
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+var testStateDB *state.StateDB
+
+func TestMain(m *testing.M) {
+	InitAuditLog()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+}
+
+func generateNativeContract(contract common.Address, blockNum int) *native.NativeContract {
+	origin := common.HexToAddress("0x1")
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(int64(blockNum)), common.HexToHash("0x2"), 0, nil)
+	ref.PushContext(&native.Context{Caller: origin, ContractAddress: contract})
+	return native.NewNativeContract(testStateDB, ref)
+}
+
+func queryRecords(t *testing.T, start, limit uint64) []*Record {
+	payload, err := (&MethodRecordsInput{Start: start, Limit: limit}).Encode()
+	assert.NoError(t, err)
+	ref := native.NewContractRef(testStateDB, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.EmptyHash, 0, nil)
+	ret, _, err := ref.NativeCall(common.EmptyAddress, this, payload)
+	assert.NoError(t, err)
+	output := new(MethodRecordsOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output.Records
+}
+
+func TestAddRecord(t *testing.T) {
+	resetTestContext()
+
+	total := int(MaxPageSize) + 10
+	for i := 0; i < total; i++ {
+		s := generateNativeContract(utils.SideChainManagerContractAddress, i+1)
+		assert.NoError(t, AddRecord(s, KindVote, "approve", common.BigToAddress(big.NewInt(int64(i))), []byte{byte(i)}))
+	}
+
+	payload, err := utils.PackMethod(ABI, MethodRecordCount)
+	assert.NoError(t, err)
+	ref := native.NewContractRef(testStateDB, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.EmptyHash, 0, nil)
+	ret, _, err := ref.NativeCall(common.EmptyAddress, this, payload)
+	assert.NoError(t, err)
+	count := new(MethodRecordCountOutput)
+	assert.NoError(t, count.Decode(ret))
+	assert.Equal(t, uint64(total), count.Count)
+
+	list := queryRecords(t, 3, 2)
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, &Record{
+		ID:       3,
+		Kind:     KindVote,
+		Contract: utils.SideChainManagerContractAddress,
+		Method:   "approve",
+		Actor:    common.BigToAddress(big.NewInt(2)),
+		Data:     []byte{2},
+		Height:   3,
+		TxHash:   common.HexToHash("0x2"),
+	}, list[0])
+	assert.Equal(t, uint64(4), list[1].ID)
+
+	// page size is capped
+	assert.Equal(t, int(MaxPageSize), len(queryRecords(t, 0, 0)))
+	assert.Equal(t, int(MaxPageSize), len(queryRecords(t, 1, MaxPageSize+1)))
+	assert.Equal(t, uint64(1), queryRecords(t, 0, 1)[0].ID)
+
+	// the last page
+	list = queryRecords(t, uint64(total)-1, 10)
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, uint64(total), list[1].ID)
+	assert.Empty(t, queryRecords(t, uint64(total)+1, 10))
+}
+
+func TestAddRecordGovV2Fork(t *testing.T) {
+	resetTestContext()
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+
+	// nothing is recorded before the fork
+	s := generateNativeContract(utils.NodeManagerContractAddress, 9)
+	s.ContractRef().SetChainConfig(config)
+	assert.NoError(t, AddRecord(s, KindPropose, "propose", common.HexToAddress("0x3"), nil))
+	count, err := getRecordCount(s)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	s = generateNativeContract(utils.NodeManagerContractAddress, 10)
+	s.ContractRef().SetChainConfig(config)
+	assert.NoError(t, AddRecord(s, KindPropose, "propose", common.HexToAddress("0x3"), nil))
+	count, err = getRecordCount(s)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit_log
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_RECORD       = "st_record"
+	SKP_RECORD_COUNT = "st_record_count"
+)
+
+func getRecordCount(s *native.NativeContract) (uint64, error) {
+	value, err := s.GetCacheDB().Get(recordCountKey())
+	if err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(value), nil
+}
+
+func getRecord(s *native.NativeContract, id uint64) (*Record, error) {
+	value, err := s.GetCacheDB().Get(recordKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrRecordNotExist
+	}
+	record := new(Record)
+	if err := rlp.DecodeBytes(value, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// appendRecord assigns the next id to the record and store it, records are never
+// modified or deleted after that.
+func appendRecord(s *native.NativeContract, record *Record) error {
+	count, err := getRecordCount(s)
+	if err != nil {
+		return err
+	}
+	record.ID = count + 1
+	value, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(recordKey(record.ID), value)
+	s.GetCacheDB().Put(recordCountKey(), utils.GetUint64Bytes(record.ID))
+	return nil
+}
+
+func recordKey(id uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_RECORD), utils.GetUint64Bytes(id))
+}
+
+func recordCountKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_RECORD_COUNT))
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package audit_log
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Kind tells which step of a governance action the record is about.
+type Kind uint8
+
+const (
+	KindPropose Kind = iota + 1 // a change is proposed
+	KindVote                    // a validator signed or voted for a change
+	KindApprove                 // the change reached quorum
+	KindExecute                 // the change is applied to state
+	KindCancel                  // the change is dropped
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindPropose:
+		return "propose"
+	case KindVote:
+		return "vote"
+	case KindApprove:
+		return "approve"
+	case KindExecute:
+		return "execute"
+	case KindCancel:
+		return "cancel"
+	default:
+		return "unknown"
+	}
+}
+
+// Record is an entry of the append only governance audit log.
+type Record struct {
+	ID       uint64
+	Kind     Kind
+	Contract common.Address // the native contract mutated by governance
+	Method   string         // the governance method
+	Actor    common.Address // proposer, voter or executor
+	Data     []byte         // the method input or the changed content
+	Height   uint64
+	TxHash   common.Hash
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package data_oracle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "data oracle"
+
+const (
+	MethodContractName = "name"
+	MethodFeed         = "feed"
+	MethodData         = "data"
+	MethodLatest       = "latest"
+	MethodSetFeed      = "setFeed"
+	MethodSubmit       = "submit"
+
+	EventFeedUpdated   = "feedUpdated"
+	EventDataFinalized = "dataFinalized"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodFeed + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"}],"outputs":[{"internalType":"bool","name":"Enabled","type":"bool"},{"internalType":"uint64","name":"Latest","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodData + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"},{"internalType":"uint64","name":"Height","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Value","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodLatest + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"}],"outputs":[{"internalType":"uint64","name":"Height","type":"uint64"},{"internalType":"bytes","name":"Value","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSetFeed + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"},{"internalType":"bool","name":"Enabled","type":"bool"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSubmit + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"},{"internalType":"uint64","name":"Height","type":"uint64"},{"internalType":"bytes","name":"Value","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"` + EventFeedUpdated + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"FeedID","type":"string"},{"indexed":false,"internalType":"bool","name":"Enabled","type":"bool"}]},
+	{"type":"event","name":"` + EventDataFinalized + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"FeedID","type":"string"},{"indexed":false,"internalType":"uint64","name":"Height","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Value","type":"bytes"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.DataOracleContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+// MethodFeedIDInput is shared by `feed` and `latest`.
+type MethodFeedIDInput struct {
+	FeedID string
+}
+
+func (m *MethodFeedIDInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.FeedID)
+}
+func (m *MethodFeedIDInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodFeedOutput struct {
+	Enabled bool
+	Latest  uint64
+}
+
+func (m *MethodFeedOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodFeed, m.Enabled, m.Latest)
+}
+func (m *MethodFeedOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodFeed, m, payload)
+}
+
+type MethodDataInput struct {
+	FeedID string
+	Height uint64
+}
+
+func (m *MethodDataInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodData, m.FeedID, m.Height)
+}
+func (m *MethodDataInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodData, m, payload)
+}
+
+type MethodDataOutput struct {
+	Value []byte
+}
+
+func (m *MethodDataOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodData, m.Value)
+}
+func (m *MethodDataOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodData, m, payload)
+}
+
+type MethodLatestOutput struct {
+	Height uint64
+	Value  []byte
+}
+
+func (m *MethodLatestOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodLatest, m.Height, m.Value)
+}
+func (m *MethodLatestOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodLatest, m, payload)
+}
+
+type MethodSetFeedInput struct {
+	FeedID  string
+	Enabled bool
+}
+
+func (m *MethodSetFeedInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetFeed, m.FeedID, m.Enabled)
+}
+func (m *MethodSetFeedInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSetFeed, m, payload)
+}
+
+type MethodSubmitInput struct {
+	FeedID string
+	Height uint64
+	Value  []byte
+}
+
+func (m *MethodSubmitInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSubmit, m.FeedID, m.Height, m.Value)
+}
+func (m *MethodSubmitInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSubmit, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitFeedUpdated(s *native.NativeContract, feed *Feed) error {
+	return s.AddNotify(ABI, []string{EventFeedUpdated}, feed.ID, feed.Enabled)
+}
+
+func emitDataFinalized(s *native.NativeContract, feedID string, height uint64, value []byte) error {
+	return s.AddNotify(ABI, []string{EventDataFinalized}, feedID, height, value)
+}
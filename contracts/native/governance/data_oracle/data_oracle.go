@@ -0,0 +1,215 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package data_oracle
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodFeed:         0,
+		MethodData:         0,
+		MethodLatest:       0,
+		MethodSetFeed:      30000,
+		MethodSubmit:       30000,
+	}
+)
+
+const (
+	// MaxFeedIDLength limits the length of feed id, e.g: `BTC/USD` or `eth:blockhash`.
+	MaxFeedIDLength = 64
+	// MaxValueSize limits the size of a data point.
+	MaxValueSize = 1024
+)
+
+func InitDataOracle() {
+	InitABI()
+	native.RegisterABI(native.NativeDataOracle, "DataOracle", abijson)
+	native.Contracts[this] = RegisterDataOracleContract
+}
+
+func RegisterDataOracleContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodFeed, GetFeed)
+	s.RegisterQuery(MethodData, GetDataPoint)
+	s.RegisterQuery(MethodLatest, GetLatestDataPoint)
+	s.Register(MethodSetFeed, SetFeed)
+	s.Register(MethodSubmit, Submit)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+func GetFeed(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodFeedIDInput)
+	if err := input.Decode(MethodFeed, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	feed, err := getFeed(s, input.FeedID)
+	if err != nil {
+		return nil, err
+	}
+	return (&MethodFeedOutput{Enabled: feed.Enabled, Latest: feed.Latest}).Encode()
+}
+
+func GetDataPoint(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodDataInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	value, err := GetData(s, input.FeedID, input.Height)
+	if err != nil {
+		return nil, err
+	}
+	return (&MethodDataOutput{Value: value}).Encode()
+}
+
+func GetLatestDataPoint(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodFeedIDInput)
+	if err := input.Decode(MethodLatest, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	height, value, err := GetLatest(s, input.FeedID)
+	if err != nil {
+		return nil, err
+	}
+	return (&MethodLatestOutput{Height: height, Value: value}).Encode()
+}
+
+// SetFeed adds a feed into the whitelist or toggles it after validators consensus signs
+// reached quorum, the finalized data of a disabled feed is still available.
+func SetFeed(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodSetFeedInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("setFeed", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.FeedID == "" || len(input.FeedID) > MaxFeedIDLength {
+		return utils.ByteFailed, ErrInvalidFeed
+	}
+	feed, err := getFeed(s, input.FeedID)
+	if err == ErrFeedNotExist {
+		feed = &Feed{ID: input.FeedID}
+	} else if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+
+	// bind the feed version into signs, so that signs of a past change can't be reused.
+	ok, err := node_manager.CheckConsensusSigns(s, MethodSetFeed, append(utils.GetUint64Bytes(feed.Version), ctx.Payload...), s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodSetFeed)
+	}
+
+	feed.Enabled = input.Enabled
+	feed.Version++
+	if err := setFeed(s, feed); err != nil {
+		log.Trace("setFeed", "store feed failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitFeedUpdated(s, feed); err != nil {
+		log.Trace("setFeed", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSetFeed)
+}
+
+// Submit records a validator's vote for the value of feed at height, the data point is
+// finalized once the votes of exactly the same value reached quorum.
+func Submit(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodSubmitInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("submit", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Height == 0 || len(input.Value) == 0 || len(input.Value) > MaxValueSize {
+		return utils.ByteFailed, ErrInvalidData
+	}
+	feed, err := getFeed(s, input.FeedID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !feed.Enabled {
+		return utils.ByteFailed, ErrFeedDisabled
+	}
+	if _, err := getData(s, feed.ID, input.Height); err == nil {
+		return utils.ByteFailed, ErrDataFinalized
+	} else if err != ErrDataNotExist {
+		return utils.ByteFailed, ErrStorage
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, MethodSubmit, ctx.Payload, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodSubmit)
+	}
+
+	setData(s, feed.ID, input.Height, input.Value)
+	if input.Height > feed.Latest {
+		feed.Latest = input.Height
+		if err := setFeed(s, feed); err != nil {
+			log.Trace("submit", "store feed failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+	}
+	if err := emitDataFinalized(s, feed.ID, input.Height, input.Value); err != nil {
+		log.Trace("submit", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSubmit)
+}
+
+// GetData returns the finalized value of feed at height.
+func GetData(s *native.NativeContract, feedID string, height uint64) ([]byte, error) {
+	value, err := getData(s, feedID, height)
+	if err != nil && err != ErrDataNotExist {
+		return nil, ErrStorage
+	}
+	return value, err
+}
+
+// GetLatest returns the finalized value of feed at the highest height.
+func GetLatest(s *native.NativeContract, feedID string) (uint64, []byte, error) {
+	feed, err := getFeed(s, feedID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if feed.Latest == 0 {
+		return 0, nil, ErrDataNotExist
+	}
+	value, err := GetData(s, feedID, feed.Latest)
+	if err != nil {
+		return 0, nil, err
+	}
+	return feed.Latest, value, nil
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package data_oracle
+
+import (
+	"crypto/rand"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(100000000000000000)
+	testFeedID     = "eth:blockhash"
+)
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	InitDataOracle()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+}
+
+func invoke(origin common.Address, payload []byte) ([]byte, error) {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(1), common.BytesToHash(token), testSupplyGas, nil)
+	ret, _, err := ref.NativeCall(origin, this, payload)
+	return ret, err
+}
+
+func validator(i int) common.Address {
+	return testGenesisEpoch.Peers.List[i].Address
+}
+
+func setTestFeed(t *testing.T, enabled bool) {
+	payload, err := (&MethodSetFeedInput{FeedID: testFeedID, Enabled: enabled}).Encode()
+	assert.NoError(t, err)
+	for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+		_, err = invoke(validator(i), payload)
+		assert.NoError(t, err)
+	}
+}
+
+func getTestFeed(t *testing.T) *MethodFeedOutput {
+	payload, err := (&MethodFeedIDInput{FeedID: testFeedID}).Encode(MethodFeed)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, payload)
+	assert.NoError(t, err)
+	output := new(MethodFeedOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output
+}
+
+func submit(i int, height uint64, value []byte) error {
+	payload, _ := (&MethodSubmitInput{FeedID: testFeedID, Height: height, Value: value}).Encode()
+	_, err := invoke(validator(i), payload)
+	return err
+}
+
+func getTestData(height uint64) ([]byte, error) {
+	payload, _ := (&MethodDataInput{FeedID: testFeedID, Height: height}).Encode()
+	ret, err := invoke(common.EmptyAddress, payload)
+	if err != nil {
+		return nil, err
+	}
+	output := new(MethodDataOutput)
+	if err := output.Decode(ret); err != nil {
+		return nil, err
+	}
+	return output.Value, nil
+}
+
+func TestSetFeed(t *testing.T) {
+	resetTestContext()
+
+	payload, err := (&MethodSetFeedInput{FeedID: testFeedID, Enabled: true}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), payload)
+	assert.Equal(t, node_manager.ErrInvalidAuthority, err)
+
+	setTestFeed(t, true)
+	assert.True(t, getTestFeed(t).Enabled)
+	setTestFeed(t, false)
+	assert.False(t, getTestFeed(t).Enabled)
+
+	// the same change can be voted again after the feed version changed
+	setTestFeed(t, true)
+	assert.True(t, getTestFeed(t).Enabled)
+}
+
+func TestSubmit(t *testing.T) {
+	resetTestContext()
+
+	value := crypto.Keccak256([]byte("block"))
+	assert.Equal(t, ErrFeedNotExist, submit(0, 10, value))
+	setTestFeed(t, true)
+	assert.Equal(t, ErrInvalidData, submit(0, 10, nil))
+
+	// votes of different values never reach quorum together
+	quorum := testGenesisEpoch.QuorumSize()
+	assert.NoError(t, submit(0, 10, []byte{1}))
+	for i := 1; i < quorum; i++ {
+		_, err := getTestData(10)
+		assert.Equal(t, ErrDataNotExist, err)
+		assert.NoError(t, submit(i, 10, value))
+	}
+	_, err := getTestData(10)
+	assert.Equal(t, ErrDataNotExist, err)
+	assert.NoError(t, submit(quorum, 10, value))
+
+	data, err := getTestData(10)
+	assert.NoError(t, err)
+	assert.Equal(t, value, data)
+	assert.Equal(t, uint64(10), getTestFeed(t).Latest)
+	assert.Equal(t, ErrDataFinalized, submit(0, 10, value))
+
+	// latest is not moved back by an older height
+	for i := 0; i < quorum; i++ {
+		assert.NoError(t, submit(i, 5, value))
+	}
+	payload, err := (&MethodFeedIDInput{FeedID: testFeedID}).Encode(MethodLatest)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, payload)
+	assert.NoError(t, err)
+	latest := new(MethodLatestOutput)
+	assert.NoError(t, latest.Decode(ret))
+	assert.Equal(t, uint64(10), latest.Height)
+	assert.Equal(t, value, latest.Value)
+
+	setTestFeed(t, false)
+	assert.Equal(t, ErrFeedDisabled, submit(0, 11, value))
+	data, err = getTestData(10)
+	assert.NoError(t, err)
+	assert.Equal(t, value, data)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package data_oracle
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_FEED = "st_feed"
+	SKP_DATA = "st_data"
+)
+
+func getFeed(s *native.NativeContract, id string) (*Feed, error) {
+	value, err := s.GetCacheDB().Get(feedKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrFeedNotExist
+	}
+
+	feed := new(Feed)
+	if err := rlp.DecodeBytes(value, feed); err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
+
+func setFeed(s *native.NativeContract, feed *Feed) error {
+	value, err := rlp.EncodeToBytes(feed)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(feedKey(feed.ID), value)
+	return nil
+}
+
+func getData(s *native.NativeContract, feedID string, height uint64) ([]byte, error) {
+	value, err := s.GetCacheDB().Get(dataKey(feedID, height))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrDataNotExist
+	}
+	return value, nil
+}
+
+func setData(s *native.NativeContract, feedID string, height uint64, value []byte) {
+	s.GetCacheDB().Put(dataKey(feedID, height), value)
+}
+
+// feed id is hashed in keys, so that ids of different length never overlap.
+func feedKey(id string) []byte {
+	return utils.ConcatKey(this, []byte(SKP_FEED), crypto.Keccak256([]byte(id)))
+}
+
+func dataKey(feedID string, height uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_DATA), crypto.Keccak256([]byte(feedID)), utils.GetUint64Bytes(height))
+}
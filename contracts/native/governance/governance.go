@@ -52,16 +52,17 @@ var (
 
 func InitGovernance() {
 	ABI = GetABI()
+	native.RegisterABI(native.NativeGovernance, "Governance", abijson)
 	native.Contracts[this] = RegisterGovernanceContract
 }
 
 func RegisterGovernanceContract(s *native.NativeContract) {
 	s.Prepare(&ABI, gasTable)
 
-	s.Register(MethodContractName, Name)
-	s.Register(MethodGetEpoch, GetEpoch)
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodGetEpoch, GetEpoch)
 	s.Register(MethodAddValidator, AddValidator)
-	s.Register(MethodGetValidators, GetValidators)
+	s.RegisterQuery(MethodGetValidators, GetValidators)
 }
 
 func Name(s *native.NativeContract) ([]byte, error) {
@@ -69,6 +69,9 @@ func TestEpoch(t *testing.T) {
 }
 
 func TestAddValidator(t *testing.T) {
+	// every transaction runs in a new contract ref, the context left by the previous call would
+	// be taken as a reentrant call.
+	testEnv = native.NewContractRef(testStateDB, testCaller, testCaller, big.NewInt(1), common.Hash{}, testGasSupply, nil)
 	name := MethodAddValidator
 
 	expectValidator := common.HexToAddress("0x12345")
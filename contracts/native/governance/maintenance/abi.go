@@ -0,0 +1,104 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "maintenance"
+
+const (
+	MethodContractName  = "name"
+	MethodDisableMethod = "disableMethod"
+	MethodEnableMethod  = "enableMethod"
+	MethodIsDisabled    = "isDisabled"
+
+	EventMethodDisabled = "methodDisabled"
+	EventMethodEnabled  = "methodEnabled"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodDisableMethod + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"string","name":"Method","type":"string"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodEnableMethod + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"string","name":"Method","type":"string"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodIsDisabled + `","inputs":[{"internalType":"address","name":"Contract","type":"address"},{"internalType":"string","name":"Method","type":"string"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"view"},
+	{"type":"event","name":"` + EventMethodDisabled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Contract","type":"address"},{"indexed":false,"internalType":"string","name":"Method","type":"string"}]},
+	{"type":"event","name":"` + EventMethodEnabled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Contract","type":"address"},{"indexed":false,"internalType":"string","name":"Method","type":"string"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.MaintenanceContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+// MethodSwitchInput is shared by `disableMethod`, `enableMethod` and `isDisabled`, the method is
+// specified by name in the abi of the target contract.
+type MethodSwitchInput struct {
+	Contract common.Address
+	Method   string
+}
+
+func (m *MethodSwitchInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.Contract, m.Method)
+}
+func (m *MethodSwitchInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitMethodSwitched(s *native.NativeContract, event string, contract common.Address, method string) error {
+	return s.AddNotify(ABI, []string{event}, contract, method)
+}
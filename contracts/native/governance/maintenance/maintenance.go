@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName:  0,
+		MethodDisableMethod: 30000,
+		MethodEnableMethod:  30000,
+		MethodIsDisabled:    0,
+	}
+)
+
+func InitMaintenance() {
+	InitABI()
+	native.RegisterABI(native.NativeMaintenance, "Maintenance", abijson)
+	native.RegisterMethodGuard(IsMethodDisabled)
+	native.Contracts[this] = RegisterMaintenanceContract
+}
+
+func RegisterMaintenanceContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.Register(MethodDisableMethod, DisableMethod)
+	s.Register(MethodEnableMethod, EnableMethod)
+	s.RegisterQuery(MethodIsDisabled, IsDisabled)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+// DisableMethod validators disable a non-query method of native contract, e.g: `propose` of node
+// manager during a planned migration. the method is rejected by the dispatcher with reason
+// `method disabled` as soon as the consensus signs of the same input reached quorum.
+func DisableMethod(s *native.NativeContract) ([]byte, error) {
+	if err := switchMethod(s, MethodDisableMethod, EventMethodDisabled, true); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodDisableMethod)
+}
+
+// EnableMethod validators enable the disabled method again after quorum reached.
+func EnableMethod(s *native.NativeContract) ([]byte, error) {
+	if err := switchMethod(s, MethodEnableMethod, EventMethodEnabled, false); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodEnableMethod)
+}
+
+// switchMethod collects the consensus sign of the caller and turns the method switch to
+// `disabled` after quorum reached, the methods are switched since governance v2.
+func switchMethod(s *native.NativeContract, method, event string, disabled bool) error {
+	if !s.ContractRef().IsGovV2() {
+		return node_manager.ErrGovV2NotActivated
+	}
+	payload := s.ContractRef().CurrentContext().Payload
+	input := new(MethodSwitchInput)
+	if err := input.Decode(method, payload); err != nil {
+		log.Trace(method, "decode input failed", err)
+		return ErrInvalidInput
+	}
+	if input.Contract == this || input.Contract == utils.SystemContractAddress {
+		return ErrProtectedContract
+	}
+	id, err := methodID(input.Contract, input.Method)
+	if err != nil {
+		log.Trace(method, "find method failed", err)
+		return err
+	}
+
+	if isDisabled(s.GetCacheDB(), input.Contract, id) == disabled {
+		if disabled {
+			return ErrMethodDisabled
+		}
+		return ErrMethodEnabled
+	}
+
+	nonce, err := getSwitchNonce(s, input.Contract, id)
+	if err != nil {
+		log.Trace(method, "get switch nonce failed", err)
+		return ErrStorage
+	}
+	sign := append(utils.GetUint64Bytes(nonce), payload...)
+	ok, err := node_manager.CheckConsensusSigns(s, method, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	setDisabled(s, input.Contract, id, disabled)
+	setSwitchNonce(s, input.Contract, id, nonce+1)
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, method, s.ContractRef().MsgSender(), payload); err != nil {
+		return ErrStorage
+	}
+	if err := emitMethodSwitched(s, event, input.Contract, input.Method); err != nil {
+		log.Trace(method, "emit event failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+func IsDisabled(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodSwitchInput)
+	if err := input.Decode(MethodIsDisabled, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	id, err := methodID(input.Contract, input.Method)
+	if err != nil {
+		return nil, err
+	}
+	output := &MethodBoolOutput{Success: isDisabled(s.GetCacheDB(), input.Contract, id)}
+	return output.Encode(MethodIsDisabled)
+}
+
+// methodID finds the non-query method in the registered abi of the native contract.
+func methodID(contract common.Address, name string) ([]byte, error) {
+	if !native.IsNativeContract(contract) {
+		return nil, ErrInvalidContract
+	}
+	for _, entry := range native.RegisteredABIs() {
+		if entry.Address != contract {
+			continue
+		}
+		ab, err := abi.JSON(strings.NewReader(entry.JSON))
+		if err != nil {
+			return nil, fmt.Errorf("invalid abi of %s: %v", entry.Name, err)
+		}
+		method, ok := ab.Methods[name]
+		if !ok || method.IsConstant() {
+			return nil, ErrInvalidMethod
+		}
+		return method.ID, nil
+	}
+	return nil, ErrInvalidMethod
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maintenance
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(100000000000000000)
+)
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+	testChainConfig  *params.ChainConfig
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	InitMaintenance()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+	testChainConfig = nil
+}
+
+func invoke(origin, to common.Address, payload []byte) ([]byte, error) {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(1), common.BytesToHash(token), testSupplyGas, nil)
+	ref.SetChainConfig(testChainConfig)
+	ret, _, err := ref.NativeCall(origin, to, payload)
+	return ret, err
+}
+
+// sign the payload with genesis validators in range of [from, to)
+func sign(t *testing.T, method string, input *MethodSwitchInput, from, to int) error {
+	payload, err := input.Encode(method)
+	assert.NoError(t, err)
+	for i := from; i < to; i++ {
+		if _, err := invoke(testGenesisEpoch.Peers.List[i].Address, this, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func disabled(t *testing.T, input *MethodSwitchInput) bool {
+	payload, err := input.Encode(MethodIsDisabled)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, this, payload)
+	assert.NoError(t, err)
+	output := new(MethodBoolOutput)
+	assert.NoError(t, output.Decode(MethodIsDisabled, ret))
+	return output.Success
+}
+
+func TestDisableAndEnableMethod(t *testing.T) {
+	resetTestContext()
+
+	input := &MethodSwitchInput{Contract: utils.NodeManagerContractAddress, Method: node_manager.MethodPropose}
+	quorum := testGenesisEpoch.QuorumSize()
+	propose, err := (&node_manager.MethodProposeInput{StartHeight: 100, Peers: testGenesisEpoch.Peers}).Encode()
+	assert.NoError(t, err)
+	proposer := testGenesisEpoch.Peers.List[0].Address
+
+	// method is not disabled before quorum
+	assert.NoError(t, sign(t, MethodDisableMethod, input, 0, quorum-1))
+	assert.False(t, disabled(t, input))
+	assert.False(t, IsMethodDisabled(testStateDB, input.Contract, node_manager.ABI.Methods[input.Method].ID))
+
+	assert.NoError(t, sign(t, MethodDisableMethod, input, quorum-1, quorum))
+	assert.True(t, disabled(t, input))
+	assert.True(t, IsMethodDisabled(testStateDB, input.Contract, node_manager.ABI.Methods[input.Method].ID))
+	assert.Equal(t, ErrMethodDisabled, sign(t, MethodDisableMethod, input, 0, 1))
+
+	// disabled method is rejected, while the queries are still available
+	_, err = invoke(proposer, utils.NodeManagerContractAddress, propose)
+	var disabledErr *native.MethodDisabledError
+	assert.True(t, errors.As(err, &disabledErr))
+	assert.Equal(t, node_manager.MethodPropose, disabledErr.Method)
+	payload, err := new(node_manager.MethodEpochInput).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(proposer, utils.NodeManagerContractAddress, payload)
+	assert.NoError(t, err)
+
+	// enable again
+	assert.NoError(t, sign(t, MethodEnableMethod, input, 0, quorum))
+	assert.False(t, disabled(t, input))
+	assert.Equal(t, ErrMethodEnabled, sign(t, MethodEnableMethod, input, 0, 1))
+	_, err = invoke(proposer, utils.NodeManagerContractAddress, propose)
+	assert.False(t, errors.As(err, &disabledErr))
+
+	// signs of the past change can not be replayed
+	assert.NoError(t, sign(t, MethodDisableMethod, input, 0, 1))
+	assert.False(t, disabled(t, input))
+}
+
+func TestInvalidSwitch(t *testing.T) {
+	resetTestContext()
+
+	cases := []struct {
+		input  *MethodSwitchInput
+		expect error
+	}{
+		{&MethodSwitchInput{Contract: common.HexToAddress("0x123"), Method: node_manager.MethodPropose}, ErrInvalidContract},
+		{&MethodSwitchInput{Contract: this, Method: MethodEnableMethod}, ErrProtectedContract},
+		{&MethodSwitchInput{Contract: utils.SystemContractAddress, Method: "finalize"}, ErrProtectedContract},
+		{&MethodSwitchInput{Contract: utils.NodeManagerContractAddress, Method: "notExist"}, ErrInvalidMethod},
+		{&MethodSwitchInput{Contract: utils.NodeManagerContractAddress, Method: node_manager.MethodEpoch}, ErrInvalidMethod},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expect, sign(t, MethodDisableMethod, c.input, 0, 1))
+	}
+}
+
+func TestSwitchMethodGovV2Fork(t *testing.T) {
+	resetTestContext()
+
+	input := &MethodSwitchInput{Contract: utils.NodeManagerContractAddress, Method: node_manager.MethodPropose}
+	quorum := testGenesisEpoch.QuorumSize()
+	propose, err := (&node_manager.MethodProposeInput{StartHeight: 100, Peers: testGenesisEpoch.Peers}).Encode()
+	assert.NoError(t, err)
+	proposer := testGenesisEpoch.Peers.List[0].Address
+	assert.NoError(t, sign(t, MethodDisableMethod, input, 0, quorum))
+
+	// methods are neither switched nor guarded before governance v2
+	testChainConfig = &params.ChainConfig{GovV2Block: big.NewInt(2)}
+	assert.Equal(t, node_manager.ErrGovV2NotActivated, sign(t, MethodEnableMethod, input, 0, 1))
+	_, err = invoke(proposer, utils.NodeManagerContractAddress, propose)
+	var disabledErr *native.MethodDisabledError
+	assert.False(t, errors.As(err, &disabledErr))
+
+	testChainConfig = &params.ChainConfig{GovV2Block: big.NewInt(1)}
+	_, err = invoke(proposer, utils.NodeManagerContractAddress, propose)
+	assert.True(t, errors.As(err, &disabledErr))
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maintenance
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// storage key prefix
+const (
+	SKP_DISABLED     = "st_disabled"
+	SKP_SWITCH_NONCE = "st_switch_nonce"
+)
+
+// IsMethodDisabled implements the native.MethodGuard, it reads the method switch from state
+// directly as it's checked before dispatching.
+func IsMethodDisabled(db *state.StateDB, contract common.Address, methodID []byte) bool {
+	return isDisabled((*state.CacheDB)(db), contract, methodID)
+}
+
+func isDisabled(db *state.CacheDB, contract common.Address, methodID []byte) bool {
+	value, err := db.Get(disabledKey(contract, methodID))
+	return err == nil && len(value) > 0
+}
+
+func setDisabled(s *native.NativeContract, contract common.Address, methodID []byte, disabled bool) {
+	key := disabledKey(contract, methodID)
+	if disabled {
+		s.GetCacheDB().Put(key, utils.BYTE_TRUE)
+	} else {
+		s.GetCacheDB().Delete(key)
+	}
+}
+
+// getSwitchNonce returns the change counter of method switch, it's bound into consensus signs so
+// that signs of a past change can not be replayed after the switch turned back.
+func getSwitchNonce(s *native.NativeContract, contract common.Address, methodID []byte) (uint64, error) {
+	value, err := s.GetCacheDB().Get(switchNonceKey(contract, methodID))
+	if err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(value), nil
+}
+
+func setSwitchNonce(s *native.NativeContract, contract common.Address, methodID []byte, nonce uint64) {
+	s.GetCacheDB().Put(switchNonceKey(contract, methodID), utils.GetUint64Bytes(nonce))
+}
+
+func disabledKey(contract common.Address, methodID []byte) []byte {
+	return utils.ConcatKey(this, []byte(SKP_DISABLED), contract.Bytes(), methodID)
+}
+
+func switchNonceKey(contract common.Address, methodID []byte) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SWITCH_NONCE), contract.Bytes(), methodID)
+}
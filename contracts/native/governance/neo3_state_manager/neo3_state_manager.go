@@ -50,14 +50,15 @@ var (
 
 func InitNeo3StateManager() {
 	ABI = GetABI()
+	native.RegisterABI(native.NativeNeo3StateManager, "Neo3StateManager", abijson)
 	native.Contracts[this] = RegisterNeo3StateManagerContract
 }
 
 func RegisterNeo3StateManagerContract(s *native.NativeContract) {
 	s.Prepare(ABI, gasTable)
 
-	s.Register(MethodContractName, Name)
-	s.Register(MethodGetCurrentStateValidator, GetCurrentStateValidator)
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodGetCurrentStateValidator, GetCurrentStateValidator)
 	s.Register(MethodRegisterStateValidator, RegisterStateValidator)
 	s.Register(MethodApproveRegisterStateValidator, ApproveRegisterStateValidator)
 	s.Register(MethodRemoveStateValidator, RemoveStateValidator)
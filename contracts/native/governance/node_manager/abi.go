@@ -32,30 +32,131 @@ import (
 const contractName = "node manager"
 
 const (
-	MethodContractName = "name"
-	MethodPropose      = "propose"
-	MethodVote         = "vote"
-	MethodEpoch        = "epoch"
-	MethodProof        = "proof"
-	MethodNextEpoch    = "nextEpoch"
-
-	EventPropose         = "proposed"
-	EventVote            = "voted"
-	EventEpochChange     = "epochChanged"
-	EventConsensusSigned = "consensusSigned"
+	MethodContractName   = "name"
+	MethodPropose        = "propose"
+	MethodVote           = "vote"
+	MethodEpoch          = "epoch"
+	MethodProof          = "proof"
+	MethodNextEpoch      = "nextEpoch"
+	MethodProposals      = "proposals"
+	MethodPeersLimit     = "peersLimit"
+	MethodSetPeersLimit  = "setPeersLimit"
+	MethodQuorumRule     = "quorumRule"
+	MethodSetQuorumRule  = "setQuorumRule"
+	MethodSeed           = "seed"
+	MethodEpochSeed      = "epochSeed"
+	MethodRegisterVrfKey = "registerVrfKey"
+	MethodSubmitVrf      = "submitVrf"
+	MethodVrfKey         = "vrfKey"
+	MethodVrfOutput      = "vrfOutput"
+	MethodPayouts        = "payouts"
+	MethodFeeSplit       = "feeSplit"
+	MethodSetFeeSplit    = "setFeeSplit"
+
+	MethodProposeWithActions = "proposeWithActions"
+	MethodProposalActions    = "proposalActions"
+	MethodSealVoting         = "sealVoting"
+	MethodVotingSeal         = "votingSeal"
+	MethodCommitVote         = "commitVote"
+	MethodRevealVote         = "revealVote"
+	MethodEject              = "eject"
+	MethodMisconduct         = "misconduct"
+	MethodSweepExpired       = "sweepExpired"
+	MethodProposeGroup       = "proposeGroup"
+	MethodVoteGroup          = "voteGroup"
+	MethodGroupEpoch         = "groupEpoch"
+	MethodGroupProof         = "groupProof"
+	MethodGroups             = "groups"
+	MethodGroupQuorumRule    = "groupQuorumRule"
+	MethodSetGroupQuorumRule = "setGroupQuorumRule"
+	MethodSignEpochChange    = "signEpochChange"
+	MethodEpochChangeSigns   = "epochChangeSigns"
+	MethodEpochPeers         = "epochPeers"
+
+	EventPropose           = "proposed"
+	EventVote              = "voted"
+	EventEpochChange       = "epochChanged"
+	EventProposalRejected  = "proposalRejected"
+	EventConsensusSigned   = "consensusSigned"
+	EventPeersLimitChanged = "peersLimitChanged"
+	EventQuorumRuleChanged = "quorumRuleChanged"
+	EventVrfSubmitted      = "vrfSubmitted"
+	EventFeeSplitChanged   = "feeSplitChanged"
+	EventActionsExecuted   = "actionsExecuted"
+	EventVotingSealed      = "votingSealed"
+	EventVoteCommitted     = "voteCommitted"
+	EventVotingTallied     = "votingTallied"
+	EventValidatorEjected  = "validatorEjected"
+	EventMisconduct        = "misconductRecorded"
+	EventExpirySwept       = "expirySwept"
+	EventGroupProposed     = "groupProposed"
+	EventGroupVoted        = "groupVoted"
+	EventGroupEpochChanged = "groupEpochChanged"
+	EventGroupRuleChanged  = "groupQuorumRuleChanged"
+	EventEpochChangeSigned = "epochChangeSigned"
 )
 
 const abijson = `[
-    {"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"nonpayable"},
+    {"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
 	{"type":"function","name":"` + MethodPropose + `","inputs":[{"internalType":"uint64","name":"StartHeight","type":"uint64"},{"internalType":"bytes","name":"Peers","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
     {"type":"function","name":"` + MethodVote + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"bytes","name":"Hash","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
-	{"type":"function","name":"` + MethodEpoch + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Epoch","type":"bytes"}],"stateMutability":"nonpayable"},
-	{"type":"function","name":"` + MethodNextEpoch + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Epoch","type":"bytes"}],"stateMutability":"nonpayable"},
-	{"type":"function","name":"` + MethodProof + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Hash","type":"bytes"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodEpoch + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Epoch","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodNextEpoch + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Epoch","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodProposals + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Proposals","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodProof + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Hash","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodPeersLimit + `","inputs":[],"outputs":[{"internalType":"uint64","name":"Target","type":"uint64"},{"internalType":"uint64","name":"MaxChange","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodQuorumRule + `","inputs":[],"outputs":[{"internalType":"uint64","name":"Numerator","type":"uint64"},{"internalType":"uint64","name":"Denominator","type":"uint64"},{"internalType":"bool","name":"Strict","type":"bool"},{"internalType":"uint64","name":"Threshold","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSeed + `","inputs":[],"outputs":[{"internalType":"bytes","name":"Seed","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodEpochSeed + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Seed","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodRegisterVrfKey + `","inputs":[{"internalType":"bytes","name":"PubKey","type":"bytes"},{"internalType":"bytes","name":"Output","type":"bytes"},{"internalType":"bytes","name":"Proof","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSubmitVrf + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"bytes","name":"Output","type":"bytes"},{"internalType":"bytes","name":"Proof","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodVrfKey + `","inputs":[{"internalType":"address","name":"Validator","type":"address"}],"outputs":[{"internalType":"bytes","name":"PubKey","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodVrfOutput + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"address","name":"Validator","type":"address"}],"outputs":[{"internalType":"bytes","name":"Output","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodPayouts + `","inputs":[{"internalType":"address","name":"Validator","type":"address"},{"internalType":"uint64","name":"StartEpoch","type":"uint64"},{"internalType":"uint64","name":"EndEpoch","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Payouts","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodFeeSplit + `","inputs":[],"outputs":[{"internalType":"uint64","name":"BurnRate","type":"uint64"},{"internalType":"uint64","name":"TreasuryRate","type":"uint64"},{"internalType":"address","name":"Treasury","type":"address"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSetFeeSplit + `","inputs":[{"internalType":"uint64","name":"BurnRate","type":"uint64"},{"internalType":"uint64","name":"TreasuryRate","type":"uint64"},{"internalType":"address","name":"Treasury","type":"address"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodProposeWithActions + `","inputs":[{"internalType":"uint64","name":"StartHeight","type":"uint64"},{"internalType":"bytes","name":"Peers","type":"bytes"},{"internalType":"bytes[]","name":"Actions","type":"bytes[]"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodProposalActions + `","inputs":[{"internalType":"bytes","name":"Hash","type":"bytes"}],"outputs":[{"internalType":"bytes[]","name":"Actions","type":"bytes[]"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSealVoting + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"uint64","name":"CommitPeriod","type":"uint64"},{"internalType":"uint64","name":"RevealPeriod","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodVotingSeal + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"}],"outputs":[{"internalType":"uint64","name":"CommitEnd","type":"uint64"},{"internalType":"uint64","name":"RevealEnd","type":"uint64"},{"internalType":"bool","name":"Tallied","type":"bool"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodCommitVote + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"bytes","name":"Commitment","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodRevealVote + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"bytes","name":"Hash","type":"bytes"},{"internalType":"bytes","name":"Salt","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodEject + `","inputs":[{"internalType":"address","name":"Validator","type":"address"},{"internalType":"bytes","name":"Evidence","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodMisconduct + `","inputs":[{"internalType":"address","name":"Validator","type":"address"},{"internalType":"uint64","name":"StartEpoch","type":"uint64"},{"internalType":"uint64","name":"EndEpoch","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Reports","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSweepExpired + `","inputs":[{"internalType":"uint64","name":"Height","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodProposeGroup + `","inputs":[{"internalType":"string","name":"Name","type":"string"},{"internalType":"uint64","name":"StartHeight","type":"uint64"},{"internalType":"bytes","name":"Peers","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodVoteGroup + `","inputs":[{"internalType":"string","name":"Name","type":"string"},{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"bytes","name":"Hash","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodGroupEpoch + `","inputs":[{"internalType":"string","name":"Name","type":"string"}],"outputs":[{"internalType":"bytes","name":"Epoch","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodGroupProof + `","inputs":[{"internalType":"string","name":"Name","type":"string"},{"internalType":"uint64","name":"EpochID","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Hash","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodGroups + `","inputs":[],"outputs":[{"internalType":"string[]","name":"Names","type":"string[]"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodGroupQuorumRule + `","inputs":[{"internalType":"string","name":"Name","type":"string"}],"outputs":[{"internalType":"uint64","name":"Numerator","type":"uint64"},{"internalType":"uint64","name":"Denominator","type":"uint64"},{"internalType":"bool","name":"Strict","type":"bool"},{"internalType":"uint64","name":"Threshold","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSetGroupQuorumRule + `","inputs":[{"internalType":"string","name":"Name","type":"string"},{"internalType":"uint64","name":"Numerator","type":"uint64"},{"internalType":"uint64","name":"Denominator","type":"uint64"},{"internalType":"bool","name":"Strict","type":"bool"},{"internalType":"uint64","name":"Threshold","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSignEpochChange + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"},{"internalType":"bytes","name":"Signature","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodEpochChangeSigns + `","inputs":[{"internalType":"uint64","name":"EpochID","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Digest","type":"bytes"},{"internalType":"bytes[]","name":"Signatures","type":"bytes[]"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodEpochPeers + `","inputs":[{"internalType":"bytes","name":"Hash","type":"bytes"},{"internalType":"uint64","name":"Start","type":"uint64"},{"internalType":"uint64","name":"Limit","type":"uint64"}],"outputs":[{"internalType":"uint64","name":"Total","type":"uint64"},{"internalType":"bytes","name":"Peers","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSetPeersLimit + `","inputs":[{"internalType":"uint64","name":"Target","type":"uint64"},{"internalType":"uint64","name":"MaxChange","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSetQuorumRule + `","inputs":[{"internalType":"uint64","name":"Numerator","type":"uint64"},{"internalType":"uint64","name":"Denominator","type":"uint64"},{"internalType":"bool","name":"Strict","type":"bool"},{"internalType":"uint64","name":"Threshold","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
     {"type":"event","name":"` + EventPropose + `","anonymous":false,"inputs":[{"internalType":"bytes","name":"Epoch","type":"bytes"}]},
 	{"type":"event","name":"` + EventVote + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Hash","type":"bytes"},{"indexed":false,"internalType":"uint64","name":"VotedNumber","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"GroupSize","type":"uint64"}]},
 	{"type":"event","name":"` + EventEpochChange + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes","name":"Epoch","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"NextEpoch","type":"bytes"}]},
-	{"type":"event","name":"` + EventConsensusSigned + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"Method","type":"string"},{"indexed":false,"internalType":"bytes","name":"Input","type":"bytes"},{"indexed":false,"internalType":"address","name":"Signer","type":"address"},{"indexed":false,"internalType":"uint64","name":"Size","type":"uint64"}]}
+	{"type":"event","name":"` + EventProposalRejected + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Hash","type":"bytes"},{"indexed":false,"internalType":"uint64","name":"Votes","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Winner","type":"bytes"}]},
+	{"type":"event","name":"` + EventConsensusSigned + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"Method","type":"string"},{"indexed":false,"internalType":"bytes","name":"Input","type":"bytes"},{"indexed":false,"internalType":"address","name":"Signer","type":"address"},{"indexed":false,"internalType":"uint64","name":"Size","type":"uint64"}]},
+	{"type":"event","name":"` + EventPeersLimitChanged + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"Target","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"MaxChange","type":"uint64"}]},
+	{"type":"event","name":"` + EventQuorumRuleChanged + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"Numerator","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"Denominator","type":"uint64"},{"indexed":false,"internalType":"bool","name":"Strict","type":"bool"},{"indexed":false,"internalType":"uint64","name":"Threshold","type":"uint64"}]},
+	{"type":"event","name":"` + EventVrfSubmitted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Validator","type":"address"},{"indexed":false,"internalType":"bytes","name":"Output","type":"bytes"}]},
+	{"type":"event","name":"` + EventFeeSplitChanged + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"BurnRate","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"TreasuryRate","type":"uint64"},{"indexed":false,"internalType":"address","name":"Treasury","type":"address"}]},
+	{"type":"event","name":"` + EventActionsExecuted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Hash","type":"bytes"},{"indexed":false,"internalType":"uint64","name":"Actions","type":"uint64"}]},
+	{"type":"event","name":"` + EventVotingSealed + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"CommitEnd","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"RevealEnd","type":"uint64"}]},
+	{"type":"event","name":"` + EventVoteCommitted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Voter","type":"address"}]},
+	{"type":"event","name":"` + EventVotingTallied + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Winner","type":"bytes"}]},
+	{"type":"event","name":"` + EventValidatorEjected + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Validator","type":"address"},{"indexed":false,"internalType":"uint64","name":"StartHeight","type":"uint64"}]},
+	{"type":"event","name":"` + EventMisconduct + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Validator","type":"address"},{"indexed":false,"internalType":"uint8","name":"Kind","type":"uint8"}]},
+	{"type":"event","name":"` + EventExpirySwept + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"Height","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"Entries","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"Reclaimed","type":"uint64"}]},
+	{"type":"event","name":"` + EventGroupProposed + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"Name","type":"string"},{"indexed":false,"internalType":"bytes","name":"Epoch","type":"bytes"}]},
+	{"type":"event","name":"` + EventGroupVoted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"Name","type":"string"},{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Hash","type":"bytes"},{"indexed":false,"internalType":"uint64","name":"VotedNumber","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"GroupSize","type":"uint64"}]},
+	{"type":"event","name":"` + EventGroupEpochChanged + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"Name","type":"string"},{"indexed":false,"internalType":"bytes","name":"Epoch","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"NextEpoch","type":"bytes"}]},
+	{"type":"event","name":"` + EventGroupRuleChanged + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"Name","type":"string"},{"indexed":false,"internalType":"uint64","name":"Numerator","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"Denominator","type":"uint64"},{"indexed":false,"internalType":"bool","name":"Strict","type":"bool"},{"indexed":false,"internalType":"uint64","name":"Threshold","type":"uint64"}]},
+	{"type":"event","name":"` + EventEpochChangeSigned + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"EpochID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Validator","type":"address"},{"indexed":false,"internalType":"uint64","name":"SignedNumber","type":"uint64"}]}
 ]`
 
 func InitABI() {
@@ -210,6 +311,38 @@ func (m *MethodNextEpochOutput) Decode(payload []byte) error {
 	return rlp.DecodeBytes(data.Epoch, &m.Epoch)
 }
 
+type MethodProposalsInput struct {
+	EpochID uint64
+}
+
+func (m *MethodProposalsInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodProposals, m.EpochID)
+}
+func (m *MethodProposalsInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodProposals, m, payload)
+}
+
+type MethodProposalsOutput struct {
+	Proposals []*ProposalDisposition
+}
+
+func (m *MethodProposalsOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Proposals)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodProposals, enc)
+}
+func (m *MethodProposalsOutput) Decode(payload []byte) error {
+	var data struct {
+		Proposals []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodProposals, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Proposals, &m.Proposals)
+}
+
 type MethodProofOutput struct {
 	Hash common.Hash
 }
@@ -226,6 +359,772 @@ func (m *MethodProofOutput) Decode(payload []byte) error {
 	return nil
 }
 
+type MethodPeersLimitOutput struct {
+	Target    uint64
+	MaxChange uint64
+}
+
+func (m *MethodPeersLimitOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodPeersLimit, m.Target, m.MaxChange)
+}
+func (m *MethodPeersLimitOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodPeersLimit, m, payload)
+}
+
+type MethodSetPeersLimitInput struct {
+	Target    uint64
+	MaxChange uint64
+}
+
+func (m *MethodSetPeersLimitInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetPeersLimit, m.Target, m.MaxChange)
+}
+func (m *MethodSetPeersLimitInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSetPeersLimit, m, payload)
+}
+
+type MethodSetPeersLimitOutput struct {
+	Success bool
+}
+
+func (m *MethodSetPeersLimitOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSetPeersLimit, m.Success)
+}
+func (m *MethodSetPeersLimitOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSetPeersLimit, m, payload)
+}
+
+type MethodQuorumRuleOutput struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}
+
+func (m *MethodQuorumRuleOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodQuorumRule, m.Numerator, m.Denominator, m.Strict, m.Threshold)
+}
+func (m *MethodQuorumRuleOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodQuorumRule, m, payload)
+}
+
+type MethodSetQuorumRuleInput struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}
+
+func (m *MethodSetQuorumRuleInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetQuorumRule, m.Numerator, m.Denominator, m.Strict, m.Threshold)
+}
+func (m *MethodSetQuorumRuleInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSetQuorumRule, m, payload)
+}
+
+type MethodSetQuorumRuleOutput struct {
+	Success bool
+}
+
+func (m *MethodSetQuorumRuleOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSetQuorumRule, m.Success)
+}
+func (m *MethodSetQuorumRuleOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSetQuorumRule, m, payload)
+}
+
+type MethodSeedOutput struct {
+	Seed common.Hash
+}
+
+func (m *MethodSeedOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSeed, m.Seed.Bytes())
+}
+func (m *MethodSeedOutput) Decode(payload []byte) error {
+	var data struct {
+		Seed []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodSeed, &data, payload); err != nil {
+		return err
+	}
+	m.Seed = common.BytesToHash(data.Seed)
+	return nil
+}
+
+type MethodEpochSeedInput struct {
+	EpochID uint64
+}
+
+func (m *MethodEpochSeedInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodEpochSeed, m.EpochID)
+}
+func (m *MethodEpochSeedInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodEpochSeed, m, payload)
+}
+
+type MethodEpochSeedOutput struct {
+	Seed common.Hash
+}
+
+func (m *MethodEpochSeedOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodEpochSeed, m.Seed.Bytes())
+}
+func (m *MethodEpochSeedOutput) Decode(payload []byte) error {
+	var data struct {
+		Seed []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodEpochSeed, &data, payload); err != nil {
+		return err
+	}
+	m.Seed = common.BytesToHash(data.Seed)
+	return nil
+}
+
+type MethodRegisterVrfKeyInput struct {
+	PubKey []byte
+	Output []byte
+	Proof  []byte
+}
+
+func (m *MethodRegisterVrfKeyInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRegisterVrfKey, m.PubKey, m.Output, m.Proof)
+}
+func (m *MethodRegisterVrfKeyInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodRegisterVrfKey, m, payload)
+}
+
+type MethodRegisterVrfKeyOutput struct {
+	Success bool
+}
+
+func (m *MethodRegisterVrfKeyOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodRegisterVrfKey, m.Success)
+}
+func (m *MethodRegisterVrfKeyOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodRegisterVrfKey, m, payload)
+}
+
+type MethodSubmitVrfInput struct {
+	EpochID uint64
+	Output  []byte
+	Proof   []byte
+}
+
+func (m *MethodSubmitVrfInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSubmitVrf, m.EpochID, m.Output, m.Proof)
+}
+func (m *MethodSubmitVrfInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSubmitVrf, m, payload)
+}
+
+type MethodSubmitVrfOutput struct {
+	Success bool
+}
+
+func (m *MethodSubmitVrfOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSubmitVrf, m.Success)
+}
+func (m *MethodSubmitVrfOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSubmitVrf, m, payload)
+}
+
+type MethodVrfKeyInput struct {
+	Validator common.Address
+}
+
+func (m *MethodVrfKeyInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodVrfKey, m.Validator)
+}
+func (m *MethodVrfKeyInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodVrfKey, m, payload)
+}
+
+type MethodVrfKeyOutput struct {
+	PubKey []byte
+}
+
+func (m *MethodVrfKeyOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodVrfKey, m.PubKey)
+}
+func (m *MethodVrfKeyOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodVrfKey, m, payload)
+}
+
+type MethodVrfOutputInput struct {
+	EpochID   uint64
+	Validator common.Address
+}
+
+func (m *MethodVrfOutputInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodVrfOutput, m.EpochID, m.Validator)
+}
+func (m *MethodVrfOutputInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodVrfOutput, m, payload)
+}
+
+type MethodVrfOutputOutput struct {
+	Output []byte
+}
+
+func (m *MethodVrfOutputOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodVrfOutput, m.Output)
+}
+func (m *MethodVrfOutputOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodVrfOutput, m, payload)
+}
+
+type MethodPayoutsInput struct {
+	Validator  common.Address
+	StartEpoch uint64
+	EndEpoch   uint64
+}
+
+func (m *MethodPayoutsInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodPayouts, m.Validator, m.StartEpoch, m.EndEpoch)
+}
+func (m *MethodPayoutsInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodPayouts, m, payload)
+}
+
+type MethodPayoutsOutput struct {
+	Payouts []*PayoutStatement
+}
+
+func (m *MethodPayoutsOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Payouts)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodPayouts, enc)
+}
+func (m *MethodPayoutsOutput) Decode(payload []byte) error {
+	var data struct {
+		Payouts []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodPayouts, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Payouts, &m.Payouts)
+}
+
+type MethodFeeSplitOutput struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+}
+
+func (m *MethodFeeSplitOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodFeeSplit, m.BurnRate, m.TreasuryRate, m.Treasury)
+}
+func (m *MethodFeeSplitOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodFeeSplit, m, payload)
+}
+
+type MethodSetFeeSplitInput struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+}
+
+func (m *MethodSetFeeSplitInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetFeeSplit, m.BurnRate, m.TreasuryRate, m.Treasury)
+}
+func (m *MethodSetFeeSplitInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSetFeeSplit, m, payload)
+}
+
+type MethodSetFeeSplitOutput struct {
+	Success bool
+}
+
+func (m *MethodSetFeeSplitOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSetFeeSplit, m.Success)
+}
+func (m *MethodSetFeeSplitOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSetFeeSplit, m, payload)
+}
+
+type MethodProposeWithActionsInput struct {
+	StartHeight uint64
+	Peers       *Peers
+	Actions     [][]byte
+}
+
+func (m *MethodProposeWithActionsInput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Peers)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackMethod(ABI, MethodProposeWithActions, m.StartHeight, enc, m.Actions)
+}
+func (m *MethodProposeWithActionsInput) Decode(payload []byte) error {
+	var data struct {
+		StartHeight uint64
+		Peers       []byte
+		Actions     [][]byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodProposeWithActions, &data, payload); err != nil {
+		return err
+	}
+	m.StartHeight = data.StartHeight
+	m.Actions = data.Actions
+	return rlp.DecodeBytes(data.Peers, &m.Peers)
+}
+
+type MethodProposeWithActionsOutput struct {
+	Success bool
+}
+
+func (m *MethodProposeWithActionsOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodProposeWithActions, m.Success)
+}
+func (m *MethodProposeWithActionsOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodProposeWithActions, m, payload)
+}
+
+type MethodProposalActionsInput struct {
+	Hash common.Hash
+}
+
+func (m *MethodProposalActionsInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodProposalActions, m.Hash.Bytes())
+}
+func (m *MethodProposalActionsInput) Decode(payload []byte) error {
+	var data struct {
+		Hash []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodProposalActions, &data, payload); err != nil {
+		return err
+	}
+	m.Hash = common.BytesToHash(data.Hash)
+	return nil
+}
+
+type MethodProposalActionsOutput struct {
+	Actions [][]byte
+}
+
+func (m *MethodProposalActionsOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodProposalActions, m.Actions)
+}
+func (m *MethodProposalActionsOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodProposalActions, m, payload)
+}
+
+type MethodSealVotingInput struct {
+	EpochID      uint64
+	CommitPeriod uint64
+	RevealPeriod uint64
+}
+
+func (m *MethodSealVotingInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSealVoting, m.EpochID, m.CommitPeriod, m.RevealPeriod)
+}
+func (m *MethodSealVotingInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSealVoting, m, payload)
+}
+
+type MethodVotingSealInput struct {
+	EpochID uint64
+}
+
+func (m *MethodVotingSealInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodVotingSeal, m.EpochID)
+}
+func (m *MethodVotingSealInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodVotingSeal, m, payload)
+}
+
+type MethodVotingSealOutput struct {
+	CommitEnd uint64
+	RevealEnd uint64
+	Tallied   bool
+}
+
+func (m *MethodVotingSealOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodVotingSeal, m.CommitEnd, m.RevealEnd, m.Tallied)
+}
+func (m *MethodVotingSealOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodVotingSeal, m, payload)
+}
+
+type MethodCommitVoteInput struct {
+	EpochID    uint64
+	Commitment common.Hash
+}
+
+func (m *MethodCommitVoteInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodCommitVote, m.EpochID, m.Commitment.Bytes())
+}
+func (m *MethodCommitVoteInput) Decode(payload []byte) error {
+	var data struct {
+		EpochID    uint64
+		Commitment []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodCommitVote, &data, payload); err != nil {
+		return err
+	}
+	m.EpochID = data.EpochID
+	m.Commitment = common.BytesToHash(data.Commitment)
+	return nil
+}
+
+type MethodRevealVoteInput struct {
+	EpochID uint64
+	Hash    common.Hash
+	Salt    []byte
+}
+
+func (m *MethodRevealVoteInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRevealVote, m.EpochID, m.Hash.Bytes(), m.Salt)
+}
+func (m *MethodRevealVoteInput) Decode(payload []byte) error {
+	var data struct {
+		EpochID uint64
+		Hash    []byte
+		Salt    []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodRevealVote, &data, payload); err != nil {
+		return err
+	}
+	m.EpochID, m.Hash, m.Salt = data.EpochID, common.BytesToHash(data.Hash), data.Salt
+	return nil
+}
+
+type MethodEjectInput struct {
+	Validator common.Address
+	Evidence  *EjectionEvidence
+}
+
+func (m *MethodEjectInput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackMethod(ABI, MethodEject, m.Validator, enc)
+}
+func (m *MethodEjectInput) Decode(payload []byte) error {
+	var data struct {
+		Validator common.Address
+		Evidence  []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodEject, &data, payload); err != nil {
+		return err
+	}
+	m.Validator = data.Validator
+	return rlp.DecodeBytes(data.Evidence, &m.Evidence)
+}
+
+type MethodMisconductInput struct {
+	Validator  common.Address
+	StartEpoch uint64
+	EndEpoch   uint64
+}
+
+func (m *MethodMisconductInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodMisconduct, m.Validator, m.StartEpoch, m.EndEpoch)
+}
+func (m *MethodMisconductInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodMisconduct, m, payload)
+}
+
+type MethodMisconductOutput struct {
+	Reports []*MisconductReport
+}
+
+func (m *MethodMisconductOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Reports)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodMisconduct, enc)
+}
+func (m *MethodMisconductOutput) Decode(payload []byte) error {
+	var data struct {
+		Reports []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodMisconduct, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Reports, &m.Reports)
+}
+
+type MethodSweepExpiredInput struct {
+	Height uint64
+}
+
+func (m *MethodSweepExpiredInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSweepExpired, m.Height)
+}
+func (m *MethodSweepExpiredInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSweepExpired, m, payload)
+}
+
+type MethodProposeGroupInput struct {
+	Name        string
+	StartHeight uint64
+	Peers       *Peers
+}
+
+func (m *MethodProposeGroupInput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Peers)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackMethod(ABI, MethodProposeGroup, m.Name, m.StartHeight, enc)
+}
+func (m *MethodProposeGroupInput) Decode(payload []byte) error {
+	var data struct {
+		Name        string
+		StartHeight uint64
+		Peers       []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodProposeGroup, &data, payload); err != nil {
+		return err
+	}
+	m.Name, m.StartHeight = data.Name, data.StartHeight
+	return rlp.DecodeBytes(data.Peers, &m.Peers)
+}
+
+type MethodVoteGroupInput struct {
+	Name    string
+	EpochID uint64
+	Hash    common.Hash
+}
+
+func (m *MethodVoteGroupInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodVoteGroup, m.Name, m.EpochID, m.Hash.Bytes())
+}
+func (m *MethodVoteGroupInput) Decode(payload []byte) error {
+	var data struct {
+		Name    string
+		EpochID uint64
+		Hash    []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodVoteGroup, &data, payload); err != nil {
+		return err
+	}
+	m.Name, m.EpochID, m.Hash = data.Name, data.EpochID, common.BytesToHash(data.Hash)
+	return nil
+}
+
+type MethodGroupEpochInput struct {
+	Name string
+}
+
+func (m *MethodGroupEpochInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGroupEpoch, m.Name)
+}
+func (m *MethodGroupEpochInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodGroupEpoch, m, payload)
+}
+
+type MethodGroupEpochOutput struct {
+	Epoch *EpochInfo
+}
+
+func (m *MethodGroupEpochOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodGroupEpoch, enc)
+}
+func (m *MethodGroupEpochOutput) Decode(payload []byte) error {
+	var data struct {
+		Epoch []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGroupEpoch, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Epoch, &m.Epoch)
+}
+
+type MethodGroupProofInput struct {
+	Name    string
+	EpochID uint64
+}
+
+func (m *MethodGroupProofInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGroupProof, m.Name, m.EpochID)
+}
+func (m *MethodGroupProofInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodGroupProof, m, payload)
+}
+
+type MethodGroupProofOutput struct {
+	Hash common.Hash
+}
+
+func (m *MethodGroupProofOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodGroupProof, m.Hash.Bytes())
+}
+func (m *MethodGroupProofOutput) Decode(payload []byte) error {
+	var data struct {
+		Hash []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGroupProof, &data, payload); err != nil {
+		return err
+	}
+	m.Hash = common.BytesToHash(data.Hash)
+	return nil
+}
+
+type MethodGroupsOutput struct {
+	Names []string
+}
+
+func (m *MethodGroupsOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodGroups, m.Names)
+}
+func (m *MethodGroupsOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodGroups, m, payload)
+}
+
+type MethodGroupQuorumRuleInput struct {
+	Name string
+}
+
+func (m *MethodGroupQuorumRuleInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGroupQuorumRule, m.Name)
+}
+func (m *MethodGroupQuorumRuleInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodGroupQuorumRule, m, payload)
+}
+
+type MethodGroupQuorumRuleOutput struct {
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}
+
+func (m *MethodGroupQuorumRuleOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodGroupQuorumRule, m.Numerator, m.Denominator, m.Strict, m.Threshold)
+}
+func (m *MethodGroupQuorumRuleOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodGroupQuorumRule, m, payload)
+}
+
+type MethodSetGroupQuorumRuleInput struct {
+	Name        string
+	Numerator   uint64
+	Denominator uint64
+	Strict      bool
+	Threshold   uint64
+}
+
+func (m *MethodSetGroupQuorumRuleInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetGroupQuorumRule, m.Name, m.Numerator, m.Denominator, m.Strict, m.Threshold)
+}
+func (m *MethodSetGroupQuorumRuleInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSetGroupQuorumRule, m, payload)
+}
+
+type MethodSignEpochChangeInput struct {
+	EpochID   uint64
+	Signature []byte
+}
+
+func (m *MethodSignEpochChangeInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSignEpochChange, m.EpochID, m.Signature)
+}
+func (m *MethodSignEpochChangeInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSignEpochChange, m, payload)
+}
+
+type MethodEpochChangeSignsInput struct {
+	EpochID uint64
+}
+
+func (m *MethodEpochChangeSignsInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodEpochChangeSigns, m.EpochID)
+}
+func (m *MethodEpochChangeSignsInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodEpochChangeSigns, m, payload)
+}
+
+type MethodEpochChangeSignsOutput struct {
+	Digest     common.Hash
+	Signatures [][]byte
+}
+
+func (m *MethodEpochChangeSignsOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodEpochChangeSigns, m.Digest.Bytes(), m.Signatures)
+}
+func (m *MethodEpochChangeSignsOutput) Decode(payload []byte) error {
+	var data struct {
+		Digest     []byte
+		Signatures [][]byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodEpochChangeSigns, &data, payload); err != nil {
+		return err
+	}
+	m.Digest, m.Signatures = common.BytesToHash(data.Digest), data.Signatures
+	return nil
+}
+
+type MethodEpochPeersInput struct {
+	Hash  common.Hash // current epoch if empty
+	Start uint64
+	Limit uint64
+}
+
+func (m *MethodEpochPeersInput) Encode() ([]byte, error) {
+	hash := m.Hash.Bytes()
+	if m.Hash == common.EmptyHash {
+		hash = []byte{}
+	}
+	return utils.PackMethod(ABI, MethodEpochPeers, hash, m.Start, m.Limit)
+}
+func (m *MethodEpochPeersInput) Decode(payload []byte) error {
+	var data struct {
+		Hash  []byte
+		Start uint64
+		Limit uint64
+	}
+	if err := utils.UnpackMethod(ABI, MethodEpochPeers, &data, payload); err != nil {
+		return err
+	}
+	m.Hash, m.Start, m.Limit = common.BytesToHash(data.Hash), data.Start, data.Limit
+	return nil
+}
+
+type MethodEpochPeersOutput struct {
+	Total uint64
+	Peers *Peers
+}
+
+func (m *MethodEpochPeersOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Peers)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodEpochPeers, m.Total, enc)
+}
+func (m *MethodEpochPeersOutput) Decode(payload []byte) error {
+	var data struct {
+		Total uint64
+		Peers []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodEpochPeers, &data, payload); err != nil {
+		return err
+	}
+	m.Total = data.Total
+	return rlp.DecodeBytes(data.Peers, &m.Peers)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
 func emitEventProposed(s *native.NativeContract, epoch *EpochInfo) error {
 	enc, err := rlp.EncodeToBytes(epoch)
 	if err != nil {
@@ -234,6 +1133,10 @@ func emitEventProposed(s *native.NativeContract, epoch *EpochInfo) error {
 	return s.AddNotify(ABI, []string{EventPropose}, enc)
 }
 
+func emitProposalRejected(s *native.NativeContract, epochID uint64, hash common.Hash, votes int, winner common.Hash) error {
+	return s.AddNotify(ABI, []string{EventProposalRejected}, epochID, hash.Bytes(), uint64(votes), winner.Bytes())
+}
+
 func emitEventVoted(s *native.NativeContract, epochID uint64, hash common.Hash, curVotedNum int, groupSize int) error {
 	return s.AddNotify(ABI, []string{EventVote}, epochID, hash.Bytes(), uint64(curVotedNum), uint64(groupSize))
 }
@@ -253,3 +1156,84 @@ func emitEpochChange(s *native.NativeContract, curEpoch, nextEpoch *EpochInfo) e
 func emitConsensusSign(s *native.NativeContract, sign *ConsensusSign, signer common.Address, num int) error {
 	return s.AddNotify(ABI, []string{EventConsensusSigned}, sign.Method, sign.Input, signer, uint64(num))
 }
+
+func emitPeersLimitChanged(s *native.NativeContract, limit *PeersLimit) error {
+	return s.AddNotify(ABI, []string{EventPeersLimitChanged}, limit.Target, limit.MaxChange)
+}
+
+func emitQuorumRuleChanged(s *native.NativeContract, rule *QuorumRule) error {
+	return s.AddNotify(ABI, []string{EventQuorumRuleChanged}, rule.Numerator, rule.Denominator, rule.Strict, rule.Threshold)
+}
+
+func emitFeeSplitChanged(s *native.NativeContract, split *FeeSplit) error {
+	return s.AddNotify(ABI, []string{EventFeeSplitChanged}, split.BurnRate, split.TreasuryRate, split.Treasury)
+}
+
+func emitVotingSealed(s *native.NativeContract, seal *VotingSeal) error {
+	return s.AddNotify(ABI, []string{EventVotingSealed}, seal.EpochID, seal.CommitEnd, seal.RevealEnd)
+}
+
+func emitVoteCommitted(s *native.NativeContract, epochID uint64, voter common.Address) error {
+	return s.AddNotify(ABI, []string{EventVoteCommitted}, epochID, voter)
+}
+
+func emitVotingTallied(s *native.NativeContract, epochID uint64, winner common.Hash) error {
+	return s.AddNotify(ABI, []string{EventVotingTallied}, epochID, winner.Bytes())
+}
+
+func emitValidatorEjected(s *native.NativeContract, epoch *EpochInfo, validator common.Address) error {
+	return s.AddNotify(ABI, []string{EventValidatorEjected}, epoch.ID, validator, epoch.StartHeight)
+}
+
+func emitMisconductRecorded(s *native.NativeContract, epochID uint64, validator common.Address, kind MisconductKind) error {
+	return s.AddNotify(ABI, []string{EventMisconduct}, epochID, validator, uint8(kind))
+}
+
+func emitExpirySwept(s *native.NativeContract, height uint64, entries, reclaimed int) error {
+	return s.AddNotify(ABI, []string{EventExpirySwept}, height, uint64(entries), uint64(reclaimed))
+}
+
+func emitActionsExecuted(s *native.NativeContract, epochID uint64, proposal common.Hash, actions int) error {
+	return s.AddNotify(ABI, []string{EventActionsExecuted}, epochID, proposal.Bytes(), uint64(actions))
+}
+
+func emitVrfSubmitted(s *native.NativeContract, epochID uint64, validator common.Address, output []byte) error {
+	return s.AddNotify(ABI, []string{EventVrfSubmitted}, epochID, validator, output)
+}
+
+func emitGroupProposed(s *native.NativeContract, name string, epoch *EpochInfo) error {
+	enc, err := rlp.EncodeToBytes(epoch)
+	if err != nil {
+		return err
+	}
+	return s.AddNotify(ABI, []string{EventGroupProposed}, name, enc)
+}
+
+func emitGroupVoted(s *native.NativeContract, name string, epochID uint64, hash common.Hash, curVotedNum int, groupSize int) error {
+	return s.AddNotify(ABI, []string{EventGroupVoted}, name, epochID, hash.Bytes(), uint64(curVotedNum), uint64(groupSize))
+}
+
+// emitGroupEpochChange emits the epoch change of the group, `Epoch` is empty if the group is created.
+func emitGroupEpochChange(s *native.NativeContract, name string, curEpoch, nextEpoch *EpochInfo) error {
+	var curEnc []byte
+	if curEpoch != nil {
+		enc, err := rlp.EncodeToBytes(curEpoch)
+		if err != nil {
+			return err
+		}
+		curEnc = enc
+	}
+	nextEnc, err := rlp.EncodeToBytes(nextEpoch)
+	if err != nil {
+		return err
+	}
+	return s.AddNotify(ABI, []string{EventGroupEpochChanged}, name, curEnc, nextEnc)
+}
+
+func emitGroupQuorumRuleChanged(s *native.NativeContract, name string, rule *QuorumRule) error {
+	return s.AddNotify(ABI, []string{EventGroupRuleChanged}, name, rule.Numerator, rule.Denominator, rule.Strict, rule.Threshold)
+}
+
+func emitEpochChangeSigned(s *native.NativeContract, epochID uint64, validator common.Address, signedNum int) error {
+	return s.AddNotify(ABI, []string{EventEpochChangeSigned}, epochID, validator, uint64(signedNum))
+}
@@ -163,3 +163,47 @@ func TestABIMethodProofOutput(t *testing.T) {
 
 	assert.Equal(t, expect, got)
 }
+
+func TestABIMethodRegisterVrfKeyInput(t *testing.T) {
+	expect := &MethodRegisterVrfKeyInput{
+		PubKey: generateTestHash(1).Bytes(),
+		Output: generateTestHash(2).Bytes(),
+		Proof:  generateTestHash(3).Bytes(),
+	}
+
+	enc, err := expect.Encode()
+	assert.NoError(t, err)
+
+	got := new(MethodRegisterVrfKeyInput)
+	assert.NoError(t, got.Decode(enc))
+
+	assert.Equal(t, expect, got)
+}
+
+func TestABIMethodSubmitVrfInput(t *testing.T) {
+	expect := &MethodSubmitVrfInput{
+		EpochID: 12,
+		Output:  generateTestHash(2).Bytes(),
+		Proof:   generateTestHash(3).Bytes(),
+	}
+
+	enc, err := expect.Encode()
+	assert.NoError(t, err)
+
+	got := new(MethodSubmitVrfInput)
+	assert.NoError(t, got.Decode(enc))
+
+	assert.Equal(t, expect, got)
+}
+
+func TestABIMethodVrfOutputInput(t *testing.T) {
+	expect := &MethodVrfOutputInput{EpochID: 12, Validator: generateTestAddress(1)}
+
+	enc, err := expect.Encode()
+	assert.NoError(t, err)
+
+	got := new(MethodVrfOutputInput)
+	assert.NoError(t, got.Decode(enc))
+
+	assert.Equal(t, expect, got)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// MaxProposalActions is the max number of actions attached to an epoch proposal.
+const MaxProposalActions = 8
+
+// actionChanges are the parameter changes allowed to be attached to epoch proposals, the change is
+// validated against the current parameters while proposing, and applied in the same transaction as
+// the vote which makes the proposal pass, so that the parameters change along with the validator set.
+var actionChanges = map[string]func(s *native.NativeContract, payload []byte, apply bool) error{
+	MethodSetPeersLimit: func(s *native.NativeContract, payload []byte, apply bool) error {
+		limit, err := nextPeersLimit(s, payload)
+		if err != nil || !apply {
+			return err
+		}
+		return changePeersLimit(s, limit)
+	},
+	MethodSetQuorumRule: func(s *native.NativeContract, payload []byte, apply bool) error {
+		rule, err := nextQuorumRule(s, payload)
+		if err != nil || !apply {
+			return err
+		}
+		return changeQuorumRule(s, rule)
+	},
+	MethodSetFeeSplit: func(s *native.NativeContract, payload []byte, apply bool) error {
+		split, err := nextFeeSplit(s, payload)
+		if err != nil || !apply {
+			return err
+		}
+		return changeFeeSplit(s, split)
+	},
+}
+
+// ProposeWithActions participant propose new `epoch change` schema along with the calldata of
+// node manager parameter changes, e.g: `setPeersLimit`, `setQuorumRule` and `setFeeSplit`. the
+// changes are executed atomically once the proposal passed, rather than signed by validators
+// separately. it takes effect since governance v2.
+func ProposeWithActions(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	return Propose(s)
+}
+
+func ProposalActions(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodProposalActionsInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("proposalActions", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	actions, err := getActions(s, input.Hash)
+	if err != nil {
+		logger.Trace("proposalActions", "get actions failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	return (&MethodProposalActionsOutput{Actions: actions}).Encode()
+}
+
+// decodeProposeInput decodes the payload of both `propose` and `proposeWithActions`.
+func decodeProposeInput(payload []byte) (*MethodProposeWithActionsInput, error) {
+	if len(payload) >= 4 && bytes.Equal(payload[:4], ABI.Methods[MethodProposeWithActions].ID) {
+		input := new(MethodProposeWithActionsInput)
+		if err := input.Decode(payload); err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	input := new(MethodProposeInput)
+	if err := input.Decode(payload); err != nil {
+		return nil, err
+	}
+	return &MethodProposeWithActionsInput{StartHeight: input.StartHeight, Peers: input.Peers}, nil
+}
+
+// checkActions validates the actions against the current parameters, each action is checked
+// independently.
+func checkActions(s *native.NativeContract, actions [][]byte) error {
+	if len(actions) > MaxProposalActions {
+		return ErrInvalidAction
+	}
+	for _, payload := range actions {
+		change, err := findActionChange(payload)
+		if err != nil {
+			return err
+		}
+		if err := change(s, payload, false); err != nil {
+			logger.Trace("propose", "check action failed", err)
+			return ErrInvalidAction
+		}
+	}
+	return nil
+}
+
+// executeActions applies the actions attached to the passed proposal in order, the vote fails if
+// any of them failed, so that the epoch and the parameters change together or not at all.
+func executeActions(s *native.NativeContract, epoch *EpochInfo) error {
+	actions, err := getActions(s, epoch.Hash())
+	if err != nil {
+		logger.Trace("vote", "get actions failed", err)
+		return ErrStorage
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+	for _, payload := range actions {
+		change, err := findActionChange(payload)
+		if err != nil {
+			return err
+		}
+		if err := change(s, payload, true); err != nil {
+			logger.Trace("vote", "execute action failed", err)
+			return err
+		}
+	}
+	if err := emitActionsExecuted(s, epoch.ID, epoch.Hash(), len(actions)); err != nil {
+		logger.Trace("vote", "emit actions executed log failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+func findActionChange(payload []byte) (func(s *native.NativeContract, payload []byte, apply bool) error, error) {
+	if len(payload) < 4 {
+		return nil, ErrInvalidAction
+	}
+	method, err := ABI.MethodById(payload[:4])
+	if err != nil {
+		return nil, ErrInvalidAction
+	}
+	change, ok := actionChanges[method.Name]
+	if !ok {
+		return nil, ErrInvalidAction
+	}
+	return change, nil
+}
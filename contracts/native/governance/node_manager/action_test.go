@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposeWithActions(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+	call := func(caller common.Address, height int, payload []byte) ([]byte, error) {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		ret, _, err := ctx.ContractRef().NativeCall(caller, this, payload)
+		return ret, err
+	}
+	members := testGenesisEpoch.MemberList()
+	peers := testGenesisEpoch.Peers.Copy()
+	peers.List = append(peers.List, generateTestPeer())
+	sort.Sort(peers)
+	epoch := &EpochInfo{ID: StartEpoch + 1, Peers: peers, StartHeight: 10 + MinEpochValidPeriod}
+
+	feeSplit, err := (&MethodSetFeeSplitInput{BurnRate: 1000}).Encode()
+	assert.NoError(t, err)
+	peersLimit, err := (&MethodSetPeersLimitInput{MaxChange: 2}).Encode()
+	assert.NoError(t, err)
+	vote, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+	assert.NoError(t, err)
+	invalidSplit, err := (&MethodSetFeeSplitInput{TreasuryRate: 1000}).Encode()
+	assert.NoError(t, err)
+	propose := func(height int, actions ...[]byte) error {
+		payload, err := (&MethodProposeWithActionsInput{StartHeight: epoch.StartHeight, Peers: epoch.Peers, Actions: actions}).Encode()
+		assert.NoError(t, err)
+		_, err = call(members[0], height, payload)
+		return err
+	}
+
+	// only the node manager parameter changes are allowed since governance v2
+	assert.Equal(t, ErrGovV2NotActivated, propose(9, feeSplit))
+	assert.Equal(t, ErrInvalidAction, propose(10, vote))
+	assert.Equal(t, ErrInvalidAction, propose(10, invalidSplit))
+	assert.Equal(t, ErrInvalidAction, propose(10, []byte{1, 2}))
+	actions := make([][]byte, MaxProposalActions+1)
+	for i := range actions {
+		actions[i] = feeSplit
+	}
+	assert.Equal(t, ErrInvalidAction, propose(10, actions...))
+	assert.NoError(t, propose(10, feeSplit, peersLimit))
+
+	payload, err := (&MethodProposalActionsInput{Hash: epoch.Hash()}).Encode()
+	assert.NoError(t, err)
+	enc, err := call(members[0], 10, payload)
+	assert.NoError(t, err)
+	output := new(MethodProposalActionsOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, [][]byte{feeSplit, peersLimit}, output.Actions)
+
+	// the actions are executed along with the vote which makes the proposal pass, the proposer
+	// voted to its proposal already.
+	quorum := QuorumSize(generateNativeContract(members[0], 11), testGenesisEpoch)
+	for i := 1; i < quorum; i++ {
+		split, err := getFeeSplit(testEmptyCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, &FeeSplit{}, split)
+		_, err = call(members[i], 11, vote)
+		assert.NoError(t, err)
+	}
+
+	cur, err := GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, epoch.Hash(), cur.Hash())
+	split, err := getFeeSplit(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, &FeeSplit{BurnRate: 1000, Nonce: 1}, split)
+	limit, err := getPeersLimit(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, &PeersLimit{MaxChange: 2, Nonce: 1}, limit)
+}
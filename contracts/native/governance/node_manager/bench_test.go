@@ -0,0 +1,101 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package node_manager
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// go test -run ^$ -bench . -benchmem github.com/ethereum/go-ethereum/contracts/native/governance/node_manager
+
+const benchProposeBlockNum = 3
+
+// benchmarkProposal returns the proposal of genesis validators with a new member.
+func benchmarkProposal(b *testing.B) (*EpochInfo, []byte) {
+	peers := testGenesisEpoch.Peers.Copy()
+	peers.List = append(peers.List, generateTestPeer())
+	sort.Sort(peers)
+	epoch := &EpochInfo{ID: testGenesisEpoch.ID + 1, Peers: peers, StartHeight: MinEpochValidPeriod + benchProposeBlockNum + 10}
+	payload, err := (&MethodProposeInput{StartHeight: epoch.StartHeight, Peers: peers}).Encode()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return epoch, payload
+}
+
+func benchmarkPropose(b *testing.B, payload []byte) {
+	proposer := testGenesisEpoch.Peers.List[0].Address
+	ref := generateNativeContractRef(proposer, benchProposeBlockNum)
+	if _, _, err := ref.NativeCall(proposer, this, payload); err != nil {
+		b.Fatal(err)
+	}
+}
+
+func BenchmarkPropose(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		resetTestContext()
+		_, payload := benchmarkProposal(b)
+		b.StartTimer()
+
+		benchmarkPropose(b, payload)
+	}
+}
+
+func BenchmarkVote(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		resetTestContext()
+		epoch, payload := benchmarkProposal(b)
+		benchmarkPropose(b, payload)
+		vote, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		// vote until the proposal passed
+		for _, peer := range testGenesisEpoch.Peers.List[:testGenesisEpoch.QuorumSize()] {
+			ref := generateNativeContractRef(peer.Address, benchProposeBlockNum)
+			if _, _, err := ref.NativeCall(peer.Address, this, vote); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkCheckConsensusSigns(b *testing.B) {
+	resetTestContext()
+	quorum := testGenesisEpoch.QuorumSize()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input := utils.GetUint64Bytes(uint64(i))
+		for _, peer := range testGenesisEpoch.Peers.List[:quorum] {
+			ref := generateNativeContractRef(peer.Address, benchProposeBlockNum)
+			ref.PushContext(&native.Context{Caller: peer.Address, ContractAddress: common.EmptyAddress})
+			if _, err := CheckConsensusSigns(native.NewNativeContract(testStateDB, ref), "bench", input, peer.Address); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
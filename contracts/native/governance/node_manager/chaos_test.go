@@ -0,0 +1,271 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// chaosVote is a vote message in flight, it's applied at the delivery height unless the
+// voter crashed in the meantime.
+type chaosVote struct {
+	voter   common.Address
+	deliver uint64
+	input   *MethodVoteInput
+}
+
+// chaosNet drives the proposals and votes of validators through the node manager with
+// injected faults: the votes of a validator may be delayed by some blocks, and a validator
+// may crash at a height, after which all of its messages are dropped until it recovers.
+// the results of delivered votes are kept by voter, so that the tests can assert which
+// liveness recovery path is taken.
+type chaosNet struct {
+	t       *testing.T
+	height  uint64
+	delays  map[common.Address]uint64
+	crashes map[common.Address]uint64
+	pending []*chaosVote
+	results map[common.Address]error
+}
+
+func newChaosNet(t *testing.T, height uint64) *chaosNet {
+	resetTestContext()
+	return &chaosNet{
+		t:       t,
+		height:  height,
+		delays:  make(map[common.Address]uint64),
+		crashes: make(map[common.Address]uint64),
+		results: make(map[common.Address]error),
+	}
+}
+
+// delayVotes delays every vote of the validator by the number of blocks.
+func (n *chaosNet) delayVotes(validator common.Address, blocks uint64) {
+	n.delays[validator] = blocks
+}
+
+// crashAt takes the validator offline from the height on.
+func (n *chaosNet) crashAt(validator common.Address, height uint64) {
+	n.crashes[validator] = height
+}
+
+// restart brings the validator back, the messages dropped during the crash are lost.
+func (n *chaosNet) restart(validator common.Address) {
+	delete(n.crashes, validator)
+}
+
+func (n *chaosNet) crashed(validator common.Address) bool {
+	height, ok := n.crashes[validator]
+	return ok && n.height >= height
+}
+
+// members returns the addresses of current epoch in order.
+func (n *chaosNet) members() []common.Address {
+	return n.epoch().MemberList()
+}
+
+func (n *chaosNet) epoch() *EpochInfo {
+	epoch, err := GetCurrentEpoch(testEmptyCtx)
+	if err != nil {
+		n.t.Fatalf("get current epoch failed: %v", err)
+	}
+	return epoch
+}
+
+// propose submits a proposal of the next epoch immediately, and returns the proposal hash.
+func (n *chaosNet) propose(proposer common.Address, startHeight uint64, peers *Peers) common.Hash {
+	peers = peers.Copy()
+	sort.Sort(peers)
+	payload, _ := (&MethodProposeInput{StartHeight: startHeight, Peers: peers}).Encode()
+	ctx := generateNativeContract(proposer, int(n.height))
+	if _, _, err := ctx.ContractRef().NativeCall(proposer, this, payload); err != nil {
+		n.t.Fatalf("propose at %d failed: %v", n.height, err)
+	}
+	return (&EpochInfo{ID: n.epoch().ID + 1, Peers: peers, StartHeight: startHeight, Proposer: proposer, Version: EpochHashV2}).Hash()
+}
+
+// vote sends the vote of validator, which is delivered after the delay of the validator.
+func (n *chaosNet) vote(voter common.Address, proposal common.Hash) {
+	if n.crashed(voter) {
+		return
+	}
+	n.pending = append(n.pending, &chaosVote{
+		voter:   voter,
+		deliver: n.height + n.delays[voter],
+		input:   &MethodVoteInput{EpochID: n.epoch().ID + 1, Hash: proposal},
+	})
+	n.deliver()
+}
+
+// advance moves the chain forward block by block, delivering the votes in flight.
+func (n *chaosNet) advance(blocks uint64) {
+	for i := uint64(0); i < blocks; i++ {
+		n.height++
+		n.deliver()
+	}
+}
+
+func (n *chaosNet) deliver() {
+	pending := n.pending[:0]
+	for _, v := range n.pending {
+		if v.deliver > n.height {
+			pending = append(pending, v)
+			continue
+		}
+		if n.crashed(v.voter) {
+			continue
+		}
+		payload, _ := v.input.Encode()
+		ctx := generateNativeContract(v.voter, int(n.height))
+		_, _, n.results[v.voter] = ctx.ContractRef().NativeCall(v.voter, this, payload)
+	}
+	n.pending = pending
+}
+
+// status returns the disposition of the proposal reported by the `proposals` method.
+func (n *chaosNet) status(epochID uint64, proposal common.Hash) ProposalStatusType {
+	payload, _ := (&MethodProposalsInput{EpochID: epochID}).Encode()
+	ctx := generateNativeContract(n.members()[0], int(n.height))
+	enc, _, err := ctx.ContractRef().NativeCall(n.members()[0], this, payload)
+	if err != nil {
+		n.t.Fatalf("query proposals failed: %v", err)
+	}
+	output := new(MethodProposalsOutput)
+	if err := output.Decode(enc); err != nil {
+		n.t.Fatalf("decode proposals failed: %v", err)
+	}
+	for _, v := range output.Proposals {
+		if v.Hash == proposal {
+			return v.Status
+		}
+	}
+	return ProposalStatusUnknown
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/governance/node_manager -run TestChaosDelayedVotesExpire
+func TestChaosDelayedVotesExpire(t *testing.T) {
+	net := newChaosNet(t, 10)
+	members := net.members()
+	start := net.height + MinEpochValidPeriod
+	proposal := net.propose(members[0], start, net.epoch().Peers)
+
+	// the vote of the last quorum member arrives after the vote deadline
+	net.delayVotes(members[2], MinEpochValidPeriod)
+	for _, v := range members[:3] {
+		net.vote(v, proposal)
+	}
+	net.advance(MinEpochValidPeriod)
+	assert.Equal(t, ErrVoteHeight, net.results[members[2]])
+	assert.Equal(t, ProposalStatusExpired, net.status(testGenesisEpoch.ID+1, proposal))
+	assert.Equal(t, testGenesisEpoch.ID, net.epoch().ID)
+
+	// fallback: a proposal with a later start height passes once the votes arrive in time,
+	// the voters of expired proposal re-vote and the expired one is rejected.
+	net.delayVotes(members[2], 1)
+	fallback := net.propose(members[1], net.height+MinEpochValidPeriod, net.epoch().Peers)
+	for _, v := range members[:3] {
+		net.vote(v, fallback)
+	}
+	net.advance(1)
+	assert.NoError(t, net.results[members[2]])
+	assert.Equal(t, fallback, net.epoch().Hash())
+	assert.Equal(t, ProposalStatusPassed, net.status(testGenesisEpoch.ID+1, fallback))
+	assert.Equal(t, ProposalStatusRejected, net.status(testGenesisEpoch.ID+1, proposal))
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/governance/node_manager -run TestChaosCrashAtStartHeight
+func TestChaosCrashAtStartHeight(t *testing.T) {
+	net := newChaosNet(t, 10)
+	members := net.members()
+	start := net.height + MinEpochValidPeriod
+	proposal := net.propose(members[0], start, net.epoch().Peers)
+	for _, v := range members[:3] {
+		net.vote(v, proposal)
+	}
+	assert.Equal(t, proposal, net.epoch().Hash())
+
+	// f validators crashed at the start height, the others still reach the quorum
+	net.crashAt(members[3], start)
+	net.advance(start - net.height)
+	proposal = net.propose(members[0], net.height+MinEpochValidPeriod, net.epoch().Peers)
+	for _, v := range members {
+		net.vote(v, proposal)
+	}
+	assert.Equal(t, proposal, net.epoch().Hash())
+
+	// more than f validators crashed, the proposal stalls and expires without epoch change
+	epochID := net.epoch().ID
+	net.crashAt(members[2], net.height)
+	stalled := net.propose(members[0], net.height+MinEpochValidPeriod, net.epoch().Peers)
+	for _, v := range members {
+		net.vote(v, stalled)
+	}
+	net.advance(MinEpochValidPeriod)
+	assert.Equal(t, epochID, net.epoch().ID)
+	assert.Equal(t, ProposalStatusExpired, net.status(epochID+1, stalled))
+
+	// the crashed validators recovered, a fallback proposal passes
+	net.restart(members[2])
+	net.restart(members[3])
+	fallback := net.propose(members[1], net.height+MinEpochValidPeriod, net.epoch().Peers)
+	for _, v := range members[1:] {
+		net.vote(v, fallback)
+	}
+	assert.Equal(t, epochID+1, net.epoch().ID)
+	assert.Equal(t, fallback, net.epoch().Hash())
+	assert.Equal(t, ProposalStatusRejected, net.status(epochID+1, stalled))
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/governance/node_manager -run TestChaosCompetingProposals
+func TestChaosCompetingProposals(t *testing.T) {
+	net := newChaosNet(t, 10)
+	members := net.members()
+	start := net.height + MinEpochValidPeriod
+	peers := net.epoch().Peers.Copy()
+	peers.List = append(peers.List, generateTestPeer())
+	first := net.propose(members[0], start, net.epoch().Peers)
+	second := net.propose(members[1], start, peers)
+
+	// the votes split and neither proposal reaches the quorum
+	net.vote(members[0], first)
+	net.vote(members[1], first)
+	net.vote(members[2], second)
+	net.vote(members[3], second)
+	net.advance(1)
+	assert.Equal(t, testGenesisEpoch.ID, net.epoch().ID)
+
+	// a delayed re-vote breaks the tie, the rival re-vote arrived after the epoch change is
+	// stale and rejected.
+	net.delayVotes(members[1], 2)
+	net.delayVotes(members[3], 3)
+	net.vote(members[1], second)
+	net.vote(members[3], first)
+	net.advance(1)
+	assert.Equal(t, testGenesisEpoch.ID, net.epoch().ID)
+	net.advance(1)
+	assert.NoError(t, net.results[members[1]])
+	assert.Equal(t, second, net.epoch().Hash())
+	assert.Equal(t, ProposalStatusRejected, net.status(testGenesisEpoch.ID+1, first))
+	net.advance(1)
+	assert.Equal(t, ErrProposalRejected, net.results[members[3]])
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Candidate is a validator candidate with its sampling weight.
+type Candidate struct {
+	Peer   *PeerInfo
+	Weight uint64
+}
+
+// NextEpochSeed derives the randomness of the next epoch from the current seed, the passed
+// epoch and the tx which made the epoch passed, so it can be recomputed by anyone.
+func NextEpochSeed(seed, epochHash, txHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(seed.Bytes(), epochHash.Bytes(), txHash.Bytes())
+}
+
+// SampleCommittee selects `size` members from candidates by weighted sampling without
+// replacement, the result only depends on the seed and the order of candidates.
+func SampleCommittee(seed common.Hash, candidates []*Candidate, size int) ([]*PeerInfo, error) {
+	if size < 0 || size > len(candidates) {
+		return nil, errors.New("committee size out of range")
+	}
+
+	pool := make([]*Candidate, 0, len(candidates))
+	total := new(big.Int)
+	for _, v := range candidates {
+		if v == nil || v.Peer == nil || v.Weight == 0 {
+			return nil, errors.New("invalid candidate")
+		}
+		pool = append(pool, v)
+		total.Add(total, new(big.Int).SetUint64(v.Weight))
+	}
+
+	committee := make([]*PeerInfo, 0, size)
+	for i := 0; i < size; i++ {
+		rnd := crypto.Keccak256(seed.Bytes(), utils.GetUint64Bytes(uint64(i)))
+		point := new(big.Int).Mod(new(big.Int).SetBytes(rnd), total)
+
+		idx := 0
+		for acc := new(big.Int); idx < len(pool); idx++ {
+			acc.Add(acc, new(big.Int).SetUint64(pool[idx].Weight))
+			if point.Cmp(acc) < 0 {
+				break
+			}
+		}
+		committee = append(committee, pool[idx].Peer)
+		total.Sub(total, new(big.Int).SetUint64(pool[idx].Weight))
+		pool = append(pool[:idx], pool[idx+1:]...)
+	}
+	return committee, nil
+}
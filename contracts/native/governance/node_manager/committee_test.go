@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleCommittee(t *testing.T) {
+	peers := generateTestPeers(10)
+	candidates := make([]*Candidate, len(peers.List))
+	for i, v := range peers.List {
+		candidates[i] = &Candidate{Peer: v, Weight: 1}
+	}
+	seed := generateTestHash(1)
+
+	committee, err := SampleCommittee(seed, candidates, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(committee))
+	members := make(map[common.Address]struct{})
+	for _, v := range committee {
+		members[v.Address] = struct{}{}
+	}
+	assert.Equal(t, 7, len(members), "members should be sampled without replacement")
+
+	// deterministic for the same seed
+	again, err := SampleCommittee(seed, candidates, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, committee, again)
+
+	all, err := SampleCommittee(seed, candidates, len(candidates))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, peers.List, all)
+
+	_, err = SampleCommittee(seed, candidates, len(candidates)+1)
+	assert.Error(t, err)
+	_, err = SampleCommittee(seed, append(candidates, &Candidate{Peer: generateTestPeer()}), 1)
+	assert.Error(t, err)
+}
+
+func TestSampleCommitteeWeighted(t *testing.T) {
+	peers := generateTestPeers(2)
+	candidates := []*Candidate{
+		{Peer: peers.List[0], Weight: 1},
+		{Peer: peers.List[1], Weight: 99},
+	}
+
+	heavy := 0
+	for i := 0; i < 1000; i++ {
+		committee, err := SampleCommittee(generateTestHash(i+1), candidates, 1)
+		assert.NoError(t, err)
+		if committee[0] == peers.List[1] {
+			heavy++
+		}
+	}
+	assert.True(t, heavy > 950, "heavy candidate selected %d times", heavy)
+}
+
+func TestNextEpochSeed(t *testing.T) {
+	seed := generateTestHash(1)
+	next := NextEpochSeed(seed, generateTestHash(2), generateTestHash(3))
+	assert.NotEqual(t, seed, next)
+	assert.Equal(t, next, NextEpochSeed(seed, generateTestHash(2), generateTestHash(3)))
+	assert.NotEqual(t, next, NextEpochSeed(seed, generateTestHash(2), generateTestHash(4)))
+}
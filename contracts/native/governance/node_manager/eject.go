@@ -0,0 +1,155 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// EmergencyEffectivePeriod is the number of blocks before the epoch without the ejected validator
+// starts, it's shorter than `MinVoteEffectivePeriod` of normal proposals to stop the compromised
+// key as soon as possible.
+const EmergencyEffectivePeriod uint64 = 3
+
+// Eject participants eject the validator of which the key is compromised with the evidence, once
+// the signs of the remaining members reached quorum, the next epoch without the validator passes
+// immediately and starts after `EmergencyEffectivePeriod` blocks, the pending proposals of the
+// next epoch are rejected. it takes effect since governance v2.
+func Eject(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	signer := s.ContractRef().TxOrigin()
+	height := s.ContractRef().BlockHeight().Uint64()
+	logger := logger.New("txHash", s.ContractRef().TxHash())
+
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("eject", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	logger = logger.New("epochID", curEpoch.ID+1)
+	if err := checkAuthority(s, signer, ctx.Caller, curEpoch); err != nil {
+		logger.Trace("eject", "check authority failed", err, "signer", signer.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+	input := new(MethodEjectInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("eject", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	validator := input.Validator
+	if validator == signer {
+		logger.Trace("eject", "validator can't sign its own ejection", validator.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+	if _, ok := curEpoch.Members()[validator]; !ok {
+		logger.Trace("eject", "validator not member of current epoch", validator.Hex())
+		return utils.ByteFailed, ErrInvalidEvidence
+	}
+	if input.Evidence == nil {
+		return utils.ByteFailed, ErrInvalidEvidence
+	}
+	if err := input.Evidence.Verify(validator); err != nil {
+		logger.Trace("eject", "verify evidence failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrInvalidEvidence
+	}
+
+	remaining := &Peers{}
+	for _, v := range curEpoch.Peers.List {
+		if v.Address != validator {
+			remaining.List = append(remaining.List, v)
+		}
+	}
+	sort.Sort(remaining)
+
+	// the signs are counted with the quorum of the remaining members, and bound to current epoch
+	sign := &ConsensusSign{Method: MethodEject, Input: append(curEpoch.Hash().Bytes(), validator.Bytes()...)}
+	if findSigner(s, sign.Hash(), signer) {
+		logger.Trace("eject", "signer already exist", signer.Hex(), "hash", sign.Hash().Hex())
+		return utils.ByteFailed, ErrDuplicateSigner
+	}
+	quorum := QuorumSize(s, &EpochInfo{Peers: remaining})
+	if err := storeSigner(s, sign.Hash(), signer); err != nil {
+		logger.Trace("eject", "store signer failed", err, "hash", sign.Hash().Hex())
+		return utils.ByteFailed, ErrStorage
+	}
+	size := getSignerSize(s, sign.Hash())
+	if err := emitConsensusSign(s, sign, signer, size); err != nil {
+		logger.Trace("eject", "emit consensus sign log failed", err, "hash", sign.Hash().Hex())
+		return utils.ByteFailed, ErrEmitLog
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindVote, MethodEject, signer, validator.Bytes()); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	waiveDutyFee(s, curEpoch.ID, signer)
+	if size < quorum {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodEject)
+	}
+
+	// the epoch changed has to start after the current one
+	startHeight := height + EmergencyEffectivePeriod
+	if startHeight <= curEpoch.StartHeight {
+		startHeight = curEpoch.StartHeight + 1
+	}
+	epoch := &EpochInfo{
+		ID:          curEpoch.ID + 1,
+		Peers:       remaining,
+		StartHeight: startHeight,
+		Proposer:    signer,
+		Status:      ProposalStatusPropose,
+		Version:     epochHashVersion(s),
+	}
+	// the mutable fields of an identical proposal are never overwritten, it's passed as it is
+	if checkProposal(s, epoch.ID, epoch.Hash()) {
+		existing, err := getEpoch(s, epoch.Hash())
+		if err != nil {
+			logger.Trace("eject", "get identical proposal failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+		epoch = existing
+	} else {
+		if err := storeEpoch(s, epoch); err != nil {
+			logger.Trace("eject", "store epoch failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+		if err := storeProposal(s, epoch.ID, epoch.Hash()); err != nil {
+			logger.Trace("eject", "store proposal hash failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+	}
+	if err := emitValidatorEjected(s, epoch, validator); err != nil {
+		logger.Trace("eject", "emit validator ejected log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	if err := recordMisconduct(s, curEpoch.ID, validator, MisconductEquivocation); err != nil {
+		logger.Trace("eject", "record equivocation failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := passProposal(s, logger, curEpoch, epoch, signer); err != nil {
+		return utils.ByteFailed, err
+	}
+	logger.Warn("Validator ejected", "validator", validator, "epoch", epoch.ID, "startHeight", epoch.StartHeight)
+	return (&MethodBoolOutput{Success: true}).Encode(MethodEject)
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateTestSealedHeader ONLY used for testing
+func generateTestSealedHeader(t *testing.T, key *ecdsa.PrivateKey, number int64, extra string) *types.Header {
+	payload, err := rlp.EncodeToBytes(&types.HotstuffExtra{})
+	assert.NoError(t, err)
+	header := &types.Header{
+		Number:     big.NewInt(number),
+		Difficulty: big.NewInt(1),
+		Coinbase:   crypto.PubkeyToAddress(key.PublicKey),
+		Extra:      append(common.RightPadBytes([]byte(extra), types.HotstuffExtraVanity), payload...),
+	}
+	sigHash := RLPHash(types.HotstuffFilteredHeader(header, false))
+	seal, err := crypto.Sign(crypto.Keccak256(sigHash.Bytes()), key)
+	assert.NoError(t, err)
+	payload, err = rlp.EncodeToBytes(&types.HotstuffExtra{Seal: seal})
+	assert.NoError(t, err)
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	return header
+}
+
+func TestEject(t *testing.T) {
+	config := &params.ChainConfig{GovV2Block: big.NewInt(0)}
+	call := func(caller common.Address, height int, payload []byte) error {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		_, _, err := ctx.ContractRef().NativeCall(caller, this, payload)
+		return err
+	}
+
+	// replace the genesis epoch with the one of which the key of the accused validator is known
+	resetTestContext()
+	key, _ := crypto.GenerateKey()
+	accused := crypto.PubkeyToAddress(key.PublicKey)
+	peers := generateTestPeers(testGenesisNum - 1)
+	peers.List = append(peers.List, &PeerInfo{PubKey: hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)), Address: accused})
+	sort.Sort(peers)
+	genesis, err := StoreGenesisEpoch(testStateDB, peers)
+	assert.NoError(t, err)
+
+	// propose the next epoch which is rejected after the ejection
+	proposal := &EpochInfo{ID: StartEpoch + 1, Peers: peers, StartHeight: 500}
+	propose, err := (&MethodProposeInput{StartHeight: proposal.StartHeight, Peers: proposal.Peers}).Encode()
+	assert.NoError(t, err)
+	var remaining []common.Address
+	for _, v := range genesis.MemberList() {
+		if v != accused {
+			remaining = append(remaining, v)
+		}
+	}
+	assert.NoError(t, call(remaining[0], 10, propose))
+
+	header1 := generateTestSealedHeader(t, key, 100, "header1")
+	header2 := generateTestSealedHeader(t, key, 100, "header2")
+	invalid := []*EjectionEvidence{
+		{Header1: header1, Header2: header1},
+		{Header1: header1, Header2: generateTestSealedHeader(t, key, 101, "header2")},
+	}
+	for _, evidence := range invalid {
+		payload, err := (&MethodEjectInput{Validator: accused, Evidence: evidence}).Encode()
+		assert.NoError(t, err)
+		assert.Equal(t, ErrInvalidEvidence, call(remaining[0], 20, payload))
+	}
+	other, _ := crypto.GenerateKey()
+	payload, err := (&MethodEjectInput{Validator: accused, Evidence: &EjectionEvidence{Header1: header1, Header2: generateTestSealedHeader(t, other, 100, "header2")}}).Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, call(remaining[0], 20, payload))
+
+	eject, err := (&MethodEjectInput{Validator: accused, Evidence: &EjectionEvidence{Header1: header1, Header2: header2}}).Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidAuthority, call(accused, 20, eject))
+
+	ctx := generateNativeContract(remaining[0], 20)
+	ctx.ContractRef().SetChainConfig(config)
+	quorum := QuorumSize(ctx, &EpochInfo{Peers: &Peers{List: peers.List[:len(remaining)]}})
+	for i, v := range remaining[:quorum] {
+		if i == 1 {
+			assert.Equal(t, ErrDuplicateSigner, call(remaining[0], 20, eject))
+		}
+		cur, err := GetCurrentEpoch(testEmptyCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, genesis.ID, cur.ID)
+		assert.NoError(t, call(v, 20, eject))
+	}
+
+	// the epoch without the accused validator passes immediately, and the pending one is rejected
+	cur, err := GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, StartEpoch+1, cur.ID)
+	assert.Equal(t, uint64(20)+EmergencyEffectivePeriod, cur.StartHeight)
+	assert.Equal(t, len(remaining), cur.Peers.Len())
+	_, ok := cur.Members()[accused]
+	assert.False(t, ok)
+	pending, err := getEpoch(testEmptyCtx, proposal.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalStatusRejected, pending.Status)
+	assert.Equal(t, ErrInvalidAuthority, call(accused, 21, propose))
+
+	// the equivocation is recorded to the accused validator
+	report, err := getMisconduct(testEmptyCtx, genesis.ID, accused)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), report.Equivocations)
+}
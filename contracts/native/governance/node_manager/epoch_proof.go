@@ -0,0 +1,360 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The epoch changes are proved to the evm contracts on other chains, e.g: `verifier/ZionEpochVerifier.sol`,
+// by the signatures of the validators of the previous epoch, which are submitted by `signEpochChange`
+// after the epoch passed. the signed digest is abi encoded, so that it's rebuilt by the contracts
+// without rlp decoding, and the epoch hash is carried as is to link the epochs. proof of the genesis
+// epoch is not stored, so the change from genesis is never signed, and the light clients start from
+// a trusted epoch snapshot instead.
+
+// EpochChangeTypeHash is the type hash of the signed epoch change.
+var EpochChangeTypeHash = crypto.Keccak256Hash([]byte("ZionEpochChange(uint64 chainId,bytes32 prevHash,bytes32 hash,uint64 id,uint64 startHeight,uint64 quorum,address[] validators)"))
+
+// EpochVerifierABI is the abi of `verifier/ZionEpochVerifier.sol`.
+const EpochVerifierABI = `[
+	{"type":"constructor","inputs":[{"internalType":"uint64","name":"chainId","type":"uint64"},{"internalType":"bytes32","name":"hash","type":"bytes32"},{"internalType":"uint64","name":"id","type":"uint64"},{"internalType":"uint64","name":"height","type":"uint64"},{"internalType":"uint64","name":"quorumSize","type":"uint64"},{"internalType":"address[]","name":"members","type":"address[]"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"changeEpoch","inputs":[{"internalType":"bytes32","name":"prevHash","type":"bytes32"},{"internalType":"bytes32","name":"hash","type":"bytes32"},{"internalType":"uint64","name":"id","type":"uint64"},{"internalType":"uint64","name":"height","type":"uint64"},{"internalType":"uint64","name":"quorumSize","type":"uint64"},{"internalType":"address[]","name":"members","type":"address[]"},{"internalType":"bytes[]","name":"signatures","type":"bytes[]"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"currentValidators","inputs":[],"outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"stateMutability":"view"},
+	{"type":"function","name":"EPOCH_CHANGE_TYPEHASH","inputs":[],"outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view"},
+	{"type":"function","name":"zionChainId","inputs":[],"outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"epochHash","inputs":[],"outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view"},
+	{"type":"function","name":"epochId","inputs":[],"outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"startHeight","inputs":[],"outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"quorum","inputs":[],"outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"view"},
+	{"type":"event","name":"EpochChanged","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ID","type":"uint64"},{"indexed":false,"internalType":"bytes32","name":"Hash","type":"bytes32"},{"indexed":false,"internalType":"uint64","name":"StartHeight","type":"uint64"}]}
+]`
+
+var (
+	epochVerifierABI abi.ABI
+	epochChangeArgs  abi.Arguments
+)
+
+func init() {
+	var err error
+	if epochVerifierABI, err = abi.JSON(strings.NewReader(EpochVerifierABI)); err != nil {
+		panic(fmt.Sprintf("invalid epoch verifier abi: %v", err))
+	}
+	for _, typ := range []string{"bytes32", "uint64", "bytes32", "bytes32", "uint64", "uint64", "uint64", "address[]"} {
+		t, err := abi.NewType(typ, "", nil)
+		if err != nil {
+			panic(fmt.Sprintf("invalid epoch change type %s: %v", typ, err))
+		}
+		epochChangeArgs = append(epochChangeArgs, abi.Argument{Type: t})
+	}
+}
+
+// EpochChange is the change from the previous epoch to the next one signed by the validators of
+// the previous epoch, `Quorum` is the quorum size of the next epoch under the rule in force while
+// signing.
+type EpochChange struct {
+	ChainID     uint64
+	PrevHash    common.Hash
+	Hash        common.Hash
+	ID          uint64
+	StartHeight uint64
+	Quorum      uint64
+	Validators  []common.Address
+}
+
+func NewEpochChange(chainID uint64, prev, next *EpochInfo, quorum int) *EpochChange {
+	return &EpochChange{
+		ChainID:     chainID,
+		PrevHash:    prev.Hash(),
+		Hash:        next.Hash(),
+		ID:          next.ID,
+		StartHeight: next.StartHeight,
+		Quorum:      uint64(quorum),
+		Validators:  next.MemberList(),
+	}
+}
+
+// Digest returns the message signed by the validators, which is
+// `keccak256(abi.encode(EPOCH_CHANGE_TYPEHASH, chainId, prevHash, hash, id, startHeight, quorum, validators))`.
+func (c *EpochChange) Digest() common.Hash {
+	enc, err := epochChangeArgs.Pack(EpochChangeTypeHash, c.ChainID, c.PrevHash, c.Hash, c.ID, c.StartHeight, c.Quorum, c.Validators)
+	if err != nil {
+		// the arguments are always packable with the static types
+		panic(fmt.Sprintf("pack epoch change failed: %v", err))
+	}
+	return crypto.Keccak256Hash(enc)
+}
+
+// EpochChangeSigns is the signatures of the change to the epoch collected on chain, the signatures
+// are dropped if the quorum rule changes before the proof is complete.
+type EpochChangeSigns struct {
+	EpochID    uint64
+	Quorum     uint64
+	Signers    []common.Address
+	Signatures [][]byte
+}
+
+// EpochChangeProof is the input of `changeEpoch` of the verifier contract, the signatures are
+// ordered by signer ascending.
+type EpochChangeProof struct {
+	Change     *EpochChange
+	Signatures [][]byte
+}
+
+// Pack returns the calldata of `changeEpoch`.
+func (p *EpochChangeProof) Pack() ([]byte, error) {
+	c := p.Change
+	return epochVerifierABI.Pack("changeEpoch", c.PrevHash, c.Hash, c.ID, c.StartHeight, c.Quorum, c.Validators, p.Signatures)
+}
+
+// GetEpochChangeProof builds the proof of the change to the epoch from the signatures collected in
+// state, it's used by the relayers of the light clients out of the native contract context.
+func GetEpochChangeProof(s *state.StateDB, chainID uint64, epochID uint64) (*EpochChangeProof, error) {
+	db := (*state.CacheDB)(s)
+	prev, next, err := readEpochChange(db, epochID)
+	if err != nil {
+		return nil, err
+	}
+	signs, err := readEpochChangeSigns(db, epochID)
+	if err != nil {
+		return nil, fmt.Errorf("read signs of epoch %d failed: %v", epochID, err)
+	}
+	if len(signs.Signatures) == 0 {
+		return nil, fmt.Errorf("change to epoch %d not signed", epochID)
+	}
+
+	index := make([]int, len(signs.Signers))
+	for i := range index {
+		index[i] = i
+	}
+	sort.Slice(index, func(i, j int) bool {
+		return bytes.Compare(signs.Signers[index[i]].Bytes(), signs.Signers[index[j]].Bytes()) < 0
+	})
+	proof := &EpochChangeProof{Change: NewEpochChange(chainID, prev, next, int(signs.Quorum))}
+	for _, i := range index {
+		proof.Signatures = append(proof.Signatures, signs.Signatures[i])
+	}
+	return proof, nil
+}
+
+// VerifyEpochChangeProof is the reference of `changeEpoch` of the verifier contract, it checks that
+// the proof changes from the epoch in force and is signed by the quorum of its validators.
+func VerifyEpochChangeProof(chainID uint64, cur *types.EpochChangeEvent, proof *EpochChangeProof) error {
+	c := proof.Change
+	if c.PrevHash != cur.Hash {
+		return fmt.Errorf("previous epoch mismatch")
+	}
+	if c.ID != cur.EpochID+1 {
+		return fmt.Errorf("epoch id not continuous")
+	}
+	if c.Quorum == 0 || c.Quorum > uint64(len(c.Validators)) {
+		return fmt.Errorf("invalid quorum")
+	}
+	validators := make(map[common.Address]struct{})
+	for _, v := range c.Validators {
+		if _, ok := validators[v]; ok {
+			return fmt.Errorf("duplicate validator")
+		}
+		validators[v] = struct{}{}
+	}
+
+	members := make(map[common.Address]struct{})
+	for _, v := range cur.Validators {
+		members[v] = struct{}{}
+	}
+	signed := *c
+	signed.ChainID = chainID
+	digest := signed.Digest()
+	var last common.Address
+	for _, sig := range proof.Signatures {
+		signer, err := recoverEpochChangeSigner(digest, sig)
+		if err != nil {
+			return err
+		}
+		if bytes.Compare(signer.Bytes(), last.Bytes()) <= 0 {
+			return fmt.Errorf("signers not ascending")
+		}
+		if _, ok := members[signer]; !ok {
+			return fmt.Errorf("signer not validator")
+		}
+		last = signer
+	}
+	if len(proof.Signatures) < cur.QuorumSize {
+		return fmt.Errorf("signatures not enough")
+	}
+	return nil
+}
+
+// recoverEpochChangeSigner recovers the signer of the 65 bytes signature in [R || S || V] format,
+// V is either 0/1 or 27/28, and the malleable signatures are rejected as the verifier contract does.
+func recoverEpochChangeSigner(digest common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length")
+	}
+	normalized := common.CopyBytes(sig)
+	if v := normalized[crypto.RecoveryIDOffset]; v >= 27 {
+		normalized[crypto.RecoveryIDOffset] = v - 27
+	}
+	r, s := new(big.Int).SetBytes(normalized[:32]), new(big.Int).SetBytes(normalized[32:64])
+	if !crypto.ValidateSignatureValues(normalized[crypto.RecoveryIDOffset], r, s, true) {
+		return common.Address{}, fmt.Errorf("invalid signature values")
+	}
+	pub, err := crypto.SigToPub(digest.Bytes(), normalized)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %v", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// SignEpochChange validators of the previous epoch sign the change to the passed epoch, the
+// signatures are collected for the light clients on other chains. the signature is dropped if the
+// quorum rule changed since the others signed, and the validators should sign again.
+func SignEpochChange(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	signer := s.ContractRef().TxOrigin()
+
+	input := new(MethodSignEpochChangeInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("signEpochChange", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("signEpochChange", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	if input.EpochID <= StartEpoch+1 || input.EpochID > curEpoch.ID {
+		logger.Trace("signEpochChange", "check epoch id failed", input.EpochID, "current", curEpoch.ID)
+		return utils.ByteFailed, ErrInvalidEpoch
+	}
+	prev, next, err := readEpochChange(s.GetCacheDB(), input.EpochID)
+	if err != nil {
+		logger.Trace("signEpochChange", "read epoch change failed", err)
+		return utils.ByteFailed, ErrEpochProofNotExist
+	}
+	if err := checkAuthority(s, signer, ctx.Caller, prev); err != nil {
+		logger.Trace("signEpochChange", "check authority failed", err, "tx origin", signer.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	quorum := QuorumSize(s, next)
+	change := NewEpochChange(chainIDOf(s), prev, next, quorum)
+	if recovered, err := recoverEpochChangeSigner(change.Digest(), input.Signature); err != nil || recovered != signer {
+		logger.Trace("signEpochChange", "check signature failed", err, "signer", signer.Hex())
+		return utils.ByteFailed, ErrInvalidSign
+	}
+	signs, err := getEpochChangeSigns(s, input.EpochID)
+	if err != nil {
+		logger.Trace("signEpochChange", "get signs failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if signs.Quorum != uint64(quorum) {
+		signs = &EpochChangeSigns{EpochID: input.EpochID, Quorum: uint64(quorum)}
+	}
+	for _, v := range signs.Signers {
+		if v == signer {
+			return utils.ByteFailed, ErrDuplicateSigner
+		}
+	}
+	signs.Signers = append(signs.Signers, signer)
+	signs.Signatures = append(signs.Signatures, input.Signature)
+	if err := storeEpochChangeSigns(s, signs); err != nil {
+		logger.Trace("signEpochChange", "store signs failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitEpochChangeSigned(s, input.EpochID, signer, len(signs.Signers)); err != nil {
+		logger.Trace("signEpochChange", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSignEpochChange)
+}
+
+// GetEpochChangeSigns returns the digest of the change to the epoch to be signed under the quorum rule
+// in force, and the signatures collected for it.
+func GetEpochChangeSigns(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodEpochChangeSignsInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("epochChangeSigns", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	prev, next, err := readEpochChange(s.GetCacheDB(), input.EpochID)
+	if err != nil {
+		logger.Trace("epochChangeSigns", "read epoch change failed", err)
+		return utils.ByteFailed, ErrEpochProofNotExist
+	}
+	quorum := QuorumSize(s, next)
+	signs, err := getEpochChangeSigns(s, input.EpochID)
+	if err != nil {
+		logger.Trace("epochChangeSigns", "get signs failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodEpochChangeSignsOutput{
+		Digest:     NewEpochChange(chainIDOf(s), prev, next, quorum).Digest(),
+		Signatures: make([][]byte, 0),
+	}
+	if signs.Quorum == uint64(quorum) {
+		output.Signatures = append(output.Signatures, signs.Signatures...)
+	}
+	return output.Encode()
+}
+
+// readEpochChange reads the passed epoch and the one it changed from by the epoch proofs.
+func readEpochChange(db *state.CacheDB, epochID uint64) (prev, next *EpochInfo, err error) {
+	if epochID <= StartEpoch+1 {
+		return nil, nil, fmt.Errorf("change to epoch %d is not provable", epochID)
+	}
+	for i, id := range []uint64{epochID - 1, epochID} {
+		hash, _ := readEpochProof(db, id)
+		if hash == common.EmptyHash {
+			return nil, nil, fmt.Errorf("proof of epoch %d not exist", id)
+		}
+		epoch, err := readEpoch(db, hash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read epoch %d failed: %v", id, err)
+		}
+		if i == 0 {
+			prev = epoch
+		} else {
+			next = epoch
+		}
+	}
+	return prev, next, nil
+}
+
+func chainIDOf(s *native.NativeContract) uint64 {
+	if config := s.ContractRef().ChainConfig(); config != nil && config.ChainID != nil {
+		return config.ChainID.Uint64()
+	}
+	return 0
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochChangeProof(t *testing.T) {
+	chainID := uint64(60801)
+	config := &params.ChainConfig{ChainID: new(big.Int).SetUint64(chainID), GovV2Block: big.NewInt(0)}
+	call := func(caller common.Address, height int, payload []byte) ([]byte, error) {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		ret, _, err := ctx.ContractRef().NativeCall(caller, this, payload)
+		return ret, err
+	}
+
+	// genesis of which the keys of validators are known
+	resetTestContext()
+	keys := make(map[common.Address]*ecdsa.PrivateKey)
+	peers := &Peers{}
+	for i := 0; i < testGenesisNum; i++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		keys[addr] = key
+		peers.List = append(peers.List, &PeerInfo{PubKey: hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)), Address: addr})
+	}
+	sort.Sort(peers)
+	genesis, err := StoreGenesisEpoch(testStateDB, peers)
+	assert.NoError(t, err)
+	members := genesis.MemberList()
+	quorum := genesis.QuorumSize()
+
+	// pass the epochs of which the change from genesis is not provable
+	for i, height := range []int{10, 200} {
+		startHeight := uint64(height) + MinEpochValidPeriod + 10
+		propose, err := (&MethodProposeInput{StartHeight: startHeight, Peers: peers}).Encode()
+		assert.NoError(t, err)
+		_, err = call(members[0], height, propose)
+		assert.NoError(t, err)
+		epoch := &EpochInfo{ID: StartEpoch + uint64(i) + 1, Peers: peers, StartHeight: startHeight}
+		vote, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+		assert.NoError(t, err)
+		for _, v := range members[1:quorum] {
+			_, err = call(v, height+1, vote)
+			assert.NoError(t, err)
+		}
+	}
+	cur, err := GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, StartEpoch+2, cur.ID)
+
+	sign := func(signer common.Address, epochID uint64, digest common.Hash) []byte {
+		sig, err := crypto.Sign(digest.Bytes(), keys[signer])
+		assert.NoError(t, err)
+		payload, err := (&MethodSignEpochChangeInput{EpochID: epochID, Signature: sig}).Encode()
+		assert.NoError(t, err)
+		return payload
+	}
+	query, err := (&MethodEpochChangeSignsInput{EpochID: cur.ID}).Encode()
+	assert.NoError(t, err)
+	ret, err := call(members[0], 300, query)
+	assert.NoError(t, err)
+	output := new(MethodEpochChangeSignsOutput)
+	assert.NoError(t, output.Decode(ret))
+	assert.Equal(t, 0, len(output.Signatures))
+
+	_, err = call(members[0], 300, sign(members[0], StartEpoch+1, output.Digest))
+	assert.Equal(t, ErrInvalidEpoch, err)
+	_, err = call(members[0], 300, sign(members[1], cur.ID, output.Digest))
+	assert.Equal(t, ErrInvalidSign, err)
+	for i, v := range members[:quorum] {
+		_, err = call(v, 300, sign(v, cur.ID, output.Digest))
+		assert.NoError(t, err)
+		if i == 0 {
+			_, err = call(v, 300, sign(v, cur.ID, output.Digest))
+			assert.Equal(t, ErrDuplicateSigner, err)
+		}
+	}
+
+	// the light client following the previous epoch changes to the one of node manager
+	prev, err := GetEpochSnapshot(testStateDB, cur.ID-1)
+	assert.NoError(t, err)
+	next, err := GetEpochSnapshot(testStateDB, cur.ID)
+	assert.NoError(t, err)
+	proof, err := GetEpochChangeProof(testStateDB, chainID, cur.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, output.Digest, proof.Change.Digest())
+	assert.Equal(t, quorum, len(proof.Signatures))
+	assert.NoError(t, VerifyEpochChangeProof(chainID, prev, proof))
+	assert.Equal(t, next.Hash, proof.Change.Hash)
+	assert.Equal(t, next.StartHeight, proof.Change.StartHeight)
+	assert.Equal(t, next.Validators, proof.Change.Validators)
+	assert.Equal(t, uint64(next.QuorumSize), proof.Change.Quorum)
+
+	assert.Error(t, VerifyEpochChangeProof(chainID+1, prev, proof))
+	assert.Error(t, VerifyEpochChangeProof(chainID, next, proof))
+	reversed := &EpochChangeProof{Change: proof.Change, Signatures: [][]byte{proof.Signatures[1], proof.Signatures[0]}}
+	assert.Error(t, VerifyEpochChangeProof(chainID, prev, reversed))
+	short := &EpochChangeProof{Change: proof.Change, Signatures: proof.Signatures[:quorum-1]}
+	assert.Error(t, VerifyEpochChangeProof(chainID, prev, short))
+
+	// the calldata of the verifier contract
+	calldata, err := proof.Pack()
+	assert.NoError(t, err)
+	method := epochVerifierABI.Methods["changeEpoch"]
+	assert.Equal(t, method.ID, calldata[:4])
+	args, err := method.Inputs.Unpack(calldata[4:])
+	assert.NoError(t, err)
+	assert.Equal(t, [32]byte(prev.Hash), args[0])
+	assert.Equal(t, [32]byte(next.Hash), args[1])
+	assert.Equal(t, next.EpochID, args[2])
+	assert.Equal(t, next.Validators, args[5])
+	assert.Equal(t, proof.Signatures, args[6])
+}
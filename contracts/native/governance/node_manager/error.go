@@ -29,6 +29,8 @@ var (
 
 	ErrEpochProofNotExist = errors.New("epoch proof not exist")
 
+	ErrOutputTooLarge = errors.New("query output too large")
+
 	ErrConsensusSignNotExist = errors.New("consensus sign not exist")
 
 	ErrInvalidAuthority = errors.New("invalid authority")
@@ -59,6 +61,54 @@ var (
 
 	ErrVoteHeight = errors.New("too late to vote")
 
+	ErrPeersChange = errors.New("proposal peers change too much")
+
+	ErrPeersTarget = errors.New("proposal peers size moves away from target")
+
+	ErrInvalidPeersLimit = errors.New("invalid peers limit")
+
+	ErrInvalidQuorumRule = errors.New("invalid quorum rule")
+
+	ErrElectorateChanged = errors.New("validator set changed since the proposal")
+
+	ErrProposalRejected = errors.New("proposal already rejected")
+
+	ErrInvalidVrfKey = errors.New("invalid vrf public key")
+
+	ErrVrfKeyNotExist = errors.New("vrf public key not exist")
+
+	ErrInvalidVrfProof = errors.New("invalid vrf proof")
+
+	ErrDuplicateVrf = errors.New("duplicate vrf output")
+
+	ErrInvalidEpochRange = errors.New("invalid epoch range")
+
+	ErrInvalidFeeSplit = errors.New("invalid fee split")
+
+	ErrInvalidAction = errors.New("invalid proposal action")
+
+	ErrVotingSealed = errors.New("voting is sealed")
+
+	ErrVotingNotSealed = errors.New("voting is not sealed")
+
+	ErrInvalidSealPeriod = errors.New("invalid voting seal period")
+
+	ErrNotCommitPeriod = errors.New("out of commit period")
+
+	ErrNotRevealPeriod = errors.New("out of reveal period")
+
+	ErrInvalidCommitment = errors.New("invalid vote commitment")
+
+	ErrInvalidEvidence = errors.New("invalid ejection evidence")
+
+	ErrVrfNotExist = errors.New("vrf output not exist")
+
+	ErrGovV2NotActivated = errors.New("governance v2 not activated")
+
+	ErrInvalidGroupName = errors.New("invalid validator group name")
+
+	ErrGroupNotExist = errors.New("validator group not exist")
+
 	ErrStorage = errors.New("store key value failed")
 
 	ErrEmitLog = errors.New("emit log failed")
@@ -0,0 +1,204 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/scheduler"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// the settled bookkeeping entries are kept for a retention period measured in blocks, and then
+// dropped by the sweeps of the scheduler. the expiry heights are aligned to `ExpiryInterval`, so
+// that there is at most one sweep task per interval.
+const (
+	SignRetention      uint64 = 86400 * 10 // about one month with 3 seconds blocks
+	ProposalRetention  uint64 = 86400 * 10
+	ExpiryInterval     uint64 = 1200
+	MaxExpiryPerBucket int    = 256
+)
+
+// ExpiryKind tells which bookkeeping entries are dropped by the expiry.
+type ExpiryKind uint8
+
+const (
+	ExpirySign      ExpiryKind = iota + 1 // the sign, signers and electorate of a consensus sign reached quorum
+	ExpiryProposals                       // the losing proposals and the sealed voting of a passed epoch
+)
+
+func (k ExpiryKind) String() string {
+	switch k {
+	case ExpirySign:
+		return "sign"
+	case ExpiryProposals:
+		return "proposals"
+	default:
+		return "unknown"
+	}
+}
+
+// expireAfter queues the entry to be dropped after the retention. it takes effect since the storage
+// v2 fork, the entries settled before that are never expired.
+func expireAfter(s *native.NativeContract, entry *ExpiryEntry, retention uint64) error {
+	if !s.ContractRef().IsStorageV2() {
+		return nil
+	}
+	height := (s.ContractRef().BlockHeight().Uint64()+retention)/ExpiryInterval*ExpiryInterval + ExpiryInterval
+	for {
+		bucket, err := getExpiryBucket(s, height)
+		if err != nil {
+			return err
+		}
+		if len(bucket) >= MaxExpiryPerBucket {
+			height += ExpiryInterval
+			continue
+		}
+		if len(bucket) == 0 {
+			// the sweep is scheduled by the finalize hook, in which node manager is the caller
+			arms, err := getExpiryArms(s)
+			if err != nil {
+				return err
+			}
+			if err := storeExpiryArms(s, append(arms, height)); err != nil {
+				return err
+			}
+		}
+		return storeExpiryBucket(s, height, append(bucket, entry))
+	}
+}
+
+// armExpiry schedules the sweeps of the expiry buckets created in the block. the bucket is left to
+// the next block if the scheduling failed, and the overdue bucket is swept in the next block.
+func armExpiry(s *native.NativeContract) error {
+	arms, err := getExpiryArms(s)
+	if err != nil || len(arms) == 0 {
+		return err
+	}
+	current := s.ContractRef().BlockHeight().Uint64()
+	left := make([]uint64, 0)
+	for _, height := range arms {
+		payload, err := (&MethodSweepExpiredInput{Height: height}).Encode()
+		if err != nil {
+			return err
+		}
+		at := height
+		if at <= current {
+			at = current + 1
+		}
+		if _, err := scheduler.Schedule(s, at, payload); err != nil {
+			logger.Warn("Failed to schedule expiry sweep", "height", height, "at", at, "err", err)
+			left = append(left, height)
+		}
+	}
+	return storeExpiryArms(s, left)
+}
+
+// SweepExpired drops the expired entries of the bucket, it can only be called by the scheduler.
+func SweepExpired(s *native.NativeContract) ([]byte, error) {
+	if err := scheduler.ValidateCaller(s); err != nil {
+		return utils.ByteFailed, err
+	}
+	input := new(MethodSweepExpiredInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("sweepExpired", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	bucket, err := getExpiryBucket(s, input.Height)
+	if err != nil {
+		logger.Trace("sweepExpired", "get expiry bucket failed", err, "height", input.Height)
+		return utils.ByteFailed, ErrStorage
+	}
+
+	reclaimed := 0
+	for _, entry := range bucket {
+		n, err := expire(s, entry)
+		if err != nil {
+			logger.Trace("sweepExpired", "expire entry failed", err, "kind", entry.Kind)
+			return utils.ByteFailed, ErrStorage
+		}
+		prefix := fmt.Sprintf("native/node_manager/expiry/%s", entry.Kind)
+		metrics.GetOrRegisterCounter(prefix+"/entries", nil).Inc(1)
+		metrics.GetOrRegisterCounter(prefix+"/reclaimed", nil).Inc(int64(n))
+		reclaimed += n
+	}
+	del(s, expiryBucketKey(input.Height))
+
+	if err := emitExpirySwept(s, input.Height, len(bucket), reclaimed); err != nil {
+		logger.Trace("sweepExpired", "emit expiry swept log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSweepExpired)
+}
+
+// expire drops the entries and returns the size of the values reclaimed in bytes.
+func expire(s *native.NativeContract, entry *ExpiryEntry) (int, error) {
+	switch entry.Kind {
+	case ExpirySign:
+		return reclaim(s, signKey(entry.Hash), signerKey(entry.Hash), electorateKey(entry.Hash)), nil
+
+	case ExpiryProposals:
+		// the passed proposal is kept, which is referred by the epoch proof.
+		passed, _ := getEpochProof(s, entry.EpochID)
+		proposals, err := getProposals(s, entry.EpochID)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return 0, err
+		}
+		reclaimed := reclaim(s, votingSealKey(entry.EpochID))
+		kept := make([]common.Hash, 0, 1)
+		for _, v := range proposals {
+			if v == passed {
+				kept = append(kept, v)
+				continue
+			}
+			reclaimed += reclaim(s, epochKey(v), tallyKey(v), actionsKey(v), voteKey(v), electorateKey(v))
+		}
+		if len(kept) == len(proposals) {
+			return reclaimed, nil
+		}
+		reclaimed += reclaim(s, proposalsKey(entry.EpochID))
+		if len(kept) > 0 {
+			if err := setProposals(s, entry.EpochID, kept); err != nil {
+				return 0, err
+			}
+			value, _ := get(s, proposalsKey(entry.EpochID))
+			reclaimed -= len(value)
+		}
+		return reclaimed, nil
+
+	default:
+		return 0, fmt.Errorf("unknown expiry kind %d", entry.Kind)
+	}
+}
+
+// reclaim deletes the keys, and returns the total size of the values deleted.
+func reclaim(s *native.NativeContract, keys ...[]byte) int {
+	size := 0
+	for _, key := range keys {
+		if value, err := get(s, key); err == nil {
+			size += len(value)
+			del(s, key)
+		}
+	}
+	return size
+}
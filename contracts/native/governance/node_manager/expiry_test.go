@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/scheduler"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiry(t *testing.T) {
+	resetTestContext()
+	scheduler.InitScheduler()
+
+	members := testGenesisEpoch.MemberList()
+	quorum := testGenesisEpoch.QuorumSize()
+	call := func(caller common.Address, height int, payload []byte) error {
+		_, _, err := generateNativeContractRef(caller, height).NativeCall(caller, this, payload)
+		return err
+	}
+
+	// two proposals of the next epoch, the second one passed
+	peers := testGenesisEpoch.Peers.Copy()
+	sort.Sort(peers)
+	proposals := make([]*EpochInfo, 2)
+	for i := range proposals {
+		proposals[i] = &EpochInfo{ID: StartEpoch + 1, Peers: peers, StartHeight: uint64(200 + i), Proposer: members[i], Version: EpochHashV2}
+		payload, err := (&MethodProposeInput{StartHeight: proposals[i].StartHeight, Peers: proposals[i].Peers}).Encode()
+		assert.NoError(t, err)
+		assert.NoError(t, call(members[i], 10, payload))
+	}
+	for _, voter := range members[:quorum] {
+		payload, err := (&MethodVoteInput{EpochID: proposals[1].ID, Hash: proposals[1].Hash()}).Encode()
+		assert.NoError(t, err)
+		assert.NoError(t, call(voter, 11, payload))
+	}
+
+	// a consensus sign reached quorum
+	sign := &ConsensusSign{Method: "expiry", Input: []byte{1}}
+	for _, signer := range members[:quorum] {
+		ref := generateNativeContractRef(signer, 12)
+		ref.PushContext(&native.Context{Caller: signer, ContractAddress: common.EmptyAddress})
+		_, err := CheckConsensusSigns(native.NewNativeContract(testStateDB, ref), sign.Method, sign.Input, signer)
+		assert.NoError(t, err)
+	}
+
+	// both are queued in the same bucket
+	height := (12+SignRetention)/ExpiryInterval*ExpiryInterval + ExpiryInterval
+	bucket, err := getExpiryBucket(testEmptyCtx, height)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ExpiryEntry{{Kind: ExpiryProposals, EpochID: proposals[1].ID}, {Kind: ExpirySign, Hash: sign.Hash()}}, bucket)
+	arms, err := getExpiryArms(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{height}, arms)
+
+	// the sweep is only callable by the scheduler
+	payload, err := (&MethodSweepExpiredInput{Height: height}).Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.ErrNotScheduler, call(members[0], int(height), payload))
+
+	// the sweep is scheduled by the finalize hook, and executed at the expiry height
+	finalize := func(addr common.Address, height uint64) {
+		ref := generateNativeContractRef(utils.SystemContractAddress, int(height))
+		ref.PushContext(&native.Context{Caller: utils.SystemContractAddress, ContractAddress: utils.SystemContractAddress})
+		assert.NoError(t, native.NewNativeContract(testStateDB, ref).RunFinalizeHook(addr))
+	}
+	finalize(this, 12)
+	arms, err = getExpiryArms(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Empty(t, arms)
+	finalize(utils.SchedulerContractAddress, height)
+
+	bucket, err = getExpiryBucket(testEmptyCtx, height)
+	assert.NoError(t, err)
+	assert.Empty(t, bucket)
+	_, err = getSign(testEmptyCtx, sign.Hash())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Zero(t, getSignerSize(testEmptyCtx, sign.Hash()))
+	_, err = getElectorate(testEmptyCtx, sign.Hash())
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// the passed proposal is kept
+	list, err := getProposals(testEmptyCtx, proposals[1].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Hash{proposals[1].Hash()}, list)
+	_, err = getEpoch(testEmptyCtx, proposals[0].Hash())
+	assert.Error(t, err)
+	cur, err := GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, proposals[1].Hash(), cur.Hash())
+}
+
+func TestExpiryBeforeStorageV2(t *testing.T) {
+	resetTestContext()
+
+	ctx := generateNativeContract(testCaller, 10)
+	ctx.ContractRef().SetChainConfig(&params.ChainConfig{StorageV2Block: big.NewInt(11)})
+	assert.NoError(t, expireAfter(ctx, &ExpiryEntry{Kind: ExpirySign}, SignRetention))
+	arms, err := getExpiryArms(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, arms)
+}
+
+func TestExpiryBucketOverflow(t *testing.T) {
+	resetTestContext()
+
+	ctx := generateNativeContract(testCaller, 10)
+	for i := 0; i <= MaxExpiryPerBucket; i++ {
+		assert.NoError(t, expireAfter(ctx, &ExpiryEntry{Kind: ExpirySign, Hash: common.BigToHash(big.NewInt(int64(i)))}, SignRetention))
+	}
+	height := (10+SignRetention)/ExpiryInterval*ExpiryInterval + ExpiryInterval
+	arms, err := getExpiryArms(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{height, height + ExpiryInterval}, arms)
+	bucket, err := getExpiryBucket(ctx, height+ExpiryInterval)
+	assert.NoError(t, err)
+	assert.Len(t, bucket, 1)
+}
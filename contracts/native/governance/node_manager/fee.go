@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+)
+
+// MaxFreeDutiesPerEpoch is the max number of governance duties, e.g: proposals, votes and consensus
+// signs, of which the fee is waived for each member in an epoch, the rest are charged as usual.
+const MaxFreeDutiesPerEpoch uint64 = 16
+
+// FinalizeBlockGas is the gas charged for the finalize hook of node manager in each block.
+const FinalizeBlockGas uint64 = 100000
+
+// FinalizeBlock is the finalize hook of node manager, the sweeps of the expiry buckets created in
+// the block are scheduled, the sealed voting closed is tallied, and the fees left to the proposer
+// by the fee split, see `core.StateTransition`, are recorded in its payout statement. the proposer
+// is the sender of the system transaction, and it's the coinbase which the fees are paid to. all
+// but the expiry take effect since governance v2.
+func FinalizeBlock(s *native.NativeContract) error {
+	ref := s.ContractRef()
+	if err := armExpiry(s); err != nil {
+		return err
+	}
+	if !ref.IsGovV2() {
+		return nil
+	}
+	if err := tallySealedVoting(s); err != nil {
+		return err
+	}
+	return recordPayout(s, ref.TxOrigin(), s.StateDB().Fees())
+}
+
+// waiveDutyFee waives the fee of the governance duty performed by the member of the epoch, it's
+// called after the duty took effect, so that the failed or redundant calls are charged. the duty
+// must be sent by the member directly, and the free duties are bounded by `MaxFreeDutiesPerEpoch`
+// to prevent spamming. it takes effect since governance v2.
+func waiveDutyFee(s *native.NativeContract, epochID uint64, member common.Address) {
+	ref := s.ContractRef()
+	if !ref.IsGovV2() || ref.TxOrigin() != member {
+		return
+	}
+	num := getFreeDuties(s, epochID, member)
+	if num >= MaxFreeDutiesPerEpoch {
+		return
+	}
+	storeFreeDuties(s, epochID, member, num+1)
+	s.StateDB().WaiveFee()
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeSplitValidate(t *testing.T) {
+	treasury := common.HexToAddress("0x01")
+	cases := []struct {
+		split *FeeSplit
+		valid bool
+	}{
+		{&FeeSplit{}, true},
+		{&FeeSplit{BurnRate: FeeSplitBase}, true},
+		{&FeeSplit{BurnRate: 3000, TreasuryRate: 7000, Treasury: treasury}, true},
+		{&FeeSplit{BurnRate: 3000, TreasuryRate: 7001, Treasury: treasury}, false},
+		{&FeeSplit{BurnRate: FeeSplitBase + 1}, false},
+		{&FeeSplit{TreasuryRate: 100}, false},
+	}
+	for i, c := range cases {
+		assert.Equal(t, c.valid, c.split.Validate() == nil, i)
+	}
+
+	burn, paid := (&FeeSplit{BurnRate: 2500, TreasuryRate: 1000, Treasury: treasury}).Split(big.NewInt(999))
+	assert.Equal(t, big.NewInt(249), burn)
+	assert.Equal(t, big.NewInt(99), paid)
+}
+
+func TestFeeSplit(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+	contract := func(caller common.Address, height int) *native.NativeContract {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		return ctx
+	}
+	setFeeSplit := func(split *MethodSetFeeSplitInput, height int) error {
+		payload, err := split.Encode()
+		assert.NoError(t, err)
+		for _, caller := range testGenesisEpoch.MemberList()[:testGenesisEpoch.QuorumSize()] {
+			if _, _, err := contract(caller, height).ContractRef().NativeCall(caller, this, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	getFeeSplit := func() *MethodFeeSplitOutput {
+		payload, err := utils.PackMethod(ABI, MethodFeeSplit)
+		assert.NoError(t, err)
+		enc, _, err := contract(testCaller, 10).ContractRef().NativeCall(testCaller, this, payload)
+		assert.NoError(t, err)
+		output := new(MethodFeeSplitOutput)
+		assert.NoError(t, output.Decode(enc))
+		return output
+	}
+	split := &MethodSetFeeSplitInput{BurnRate: 2000, TreasuryRate: 3000, Treasury: common.HexToAddress("0x01")}
+	assert.Equal(t, ErrGovV2NotActivated, setFeeSplit(split, 9))
+	assert.Equal(t, ErrInvalidFeeSplit, setFeeSplit(&MethodSetFeeSplitInput{TreasuryRate: 3000}, 10))
+	assert.NoError(t, setFeeSplit(split, 10))
+	assert.Equal(t, &MethodFeeSplitOutput{BurnRate: 2000, TreasuryRate: 3000, Treasury: split.Treasury}, getFeeSplit())
+
+	// the split in force is readable out of the native contract context
+	read, err := ReadFeeSplit(testStateDB)
+	assert.NoError(t, err)
+	assert.Equal(t, &FeeSplit{BurnRate: 2000, TreasuryRate: 3000, Treasury: split.Treasury, Nonce: 1}, read)
+
+	// the signs of the same change are distinguished by nonce
+	assert.NoError(t, setFeeSplit(&MethodSetFeeSplitInput{}, 12))
+	assert.Equal(t, &MethodFeeSplitOutput{}, getFeeSplit())
+	assert.NoError(t, setFeeSplit(split, 13))
+	read, err = ReadFeeSplit(testStateDB)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), read.Nonce)
+}
+
+func TestDutyFeeWaiver(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+	member := testGenesisEpoch.MemberList()[0]
+	// signs the fee split change alone, so that the quorum is never reached
+	sign := func(burnRate uint64, height int) (bool, error) {
+		testStateDB.Finalise(false)
+		payload, err := (&MethodSetFeeSplitInput{BurnRate: burnRate}).Encode()
+		assert.NoError(t, err)
+		ctx := generateNativeContract(member, height)
+		ctx.ContractRef().SetChainConfig(config)
+		_, _, err = ctx.ContractRef().NativeCall(member, this, payload)
+		return testStateDB.FeeWaived(), err
+	}
+
+	// the fee is waived since governance v2
+	waived, err := sign(1, 9)
+	assert.Equal(t, ErrGovV2NotActivated, err)
+	assert.False(t, waived)
+	waived, err = sign(1, 10)
+	assert.NoError(t, err)
+	assert.True(t, waived)
+
+	// the failed duty is charged
+	waived, err = sign(1, 10)
+	assert.Equal(t, ErrDuplicateSigner, err)
+	assert.False(t, waived)
+
+	// the free duties of each member are bounded in an epoch
+	for i := uint64(2); i <= MaxFreeDutiesPerEpoch; i++ {
+		waived, err = sign(i, 10)
+		assert.NoError(t, err)
+		assert.True(t, waived)
+	}
+	waived, err = sign(MaxFreeDutiesPerEpoch+1, 10)
+	assert.NoError(t, err)
+	assert.False(t, waived)
+	assert.Equal(t, MaxFreeDutiesPerEpoch, getFreeDuties(testEmptyCtx, StartEpoch, member))
+	assert.Equal(t, uint64(0), getFreeDuties(testEmptyCtx, StartEpoch, testGenesisEpoch.MemberList()[1]))
+}
@@ -0,0 +1,557 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// MaxGroupNameLen is the max length of the name of auxiliary validator group.
+const MaxGroupNameLen = 32
+
+// Besides the main consensus, node manager manages the auxiliary validator groups by name, e.g: the
+// attestor sets of the multisig mode. each group has its own epochs, proposals, votes and quorum
+// rule, which are kept in the same records as the main consensus in the namespace of the group.
+// the first epoch of a group is proposed and voted by the validators of the main consensus, and
+// the following epochs by the members of the group itself. the quorum rule of a group is changed
+// by the consensus signs of the main validators. it takes effect since governance v2.
+
+// ProposeGroup proposes the next epoch of the validator group, the group is created once its first
+// epoch passed.
+func ProposeGroup(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight().Uint64()
+	proposer := s.ContractRef().TxOrigin()
+	logger := logger.New("txHash", s.ContractRef().TxHash())
+
+	input := new(MethodProposeGroupInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("proposeGroup", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	name := input.Name
+	if err := validateGroupName(name); err != nil {
+		return utils.ByteFailed, err
+	}
+	cur, electorate, quorum, err := groupElectorate(s, name)
+	if err != nil {
+		logger.Trace("proposeGroup", "get electorate failed", err, "group", name)
+		return utils.ByteFailed, err
+	}
+	epochID := StartEpoch
+	if cur != nil {
+		epochID = cur.ID + 1
+	}
+	logger = logger.New("group", name, "epochID", epochID)
+	if err := checkAuthority(s, proposer, ctx.Caller, electorate); err != nil {
+		logger.Trace("proposeGroup", "check authority failed", err, "tx origin", proposer.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	// the group is allowed to be smaller than the main consensus, but its quorum must be reachable
+	peers := input.Peers
+	if peers == nil || len(peers.List) == 0 || len(peers.List) > MaxProposalPeersLen {
+		logger.Trace("proposeGroup", "check peers number", "out of range")
+		return utils.ByteFailed, ErrPeersNum
+	}
+	for _, peer := range peers.List {
+		if err := CheckPeer(peer); err != nil {
+			logger.Trace("proposeGroup", "check peer public key", err)
+			return utils.ByteFailed, ErrInvalidPubKey
+		}
+	}
+	next := &EpochInfo{Peers: peers}
+	if len(next.Members()) != len(peers.List) {
+		logger.Trace("proposeGroup", "check peers", "duplicate peer")
+		return utils.ByteFailed, ErrInvalidPeers
+	}
+	rule, err := readGroupQuorumRule(s.GetCacheDB(), name)
+	if err != nil {
+		logger.Trace("proposeGroup", "get quorum rule failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if size := rule.Size(len(peers.List)); size > len(peers.List) {
+		logger.Trace("proposeGroup", "check peers", "quorum not reachable", "quorum", size)
+		return utils.ByteFailed, ErrInvalidPeers
+	}
+	if cur != nil && cur.OldMemberNum(peers) < quorum {
+		logger.Trace("proposeGroup", "check old members", "proposal peers should contain quorum of old members")
+		return utils.ByteFailed, ErrOldParticipantsNumber
+	}
+
+	// proposal start height should be in range of [height + minEpochValidPeriod, height + maxEpochValidPeriod]
+	startHeight := input.StartHeight
+	if startHeight > 0 {
+		latestStartHeight := height + MinEpochValidPeriod
+		farawayStartHeight := height + MaxEpochValidPeriod
+		if startHeight < latestStartHeight || startHeight > farawayStartHeight {
+			logger.Trace("proposeGroup", "check start height", fmt.Errorf("proposal start height should be in range of [%d,  %d]",
+				latestStartHeight, farawayStartHeight))
+			return utils.ByteFailed, ErrProposalStartHeight
+		}
+	} else {
+		startHeight = height + DefaultEpochValidPeriod
+	}
+	sort.Sort(peers)
+	epoch := &EpochInfo{
+		ID:          epochID,
+		Peers:       peers,
+		StartHeight: startHeight,
+		Proposer:    proposer,
+		Status:      ProposalStatusPropose,
+		Version:     epochHashVersion(s),
+	}
+	proposal := epoch.Hash()
+
+	// check duplicate proposal and proposer's proposals number
+	proposals, err := getGroupProposals(s, name, epochID)
+	if err != nil {
+		logger.Trace("proposeGroup", "get proposals failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	num := 0
+	for _, v := range proposals {
+		if v == proposal {
+			logger.Trace("proposeGroup", "check proposal hash, dump proposal", proposal.Hex())
+			return utils.ByteFailed, ErrDuplicateProposal
+		}
+		if other, err := getGroupEpoch(s, name, v); err == nil && other.Proposer == proposer {
+			num++
+		}
+	}
+	if num >= MaxProposalNumPerEpoch {
+		logger.Trace("proposeGroup", "check proposer proposal number, expect < ", MaxProposalNumPerEpoch, "got", num)
+		return utils.ByteFailed, ErrProposalsNum
+	}
+
+	if err := storeGroupEpoch(s, name, epoch); err != nil {
+		logger.Trace("proposeGroup", "store epoch failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := storeGroupProposals(s, name, epochID, append(proposals, proposal)); err != nil {
+		logger.Trace("proposeGroup", "store proposal hash failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := storeGroupElectorate(s, name, proposal, &Electorate{EpochHash: electorate.Hash(), Quorum: uint64(quorum)}); err != nil {
+		logger.Trace("proposeGroup", "store electorate failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindPropose, MethodProposeGroup, proposer, proposal.Bytes()); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitGroupProposed(s, name, epoch); err != nil {
+		logger.Trace("proposeGroup", "emit event log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+
+	// vote to self proposal, which passes immediately if the quorum of group is one
+	if err := castGroupVote(s, logger, name, cur, electorate, epoch, proposer, quorum); err != nil {
+		return utils.ByteFailed, err
+	}
+	logger.Debug("proposeGroup", "proposer", proposer, "proposal", proposal, "epoch", epoch.String())
+	return (&MethodBoolOutput{Success: true}).Encode(MethodProposeGroup)
+}
+
+// VoteGroup votes to the proposal of the next epoch of the validator group.
+func VoteGroup(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight().Uint64()
+	voter := s.ContractRef().TxOrigin()
+	logger := logger.New("txHash", s.ContractRef().TxHash())
+
+	input := new(MethodVoteGroupInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("voteGroup", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	name := input.Name
+	if err := validateGroupName(name); err != nil {
+		return utils.ByteFailed, err
+	}
+	cur, electorate, _, err := groupElectorate(s, name)
+	if err != nil {
+		logger.Trace("voteGroup", "get electorate failed", err, "group", name)
+		return utils.ByteFailed, err
+	}
+	logger = logger.New("group", name, "epochID", input.EpochID)
+	if err := checkAuthority(s, voter, ctx.Caller, electorate); err != nil {
+		logger.Trace("voteGroup", "check authority failed", err, "voter", voter.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	expectEpochID := StartEpoch
+	if cur != nil {
+		expectEpochID = cur.ID + 1
+	}
+	if input.EpochID != expectEpochID {
+		logger.Trace("voteGroup", "check epoch ID failed, expect", expectEpochID, "got", input.EpochID)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	proposals, err := getGroupProposals(s, name, input.EpochID)
+	if err != nil {
+		logger.Trace("voteGroup", "get proposals failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	found := false
+	for _, v := range proposals {
+		found = found || v == input.Hash
+	}
+	if !found {
+		logger.Trace("voteGroup", "find proposal failed", input.Hash.Hex())
+		return utils.ByteFailed, ErrProposalNotExist
+	}
+	epoch, err := getGroupEpoch(s, name, input.Hash)
+	if err != nil {
+		logger.Trace("voteGroup", "get epoch failed", input.Hash.Hex())
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	if epoch.Status == ProposalStatusPassed {
+		return utils.ByteFailed, ErrProposalPassed
+	}
+	if epoch.Status == ProposalStatusRejected {
+		return utils.ByteFailed, ErrProposalRejected
+	}
+	if height+MinVoteEffectivePeriod >= epoch.StartHeight {
+		logger.Trace("voteGroup", "too late to change epoch", "start height", epoch.StartHeight)
+		return utils.ByteFailed, ErrVoteHeight
+	}
+
+	// votes are counted with the electorate recorded at proposal time
+	recorded, err := getGroupElectorate(s, name, input.Hash)
+	if err != nil {
+		logger.Trace("voteGroup", "get electorate failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if recorded.EpochHash != electorate.Hash() {
+		logger.Trace("voteGroup", "electorate changed, expect", recorded.EpochHash.Hex(), "got", electorate.Hash().Hex())
+		return utils.ByteFailed, ErrElectorateChanged
+	}
+	if err := castGroupVote(s, logger, name, cur, electorate, epoch, voter, int(recorded.Quorum)); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodVoteGroup)
+}
+
+// castGroupVote records the vote to the proposal of group and withdraws the last vote of the voter
+// to the other proposal of the same epoch, the proposal passes once the votes reached quorum. the
+// duplicate vote and the votes after quorum reached are ignored.
+func castGroupVote(s *native.NativeContract, logger log.Logger, name string, cur, electorate, epoch *EpochInfo, voter common.Address, quorum int) error {
+	epochID, proposal := epoch.ID, epoch.Hash()
+	votes, err := getGroupVotes(s, name, proposal)
+	if err != nil {
+		logger.Trace("voteGroup", "get votes failed", err)
+		return ErrStorage
+	}
+	if len(votes) >= quorum {
+		return nil
+	}
+	last := findGroupVoteTo(s, name, epochID, voter)
+	if last == proposal {
+		logger.Trace("voteGroup", "check vote", "duplicate vote", "proposal", proposal.Hex(), "voter", voter.Hex())
+		return nil
+	}
+	if last != common.EmptyHash {
+		lastVotes, err := getGroupVotes(s, name, last)
+		if err != nil {
+			logger.Trace("voteGroup", "get last votes failed", err)
+			return ErrStorage
+		}
+		remaining := make([]common.Address, 0, len(lastVotes))
+		for _, v := range lastVotes {
+			if v != voter {
+				remaining = append(remaining, v)
+			}
+		}
+		if err := storeGroupVotes(s, name, last, remaining); err != nil {
+			logger.Trace("voteGroup", "delete last voted proposal failed", err, "proposal", last.Hex())
+			return ErrStorage
+		}
+	}
+
+	storeGroupVoteTo(s, name, epochID, voter, proposal)
+	votes = append(votes, voter)
+	if err := storeGroupVotes(s, name, proposal, votes); err != nil {
+		logger.Trace("voteGroup", "store vote failed", err)
+		return ErrStorage
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindVote, MethodVoteGroup, voter, proposal.Bytes()); err != nil {
+		return ErrStorage
+	}
+	if err := emitGroupVoted(s, name, epochID, proposal, len(votes), electorate.Peers.Len()); err != nil {
+		logger.Trace("voteGroup", "emit voted log failed", err)
+		return ErrEmitLog
+	}
+	logger.Debug("voteGroup", "voter", voter, "proposal", proposal)
+	if len(votes) < quorum {
+		return nil
+	}
+	return passGroupProposal(s, logger, name, cur, epoch, voter)
+}
+
+// passGroupProposal changes the epoch of group to the proposal reached quorum, the other proposals
+// of the same epoch are rejected, and the vote state of all of them is cleared.
+func passGroupProposal(s *native.NativeContract, logger log.Logger, name string, cur, epoch *EpochInfo, executor common.Address) error {
+	epoch.Status = ProposalStatusPassed
+	if err := storeGroupEpoch(s, name, epoch); err != nil {
+		logger.Trace("voteGroup", "store passed epoch failed", err)
+		return ErrStorage
+	}
+	storeGroupCurrentEpochHash(s, name, epoch.Hash())
+	storeGroupEpochProof(s, name, epoch.ID, epoch.Hash())
+	if cur == nil {
+		names, err := getGroups(s)
+		if err != nil {
+			logger.Trace("voteGroup", "get groups failed", err)
+			return ErrStorage
+		}
+		if err := storeGroups(s, append(names, name)); err != nil {
+			logger.Trace("voteGroup", "store groups failed", err)
+			return ErrStorage
+		}
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, MethodVoteGroup, executor, epoch.Hash().Bytes()); err != nil {
+		return ErrStorage
+	}
+	if err := emitGroupEpochChange(s, name, cur, epoch); err != nil {
+		logger.Trace("voteGroup", "emit epoch change log failed", err)
+		return ErrEmitLog
+	}
+
+	proposals, err := getGroupProposals(s, name, epoch.ID)
+	if err != nil {
+		logger.Trace("voteGroup", "get proposals failed", err)
+		return ErrStorage
+	}
+	for _, v := range proposals {
+		voters, _ := getGroupVotes(s, name, v)
+		for _, voter := range voters {
+			delGroupVoteTo(s, name, epoch.ID, voter)
+		}
+		if err := storeGroupVotes(s, name, v, nil); err != nil {
+			return ErrStorage
+		}
+		delGroupElectorate(s, name, v)
+		if v == epoch.Hash() {
+			continue
+		}
+		rejected, err := getGroupEpoch(s, name, v)
+		if err != nil {
+			logger.Trace("voteGroup", "get rejected epoch failed", err, "hash", v.Hex())
+			return ErrEpochNotExist
+		}
+		rejected.Status = ProposalStatusRejected
+		if err := storeGroupEpoch(s, name, rejected); err != nil {
+			logger.Trace("voteGroup", "store rejected epoch failed", err)
+			return ErrStorage
+		}
+	}
+	logger.Info("Validator group epoch proposal passed", "proposal", epoch.Hash(), "startHeight", epoch.StartHeight)
+	return nil
+}
+
+func GroupEpoch(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodGroupEpochInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("groupEpoch", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	epoch, err := GetGroupEpoch(s, input.Name)
+	if err != nil {
+		logger.Trace("groupEpoch", "get group epoch failed", err, "group", input.Name)
+		return utils.ByteFailed, err
+	}
+	return limitOutput((&MethodGroupEpochOutput{Epoch: epoch}).Encode())
+}
+
+func GroupProof(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodGroupProofInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("groupProof", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	hash := getGroupEpochProof(s, input.Name, input.EpochID)
+	if hash == common.EmptyHash {
+		return utils.ByteFailed, ErrEpochProofNotExist
+	}
+	return (&MethodGroupProofOutput{Hash: hash}).Encode()
+}
+
+func Groups(s *native.NativeContract) ([]byte, error) {
+	names, err := getGroups(s)
+	if err != nil {
+		logger.Trace("groups", "get groups failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if names == nil {
+		names = make([]string, 0)
+	}
+	return (&MethodGroupsOutput{Names: names}).Encode()
+}
+
+func GroupQuorumRule(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodGroupQuorumRuleInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("groupQuorumRule", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	rule, err := readGroupQuorumRule(s.GetCacheDB(), input.Name)
+	if err != nil {
+		logger.Trace("groupQuorumRule", "get quorum rule failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodGroupQuorumRuleOutput{Numerator: rule.Numerator, Denominator: rule.Denominator, Strict: rule.Strict, Threshold: rule.Threshold}
+	return output.Encode()
+}
+
+// SetGroupQuorumRule validators of the main consensus change the quorum rule of the validator
+// group, the rule must be reachable by the current epoch of the group, and it takes effect
+// immediately after the consensus signs reached quorum.
+func SetGroupQuorumRule(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodSetGroupQuorumRuleInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("setGroupQuorumRule", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	cur, err := GetGroupEpoch(s, input.Name)
+	if err != nil {
+		logger.Trace("setGroupQuorumRule", "get group epoch failed", err, "group", input.Name)
+		return utils.ByteFailed, err
+	}
+	rule, err := readGroupQuorumRule(s.GetCacheDB(), input.Name)
+	if err != nil {
+		logger.Trace("setGroupQuorumRule", "get quorum rule failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	next := &QuorumRule{Numerator: input.Numerator, Denominator: input.Denominator, Strict: input.Strict, Threshold: input.Threshold, Nonce: rule.Nonce + 1}
+	if err := next.Validate(); err != nil {
+		logger.Trace("setGroupQuorumRule", "invalid rule", input)
+		return utils.ByteFailed, err
+	}
+	if next.Size(cur.Peers.Len()) > cur.Peers.Len() {
+		logger.Trace("setGroupQuorumRule", "quorum not reachable by group", input.Name)
+		return utils.ByteFailed, ErrInvalidQuorumRule
+	}
+
+	sign := append(utils.GetUint64Bytes(rule.Nonce), ctx.Payload...)
+	ok, err := CheckConsensusSigns(s, MethodSetGroupQuorumRule, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodSetGroupQuorumRule)
+	}
+	if err := storeGroupQuorumRule(s, input.Name, next); err != nil {
+		logger.Trace("setGroupQuorumRule", "store quorum rule failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitGroupQuorumRuleChanged(s, input.Name, next); err != nil {
+		logger.Trace("setGroupQuorumRule", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSetGroupQuorumRule)
+}
+
+// GetGroupEpoch returns the current epoch of the validator group, ErrGroupNotExist is returned if
+// the group is never created.
+func GetGroupEpoch(s *native.NativeContract, name string) (*EpochInfo, error) {
+	return ReadGroupEpoch(s.StateDB(), name)
+}
+
+// ReadGroupEpoch reads the current epoch of the validator group from state directly, it's used out
+// of the native contract context.
+func ReadGroupEpoch(s *state.StateDB, name string) (*EpochInfo, error) {
+	db := (*state.CacheDB)(s)
+	hash, err := readGroupCurrentEpochHash(db, name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrGroupNotExist
+	} else if err != nil {
+		return nil, err
+	}
+	epoch, err := readGroupEpoch(db, name, hash)
+	if err != nil {
+		return nil, fmt.Errorf("read epoch of group %s failed: %v", name, err)
+	}
+	return epoch, nil
+}
+
+// GroupQuorumSize returns the quorum size of the epoch of validator group under its quorum rule,
+// all members are required if the rule is unreadable.
+func GroupQuorumSize(s *native.NativeContract, name string, epoch *EpochInfo) int {
+	if epoch == nil || epoch.Peers == nil {
+		return 0
+	}
+	rule, err := readGroupQuorumRule(s.GetCacheDB(), name)
+	if err != nil {
+		logger.Error("get group quorum rule failed", "group", name, "err", err)
+		return epoch.Peers.Len()
+	}
+	return rule.Size(epoch.Peers.Len())
+}
+
+// groupElectorate returns the current epoch of the group, nil if the group is not created yet, and
+// the epoch of which the members decide the next epoch of the group with the quorum size.
+func groupElectorate(s *native.NativeContract, name string) (cur, electorate *EpochInfo, quorum int, err error) {
+	hash, err := readGroupCurrentEpochHash(s.GetCacheDB(), name)
+	if errors.Is(err, ErrNotFound) {
+		main, err := GetCurrentEpoch(s)
+		if err != nil {
+			return nil, nil, 0, ErrEpochNotExist
+		}
+		return nil, main, QuorumSize(s, main), nil
+	} else if err != nil {
+		return nil, nil, 0, ErrStorage
+	}
+	if cur, err = getGroupEpoch(s, name, hash); err != nil {
+		return nil, nil, 0, ErrEpochNotExist
+	}
+	return cur, cur, GroupQuorumSize(s, name, cur), nil
+}
+
+// validateGroupName checks the name of validator group, which consists of lowercase letters,
+// digits and underscores.
+func validateGroupName(name string) error {
+	if len(name) == 0 || len(name) > MaxGroupNameLen {
+		return ErrInvalidGroupName
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return ErrInvalidGroupName
+		}
+	}
+	return nil
+}
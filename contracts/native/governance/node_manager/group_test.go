@@ -0,0 +1,127 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorGroup(t *testing.T) {
+	config := &params.ChainConfig{GovV2Block: big.NewInt(0)}
+	call := func(caller common.Address, height int, payload []byte) ([]byte, error) {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		ret, _, err := ctx.ContractRef().NativeCall(caller, this, payload)
+		return ret, err
+	}
+
+	resetTestContext()
+	name := "attestor"
+	members := testGenesisEpoch.MemberList()
+	groupPeers := generateTestPeers(4)
+	sort.Sort(groupPeers)
+	propose, err := (&MethodProposeGroupInput{Name: name, StartHeight: 500, Peers: groupPeers}).Encode()
+	assert.NoError(t, err)
+
+	// not available before governance v2
+	config = &params.ChainConfig{GovV2Block: big.NewInt(100)}
+	_, err = call(members[0], 10, propose)
+	assert.Equal(t, ErrGovV2NotActivated, err)
+	config = &params.ChainConfig{GovV2Block: big.NewInt(0)}
+
+	for _, invalid := range []string{"", "Attestor", "a-b", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		payload, err := (&MethodProposeGroupInput{Name: invalid, StartHeight: 500, Peers: groupPeers}).Encode()
+		assert.NoError(t, err)
+		_, err = call(members[0], 10, payload)
+		assert.Equal(t, ErrInvalidGroupName, err)
+	}
+
+	// the first epoch of group is decided by the main validators
+	_, err = call(groupPeers.List[0].Address, 10, propose)
+	assert.Equal(t, ErrInvalidAuthority, err)
+	_, err = call(members[0], 10, propose)
+	assert.NoError(t, err)
+	_, err = call(members[1], 10, propose)
+	assert.Equal(t, ErrDuplicateProposal, err)
+
+	first := &EpochInfo{ID: StartEpoch, Peers: groupPeers, StartHeight: 500, Proposer: members[0], Status: ProposalStatusPropose}
+	vote, err := (&MethodVoteGroupInput{Name: name, EpochID: StartEpoch, Hash: first.Hash()}).Encode()
+	assert.NoError(t, err)
+	quorum := testGenesisEpoch.QuorumSize()
+	for _, v := range members[1:quorum] {
+		_, err = GetGroupEpoch(testEmptyCtx, name)
+		assert.Equal(t, ErrGroupNotExist, err)
+		_, err = call(v, 20, vote)
+		assert.NoError(t, err)
+	}
+
+	query, err := (&MethodGroupEpochInput{Name: name}).Encode()
+	assert.NoError(t, err)
+	ret, err := call(members[0], 21, query)
+	assert.NoError(t, err)
+	output := new(MethodGroupEpochOutput)
+	assert.NoError(t, output.Decode(ret))
+	assert.Equal(t, StartEpoch, output.Epoch.ID)
+	assert.Equal(t, ProposalStatusPassed, output.Epoch.Status)
+	assert.Equal(t, first.Hash(), getGroupEpochProof(testEmptyCtx, name, StartEpoch))
+	names, err := getGroups(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{name}, names)
+
+	// the main consensus is not affected
+	cur, err := GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, testGenesisEpoch.Hash(), cur.Hash())
+
+	// the following epochs are decided by the members of group
+	nextPeers := groupPeers.Copy()
+	nextPeers.List[3] = generateTestPeer()
+	propose, err = (&MethodProposeGroupInput{Name: name, StartHeight: 600, Peers: nextPeers}).Encode()
+	assert.NoError(t, err)
+	_, err = call(members[0], 30, propose)
+	assert.Equal(t, ErrInvalidAuthority, err)
+	_, err = call(groupPeers.List[0].Address, 30, propose)
+	assert.NoError(t, err)
+
+	next, err := GetGroupEpoch(testEmptyCtx, name)
+	assert.NoError(t, err)
+	proposals, err := getGroupProposals(testEmptyCtx, name, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(proposals))
+	vote, err = (&MethodVoteGroupInput{Name: name, EpochID: StartEpoch + 1, Hash: proposals[0]}).Encode()
+	assert.NoError(t, err)
+	groupQuorum := GroupQuorumSize(testEmptyCtx, name, next)
+	for _, peer := range groupPeers.List[1:groupQuorum] {
+		_, err = call(peer.Address, 40, vote)
+		assert.NoError(t, err)
+	}
+	next, err = GetGroupEpoch(testEmptyCtx, name)
+	assert.NoError(t, err)
+	assert.Equal(t, StartEpoch+1, next.ID)
+	assert.Equal(t, proposals[0], next.Hash())
+	cur, err = GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, testGenesisEpoch.Hash(), cur.Hash())
+}
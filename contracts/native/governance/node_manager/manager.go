@@ -19,30 +19,99 @@
 package node_manager
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 )
 
 var epochChangeFeed event.Feed
 
+// logger is tagged with `module=node_manager`, the calls of proposals and votes add the
+// `epochID` and `txHash` fields.
+var logger = utils.NewLogger(utils.LogModuleNodeManager)
+
 func SubscribeEpochChange(ch chan<- types.EpochChangeEvent) event.Subscription {
 	return epochChangeFeed.Subscribe(ch)
 }
 
+func newEpochChangeEvent(prevHash common.Hash, epoch *EpochInfo, quorum int) types.EpochChangeEvent {
+	ev := types.EpochChangeEvent{
+		EpochID:     epoch.ID,
+		StartHeight: epoch.StartHeight,
+		Validators:  epoch.MemberList(),
+		QuorumSize:  quorum,
+		PrevHash:    prevHash,
+		Hash:        epoch.Hash(),
+	}
+	if epoch.Peers == nil {
+		return ev
+	}
+	for _, v := range epoch.Peers.List {
+		// public keys had been checked while proposing
+		pubKey, _ := hexutil.Decode(v.PubKey)
+		ev.Peers = append(ev.Peers, types.EpochValidator{Address: v.Address, PubKey: pubKey})
+	}
+	return ev
+}
+
 var (
 	gasTable = map[string]uint64{
-		MethodContractName: 0,
-		MethodPropose:      30000,
-		MethodVote:         30000,
-		MethodEpoch:        0,
+		MethodContractName:   0,
+		MethodPropose:        30000,
+		MethodVote:           30000,
+		MethodEpoch:          0,
+		MethodProposals:      0,
+		MethodPeersLimit:     0,
+		MethodSetPeersLimit:  30000,
+		MethodQuorumRule:     0,
+		MethodSetQuorumRule:  30000,
+		MethodSeed:           0,
+		MethodEpochSeed:      0,
+		MethodRegisterVrfKey: 30000,
+		MethodSubmitVrf:      30000,
+		MethodVrfKey:         0,
+		MethodVrfOutput:      0,
+		MethodPayouts:        0,
+		MethodFeeSplit:       0,
+		MethodSetFeeSplit:    30000,
+
+		MethodProposeWithActions: 30000,
+		MethodProposalActions:    0,
+		MethodSealVoting:         30000,
+		MethodVotingSeal:         0,
+		MethodCommitVote:         30000,
+		MethodRevealVote:         30000,
+		MethodEject:              30000,
+		MethodMisconduct:         0,
+		MethodSweepExpired:       30000,
+		MethodProposeGroup:       30000,
+		MethodVoteGroup:          30000,
+		MethodGroupEpoch:         0,
+		MethodGroupProof:         0,
+		MethodGroups:             0,
+		MethodGroupQuorumRule:    0,
+		MethodSetGroupQuorumRule: 30000,
+		MethodSignEpochChange:    30000,
+		MethodEpochChangeSigns:   0,
+		MethodEpochPeers:         0,
 	}
+
+	// the bounds of arguments checked before dispatching, the handlers still validate the values
+	hashRule      = &native.ArgRule{NonZero: true, MaxBytes: common.HashLength}
+	groupNameRule = &native.ArgRule{NonZero: true, MaxBytes: MaxGroupNameLen}
+	addressRule   = &native.ArgRule{NonZero: true}
+	pageRule      = &native.ArgRule{Max: new(big.Int).SetUint64(MaxEpochPeersPage)}
 )
 
 const (
@@ -52,6 +121,11 @@ const (
 	MinProposalPeersLen     int    = 4   // F = 1, n >= 3f + 1
 	MaxProposalPeersLen     int    = 100 // F = 33
 	MaxProposalNumPerEpoch  int    = 3   // 每个共识节点每个epoch最多有3次提案
+	MaxEpochPeersPage       uint64 = 32  // max number of peers returned by `epochPeers` in a page
+
+	// max size of the output of epoch queries, so that the contracts calling them are never grieved
+	// by unbounded return data, larger peer sets have to be read page by page with `epochPeers`.
+	MaxQueryOutputSize = 24 * 1024
 
 	// 提案生成后必须在有效时间内完成投票，否则无法实现change epoch
 	MinVoteEffectivePeriod uint64 = 10 // 一轮epoch投票成功后，共识切换需要一定的时间间隔
@@ -59,17 +133,66 @@ const (
 
 func InitNodeManager() {
 	InitABI()
+	native.RegisterABI(native.NativeNodeManager, "NodeManager", abijson)
 	native.Contracts[this] = RegisterNodeManagerContract
+	native.RegisterFinalizeHook(native.NativeNodeManager, FinalizeBlockGas, FinalizeBlock)
 }
 
 func RegisterNodeManagerContract(s *native.NativeContract) {
 	s.Prepare(ABI, gasTable)
 
-	s.Register(MethodContractName, Name)
+	s.RegisterQuery(MethodContractName, Name)
 	s.Register(MethodPropose, Propose)
 	s.Register(MethodVote, Vote)
-	s.Register(MethodEpoch, Epoch)
-	s.Register(MethodProof, EpochProof)
+	s.RegisterQuery(MethodEpoch, Epoch)
+	s.RegisterQuery(MethodProof, EpochProof)
+	s.RegisterQuery(MethodProposals, Proposals)
+	s.RegisterQuery(MethodPeersLimit, GetPeersLimit)
+	s.Register(MethodSetPeersLimit, SetPeersLimit)
+	s.RegisterQuery(MethodQuorumRule, GetQuorumRule)
+	s.Register(MethodSetQuorumRule, SetQuorumRule)
+	s.RegisterQuery(MethodSeed, Seed)
+	s.RegisterQuery(MethodEpochSeed, EpochSeed)
+	s.Register(MethodRegisterVrfKey, RegisterVrfKey)
+	s.Register(MethodSubmitVrf, SubmitVrf)
+	s.RegisterQuery(MethodVrfKey, VrfKey)
+	s.RegisterQuery(MethodVrfOutput, VrfOutput)
+	s.RegisterQuery(MethodPayouts, Payouts)
+	s.RegisterQuery(MethodFeeSplit, GetFeeSplit)
+	s.Register(MethodSetFeeSplit, SetFeeSplit)
+	s.Register(MethodProposeWithActions, ProposeWithActions)
+	s.RegisterQuery(MethodProposalActions, ProposalActions)
+	s.Register(MethodSealVoting, SealVoting)
+	s.RegisterQuery(MethodVotingSeal, GetVotingSeal)
+	s.Register(MethodCommitVote, CommitVote)
+	s.Register(MethodRevealVote, RevealVote)
+	s.Register(MethodEject, Eject)
+	s.RegisterQuery(MethodMisconduct, Misconduct)
+	s.Register(MethodSweepExpired, SweepExpired)
+	s.Register(MethodProposeGroup, ProposeGroup)
+	s.Register(MethodVoteGroup, VoteGroup)
+	s.RegisterQuery(MethodGroupEpoch, GroupEpoch)
+	s.RegisterQuery(MethodGroupProof, GroupProof)
+	s.RegisterQuery(MethodGroups, Groups)
+	s.RegisterQuery(MethodGroupQuorumRule, GroupQuorumRule)
+	s.Register(MethodSetGroupQuorumRule, SetGroupQuorumRule)
+	s.Register(MethodSignEpochChange, SignEpochChange)
+	s.RegisterQuery(MethodEpochChangeSigns, GetEpochChangeSigns)
+	s.RegisterQuery(MethodEpochPeers, EpochPeers)
+
+	s.RegisterRules(MethodVote, native.MethodRules{"Hash": hashRule})
+	s.RegisterRules(MethodRevealVote, native.MethodRules{"Hash": hashRule})
+	s.RegisterRules(MethodProposalActions, native.MethodRules{"Hash": hashRule})
+	s.RegisterRules(MethodProposeWithActions, native.MethodRules{"Actions": {MaxLen: MaxProposalActions}})
+	s.RegisterRules(MethodEject, native.MethodRules{"Validator": addressRule})
+	s.RegisterRules(MethodProposeGroup, native.MethodRules{"Name": groupNameRule})
+	s.RegisterRules(MethodVoteGroup, native.MethodRules{"Name": groupNameRule, "Hash": hashRule})
+	s.RegisterRules(MethodGroupEpoch, native.MethodRules{"Name": groupNameRule})
+	s.RegisterRules(MethodGroupProof, native.MethodRules{"Name": groupNameRule})
+	s.RegisterRules(MethodGroupQuorumRule, native.MethodRules{"Name": groupNameRule})
+	s.RegisterRules(MethodSetGroupQuorumRule, native.MethodRules{"Name": groupNameRule})
+	s.RegisterRules(MethodEpochPeers, native.MethodRules{"Hash": {MaxBytes: common.HashLength}, "Limit": pageRule})
+	s.RegisterRules(MethodSignEpochChange, native.MethodRules{"Signature": {NonZero: true, MaxBytes: crypto.SignatureLength}})
 }
 
 func Name(s *native.NativeContract) ([]byte, error) {
@@ -82,22 +205,24 @@ func Propose(s *native.NativeContract) ([]byte, error) {
 	height := s.ContractRef().BlockHeight().Uint64()
 	proposer := s.ContractRef().TxOrigin()
 	caller := ctx.Caller
+	logger := logger.New("txHash", s.ContractRef().TxHash())
 
 	// check authority
 	curEpoch, err := GetCurrentEpoch(s)
 	if err != nil {
-		log.Trace("checkConsensusSign", "get current epoch failed", err)
+		logger.Trace("checkConsensusSign", "get current epoch failed", err)
 		return utils.ByteFailed, ErrEpochNotExist
 	}
-	if err := checkAuthority(proposer, caller, curEpoch); err != nil {
-		log.Trace("propose", "check authority failed", err, "tx origin", proposer.Hex())
+	logger = logger.New("epochID", curEpoch.ID+1)
+	if err := checkAuthority(s, proposer, caller, curEpoch); err != nil {
+		logger.Trace("propose", "check authority failed", err, "tx origin", proposer.Hex())
 		return utils.ByteFailed, ErrInvalidAuthority
 	}
 
 	// decode input
-	input := new(MethodProposeInput)
-	if err := input.Decode(ctx.Payload); err != nil {
-		log.Trace("propose", "decode input failed", err)
+	input, err := decodeProposeInput(ctx.Payload)
+	if err != nil {
+		logger.Trace("propose", "decode input failed", err)
 		return utils.ByteFailed, ErrInvalidInput
 	}
 
@@ -105,34 +230,66 @@ func Propose(s *native.NativeContract) ([]byte, error) {
 	startHeight := input.StartHeight
 	// check peers, try to match all peer's public key and address
 	if peers == nil || peers.List == nil || len(peers.List) == 0 {
-		log.Trace("propose", "check peers", "peer list is nil")
+		logger.Trace("propose", "check peers", "peer list is nil")
 		return utils.ByteFailed, ErrInvalidPeers
 	}
 	if len(peers.List) < MinProposalPeersLen || len(peers.List) > MaxProposalPeersLen {
-		log.Trace("propose", "check peers number",
+		logger.Trace("propose", "check peers number",
 			fmt.Errorf("propose, peers length should be in range of [%d, %d]",
 				MinProposalPeersLen, MaxProposalPeersLen))
 		return utils.ByteFailed, ErrPeersNum
 	}
 	for _, peer := range peers.List {
-		if err := checkPeer(peer); err != nil {
-			log.Trace("propose", "check peer public key", "public key not match address")
+		if err := CheckPeer(peer); err != nil {
+			logger.Trace("propose", "check peer public key", "public key not match address")
 			return utils.ByteFailed, ErrInvalidPubKey
 		}
 	}
 
-	// check peers, number for proposal's peers should be at least 2/3 of old members
-	if curEpoch.OldMemberNum(peers) < curEpoch.QuorumSize() {
-		log.Trace("propose", "check old members", "proposal peers should be at least 2/3 old members")
+	if err := checkActions(s, input.Actions); err != nil {
+		logger.Trace("propose", "check actions failed", err)
+		return utils.ByteFailed, err
+	}
+
+	// sample the members with epoch seed if the candidates exceeds target size of validator set
+	// since governance v2
+	limit, err := getPeersLimit(s)
+	if err != nil {
+		logger.Trace("propose", "get peers limit failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if s.ContractRef().IsGovV2() && limit.Target > 0 && uint64(len(peers.List)) > limit.Target {
+		sort.Sort(peers)
+		candidates := make([]*Candidate, len(peers.List))
+		for i, v := range peers.List {
+			candidates[i] = &Candidate{Peer: v, Weight: 1}
+		}
+		list, err := SampleCommittee(getEpochSeed(s, curEpoch), candidates, int(limit.Target))
+		if err != nil {
+			logger.Trace("propose", "sample committee failed", err)
+			return utils.ByteFailed, ErrInvalidPeers
+		}
+		peers = &Peers{List: list}
+	}
+
+	// check peers, number of old members in proposal's peers should reach the quorum
+	if curEpoch.OldMemberNum(peers) < QuorumSize(s, curEpoch) {
+		logger.Trace("propose", "check old members", "proposal peers should contain quorum of old members")
 		return utils.ByteFailed, ErrOldParticipantsNumber
 	}
 
+	// check peers, the change of validator set should be limited
+	if err := limit.Check(curEpoch, peers); err != nil {
+		logger.Trace("propose", "check peers limit failed", err, "target", limit.Target, "max change", limit.MaxChange)
+		return utils.ByteFailed, err
+	}
+
 	// proposal start height should be in range of [height + minEpochValidPeriod, height + maxEpochValidPeriod]
 	if startHeight > 0 {
 		latestStartHeight := height + MinEpochValidPeriod
 		farawayStartHeight := height + MaxEpochValidPeriod
 		if startHeight < latestStartHeight || startHeight > farawayStartHeight {
-			log.Trace("propose", "check start height", fmt.Errorf("propose, proposal start height should be in range of [%d,  %d]",
+			logger.Trace("propose", "check start height", fmt.Errorf("propose, proposal start height should be in range of [%d,  %d]",
 				latestStartHeight, farawayStartHeight))
 			return utils.ByteFailed, ErrProposalStartHeight
 		}
@@ -149,42 +306,68 @@ func Propose(s *native.NativeContract) ([]byte, error) {
 		StartHeight: startHeight,
 		Proposer:    proposer,
 		Status:      ProposalStatusPropose,
+		Version:     epochHashVersion(s),
 	}
 	proposal := epoch.Hash()
 
 	// check duplicate proposal and validator's proposals number
 	if checkProposal(s, epochID, proposal) {
-		log.Trace("propose", "check proposal hash, dump proposal", proposal.Hex())
+		logger.Trace("propose", "check proposal hash, dump proposal", proposal.Hex())
 		return utils.ByteFailed, ErrDuplicateProposal
 	}
 	if num := proposalsNum(s, epochID, proposer); num >= MaxProposalNumPerEpoch {
-		log.Trace("propose", "check validator proposal number, expect < ", MaxProposalNumPerEpoch, "got", num)
+		logger.Trace("propose", "check validator proposal number, expect < ", MaxProposalNumPerEpoch, "got", num)
 		return utils.ByteFailed, ErrProposalsNum
 	}
 
 	if err := storeEpoch(s, epoch); err != nil {
-		log.Trace("propose", "store epoch failed", err)
+		logger.Trace("propose", "store epoch failed", err)
 		return utils.ByteFailed, ErrStorage
 	}
 	if err := storeProposal(s, epoch.ID, epoch.Hash()); err != nil {
-		log.Trace("propose", "store proposal hash failed", err)
+		logger.Trace("propose", "store proposal hash failed", err)
 		return utils.ByteFailed, ErrStorage
 	}
+	if s.ContractRef().IsGovV2() {
+		if err := storeElectorate(s, proposal, newElectorate(s, curEpoch)); err != nil {
+			logger.Trace("propose", "store electorate failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+	}
+	if len(input.Actions) > 0 {
+		if err := storeActions(s, proposal, input.Actions); err != nil {
+			logger.Trace("propose", "store actions failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+	}
 
-	// vote to self proposal
-	if err := storeVote(s, proposal, proposer); err != nil {
-		log.Trace("propose", "store vote failed", err)
+	// vote to self proposal, and withdraw the vote to other proposal of the same epoch. the
+	// proposer commits and reveals its vote as the others while the voting is sealed.
+	if !isVotingSealed(s, epochID) {
+		if lastVote := findVoteTo(s, epochID, proposer); lastVote != common.EmptyHash {
+			if err := deleteVote(s, lastVote, proposer); err != nil {
+				logger.Trace("propose", "delete last voted proposal failed", err, "proposal", lastVote.Hex(), "proposer", proposer.Hex())
+				return utils.ByteFailed, ErrStorage
+			}
+		}
+		if err := storeVote(s, proposal, proposer); err != nil {
+			logger.Trace("propose", "store vote failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+		storeVoteTo(s, epochID, proposer, proposal)
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindPropose, MethodPropose, proposer, proposal.Bytes()); err != nil {
 		return utils.ByteFailed, ErrStorage
 	}
-	storeVoteTo(s, epochID, proposer, proposal)
 
 	// emit event log
 	if err := emitEventProposed(s, epoch); err != nil {
-		log.Trace("propose", "emit event log failed", err)
+		logger.Trace("propose", "emit event log failed", err)
 		return utils.ByteFailed, ErrEmitLog
 	}
+	waiveDutyFee(s, curEpoch.ID, proposer)
 
-	log.Debug("propose", "validator send an proposal", proposer.Hex(), "epoch", epoch.String())
+	logger.Debug("propose", "proposer", proposer, "proposal", proposal, "epoch", epoch.String())
 	return utils.ByteSuccess, nil
 }
 
@@ -194,132 +377,280 @@ func Vote(s *native.NativeContract) ([]byte, error) {
 	voter := s.ContractRef().TxOrigin()
 	caller := ctx.Caller
 	height := s.ContractRef().BlockHeight().Uint64()
+	logger := logger.New("txHash", s.ContractRef().TxHash())
 
 	// check authority
 	curEpoch, err := GetCurrentEpoch(s)
 	if err != nil {
-		log.Trace("checkConsensusSign", "get current epoch failed", err)
+		logger.Trace("checkConsensusSign", "get current epoch failed", err)
 		return utils.ByteFailed, ErrEpochNotExist
 	}
-	if err := checkAuthority(voter, caller, curEpoch); err != nil {
-		log.Trace("vote", "check authority failed", err, "voter", voter.Hex())
+	logger = logger.New("epochID", curEpoch.ID+1)
+	if err := checkAuthority(s, voter, caller, curEpoch); err != nil {
+		logger.Trace("vote", "check authority failed", err, "voter", voter.Hex())
 		return utils.ByteFailed, ErrInvalidAuthority
 	}
 
 	// decode and check epoch info
 	input := new(MethodVoteInput)
 	if err := input.Decode(ctx.Payload); err != nil {
-		log.Trace("vote", "decode input failed", err)
+		logger.Trace("vote", "decode input failed", err)
 		return utils.ByteFailed, ErrInvalidInput
 	}
-	epochID := input.EpochID
-	proposal := input.Hash
+	if isVotingSealed(s, input.EpochID) {
+		logger.Trace("vote", "voting sealed", "votes should be committed and revealed")
+		return utils.ByteFailed, ErrVotingSealed
+	}
+	epoch, quorum, err := checkVote(s, logger, curEpoch, input.EpochID, input.Hash, height)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	proposal := epoch.Hash()
 
+	// already reach quorum size
+	sizeBeforeVote := voteSize(s, proposal)
+	if sizeBeforeVote >= quorum {
+		logger.Trace("vote", "check size", "already reach quorum size", "num", sizeBeforeVote, "quorum size", quorum)
+		return utils.ByteSuccess, nil
+	}
+
+	sizeAfterVote, voted, err := castVote(s, logger, curEpoch, epoch, voter, MethodVote)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !voted {
+		return utils.ByteSuccess, nil
+	}
+	waiveDutyFee(s, curEpoch.ID, voter)
+
+	if sizeAfterVote == quorum {
+		if err := passProposal(s, logger, curEpoch, epoch, voter); err != nil {
+			return utils.ByteFailed, err
+		}
+	}
+	return utils.ByteSuccess, nil
+}
+
+// checkVote checks that the proposal of the next epoch is open to vote at the height, and returns
+// the proposal with the quorum size of its electorate.
+func checkVote(s *native.NativeContract, logger log.Logger, curEpoch *EpochInfo, epochID uint64, proposal common.Hash, height uint64) (*EpochInfo, int, error) {
 	if expectEpochID := curEpoch.ID + 1; epochID != expectEpochID {
-		log.Trace("vote", "check epoch ID failed, expect", expectEpochID, "got", curEpoch.ID)
-		return utils.ByteFailed, ErrInvalidInput
+		logger.Trace("vote", "check epoch ID failed, expect", expectEpochID, "got", curEpoch.ID)
+		if isRejected(s, epochID, proposal) {
+			return nil, 0, ErrProposalRejected
+		}
+		return nil, 0, ErrInvalidInput
 	}
 	if !findProposal(s, epochID, proposal) {
-		log.Trace("vote", "find proposal failed", proposal.Hex())
-		return utils.ByteFailed, ErrProposalNotExist
+		logger.Trace("vote", "find proposal failed", proposal.Hex())
+		return nil, 0, ErrProposalNotExist
 	}
 	epoch, err := getEpoch(s, proposal)
 	if err != nil {
-		log.Trace("vote", "get epoch failed", proposal.Hex())
-		return utils.ByteFailed, ErrEpochNotExist
+		logger.Trace("vote", "get epoch failed", proposal.Hex())
+		return nil, 0, ErrEpochNotExist
 	}
 	if epoch.Status == ProposalStatusPassed {
-		log.Trace("vote", "epoch status err", "proposal already passed", "epoch", epoch.Hash().Hex(), "epoch ID", epoch.ID)
-		return utils.ByteFailed, ErrProposalPassed
+		logger.Trace("vote", "epoch status err", "proposal already passed", "epoch", epoch.Hash().Hex(), "epoch ID", epoch.ID)
+		return nil, 0, ErrProposalPassed
+	}
+	if epoch.Status == ProposalStatusRejected {
+		logger.Trace("vote", "epoch status err", "proposal already rejected", "epoch", epoch.Hash().Hex(), "epoch ID", epoch.ID)
+		return nil, 0, ErrProposalRejected
 	}
 	if epochID != epoch.ID {
-		log.Trace("vote", "check epoch id failed, expect", epoch.ID, "got", epochID)
-		return utils.ByteFailed, ErrInvalidEpoch
+		logger.Trace("vote", "check epoch id failed, expect", epoch.ID, "got", epochID)
+		return nil, 0, ErrInvalidEpoch
 	}
 	if proposal != epoch.Hash() {
-		log.Trace("vote", "check epoch hash failed, expect", proposal.Hex(), "got", epoch.Hash().Hex())
-		return utils.ByteFailed, ErrInvalidEpoch
+		logger.Trace("vote", "check epoch hash failed, expect", proposal.Hex(), "got", epoch.Hash().Hex())
+		return nil, 0, ErrInvalidEpoch
 	}
 
 	// vote should be finished before start height
 	if height+MinVoteEffectivePeriod >= epoch.StartHeight {
-		log.Trace("vote", "too late to change epoch", "consensus need some time to restart")
-		return utils.ByteFailed, ErrVoteHeight
+		logger.Trace("vote", "too late to change epoch", "consensus need some time to restart")
+		return nil, 0, ErrVoteHeight
 	}
 
-	// already reach quorum size
-	sizeBeforeVote := voteSize(s, proposal)
-	if sizeBeforeVote >= curEpoch.QuorumSize() {
-		log.Trace("vote", "check size", "already reach quorum size", "num", sizeBeforeVote, "quorum size", curEpoch.QuorumSize())
-		return utils.ByteSuccess, nil
+	// votes are counted with the electorate recorded at proposal time since governance v2
+	if !s.ContractRef().IsGovV2() {
+		return epoch, QuorumSize(s, curEpoch), nil
+	}
+	electorate, err := getElectorate(s, proposal)
+	if errors.Is(err, ErrNotFound) {
+		electorate = newElectorate(s, curEpoch)
+	} else if err != nil {
+		logger.Trace("vote", "get electorate failed", err)
+		return nil, 0, ErrStorage
 	}
+	if electorate.EpochHash != curEpoch.Hash() {
+		logger.Trace("vote", "electorate changed, expect", electorate.EpochHash.Hex(), "got", curEpoch.Hash().Hex())
+		return nil, 0, ErrElectorateChanged
+	}
+	return epoch, int(electorate.Quorum), nil
+}
+
+// castVote records the vote to the proposal and withdraws the last vote of the voter to the other
+// proposal of the same epoch, it returns the votes of the proposal after voting, and whether the
+// vote is recorded, the duplicate vote is ignored.
+func castVote(s *native.NativeContract, logger log.Logger, curEpoch, epoch *EpochInfo, voter common.Address, method string) (int, bool, error) {
+	epochID, proposal := epoch.ID, epoch.Hash()
 
 	// filter duplicate vote or delete old vote
 	lastVote2 := findVoteTo(s, epochID, voter)
 	if lastVote2 != common.EmptyHash {
 		if lastVote2 == proposal {
-			log.Trace("vote", "check vote", "duplicate vote", "proposal", proposal.Hex(), "vote", voter.Hex())
-			return utils.ByteSuccess, nil
+			logger.Trace("vote", "check vote", "duplicate vote", "proposal", proposal.Hex(), "vote", voter.Hex())
+			return voteSize(s, proposal), false, nil
 		}
 		delVoteTo(s, epochID, voter)
-		if err := deleteVote(s, proposal, voter); err != nil {
-			log.Trace("vote", "delete last voted proposal failed", err, "proposal", proposal.Hex(), "vote", voter.Hex())
-			return utils.ByteFailed, ErrStorage
+		if err := deleteVote(s, lastVote2, voter); err != nil {
+			logger.Trace("vote", "delete last voted proposal failed", err, "proposal", lastVote2.Hex(), "vote", voter.Hex())
+			return 0, false, ErrStorage
+		}
+		if err := recordMisconduct(s, curEpoch.ID, voter, MisconductVoteSwitch); err != nil {
+			logger.Trace("vote", "record vote switch failed", err, "vote", voter.Hex())
+			return 0, false, ErrStorage
 		}
 	}
 
-	log.Debug("vote", "validator vote to proposal", epoch.Hash(), "voter", voter.Hex(), "epoch ID", epochID)
+	logger.Debug("vote", "voter", voter, "proposal", proposal)
 	// store vote
-	storeVoteTo(s, input.EpochID, voter, proposal)
+	storeVoteTo(s, epochID, voter, proposal)
 	if err := storeVote(s, proposal, voter); err != nil {
-		log.Trace("vote", "store vote failed", err)
-		return utils.ByteFailed, ErrStorage
+		logger.Trace("vote", "store vote failed", err)
+		return 0, false, ErrStorage
+	}
+
+	if err := audit_log.AddRecord(s, audit_log.KindVote, method, voter, proposal.Bytes()); err != nil {
+		return 0, false, ErrStorage
 	}
 
 	sizeAfterVote := voteSize(s, proposal)
 	groupSize := len(curEpoch.Members())
-	if err := emitEventVoted(s, input.EpochID, proposal, sizeAfterVote, groupSize); err != nil {
-		log.Trace("vote", "emit voted log failed", err)
-		return utils.ByteFailed, ErrEmitLog
+	if err := emitEventVoted(s, epochID, proposal, sizeAfterVote, groupSize); err != nil {
+		logger.Trace("vote", "emit voted log failed", err)
+		return 0, false, ErrEmitLog
 	}
+	return sizeAfterVote, true, nil
+}
 
-	// change epoch point:
-	// 1. update status and store current epoch
-	// 2. store current epoch proof
-	// 3. emit event log
-	// 4. dirty job which used to clear all useless storage
-	// 5. pub epoch change event to miner worker
-	if sizeAfterVote == curEpoch.QuorumSize() {
-		epoch.Status = ProposalStatusPassed
-		if err := storeEpoch(s, epoch); err != nil {
-			log.Trace("vote", "store passed epoch failed", err)
-			return utils.ByteFailed, ErrStorage
-		}
+// passProposal changes the epoch to the proposal reached quorum, it's executed by `executor`:
+// 1. update status and store current epoch
+// 2. store current epoch proof
+// 3. emit event log
+// 4. reject the other proposals of the same epoch
+// 5. dirty job which used to clear all useless storage, and queue the expiry of the proposals
+// 6. execute the actions attached to the proposal
+// 7. pub epoch change event to miner worker
+func passProposal(s *native.NativeContract, logger log.Logger, curEpoch, epoch *EpochInfo, executor common.Address) error {
+	epoch.Status = ProposalStatusPassed
+	if err := storeEpoch(s, epoch); err != nil {
+		logger.Trace("vote", "store passed epoch failed", err)
+		return ErrStorage
+	}
 
-		storeCurrentEpochHash(s, epoch.Hash())
-		storeEpochProof(s, epoch.ID, epoch.Hash())
-		if err := emitEpochChange(s, curEpoch, epoch); err != nil {
-			log.Trace("vote", "emit epoch change log failed", err)
-			return utils.ByteFailed, ErrEmitLog
+	storeCurrentEpochHash(s, epoch.Hash())
+	storeEpochProof(s, epoch.ID, epoch.Hash())
+	if s.ContractRef().IsGovV2() {
+		storeEpochSeed(s, epoch.ID, NextEpochSeed(getEpochSeed(s, curEpoch), epoch.Hash(), s.ContractRef().TxHash()))
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, MethodVote, executor, epoch.Hash().Bytes()); err != nil {
+		return ErrStorage
+	}
+	if err := emitEpochChange(s, curEpoch, epoch); err != nil {
+		logger.Trace("vote", "emit epoch change log failed", err)
+		return ErrEmitLog
+	}
+	if s.ContractRef().IsGovV2() {
+		if err := rejectProposals(s, epoch); err != nil {
+			return err
 		}
+		dirtyJob(s, epoch)
+	} else {
+		legacyDirtyJob(s, curEpoch, epoch)
+	}
+	if err := expireAfter(s, &ExpiryEntry{Kind: ExpiryProposals, EpochID: epoch.ID}, ProposalRetention); err != nil {
+		logger.Trace("vote", "queue proposals expiry failed", err)
+		return ErrStorage
+	}
+	if err := executeActions(s, epoch); err != nil {
+		return err
+	}
 
-		dirtyJob(s, curEpoch, epoch)
+	epochChangeFeed.Send(newEpochChangeEvent(curEpoch.Hash(), epoch, QuorumSize(s, epoch)))
 
-		epochChangeFeed.Send(types.EpochChangeEvent{
-			EpochID:     epoch.StartHeight,
-			StartHeight: epoch.StartHeight,
-			Validators:  epoch.MemberList(),
-			Hash:        epoch.Hash(),
-		})
+	logger.Info("Epoch proposal passed", "proposal", epoch.Hash(), "startHeight", epoch.StartHeight)
+	return nil
+}
 
-		log.Debug("vote", "proposal passed", epoch.Hash())
+// isRejected reports whether the proposal was rejected by another one passed in its epoch, the
+// epoch has moved on by then, so that the rejection is only seen with the epoch of the proposal.
+func isRejected(s *native.NativeContract, epochID uint64, proposal common.Hash) bool {
+	if !findProposal(s, epochID, proposal) {
+		return false
 	}
+	epoch, err := getEpoch(s, proposal)
+	return err == nil && epoch.Status == ProposalStatusRejected
+}
 
-	return utils.ByteSuccess, nil
+func newElectorate(s *native.NativeContract, epoch *EpochInfo) *Electorate {
+	return &Electorate{EpochHash: epoch.Hash(), Quorum: uint64(QuorumSize(s, epoch))}
+}
+
+// rejectProposals marks the other proposals of the passed epoch as rejected, the first proposal
+// reached quorum wins, and the losers are kept with their tallies as the final disposition. the
+// rejections are accounted to the misconduct reports of the proposers.
+func rejectProposals(s *native.NativeContract, passed *EpochInfo) error {
+	proposals, _ := getProposals(s, passed.ID)
+	for _, v := range proposals {
+		if v == passed.Hash() {
+			continue
+		}
+		epoch, err := getEpoch(s, v)
+		if err != nil {
+			logger.Trace("vote", "get rejected epoch failed", err, "hash", v.Hex())
+			return ErrEpochNotExist
+		}
+		epoch.Status = ProposalStatusRejected
+		if err := storeEpoch(s, epoch); err != nil {
+			logger.Trace("vote", "store rejected epoch failed", err)
+			return ErrStorage
+		}
+		if err := emitProposalRejected(s, epoch.ID, v, voteSize(s, v), passed.Hash()); err != nil {
+			logger.Trace("vote", "emit proposal rejected log failed", err)
+			return ErrEmitLog
+		}
+		if err := recordMisconduct(s, passed.ID-1, epoch.Proposer, MisconductRejectedProposal); err != nil {
+			logger.Trace("vote", "record rejected proposal failed", err, "proposer", epoch.Proposer.Hex())
+			return ErrStorage
+		}
+	}
+	return nil
 }
 
-// dirtyJob filter current epoch and clear storage of `epoch`, `proposal`, `vote`, `voteTo`
-func dirtyJob(s *native.NativeContract, last, cur *EpochInfo) {
+// dirtyJob clear the vote state of all proposals of the passed epoch `cur`. the voters of each
+// proposal bucket are iterated to drop their `voteTo`, so that the proposers joined mid-stream
+// and the voters of winner are cleared as well. the final tallies are recorded before the votes
+// dropped, and the proposals are kept as the final disposition.
+func dirtyJob(s *native.NativeContract, cur *EpochInfo) {
+	proposals, _ := getProposals(s, cur.ID)
+	for _, v := range proposals {
+		voters, _ := getVotes(s, v)
+		for _, voter := range voters {
+			delVoteTo(s, cur.ID, voter)
+		}
+		storeTally(s, v, uint64(len(voters)))
+		clearVotes(s, v)
+		delElectorate(s, v)
+	}
+}
+
+// legacyDirtyJob is the cleanup before governance v2, the other proposals of the passed epoch
+// `cur` are deleted with their votes, and the `voteTo` of the validators of epoch `last` are
+// cleared only if there is any other proposal.
+func legacyDirtyJob(s *native.NativeContract, last, cur *EpochInfo) {
 	proposals, _ := getProposals(s, cur.ID)
 	for _, v := range proposals {
 		if v == cur.Hash() {
@@ -328,7 +659,7 @@ func dirtyJob(s *native.NativeContract, last, cur *EpochInfo) {
 
 		delEpoch(s, v)
 		if err := delProposal(s, cur.ID, v); err != nil {
-			log.Error("vote", "dirty job failed", err)
+			logger.Error("vote", "dirty job failed", err)
 		}
 
 		clearVotes(s, v)
@@ -343,29 +674,482 @@ func dirtyJob(s *native.NativeContract, last, cur *EpochInfo) {
 func Epoch(s *native.NativeContract) ([]byte, error) {
 	epoch, err := GetCurrentEpoch(s)
 	if err != nil {
-		log.Trace("checkConsensusSign", "get current epoch failed", err)
+		logger.Trace("checkConsensusSign", "get current epoch failed", err)
 		return utils.ByteFailed, ErrEpochNotExist
 	}
 
 	output := &MethodEpochOutput{Epoch: epoch}
-	return output.Encode()
+	return limitOutput(output.Encode())
 }
 
 func EpochProof(s *native.NativeContract) ([]byte, error) {
 	epoch, err := GetCurrentEpoch(s)
 	if err != nil {
-		log.Trace("checkConsensusSign", "get current epoch failed", err)
+		logger.Trace("checkConsensusSign", "get current epoch failed", err)
 		return utils.ByteFailed, ErrEpochNotExist
 	}
 	proof, err := getEpochProof(s, epoch.ID)
 	if err != nil {
-		log.Trace("epoch proof", "get current epoch proof failed", err)
+		logger.Trace("epoch proof", "get current epoch proof failed", err)
 		return utils.ByteFailed, ErrEpochProofNotExist
 	}
 	output := &MethodProofOutput{Hash: proof}
 	return output.Encode()
 }
 
+// EpochPeers returns the peers of the epoch in the index range of [Start, Start+Limit) with the
+// total number of peers, the current epoch is read if the hash is empty, and the page is
+// `MaxEpochPeersPage` if the limit is zero.
+func EpochPeers(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodEpochPeersInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("epochPeers", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	var (
+		epoch *EpochInfo
+		err   error
+	)
+	if input.Hash == common.EmptyHash {
+		epoch, err = GetCurrentEpoch(s)
+	} else {
+		epoch, err = getEpoch(s, input.Hash)
+	}
+	if err != nil {
+		logger.Trace("epochPeers", "get epoch failed", err, "hash", input.Hash.Hex())
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+
+	var list []*PeerInfo
+	if epoch.Peers != nil {
+		list = epoch.Peers.List
+	}
+	// the page rule is not enforced before the input rules fork, clamp here as well.
+	limit := input.Limit
+	if limit == 0 || limit > MaxEpochPeersPage {
+		limit = MaxEpochPeersPage
+	}
+	total := uint64(len(list))
+	start := input.Start
+	if start > total {
+		start = total
+	}
+	if rest := total - start; limit > rest {
+		limit = rest
+	}
+	end := start + limit
+	output := &MethodEpochPeersOutput{Total: total, Peers: &Peers{List: list[start:end]}}
+	return output.Encode()
+}
+
+// limitOutput returns ErrOutputTooLarge if the encoded output exceeds MaxQueryOutputSize.
+func limitOutput(enc []byte, err error) ([]byte, error) {
+	if err == nil && len(enc) > MaxQueryOutputSize {
+		return utils.ByteFailed, ErrOutputTooLarge
+	}
+	return enc, err
+}
+
+// Proposals returns the disposition of every proposal of the epoch id, proposals which can not be
+// voted any more before passed are reported as expired.
+func Proposals(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodProposalsInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("proposals", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	height := s.ContractRef().BlockHeight().Uint64()
+	proposals, _ := getProposals(s, input.EpochID)
+	list := make([]*ProposalDisposition, 0, len(proposals))
+	for _, v := range proposals {
+		epoch, err := getEpoch(s, v)
+		if err != nil {
+			logger.Trace("proposals", "get epoch failed", err, "hash", v.Hex())
+			return utils.ByteFailed, ErrEpochNotExist
+		}
+		status := epoch.Status
+		if status == ProposalStatusPropose && height+MinVoteEffectivePeriod >= epoch.StartHeight {
+			status = ProposalStatusExpired
+		}
+		list = append(list, &ProposalDisposition{Hash: v, Proposer: epoch.Proposer, Status: status, Votes: getTally(s, v)})
+	}
+	return (&MethodProposalsOutput{Proposals: list}).Encode()
+}
+
+// Seed returns the randomness of current epoch.
+func Seed(s *native.NativeContract) ([]byte, error) {
+	epoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("seed", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	output := &MethodSeedOutput{Seed: getEpochSeed(s, epoch)}
+	return output.Encode()
+}
+
+// EpochSeed returns the randomness of the passed epoch with specific id.
+func EpochSeed(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodEpochSeedInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("epochSeed", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	epoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("epochSeed", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	// the genesis epoch has no proof, history epochs should be found by proof
+	if input.EpochID != epoch.ID {
+		hash, err := getEpochProof(s, input.EpochID)
+		if err != nil {
+			logger.Trace("epochSeed", "get epoch proof failed", err, "epoch", input.EpochID)
+			return utils.ByteFailed, ErrEpochNotExist
+		}
+		if epoch, err = getEpoch(s, hash); err != nil {
+			logger.Trace("epochSeed", "get epoch failed", err, "epoch", input.EpochID)
+			return utils.ByteFailed, ErrEpochNotExist
+		}
+	}
+	output := &MethodEpochSeedOutput{Seed: getEpochSeed(s, epoch)}
+	return output.Encode()
+}
+
+// RegisterVrfKey validator register or rotate the vrf public key, the output and proof of
+// `VrfKeyMessage` should be provided to prove the possession of the private key.
+func RegisterVrfKey(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	validator := s.ContractRef().MsgSender()
+
+	input := new(MethodRegisterVrfKeyInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("registerVrfKey", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	pk, err := checkVrfKey(input.PubKey)
+	if err != nil {
+		logger.Trace("registerVrfKey", "check vrf key failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrInvalidVrfKey
+	}
+	if err := verifyVrf(pk, VrfKeyMessage(validator), input.Output, input.Proof); err != nil {
+		logger.Trace("registerVrfKey", "verify vrf failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrInvalidVrfProof
+	}
+
+	storeVrfKey(s, validator, input.PubKey)
+	return (&MethodRegisterVrfKeyOutput{Success: true}).Encode()
+}
+
+// SubmitVrf validator of current epoch submit the vrf output of the epoch seed, the output
+// can be submitted only once in an epoch and is used by consensus to elect proposers.
+func SubmitVrf(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	validator := s.ContractRef().TxOrigin()
+	caller := ctx.Caller
+
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("submitVrf", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	if err := checkAuthority(s, validator, caller, curEpoch); err != nil {
+		logger.Trace("submitVrf", "check authority failed", err, "tx origin", validator.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	input := new(MethodSubmitVrfInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("submitVrf", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.EpochID != curEpoch.ID {
+		logger.Trace("submitVrf", "check epoch id failed", "expect", curEpoch.ID, "got", input.EpochID)
+		return utils.ByteFailed, ErrInvalidEpoch
+	}
+	if _, err := getVrfOutput(s, curEpoch.ID, validator); err == nil {
+		logger.Trace("submitVrf", "vrf output already exist", validator.Hex())
+		return utils.ByteFailed, ErrDuplicateVrf
+	}
+
+	enc, err := getVrfKey(s, validator)
+	if err != nil {
+		logger.Trace("submitVrf", "get vrf key failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrVrfKeyNotExist
+	}
+	pk, err := checkVrfKey(enc)
+	if err != nil {
+		logger.Trace("submitVrf", "check vrf key failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrInvalidVrfKey
+	}
+	if err := verifyVrf(pk, VrfEpochMessage(getEpochSeed(s, curEpoch)), input.Output, input.Proof); err != nil {
+		logger.Trace("submitVrf", "verify vrf failed", err, "validator", validator.Hex())
+		return utils.ByteFailed, ErrInvalidVrfProof
+	}
+
+	storeVrfOutput(s, curEpoch.ID, validator, input.Output)
+	if err := emitVrfSubmitted(s, curEpoch.ID, validator, input.Output); err != nil {
+		logger.Trace("submitVrf", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodSubmitVrfOutput{Success: true}).Encode()
+}
+
+func VrfKey(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodVrfKeyInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("vrfKey", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	pubKey, err := getVrfKey(s, input.Validator)
+	if err != nil {
+		logger.Trace("vrfKey", "get vrf key failed", err, "validator", input.Validator.Hex())
+		return utils.ByteFailed, ErrVrfKeyNotExist
+	}
+	return (&MethodVrfKeyOutput{PubKey: pubKey}).Encode()
+}
+
+func VrfOutput(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodVrfOutputInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("vrfOutput", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	output, err := getVrfOutput(s, input.EpochID, input.Validator)
+	if err != nil {
+		logger.Trace("vrfOutput", "get vrf output failed", err, "epoch", input.EpochID, "validator", input.Validator.Hex())
+		return utils.ByteFailed, ErrVrfNotExist
+	}
+	return (&MethodVrfOutputOutput{Output: output}).Encode()
+}
+
+func GetPeersLimit(s *native.NativeContract) ([]byte, error) {
+	limit, err := getPeersLimit(s)
+	if err != nil {
+		logger.Trace("peersLimit", "get peers limit failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodPeersLimitOutput{Target: limit.Target, MaxChange: limit.MaxChange}
+	return output.Encode()
+}
+
+// SetPeersLimit validators change the validator set size limit, it takes effect after the
+// consensus signs reached quorum.
+func SetPeersLimit(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	limit, err := nextPeersLimit(s, ctx.Payload)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	sign := append(utils.GetUint64Bytes(limit.Nonce-1), ctx.Payload...)
+	ok, err := CheckConsensusSigns(s, MethodSetPeersLimit, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodSetPeersLimitOutput{Success: true}).Encode()
+	}
+	if err := changePeersLimit(s, limit); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodSetPeersLimitOutput{Success: true}).Encode()
+}
+
+// nextPeersLimit decodes the payload of `setPeersLimit`, and returns the peers limit to be set.
+func nextPeersLimit(s *native.NativeContract, payload []byte) (*PeersLimit, error) {
+	input := new(MethodSetPeersLimitInput)
+	if err := input.Decode(payload); err != nil {
+		logger.Trace("setPeersLimit", "decode input failed", err)
+		return nil, ErrInvalidInput
+	}
+	if input.Target != 0 && (input.Target < uint64(MinProposalPeersLen) || input.Target > uint64(MaxProposalPeersLen)) {
+		logger.Trace("setPeersLimit", "target out of range", input.Target)
+		return nil, ErrInvalidPeersLimit
+	}
+	limit, err := getPeersLimit(s)
+	if err != nil {
+		logger.Trace("setPeersLimit", "get peers limit failed", err)
+		return nil, ErrStorage
+	}
+	return &PeersLimit{Target: input.Target, MaxChange: input.MaxChange, Nonce: limit.Nonce + 1}, nil
+}
+
+func changePeersLimit(s *native.NativeContract, limit *PeersLimit) error {
+	if err := storePeersLimit(s, limit); err != nil {
+		logger.Trace("setPeersLimit", "store peers limit failed", err)
+		return ErrStorage
+	}
+	if err := emitPeersLimitChanged(s, limit); err != nil {
+		logger.Trace("setPeersLimit", "emit event failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+func GetQuorumRule(s *native.NativeContract) ([]byte, error) {
+	rule, err := getQuorumRule(s)
+	if err != nil {
+		logger.Trace("quorumRule", "get quorum rule failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodQuorumRuleOutput{Numerator: rule.Numerator, Denominator: rule.Denominator, Strict: rule.Strict, Threshold: rule.Threshold}
+	return output.Encode()
+}
+
+// SetQuorumRule validators change the quorum rule, the consensus signs of the change itself are
+// counted with the rule in force, and the new rule takes effect immediately after that.
+func SetQuorumRule(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	rule, err := nextQuorumRule(s, ctx.Payload)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+
+	sign := append(utils.GetUint64Bytes(rule.Nonce-1), ctx.Payload...)
+	ok, err := CheckConsensusSigns(s, MethodSetQuorumRule, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodSetQuorumRuleOutput{Success: true}).Encode()
+	}
+	if err := changeQuorumRule(s, rule); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodSetQuorumRuleOutput{Success: true}).Encode()
+}
+
+// nextQuorumRule decodes the payload of `setQuorumRule`, and returns the quorum rule to be set.
+func nextQuorumRule(s *native.NativeContract, payload []byte) (*QuorumRule, error) {
+	input := new(MethodSetQuorumRuleInput)
+	if err := input.Decode(payload); err != nil {
+		logger.Trace("setQuorumRule", "decode input failed", err)
+		return nil, ErrInvalidInput
+	}
+	rule, err := getQuorumRule(s)
+	if err != nil {
+		logger.Trace("setQuorumRule", "get quorum rule failed", err)
+		return nil, ErrStorage
+	}
+	next := &QuorumRule{Numerator: input.Numerator, Denominator: input.Denominator, Strict: input.Strict, Threshold: input.Threshold, Nonce: rule.Nonce + 1}
+	if err := next.Validate(); err != nil {
+		logger.Trace("setQuorumRule", "invalid rule", input)
+		return nil, err
+	}
+	return next, nil
+}
+
+func changeQuorumRule(s *native.NativeContract, rule *QuorumRule) error {
+	if err := storeQuorumRule(s, rule); err != nil {
+		logger.Trace("setQuorumRule", "store quorum rule failed", err)
+		return ErrStorage
+	}
+	if err := emitQuorumRuleChanged(s, rule); err != nil {
+		logger.Trace("setQuorumRule", "emit event failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+func GetFeeSplit(s *native.NativeContract) ([]byte, error) {
+	split, err := getFeeSplit(s)
+	if err != nil {
+		logger.Trace("feeSplit", "get fee split failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodFeeSplitOutput{BurnRate: split.BurnRate, TreasuryRate: split.TreasuryRate, Treasury: split.Treasury}
+	return output.Encode()
+}
+
+// SetFeeSplit validators change the split of block fees, it applies to the fees paid since the
+// transaction in which the consensus signs reached quorum.
+func SetFeeSplit(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	split, err := nextFeeSplit(s, ctx.Payload)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+
+	sign := append(utils.GetUint64Bytes(split.Nonce-1), ctx.Payload...)
+	ok, err := CheckConsensusSigns(s, MethodSetFeeSplit, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodSetFeeSplitOutput{Success: true}).Encode()
+	}
+	if err := changeFeeSplit(s, split); err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodSetFeeSplitOutput{Success: true}).Encode()
+}
+
+// nextFeeSplit decodes the payload of `setFeeSplit`, and returns the fee split to be set.
+func nextFeeSplit(s *native.NativeContract, payload []byte) (*FeeSplit, error) {
+	input := new(MethodSetFeeSplitInput)
+	if err := input.Decode(payload); err != nil {
+		logger.Trace("setFeeSplit", "decode input failed", err)
+		return nil, ErrInvalidInput
+	}
+	split, err := getFeeSplit(s)
+	if err != nil {
+		logger.Trace("setFeeSplit", "get fee split failed", err)
+		return nil, ErrStorage
+	}
+	next := &FeeSplit{BurnRate: input.BurnRate, TreasuryRate: input.TreasuryRate, Treasury: input.Treasury, Nonce: split.Nonce + 1}
+	if err := next.Validate(); err != nil {
+		logger.Trace("setFeeSplit", "invalid split", input)
+		return nil, err
+	}
+	return next, nil
+}
+
+func changeFeeSplit(s *native.NativeContract, split *FeeSplit) error {
+	if err := storeFeeSplit(s, split); err != nil {
+		logger.Trace("setFeeSplit", "store fee split failed", err)
+		return ErrStorage
+	}
+	if err := emitFeeSplitChanged(s, split); err != nil {
+		logger.Trace("setFeeSplit", "emit event failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+// signElectorateQuorum returns the quorum size of the consensus sign. since governance v2 the tally
+// is bound to the electorate of its first sign, and restarted if the validator set changed since
+// then, so that signs of different electorates are never mixed.
+func signElectorateQuorum(s *native.NativeContract, hash common.Hash, epoch *EpochInfo) (int, error) {
+	if !s.ContractRef().IsGovV2() {
+		return QuorumSize(s, epoch), nil
+	}
+	electorate, err := getElectorate(s, hash)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		logger.Trace("checkConsensusSign", "get electorate failed", err, "hash", hash.Hex())
+		return 0, ErrStorage
+	}
+	if electorate == nil || electorate.EpochHash != epoch.Hash() {
+		if electorate != nil {
+			logger.Debug("checkConsensusSign", "restart tally of changed electorate", hash.Hex())
+			clearSigner(s, hash)
+		}
+		electorate = newElectorate(s, epoch)
+		if err := storeElectorate(s, hash, electorate); err != nil {
+			logger.Trace("checkConsensusSign", "store electorate failed", err, "hash", hash.Hex())
+			return 0, ErrStorage
+		}
+	}
+	return int(electorate.Quorum), nil
+}
+
 func CheckConsensusSigns(s *native.NativeContract, method string, input []byte, signer common.Address) (bool, error) {
 	ctx := s.ContractRef().CurrentContext()
 	caller := ctx.Caller
@@ -373,55 +1157,75 @@ func CheckConsensusSigns(s *native.NativeContract, method string, input []byte,
 	// get epoch info
 	epoch, err := GetCurrentEpoch(s)
 	if err != nil {
-		log.Trace("checkConsensusSign", "get current epoch failed", err)
+		logger.Trace("checkConsensusSign", "get current epoch failed", err)
 		return false, ErrEpochNotExist
 	}
 
 	// check authority
-	if err := checkAuthority(signer, caller, epoch); err != nil {
-		log.Trace("checkConsensusSign", "check authority failed", err)
+	if err := checkAuthority(s, signer, caller, epoch); err != nil {
+		logger.Trace("checkConsensusSign", "check authority failed", err)
 		return false, ErrInvalidAuthority
 	}
 
 	// get or set consensus sign info
 	sign := &ConsensusSign{Method: method, Input: input}
 	if exist, err := getSign(s, sign.Hash()); err != nil {
-		if err.Error() == "EOF" {
+		if errors.Is(err, ErrNotFound) {
 			if err := storeSign(s, sign); err != nil {
-				log.Trace("checkConsensusSign", "store sign failed", err, "hash", sign.Hash().Hex())
+				logger.Trace("checkConsensusSign", "store sign failed", err, "hash", sign.Hash().Hex())
 				return false, ErrStorage
 			}
 		} else {
-			log.Trace("checkConsensusSign", "get sign failed", err, "hash", sign.Hash().Hex())
+			logger.Trace("checkConsensusSign", "get sign failed", err, "hash", sign.Hash().Hex())
 			return false, ErrConsensusSignNotExist
 		}
 	} else if exist.Hash() != sign.Hash() {
-		log.Trace("checkConsensusSign", "check sign hash failed, expect", exist.Hash().Hex(), "got", sign.Hash().Hex())
+		logger.Trace("checkConsensusSign", "check sign hash failed, expect", exist.Hash().Hex(), "got", sign.Hash().Hex())
 		return false, ErrInvalidSign
 	}
 
+	quorum, err := signElectorateQuorum(s, sign.Hash(), epoch)
+	if err != nil {
+		return false, err
+	}
+
 	// check duplicate signature
 	if findSigner(s, sign.Hash(), signer) {
-		log.Trace("checkConsensusSign", "signer already exist", signer.Hex(), "hash", sign.Hash().Hex())
+		logger.Trace("checkConsensusSign", "signer already exist", signer.Hex(), "hash", sign.Hash().Hex())
 		return false, ErrDuplicateSigner
 	}
 
 	// do not store redundancy sign
 	sizeBeforeSign := getSignerSize(s, sign.Hash())
-	if sizeBeforeSign >= epoch.QuorumSize() {
+	if sizeBeforeSign >= quorum {
 		return true, nil
 	}
 
 	// store signer address and emit event log
 	if err := storeSigner(s, sign.Hash(), signer); err != nil {
-		log.Trace("checkConsensusSign", "store signer failed", err, "hash", sign.Hash().Hex())
+		logger.Trace("checkConsensusSign", "store signer failed", err, "hash", sign.Hash().Hex())
 		return false, ErrStorage
 	}
 	sizeAfterSign := getSignerSize(s, sign.Hash())
 	if err := emitConsensusSign(s, sign, signer, sizeAfterSign); err != nil {
-		log.Trace("checkConsensusSign", "emit consensus sign log failed", err, "hash", sign.Hash().Hex())
+		logger.Trace("checkConsensusSign", "emit consensus sign log failed", err, "hash", sign.Hash().Hex())
 		return false, ErrEmitLog
 	}
 
-	return sizeAfterSign >= epoch.QuorumSize(), nil
+	// record the sign and the approval in audit log
+	if err := audit_log.AddRecord(s, audit_log.KindVote, method, signer, input); err != nil {
+		return false, ErrStorage
+	}
+	if sizeAfterSign == quorum {
+		if err := audit_log.AddRecord(s, audit_log.KindApprove, method, signer, input); err != nil {
+			return false, ErrStorage
+		}
+		if err := expireAfter(s, &ExpiryEntry{Kind: ExpirySign, Hash: sign.Hash()}, SignRetention); err != nil {
+			logger.Trace("checkConsensusSign", "queue sign expiry failed", err, "hash", sign.Hash().Hex())
+			return false, ErrStorage
+		}
+	}
+	waiveDutyFee(s, epoch.ID, signer)
+
+	return sizeAfterSign >= quorum, nil
 }
@@ -20,17 +20,23 @@ package node_manager
 
 import (
 	"crypto/rand"
+	"errors"
+	"math"
 	"math/big"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -206,6 +212,7 @@ func TestPropose(t *testing.T) {
 					StartHeight: c.StartHeight,
 					Peers:       peers,
 					Proposer:    ctx.ContractRef().TxOrigin(),
+					Version:     EpochHashV2,
 				}
 				storeProposal(ctx, epoch.ID, epoch.Hash())
 				c.Payload, _ = input.Encode()
@@ -227,18 +234,21 @@ func TestPropose(t *testing.T) {
 					StartHeight: c.StartHeight + 2,
 					Peers:       peers,
 					Proposer:    proposer,
+					Version:     EpochHashV2,
 				}
 				epoch2 := &EpochInfo{
 					ID:          testGenesisEpoch.ID + 1,
 					StartHeight: c.StartHeight + 3,
 					Peers:       peers,
 					Proposer:    proposer,
+					Version:     EpochHashV2,
 				}
 				epoch3 := &EpochInfo{
 					ID:          testGenesisEpoch.ID + 1,
 					StartHeight: c.StartHeight + 4,
 					Peers:       peers,
 					Proposer:    proposer,
+					Version:     EpochHashV2,
 				}
 				storeProposal(ctx, epoch1.ID, epoch1.Hash())
 				storeEpoch(ctx, epoch1)
@@ -313,13 +323,13 @@ func TestVote(t *testing.T) {
 		peers.List = append(peers.List, newList.List...)
 		sort.Sort(peers)
 
-		c.Epoch = &EpochInfo{StartHeight: c.ProposalStartHeight, Peers: peers, ID: epochID}
+		c.Epoch = &EpochInfo{StartHeight: c.ProposalStartHeight, Peers: peers, ID: epochID, Proposer: c.OldMembers[0], Version: EpochHashV2}
 		input := &MethodProposeInput{StartHeight: c.Epoch.StartHeight, Peers: c.Epoch.Peers}
 		payload, err := input.Encode()
 		if err != nil {
 			t.Fatal(err)
 		}
-		proposer := c.OldMembers[0]
+		proposer := c.Epoch.Proposer
 		ctx := generateNativeContract(proposer, c.ProposeBlockNum)
 		if _, _, err := ctx.ContractRef().NativeCall(proposer, this, payload); err != nil {
 			t.Fatal(err)
@@ -504,7 +514,7 @@ func TestProposalPassed(t *testing.T) {
 	epochID := uint64(2)
 	proposeBlockNum := 9
 	proposalStartHeight := uint64(proposeBlockNum) + MinEpochValidPeriod + 1
-	epoch := &EpochInfo{StartHeight: proposalStartHeight, Peers: peers, ID: epochID, Status: ProposalStatusPropose}
+	epoch := &EpochInfo{StartHeight: proposalStartHeight, Peers: peers, ID: epochID, Status: ProposalStatusPropose, Proposer: oldMembers[0], Version: EpochHashV2}
 	input := &MethodProposeInput{StartHeight: epoch.StartHeight, Peers: epoch.Peers}
 	payload, _ := input.Encode()
 
@@ -516,6 +526,15 @@ func TestProposalPassed(t *testing.T) {
 	curEpoch, err := GetCurrentEpoch(ctx)
 	assert.NoError(t, err)
 
+	// competing proposal of the same epoch
+	rival := &EpochInfo{StartHeight: proposalStartHeight, Peers: testGenesisEpoch.Peers.Copy(), ID: epochID, Proposer: oldMembers[1], Version: EpochHashV2}
+	sort.Sort(rival.Peers)
+	payload, err = (&MethodProposeInput{StartHeight: rival.StartHeight, Peers: rival.Peers}).Encode()
+	assert.NoError(t, err)
+	ctx = generateNativeContract(oldMembers[1], proposeBlockNum)
+	_, _, err = ctx.ContractRef().NativeCall(oldMembers[1], this, payload)
+	assert.NoError(t, err)
+
 	// prepare vote data
 	n := curEpoch.QuorumSize()
 	voteBlockNum := proposeBlockNum + 1
@@ -535,6 +554,9 @@ func TestProposalPassed(t *testing.T) {
 	assert.Equal(t, ProposalStatusPropose, curEpoch.Status)
 
 	// proposal passed
+	ch := make(chan types.EpochChangeEvent, 1)
+	sub := SubscribeEpochChange(ch)
+	defer sub.Unsubscribe()
 	voter := oldMembers[n-1]
 	ctx = generateNativeContract(voter, voteBlockNum)
 	_, _, err = ctx.ContractRef().NativeCall(voter, this, votePayload)
@@ -542,13 +564,449 @@ func TestProposalPassed(t *testing.T) {
 	curEpoch, err = getEpoch(ctx, epoch.Hash())
 	assert.NoError(t, err)
 	assert.Equal(t, ProposalStatusPassed, curEpoch.Status)
+
+	// the competing proposal is rejected, its proposer re-voted to the winner
+	rejected, err := getEpoch(ctx, rival.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalStatusRejected, rejected.Status)
+	payload, err = (&MethodProposalsInput{EpochID: epochID}).Encode()
+	assert.NoError(t, err)
+	enc, _, err := ctx.ContractRef().NativeCall(voter, this, payload)
+	assert.NoError(t, err)
+	proposals := new(MethodProposalsOutput)
+	assert.NoError(t, proposals.Decode(enc))
+	assert.Equal(t, []*ProposalDisposition{
+		{Hash: epoch.Hash(), Proposer: proposer, Status: ProposalStatusPassed, Votes: uint64(n)},
+		{Hash: rival.Hash(), Proposer: oldMembers[1], Status: ProposalStatusRejected, Votes: 0},
+	}, proposals.Proposals)
+	rivalVote, err := (&MethodVoteInput{EpochID: epochID, Hash: rival.Hash()}).Encode()
+	assert.NoError(t, err)
+	rivalVoter := generateNativeContract(oldMembers[2], voteBlockNum)
+	_, _, err = rivalVoter.ContractRef().NativeCall(oldMembers[2], this, rivalVote)
+	assert.Equal(t, ErrProposalRejected, err)
+
+	// epoch change event carries the consensus public keys
+	ev := <-ch
+	assert.Equal(t, epochID, ev.EpochID)
+	assert.Equal(t, proposalStartHeight, ev.StartHeight)
+	assert.Equal(t, testGenesisEpoch.Hash(), ev.PrevHash)
+	assert.Equal(t, epoch.Hash(), ev.Hash)
+	assert.Equal(t, epoch.QuorumSize(), ev.QuorumSize)
+	assert.Equal(t, epoch.MemberList(), ev.Validators)
+	for i, v := range epoch.Peers.List {
+		assert.Equal(t, v.Address, ev.Peers[i].Address)
+		assert.Equal(t, v.PubKey, hexutil.Encode(ev.Peers[i].PubKey))
+	}
+
+	// seed of the new epoch is derived from the last one
+	genesisSeed := getEpochSeed(ctx, testGenesisEpoch)
+	assert.Equal(t, NextEpochSeed(genesisSeed, epoch.Hash(), ctx.ContractRef().TxHash()), getEpochSeed(ctx, curEpoch))
+	payload, err = utils.PackMethod(ABI, MethodSeed)
+	assert.NoError(t, err)
+	enc, _, err = ctx.ContractRef().NativeCall(voter, this, payload)
+	assert.NoError(t, err)
+	output := new(MethodSeedOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, getEpochSeed(ctx, curEpoch), output.Seed)
+}
+
+func TestProposalIdentity(t *testing.T) {
+	var (
+		members []common.Address
+		payload []byte
+	)
+	proposeBlockNum := 9
+	reset := func() {
+		resetTestContext()
+		members = testGenesisEpoch.MemberList()
+		peers := testGenesisEpoch.Peers.Copy()
+		peers.List = append(peers.List, generateTestPeers(1).List...)
+		sort.Sort(peers)
+		payload, _ = (&MethodProposeInput{StartHeight: uint64(proposeBlockNum) + MinEpochValidPeriod + 1, Peers: peers}).Encode()
+	}
+	propose := func(proposer common.Address, config *params.ChainConfig) error {
+		ctx := generateNativeContract(proposer, proposeBlockNum)
+		ctx.ContractRef().SetChainConfig(config)
+		_, _, err := ctx.ContractRef().NativeCall(proposer, this, payload)
+		return err
+	}
+
+	// the same epoch proposed by different validators are different proposals since epoch hash v2
+	reset()
+	assert.NoError(t, propose(members[0], nil))
+	assert.NoError(t, propose(members[1], nil))
+	assert.Equal(t, ErrDuplicateProposal, propose(members[0], nil))
+	proposals, err := getProposals(testEmptyCtx, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(proposals))
+
+	// mutable status never makes the proposal a new one
+	epoch, err := getEpoch(testEmptyCtx, proposals[0])
+	assert.NoError(t, err)
+	epoch.Status = ProposalStatusRejected
+	assert.NoError(t, storeEpoch(testEmptyCtx, epoch))
+	assert.Equal(t, ErrDuplicateProposal, propose(members[0], nil))
+
+	// the proposer is not a part of the identity before v2
+	for _, legacy := range []*params.ChainConfig{{}, {EpochHashV1Block: common.Big0}} {
+		reset()
+		assert.NoError(t, propose(members[0], legacy))
+		assert.Equal(t, ErrDuplicateProposal, propose(members[1], legacy))
+	}
+}
+
+func TestGetEpochChangeEvents(t *testing.T) {
+	resetTestContext()
+
+	// store passed epochs with start heights 100, 200, 300
+	epochs := make([]*EpochInfo, 0)
+	for id := uint64(2); id <= 4; id++ {
+		epoch := generateTestEpochInfo(id, (id-1)*100, 4)
+		epoch.Status = ProposalStatusPassed
+		assert.NoError(t, storeEpoch(testEmptyCtx, epoch))
+		storeEpochProof(testEmptyCtx, epoch.ID, epoch.Hash())
+		storeCurrentEpochHash(testEmptyCtx, epoch.Hash())
+		epochs = append(epochs, epoch)
+	}
+
+	cases := []struct {
+		start, end uint64
+		expect     []*EpochInfo
+	}{
+		{0, 1000, epochs},
+		{100, 300, epochs},
+		{101, 300, epochs[1:]},
+		{150, 250, epochs[1:2]},
+		{0, 99, nil},
+		{301, 1000, nil},
+	}
+	for _, c := range cases {
+		list, err := GetEpochChangeEvents(testStateDB, c.start, c.end)
+		assert.NoError(t, err)
+		assert.Equal(t, len(c.expect), len(list))
+		for i, epoch := range c.expect {
+			prevHash := common.EmptyHash
+			if epoch.ID > 2 {
+				prevHash = epochs[epoch.ID-3].Hash()
+			}
+			assert.Equal(t, newEpochChangeEvent(prevHash, epoch, epoch.QuorumSize()), list[i])
+			assert.Equal(t, epoch.ID, list[i].EpochID)
+			assert.Equal(t, epoch.QuorumSize(), list[i].QuorumSize)
+			assert.Equal(t, len(epoch.Peers.List), len(list[i].Peers))
+		}
+	}
+
+	_, err := GetEpochChangeEvents(testStateDB, 10, 9)
+	assert.Error(t, err)
+
+	ch := make(chan types.EpochChangeEvent, len(epochs))
+	n, err := ReplayEpochChange(testStateDB, 0, 1000, ch)
+	assert.NoError(t, err)
+	assert.Equal(t, len(epochs), n)
+	for _, epoch := range epochs {
+		assert.Equal(t, epoch.Hash(), (<-ch).Hash)
+	}
+}
+
+func TestGetEpochSnapshot(t *testing.T) {
+	resetTestContext()
+
+	// the genesis epoch is readable while it's the current one
+	ev, err := GetEpochSnapshot(testStateDB, StartEpoch)
+	assert.NoError(t, err)
+	assert.Equal(t, testGenesisEpoch.Hash(), ev.Hash)
+	assert.Equal(t, common.EmptyHash, ev.PrevHash)
+
+	epochs := make([]*EpochInfo, 0)
+	for id := uint64(2); id <= 4; id++ {
+		epoch := generateTestEpochInfo(id, (id-1)*100, 4)
+		epoch.Status = ProposalStatusPassed
+		assert.NoError(t, storeEpoch(testEmptyCtx, epoch))
+		storeEpochProof(testEmptyCtx, epoch.ID, epoch.Hash())
+		storeCurrentEpochHash(testEmptyCtx, epoch.Hash())
+		epochs = append(epochs, epoch)
+	}
+	for i, epoch := range epochs {
+		ev, err := GetEpochSnapshot(testStateDB, epoch.ID)
+		assert.NoError(t, err)
+		prevHash := common.EmptyHash
+		if i > 0 {
+			prevHash = epochs[i-1].Hash()
+		}
+		assert.Equal(t, newEpochChangeEvent(prevHash, epoch, epoch.QuorumSize()), *ev)
+	}
+
+	_, err = GetEpochSnapshot(testStateDB, StartEpoch)
+	assert.Error(t, err)
+	_, err = GetEpochSnapshot(testStateDB, 5)
+	assert.Error(t, err)
+}
+
+func TestPeersLimit(t *testing.T) {
+	resetTestContext()
+
+	setPeersLimit := func(target, maxChange uint64) error {
+		payload, err := (&MethodSetPeersLimitInput{Target: target, MaxChange: maxChange}).Encode()
+		assert.NoError(t, err)
+		for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+			caller := testGenesisEpoch.Peers.List[i].Address
+			ctx := generateNativeContract(caller, 1)
+			if _, _, err := ctx.ContractRef().NativeCall(caller, this, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	propose := func(newPeers int) error {
+		peers := testGenesisEpoch.Peers.Copy()
+		peers.List = append(peers.List, generateTestPeers(newPeers).List...)
+		payload, err := (&MethodProposeInput{Peers: peers}).Encode()
+		assert.NoError(t, err)
+		ctx := generateNativeContract(testCaller, 3)
+		_, _, err = ctx.ContractRef().NativeCall(testCaller, this, payload)
+		return err
+	}
+
+	assert.Equal(t, ErrInvalidPeersLimit, setPeersLimit(uint64(MinProposalPeersLen-1), 1))
+	assert.Equal(t, ErrInvalidPeersLimit, setPeersLimit(uint64(MaxProposalPeersLen+1), 1))
+
+	assert.NoError(t, setPeersLimit(6, 1))
+	ctx := generateNativeContract(testCaller, 1)
+	payload, err := utils.PackMethod(ABI, MethodPeersLimit)
+	assert.NoError(t, err)
+	enc, _, err := ctx.ContractRef().NativeCall(testCaller, this, payload)
+	assert.NoError(t, err)
+	output := new(MethodPeersLimitOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, &MethodPeersLimitOutput{Target: 6, MaxChange: 1}, output)
+
+	assert.Equal(t, ErrPeersChange, propose(2))
+	assert.NoError(t, propose(1))
+
+	// candidates exceeding the target size are sampled with the epoch seed
+	assert.NoError(t, setPeersLimit(uint64(testGenesisNum), 0))
+	assert.NoError(t, propose(1))
+	proposals, err := getProposals(ctx, testGenesisEpoch.ID+1)
+	assert.NoError(t, err)
+	epoch, err := getEpoch(ctx, proposals[len(proposals)-1])
+	assert.NoError(t, err)
+	assert.Equal(t, testGenesisNum, epoch.Peers.Len())
+	assert.True(t, testGenesisEpoch.OldMemberNum(epoch.Peers) >= testGenesisEpoch.QuorumSize())
+}
+
+func TestQuorumRule(t *testing.T) {
+	resetTestContext()
+
+	setQuorumRule := func(rule *MethodSetQuorumRuleInput, from, to int) error {
+		payload, err := rule.Encode()
+		assert.NoError(t, err)
+		for i := from; i < to; i++ {
+			caller := testGenesisEpoch.Peers.List[i].Address
+			ctx := generateNativeContract(caller, 1)
+			if _, _, err := ctx.ContractRef().NativeCall(caller, this, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	getQuorumRule := func() *MethodQuorumRuleOutput {
+		ctx := generateNativeContract(testCaller, 1)
+		payload, err := utils.PackMethod(ABI, MethodQuorumRule)
+		assert.NoError(t, err)
+		enc, _, err := ctx.ContractRef().NativeCall(testCaller, this, payload)
+		assert.NoError(t, err)
+		output := new(MethodQuorumRuleOutput)
+		assert.NoError(t, output.Decode(enc))
+		return output
+	}
+
+	assert.Equal(t, &MethodQuorumRuleOutput{}, getQuorumRule())
+	assert.Equal(t, 3, QuorumSize(testEmptyCtx, testGenesisEpoch))
+	assert.Equal(t, ErrInvalidQuorumRule, setQuorumRule(&MethodSetQuorumRuleInput{Numerator: 1, Denominator: 3}, 0, 1))
+
+	// all of the 4 validators are required after changed to 3/4+1
+	strict := &MethodSetQuorumRuleInput{Numerator: 3, Denominator: 4, Strict: true}
+	assert.NoError(t, setQuorumRule(strict, 0, 2))
+	assert.Equal(t, &MethodQuorumRuleOutput{}, getQuorumRule())
+	assert.NoError(t, setQuorumRule(strict, 2, 3))
+	assert.Equal(t, &MethodQuorumRuleOutput{Numerator: 3, Denominator: 4, Strict: true}, getQuorumRule())
+	assert.Equal(t, 4, QuorumSize(testEmptyCtx, testGenesisEpoch))
+
+	// consensus signs are counted with the new rule
+	fixed := &MethodSetQuorumRuleInput{Threshold: 2}
+	assert.NoError(t, setQuorumRule(fixed, 0, 3))
+	assert.Equal(t, &MethodQuorumRuleOutput{Numerator: 3, Denominator: 4, Strict: true}, getQuorumRule())
+	assert.NoError(t, setQuorumRule(fixed, 3, 4))
+	assert.Equal(t, &MethodQuorumRuleOutput{Threshold: 2}, getQuorumRule())
+	assert.Equal(t, 2, QuorumSize(testEmptyCtx, testGenesisEpoch))
+
+	// genesis rule
+	resetTestContext()
+	assert.Equal(t, ErrInvalidQuorumRule, StoreGenesisQuorumRule(testStateDB, &QuorumRule{Numerator: 1, Denominator: 2}))
+	assert.NoError(t, StoreGenesisQuorumRule(testStateDB, &QuorumRule{Threshold: 4}))
+	assert.Equal(t, &MethodQuorumRuleOutput{Threshold: 4}, getQuorumRule())
+}
+
+func TestQuorumRuleGovV2Fork(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+	contract := func(caller common.Address, height int) *native.NativeContract {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		return ctx
+	}
+	assert.NoError(t, storeQuorumRule(testEmptyCtx, &QuorumRule{Threshold: 4}))
+
+	// the stored rule is ignored before governance v2
+	assert.Equal(t, 3, QuorumSize(contract(testCaller, 9), testGenesisEpoch))
+	assert.Equal(t, 4, QuorumSize(contract(testCaller, 10), testGenesisEpoch))
+
+	payload, err := (&MethodSetQuorumRuleInput{Threshold: 2}).Encode()
+	assert.NoError(t, err)
+	_, _, err = contract(testCaller, 9).ContractRef().NativeCall(testCaller, this, payload)
+	assert.Equal(t, ErrGovV2NotActivated, err)
+	_, _, err = contract(testCaller, 10).ContractRef().NativeCall(testCaller, this, payload)
+	assert.NoError(t, err)
+}
+
+func TestGovV2Fork(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+	contract := func(caller common.Address, height int) *native.NativeContract {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		return ctx
+	}
+	member := func(i int) common.Address { return testGenesisEpoch.Peers.List[i].Address }
+	propose := func(proposer common.Address, height int, newPeers int) (*EpochInfo, error) {
+		peers := testGenesisEpoch.Peers.Copy()
+		peers.List = append(peers.List, generateTestPeers(newPeers).List...)
+		payload, err := (&MethodProposeInput{StartHeight: 100, Peers: peers}).Encode()
+		assert.NoError(t, err)
+		if _, _, err := contract(proposer, height).ContractRef().NativeCall(proposer, this, payload); err != nil {
+			return nil, err
+		}
+		proposals, err := getProposals(testEmptyCtx, testGenesisEpoch.ID+1)
+		assert.NoError(t, err)
+		return getEpoch(testEmptyCtx, proposals[len(proposals)-1])
+	}
+	vote := func(epoch *EpochInfo, height int) {
+		payload, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+		assert.NoError(t, err)
+		for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+			_, _, err := contract(member(i), height).ContractRef().NativeCall(member(i), this, payload)
+			assert.NoError(t, err)
+		}
+	}
+
+	// candidates exceeding the target size are not sampled before governance v2
+	assert.NoError(t, storePeersLimit(testEmptyCtx, &PeersLimit{Target: uint64(testGenesisNum)}))
+	_, err := propose(member(0), 9, 1)
+	assert.Equal(t, ErrPeersTarget, err)
+	epoch, err := propose(member(0), 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, testGenesisNum, epoch.Peers.Len())
+	resetTestContext()
+
+	// proposals are not bound to the electorate, and the losing ones are deleted instead of
+	// rejected before governance v2
+	winner, err := propose(member(0), 9, 1)
+	assert.NoError(t, err)
+	_, err = getElectorate(testEmptyCtx, winner.Hash())
+	assert.Equal(t, ErrNotFound, err)
+	rival, err := propose(member(1), 9, 0)
+	assert.NoError(t, err)
+	vote(winner, 9)
+	_, err = getEpoch(testEmptyCtx, rival.Hash())
+	assert.Error(t, err)
+	_, err = get(testEmptyCtx, seedKey(winner.ID))
+	assert.Equal(t, ErrNotFound, err)
+	assert.Equal(t, crypto.Keccak256Hash(winner.Hash().Bytes()), getEpochSeed(contract(member(0), 9), winner))
+	proposals, err := getProposals(testEmptyCtx, testGenesisEpoch.ID+1)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Hash{winner.Hash()}, proposals)
+	resetTestContext()
+
+	winner, err = propose(member(0), 10, 1)
+	assert.NoError(t, err)
+	_, err = getElectorate(testEmptyCtx, winner.Hash())
+	assert.NoError(t, err)
+	rival, err = propose(member(1), 10, 0)
+	assert.NoError(t, err)
+	vote(winner, 10)
+	rival, err = getEpoch(testEmptyCtx, rival.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalStatusRejected, rival.Status)
+	_, err = get(testEmptyCtx, seedKey(winner.ID))
+	assert.NoError(t, err)
+}
+
+func TestElectorate(t *testing.T) {
+	resetTestContext()
+
+	// replace the current epoch with the same members, so that the epoch id is kept
+	changeElectorate := func() {
+		epoch := &EpochInfo{ID: testGenesisEpoch.ID, Peers: testGenesisEpoch.Peers, StartHeight: 1}
+		assert.NoError(t, storeEpoch(testEmptyCtx, epoch))
+		storeCurrentEpochHash(testEmptyCtx, epoch.Hash())
+	}
+
+	// proposal is bound to the epoch at proposal time
+	peers := testGenesisEpoch.Peers.Copy()
+	peers.List = append(peers.List, generateTestPeers(1).List...)
+	payload, err := (&MethodProposeInput{StartHeight: 100, Peers: peers}).Encode()
+	assert.NoError(t, err)
+	ctx := generateNativeContract(testCaller, 3)
+	_, _, err = ctx.ContractRef().NativeCall(testCaller, this, payload)
+	assert.NoError(t, err)
+	proposals, err := getProposals(ctx, testGenesisEpoch.ID+1)
+	assert.NoError(t, err)
+	electorate, err := getElectorate(ctx, proposals[0])
+	assert.NoError(t, err)
+	assert.Equal(t, &Electorate{EpochHash: testGenesisEpoch.Hash(), Quorum: uint64(testGenesisEpoch.QuorumSize())}, electorate)
+
+	// consensus signs collected before the electorate changed
+	sign := func(from, to int) {
+		payload, err := (&MethodSetPeersLimitInput{Target: 6, MaxChange: 1}).Encode()
+		assert.NoError(t, err)
+		for i := from; i < to; i++ {
+			caller := testGenesisEpoch.Peers.List[i].Address
+			_, _, err := generateNativeContract(caller, 3).ContractRef().NativeCall(caller, this, payload)
+			assert.NoError(t, err)
+		}
+	}
+	quorum := testGenesisEpoch.QuorumSize()
+	sign(0, quorum-1)
+	changeElectorate()
+
+	voter := testGenesisEpoch.Peers.List[1].Address
+	payload, err = (&MethodVoteInput{EpochID: testGenesisEpoch.ID + 1, Hash: proposals[0]}).Encode()
+	assert.NoError(t, err)
+	_, _, err = generateNativeContract(voter, 3).ContractRef().NativeCall(voter, this, payload)
+	assert.Equal(t, ErrElectorateChanged, err)
+
+	// tally of the consensus sign is restarted, and the previous signers are able to sign again
+	sign(quorum-1, quorum)
+	limit, err := getPeersLimit(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), limit.Target)
+	sign(0, quorum-1)
+	limit, err = getPeersLimit(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), limit.Target)
 }
 
 func TestDirtyJob(t *testing.T) {
+	resetTestContext()
+
 	s := testEmptyCtx
 	epochID := uint64(2)
 	peers := generateTestPeers(12)
 	voters := []common.Address{peers.List[2].Address, peers.List[3].Address}
+	// the proposer joined mid-stream is not a peer of last epoch
+	outsider := generateTestPeer().Address
 
 	// store last epoch
 	lastEpoch := &EpochInfo{ID: epochID - 1, Proposer: peers.List[0].Address, Peers: peers, StartHeight: 60}
@@ -558,7 +1016,7 @@ func TestDirtyJob(t *testing.T) {
 	// store current useless epoch and votes
 	eps := []*EpochInfo{
 		{ID: epochID, Proposer: peers.List[0].Address, Peers: &Peers{List: peers.List[:5]}, StartHeight: 270},
-		{ID: epochID, Proposer: peers.List[1].Address, Peers: &Peers{List: peers.List[:6]}, StartHeight: 290},
+		{ID: epochID, Proposer: outsider, Peers: &Peers{List: peers.List[:6]}, StartHeight: 290},
 	}
 	for i, v := range eps {
 		assert.NoError(t, storeEpoch(s, v))
@@ -566,33 +1024,144 @@ func TestDirtyJob(t *testing.T) {
 		assert.NoError(t, storeVote(s, v.Hash(), voters[i]))
 		storeVoteTo(s, v.ID, voters[i], v.Hash())
 	}
+	assert.NoError(t, storeVote(s, eps[1].Hash(), outsider))
+	storeVoteTo(s, epochID, outsider, eps[1].Hash())
 
+	// the winner with votes
 	curEpoch := generateTestEpochInfo(epochID, 270, 13)
 	assert.NoError(t, storeEpoch(s, curEpoch))
 	assert.NoError(t, storeProposal(s, curEpoch.ID, curEpoch.Hash()))
+	winners := []common.Address{peers.List[4].Address, peers.List[5].Address, peers.List[6].Address}
+	for _, v := range winners {
+		assert.NoError(t, storeVote(s, curEpoch.Hash(), v))
+		storeVoteTo(s, epochID, v, curEpoch.Hash())
+	}
 
 	// before dirty job
 	list, err := getProposals(s, epochID)
 	assert.NoError(t, err)
 	assert.Equal(t, 1+len(eps), len(list))
-	for _, v := range eps {
-		assert.Equal(t, 1, voteSize(s, v.Hash()))
-	}
+	assert.Equal(t, 1, voteSize(s, eps[0].Hash()))
+	assert.Equal(t, 2, voteSize(s, eps[1].Hash()))
 
-	dirtyJob(s, lastEpoch, curEpoch)
+	dirtyJob(s, curEpoch)
 
-	// after dirty job
-	// proposal number should be only 1 with same epochID
+	// after dirty job, proposals and tallies are kept as the final disposition while the votes
+	// and `voteTo` of all proposals are dropped.
 	list, err = getProposals(s, epochID)
 	assert.NoError(t, err)
-	assert.Equal(t, int(1), len(list))
-	assert.Equal(t, curEpoch.Hash(), list[0])
+	assert.Equal(t, 1+len(eps), len(list))
 
 	for _, v := range eps {
 		inf, _ := getEpoch(s, v.Hash())
-		assert.Nil(t, inf)
+		assert.NotNil(t, inf)
 		assert.Equal(t, 0, voteSize(s, v.Hash()))
 	}
+	assert.Equal(t, uint64(1), getTally(s, eps[0].Hash()))
+	assert.Equal(t, uint64(2), getTally(s, eps[1].Hash()))
+	assert.Equal(t, 0, voteSize(s, curEpoch.Hash()))
+	assert.Equal(t, uint64(len(winners)), getTally(s, curEpoch.Hash()))
+	for _, v := range append(append(voters, outsider), winners...) {
+		assert.Equal(t, common.EmptyHash, findVoteTo(s, epochID, v))
+	}
+}
+
+func TestInputRules(t *testing.T) {
+	resetTestContext()
+
+	caller := testGenesisEpoch.MemberList()[0]
+	call := func(payload []byte) error {
+		_, _, err := generateNativeContractRef(caller, 10).NativeCall(caller, this, payload)
+		return err
+	}
+	rejected := func(err error, method, arg string) {
+		var invalid *native.InvalidInputError
+		if assert.True(t, errors.As(err, &invalid)) {
+			assert.Equal(t, method, invalid.Method)
+			assert.Equal(t, arg, invalid.Arg)
+		}
+	}
+
+	// hash longer than 32 bytes is never truncated into a proposal hash
+	payload, err := utils.PackMethod(ABI, MethodVote, StartEpoch+1, make([]byte, common.HashLength+1))
+	assert.NoError(t, err)
+	rejected(call(payload), MethodVote, "Hash")
+	payload, err = utils.PackMethod(ABI, MethodVote, StartEpoch+1, []byte{})
+	assert.NoError(t, err)
+	rejected(call(payload), MethodVote, "Hash")
+
+	payload, err = utils.PackMethod(ABI, MethodGroupEpoch, strings.Repeat("a", MaxGroupNameLen+1))
+	assert.NoError(t, err)
+	rejected(call(payload), MethodGroupEpoch, "Name")
+	payload, err = utils.PackMethod(ABI, MethodEject, common.Address{}, []byte{1})
+	assert.NoError(t, err)
+	rejected(call(payload), MethodEject, "Validator")
+}
+
+func TestEpochPeers(t *testing.T) {
+	resetTestContext()
+
+	caller := testGenesisEpoch.MemberList()[0]
+	query := func(hash common.Hash, start, limit uint64) (*MethodEpochPeersOutput, error) {
+		payload, err := (&MethodEpochPeersInput{Hash: hash, Start: start, Limit: limit}).Encode()
+		assert.NoError(t, err)
+		ret, _, err := generateNativeContractRef(caller, 10).NativeCall(caller, this, payload)
+		if err != nil {
+			return nil, err
+		}
+		output := new(MethodEpochPeersOutput)
+		assert.NoError(t, output.Decode(ret))
+		return output, nil
+	}
+
+	// current epoch if the hash is empty
+	output, err := query(common.EmptyHash, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(testGenesisNum), output.Total)
+	assert.Equal(t, testGenesisEpoch.Peers.List, output.Peers.List)
+
+	// read the large peer set page by page
+	large := generateTestEpochInfo(StartEpoch+1, 100, 300)
+	assert.NoError(t, storeEpoch(testEmptyCtx, large))
+	list := make([]*PeerInfo, 0)
+	for start := uint64(0); ; start += MaxEpochPeersPage {
+		output, err := query(large.Hash(), start, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(300), output.Total)
+		if len(output.Peers.List) == 0 {
+			break
+		}
+		assert.LessOrEqual(t, len(output.Peers.List), int(MaxEpochPeersPage))
+		list = append(list, output.Peers.List...)
+	}
+	assert.Equal(t, large.Peers.List, list)
+	output, err = query(large.Hash(), 298, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, large.Peers.List[298:], output.Peers.List)
+
+	_, err = query(large.Hash(), 0, MaxEpochPeersPage+1)
+	var invalid *native.InvalidInputError
+	assert.True(t, errors.As(err, &invalid))
+	_, err = query(generateTestHash(1), 0, 0)
+	assert.Equal(t, ErrEpochNotExist, err)
+
+	// the handler clamps the page itself before the input rules fork
+	payload, err := (&MethodEpochPeersInput{Hash: large.Hash(), Start: 1, Limit: math.MaxUint64}).Encode()
+	assert.NoError(t, err)
+	ref := generateNativeContractRef(caller, 10)
+	ref.SetChainConfig(&params.ChainConfig{InputRulesBlock: big.NewInt(11)})
+	ret, _, err := ref.NativeCall(caller, this, payload)
+	assert.NoError(t, err)
+	output = new(MethodEpochPeersOutput)
+	assert.NoError(t, output.Decode(ret))
+	assert.Equal(t, large.Peers.List[1:1+MaxEpochPeersPage], output.Peers.List)
+
+	// the whole epoch is too large to be returned
+	storeCurrentEpochHash(testEmptyCtx, large.Hash())
+	payload, err = utils.PackMethod(ABI, MethodEpoch)
+	assert.NoError(t, err)
+	_, _, err = generateNativeContractRef(caller, 10).NativeCall(caller, this, payload)
+	assert.Equal(t, ErrOutputTooLarge, err)
 }
 
 func generateNativeContractRef(origin common.Address, blockNum int) *native.ContractRef {
@@ -667,3 +1236,33 @@ func generateTestAddressList(n int) *AddressList {
 	}
 	return data
 }
+
+func TestQueryMethods(t *testing.T) {
+	resetTestContext()
+
+	// query methods should be free of gas and never consume the supplied gas
+	caller := testGenesisEpoch.Peers.List[0].Address
+	ref := native.NewContractRef(testStateDB, caller, caller, big.NewInt(1), generateTestHash(1), 0, nil)
+
+	payload, err := new(MethodEpochInput).Encode()
+	assert.NoError(t, err)
+	enc, gasLeft, err := ref.NativeCall(caller, this, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), gasLeft)
+
+	output := new(MethodEpochOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, testGenesisEpoch.Hash(), output.Epoch.Hash())
+
+	payload, err = utils.PackMethod(ABI, MethodProof)
+	assert.NoError(t, err)
+	_, _, err = ref.NativeCall(caller, this, payload)
+	assert.NoError(t, err)
+
+	// state-changing methods still require gas
+	input := &MethodVoteInput{EpochID: 2, Hash: generateTestHash(2)}
+	payload, err = input.Encode()
+	assert.NoError(t, err)
+	_, _, err = ref.NativeCall(caller, this, payload)
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// MaxMisconductEpochs is the max number of epochs covered by one `misconduct` query.
+const MaxMisconductEpochs uint64 = 100
+
+// MisconductKind tells which contradictory governance behavior is recorded.
+type MisconductKind uint8
+
+const (
+	MisconductVoteSwitch       MisconductKind = iota + 1 // the vote moved to a conflicting proposal of the same epoch
+	MisconductRejectedProposal                           // the proposal lost to another one of the same epoch
+	MisconductEquivocation                               // two blocks of the same height proposed, proven by ejection
+)
+
+// recordMisconduct accounts the misconduct of the validator in the epoch and emits the event, the
+// records are only reported, there is no slashing in zion. it takes effect since governance v2.
+func recordMisconduct(s *native.NativeContract, epochID uint64, validator common.Address, kind MisconductKind) error {
+	if !s.ContractRef().IsGovV2() {
+		return nil
+	}
+	report, err := getMisconduct(s, epochID, validator)
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case MisconductVoteSwitch:
+		report.VoteSwitches++
+	case MisconductRejectedProposal:
+		report.RejectedProposals++
+	case MisconductEquivocation:
+		report.Equivocations++
+	default:
+		return ErrInvalidInput
+	}
+	if err := storeMisconduct(s, report); err != nil {
+		return err
+	}
+	return emitMisconductRecorded(s, epochID, validator, kind)
+}
+
+// Misconduct returns the misconduct reports of the validator in the epochs [StartEpoch, EndEpoch],
+// the epochs without any misconduct are skipped. the range is capped by `MaxMisconductEpochs`.
+func Misconduct(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodMisconductInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("misconduct", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.EndEpoch < input.StartEpoch || input.EndEpoch-input.StartEpoch >= MaxMisconductEpochs {
+		return utils.ByteFailed, ErrInvalidEpochRange
+	}
+
+	list := make([]*MisconductReport, 0)
+	for i := uint64(0); i <= input.EndEpoch-input.StartEpoch; i++ {
+		id := input.StartEpoch + i
+		report, err := getMisconduct(s, id, input.Validator)
+		if err != nil {
+			logger.Trace("misconduct", "get misconduct failed", err, "epoch", id, "validator", input.Validator.Hex())
+			return utils.ByteFailed, ErrStorage
+		}
+		if !report.Empty() {
+			list = append(list, report)
+		}
+	}
+	return (&MethodMisconductOutput{Reports: list}).Encode()
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMisconduct(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(0)}
+	call := func(caller common.Address, height int, payload []byte) ([]byte, error) {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		enc, _, err := ctx.ContractRef().NativeCall(caller, this, payload)
+		return enc, err
+	}
+	misconduct := func(validator common.Address, start, end uint64) ([]*MisconductReport, error) {
+		payload, err := (&MethodMisconductInput{Validator: validator, StartEpoch: start, EndEpoch: end}).Encode()
+		assert.NoError(t, err)
+		enc, err := call(validator, 100, payload)
+		if err != nil {
+			return nil, err
+		}
+		output := new(MethodMisconductOutput)
+		assert.NoError(t, output.Decode(enc))
+		return output.Reports, nil
+	}
+	members := testGenesisEpoch.MemberList()
+	quorum := QuorumSize(generateNativeContract(members[0], 10), testGenesisEpoch)
+	assert.Equal(t, 3, quorum)
+
+	// two conflicting proposals of the next epoch
+	peers := testGenesisEpoch.Peers.Copy()
+	sort.Sort(peers)
+	proposals := make([]*EpochInfo, 2)
+	for i := range proposals {
+		proposals[i] = &EpochInfo{ID: StartEpoch + 1, Peers: peers, StartHeight: uint64(200 + i)}
+		payload, err := (&MethodProposeInput{StartHeight: proposals[i].StartHeight, Peers: proposals[i].Peers}).Encode()
+		assert.NoError(t, err)
+		_, err = call(members[i], 10, payload)
+		assert.NoError(t, err)
+	}
+	vote := func(voter common.Address, epoch *EpochInfo) {
+		payload, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+		assert.NoError(t, err)
+		_, err = call(voter, 11, payload)
+		assert.NoError(t, err)
+	}
+
+	// the voter switched to the other proposal, and the proposer of the loser is recorded
+	vote(members[2], proposals[0])
+	vote(members[2], proposals[1])
+	vote(members[2], proposals[1])
+	vote(members[3], proposals[1])
+	cur, err := GetCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, proposals[1].Hash(), cur.Hash())
+
+	reports, err := misconduct(members[2], StartEpoch, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*MisconductReport{{EpochID: StartEpoch, Validator: members[2], VoteSwitches: 1}}, reports)
+	reports, err = misconduct(members[0], StartEpoch, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*MisconductReport{{EpochID: StartEpoch, Validator: members[0], RejectedProposals: 1}}, reports)
+	reports, err = misconduct(members[3], StartEpoch, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+
+	_, err = misconduct(members[0], StartEpoch+1, StartEpoch)
+	assert.Equal(t, ErrInvalidEpochRange, err)
+	_, err = misconduct(members[0], StartEpoch, StartEpoch+MaxMisconductEpochs)
+	assert.Equal(t, ErrInvalidEpochRange, err)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// MaxPayoutEpochs is the max number of epochs covered by one `payouts` query.
+const MaxPayoutEpochs uint64 = 100
+
+// recordPayout credits the fees earned by the proposer of the block to its payout statement. a
+// proposal passed ahead of its start height is already the current epoch, so the block is
+// accounted to the epoch in force at its height.
+func recordPayout(s *native.NativeContract, proposer common.Address, fees *big.Int) error {
+	epoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		return err
+	}
+	epochID := epoch.ID
+	if height := s.ContractRef().BlockHeight().Uint64(); height < epoch.StartHeight && epochID > StartEpoch {
+		epochID--
+	}
+
+	statement, err := getPayout(s, epochID, proposer)
+	if err != nil {
+		return err
+	}
+	statement.Blocks++
+	statement.Fees.Add(statement.Fees, fees)
+	return storePayout(s, statement)
+}
+
+// Payouts returns the payout statements of the validator in the epochs [StartEpoch, EndEpoch],
+// the epochs in which the validator earned nothing are skipped. the range is capped by
+// `MaxPayoutEpochs`.
+func Payouts(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodPayoutsInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("payouts", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.EndEpoch < input.StartEpoch || input.EndEpoch-input.StartEpoch >= MaxPayoutEpochs {
+		return utils.ByteFailed, ErrInvalidEpochRange
+	}
+
+	list := make([]*PayoutStatement, 0)
+	for i := uint64(0); i <= input.EndEpoch-input.StartEpoch; i++ {
+		id := input.StartEpoch + i
+		statement, err := getPayout(s, id, input.Validator)
+		if err != nil {
+			logger.Trace("payouts", "get payout failed", err, "epoch", id, "validator", input.Validator.Hex())
+			return utils.ByteFailed, ErrStorage
+		}
+		if statement.Blocks > 0 {
+			list = append(list, statement)
+		}
+	}
+	return (&MethodPayoutsOutput{Payouts: list}).Encode()
+}
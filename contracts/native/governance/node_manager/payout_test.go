@@ -0,0 +1,94 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayouts(t *testing.T) {
+	resetTestContext()
+
+	config := &params.ChainConfig{GovV2Block: big.NewInt(10)}
+	// the state of each block is created from the parent, so that the fees are counted per block
+	finalize := func(proposer common.Address, height int, fee int64) {
+		testStateDB.AddFees(big.NewInt(fee))
+		ctx := generateNativeContract(proposer, height)
+		ctx.ContractRef().SetChainConfig(config)
+		assert.NoError(t, FinalizeBlock(ctx))
+		root, err := testStateDB.Commit(false)
+		assert.NoError(t, err)
+		testStateDB, err = state.New(root, testStateDB.Database(), nil)
+		assert.NoError(t, err)
+		testEmptyCtx = native.NewNativeContract(testStateDB, nil)
+	}
+	payouts := func(validator common.Address, start, end uint64) ([]*PayoutStatement, error) {
+		payload, err := (&MethodPayoutsInput{Validator: validator, StartEpoch: start, EndEpoch: end}).Encode()
+		assert.NoError(t, err)
+		enc, _, err := generateNativeContractRef(validator, 100).NativeCall(validator, this, payload)
+		if err != nil {
+			return nil, err
+		}
+		output := new(MethodPayoutsOutput)
+		assert.NoError(t, output.Decode(enc))
+		return output.Payouts, nil
+	}
+	members := testGenesisEpoch.MemberList()
+
+	// nothing recorded before governance v2
+	finalize(members[0], 9, 100)
+	finalize(members[0], 10, 100)
+	finalize(members[0], 11, 50)
+	finalize(members[1], 12, 0)
+
+	// the next epoch passed ahead of its start height
+	next := generateTestEpochInfo(StartEpoch+1, 20, 4)
+	next.Peers.List[0] = testGenesisEpoch.Peers.List[0]
+	assert.NoError(t, storeEpoch(testEmptyCtx, next))
+	storeCurrentEpochHash(testEmptyCtx, next.Hash())
+	finalize(members[0], 19, 10)
+	finalize(members[0], 20, 30)
+
+	list, err := payouts(members[0], StartEpoch, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*PayoutStatement{
+		{EpochID: StartEpoch, Validator: members[0], Blocks: 3, Fees: big.NewInt(160)},
+		{EpochID: StartEpoch + 1, Validator: members[0], Blocks: 1, Fees: big.NewInt(30)},
+	}, list)
+	list, err = payouts(members[1], StartEpoch, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*PayoutStatement{{EpochID: StartEpoch, Validator: members[1], Blocks: 1, Fees: big.NewInt(0)}}, list)
+	list, err = payouts(members[2], StartEpoch, StartEpoch+1)
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	_, err = payouts(members[0], 2, 1)
+	assert.Error(t, err)
+	_, err = payouts(members[0], 1, MaxPayoutEpochs+1)
+	assert.Error(t, err)
+	_, err = payouts(members[0], ^uint64(0)-1, ^uint64(0))
+	assert.NoError(t, err)
+}
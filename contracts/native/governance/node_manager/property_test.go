@@ -0,0 +1,267 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+)
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/governance/node_manager -run TestVoteStateRandom
+func TestVoteStateRandom(t *testing.T) {
+	defer resetTestContext()
+
+	config := &quick.Config{MaxCount: 200}
+	err := quick.Check((*voteStateTest).run, config)
+	if cerr, ok := err.(*quick.CheckError); ok {
+		test := cerr.In[0].(*voteStateTest)
+		t.Errorf("%v:\n%s", test.err, test)
+	} else if err != nil {
+		t.Error(err)
+	}
+}
+
+const (
+	actionPropose = iota // propose a new peer set for the next epoch
+	actionVote           // vote to one of the proposals of the next epoch, re-vote if voted before
+	actionAdvance        // move the block height forward
+	actionCount
+)
+
+// voteAction is a single step of the proposal/vote state machine. the actor and target are
+// picked modulo the members and proposals present when the action is applied, so that the
+// generated sequences remain meaningful across epoch changes.
+type voteAction struct {
+	kind   int
+	actor  int
+	target int
+}
+
+func (a voteAction) String() string {
+	switch a.kind {
+	case actionPropose:
+		return fmt.Sprintf("propose(actor: %d, variant: %d)", a.actor, a.target)
+	case actionVote:
+		return fmt.Sprintf("vote(actor: %d, proposal: %d)", a.actor, a.target)
+	default:
+		return fmt.Sprintf("advance(%d)", a.target)
+	}
+}
+
+// A voteStateTest applies a pseudorandom sequence of proposes, votes, re-votes and block
+// advances to a fresh genesis epoch, epoch changes happen whenever a proposal reaches the
+// quorum. after each action the storage of node manager is checked against the invariants:
+//
+//   - at most one proposal of an epoch ID is passed, and it's the one of the epoch proof.
+//   - a member votes to at most one proposal per epoch, the `voteTo` agrees with the vote
+//     lists, and no pending proposal holds votes of quorum size.
+//   - the votes, `voteTo` and electorates of a passed epoch are all cleared, and the
+//     tallies recorded never count a voter twice.
+type voteStateTest struct {
+	actions []voteAction
+	err     error // failure details are reported through this field
+}
+
+func (*voteStateTest) Generate(r *rand.Rand, size int) reflect.Value {
+	actions := make([]voteAction, size)
+	for i := range actions {
+		actions[i] = voteAction{
+			kind:   r.Intn(actionCount),
+			actor:  r.Intn(16),
+			target: r.Intn(8),
+		}
+	}
+	return reflect.ValueOf(&voteStateTest{actions: actions})
+}
+
+func (test *voteStateTest) String() string {
+	out := new(strings.Builder)
+	for i, action := range test.actions {
+		fmt.Fprintf(out, "%3d: %s\n", i, action)
+	}
+	return out.String()
+}
+
+func (test *voteStateTest) run() bool {
+	resetTestContext()
+
+	height := 10
+	// electors records the members of the epoch which the proposals of epoch ID voted by
+	electors := map[uint64][]common.Address{
+		testGenesisEpoch.ID + 1: testGenesisEpoch.MemberList(),
+	}
+	for i, action := range test.actions {
+		cur, err := GetCurrentEpoch(testEmptyCtx)
+		if err != nil {
+			test.err = fmt.Errorf("action %d: get current epoch failed: %v", i, err)
+			return false
+		}
+		members := cur.Peers.List
+		actor := members[action.actor%len(members)].Address
+		ctx := generateNativeContract(actor, height)
+
+		switch action.kind {
+		case actionPropose:
+			peers := proposalPeers(cur, action.target)
+			payload, _ := (&MethodProposeInput{Peers: peers}).Encode()
+			ctx.ContractRef().NativeCall(actor, this, payload)
+		case actionVote:
+			proposals, _ := getProposals(ctx, cur.ID+1)
+			if len(proposals) == 0 {
+				continue
+			}
+			proposal := proposals[action.target%len(proposals)]
+			payload, _ := (&MethodVoteInput{EpochID: cur.ID + 1, Hash: proposal}).Encode()
+			ctx.ContractRef().NativeCall(actor, this, payload)
+		case actionAdvance:
+			height += action.target + 1
+		}
+
+		next, err := GetCurrentEpoch(testEmptyCtx)
+		if err != nil {
+			test.err = fmt.Errorf("action %d: get current epoch failed: %v", i, err)
+			return false
+		}
+		if next.ID != cur.ID {
+			electors[next.ID+1] = next.MemberList()
+		}
+		if err := checkVoteState(next, electors); err != nil {
+			test.err = fmt.Errorf("action %d: %v", i, err)
+			return false
+		}
+	}
+	return true
+}
+
+// proposalPeers derives the peer set of a proposal from the current members, the variant
+// decides to keep, extend, shrink or rotate the set.
+func proposalPeers(cur *EpochInfo, variant int) *Peers {
+	peers := cur.Peers.Copy()
+	switch variant % 4 {
+	case 1:
+		peers.List = append(peers.List, generateTestPeer())
+	case 2:
+		if len(peers.List) > MinProposalPeersLen {
+			peers.List = peers.List[:len(peers.List)-1]
+		}
+	case 3:
+		peers.List[variant%len(peers.List)] = generateTestPeer()
+	}
+	return peers
+}
+
+func checkVoteState(cur *EpochInfo, electors map[uint64][]common.Address) error {
+	s := testEmptyCtx
+	for epochID := testGenesisEpoch.ID + 1; epochID <= cur.ID+1; epochID++ {
+		proposals, _ := getProposals(s, epochID)
+		passed := make([]common.Hash, 0)
+		for _, v := range proposals {
+			epoch, err := getEpoch(s, v)
+			if err != nil {
+				return fmt.Errorf("epoch %d: get proposal %s failed: %v", epochID, v.Hex(), err)
+			}
+			if epoch.Status == ProposalStatusPassed {
+				passed = append(passed, v)
+			}
+		}
+		if len(passed) > 1 {
+			return fmt.Errorf("epoch %d: %d proposals passed", epochID, len(passed))
+		}
+		if epochID <= cur.ID {
+			if len(passed) != 1 {
+				return fmt.Errorf("epoch %d: changed without passed proposal", epochID)
+			}
+			if proof, _ := getEpochProof(s, epochID); proof != passed[0] {
+				return fmt.Errorf("epoch %d: proof %s mismatch with passed proposal %s", epochID, proof.Hex(), passed[0].Hex())
+			}
+			if err := checkPassedEpoch(s, epochID, passed[0], proposals, electors[epochID]); err != nil {
+				return fmt.Errorf("epoch %d: %v", epochID, err)
+			}
+		} else {
+			if len(passed) != 0 {
+				return fmt.Errorf("epoch %d: proposal %s passed before epoch change", epochID, passed[0].Hex())
+			}
+			if err := checkPendingEpoch(s, epochID, proposals, cur); err != nil {
+				return fmt.Errorf("epoch %d: %v", epochID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkPendingEpoch(s *native.NativeContract, epochID uint64, proposals []common.Hash, cur *EpochInfo) error {
+	quorum := QuorumSize(s, cur)
+	members := cur.Members()
+	voted := make(map[common.Address]common.Hash)
+	for _, v := range proposals {
+		votes, _ := getVotes(s, v)
+		if len(votes) >= quorum {
+			return fmt.Errorf("proposal %s pending with %d votes, quorum %d", v.Hex(), len(votes), quorum)
+		}
+		for _, voter := range votes {
+			if last, ok := voted[voter]; ok {
+				return fmt.Errorf("voter %s counted by both %s and %s", voter.Hex(), last.Hex(), v.Hex())
+			}
+			voted[voter] = v
+			if _, ok := members[voter]; !ok {
+				return fmt.Errorf("voter %s of proposal %s is not a member", voter.Hex(), v.Hex())
+			}
+		}
+	}
+	for member := range members {
+		if to := findVoteTo(s, epochID, member); to != voted[member] {
+			return fmt.Errorf("member %s votes to %s, but counted by %s", member.Hex(), to.Hex(), voted[member].Hex())
+		}
+	}
+	return nil
+}
+
+func checkPassedEpoch(s *native.NativeContract, epochID uint64, passed common.Hash, proposals []common.Hash, electors []common.Address) error {
+	var total uint64
+	for _, v := range proposals {
+		if votes, _ := getVotes(s, v); len(votes) != 0 {
+			return fmt.Errorf("stale votes of proposal %s", v.Hex())
+		}
+		if _, err := getElectorate(s, v); !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("stale electorate of proposal %s", v.Hex())
+		}
+		tally := getTally(s, v)
+		if v != passed && tally >= getTally(s, passed) {
+			return fmt.Errorf("rejected proposal %s tally %d exceeds the passed %d", v.Hex(), tally, getTally(s, passed))
+		}
+		total += tally
+	}
+	if total > uint64(len(electors)) {
+		return fmt.Errorf("tallies %d exceed the electors %d", total, len(electors))
+	}
+	for _, member := range electors {
+		if to := findVoteTo(s, epochID, member); to != common.EmptyHash {
+			return fmt.Errorf("stale vote of %s to %s", member.Hex(), to.Hex())
+		}
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ package node_manager
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
@@ -27,26 +29,53 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/snappy"
 )
 
 // todo: issue, add field `len` for stat addressList and hashList
 
 const (
 	StartEpoch uint64 = 1 // epoch started from 1, NOT 0!
+
+	compressedEpochPrefix byte = 0x01 // prefix of the epochs compressed with snappy
 )
 
-var ErrEof = errors.New("EOF")
+var (
+	// ErrNotFound is returned by the storage if the value of the key is empty, i.e: never stored or deleted.
+	ErrNotFound = errors.New("storage not found")
+	// ErrCorrupt is wrapped by the failure of decoding the stored value.
+	ErrCorrupt = errors.New("storage corrupt")
+)
 
 // storage key prefix
 const (
-	SKP_EPOCH     = "st_epoch"
-	SKP_PROOF     = "st_proof"
-	SKP_PROPOSAL  = "st_proposal"
-	SKP_VOTE      = "st_vote"
-	SKP_VOTE_TO   = "st_vote_to"
-	SKP_CUR_EPOCH = "st_cur_epoch"
-	SKP_SIGN      = "st_sign"
-	SKP_SIGNER    = "st_signer"
+	SKP_EPOCH       = "st_epoch"
+	SKP_PROOF       = "st_proof"
+	SKP_PROPOSAL    = "st_proposal"
+	SKP_VOTE        = "st_vote"
+	SKP_VOTE_TO     = "st_vote_to"
+	SKP_TALLY       = "st_tally"
+	SKP_CUR_EPOCH   = "st_cur_epoch"
+	SKP_SIGN        = "st_sign"
+	SKP_SIGNER      = "st_signer"
+	SKP_PEERS_LIMIT = "st_peers_limit"
+	SKP_QUORUM_RULE = "st_quorum_rule"
+	SKP_ELECTORATE  = "st_electorate"
+	SKP_SEED        = "st_seed"
+	SKP_VRF_KEY     = "st_vrf_key"
+	SKP_VRF_OUTPUT  = "st_vrf_output"
+	SKP_PAYOUT      = "st_payout"
+	SKP_FEE_SPLIT   = "st_fee_split"
+	SKP_FREE_DUTY   = "st_free_duty"
+	SKP_ACTIONS     = "st_actions"
+	SKP_SEAL        = "st_seal"
+	SKP_COMMITMENT  = "st_commitment"
+	SKP_MISCONDUCT  = "st_misconduct"
+	SKP_EXPIRY      = "st_expiry"
+	SKP_EXPIRY_ARM  = "st_expiry_arm"
+	SKP_GROUP       = "st_group"
+	SKP_GROUPS      = "st_groups"
+	SKP_EC_SIGNS    = "st_ec_signs"
 )
 
 // ====================================================================
@@ -55,18 +84,30 @@ const (
 //
 // ====================================================================
 func storeEpoch(s *native.NativeContract, epoch *EpochInfo) error {
-	return setEpoch(s.GetCacheDB(), epoch)
+	return setEpoch(s.GetCacheDB(), epoch, s.ContractRef().IsStorageV2())
 }
 
 func getEpoch(s *native.NativeContract, epochHash common.Hash) (*EpochInfo, error) {
-	key := epochKey(epochHash)
-	enc, err := get(s, key)
+	return readEpoch(s.GetCacheDB(), epochHash)
+}
+
+func readEpoch(db *state.CacheDB, epochHash common.Hash) (*EpochInfo, error) {
+	return loadEpoch(db, epochKey(epochHash))
+}
+
+func loadEpoch(db *state.CacheDB, key []byte) (*EpochInfo, error) {
+	enc, err := customGet(db, key)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(enc) > 0 && enc[0] == compressedEpochPrefix {
+		if enc, err = snappy.Decode(nil, enc[1:]); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+	}
 	epoch := new(EpochInfo)
-	if err := rlp.DecodeBytes(enc, epoch); err != nil {
+	if err := decodeStored(enc, epoch); err != nil {
 		return nil, err
 	}
 
@@ -78,14 +119,21 @@ func delEpoch(s *native.NativeContract, epochHash common.Hash) {
 	del(s, key)
 }
 
-func setEpoch(s *state.CacheDB, epoch *EpochInfo) error {
-	hash := epoch.Hash()
-	key := epochKey(hash)
+// setEpoch stores the epoch in rlp, which is compressed with snappy and prefixed by
+// `compressedEpochPrefix` since the storage v2 fork. the prefix never starts the rlp of a struct,
+// so that the epochs stored in both encodings are read transparently.
+func setEpoch(s *state.CacheDB, epoch *EpochInfo, compress bool) error {
+	return putEpoch(s, epochKey(epoch.Hash()), epoch, compress)
+}
 
+func putEpoch(s *state.CacheDB, key []byte, epoch *EpochInfo, compress bool) error {
 	value, err := rlp.EncodeToBytes(epoch)
 	if err != nil {
 		return err
 	}
+	if compress {
+		value = append([]byte{compressedEpochPrefix}, snappy.Encode(nil, value)...)
+	}
 
 	s.Put(key, value)
 	return nil
@@ -122,8 +170,12 @@ func storeEpochProof(s *native.NativeContract, epochID uint64, epochHash common.
 }
 
 func getEpochProof(s *native.NativeContract, epochID uint64) (common.Hash, error) {
+	return readEpochProof(s.GetCacheDB(), epochID)
+}
+
+func readEpochProof(db *state.CacheDB, epochID uint64) (common.Hash, error) {
 	key := epochProofKey(EpochProofHash(epochID))
-	value, err := get(s, key)
+	value, err := customGet(db, key)
 	if err != nil {
 		return common.EmptyHash, nil
 	}
@@ -146,7 +198,7 @@ func EpochProofHash(epochID uint64) common.Hash {
 func storeProposal(s *native.NativeContract, epochID uint64, hash common.Hash) error {
 	list, err := getProposals(s, epochID)
 	if err != nil {
-		if err.Error() == "EOF" {
+		if errors.Is(err, ErrNotFound) {
 			list = make([]common.Hash, 0)
 		} else {
 			return err
@@ -231,7 +283,7 @@ func getProposals(s *native.NativeContract, epochID uint64) ([]common.Hash, erro
 	}
 
 	var data *HashList
-	if err := rlp.DecodeBytes(enc, &data); err != nil {
+	if err := decodeStored(enc, &data); err != nil {
 		return nil, err
 	}
 	return data.List, nil
@@ -262,7 +314,7 @@ func proposalsNum(s *native.NativeContract, epochID uint64, proposer common.Addr
 func storeVote(s *native.NativeContract, epochHash common.Hash, voter common.Address) error {
 	list, err := getVotes(s, epochHash)
 	if err != nil {
-		if err.Error() == ErrEof.Error() {
+		if errors.Is(err, ErrNotFound) {
 			list = make([]common.Address, 0)
 		} else {
 			return err
@@ -339,12 +391,28 @@ func getVotes(s *native.NativeContract, epochHash common.Hash) ([]common.Address
 		return nil, err
 	}
 	var data *AddressList
-	if err := rlp.DecodeBytes(enc, &data); err != nil {
+	if err := decodeStored(enc, &data); err != nil {
 		return nil, err
 	}
 	return data.List, nil
 }
 
+// storeTally records the final vote count of the proposal, the votes are dropped after the
+// epoch of proposal passed.
+func storeTally(s *native.NativeContract, epochHash common.Hash, votes uint64) {
+	set(s, tallyKey(epochHash), utils.GetUint64Bytes(votes))
+}
+
+// getTally returns the final vote count of the proposal if recorded, otherwise the size of
+// current votes.
+func getTally(s *native.NativeContract, epochHash common.Hash) uint64 {
+	value, err := get(s, tallyKey(epochHash))
+	if err != nil {
+		return uint64(voteSize(s, epochHash))
+	}
+	return utils.GetBytesUint64(value)
+}
+
 // ====================================================================
 //
 // `vote to` storage
@@ -396,7 +464,7 @@ func getSign(s *native.NativeContract, hash common.Hash) (*ConsensusSign, error)
 		return nil, err
 	}
 	var sign *ConsensusSign
-	if err := rlp.DecodeBytes(value, &sign); err != nil {
+	if err := decodeStored(value, &sign); err != nil {
 		return nil, err
 	}
 	return sign, nil
@@ -405,7 +473,7 @@ func getSign(s *native.NativeContract, hash common.Hash) (*ConsensusSign, error)
 func storeSigner(s *native.NativeContract, hash common.Hash, signer common.Address) error {
 	data, err := getSigners(s, hash)
 	if err != nil {
-		if err.Error() == ErrEof.Error() {
+		if errors.Is(err, ErrNotFound) {
 			data = make([]common.Address, 0)
 		} else {
 			return err
@@ -445,7 +513,7 @@ func getSigners(s *native.NativeContract, hash common.Hash) ([]common.Address, e
 	}
 
 	var list *AddressList
-	if err := rlp.DecodeBytes(value, &list); err != nil {
+	if err := decodeStored(value, &list); err != nil {
 		return nil, err
 	}
 	return list.List, nil
@@ -464,6 +532,37 @@ func clearSigner(s *native.NativeContract, hash common.Hash) {
 	del(s, key)
 }
 
+// ====================================================================
+//
+// `electorate` storage, keyed by hash of proposal or consensus sign
+//
+// ====================================================================
+func storeElectorate(s *native.NativeContract, hash common.Hash, electorate *Electorate) error {
+	value, err := rlp.EncodeToBytes(electorate)
+	if err != nil {
+		return err
+	}
+	set(s, electorateKey(hash), value)
+	return nil
+}
+
+// getElectorate returns ErrNotFound if the electorate never recorded.
+func getElectorate(s *native.NativeContract, hash common.Hash) (*Electorate, error) {
+	value, err := get(s, electorateKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	electorate := new(Electorate)
+	if err := decodeStored(value, electorate); err != nil {
+		return nil, err
+	}
+	return electorate, nil
+}
+
+func delElectorate(s *native.NativeContract, hash common.Hash) {
+	del(s, electorateKey(hash))
+}
+
 // ====================================================================
 //
 // storage basic operations
@@ -487,12 +586,20 @@ func customGet(db *state.CacheDB, key []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	} else if value == nil || len(value) == 0 {
-		return nil, ErrEof
+		return nil, ErrNotFound
 	} else {
 		return value, nil
 	}
 }
 
+// decodeStored decodes the stored value in rlp, the failure is wrapped in ErrCorrupt.
+func decodeStored(enc []byte, val interface{}) error {
+	if err := rlp.DecodeBytes(enc, val); err != nil {
+		return fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	return nil
+}
+
 func customSet(db *state.CacheDB, key, value []byte) {
 	db.Put(key, value)
 }
@@ -501,12 +608,600 @@ func customDel(db *state.CacheDB, key []byte) {
 	db.Delete(key)
 }
 
+// ====================================================================
+//
+// `peers limit` storage
+//
+// ====================================================================
+func storePeersLimit(s *native.NativeContract, limit *PeersLimit) error {
+	value, err := rlp.EncodeToBytes(limit)
+	if err != nil {
+		return err
+	}
+	set(s, peersLimitKey(), value)
+	return nil
+}
+
+// getPeersLimit returns an empty limit if it never set.
+func getPeersLimit(s *native.NativeContract) (*PeersLimit, error) {
+	limit := new(PeersLimit)
+	value, err := get(s, peersLimitKey())
+	if errors.Is(err, ErrNotFound) {
+		return limit, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, limit); err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// ====================================================================
+//
+// `quorum rule` storage
+//
+// ====================================================================
+func storeQuorumRule(s *native.NativeContract, rule *QuorumRule) error {
+	return setQuorumRule(s.GetCacheDB(), rule)
+}
+
+func setQuorumRule(db *state.CacheDB, rule *QuorumRule) error {
+	value, err := rlp.EncodeToBytes(rule)
+	if err != nil {
+		return err
+	}
+	customSet(db, quorumRuleKey(), value)
+	return nil
+}
+
+// getQuorumRule returns the default rule if it never set.
+func getQuorumRule(s *native.NativeContract) (*QuorumRule, error) {
+	return readQuorumRule(s.GetCacheDB())
+}
+
+func readQuorumRule(db *state.CacheDB) (*QuorumRule, error) {
+	rule := new(QuorumRule)
+	value, err := customGet(db, quorumRuleKey())
+	if errors.Is(err, ErrNotFound) {
+		return rule, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// ====================================================================
+//
+// `epoch seed` storage
+//
+// ====================================================================
+func storeEpochSeed(s *native.NativeContract, epochID uint64, seed common.Hash) {
+	set(s, seedKey(epochID), seed.Bytes())
+}
+
+// getEpochSeed returns the randomness of epoch, epochs passed before seeds were recorded,
+// e.g: the genesis epoch or any epoch before governance v2, fall back to the hash of the
+// epoch itself.
+func getEpochSeed(s *native.NativeContract, epoch *EpochInfo) common.Hash {
+	if !s.ContractRef().IsGovV2() {
+		return crypto.Keccak256Hash(epoch.Hash().Bytes())
+	}
+	value, err := get(s, seedKey(epoch.ID))
+	if err != nil {
+		return crypto.Keccak256Hash(epoch.Hash().Bytes())
+	}
+	return common.BytesToHash(value)
+}
+
+// ====================================================================
+//
+// `vrf` storage
+//
+// ====================================================================
+func storeVrfKey(s *native.NativeContract, validator common.Address, pubKey []byte) {
+	set(s, vrfKeyKey(validator), pubKey)
+}
+
+func getVrfKey(s *native.NativeContract, validator common.Address) ([]byte, error) {
+	return get(s, vrfKeyKey(validator))
+}
+
+func storeVrfOutput(s *native.NativeContract, epochID uint64, validator common.Address, output []byte) {
+	set(s, vrfOutputKey(epochID, validator), output)
+}
+
+func getVrfOutput(s *native.NativeContract, epochID uint64, validator common.Address) ([]byte, error) {
+	return get(s, vrfOutputKey(epochID, validator))
+}
+
 // ====================================================================
 //
 // storage keys
 //
 // ====================================================================
 
+// ====================================================================
+//
+// `fee split` storage
+//
+// ====================================================================
+func storeFeeSplit(s *native.NativeContract, split *FeeSplit) error {
+	value, err := rlp.EncodeToBytes(split)
+	if err != nil {
+		return err
+	}
+	set(s, feeSplitKey(), value)
+	return nil
+}
+
+// getFeeSplit returns the zero split if it never set.
+func getFeeSplit(s *native.NativeContract) (*FeeSplit, error) {
+	return readFeeSplit(s.GetCacheDB())
+}
+
+func readFeeSplit(db *state.CacheDB) (*FeeSplit, error) {
+	split := new(FeeSplit)
+	value, err := customGet(db, feeSplitKey())
+	if errors.Is(err, ErrNotFound) {
+		return split, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, split); err != nil {
+		return nil, err
+	}
+	return split, nil
+}
+
+// ====================================================================
+//
+// `payout statement` storage
+//
+// ====================================================================
+func storePayout(s *native.NativeContract, statement *PayoutStatement) error {
+	value, err := rlp.EncodeToBytes(statement)
+	if err != nil {
+		return err
+	}
+	set(s, payoutKey(statement.EpochID, statement.Validator), value)
+	return nil
+}
+
+// getPayout returns the payout statement of the validator in the epoch, an empty statement is
+// returned if the validator earned nothing in the epoch.
+func getPayout(s *native.NativeContract, epochID uint64, validator common.Address) (*PayoutStatement, error) {
+	statement := &PayoutStatement{EpochID: epochID, Validator: validator, Fees: new(big.Int)}
+	value, err := get(s, payoutKey(epochID, validator))
+	if errors.Is(err, ErrNotFound) {
+		return statement, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, statement); err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+// ====================================================================
+//
+// `free duty` storage
+//
+// ====================================================================
+func storeFreeDuties(s *native.NativeContract, epochID uint64, member common.Address, num uint64) {
+	set(s, freeDutyKey(epochID, member), utils.GetUint64Bytes(num))
+}
+
+func getFreeDuties(s *native.NativeContract, epochID uint64, member common.Address) uint64 {
+	value, err := get(s, freeDutyKey(epochID, member))
+	if err != nil {
+		return 0
+	}
+	return utils.GetBytesUint64(value)
+}
+
+// ====================================================================
+//
+// `proposal actions` storage
+//
+// ====================================================================
+func storeActions(s *native.NativeContract, proposal common.Hash, actions [][]byte) error {
+	value, err := rlp.EncodeToBytes(actions)
+	if err != nil {
+		return err
+	}
+	set(s, actionsKey(proposal), value)
+	return nil
+}
+
+// getActions returns the actions attached to the proposal, nil is returned if there is none.
+func getActions(s *native.NativeContract, proposal common.Hash) ([][]byte, error) {
+	value, err := get(s, actionsKey(proposal))
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var actions [][]byte
+	if err := decodeStored(value, &actions); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// ====================================================================
+//
+// `misconduct report` storage
+//
+// ====================================================================
+func storeMisconduct(s *native.NativeContract, report *MisconductReport) error {
+	value, err := rlp.EncodeToBytes(report)
+	if err != nil {
+		return err
+	}
+	set(s, misconductKey(report.EpochID, report.Validator), value)
+	return nil
+}
+
+// getMisconduct returns the misconduct report of the validator in the epoch, an empty report is
+// returned if nothing recorded.
+func getMisconduct(s *native.NativeContract, epochID uint64, validator common.Address) (*MisconductReport, error) {
+	report := &MisconductReport{EpochID: epochID, Validator: validator}
+	value, err := get(s, misconductKey(epochID, validator))
+	if errors.Is(err, ErrNotFound) {
+		return report, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ====================================================================
+//
+// `sealed voting` storage
+//
+// ====================================================================
+func storeVotingSeal(s *native.NativeContract, seal *VotingSeal) error {
+	value, err := rlp.EncodeToBytes(seal)
+	if err != nil {
+		return err
+	}
+	set(s, votingSealKey(seal.EpochID), value)
+	return nil
+}
+
+func getVotingSeal(s *native.NativeContract, epochID uint64) (*VotingSeal, error) {
+	value, err := get(s, votingSealKey(epochID))
+	if err != nil {
+		return nil, err
+	}
+	seal := new(VotingSeal)
+	if err := decodeStored(value, seal); err != nil {
+		return nil, err
+	}
+	return seal, nil
+}
+
+func storeCommitment(s *native.NativeContract, epochID uint64, voter common.Address, commitment common.Hash) {
+	set(s, commitmentKey(epochID, voter), commitment.Bytes())
+}
+
+func getCommitment(s *native.NativeContract, epochID uint64, voter common.Address) common.Hash {
+	value, err := get(s, commitmentKey(epochID, voter))
+	if err != nil {
+		return common.EmptyHash
+	}
+	return common.BytesToHash(value)
+}
+
+func delCommitment(s *native.NativeContract, epochID uint64, voter common.Address) {
+	del(s, commitmentKey(epochID, voter))
+}
+
+// ====================================================================
+//
+// `expiry` storage
+//
+// ====================================================================
+func storeExpiryBucket(s *native.NativeContract, height uint64, bucket []*ExpiryEntry) error {
+	value, err := rlp.EncodeToBytes(bucket)
+	if err != nil {
+		return err
+	}
+	set(s, expiryBucketKey(height), value)
+	return nil
+}
+
+// getExpiryBucket returns the entries expire at the height, nil is returned if there is none.
+func getExpiryBucket(s *native.NativeContract, height uint64) ([]*ExpiryEntry, error) {
+	value, err := get(s, expiryBucketKey(height))
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var bucket []*ExpiryEntry
+	if err := decodeStored(value, &bucket); err != nil {
+		return nil, err
+	}
+	return bucket, nil
+}
+
+// storeExpiryArms records the heights of the expiry buckets whose sweep is not scheduled yet.
+func storeExpiryArms(s *native.NativeContract, heights []uint64) error {
+	if len(heights) == 0 {
+		del(s, expiryArmKey())
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(heights)
+	if err != nil {
+		return err
+	}
+	set(s, expiryArmKey(), value)
+	return nil
+}
+
+func getExpiryArms(s *native.NativeContract) ([]uint64, error) {
+	value, err := get(s, expiryArmKey())
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var heights []uint64
+	if err := decodeStored(value, &heights); err != nil {
+		return nil, err
+	}
+	return heights, nil
+}
+
+// ====================================================================
+//
+// `epoch change signs` storage
+//
+// ====================================================================
+func storeEpochChangeSigns(s *native.NativeContract, signs *EpochChangeSigns) error {
+	value, err := rlp.EncodeToBytes(signs)
+	if err != nil {
+		return err
+	}
+	set(s, epochChangeSignsKey(signs.EpochID), value)
+	return nil
+}
+
+// getEpochChangeSigns returns the signatures collected for the change to the epoch, an empty
+// record is returned if nothing signed.
+func getEpochChangeSigns(s *native.NativeContract, epochID uint64) (*EpochChangeSigns, error) {
+	return readEpochChangeSigns(s.GetCacheDB(), epochID)
+}
+
+func readEpochChangeSigns(db *state.CacheDB, epochID uint64) (*EpochChangeSigns, error) {
+	signs := &EpochChangeSigns{EpochID: epochID}
+	value, err := customGet(db, epochChangeSignsKey(epochID))
+	if errors.Is(err, ErrNotFound) {
+		return signs, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, signs); err != nil {
+		return nil, err
+	}
+	return signs, nil
+}
+
+// ====================================================================
+//
+// `validator group` storage, the records of auxiliary groups are kept in
+// the same encodings as the main consensus, in the namespaces of groups
+//
+// ====================================================================
+func storeGroupEpoch(s *native.NativeContract, name string, epoch *EpochInfo) error {
+	return putEpoch(s.GetCacheDB(), groupKey(name, SKP_EPOCH, epoch.Hash().Bytes()), epoch, s.ContractRef().IsStorageV2())
+}
+
+func getGroupEpoch(s *native.NativeContract, name string, epochHash common.Hash) (*EpochInfo, error) {
+	return readGroupEpoch(s.GetCacheDB(), name, epochHash)
+}
+
+func readGroupEpoch(db *state.CacheDB, name string, epochHash common.Hash) (*EpochInfo, error) {
+	return loadEpoch(db, groupKey(name, SKP_EPOCH, epochHash.Bytes()))
+}
+
+func storeGroupCurrentEpochHash(s *native.NativeContract, name string, epochHash common.Hash) {
+	set(s, groupKey(name, SKP_CUR_EPOCH), epochHash.Bytes())
+}
+
+// readGroupCurrentEpochHash returns ErrNotFound if the group is never created.
+func readGroupCurrentEpochHash(db *state.CacheDB, name string) (common.Hash, error) {
+	value, err := customGet(db, groupKey(name, SKP_CUR_EPOCH))
+	if err != nil {
+		return common.EmptyHash, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+func storeGroupEpochProof(s *native.NativeContract, name string, epochID uint64, epochHash common.Hash) {
+	set(s, groupKey(name, SKP_PROOF, utils.GetUint64Bytes(epochID)), epochHash.Bytes())
+}
+
+func getGroupEpochProof(s *native.NativeContract, name string, epochID uint64) common.Hash {
+	value, err := get(s, groupKey(name, SKP_PROOF, utils.GetUint64Bytes(epochID)))
+	if err != nil {
+		return common.EmptyHash
+	}
+	return common.BytesToHash(value)
+}
+
+func storeGroupProposals(s *native.NativeContract, name string, epochID uint64, list []common.Hash) error {
+	return setHashList(s, groupKey(name, SKP_PROPOSAL, utils.GetUint64Bytes(epochID)), list)
+}
+
+func getGroupProposals(s *native.NativeContract, name string, epochID uint64) ([]common.Hash, error) {
+	return getHashList(s, groupKey(name, SKP_PROPOSAL, utils.GetUint64Bytes(epochID)))
+}
+
+func storeGroupVotes(s *native.NativeContract, name string, proposal common.Hash, list []common.Address) error {
+	return setAddressList(s, groupKey(name, SKP_VOTE, proposal.Bytes()), list)
+}
+
+func getGroupVotes(s *native.NativeContract, name string, proposal common.Hash) ([]common.Address, error) {
+	return getAddressList(s, groupKey(name, SKP_VOTE, proposal.Bytes()))
+}
+
+func storeGroupVoteTo(s *native.NativeContract, name string, epochID uint64, voter common.Address, proposal common.Hash) {
+	set(s, groupKey(name, SKP_VOTE_TO, utils.GetUint64Bytes(epochID), voter.Bytes()), proposal.Bytes())
+}
+
+func delGroupVoteTo(s *native.NativeContract, name string, epochID uint64, voter common.Address) {
+	del(s, groupKey(name, SKP_VOTE_TO, utils.GetUint64Bytes(epochID), voter.Bytes()))
+}
+
+func findGroupVoteTo(s *native.NativeContract, name string, epochID uint64, voter common.Address) common.Hash {
+	value, err := get(s, groupKey(name, SKP_VOTE_TO, utils.GetUint64Bytes(epochID), voter.Bytes()))
+	if err != nil {
+		return common.EmptyHash
+	}
+	return common.BytesToHash(value)
+}
+
+func storeGroupElectorate(s *native.NativeContract, name string, proposal common.Hash, electorate *Electorate) error {
+	value, err := rlp.EncodeToBytes(electorate)
+	if err != nil {
+		return err
+	}
+	set(s, groupKey(name, SKP_ELECTORATE, proposal.Bytes()), value)
+	return nil
+}
+
+func getGroupElectorate(s *native.NativeContract, name string, proposal common.Hash) (*Electorate, error) {
+	value, err := get(s, groupKey(name, SKP_ELECTORATE, proposal.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	electorate := new(Electorate)
+	if err := decodeStored(value, electorate); err != nil {
+		return nil, err
+	}
+	return electorate, nil
+}
+
+func delGroupElectorate(s *native.NativeContract, name string, proposal common.Hash) {
+	del(s, groupKey(name, SKP_ELECTORATE, proposal.Bytes()))
+}
+
+func storeGroupQuorumRule(s *native.NativeContract, name string, rule *QuorumRule) error {
+	value, err := rlp.EncodeToBytes(rule)
+	if err != nil {
+		return err
+	}
+	set(s, groupKey(name, SKP_QUORUM_RULE), value)
+	return nil
+}
+
+// readGroupQuorumRule returns the default 2/3 rule if the rule of group is never set.
+func readGroupQuorumRule(db *state.CacheDB, name string) (*QuorumRule, error) {
+	rule := new(QuorumRule)
+	value, err := customGet(db, groupKey(name, SKP_QUORUM_RULE))
+	if errors.Is(err, ErrNotFound) {
+		return rule, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := decodeStored(value, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func storeGroups(s *native.NativeContract, names []string) error {
+	value, err := rlp.EncodeToBytes(names)
+	if err != nil {
+		return err
+	}
+	set(s, groupsKey(), value)
+	return nil
+}
+
+// getGroups returns the names of auxiliary groups in the order of creation.
+func getGroups(s *native.NativeContract) ([]string, error) {
+	value, err := get(s, groupsKey())
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var names []string
+	if err := decodeStored(value, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// setHashList stores the hash list under the key, and deletes the key if the list is empty.
+func setHashList(s *native.NativeContract, key []byte, list []common.Hash) error {
+	if len(list) == 0 {
+		del(s, key)
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(&HashList{List: list})
+	if err != nil {
+		return err
+	}
+	set(s, key, value)
+	return nil
+}
+
+// getHashList returns nil if the key is never set.
+func getHashList(s *native.NativeContract, key []byte) ([]common.Hash, error) {
+	value, err := get(s, key)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var data *HashList
+	if err := decodeStored(value, &data); err != nil {
+		return nil, err
+	}
+	return data.List, nil
+}
+
+// setAddressList stores the address list under the key, and deletes the key if the list is empty.
+func setAddressList(s *native.NativeContract, key []byte, list []common.Address) error {
+	if len(list) == 0 {
+		del(s, key)
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(&AddressList{List: list})
+	if err != nil {
+		return err
+	}
+	set(s, key, value)
+	return nil
+}
+
+// getAddressList returns nil if the key is never set.
+func getAddressList(s *native.NativeContract, key []byte) ([]common.Address, error) {
+	value, err := get(s, key)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var data *AddressList
+	if err := decodeStored(value, &data); err != nil {
+		return nil, err
+	}
+	return data.List, nil
+}
+
 func epochKey(epochHash common.Hash) []byte {
 	return utils.ConcatKey(this, []byte(SKP_EPOCH), epochHash.Bytes())
 }
@@ -531,6 +1226,10 @@ func voteToKey(epochID uint64, voter common.Address) []byte {
 	return utils.ConcatKey(this, []byte(SKP_VOTE_TO), utils.GetUint64Bytes(epochID), voter.Bytes())
 }
 
+func tallyKey(epochHash common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_TALLY), epochHash.Bytes())
+}
+
 func signKey(hash common.Hash) []byte {
 	return utils.ConcatKey(this, []byte(SKP_SIGN), hash.Bytes())
 }
@@ -538,3 +1237,78 @@ func signKey(hash common.Hash) []byte {
 func signerKey(hash common.Hash) []byte {
 	return utils.ConcatKey(this, []byte(SKP_SIGNER), hash.Bytes())
 }
+
+func peersLimitKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_PEERS_LIMIT))
+}
+
+func electorateKey(hash common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ELECTORATE), hash.Bytes())
+}
+
+func quorumRuleKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_QUORUM_RULE))
+}
+
+func seedKey(epochID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SEED), utils.GetUint64Bytes(epochID))
+}
+
+func vrfKeyKey(validator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_VRF_KEY), validator.Bytes())
+}
+
+func feeSplitKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_FEE_SPLIT))
+}
+
+func payoutKey(epochID uint64, validator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_PAYOUT), utils.GetUint64Bytes(epochID), validator.Bytes())
+}
+
+func freeDutyKey(epochID uint64, member common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_FREE_DUTY), utils.GetUint64Bytes(epochID), member.Bytes())
+}
+
+func actionsKey(proposal common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ACTIONS), proposal.Bytes())
+}
+
+func votingSealKey(epochID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SEAL), utils.GetUint64Bytes(epochID))
+}
+
+func commitmentKey(epochID uint64, voter common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_COMMITMENT), utils.GetUint64Bytes(epochID), voter.Bytes())
+}
+
+func vrfOutputKey(epochID uint64, validator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_VRF_OUTPUT), utils.GetUint64Bytes(epochID), validator.Bytes())
+}
+
+func misconductKey(epochID uint64, validator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_MISCONDUCT), utils.GetUint64Bytes(epochID), validator.Bytes())
+}
+
+func epochChangeSignsKey(epochID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_EC_SIGNS), utils.GetUint64Bytes(epochID))
+}
+
+func expiryBucketKey(height uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_EXPIRY), utils.GetUint64Bytes(height))
+}
+
+func expiryArmKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_EXPIRY_ARM))
+}
+
+// groupKey namespaces the storage key of the auxiliary group, the name is prefixed by its length
+// so that the keys of different groups never collide.
+func groupKey(name string, prefix string, fields ...[]byte) []byte {
+	args := append([][]byte{[]byte(SKP_GROUP), {byte(len(name))}, []byte(name), []byte(prefix)}, fields...)
+	return utils.ConcatKey(this, args...)
+}
+
+func groupsKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_GROUPS))
+}
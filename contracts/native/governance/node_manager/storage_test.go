@@ -19,6 +19,7 @@
 package node_manager
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -39,10 +40,46 @@ func TestStorageEpoch(t *testing.T) {
 	// epoch info should be nil after delete
 	delEpoch(testEmptyCtx, expect.Hash())
 	got, err = getEpoch(testEmptyCtx, expect.Hash())
-	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
 	assert.Nil(t, got)
 }
 
+func TestStorageEpochCompression(t *testing.T) {
+	cache := testEmptyCtx.GetCacheDB()
+	for _, compress := range []bool{false, true} {
+		expect := generateTestEpochInfo(2, 24, 100)
+		assert.NoError(t, setEpoch(cache, expect, compress))
+		enc, err := customGet(cache, epochKey(expect.Hash()))
+		assert.NoError(t, err)
+		assert.Equal(t, compress, enc[0] == compressedEpochPrefix)
+
+		// epochs written before and after the storage v2 fork are both readable
+		got, err := readEpoch(cache, expect.Hash())
+		assert.NoError(t, err)
+		assert.Equal(t, expect.Hash(), got.Hash())
+		assert.Equal(t, expect, got)
+		delEpoch(testEmptyCtx, expect.Hash())
+	}
+}
+
+func TestStorageCorrupt(t *testing.T) {
+	hash := generateTestHash(4321)
+	for _, value := range [][]byte{{0xc1}, {compressedEpochPrefix, 0xff}} {
+		set(testEmptyCtx, epochKey(hash), value)
+		_, err := getEpoch(testEmptyCtx, hash)
+		assert.ErrorIs(t, err, ErrCorrupt)
+		assert.False(t, errors.Is(err, ErrNotFound))
+	}
+	del(testEmptyCtx, epochKey(hash))
+
+	set(testEmptyCtx, signKey(hash), []byte{0xc1})
+	_, err := getSign(testEmptyCtx, hash)
+	assert.ErrorIs(t, err, ErrCorrupt)
+	del(testEmptyCtx, signKey(hash))
+	_, err = getSign(testEmptyCtx, hash)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
 func TestStorageEpochProof(t *testing.T) {
 	startEpochProofHash := EpochProofHash(StartEpoch)
 	assert.NotEqual(t, EpochProofDigest, startEpochProofHash)
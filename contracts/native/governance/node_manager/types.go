@@ -21,11 +21,13 @@ package node_manager
 import (
 	"fmt"
 	"io"
-	"math"
+	"math/big"
 	"strings"
 	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"golang.org/x/crypto/sha3"
 )
@@ -109,6 +111,10 @@ const (
 	ProposalStatusUnknown ProposalStatusType = 0
 	ProposalStatusPropose ProposalStatusType = 1
 	ProposalStatusPassed  ProposalStatusType = 2
+	// another proposal of the same epoch reached quorum first
+	ProposalStatusRejected ProposalStatusType = 3
+	// start height is too close to vote, it's never stored but derived in `proposals`
+	ProposalStatusExpired ProposalStatusType = 4
 )
 
 func (p ProposalStatusType) String() string {
@@ -117,23 +123,39 @@ func (p ProposalStatusType) String() string {
 		return "STATUS_PROPOSE"
 	case ProposalStatusPassed:
 		return "STATUS_PASSED"
+	case ProposalStatusRejected:
+		return "STATUS_REJECTED"
+	case ProposalStatusExpired:
+		return "STATUS_EXPIRED"
 	default:
 		return "STATUS_UNKNOWN"
 	}
 }
 
+// Versions of the epoch hash preimage, see `EpochInfo.HashPreimage`.
+const (
+	EpochHashV0 uint8 = 0 // legacy, the genesis epoch and proposals before the epoch hash v1 fork
+	EpochHashV1 uint8 = 1
+	EpochHashV2 uint8 = 2 // the proposer is a part of the identity of the proposal
+)
+
 type EpochInfo struct {
 	ID          uint64
 	Peers       *Peers
 	StartHeight uint64
-	Proposer    common.Address // hash generating without fields of `Proposer` and `Status`
+	Proposer    common.Address // hash generating without `Status`, and without `Proposer` before v2
 	Status      ProposalStatusType
+	Version     uint8 // version of the hash preimage, it's fixed once the epoch is proposed
 
 	hash atomic.Value
 }
 
 func (m *EpochInfo) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{m.ID, m.Peers, m.StartHeight, m.Proposer, uint8(m.Status)})
+	// legacy epochs are encoded without version to keep the stored bytes unchanged
+	if m.Version == EpochHashV0 {
+		return rlp.Encode(w, []interface{}{m.ID, m.Peers, m.StartHeight, m.Proposer, uint8(m.Status)})
+	}
+	return rlp.Encode(w, []interface{}{m.ID, m.Peers, m.StartHeight, m.Proposer, uint8(m.Status), m.Version})
 }
 
 func (m *EpochInfo) DecodeRLP(s *rlp.Stream) error {
@@ -143,12 +165,14 @@ func (m *EpochInfo) DecodeRLP(s *rlp.Stream) error {
 		StartHeight uint64
 		Proposer    common.Address
 		Status      uint8
+		Version     uint8 `rlp:"optional"`
 	}
 
 	if err := s.Decode(&data); err != nil {
 		return err
 	}
 	m.ID, m.Peers, m.StartHeight, m.Proposer, m.Status = data.ID, data.Peers, data.StartHeight, data.Proposer, ProposalStatusType(data.Status)
+	m.Version = data.Version
 	return nil
 }
 
@@ -163,24 +187,39 @@ func (m *EpochInfo) String() string {
 		m.Hash().Hex(), m.ID, pstr, m.StartHeight, m.Proposer.Hex(), m.Status.String())
 }
 
+// Hash returns the keccak256 of `HashPreimage`, it identifies the epoch in storage, votes,
+// consensus signs and epoch change proofs.
 func (m *EpochInfo) Hash() common.Hash {
 	if hash := m.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	var inf = struct {
-		ID          uint64
-		Peers       *Peers
-		StartHeight uint64
-	}{
-		ID:          m.ID,
-		Peers:       m.Peers,
-		StartHeight: m.StartHeight,
-	}
-	v := RLPHash(inf)
+	v := crypto.Keccak256Hash(m.HashPreimage())
 	m.hash.Store(v)
 	return v
 }
 
+// HashPreimage returns the exact bytes hashed into the epoch hash, so that external verifiers
+// could recompute it. Only the immutable fields are covered and `Status` is always excluded, since
+// v2 the hash is the identity of the proposal, which covers the `Proposer` as well.
+//
+//	peers = [[PubKey, Address], ...] in the stored order
+//	v0    = rlp([ID, [peers], StartHeight])
+//	v1    = 0x01 || rlp([ID, [peers], StartHeight])
+//	v2    = 0x02 || rlp([ID, [peers], StartHeight, Proposer])
+func (m *EpochInfo) HashPreimage() []byte {
+	switch m.Version {
+	case EpochHashV0:
+		body, _ := rlp.EncodeToBytes([]interface{}{m.ID, m.Peers, m.StartHeight})
+		return body
+	case EpochHashV1:
+		body, _ := rlp.EncodeToBytes([]interface{}{m.ID, m.Peers, m.StartHeight})
+		return append([]byte{m.Version}, body...)
+	default:
+		body, _ := rlp.EncodeToBytes([]interface{}{m.ID, m.Peers, m.StartHeight, m.Proposer})
+		return append([]byte{m.Version}, body...)
+	}
+}
+
 func (m *EpochInfo) Members() map[common.Address]struct{} {
 	if m == nil || m.Peers == nil || m.Peers.List == nil || len(m.Peers.List) == 0 {
 		return nil
@@ -203,12 +242,14 @@ func (m *EpochInfo) MemberList() []common.Address {
 	return list
 }
 
+// QuorumSize returns the quorum size under the default rule, the native contracts should
+// read the quorum with rule of the deployment by `QuorumSize`.
 func (m *EpochInfo) QuorumSize() int {
 	if m == nil || m.Peers == nil {
 		return 0
 	}
 	total := m.Peers.Len()
-	return int(math.Ceil(float64(2*total) / 3))
+	return (2*total + 2) / 3
 }
 
 func (m *EpochInfo) OldMemberNum(peers *Peers) int {
@@ -237,6 +278,180 @@ func (m *EpochInfo) OldMemberNum(peers *Peers) int {
 	return num
 }
 
+// PeersLimit restricts how the validator set changes in one epoch rotation, so that the
+// quorum composition can't swing wildly. zero value of the field means no limit.
+type PeersLimit struct {
+	Target    uint64 // target size of validator set, proposals can't move away from it
+	MaxChange uint64 // max number of members added or removed in one epoch
+	Nonce     uint64 // times of changes, used to distinguish the consensus signs
+}
+
+func (m *PeersLimit) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.Target, m.MaxChange, m.Nonce})
+}
+
+func (m *PeersLimit) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Target    uint64
+		MaxChange uint64
+		Nonce     uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Target, m.MaxChange, m.Nonce = data.Target, data.MaxChange, data.Nonce
+	return nil
+}
+
+// Check validates the proposal peers against current epoch.
+func (m *PeersLimit) Check(cur *EpochInfo, peers *Peers) error {
+	old := cur.OldMemberNum(peers)
+	added, removed := peers.Len()-old, cur.Peers.Len()-old
+	if m.MaxChange > 0 && (uint64(added) > m.MaxChange || uint64(removed) > m.MaxChange) {
+		return ErrPeersChange
+	}
+
+	distance := func(n int) uint64 {
+		if uint64(n) > m.Target {
+			return uint64(n) - m.Target
+		}
+		return m.Target - uint64(n)
+	}
+	if m.Target > 0 && distance(peers.Len()) > distance(cur.Peers.Len()) {
+		return ErrPeersTarget
+	}
+	return nil
+}
+
+// QuorumRule decides how many validators are required to pass the votes and consensus signs.
+// zero value of the rule is the default 2/3 quorum, and only one of the fraction and the
+// threshold is allowed to be set.
+type QuorumRule struct {
+	Numerator   uint64 // quorum is Numerator/Denominator of the validators
+	Denominator uint64
+	Strict      bool   // quorum must be more than the fraction rather than reach it, e.g: 2/3+1
+	Threshold   uint64 // fixed number of validators, used in permissioned deployments
+	Nonce       uint64 // times of changes, used to distinguish the consensus signs
+}
+
+func (m *QuorumRule) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.Numerator, m.Denominator, m.Strict, m.Threshold, m.Nonce})
+}
+
+func (m *QuorumRule) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Numerator   uint64
+		Denominator uint64
+		Strict      bool
+		Threshold   uint64
+		Nonce       uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Numerator, m.Denominator, m.Strict, m.Threshold, m.Nonce = data.Numerator, data.Denominator, data.Strict, data.Threshold, data.Nonce
+	return nil
+}
+
+// Validate checks the rule, a fraction rule should be more than half of validators at least to
+// avoid conflicting decisions.
+func (m *QuorumRule) Validate() error {
+	if m.Threshold > 0 {
+		if m.Denominator != 0 || m.Numerator != 0 || m.Strict || m.Threshold > uint64(MaxProposalPeersLen) {
+			return ErrInvalidQuorumRule
+		}
+		return nil
+	}
+	if m.Denominator == 0 {
+		if m.Numerator != 0 || m.Strict {
+			return ErrInvalidQuorumRule
+		}
+		return nil
+	}
+	if m.Numerator > m.Denominator || m.Denominator > uint64(MaxProposalPeersLen) {
+		return ErrInvalidQuorumRule
+	}
+	if double := 2 * m.Numerator; double < m.Denominator || (double == m.Denominator && !m.Strict) {
+		return ErrInvalidQuorumRule
+	}
+	return nil
+}
+
+// Size returns the quorum size of `total` validators, it never exceeds the total number so that
+// the quorum is always reachable.
+func (m *QuorumRule) Size(total int) int {
+	var size uint64
+	n := uint64(total)
+	switch {
+	case m.Threshold > 0:
+		size = m.Threshold
+	case m.Denominator > 0 && m.Strict:
+		size = n*m.Numerator/m.Denominator + 1
+	case m.Denominator > 0:
+		size = (n*m.Numerator + m.Denominator - 1) / m.Denominator
+	default:
+		size = (2*n + 2) / 3
+	}
+	if size > n {
+		size = n
+	}
+	return int(size)
+}
+
+// Electorate is the snapshot of validator set which a proposal or consensus sign is bound to, the
+// votes are counted only while the epoch is still in force, with the quorum size recorded at the
+// beginning of the tally.
+type Electorate struct {
+	EpochHash common.Hash
+	Quorum    uint64
+}
+
+func (m *Electorate) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.EpochHash, m.Quorum})
+}
+
+func (m *Electorate) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		EpochHash common.Hash
+		Quorum    uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.EpochHash, m.Quorum = data.EpochHash, data.Quorum
+	return nil
+}
+
+// ProposalDisposition is the final or current state of a proposal of epoch change.
+type ProposalDisposition struct {
+	Hash     common.Hash
+	Proposer common.Address
+	Status   ProposalStatusType
+	Votes    uint64
+}
+
+func (m *ProposalDisposition) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.Hash, m.Proposer, uint8(m.Status), m.Votes})
+}
+
+func (m *ProposalDisposition) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Hash     common.Hash
+		Proposer common.Address
+		Status   uint8
+		Votes    uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Hash, m.Proposer, m.Status, m.Votes = data.Hash, data.Proposer, ProposalStatusType(data.Status), data.Votes
+	return nil
+}
+
 type HashList struct {
 	List []common.Hash
 }
@@ -314,9 +529,231 @@ func (m *ConsensusSign) Hash() common.Hash {
 	return v
 }
 
+// FeeSplitBase is the denominator of the fee split rates, which are in basis points.
+const FeeSplitBase uint64 = 10000
+
+// FeeSplit decides how the transaction fees of a block are shared, the burned and the treasury
+// parts are taken from the fee of each transaction before it's paid to the proposer, and the rest
+// is kept by the proposer. zion has no base fee, so the split applies to the whole fees, and the
+// zero value of the split leaves all of the fees to the proposer.
+type FeeSplit struct {
+	BurnRate     uint64
+	TreasuryRate uint64
+	Treasury     common.Address
+	Nonce        uint64 // times of changes, used to distinguish the consensus signs
+}
+
+func (m *FeeSplit) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.BurnRate, m.TreasuryRate, m.Treasury, m.Nonce})
+}
+
+func (m *FeeSplit) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		BurnRate     uint64
+		TreasuryRate uint64
+		Treasury     common.Address
+		Nonce        uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.BurnRate, m.TreasuryRate, m.Treasury, m.Nonce = data.BurnRate, data.TreasuryRate, data.Treasury, data.Nonce
+	return nil
+}
+
+// Validate checks that the rates don't exceed the fees, and the treasury is set if it's paid.
+func (m *FeeSplit) Validate() error {
+	if m.BurnRate > FeeSplitBase || m.TreasuryRate > FeeSplitBase-m.BurnRate {
+		return ErrInvalidFeeSplit
+	}
+	if m.TreasuryRate > 0 && m.Treasury == common.EmptyAddress {
+		return ErrInvalidFeeSplit
+	}
+	return nil
+}
+
+// Split returns the burned and the treasury parts of the fees.
+func (m *FeeSplit) Split(fees *big.Int) (burn, treasury *big.Int) {
+	base := new(big.Int).SetUint64(FeeSplitBase)
+	burn = new(big.Int).Mul(fees, new(big.Int).SetUint64(m.BurnRate))
+	burn.Div(burn, base)
+	treasury = new(big.Int).Mul(fees, new(big.Int).SetUint64(m.TreasuryRate))
+	treasury.Div(treasury, base)
+	return burn, treasury
+}
+
+// PayoutStatement is the earnings of a validator in an epoch. validators are paid by the fees of
+// the blocks they proposed except the burned and the treasury parts, there is neither commission
+// nor slashing in zion, so the statement only accumulates the fees and the number of blocks.
+type PayoutStatement struct {
+	EpochID   uint64
+	Validator common.Address
+	Blocks    uint64
+	Fees      *big.Int
+}
+
+func (m *PayoutStatement) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.EpochID, m.Validator, m.Blocks, m.Fees})
+}
+
+func (m *PayoutStatement) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		EpochID   uint64
+		Validator common.Address
+		Blocks    uint64
+		Fees      *big.Int
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.EpochID, m.Validator, m.Blocks, m.Fees = data.EpochID, data.Validator, data.Blocks, data.Fees
+	return nil
+}
+
+// MisconductReport is the contradictory governance behavior of a validator in an epoch, which is
+// accounted by kind.
+type MisconductReport struct {
+	EpochID           uint64
+	Validator         common.Address
+	VoteSwitches      uint64
+	RejectedProposals uint64
+	Equivocations     uint64
+}
+
+func (m *MisconductReport) Empty() bool {
+	return m.VoteSwitches == 0 && m.RejectedProposals == 0 && m.Equivocations == 0
+}
+
+func (m *MisconductReport) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.EpochID, m.Validator, m.VoteSwitches, m.RejectedProposals, m.Equivocations})
+}
+
+func (m *MisconductReport) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		EpochID           uint64
+		Validator         common.Address
+		VoteSwitches      uint64
+		RejectedProposals uint64
+		Equivocations     uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.EpochID, m.Validator, m.VoteSwitches, m.RejectedProposals, m.Equivocations = data.EpochID, data.Validator, data.VoteSwitches, data.RejectedProposals, data.Equivocations
+	return nil
+}
+
+// VotingSeal is the commit-reveal schedule of the votes to the proposals of an epoch, the votes
+// are committed by hash until `CommitEnd`, revealed until `RevealEnd`, and tallied after that.
+type VotingSeal struct {
+	EpochID   uint64
+	CommitEnd uint64
+	RevealEnd uint64
+	Tallied   bool
+}
+
+func (m *VotingSeal) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.EpochID, m.CommitEnd, m.RevealEnd, m.Tallied})
+}
+
+func (m *VotingSeal) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		EpochID   uint64
+		CommitEnd uint64
+		RevealEnd uint64
+		Tallied   bool
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.EpochID, m.CommitEnd, m.RevealEnd, m.Tallied = data.EpochID, data.CommitEnd, data.RevealEnd, data.Tallied
+	return nil
+}
+
+// VoteCommitment is the hash committed by the voter in the sealed voting, the salt should be kept
+// secret by the voter until revealing.
+func VoteCommitment(epochID uint64, proposal common.Hash, voter common.Address, salt []byte) common.Hash {
+	return RLPHash([]interface{}{epochID, proposal, voter, salt})
+}
+
+// EjectionEvidence proves that the key of a validator is compromised, it consists of two different
+// headers of the same height, both sealed by the validator as proposer, which an honest validator
+// never does.
+type EjectionEvidence struct {
+	Header1 *types.Header
+	Header2 *types.Header
+}
+
+// Verify checks the evidence against the validator.
+func (m *EjectionEvidence) Verify(validator common.Address) error {
+	if m.Header1 == nil || m.Header2 == nil {
+		return fmt.Errorf("missing header")
+	}
+	if m.Header1.Number == nil || m.Header2.Number == nil || m.Header1.Number.Cmp(m.Header2.Number) != 0 {
+		return fmt.Errorf("headers of different height")
+	}
+	if m.Header1.Hash() == m.Header2.Hash() {
+		return fmt.Errorf("same header")
+	}
+	for _, header := range []*types.Header{m.Header1, m.Header2} {
+		signer, err := recoverProposer(header)
+		if err != nil {
+			return err
+		}
+		if signer != validator {
+			return fmt.Errorf("header %s not sealed by %s", header.Hash().Hex(), validator.Hex())
+		}
+	}
+	return nil
+}
+
+// recoverProposer recovers the address of proposer from the seal of hotstuff header.
+func recoverProposer(header *types.Header) (common.Address, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return common.EmptyAddress, err
+	}
+	sigHash := RLPHash(types.HotstuffFilteredHeader(header, false))
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(sigHash.Bytes()), extra.Seal)
+	if err != nil {
+		return common.EmptyAddress, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
 func RLPHash(v interface{}) (h common.Hash) {
 	hw := sha3.NewLegacyKeccak256()
 	rlp.Encode(hw, v)
 	hw.Sum(h[:0])
 	return h
 }
+
+// ExpiryEntry is the bookkeeping entries to be dropped, `Hash` is the hash of the consensus sign
+// and `EpochID` is the passed epoch of the proposals.
+type ExpiryEntry struct {
+	Kind    ExpiryKind
+	Hash    common.Hash
+	EpochID uint64
+}
+
+func (m *ExpiryEntry) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{uint8(m.Kind), m.Hash, m.EpochID})
+}
+
+func (m *ExpiryEntry) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Kind    uint8
+		Hash    common.Hash
+		EpochID uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Kind, m.Hash, m.EpochID = ExpiryKind(data.Kind), data.Hash, data.EpochID
+	return nil
+}
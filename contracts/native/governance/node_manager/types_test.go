@@ -21,6 +21,8 @@ package node_manager
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 )
@@ -80,6 +82,142 @@ func TestEpochInfoType(t *testing.T) {
 	t.Log(got.String())
 }
 
+// the golden vectors lock the epoch hash down, any change of them breaks the consensus
+func TestEpochInfoHash(t *testing.T) {
+	newEpoch := func(version uint8) *EpochInfo {
+		return &EpochInfo{
+			ID: 2,
+			Peers: &Peers{List: []*PeerInfo{
+				{PubKey: "0x02aa", Address: common.HexToAddress("0x1111111111111111111111111111111111111111")},
+				{PubKey: "0x03bb", Address: common.HexToAddress("0x2222222222222222222222222222222222222222")},
+			}},
+			StartHeight: 1000,
+			Proposer:    common.HexToAddress("0x1111111111111111111111111111111111111111"),
+			Version:     version,
+		}
+	}
+	cases := []struct {
+		Version  uint8
+		Preimage string
+		Hash     string
+	}{
+		{EpochHashV0, "0xf84202f83cf83adc86307830326161941111111111111111111111111111111111111111dc863078303362629422222222222222222222222222222222222222228203e8", "0x203eda20bc15ace0f96424ac12f605dbd920ecafbcde3111a8494e72272996c9"},
+		{EpochHashV1, "0x01f84202f83cf83adc86307830326161941111111111111111111111111111111111111111dc863078303362629422222222222222222222222222222222222222228203e8", "0xae94fd4aac8d0151afee2aac69f5d4d1b3c1529a215a51ecb9055a166452ed2a"},
+		{EpochHashV2, "0x02f85702f83cf83adc86307830326161941111111111111111111111111111111111111111dc863078303362629422222222222222222222222222222222222222228203e8941111111111111111111111111111111111111111", "0x7a5b6e5fa660021f3e71518c3f1f4bf19730d54a1535272abb869e31019b8130"},
+	}
+	for _, c := range cases {
+		epoch := newEpoch(c.Version)
+		assert.Equal(t, c.Preimage, hexutil.Encode(epoch.HashPreimage()))
+		assert.Equal(t, common.HexToHash(c.Hash), epoch.Hash())
+
+		// status is excluded, and proposer is excluded before v2
+		changed := newEpoch(c.Version)
+		changed.Status = ProposalStatusRejected
+		assert.Equal(t, epoch.Hash(), changed.Hash())
+		other := newEpoch(c.Version)
+		other.Proposer = common.HexToAddress("0x2222222222222222222222222222222222222222")
+		assert.Equal(t, c.Version != EpochHashV2, epoch.Hash() == other.Hash())
+
+		// version survives the storage encoding
+		enc, err := rlp.EncodeToBytes(changed)
+		assert.NoError(t, err)
+		var got *EpochInfo
+		assert.NoError(t, rlp.DecodeBytes(enc, &got))
+		assert.Equal(t, epoch.Hash(), got.Hash())
+	}
+
+	// v0 is the legacy hash of the epochs stored before the fork
+	legacy := newEpoch(EpochHashV0)
+	assert.Equal(t, RLPHash(struct {
+		ID          uint64
+		Peers       *Peers
+		StartHeight uint64
+	}{legacy.ID, legacy.Peers, legacy.StartHeight}), legacy.Hash())
+	enc, err := rlp.EncodeToBytes([]interface{}{legacy.ID, legacy.Peers, legacy.StartHeight, legacy.Proposer, uint8(legacy.Status)})
+	assert.NoError(t, err)
+	stored, err := rlp.EncodeToBytes(legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, enc, stored)
+}
+
+func TestPeersLimitType(t *testing.T) {
+	expect := &PeersLimit{Target: 7, MaxChange: 2, Nonce: 1}
+	enc, err := rlp.EncodeToBytes(expect)
+	assert.NoError(t, err)
+
+	var got *PeersLimit
+	assert.NoError(t, rlp.DecodeBytes(enc, &got))
+	assert.Equal(t, expect, got)
+
+	cur := generateTestEpochInfo(1, 0, 6)
+	peers := func(keep, add int) *Peers {
+		list := append([]*PeerInfo{}, cur.Peers.List[:keep]...)
+		return &Peers{List: append(list, generateTestPeers(add).List...)}
+	}
+
+	assert.NoError(t, (&PeersLimit{}).Check(cur, peers(1, 20)))
+	assert.NoError(t, expect.Check(cur, peers(6, 1)))
+	assert.NoError(t, expect.Check(cur, peers(5, 2)))
+	assert.Equal(t, ErrPeersChange, expect.Check(cur, peers(6, 3)))
+	assert.Equal(t, ErrPeersChange, expect.Check(cur, peers(3, 3)))
+	assert.Equal(t, ErrPeersTarget, expect.Check(cur, peers(5, 0)))
+	assert.Equal(t, ErrPeersTarget, (&PeersLimit{Target: 6}).Check(cur, peers(6, 1)))
+}
+
+func TestQuorumRuleType(t *testing.T) {
+	expect := &QuorumRule{Numerator: 2, Denominator: 3, Strict: true, Nonce: 1}
+	enc, err := rlp.EncodeToBytes(expect)
+	assert.NoError(t, err)
+
+	var got *QuorumRule
+	assert.NoError(t, rlp.DecodeBytes(enc, &got))
+	assert.Equal(t, expect, got)
+
+	for _, v := range []*QuorumRule{
+		{},
+		{Numerator: 2, Denominator: 3},
+		{Numerator: 1, Denominator: 2, Strict: true},
+		{Numerator: 1, Denominator: 1},
+		{Threshold: 3},
+	} {
+		assert.NoError(t, v.Validate())
+	}
+	for _, v := range []*QuorumRule{
+		{Numerator: 1},
+		{Strict: true},
+		{Numerator: 1, Denominator: 2},
+		{Numerator: 1, Denominator: 3, Strict: true},
+		{Numerator: 4, Denominator: 3},
+		{Numerator: 2, Denominator: 3, Threshold: 3},
+		{Threshold: uint64(MaxProposalPeersLen + 1)},
+	} {
+		assert.Equal(t, ErrInvalidQuorumRule, v.Validate())
+	}
+
+	cases := []struct {
+		rule  *QuorumRule
+		total int
+		size  int
+	}{
+		{&QuorumRule{}, 4, 3},
+		{&QuorumRule{}, 7, 5},
+		{&QuorumRule{}, 9, 6},
+		{&QuorumRule{Numerator: 2, Denominator: 3, Strict: true}, 4, 3},
+		{&QuorumRule{Numerator: 2, Denominator: 3, Strict: true}, 9, 7},
+		{&QuorumRule{Numerator: 3, Denominator: 4}, 8, 6},
+		{&QuorumRule{Numerator: 3, Denominator: 4}, 9, 7},
+		{&QuorumRule{Threshold: 3}, 10, 3},
+		{&QuorumRule{Threshold: 5}, 4, 4},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.size, c.rule.Size(c.total), "rule %+v total %d", c.rule, c.total)
+	}
+	for n := 0; n <= MaxProposalPeersLen; n++ {
+		epoch := &EpochInfo{Peers: &Peers{List: make([]*PeerInfo, n)}}
+		assert.Equal(t, epoch.QuorumSize(), new(QuorumRule).Size(n))
+	}
+}
+
 func TestHashListType(t *testing.T) {
 	expect := generateTestHashList(12)
 
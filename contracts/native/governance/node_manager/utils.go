@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -37,7 +38,7 @@ func StoreGenesisEpoch(s *state.StateDB, peers *Peers) (*EpochInfo, error) {
 	}
 
 	// store current epoch and epoch info
-	if err := setEpoch(cache, epoch); err != nil {
+	if err := setEpoch(cache, epoch, false); err != nil {
 		return nil, err
 	}
 
@@ -47,6 +48,17 @@ func StoreGenesisEpoch(s *state.StateDB, peers *Peers) (*EpochInfo, error) {
 	return epoch, nil
 }
 
+// epochHashVersion returns the hash version of the epochs proposed at the block.
+func epochHashVersion(s *native.NativeContract) uint8 {
+	if s.ContractRef().IsEpochHashV2() {
+		return EpochHashV2
+	}
+	if s.ContractRef().IsEpochHashV1() {
+		return EpochHashV1
+	}
+	return EpochHashV0
+}
+
 func GetCurrentEpoch(s *native.NativeContract) (*EpochInfo, error) {
 	epochHash, err := getCurrentEpochHash(s)
 	if err != nil {
@@ -59,6 +71,156 @@ func GetCurrentEpoch(s *native.NativeContract) (*EpochInfo, error) {
 	return epoch, nil
 }
 
+// StoreGenesisQuorumRule sets the quorum rule of the deployment at genesis.
+func StoreGenesisQuorumRule(s *state.StateDB, rule *QuorumRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	return setQuorumRule((*state.CacheDB)(s), rule)
+}
+
+// QuorumSize returns the quorum size of the epoch under the quorum rule of the deployment, all
+// validators are required if the rule is unreadable. the rule takes effect since governance v2,
+// and the default 2/3 quorum is used before that.
+func QuorumSize(s *native.NativeContract, epoch *EpochInfo) int {
+	if epoch == nil || epoch.Peers == nil {
+		return 0
+	}
+	if !s.ContractRef().IsGovV2() {
+		return epoch.QuorumSize()
+	}
+	rule, err := getQuorumRule(s)
+	if err != nil {
+		logger.Error("get quorum rule failed", "err", err)
+		return epoch.Peers.Len()
+	}
+	return rule.Size(epoch.Peers.Len())
+}
+
+// GetCurrentEpochHash reads the current epoch hash from state directly, it's used out of the
+// native contract context, e.g. verifying trusted checkpoints while importing blocks.
+func GetCurrentEpochHash(s *state.StateDB) (common.Hash, error) {
+	value, err := customGet((*state.CacheDB)(s), curEpochKey())
+	if err != nil {
+		return common.EmptyHash, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// ReadFeeSplit reads the fee split in force from state directly, it's used out of the native
+// contract context, e.g. paying the transaction fees in state transition.
+func ReadFeeSplit(s *state.StateDB) (*FeeSplit, error) {
+	return readFeeSplit((*state.CacheDB)(s))
+}
+
+// ReadCurrentEpoch reads the current epoch from state directly, it's used out of the native
+// contract context, e.g. reporting the bridge health.
+func ReadCurrentEpoch(s *state.StateDB) (*EpochInfo, error) {
+	epochHash, err := GetCurrentEpochHash(s)
+	if err != nil {
+		return nil, err
+	}
+	return readEpoch((*state.CacheDB)(s), epochHash)
+}
+
+// GetEpochSnapshot returns the validator set of the current or a passed epoch by id, which is
+// rebuilt from state as `GetEpochChangeEvents` does. proof of the genesis epoch is not stored, so
+// the genesis epoch is only readable from the state in which it is still the current one.
+func GetEpochSnapshot(s *state.StateDB, epochID uint64) (*types.EpochChangeEvent, error) {
+	cache := (*state.CacheDB)(s)
+	cur, err := ReadCurrentEpoch(s)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := readQuorumRule(cache)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := cur
+	if epochID > cur.ID {
+		return nil, fmt.Errorf("epoch %d not started, current epoch %d", epochID, cur.ID)
+	} else if epochID < cur.ID {
+		hash, _ := readEpochProof(cache, epochID)
+		if hash == common.EmptyHash {
+			return nil, fmt.Errorf("proof of epoch %d not exist", epochID)
+		}
+		if epoch, err = readEpoch(cache, hash); err != nil {
+			return nil, fmt.Errorf("read epoch %d failed: %v", epochID, err)
+		}
+	}
+	var prevHash common.Hash
+	if epochID > StartEpoch {
+		prevHash, _ = readEpochProof(cache, epochID-1)
+	}
+	ev := newEpochChangeEvent(prevHash, epoch, rule.Size(epoch.Peers.Len()))
+	return &ev, nil
+}
+
+// GetEpochChangeEvents returns the epoch change events of the passed epochs which start within
+// the height range [start, end] in ascending order. the events are rebuilt from state, so that
+// the components started in the middle of an epoch are able to reconstruct the validator set
+// history. the genesis epoch is never changed to, and it is not included, besides, proof of the
+// genesis epoch is not stored, so `PrevHash` of the event changed from genesis is empty, and
+// `QuorumSize` is calculated with the quorum rule in force rather than the historical ones.
+func GetEpochChangeEvents(s *state.StateDB, start, end uint64) ([]types.EpochChangeEvent, error) {
+	if end < start {
+		return nil, fmt.Errorf("invalid height range [%d, %d]", start, end)
+	}
+	cache := (*state.CacheDB)(s)
+	curHash, err := GetCurrentEpochHash(s)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := readEpoch(cache, curHash)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := readQuorumRule(cache)
+	if err != nil {
+		return nil, err
+	}
+
+	// start heights of the passed epochs are increasing with the epoch id
+	var list []types.EpochChangeEvent
+	for id := cur.ID; id > StartEpoch; id-- {
+		hash, _ := readEpochProof(cache, id)
+		if hash == common.EmptyHash {
+			return nil, fmt.Errorf("proof of epoch %d not exist", id)
+		}
+		epoch, err := readEpoch(cache, hash)
+		if err != nil {
+			return nil, fmt.Errorf("read epoch %d failed: %v", id, err)
+		}
+		if epoch.StartHeight < start {
+			break
+		}
+		if epoch.StartHeight <= end {
+			prevHash, _ := readEpochProof(cache, id-1)
+			list = append(list, newEpochChangeEvent(prevHash, epoch, rule.Size(epoch.Peers.Len())))
+		}
+	}
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list, nil
+}
+
+// ReplayEpochChange sends the epoch change events of the passed epochs which start within the
+// height range [start, end] to the channel in ascending order, and returns the number of events
+// sent. it should be called after subscribing the channel with `SubscribeEpochChange` to avoid
+// missing any event, and the subscriber should drop the duplicated events by epoch hash.
+func ReplayEpochChange(s *state.StateDB, start, end uint64, ch chan<- types.EpochChangeEvent) (int, error) {
+	list, err := GetEpochChangeEvents(s, start, end)
+	if err != nil {
+		return 0, err
+	}
+	for _, ev := range list {
+		ch <- ev
+	}
+	return len(list), nil
+}
+
 //
 //func getCurEpoch(cache *state.CacheDB) (*EpochInfo, error) {
 //	// get current hash
@@ -83,14 +245,21 @@ func GetCurrentEpoch(s *native.NativeContract) (*EpochInfo, error) {
 //	return epoch, nil
 //}
 
-func checkAuthority(origin, caller common.Address, epoch *EpochInfo) error {
+// RelayerChecker reports whether the account holds the relayer role of the native contract, it's
+// installed by the access control contract, which depends on node manager itself.
+var RelayerChecker func(s *native.NativeContract, contract, account common.Address) bool
+
+// checkAuthority requires the tx origin to be a validator of the epoch calling node manager
+// directly. since the access control fork, the validator is able to call through the contract
+// holding the relayer role of node manager as well, e.g. the multisig wallet of the validator.
+func checkAuthority(s *native.NativeContract, origin, caller common.Address, epoch *EpochInfo) error {
 	if epoch == nil || epoch.Peers == nil || epoch.Peers.List == nil {
 		return fmt.Errorf("invalid epoch")
 	}
 	if origin == common.EmptyAddress || caller == common.EmptyAddress {
 		return fmt.Errorf("origin/caller is empty address")
 	}
-	if origin != caller {
+	if origin != caller && !isRelayer(s, caller) {
 		return fmt.Errorf("origin must be caller")
 	}
 	for _, v := range epoch.Peers.List {
@@ -101,7 +270,16 @@ func checkAuthority(origin, caller common.Address, epoch *EpochInfo) error {
 	return fmt.Errorf("tx origin %s is not valid validator", origin.Hex())
 }
 
-func checkPeer(peer *PeerInfo) error {
+func isRelayer(s *native.NativeContract, caller common.Address) bool {
+	if RelayerChecker == nil || !s.ContractRef().IsAccessControl() {
+		return false
+	}
+	return RelayerChecker(s, this, caller)
+}
+
+// CheckPeer checks the peer of the epoch proposal, the public key must be a compressed secp256k1
+// key in hex which derives the address of the peer.
+func CheckPeer(peer *PeerInfo) error {
 	if peer == nil || peer.Address == common.EmptyAddress || peer.PubKey == "" {
 		return fmt.Errorf("invalid peer")
 	}
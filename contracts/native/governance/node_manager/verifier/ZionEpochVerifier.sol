@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: LGPL-3.0
+
+pragma solidity >=0.8.5 <0.9.0;
+
+/// @title ZionEpochVerifier
+/// @notice light client of the zion validator set on an evm chain. it's initialized with a trusted
+/// epoch snapshot, e.g: `node_manager.GetEpochSnapshot`, and follows the epoch changes proved by the
+/// signatures of the validators in force, which are collected by `signEpochChange` of the node
+/// manager and packed by `node_manager.EpochChangeProof.Pack`.
+/// @dev `node_manager.VerifyEpochChangeProof` is the reference of `changeEpoch`, keep them the same.
+contract ZionEpochVerifier {
+    bytes32 public constant EPOCH_CHANGE_TYPEHASH =
+        keccak256(
+            "ZionEpochChange(uint64 chainId,bytes32 prevHash,bytes32 hash,uint64 id,uint64 startHeight,uint64 quorum,address[] validators)"
+        );
+
+    // the upper bound of `s` in the signatures, the malleable ones are rejected
+    uint256 private constant SECP256K1N_HALF = 0x7FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF5D576E7357A4501DDFE92F46681B20A0;
+
+    uint64 public immutable zionChainId;
+
+    bytes32 public epochHash;
+    uint64 public epochId;
+    uint64 public startHeight;
+    uint64 public quorum;
+    address[] private validators;
+    mapping(bytes32 => mapping(address => bool)) private isValidator;
+
+    event EpochChanged(uint64 ID, bytes32 Hash, uint64 StartHeight);
+
+    constructor(
+        uint64 chainId,
+        bytes32 hash,
+        uint64 id,
+        uint64 height,
+        uint64 quorumSize,
+        address[] memory members
+    ) {
+        zionChainId = chainId;
+        setEpoch(hash, id, height, quorumSize, members);
+    }
+
+    /// @notice validators of the epoch in force
+    function currentValidators() external view returns (address[] memory) {
+        return validators;
+    }
+
+    /// @notice changes to the next epoch which is signed by the quorum of the validators in force,
+    /// the signatures are ordered by the signer address ascending.
+    function changeEpoch(
+        bytes32 prevHash,
+        bytes32 hash,
+        uint64 id,
+        uint64 height,
+        uint64 quorumSize,
+        address[] calldata members,
+        bytes[] calldata signatures
+    ) external {
+        require(prevHash == epochHash, "previous epoch mismatch");
+        require(id == epochId + 1, "epoch id not continuous");
+
+        bytes32 digest = keccak256(
+            abi.encode(EPOCH_CHANGE_TYPEHASH, zionChainId, prevHash, hash, id, height, quorumSize, members)
+        );
+        address last;
+        uint256 signed;
+        for (uint256 i = 0; i < signatures.length; i++) {
+            address signer = recover(digest, signatures[i]);
+            require(signer > last, "signers not ascending");
+            require(isValidator[epochHash][signer], "signer not validator");
+            last = signer;
+            signed++;
+        }
+        require(signed >= quorum, "signatures not enough");
+        setEpoch(hash, id, height, quorumSize, members);
+    }
+
+    function setEpoch(
+        bytes32 hash,
+        uint64 id,
+        uint64 height,
+        uint64 quorumSize,
+        address[] memory members
+    ) private {
+        require(quorumSize > 0 && quorumSize <= members.length, "invalid quorum");
+        for (uint256 i = 0; i < members.length; i++) {
+            require(!isValidator[hash][members[i]], "duplicate validator");
+            isValidator[hash][members[i]] = true;
+        }
+        epochHash = hash;
+        epochId = id;
+        startHeight = height;
+        quorum = quorumSize;
+        validators = members;
+        emit EpochChanged(id, hash, height);
+    }
+
+    function recover(bytes32 digest, bytes calldata signature) private pure returns (address) {
+        require(signature.length == 65, "invalid signature length");
+        bytes32 r = bytes32(signature[0:32]);
+        bytes32 s = bytes32(signature[32:64]);
+        uint8 v = uint8(signature[64]);
+        if (v < 27) {
+            v += 27;
+        }
+        require(v == 27 || v == 28, "invalid signature v");
+        require(uint256(s) <= SECP256K1N_HALF, "invalid signature s");
+        address signer = ecrecover(digest, v, r, s);
+        require(signer != address(0), "invalid signature");
+        return signer;
+    }
+}
@@ -0,0 +1,280 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// period of the commit and reveal phase of sealed voting, measured in blocks.
+const (
+	MinSealPeriod uint64 = 10
+	MaxSealPeriod uint64 = 86400
+)
+
+// SealVoting validators switch the voting of the next epoch to commit-reveal mode for contentious
+// proposals, once the consensus signs reached quorum, votes are committed by hash for
+// `CommitPeriod` blocks, revealed for `RevealPeriod` blocks, and tallied at the end of the reveal
+// period, the proposal with the most votes reached quorum wins. the voting is opened again if no
+// proposal wins. it takes effect since governance v2.
+func SealVoting(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsGovV2() {
+		return utils.ByteFailed, ErrGovV2NotActivated
+	}
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodSealVotingInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("sealVoting", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.CommitPeriod < MinSealPeriod || input.CommitPeriod > MaxSealPeriod ||
+		input.RevealPeriod < MinSealPeriod || input.RevealPeriod > MaxSealPeriod {
+		return utils.ByteFailed, ErrInvalidSealPeriod
+	}
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("sealVoting", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	if input.EpochID != curEpoch.ID+1 {
+		return utils.ByteFailed, ErrInvalidEpoch
+	}
+	if _, err := getVotingSeal(s, input.EpochID); !errors.Is(err, ErrNotFound) {
+		return utils.ByteFailed, ErrVotingSealed
+	}
+
+	ok, err := CheckConsensusSigns(s, MethodSealVoting, ctx.Payload, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodSealVoting)
+	}
+
+	height := s.ContractRef().BlockHeight().Uint64()
+	seal := &VotingSeal{EpochID: input.EpochID, CommitEnd: height + input.CommitPeriod}
+	seal.RevealEnd = seal.CommitEnd + input.RevealPeriod
+	if err := storeVotingSeal(s, seal); err != nil {
+		logger.Trace("sealVoting", "store voting seal failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	// the votes cast before sealing are dropped, they should be committed again.
+	proposals, _ := getProposals(s, input.EpochID)
+	for _, v := range proposals {
+		voters, _ := getVotes(s, v)
+		for _, voter := range voters {
+			delVoteTo(s, input.EpochID, voter)
+		}
+		clearVotes(s, v)
+	}
+	if err := emitVotingSealed(s, seal); err != nil {
+		logger.Trace("sealVoting", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSealVoting)
+}
+
+func GetVotingSeal(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodVotingSealInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		logger.Trace("votingSeal", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	seal, err := getVotingSeal(s, input.EpochID)
+	if errors.Is(err, ErrNotFound) {
+		return utils.ByteFailed, ErrVotingNotSealed
+	} else if err != nil {
+		logger.Trace("votingSeal", "get voting seal failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	return (&MethodVotingSealOutput{CommitEnd: seal.CommitEnd, RevealEnd: seal.RevealEnd, Tallied: seal.Tallied}).Encode()
+}
+
+// CommitVote participants commit the hash of their votes in the commit period of sealed voting,
+// the commitment can be replaced until the end of the period.
+func CommitVote(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	voter := s.ContractRef().TxOrigin()
+	height := s.ContractRef().BlockHeight().Uint64()
+
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("commitVote", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	if err := checkAuthority(s, voter, ctx.Caller, curEpoch); err != nil {
+		logger.Trace("commitVote", "check authority failed", err, "voter", voter.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+	input := new(MethodCommitVoteInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("commitVote", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	seal, err := getOpenVotingSeal(s, input.EpochID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if height > seal.CommitEnd {
+		return utils.ByteFailed, ErrNotCommitPeriod
+	}
+	if input.Commitment == common.EmptyHash {
+		return utils.ByteFailed, ErrInvalidCommitment
+	}
+
+	storeCommitment(s, input.EpochID, voter, input.Commitment)
+	if err := emitVoteCommitted(s, input.EpochID, voter); err != nil {
+		logger.Trace("commitVote", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	waiveDutyFee(s, curEpoch.ID, voter)
+	return (&MethodBoolOutput{Success: true}).Encode(MethodCommitVote)
+}
+
+// RevealVote participants reveal their committed votes in the reveal period of sealed voting, the
+// votes are counted but the proposal does not pass until the voting is tallied.
+func RevealVote(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	voter := s.ContractRef().TxOrigin()
+	height := s.ContractRef().BlockHeight().Uint64()
+	logger := logger.New("txHash", s.ContractRef().TxHash())
+
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		logger.Trace("revealVote", "get current epoch failed", err)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	logger = logger.New("epochID", curEpoch.ID+1)
+	if err := checkAuthority(s, voter, ctx.Caller, curEpoch); err != nil {
+		logger.Trace("revealVote", "check authority failed", err, "voter", voter.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+	input := new(MethodRevealVoteInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		logger.Trace("revealVote", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	seal, err := getOpenVotingSeal(s, input.EpochID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if height <= seal.CommitEnd || height > seal.RevealEnd {
+		return utils.ByteFailed, ErrNotRevealPeriod
+	}
+	if getCommitment(s, input.EpochID, voter) != VoteCommitment(input.EpochID, input.Hash, voter, input.Salt) {
+		logger.Trace("revealVote", "commitment mismatch", "voter", voter.Hex())
+		return utils.ByteFailed, ErrInvalidCommitment
+	}
+	delCommitment(s, input.EpochID, voter)
+
+	epoch, _, err := checkVote(s, logger, curEpoch, input.EpochID, input.Hash, height)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if _, _, err := castVote(s, logger, curEpoch, epoch, voter, MethodRevealVote); err != nil {
+		return utils.ByteFailed, err
+	}
+	waiveDutyFee(s, curEpoch.ID, voter)
+	return (&MethodBoolOutput{Success: true}).Encode(MethodRevealVote)
+}
+
+// isVotingSealed returns whether the votes of the epoch should be committed and revealed.
+func isVotingSealed(s *native.NativeContract, epochID uint64) bool {
+	_, err := getOpenVotingSeal(s, epochID)
+	return err == nil
+}
+
+func getOpenVotingSeal(s *native.NativeContract, epochID uint64) (*VotingSeal, error) {
+	seal, err := getVotingSeal(s, epochID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrVotingNotSealed
+	} else if err != nil {
+		logger.Trace("sealVoting", "get voting seal failed", err)
+		return nil, ErrStorage
+	}
+	if seal.Tallied {
+		return nil, ErrVotingNotSealed
+	}
+	return seal, nil
+}
+
+// tallySealedVoting tallies the sealed voting of the next epoch at the end of reveal period, the
+// unrevealed commitments are dropped, and the proposal with the most votes reached quorum wins.
+// the voting is opened once tallied, so that the proposals could still be voted if none won.
+func tallySealedVoting(s *native.NativeContract) error {
+	curEpoch, err := GetCurrentEpoch(s)
+	if err != nil {
+		return ErrEpochNotExist
+	}
+	epochID := curEpoch.ID + 1
+	seal, err := getOpenVotingSeal(s, epochID)
+	if err == ErrVotingNotSealed {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	height := s.ContractRef().BlockHeight().Uint64()
+	if height < seal.RevealEnd {
+		return nil
+	}
+
+	seal.Tallied = true
+	if err := storeVotingSeal(s, seal); err != nil {
+		return ErrStorage
+	}
+	for _, member := range curEpoch.MemberList() {
+		delCommitment(s, epochID, member)
+	}
+
+	var (
+		winner *EpochInfo
+		best   int
+	)
+	proposals, _ := getProposals(s, epochID)
+	for _, v := range proposals {
+		epoch, quorum, err := checkVote(s, logger, curEpoch, epochID, v, height)
+		if err != nil {
+			continue
+		}
+		if size := voteSize(s, v); size >= quorum && size > best {
+			winner, best = epoch, size
+		}
+	}
+	// the failure of passing the winner is isolated, so that the voting is still tallied.
+	if winner != nil {
+		snapshot := s.StateDB().Snapshot()
+		if err := passProposal(s, logger, curEpoch, winner, s.ContractRef().TxOrigin()); err != nil {
+			s.StateDB().RevertToSnapshot(snapshot)
+			logger.Warn("Pass winner of sealed voting failed", "proposal", winner.Hash(), "err", err)
+			winner = nil
+		}
+	}
+	hash := common.EmptyHash
+	if winner != nil {
+		hash = winner.Hash()
+	}
+	if err := emitVotingTallied(s, epochID, hash); err != nil {
+		return ErrEmitLog
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealedVoting(t *testing.T) {
+	config := &params.ChainConfig{GovV2Block: big.NewInt(0)}
+	contract := func(caller common.Address, height int) *native.NativeContract {
+		ctx := generateNativeContract(caller, height)
+		ctx.ContractRef().SetChainConfig(config)
+		return ctx
+	}
+	call := func(caller common.Address, height int, payload []byte) error {
+		_, _, err := contract(caller, height).ContractRef().NativeCall(caller, this, payload)
+		return err
+	}
+	// the finalize hook runs in the context of node manager
+	finalize := func(height int) error {
+		ctx := contract(testCaller, height)
+		ctx.ContractRef().PushContext(&native.Context{ContractAddress: this})
+		return FinalizeBlock(ctx)
+	}
+	salt := []byte("salt")
+	commit := func(voter common.Address, epoch *EpochInfo, height int) error {
+		payload, err := (&MethodCommitVoteInput{EpochID: epoch.ID, Commitment: VoteCommitment(epoch.ID, epoch.Hash(), voter, salt)}).Encode()
+		assert.NoError(t, err)
+		return call(voter, height, payload)
+	}
+	reveal := func(voter common.Address, epoch *EpochInfo, height int, salt []byte) error {
+		payload, err := (&MethodRevealVoteInput{EpochID: epoch.ID, Hash: epoch.Hash(), Salt: salt}).Encode()
+		assert.NoError(t, err)
+		return call(voter, height, payload)
+	}
+
+	// propose the next epoch at height 10, and seal the voting with the commit period of [10, 20]
+	// and the reveal period of (20, 30].
+	setup := func() (*EpochInfo, []common.Address, int) {
+		resetTestContext()
+		members := testGenesisEpoch.MemberList()
+		peers := testGenesisEpoch.Peers.Copy()
+		peers.List = append(peers.List, generateTestPeer())
+		sort.Sort(peers)
+		epoch := &EpochInfo{ID: StartEpoch + 1, Peers: peers, StartHeight: 200}
+		payload, err := (&MethodProposeInput{StartHeight: epoch.StartHeight, Peers: epoch.Peers}).Encode()
+		assert.NoError(t, err)
+		assert.NoError(t, call(members[0], 10, payload))
+
+		seal, err := (&MethodSealVotingInput{EpochID: epoch.ID, CommitPeriod: 10, RevealPeriod: 10}).Encode()
+		assert.NoError(t, err)
+		invalid, err := (&MethodSealVotingInput{EpochID: epoch.ID, CommitPeriod: MinSealPeriod - 1, RevealPeriod: 10}).Encode()
+		assert.NoError(t, err)
+		assert.Equal(t, ErrInvalidSealPeriod, call(members[0], 10, invalid))
+		quorum := QuorumSize(contract(members[0], 10), testGenesisEpoch)
+		for _, v := range members[:quorum] {
+			assert.NoError(t, call(v, 10, seal))
+		}
+		assert.Equal(t, ErrVotingSealed, call(members[quorum], 10, seal))
+		return epoch, members, quorum
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		epoch, members, quorum := setup()
+
+		// the votes cast before sealing are dropped, and the open voting is disabled
+		assert.Equal(t, 0, voteSize(testEmptyCtx, epoch.Hash()))
+		vote, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+		assert.NoError(t, err)
+		assert.Equal(t, ErrVotingSealed, call(members[1], 11, vote))
+
+		for _, v := range members[:quorum] {
+			assert.NoError(t, commit(v, epoch, 20))
+		}
+		assert.Equal(t, ErrNotCommitPeriod, commit(members[quorum], epoch, 21))
+		assert.Equal(t, ErrNotRevealPeriod, reveal(members[0], epoch, 20, salt))
+		assert.Equal(t, ErrInvalidCommitment, reveal(members[0], epoch, 21, []byte("wrong")))
+		assert.Equal(t, ErrInvalidCommitment, reveal(members[quorum], epoch, 21, salt))
+		for _, v := range members[:quorum] {
+			assert.NoError(t, reveal(v, epoch, 30, salt))
+		}
+		assert.Equal(t, ErrNotRevealPeriod, reveal(members[0], epoch, 31, salt))
+
+		// the proposal reached quorum passes once the voting tallied
+		assert.Equal(t, quorum, voteSize(testEmptyCtx, epoch.Hash()))
+		assert.NoError(t, finalize(29))
+		cur, err := GetCurrentEpoch(testEmptyCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, StartEpoch, cur.ID)
+		assert.NoError(t, finalize(30))
+		cur, err = GetCurrentEpoch(testEmptyCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, epoch.Hash(), cur.Hash())
+
+		payload, err := (&MethodVotingSealInput{EpochID: epoch.ID}).Encode()
+		assert.NoError(t, err)
+		enc, _, err := contract(members[0], 30).ContractRef().NativeCall(members[0], this, payload)
+		assert.NoError(t, err)
+		output := new(MethodVotingSealOutput)
+		assert.NoError(t, output.Decode(enc))
+		assert.Equal(t, &MethodVotingSealOutput{CommitEnd: 20, RevealEnd: 30, Tallied: true}, output)
+	})
+
+	t.Run("opened", func(t *testing.T) {
+		epoch, members, quorum := setup()
+
+		// the voting is opened again if none of the proposals won
+		for _, v := range members[:quorum-1] {
+			assert.NoError(t, commit(v, epoch, 15))
+			assert.NoError(t, reveal(v, epoch, 25, salt))
+		}
+		assert.NoError(t, finalize(30))
+		cur, err := GetCurrentEpoch(testEmptyCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, StartEpoch, cur.ID)
+
+		vote, err := (&MethodVoteInput{EpochID: epoch.ID, Hash: epoch.Hash()}).Encode()
+		assert.NoError(t, err)
+		assert.NoError(t, call(members[quorum-1], 31, vote))
+		cur, err = GetCurrentEpoch(testEmptyCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, epoch.Hash(), cur.Hash())
+	})
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ontio/ontology-crypto/keypair"
+	"github.com/ontio/ontology-crypto/vrf"
+)
+
+// checkVrfKey validates the serialized vrf public key and returns the deserialized one.
+func checkVrfKey(pubKey []byte) (keypair.PublicKey, error) {
+	pk, err := keypair.DeserializePublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !vrf.ValidatePublicKey(pk) {
+		return nil, fmt.Errorf("public key is not valid for vrf")
+	}
+	return pk, nil
+}
+
+// verifyVrf checks that the output and proof are generated by the owner of the public key on msg.
+func verifyVrf(pk keypair.PublicKey, msg, output, proof []byte) error {
+	ok, err := vrf.Verify(pk, msg, output, proof)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("vrf proof verify failed")
+	}
+	return nil
+}
+
+// VrfKeyMessage is the message which should be evaluated by the vrf key while registering,
+// the key owner proves the possession of private key and binds it to the validator.
+func VrfKeyMessage(validator common.Address) []byte {
+	return validator.Bytes()
+}
+
+// VrfEpochMessage is the message which should be evaluated by validators' vrf key in epoch,
+// e.g: the epoch seed.
+func VrfEpochMessage(seed common.Hash) []byte {
+	return seed.Bytes()
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ontio/ontology-crypto/keypair"
+	"github.com/ontio/ontology-crypto/vrf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVrf(t *testing.T) {
+	resetTestContext()
+
+	pri, pub, err := keypair.GenerateKeyPair(keypair.PK_ECDSA, keypair.P256)
+	assert.NoError(t, err)
+	pubKey := keypair.SerializePublicKey(pub)
+
+	call := func(caller common.Address, payload []byte) ([]byte, error) {
+		ctx := generateNativeContract(caller, 1)
+		enc, _, err := ctx.ContractRef().NativeCall(caller, this, payload)
+		return enc, err
+	}
+	register := func(caller, owner common.Address, pubKey []byte) error {
+		output, proof, err := vrf.Vrf(pri, VrfKeyMessage(owner))
+		assert.NoError(t, err)
+		payload, err := (&MethodRegisterVrfKeyInput{PubKey: pubKey, Output: output, Proof: proof}).Encode()
+		assert.NoError(t, err)
+		_, err = call(caller, payload)
+		return err
+	}
+	submit := func(caller common.Address, epochID uint64) error {
+		output, proof, err := vrf.Vrf(pri, VrfEpochMessage(getEpochSeed(testEmptyCtx, testGenesisEpoch)))
+		assert.NoError(t, err)
+		payload, err := (&MethodSubmitVrfInput{EpochID: epochID, Output: output, Proof: proof}).Encode()
+		assert.NoError(t, err)
+		_, err = call(caller, payload)
+		return err
+	}
+
+	// register vrf key with proof of possession
+	validator := testGenesisEpoch.Peers.List[0].Address
+	assert.Equal(t, ErrInvalidVrfKey, register(validator, validator, []byte{0x01, 0x02}))
+	assert.Equal(t, ErrInvalidVrfProof, register(validator, generateTestAddress(1), pubKey))
+	assert.Equal(t, ErrVrfKeyNotExist, submit(validator, testGenesisEpoch.ID))
+	assert.NoError(t, register(validator, validator, pubKey))
+
+	payload, err := (&MethodVrfKeyInput{Validator: validator}).Encode()
+	assert.NoError(t, err)
+	enc, err := call(validator, payload)
+	assert.NoError(t, err)
+	keyOutput := new(MethodVrfKeyOutput)
+	assert.NoError(t, keyOutput.Decode(enc))
+	assert.Equal(t, pubKey, keyOutput.PubKey)
+
+	// submit vrf output of the epoch seed
+	assert.NoError(t, register(generateTestAddress(2), generateTestAddress(2), pubKey))
+	assert.Equal(t, ErrInvalidAuthority, submit(generateTestAddress(2), testGenesisEpoch.ID))
+	assert.Equal(t, ErrInvalidEpoch, submit(validator, testGenesisEpoch.ID+1))
+	assert.NoError(t, submit(validator, testGenesisEpoch.ID))
+	assert.Equal(t, ErrDuplicateVrf, submit(validator, testGenesisEpoch.ID))
+
+	payload, err = (&MethodVrfOutputInput{EpochID: testGenesisEpoch.ID, Validator: validator}).Encode()
+	assert.NoError(t, err)
+	enc, err = call(validator, payload)
+	assert.NoError(t, err)
+	vrfOutput := new(MethodVrfOutputOutput)
+	assert.NoError(t, vrfOutput.Decode(enc))
+	expect, err := getVrfOutput(testEmptyCtx, testGenesisEpoch.ID, validator)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, vrfOutput.Output)
+
+	payload, err = (&MethodVrfOutputInput{EpochID: testGenesisEpoch.ID, Validator: generateTestAddress(2)}).Encode()
+	assert.NoError(t, err)
+	_, err = call(validator, payload)
+	assert.Equal(t, ErrVrfNotExist, err)
+
+	// per-epoch seed
+	payload, err = (&MethodEpochSeedInput{EpochID: testGenesisEpoch.ID}).Encode()
+	assert.NoError(t, err)
+	enc, err = call(validator, payload)
+	assert.NoError(t, err)
+	seedOutput := new(MethodEpochSeedOutput)
+	assert.NoError(t, seedOutput.Decode(enc))
+	assert.Equal(t, getEpochSeed(testEmptyCtx, testGenesisEpoch), seedOutput.Seed)
+
+	payload, err = (&MethodEpochSeedInput{EpochID: testGenesisEpoch.ID + 1}).Encode()
+	assert.NoError(t, err)
+	_, err = call(validator, payload)
+	assert.Equal(t, ErrEpochNotExist, err)
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package price_oracle
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "price oracle"
+
+const (
+	MethodContractName = "name"
+	MethodFeed         = "feed"
+	MethodPrice        = "price"
+	MethodConvert      = "convert"
+	MethodSetFeed      = "setFeed"
+	MethodSubmitPrice  = "submitPrice"
+
+	EventFeedUpdated  = "feedUpdated"
+	EventPriceUpdated = "priceUpdated"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodFeed + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"}],"outputs":[{"internalType":"uint64","name":"MaxAge","type":"uint64"},{"internalType":"uint64","name":"MaxDeviation","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodPrice + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"}],"outputs":[{"internalType":"uint64","name":"Round","type":"uint64"},{"internalType":"uint256","name":"Price","type":"uint256"},{"internalType":"uint64","name":"Height","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodConvert + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"},{"internalType":"uint256","name":"Amount","type":"uint256"}],"outputs":[{"internalType":"uint256","name":"Value","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSetFeed + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"},{"internalType":"uint64","name":"MaxAge","type":"uint64"},{"internalType":"uint64","name":"MaxDeviation","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSubmitPrice + `","inputs":[{"internalType":"string","name":"FeedID","type":"string"},{"internalType":"uint64","name":"Round","type":"uint64"},{"internalType":"uint256","name":"Price","type":"uint256"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"` + EventFeedUpdated + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"FeedID","type":"string"},{"indexed":false,"internalType":"uint64","name":"MaxAge","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"MaxDeviation","type":"uint64"}]},
+	{"type":"event","name":"` + EventPriceUpdated + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"FeedID","type":"string"},{"indexed":false,"internalType":"uint64","name":"Round","type":"uint64"},{"indexed":false,"internalType":"uint256","name":"Price","type":"uint256"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.PriceOracleContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+// MethodFeedIDInput is shared by `feed` and `price`.
+type MethodFeedIDInput struct {
+	FeedID string
+}
+
+func (m *MethodFeedIDInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.FeedID)
+}
+func (m *MethodFeedIDInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodFeedOutput struct {
+	MaxAge       uint64
+	MaxDeviation uint64
+}
+
+func (m *MethodFeedOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodFeed, m.MaxAge, m.MaxDeviation)
+}
+func (m *MethodFeedOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodFeed, m, payload)
+}
+
+type MethodPriceOutput struct {
+	Round  uint64
+	Price  *big.Int
+	Height uint64
+}
+
+func (m *MethodPriceOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodPrice, m.Round, m.Price, m.Height)
+}
+func (m *MethodPriceOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodPrice, m, payload)
+}
+
+type MethodConvertInput struct {
+	FeedID string
+	Amount *big.Int
+}
+
+func (m *MethodConvertInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodConvert, m.FeedID, m.Amount)
+}
+func (m *MethodConvertInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodConvert, m, payload)
+}
+
+type MethodConvertOutput struct {
+	Value *big.Int
+}
+
+func (m *MethodConvertOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodConvert, m.Value)
+}
+func (m *MethodConvertOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodConvert, m, payload)
+}
+
+type MethodSetFeedInput struct {
+	FeedID       string
+	MaxAge       uint64
+	MaxDeviation uint64
+}
+
+func (m *MethodSetFeedInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetFeed, m.FeedID, m.MaxAge, m.MaxDeviation)
+}
+func (m *MethodSetFeedInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSetFeed, m, payload)
+}
+
+type MethodSubmitPriceInput struct {
+	FeedID string
+	Round  uint64
+	Price  *big.Int
+}
+
+func (m *MethodSubmitPriceInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSubmitPrice, m.FeedID, m.Round, m.Price)
+}
+func (m *MethodSubmitPriceInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSubmitPrice, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitFeedUpdated(s *native.NativeContract, feed *Feed) error {
+	return s.AddNotify(ABI, []string{EventFeedUpdated}, feed.ID, feed.MaxAge, feed.MaxDeviation)
+}
+
+func emitPriceUpdated(s *native.NativeContract, feedID string, price *Price) error {
+	return s.AddNotify(ABI, []string{EventPriceUpdated}, feedID, price.Round, price.Value)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package price_oracle
+
+import "errors"
+
+var (
+	ErrInvalidInput = errors.New("decode input params failed")
+
+	ErrInvalidFeed = errors.New("invalid feed config")
+
+	ErrInvalidPrice = errors.New("price should be positive")
+
+	ErrFeedNotExist = errors.New("feed not exist")
+
+	ErrPriceNotExist = errors.New("price not exist")
+
+	ErrStalePrice = errors.New("price is stale")
+
+	ErrNotValidator = errors.New("submitter is not validator")
+
+	ErrInvalidRound = errors.New("invalid round")
+
+	ErrDuplicateSubmission = errors.New("price already submitted in this round")
+
+	ErrPriceDeviation = errors.New("price deviation exceeds the limit")
+
+	ErrStorage = errors.New("failed to store data")
+
+	ErrEmitLog = errors.New("failed to emit log")
+)
@@ -0,0 +1,285 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package price_oracle
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodFeed:         0,
+		MethodPrice:        0,
+		MethodConvert:      0,
+		MethodSetFeed:      30000,
+		MethodSubmitPrice:  30000,
+	}
+)
+
+const (
+	// MaxFeedIDLength limits the length of feed id, e.g: `ETH/ZION`.
+	MaxFeedIDLength = 64
+	// MaxDeviationLimit is the upper bound of feed deviation limit, in basis points.
+	MaxDeviationLimit uint64 = 10000
+)
+
+// PriceUnit is the amount of source asset that prices are quoted for, prices have 18 decimals.
+var PriceUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+func InitPriceOracle() {
+	InitABI()
+	native.RegisterABI(native.NativePriceOracle, "PriceOracle", abijson)
+	native.Contracts[this] = RegisterPriceOracleContract
+}
+
+func RegisterPriceOracleContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodFeed, GetFeed)
+	s.RegisterQuery(MethodPrice, GetLatestPrice)
+	s.RegisterQuery(MethodConvert, Convert)
+	s.Register(MethodSetFeed, SetFeed)
+	s.Register(MethodSubmitPrice, SubmitPrice)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+func GetFeed(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodFeedIDInput)
+	if err := input.Decode(MethodFeed, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	feed, err := getFeed(s, input.FeedID)
+	if err != nil {
+		return nil, err
+	}
+	return (&MethodFeedOutput{MaxAge: feed.MaxAge, MaxDeviation: feed.MaxDeviation}).Encode()
+}
+
+// GetLatestPrice returns the latest price of feed even if it is stale, the height is
+// returned as well so that callers can judge the freshness by themselves.
+func GetLatestPrice(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodFeedIDInput)
+	if err := input.Decode(MethodPrice, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	price, err := getPrice(s, input.FeedID)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if price == nil {
+		return nil, ErrPriceNotExist
+	}
+	return (&MethodPriceOutput{Round: price.Round, Price: price.Value, Height: price.Height}).Encode()
+}
+
+func Convert(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodConvertInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	value, err := ConvertToNative(s, input.FeedID, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return (&MethodConvertOutput{Value: value}).Encode()
+}
+
+// SetFeed creates or updates the feed config after validators consensus signs reached quorum.
+func SetFeed(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodSetFeedInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("setFeed", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.FeedID == "" || len(input.FeedID) > MaxFeedIDLength || input.MaxAge == 0 || input.MaxDeviation > MaxDeviationLimit {
+		return utils.ByteFailed, ErrInvalidFeed
+	}
+
+	// bind the current config into signs, so that signs of a past change can't be reused.
+	current, err := s.GetCacheDB().Get(feedKey(input.FeedID))
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, MethodSetFeed, append(current, ctx.Payload...), s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodSetFeed)
+	}
+
+	feed := &Feed{ID: input.FeedID, MaxAge: input.MaxAge, MaxDeviation: input.MaxDeviation}
+	if err := setFeed(s, feed); err != nil {
+		log.Trace("setFeed", "store feed failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitFeedUpdated(s, feed); err != nil {
+		log.Trace("setFeed", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSetFeed)
+}
+
+// SubmitPrice records the price submitted by a validator for the next round of feed, the
+// median is published as the latest price once the submissions reached quorum.
+func SubmitPrice(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodSubmitPriceInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("submitPrice", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Price == nil || input.Price.Sign() <= 0 {
+		return utils.ByteFailed, ErrInvalidPrice
+	}
+
+	submitter := s.ContractRef().MsgSender()
+	epoch, err := node_manager.GetCurrentEpoch(s)
+	if err != nil {
+		return utils.ByteFailed, node_manager.ErrEpochNotExist
+	}
+	if _, ok := epoch.Members()[submitter]; !ok || ctx.Caller != submitter {
+		return utils.ByteFailed, ErrNotValidator
+	}
+
+	feed, err := getFeed(s, input.FeedID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	latest, err := getPrice(s, feed.ID)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	round := uint64(1)
+	if latest != nil {
+		round = latest.Round + 1
+	}
+	if input.Round != round {
+		return utils.ByteFailed, ErrInvalidRound
+	}
+
+	// the deviation is only checked against a fresh price, otherwise the feed would be stuck
+	// forever if the market moved a lot while the validators were offline.
+	height := s.ContractRef().BlockHeight().Uint64()
+	if latest != nil && !latest.Stale(feed, height) && exceedDeviation(latest.Value, input.Price, feed.MaxDeviation) {
+		return utils.ByteFailed, ErrPriceDeviation
+	}
+
+	list, err := getSubmissions(s, feed.ID, round)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	for _, v := range list {
+		if v.Submitter == submitter {
+			return utils.ByteFailed, ErrDuplicateSubmission
+		}
+	}
+	list = append(list, &Submission{Submitter: submitter, Value: input.Price})
+	if len(list) < node_manager.QuorumSize(s, epoch) {
+		if err := setSubmissions(s, feed.ID, round, list); err != nil {
+			log.Trace("submitPrice", "store submissions failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+		return (&MethodBoolOutput{Success: true}).Encode(MethodSubmitPrice)
+	}
+
+	price := &Price{Round: round, Value: median(list), Height: height}
+	if err := setPrice(s, feed.ID, price); err != nil {
+		log.Trace("submitPrice", "store price failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	delSubmissions(s, feed.ID, round)
+	if err := emitPriceUpdated(s, feed.ID, price); err != nil {
+		log.Trace("submitPrice", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodSubmitPrice)
+}
+
+// GetPrice returns the latest price of feed, it fails if the price is stale.
+func GetPrice(s *native.NativeContract, feedID string) (*Price, error) {
+	feed, err := getFeed(s, feedID)
+	if err != nil {
+		return nil, err
+	}
+	price, err := getPrice(s, feedID)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if price == nil {
+		return nil, ErrPriceNotExist
+	}
+	if price.Stale(feed, s.ContractRef().BlockHeight().Uint64()) {
+		return nil, ErrStalePrice
+	}
+	return price, nil
+}
+
+// ConvertToNative converts the amount of source asset to native token with the fresh price
+// of feed, e.g: the minimum fee of a cross chain message paid in source asset.
+func ConvertToNative(s *native.NativeContract, feedID string, amount *big.Int) (*big.Int, error) {
+	if amount == nil || amount.Sign() < 0 {
+		return nil, ErrInvalidInput
+	}
+	price, err := GetPrice(s, feedID)
+	if err != nil {
+		return nil, err
+	}
+	value := new(big.Int).Mul(amount, price.Value)
+	return value.Div(value, PriceUnit), nil
+}
+
+// exceedDeviation returns true if the price deviates from the reference price more than
+// limit basis points, zero limit means no limit.
+func exceedDeviation(ref, price *big.Int, limit uint64) bool {
+	if limit == 0 {
+		return false
+	}
+	diff := new(big.Int).Sub(price, ref)
+	diff.Abs(diff).Mul(diff, big.NewInt(int64(MaxDeviationLimit)))
+	return diff.Cmp(new(big.Int).Mul(ref, new(big.Int).SetUint64(limit))) > 0
+}
+
+func median(list []*Submission) *big.Int {
+	values := make([]*big.Int, len(list))
+	for i, v := range list {
+		values[i] = v.Value
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return new(big.Int).Set(values[mid])
+	}
+	sum := new(big.Int).Add(values[mid-1], values[mid])
+	return sum.Rsh(sum, 1)
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package price_oracle
+
+import (
+	"crypto/rand"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(100000000000000000)
+	testFeedID     = "ETH/ZION"
+)
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	InitPriceOracle()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+}
+
+func invoke(origin common.Address, payload []byte, blockNum uint64) ([]byte, error) {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	ref := native.NewContractRef(testStateDB, origin, origin, new(big.Int).SetUint64(blockNum), common.BytesToHash(token), testSupplyGas, nil)
+	ret, _, err := ref.NativeCall(origin, this, payload)
+	return ret, err
+}
+
+func validator(i int) common.Address {
+	return testGenesisEpoch.Peers.List[i].Address
+}
+
+func setTestFeed(t *testing.T, maxAge, maxDeviation uint64) {
+	payload, err := (&MethodSetFeedInput{FeedID: testFeedID, MaxAge: maxAge, MaxDeviation: maxDeviation}).Encode()
+	assert.NoError(t, err)
+	for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+		_, err = invoke(validator(i), payload, 1)
+		assert.NoError(t, err)
+	}
+}
+
+func submit(i int, round uint64, price int64, blockNum uint64) error {
+	payload, _ := (&MethodSubmitPriceInput{FeedID: testFeedID, Round: round, Price: big.NewInt(price)}).Encode()
+	_, err := invoke(validator(i), payload, blockNum)
+	return err
+}
+
+func latestPrice(t *testing.T) *MethodPriceOutput {
+	payload, err := (&MethodFeedIDInput{FeedID: testFeedID}).Encode(MethodPrice)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, payload, 1)
+	if err != nil {
+		return nil
+	}
+	output := new(MethodPriceOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output
+}
+
+func convert(amount int64, blockNum uint64) (*big.Int, error) {
+	payload, _ := (&MethodConvertInput{FeedID: testFeedID, Amount: big.NewInt(amount)}).Encode()
+	ret, err := invoke(common.EmptyAddress, payload, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	output := new(MethodConvertOutput)
+	if err := output.Decode(ret); err != nil {
+		return nil, err
+	}
+	return output.Value, nil
+}
+
+func TestSetFeed(t *testing.T) {
+	resetTestContext()
+
+	payload, err := (&MethodSetFeedInput{FeedID: testFeedID, MaxAge: 100, MaxDeviation: 500}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), payload, 1)
+	assert.Equal(t, node_manager.ErrInvalidAuthority, err)
+
+	invalid, err := (&MethodSetFeedInput{FeedID: testFeedID, MaxAge: 0, MaxDeviation: 500}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(validator(0), invalid, 1)
+	assert.Equal(t, ErrInvalidFeed, err)
+
+	setTestFeed(t, 100, 500)
+	query, err := (&MethodFeedIDInput{FeedID: testFeedID}).Encode(MethodFeed)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, query, 1)
+	assert.NoError(t, err)
+	output := new(MethodFeedOutput)
+	assert.NoError(t, output.Decode(ret))
+	assert.Equal(t, uint64(100), output.MaxAge)
+	assert.Equal(t, uint64(500), output.MaxDeviation)
+}
+
+func TestSubmitPrice(t *testing.T) {
+	resetTestContext()
+
+	assert.Equal(t, ErrFeedNotExist, submit(0, 1, 100, 1))
+	setTestFeed(t, 100, 0)
+
+	payload, err := (&MethodSubmitPriceInput{FeedID: testFeedID, Round: 1, Price: big.NewInt(100)}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), payload, 1)
+	assert.Equal(t, ErrNotValidator, err)
+	assert.Equal(t, ErrInvalidRound, submit(0, 2, 100, 1))
+	assert.Equal(t, ErrInvalidPrice, submit(0, 1, 0, 1))
+
+	// the median is published once quorum reached
+	prices := []int64{120, 100, 110}
+	for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+		assert.Nil(t, latestPrice(t))
+		assert.NoError(t, submit(i, 1, prices[i], 10))
+		if i == 0 {
+			assert.Equal(t, ErrDuplicateSubmission, submit(i, 1, prices[i], 10))
+		}
+	}
+	price := latestPrice(t)
+	assert.Equal(t, uint64(1), price.Round)
+	assert.Equal(t, big.NewInt(110), price.Price)
+	assert.Equal(t, uint64(10), price.Height)
+
+	// the round is closed
+	assert.Equal(t, ErrInvalidRound, submit(3, 1, 110, 11))
+	assert.NoError(t, submit(3, 2, 110, 11))
+}
+
+func TestPriceDeviationAndStaleness(t *testing.T) {
+	resetTestContext()
+
+	setTestFeed(t, 100, 1000)
+	for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+		assert.NoError(t, submit(i, 1, 1000, 10))
+	}
+	assert.NoError(t, submit(0, 2, 1100, 20))
+	assert.Equal(t, ErrPriceDeviation, submit(1, 2, 1101, 20))
+	assert.Equal(t, ErrPriceDeviation, submit(1, 2, 899, 20))
+
+	value, err := convert(3e18, 110)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(3000), value)
+	_, err = convert(3e18, 111)
+	assert.Equal(t, ErrStalePrice, err)
+
+	// the deviation is not checked against a stale price
+	assert.NoError(t, submit(1, 2, 2000, 111))
+}
+
+func TestMedian(t *testing.T) {
+	list := func(values ...int64) []*Submission {
+		ret := make([]*Submission, len(values))
+		for i, v := range values {
+			ret[i] = &Submission{Value: big.NewInt(v)}
+		}
+		return ret
+	}
+	assert.Equal(t, big.NewInt(5), median(list(5)))
+	assert.Equal(t, big.NewInt(3), median(list(9, 1, 3)))
+	assert.Equal(t, big.NewInt(4), median(list(9, 1, 3, 5)))
+}
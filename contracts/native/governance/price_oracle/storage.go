@@ -0,0 +1,126 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package price_oracle
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_FEED        = "st_feed"
+	SKP_PRICE       = "st_price"
+	SKP_SUBMISSIONS = "st_submissions"
+)
+
+func getFeed(s *native.NativeContract, id string) (*Feed, error) {
+	value, err := s.GetCacheDB().Get(feedKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrFeedNotExist
+	}
+
+	feed := new(Feed)
+	if err := rlp.DecodeBytes(value, feed); err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
+
+func setFeed(s *native.NativeContract, feed *Feed) error {
+	value, err := rlp.EncodeToBytes(feed)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(feedKey(feed.ID), value)
+	return nil
+}
+
+// getPrice returns the latest price of feed, or nil if no round finished yet.
+func getPrice(s *native.NativeContract, feedID string) (*Price, error) {
+	value, err := s.GetCacheDB().Get(priceKey(feedID))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	price := new(Price)
+	if err := rlp.DecodeBytes(value, price); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+func setPrice(s *native.NativeContract, feedID string, price *Price) error {
+	value, err := rlp.EncodeToBytes(price)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(priceKey(feedID), value)
+	return nil
+}
+
+func getSubmissions(s *native.NativeContract, feedID string, round uint64) ([]*Submission, error) {
+	value, err := s.GetCacheDB().Get(submissionsKey(feedID, round))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var list []*Submission
+	if err := rlp.DecodeBytes(value, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func setSubmissions(s *native.NativeContract, feedID string, round uint64, list []*Submission) error {
+	value, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(submissionsKey(feedID, round), value)
+	return nil
+}
+
+func delSubmissions(s *native.NativeContract, feedID string, round uint64) {
+	s.GetCacheDB().Delete(submissionsKey(feedID, round))
+}
+
+// feed id is hashed in keys, so that ids of different length never overlap.
+func feedKey(id string) []byte {
+	return utils.ConcatKey(this, []byte(SKP_FEED), crypto.Keccak256([]byte(id)))
+}
+
+func priceKey(feedID string) []byte {
+	return utils.ConcatKey(this, []byte(SKP_PRICE), crypto.Keccak256([]byte(feedID)))
+}
+
+func submissionsKey(feedID string, round uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SUBMISSIONS), crypto.Keccak256([]byte(feedID)), utils.GetUint64Bytes(round))
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package price_oracle
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Feed is the config of a price feed, prices older than `MaxAge` blocks are stale, and
+// submissions deviating more than `MaxDeviation` basis points from the latest fresh price
+// are rejected, zero `MaxDeviation` means no limit.
+type Feed struct {
+	ID           string
+	MaxAge       uint64
+	MaxDeviation uint64
+}
+
+// Price is the median of the validators submissions of a round, `Value` is the amount of
+// native token in wei per `PriceUnit` of the source asset.
+type Price struct {
+	Round  uint64
+	Value  *big.Int
+	Height uint64
+}
+
+// Stale returns true if the price is older than the max age of feed at block height.
+func (p *Price) Stale(feed *Feed, height uint64) bool {
+	return height > p.Height+feed.MaxAge
+}
+
+// Submission is a price submitted by a validator in a pending round.
+type Submission struct {
+	Submitter common.Address
+	Value     *big.Int
+}
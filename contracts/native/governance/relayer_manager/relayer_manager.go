@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/relayer_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 )
@@ -60,13 +61,14 @@ var (
 
 func InitRelayerManager() {
 	ABI = GetABI()
+	native.RegisterABI(native.NativeRelayerManager, "RelayerManager", relayer_manager_abi.RelayerManagerABI)
 	native.Contracts[this] = RegisterRelayerManagerContract
 }
 
 func RegisterRelayerManagerContract(s *native.NativeContract) {
 	s.Prepare(ABI, gasTable)
 
-	s.Register(MethodContractName, Name)
+	s.RegisterQuery(MethodContractName, Name)
 	s.Register(MethodRegisterRelayer, RegisterRelayer)
 	s.Register(MethodApproveRegisterRelayer, ApproveRegisterRelayer)
 	s.Register(MethodRemoveRelayer, RemoveRelayer)
@@ -0,0 +1,167 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "scheduler"
+
+const (
+	MethodContractName = "name"
+	MethodTask         = "task"
+	MethodPending      = "pending"
+	MethodSchedule     = "schedule"
+	MethodCancel       = "cancel"
+
+	EventTaskScheduled = "taskScheduled"
+	EventTaskCanceled  = "taskCanceled"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodTask + `","inputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"outputs":[{"internalType":"address","name":"Target","type":"address"},{"internalType":"bytes","name":"Payload","type":"bytes"},{"internalType":"uint64","name":"Height","type":"uint64"},{"internalType":"uint8","name":"Status","type":"uint8"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodPending + `","inputs":[{"internalType":"uint64","name":"Height","type":"uint64"}],"outputs":[{"internalType":"uint64[]","name":"IDs","type":"uint64[]"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSchedule + `","inputs":[{"internalType":"uint64","name":"Height","type":"uint64"},{"internalType":"bytes","name":"Payload","type":"bytes"}],"outputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodCancel + `","inputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"` + EventTaskScheduled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Target","type":"address"},{"indexed":false,"internalType":"uint64","name":"Height","type":"uint64"}]},
+	{"type":"event","name":"` + EventTaskCanceled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ID","type":"uint64"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.SchedulerContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+// MethodIDInput is shared by `task` and `cancel`.
+type MethodIDInput struct {
+	ID uint64
+}
+
+func (m *MethodIDInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.ID)
+}
+func (m *MethodIDInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodTaskOutput struct {
+	Target  common.Address
+	Payload []byte
+	Height  uint64
+	Status  uint8
+}
+
+func (m *MethodTaskOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodTask, m.Target, m.Payload, m.Height, m.Status)
+}
+func (m *MethodTaskOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodTask, m, payload)
+}
+
+type MethodPendingInput struct {
+	Height uint64
+}
+
+func (m *MethodPendingInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodPending, m.Height)
+}
+func (m *MethodPendingInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodPending, m, payload)
+}
+
+type MethodPendingOutput struct {
+	IDs []uint64
+}
+
+func (m *MethodPendingOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodPending, m.IDs)
+}
+func (m *MethodPendingOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodPending, m, payload)
+}
+
+type MethodScheduleInput struct {
+	Height  uint64
+	Payload []byte
+}
+
+func (m *MethodScheduleInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSchedule, m.Height, m.Payload)
+}
+func (m *MethodScheduleInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSchedule, m, payload)
+}
+
+type MethodScheduleOutput struct {
+	ID uint64
+}
+
+func (m *MethodScheduleOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSchedule, m.ID)
+}
+func (m *MethodScheduleOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSchedule, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitTaskScheduled(s *native.NativeContract, task *Task) error {
+	return s.AddNotify(ABI, []string{EventTaskScheduled}, task.ID, task.Target, task.Height)
+}
+
+func emitTaskCanceled(s *native.NativeContract, id uint64) error {
+	return s.AddNotify(ABI, []string{EventTaskCanceled}, id)
+}
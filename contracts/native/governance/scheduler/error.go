@@ -0,0 +1,43 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import "errors"
+
+var (
+	ErrInvalidInput = errors.New("decode input params failed")
+
+	ErrInvalidCaller = errors.New("only native contract can schedule task")
+
+	ErrNotScheduler = errors.New("caller is not scheduler")
+
+	ErrInvalidHeight = errors.New("task height should be in the future")
+
+	ErrTooManyTasks = errors.New("too many tasks at the height")
+
+	ErrTaskNotExist = errors.New("task not exist")
+
+	ErrTaskNotPending = errors.New("task is not pending")
+
+	ErrNotTaskOwner = errors.New("caller is not the task target")
+
+	ErrStorage = errors.New("failed to store data")
+
+	ErrEmitLog = errors.New("failed to emit log")
+)
@@ -0,0 +1,285 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodTask:         0,
+		MethodPending:      0,
+		MethodSchedule:     0,
+		MethodCancel:       0,
+	}
+)
+
+const (
+	// MaxTasksPerHeight bounds the work of finalizing a single block.
+	MaxTasksPerHeight = 64
+	// TaskGasLimit is the native gas supplied to each task.
+	TaskGasLimit uint64 = 1000000
+	// RunTasksGas is the gas charged for the finalize hook of each block, the gas used by the
+	// tasks is charged in addition.
+	RunTasksGas uint64 = 20000
+)
+
+func InitScheduler() {
+	InitABI()
+	native.RegisterABI(native.NativeScheduler, "Scheduler", abijson)
+	native.Contracts[this] = RegisterSchedulerContract
+	native.RegisterFinalizeHook(native.NativeScheduler, RunTasksGas, RunTasks)
+}
+
+func RegisterSchedulerContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodTask, GetTask)
+	s.RegisterQuery(MethodPending, Pending)
+	s.Register(MethodSchedule, ScheduleTask)
+	s.Register(MethodCancel, Cancel)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+func GetTask(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodIDInput)
+	if err := input.Decode(MethodTask, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	task, err := getTask(s, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	output := &MethodTaskOutput{
+		Target:  task.Target,
+		Payload: task.Payload,
+		Height:  task.Height,
+		Status:  uint8(task.Status),
+	}
+	return output.Encode()
+}
+
+func Pending(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodPendingInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	list, err := getHeightTasks(s, input.Height)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if list == nil {
+		list = []uint64{}
+	}
+	return (&MethodPendingOutput{IDs: list}).Encode()
+}
+
+// ScheduleTask registers the payload as a callback of the calling native contract at a
+// future height, and returns the task id.
+func ScheduleTask(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	if ctx.Caller == this || !native.IsNativeContract(ctx.Caller) {
+		return nil, ErrInvalidCaller
+	}
+
+	input := new(MethodScheduleInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("schedule", "decode input failed", err)
+		return nil, ErrInvalidInput
+	}
+	if input.Height <= s.ContractRef().BlockHeight().Uint64() {
+		return nil, ErrInvalidHeight
+	}
+	list, err := getHeightTasks(s, input.Height)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if len(list) >= MaxTasksPerHeight {
+		return nil, ErrTooManyTasks
+	}
+
+	id, err := nextTaskID(s)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	task := &Task{
+		ID:      id,
+		Target:  ctx.Caller,
+		Payload: input.Payload,
+		Height:  input.Height,
+		Status:  StatusPending,
+	}
+	if err := setTask(s, task); err != nil {
+		log.Trace("schedule", "store task failed", err)
+		return nil, ErrStorage
+	}
+	if err := setHeightTasks(s, task.Height, append(list, id)); err != nil {
+		log.Trace("schedule", "store height tasks failed", err)
+		return nil, ErrStorage
+	}
+	if err := emitTaskScheduled(s, task); err != nil {
+		log.Trace("schedule", "emit event failed", err)
+		return nil, ErrEmitLog
+	}
+	return (&MethodScheduleOutput{ID: id}).Encode()
+}
+
+// Cancel drops a pending task, it can only be called by the target of the task.
+func Cancel(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodIDInput)
+	if err := input.Decode(MethodCancel, ctx.Payload); err != nil {
+		log.Trace("cancel", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	task, err := getTask(s, input.ID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if task.Target != ctx.Caller {
+		return utils.ByteFailed, ErrNotTaskOwner
+	}
+	if task.Status != StatusPending {
+		return utils.ByteFailed, ErrTaskNotPending
+	}
+
+	list, err := getHeightTasks(s, task.Height)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	for i, id := range list {
+		if id == task.ID {
+			list = append(list[:i], list[i+1:]...)
+			break
+		}
+	}
+	task.Status = StatusCanceled
+	if err := setTask(s, task); err != nil {
+		log.Trace("cancel", "store task failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := setHeightTasks(s, task.Height, list); err != nil {
+		log.Trace("cancel", "store height tasks failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitTaskCanceled(s, task.ID); err != nil {
+		log.Trace("cancel", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodCancel)
+}
+
+// Schedule registers the payload as a callback of the current native contract at height, and
+// returns the task id.
+func Schedule(s *native.NativeContract, height uint64, payload []byte) (uint64, error) {
+	input, err := (&MethodScheduleInput{Height: height, Payload: payload}).Encode()
+	if err != nil {
+		return 0, err
+	}
+	ret, err := s.CallNative(this, input)
+	if err != nil {
+		return 0, err
+	}
+	output := new(MethodScheduleOutput)
+	if err := output.Decode(ret); err != nil {
+		return 0, err
+	}
+	return output.ID, nil
+}
+
+// Unschedule cancels a pending task of the current native contract.
+func Unschedule(s *native.NativeContract, id uint64) error {
+	input, err := (&MethodIDInput{ID: id}).Encode(MethodCancel)
+	if err != nil {
+		return err
+	}
+	_, err = s.CallNative(this, input)
+	return err
+}
+
+// ValidateCaller checks that the current method is invoked by the scheduler, callbacks which
+// should not be triggered by anyone else should call it first.
+func ValidateCaller(s *native.NativeContract) error {
+	if s.ContractRef().CurrentContext().Caller != this {
+		return ErrNotScheduler
+	}
+	return nil
+}
+
+// RunTasks executes the tasks due at the block height in the order of scheduling, it is
+// registered as the finalize hook of the scheduler. the state modification of a failed task is
+// reverted, and the task is marked as failed without affecting the block. the gas used by tasks
+// is metered in the system transaction of the block.
+func RunTasks(s *native.NativeContract) error {
+	height := s.ContractRef().BlockHeight()
+	list, err := getHeightTasks(s, height.Uint64())
+	if err != nil {
+		return fmt.Errorf("load tasks of height %d failed: %v", height, err)
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	db := s.StateDB()
+	for _, id := range list {
+		task, err := getTask(s, id)
+		if err != nil {
+			log.Error("Failed to load scheduled task", "id", id, "err", err)
+			continue
+		}
+		if task.Status != StatusPending {
+			continue
+		}
+
+		gas := TaskGasLimit
+		if left := s.ContractRef().GasLeft(); left < gas {
+			gas = left
+		}
+		snapshot := db.Snapshot()
+		ref := native.NewContractRef(db, this, this, height, common.EmptyHash, gas, nil)
+		ref.SetChainConfig(s.ContractRef().ChainConfig())
+		_, gasLeft, err := ref.NativeCall(this, task.Target, task.Payload)
+		s.ContractRef().UseGas(gas - gasLeft)
+		if err != nil {
+			db.RevertToSnapshot(snapshot)
+			task.Status = StatusFailed
+			log.Warn("Scheduled task failed", "id", task.ID, "target", task.Target, "height", height, "err", err)
+		} else {
+			task.Status = StatusExecuted
+		}
+		if err := setTask(s, task); err != nil {
+			log.Error("Failed to store scheduled task", "id", task.ID, "err", err)
+		}
+	}
+	if err := setHeightTasks(s, height.Uint64(), nil); err != nil {
+		return fmt.Errorf("clear tasks of height %d failed: %v", height, err)
+	}
+	return nil
+}
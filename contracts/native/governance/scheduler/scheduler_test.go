@@ -0,0 +1,235 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/system"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSupplyGas = uint64(100000000000000000)
+
+// the target contract schedules `tick` with `queue`, and `tick` fails if `Fail` is set.
+const testTargetABIJSON = `[
+	{"type":"function","name":"queue","inputs":[{"name":"Height","type":"uint64"},{"name":"Fail","type":"bool"}],"outputs":[{"name":"ID","type":"uint64"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"unqueue","inputs":[{"name":"ID","type":"uint64"}],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"tick","inputs":[{"name":"Fail","type":"bool"}],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"}
+]`
+
+var (
+	testStateDB   *state.StateDB
+	testTargetABI *abi.ABI
+	testTarget    = native.NativeContractAddrMap[native.NativeExtra19]
+	testTicksKey  = []byte("ticks")
+)
+
+func TestMain(m *testing.M) {
+	ab, err := abi.JSON(strings.NewReader(testTargetABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	testTargetABI = &ab
+	InitScheduler()
+	system.InitSystem()
+	native.Contracts[testTarget] = registerTestTarget
+	os.Exit(m.Run())
+}
+
+func registerTestTarget(s *native.NativeContract) {
+	s.Prepare(testTargetABI, map[string]uint64{"queue": 0, "unqueue": 0, "tick": 0})
+	s.Register("queue", func(s *native.NativeContract) ([]byte, error) {
+		var input struct {
+			Height uint64
+			Fail   bool
+		}
+		if err := utils.UnpackMethod(testTargetABI, "queue", &input, s.ContractRef().CurrentContext().Payload); err != nil {
+			return nil, err
+		}
+		payload, err := utils.PackMethod(testTargetABI, "tick", input.Fail)
+		if err != nil {
+			return nil, err
+		}
+		id, err := Schedule(s, input.Height, payload)
+		if err != nil {
+			return nil, err
+		}
+		return utils.PackOutputs(testTargetABI, "queue", id)
+	})
+	s.Register("unqueue", func(s *native.NativeContract) ([]byte, error) {
+		var input struct{ ID uint64 }
+		if err := utils.UnpackMethod(testTargetABI, "unqueue", &input, s.ContractRef().CurrentContext().Payload); err != nil {
+			return nil, err
+		}
+		if err := Unschedule(s, input.ID); err != nil {
+			return nil, err
+		}
+		return utils.PackOutputs(testTargetABI, "unqueue", true)
+	})
+	s.Register("tick", func(s *native.NativeContract) ([]byte, error) {
+		if err := ValidateCaller(s); err != nil {
+			return nil, err
+		}
+		var input struct{ Fail bool }
+		if err := utils.UnpackMethod(testTargetABI, "tick", &input, s.ContractRef().CurrentContext().Payload); err != nil {
+			return nil, err
+		}
+		s.GetCacheDB().Put(utils.ConcatKey(testTarget, testTicksKey), utils.GetUint64Bytes(ticks()+1))
+		if input.Fail {
+			return nil, errors.New("tick failed")
+		}
+		return utils.PackOutputs(testTargetABI, "tick", true)
+	})
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+}
+
+func finalize(height uint64) {
+	input, _ := new(system.MethodFinalizeInput).Encode()
+	ref := native.NewContractRef(testStateDB, common.EmptyAddress, common.EmptyAddress, new(big.Int).SetUint64(height), common.EmptyHash, testSupplyGas, nil)
+	ref.SetSystemTx()
+	ref.NativeCall(common.EmptyAddress, utils.SystemContractAddress, input)
+}
+
+func invoke(origin, contract common.Address, payload []byte, blockNum uint64) ([]byte, error) {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	ref := native.NewContractRef(testStateDB, origin, origin, new(big.Int).SetUint64(blockNum), common.BytesToHash(token), testSupplyGas, nil)
+	ret, _, err := ref.NativeCall(origin, contract, payload)
+	return ret, err
+}
+
+func queueTestTask(height uint64, fail bool, blockNum uint64) (uint64, error) {
+	payload, _ := utils.PackMethod(testTargetABI, "queue", height, fail)
+	ret, err := invoke(common.HexToAddress("0x1"), testTarget, payload, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	var id uint64
+	err = testTargetABI.UnpackIntoInterface(&id, "queue", ret)
+	return id, err
+}
+
+func getTestTask(t *testing.T, id uint64) *MethodTaskOutput {
+	payload, err := (&MethodIDInput{ID: id}).Encode(MethodTask)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, this, payload, 1)
+	assert.NoError(t, err)
+	output := new(MethodTaskOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output
+}
+
+func pending(t *testing.T, height uint64) []uint64 {
+	payload, err := (&MethodPendingInput{Height: height}).Encode()
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, this, payload, 1)
+	assert.NoError(t, err)
+	output := new(MethodPendingOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output.IDs
+}
+
+func ticks() uint64 {
+	value, _ := (*state.CacheDB)(testStateDB).Get(utils.ConcatKey(testTarget, testTicksKey))
+	if len(value) == 0 {
+		return 0
+	}
+	return utils.GetBytesUint64(value)
+}
+
+func TestRunTasks(t *testing.T) {
+	resetTestContext()
+
+	_, err := queueTestTask(10, false, 10)
+	assert.Equal(t, ErrInvalidHeight, err)
+
+	first, err := queueTestTask(20, false, 10)
+	assert.NoError(t, err)
+	failed, err := queueTestTask(20, true, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{first, failed}, pending(t, 20))
+	assert.Equal(t, testTarget, getTestTask(t, first).Target)
+
+	finalize(19)
+	assert.Equal(t, uint64(0), ticks())
+
+	// the modification of the failed task is reverted
+	finalize(20)
+	assert.Equal(t, uint64(1), ticks())
+	assert.Equal(t, uint8(StatusExecuted), getTestTask(t, first).Status)
+	assert.Equal(t, uint8(StatusFailed), getTestTask(t, failed).Status)
+	assert.Empty(t, pending(t, 20))
+
+	// tasks never run twice
+	finalize(20)
+	assert.Equal(t, uint64(1), ticks())
+}
+
+func TestCancelTask(t *testing.T) {
+	resetTestContext()
+
+	id, err := queueTestTask(20, false, 10)
+	assert.NoError(t, err)
+
+	payload, err := (&MethodIDInput{ID: id}).Encode(MethodCancel)
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), this, payload, 11)
+	assert.Equal(t, ErrNotTaskOwner, err)
+
+	payload, err = utils.PackMethod(testTargetABI, "unqueue", id)
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), testTarget, payload, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(StatusCanceled), getTestTask(t, id).Status)
+	assert.Empty(t, pending(t, 20))
+
+	finalize(20)
+	assert.Equal(t, uint64(0), ticks())
+}
+
+func TestScheduleInvalidCaller(t *testing.T) {
+	resetTestContext()
+
+	// accounts can't schedule tasks or trigger callbacks directly
+	payload, err := (&MethodScheduleInput{Height: 20, Payload: []byte{1}}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), this, payload, 1)
+	assert.Equal(t, ErrInvalidCaller, err)
+
+	payload, err = utils.PackMethod(testTargetABI, "tick", false)
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), testTarget, payload, 1)
+	assert.Equal(t, ErrNotScheduler, err)
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_TASK    = "st_task"
+	SKP_TASK_ID = "st_task_id"
+	SKP_HEIGHT  = "st_height"
+)
+
+func getTask(s *native.NativeContract, id uint64) (*Task, error) {
+	value, err := s.GetCacheDB().Get(taskKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrTaskNotExist
+	}
+
+	task := new(Task)
+	if err := rlp.DecodeBytes(value, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func setTask(s *native.NativeContract, task *Task) error {
+	value, err := rlp.EncodeToBytes(task)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(taskKey(task.ID), value)
+	return nil
+}
+
+// nextTaskID increase and returns the task id, the first task id is 1.
+func nextTaskID(s *native.NativeContract) (uint64, error) {
+	key := utils.ConcatKey(this, []byte(SKP_TASK_ID))
+	value, err := s.GetCacheDB().Get(key)
+	if err != nil {
+		return 0, err
+	}
+	id := uint64(1)
+	if len(value) > 0 {
+		id = utils.GetBytesUint64(value) + 1
+	}
+	s.GetCacheDB().Put(key, utils.GetUint64Bytes(id))
+	return id, nil
+}
+
+// getHeightTasks returns ids of the tasks at height in the order of scheduling.
+func getHeightTasks(s *native.NativeContract, height uint64) ([]uint64, error) {
+	value, err := s.GetCacheDB().Get(heightKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var list []uint64
+	if err := rlp.DecodeBytes(value, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func setHeightTasks(s *native.NativeContract, height uint64, list []uint64) error {
+	if len(list) == 0 {
+		s.GetCacheDB().Delete(heightKey(height))
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(heightKey(height), value)
+	return nil
+}
+
+func taskKey(id uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_TASK), utils.GetUint64Bytes(id))
+}
+
+func heightKey(height uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_HEIGHT), utils.GetUint64Bytes(height))
+}
@@ -35,6 +35,8 @@ var (
 	EventQuitSideChain            = side_chain_manager_abi.MethodQuitSideChain
 	EventApproveQuitSideChain     = side_chain_manager_abi.MethodApproveQuitSideChain
 	EventRegisterRedeem           = side_chain_manager_abi.MethodRegisterRedeem
+	EventSetShadowMode            = side_chain_manager_abi.MethodSetShadowMode
+	EventMigrateCCMC              = side_chain_manager_abi.MethodMigrateCCMC
 )
 
 func GetABI() *abi.ABI {
@@ -55,11 +57,39 @@ type RegisterSideChainParam struct {
 	ExtraInfo    []byte
 }
 
+type ExecuteUpdateSideChainParam struct {
+	SideChain []byte
+}
+
 type ChainidParam struct {
 	Chainid uint64
 	Address common.Address
 }
 
+type ShadowModeParam struct {
+	ChainId uint64
+	Blocks  uint64
+}
+
+type ChainIDParam struct {
+	ChainId uint64
+}
+
+type MigrateCCMCParam struct {
+	ChainId     uint64
+	CCMCAddress []byte
+	Height      uint64
+}
+
+type ChainHeightParam struct {
+	ChainId uint64
+	Height  uint64
+}
+
+type ChainRangeParam struct {
+	Range uint8
+}
+
 type RegisterRedeemParam struct {
 	RedeemChainID   uint64
 	ContractChainID uint64
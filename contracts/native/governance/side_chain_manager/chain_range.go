@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package side_chain_manager
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// Chain id ranges reserved for the ecosystems, a side chain can only be registered
+// with the chain id inside one of the ranges. chain id 0 is the relay chain itself,
+// and the ids above the user range are kept for the future ranges.
+const (
+	ChainRangePartner uint8 = iota + 1
+	ChainRangeTestnet
+	ChainRangeUser
+)
+
+// ChainRange is a closed interval of chain ids.
+type ChainRange struct {
+	ID    uint8
+	Name  string
+	Start uint64
+	End   uint64
+}
+
+var chainRanges = []*ChainRange{
+	{ID: ChainRangePartner, Name: "partner", Start: 1, End: 9999},
+	{ID: ChainRangeTestnet, Name: "testnet", Start: 10000, End: 99999},
+	{ID: ChainRangeUser, Name: "user", Start: 100000, End: math.MaxUint32},
+}
+
+// GetChainRange returns the range of id, or nil if the range is undefined.
+func GetChainRange(id uint8) *ChainRange {
+	for _, r := range chainRanges {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// ChainRangeOf returns the range containing the chain id, or nil if the chain id is reserved.
+func ChainRangeOf(chainID uint64) *ChainRange {
+	for _, r := range chainRanges {
+		if chainID >= r.Start && chainID <= r.End {
+			return r
+		}
+	}
+	return nil
+}
+
+func validateChainID(chainID uint64) error {
+	if ChainRangeOf(chainID) == nil {
+		return fmt.Errorf("chainid %d is reserved, not in any registrable range", chainID)
+	}
+	return nil
+}
+
+// getRangeIndex returns the sorted chain ids registered in the range.
+func getRangeIndex(native *native.NativeContract, rangeID uint8) ([]uint64, error) {
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(SIDE_CHAIN_INDEX), []byte{rangeID}))
+	if err != nil {
+		return nil, fmt.Errorf("getRangeIndex, get index store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("getRangeIndex, deserialize from raw storage item err: %v", err)
+	}
+	source := common.NewZeroCopySource(raw)
+	n, eof := source.NextVarUint()
+	if eof {
+		return nil, fmt.Errorf("getRangeIndex, deserialize length error")
+	}
+	ids := make([]uint64, 0, n)
+	for i := uint64(0); i < n; i++ {
+		id, eof := source.NextVarUint()
+		if eof {
+			return nil, fmt.Errorf("getRangeIndex, deserialize chainid error")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func putRangeIndex(native *native.NativeContract, rangeID uint8, ids []uint64) {
+	key := utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(SIDE_CHAIN_INDEX), []byte{rangeID})
+	if len(ids) == 0 {
+		native.GetCacheDB().Delete(key)
+		return
+	}
+	sink := common.NewZeroCopySink(nil)
+	sink.WriteVarUint(uint64(len(ids)))
+	for _, id := range ids {
+		sink.WriteVarUint(id)
+	}
+	native.GetCacheDB().Put(key, cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetSideChainIDs reads the ids of side chains registered in all ranges from the given state.
+func GetSideChainIDs(db *state.StateDB) ([]uint64, error) {
+	ref := native.NewContractRef(db, ecommon.EmptyAddress, ecommon.EmptyAddress, ecommon.Big0, ecommon.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	var ids []uint64
+	for _, r := range chainRanges {
+		list, err := getRangeIndex(s, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, list...)
+	}
+	return ids, nil
+}
+
+// indexSideChain adds the chain id into the index of its range, the chain ids out of
+// the ranges are left unindexed.
+func indexSideChain(native *native.NativeContract, chainID uint64) error {
+	r := ChainRangeOf(chainID)
+	if r == nil {
+		return nil
+	}
+	ids, err := getRangeIndex(native, r.ID)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(ids), func(i int) bool { return ids[i] >= chainID })
+	if i < len(ids) && ids[i] == chainID {
+		return nil
+	}
+	ids = append(ids, 0)
+	copy(ids[i+1:], ids[i:])
+	ids[i] = chainID
+	putRangeIndex(native, r.ID, ids)
+	return nil
+}
+
+// unindexSideChain removes the chain id from the index of its range.
+func unindexSideChain(native *native.NativeContract, chainID uint64) error {
+	r := ChainRangeOf(chainID)
+	if r == nil {
+		return nil
+	}
+	ids, err := getRangeIndex(native, r.ID)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(ids), func(i int) bool { return ids[i] >= chainID })
+	if i == len(ids) || ids[i] != chainID {
+		return nil
+	}
+	putRangeIndex(native, r.ID, append(ids[:i], ids[i+1:]...))
+	return nil
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// CCMCMigration is a cutover of the CCMC address of a chain, the proofs of the source blocks lower
+// than the height are verified against the previous address.
+type CCMCMigration struct {
+	Height   uint64
+	Previous []byte
+}
+
+// CCMCMigrations are the cutovers of a chain in ascending order of height.
+type CCMCMigrations struct {
+	List  []*CCMCMigration
+	Nonce uint64 // nonce of the change
+}
+
+func (m *CCMCMigrations) Serialization(sink *common.ZeroCopySink) {
+	sink.WriteVarUint(uint64(len(m.List)))
+	for _, v := range m.List {
+		sink.WriteVarUint(v.Height)
+		sink.WriteVarBytes(v.Previous)
+	}
+	sink.WriteVarUint(m.Nonce)
+}
+
+func (m *CCMCMigrations) Deserialization(source *common.ZeroCopySource) error {
+	n, eof := source.NextVarUint()
+	if eof {
+		return fmt.Errorf("CCMCMigrations deserialize length error")
+	}
+	m.List = make([]*CCMCMigration, 0, n)
+	for i := uint64(0); i < n; i++ {
+		v := new(CCMCMigration)
+		if v.Height, eof = source.NextVarUint(); eof {
+			return fmt.Errorf("CCMCMigrations deserialize height error")
+		}
+		if v.Previous, eof = source.NextVarBytes(); eof {
+			return fmt.Errorf("CCMCMigrations deserialize previous address error")
+		}
+		m.List = append(m.List, v)
+	}
+	if m.Nonce, eof = source.NextVarUint(); eof {
+		return fmt.Errorf("CCMCMigrations deserialize nonce error")
+	}
+	return nil
+}
+
+// AddressAt returns the CCMC address in force at the source height, `current` is the address of
+// the side chain which is in force since the last cutover.
+func (m *CCMCMigrations) AddressAt(height uint64, current []byte) []byte {
+	for _, v := range m.List {
+		if height < v.Height {
+			return v.Previous
+		}
+	}
+	return current
+}
+
+// MigrateCCMC validators move the CCMC of the chain to the new address since the source height,
+// the proofs of the lower blocks are still verified against the old address, so that the messages
+// in flight are not dropped while the chain upgrades its CCMC. the cutover heights must increase,
+// and the change is applied after quorum reached.
+func MigrateCCMC(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &MigrateCCMCParam{}
+	if err := utils.UnpackMethod(ABI, MethodMigrateCCMC, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sideChain, err := GetSideChain(native, params.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("MigrateCCMC, %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("MigrateCCMC, side chain %d is not registered", params.ChainId)
+	}
+	if len(params.CCMCAddress) == 0 || bytes.Equal(params.CCMCAddress, sideChain.CCMCAddress) {
+		return nil, fmt.Errorf("MigrateCCMC, invalid CCMC address %x", params.CCMCAddress)
+	}
+	migrations, err := GetCCMCMigrations(native, params.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("MigrateCCMC, %v", err)
+	}
+	if n := len(migrations.List); n > 0 && params.Height <= migrations.List[n-1].Height {
+		return nil, fmt.Errorf("MigrateCCMC, height %d not higher than the last cutover %d", params.Height, migrations.List[n-1].Height)
+	}
+	sign := append(utils.GetUint64Bytes(migrations.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, MethodMigrateCCMC, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("MigrateCCMC, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(ABI, MethodMigrateCCMC, true)
+	}
+
+	migrations.Nonce++
+	migrations.List = append(migrations.List, &CCMCMigration{Height: params.Height, Previous: sideChain.CCMCAddress})
+	putCCMCMigrations(native, params.ChainId, migrations)
+	sideChain.CCMCAddress = params.CCMCAddress
+	if err := PutSideChain(native, sideChain); err != nil {
+		return nil, fmt.Errorf("MigrateCCMC, %v", err)
+	}
+	if err := native.AddNotify(ABI, []string{EventMigrateCCMC}, params.ChainId, params.CCMCAddress, params.Height); err != nil {
+		return nil, fmt.Errorf("MigrateCCMC, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodMigrateCCMC, true)
+}
+
+func CCMCAddressAt(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &ChainHeightParam{}
+	if err := utils.UnpackMethod(ABI, MethodCCMCAddressAt, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sideChain, err := GetSideChainAt(native, params.ChainId, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("CCMCAddressAt, %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("CCMCAddressAt, side chain %d is not registered", params.ChainId)
+	}
+	return utils.PackOutputs(ABI, MethodCCMCAddressAt, sideChain.CCMCAddress)
+}
+
+// GetSideChainAt returns the side chain with the CCMC address in force at the source height, it
+// should be used by the chain handlers to verify the proofs.
+func GetSideChainAt(native *native.NativeContract, chainID, height uint64) (*SideChain, error) {
+	sideChain, err := GetSideChain(native, chainID)
+	if err != nil || sideChain == nil {
+		return sideChain, err
+	}
+	migrations, err := GetCCMCMigrations(native, chainID)
+	if err != nil {
+		return nil, err
+	}
+	sideChain.CCMCAddress = migrations.AddressAt(height, sideChain.CCMCAddress)
+	return sideChain, nil
+}
+
+// GetCCMCMigrations returns empty migrations if the CCMC of the chain is never migrated.
+func GetCCMCMigrations(native *native.NativeContract, chainID uint64) (*CCMCMigrations, error) {
+	store, err := native.GetCacheDB().Get(ccmcMigrationKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("GetCCMCMigrations, get migrations store error: %v", err)
+	}
+	migrations := new(CCMCMigrations)
+	if store == nil {
+		return migrations, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetCCMCMigrations, deserialize from raw storage item err: %v", err)
+	}
+	if err := migrations.Deserialization(common.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetCCMCMigrations, %v", err)
+	}
+	return migrations, nil
+}
+
+func putCCMCMigrations(native *native.NativeContract, chainID uint64, migrations *CCMCMigrations) {
+	sink := common.NewZeroCopySink(nil)
+	migrations.Serialization(sink)
+	native.GetCacheDB().Put(ccmcMigrationKey(chainID), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+func ccmcMigrationKey(chainID uint64) []byte {
+	return utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(CCMC_MIGRATION), utils.GetUint64Bytes(chainID))
+}
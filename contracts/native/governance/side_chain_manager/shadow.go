@@ -0,0 +1,149 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// MaxShadowBlocks is the upper bound of the blocks which a chain runs in shadow mode.
+const MaxShadowBlocks = uint64(1000000)
+
+// ShadowMode keeps the imports of a chain in shadow until the block height: the imports are
+// verified, metered and logged with events by the cross chain manager, but never executed, so that
+// a new chain handler could be de-risked against the live traffic of the chain.
+type ShadowMode struct {
+	Until uint64 // shadow mode ends at the height
+	Nonce uint64 // nonce of the change
+}
+
+func (m *ShadowMode) Serialization(sink *common.ZeroCopySink) {
+	sink.WriteVarUint(m.Until)
+	sink.WriteVarUint(m.Nonce)
+}
+
+func (m *ShadowMode) Deserialization(source *common.ZeroCopySource) error {
+	var eof bool
+	if m.Until, eof = source.NextVarUint(); eof {
+		return fmt.Errorf("ShadowMode deserialize until error")
+	}
+	if m.Nonce, eof = source.NextVarUint(); eof {
+		return fmt.Errorf("ShadowMode deserialize nonce error")
+	}
+	return nil
+}
+
+// SetShadowMode validators keep the imports of the chain in shadow for the blocks from now on, the
+// chain is not required to be registered, so that the shadow mode could be set before approving
+// the registration or the handler update. zero blocks ends the shadow mode immediately, and the
+// change is applied after quorum reached.
+func SetShadowMode(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &ShadowModeParam{}
+	if err := utils.UnpackMethod(ABI, MethodSetShadowMode, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if err := validateChainID(params.ChainId); err != nil {
+		return nil, fmt.Errorf("SetShadowMode, %v", err)
+	}
+	if params.Blocks > MaxShadowBlocks {
+		return nil, fmt.Errorf("SetShadowMode, blocks %d exceeds the limit %d", params.Blocks, MaxShadowBlocks)
+	}
+	mode, err := GetShadowMode(native, params.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("SetShadowMode, %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(mode.Nonce), ctx.Payload...)
+	ok, err := node_manager.CheckConsensusSigns(native, MethodSetShadowMode, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("SetShadowMode, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(ABI, MethodSetShadowMode, true)
+	}
+
+	mode.Nonce++
+	mode.Until = 0
+	if params.Blocks > 0 {
+		mode.Until = native.ContractRef().BlockHeight().Uint64() + params.Blocks
+	}
+	putShadowMode(native, params.ChainId, mode)
+	if err := native.AddNotify(ABI, []string{EventSetShadowMode}, params.ChainId, mode.Until); err != nil {
+		return nil, fmt.Errorf("SetShadowMode, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodSetShadowMode, true)
+}
+
+func ShadowModeUntil(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := utils.UnpackMethod(ABI, MethodShadowMode, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	mode, err := GetShadowMode(native, params.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("ShadowModeUntil, %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodShadowMode, mode.Until)
+}
+
+// IsShadowMode returns whether the imports of chain are in shadow at the current block.
+func IsShadowMode(native *native.NativeContract, chainID uint64) (bool, error) {
+	mode, err := GetShadowMode(native, chainID)
+	if err != nil {
+		return false, err
+	}
+	return native.ContractRef().BlockHeight().Uint64() < mode.Until, nil
+}
+
+// GetShadowMode returns an empty shadow mode if it's never set for the chain.
+func GetShadowMode(native *native.NativeContract, chainID uint64) (*ShadowMode, error) {
+	store, err := native.GetCacheDB().Get(shadowModeKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("GetShadowMode, get shadow mode store error: %v", err)
+	}
+	mode := new(ShadowMode)
+	if store == nil {
+		return mode, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetShadowMode, deserialize from raw storage item err: %v", err)
+	}
+	if err := mode.Deserialization(common.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetShadowMode, %v", err)
+	}
+	return mode, nil
+}
+
+func putShadowMode(native *native.NativeContract, chainID uint64, mode *ShadowMode) {
+	sink := common.NewZeroCopySink(nil)
+	mode.Serialization(sink)
+	native.GetCacheDB().Put(shadowModeKey(chainID), cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+func shadowModeKey(chainID uint64) []byte {
+	return utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(SHADOW_MODE), utils.GetUint64Bytes(chainID))
+}
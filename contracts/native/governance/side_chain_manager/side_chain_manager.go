@@ -26,7 +26,9 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/side_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/timelock"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/polynetwork/poly/common"
 )
@@ -40,10 +42,16 @@ const (
 	MethodApproveRegisterSideChain = "approveRegisterSideChain"
 	MethodUpdateSideChain          = "updateSideChain"
 	MethodApproveUpdateSideChain   = "approveUpdateSideChain"
+	MethodExecuteUpdateSideChain   = "executeUpdateSideChain"
 	MethodQuitSideChain            = "quitSideChain"
 	MethodApproveQuitSideChain     = "approveQuitSideChain"
 	MethodRegisterRedeem           = "registerRedeem"
 	MethodSetBtcTxParam            = "setBtcTxParam"
+	MethodSideChainsInRange        = "sideChainsInRange"
+	MethodSetShadowMode            = "setShadowMode"
+	MethodShadowMode               = "shadowMode"
+	MethodMigrateCCMC              = "migrateCCMC"
+	MethodCCMCAddressAt            = "ccmcAddressAt"
 
 	//key prefix
 	SIDE_CHAIN_APPLY          = "sideChainApply"
@@ -54,42 +62,74 @@ const (
 	BIND_SIGN_INFO            = "bindSignInfo"
 	BTC_TX_PARAM              = "btcTxParam"
 	REDEEM_SCRIPT             = "redeemScript"
+	SIDE_CHAIN_INDEX          = "sideChainIndex"
+	SHADOW_MODE               = "shadowMode"
+	CCMC_MIGRATION            = "ccmcMigration"
 )
 
 var (
 	this     = native.NativeContractAddrMap[native.NativeSideChainManager]
 	gasTable = map[string]uint64{
-		// MethodContractName:             0,
+		MethodContractName:             0,
 		MethodRegisterSideChain:        0,
 		MethodApproveRegisterSideChain: 100000,
 		MethodUpdateSideChain:          0,
 		MethodApproveUpdateSideChain:   0,
+		MethodExecuteUpdateSideChain:   0,
 		MethodQuitSideChain:            0,
 		MethodApproveQuitSideChain:     0,
 		MethodRegisterRedeem:           0,
 		MethodSetBtcTxParam:            0,
+		MethodSideChainsInRange:        0,
+		MethodSetShadowMode:            100000,
+		MethodShadowMode:               0,
+		MethodMigrateCCMC:              100000,
+		MethodCCMCAddressAt:            0,
 	}
 
+	// the bounds of arguments checked before dispatching, the handlers still validate the values
+	addressRule = &native.ArgRule{NonZero: true}
+	bytesRule   = &native.ArgRule{NonZero: true}
+	redeemRule  = &native.ArgRule{NonZero: true, MaxBytes: txscript.MaxScriptElementSize}
+
 	ABI *abi.ABI
 )
 
 func InitSideChainManager() {
 	ABI = GetABI()
+	native.RegisterABI(native.NativeSideChainManager, "SideChainManager", side_chain_manager_abi.SideChainManagerABI)
 	native.Contracts[this] = RegisterSideChainManagerContract
 }
 
 func RegisterSideChainManagerContract(s *native.NativeContract) {
 	s.Prepare(ABI, gasTable)
 
-	// s.Register(MethodContractName, Name)
+	s.RegisterQuery(MethodContractName, Name)
 	s.Register(MethodRegisterSideChain, RegisterSideChain)
 	s.Register(MethodApproveRegisterSideChain, ApproveRegisterSideChain)
 	s.Register(MethodUpdateSideChain, UpdateSideChain)
 	s.Register(MethodApproveUpdateSideChain, ApproveUpdateSideChain)
+	s.Register(MethodExecuteUpdateSideChain, ExecuteUpdateSideChain)
 	s.Register(MethodQuitSideChain, QuitSideChain)
 	s.Register(MethodApproveQuitSideChain, ApproveQuitSideChain)
 	s.Register(MethodRegisterRedeem, RegisterRedeem)
 	s.Register(MethodSetBtcTxParam, SetBtcTxParam)
+	s.RegisterQuery(MethodSideChainsInRange, SideChainsInRange)
+	s.Register(MethodSetShadowMode, SetShadowMode)
+	s.RegisterQuery(MethodShadowMode, ShadowModeUntil)
+	s.Register(MethodMigrateCCMC, MigrateCCMC)
+	s.RegisterQuery(MethodCCMCAddressAt, CCMCAddressAt)
+
+	s.RegisterRules(MethodRegisterSideChain, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(MethodApproveRegisterSideChain, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(MethodUpdateSideChain, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(MethodApproveUpdateSideChain, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(MethodExecuteUpdateSideChain, native.MethodRules{"SideChain": bytesRule})
+	s.RegisterRules(MethodQuitSideChain, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(MethodApproveQuitSideChain, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(MethodRegisterRedeem, native.MethodRules{"Redeem": redeemRule})
+	s.RegisterRules(MethodSetBtcTxParam, native.MethodRules{"Redeem": redeemRule})
+	s.RegisterRules(MethodMigrateCCMC, native.MethodRules{"CCMCAddress": bytesRule})
 }
 
 func Name(s *native.NativeContract) ([]byte, error) {
@@ -108,6 +148,9 @@ func RegisterSideChain(native *native.NativeContract) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSideChain, checkWitness error: %v", err)
 	}
+	if err := validateChainID(params.ChainId); err != nil {
+		return nil, fmt.Errorf("RegisterSideChain, %v", err)
+	}
 	registerSideChain, err := GetSideChainApply(native, params.ChainId)
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSideChain, getRegisterSideChain error: %v", err)
@@ -258,12 +301,20 @@ func ApproveUpdateSideChain(native *native.NativeContract) ([]byte, error) {
 		return nil, fmt.Errorf("ApproveUpdateSideChain, CheckConsensusSigns error: %v", err)
 	}
 	if !ok {
-		utils.PackOutputs(ABI, MethodApproveUpdateSideChain, true)
+		return utils.PackOutputs(ABI, MethodApproveUpdateSideChain, true)
 	}
 
-	err = PutSideChain(native, sideChain)
+	// the approved update takes effect after the timelock delay
+	sink := common.NewZeroCopySink(nil)
+	if err := sideChain.Serialization(sink); err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, sideChain.Serialization error: %v", err)
+	}
+	payload, err := utils.PackMethod(ABI, MethodExecuteUpdateSideChain, sink.Bytes())
 	if err != nil {
-		return nil, fmt.Errorf("ApproveUpdateSideChain, putSideChain error: %v", err)
+		return nil, fmt.Errorf("ApproveUpdateSideChain, pack execute payload error: %v", err)
+	}
+	if _, err := timelock.Schedule(native, payload); err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, timelock.Schedule error: %v", err)
 	}
 
 	chainidByte := utils.GetUint64Bytes(params.Chainid)
@@ -277,6 +328,37 @@ func ApproveUpdateSideChain(native *native.NativeContract) ([]byte, error) {
 	return utils.PackOutputs(ABI, MethodApproveUpdateSideChain, true)
 }
 
+func ExecuteUpdateSideChain(native *native.NativeContract) ([]byte, error) {
+	if err := timelock.ValidateCaller(native); err != nil {
+		return nil, fmt.Errorf("ExecuteUpdateSideChain, %v", err)
+	}
+
+	ctx := native.ContractRef().CurrentContext()
+	params := &ExecuteUpdateSideChainParam{}
+	if err := utils.UnpackMethod(ABI, MethodExecuteUpdateSideChain, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	updateSideChain := new(SideChain)
+	if err := updateSideChain.Deserialization(common.NewZeroCopySource(params.SideChain)); err != nil {
+		return nil, fmt.Errorf("ExecuteUpdateSideChain, deserialize sideChain error: %v", err)
+	}
+
+	// side chain may quit during the delay
+	sideChain, err := GetSideChain(native, updateSideChain.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("ExecuteUpdateSideChain, getSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("ExecuteUpdateSideChain, side chain is not registered")
+	}
+
+	err = PutSideChain(native, updateSideChain)
+	if err != nil {
+		return nil, fmt.Errorf("ExecuteUpdateSideChain, putSideChain error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodExecuteUpdateSideChain, true)
+}
+
 func QuitSideChain(native *native.NativeContract) ([]byte, error) {
 	ctx := native.ContractRef().CurrentContext()
 	params := &ChainidParam{}
@@ -343,6 +425,9 @@ func ApproveQuitSideChain(native *native.NativeContract) ([]byte, error) {
 	chainidByte := utils.GetUint64Bytes(params.Chainid)
 	native.GetCacheDB().Delete(utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(MethodQuitSideChain), chainidByte))
 	native.GetCacheDB().Delete(utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(SIDE_CHAIN), chainidByte))
+	if err := unindexSideChain(native, params.Chainid); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, unindexSideChain error: %v", err)
+	}
 
 	err = native.AddNotify(ABI, []string{EventApproveQuitSideChain}, params.Chainid)
 	if err != nil {
@@ -470,3 +555,23 @@ func SetBtcTxParam(native *native.NativeContract) ([]byte, error) {
 
 	return utils.PackOutputs(ABI, MethodRegisterRedeem, true)
 }
+
+func SideChainsInRange(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &ChainRangeParam{}
+	if err := utils.UnpackMethod(ABI, MethodSideChainsInRange, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	r := GetChainRange(params.Range)
+	if r == nil {
+		return nil, fmt.Errorf("SideChainsInRange, range %d is undefined", params.Range)
+	}
+	ids, err := getRangeIndex(native, r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("SideChainsInRange, getRangeIndex error: %v", err)
+	}
+	if ids == nil {
+		ids = []uint64{}
+	}
+	return utils.PackOutputs(ABI, MethodSideChainsInRange, r.Start, r.End, ids)
+}
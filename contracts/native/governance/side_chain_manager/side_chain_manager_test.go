@@ -19,6 +19,7 @@ package side_chain_manager
 
 import (
 	"encoding/hex"
+	"math"
 	"math/big"
 	"testing"
 
@@ -190,3 +191,54 @@ func TestApproveUpdateSideChain(t *testing.T) {
 	assert.Equal(t, sideChain.Name, "own")
 	assert.Nil(t, err)
 }
+
+func TestChainRange(t *testing.T) {
+	assert.Nil(t, ChainRangeOf(0))
+	assert.Equal(t, ChainRangePartner, ChainRangeOf(1).ID)
+	assert.Equal(t, ChainRangePartner, ChainRangeOf(9999).ID)
+	assert.Equal(t, ChainRangeTestnet, ChainRangeOf(10000).ID)
+	assert.Equal(t, ChainRangeUser, ChainRangeOf(100000).ID)
+	assert.Nil(t, ChainRangeOf(math.MaxUint32+1))
+	assert.Nil(t, GetChainRange(0))
+
+	// the reserved chain id can't be registered
+	param := &RegisterSideChainParam{ChainId: 0, Name: "reserved", Router: 3}
+	input, err := utils.PackMethodWithStruct(ABI, MethodRegisterSideChain, param)
+	assert.Nil(t, err)
+	contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, gasTable[MethodRegisterSideChain], nil)
+	_, _, err = contractRef.NativeCall(common.Address{}, utils.SideChainManagerContractAddress, input)
+	assert.NotNil(t, err)
+}
+
+func TestSideChainsInRange(t *testing.T) {
+	contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	contract := native.NewNativeContract(sdb, contractRef)
+	for _, id := range []uint64{20000, 5, 10001, 20000} {
+		assert.Nil(t, PutSideChain(contract, &SideChain{ChainId: id, Name: "chain"}))
+	}
+
+	query := func(rangeID uint8) (uint64, uint64, []uint64) {
+		input, err := utils.PackMethod(ABI, MethodSideChainsInRange, rangeID)
+		assert.Nil(t, err)
+		ret, _, err := contractRef.NativeCall(common.Address{}, utils.SideChainManagerContractAddress, input)
+		assert.Nil(t, err)
+		output, err := ABI.Unpack(MethodSideChainsInRange, ret)
+		assert.Nil(t, err)
+		return output[0].(uint64), output[1].(uint64), output[2].([]uint64)
+	}
+	start, end, ids := query(ChainRangeTestnet)
+	assert.Equal(t, uint64(10000), start)
+	assert.Equal(t, uint64(99999), end)
+	assert.Equal(t, []uint64{10001, 20000}, ids)
+
+	assert.Nil(t, unindexSideChain(contract, 10001))
+	_, _, ids = query(ChainRangeTestnet)
+	assert.Equal(t, []uint64{20000}, ids)
+	_, _, ids = query(ChainRangeUser)
+	assert.Empty(t, ids)
+
+	input, err := utils.PackMethod(ABI, MethodSideChainsInRange, uint8(9))
+	assert.Nil(t, err)
+	_, _, err = contractRef.NativeCall(common.Address{}, utils.SideChainManagerContractAddress, input)
+	assert.NotNil(t, err)
+}
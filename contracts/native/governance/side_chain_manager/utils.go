@@ -107,6 +107,9 @@ func PutSideChain(native *native.NativeContract, sideChain *SideChain) error {
 
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(SIDE_CHAIN), chainidByte),
 		cstates.GenRawStorageItem(sink.Bytes()))
+	if err := indexSideChain(native, sideChain.ChainId); err != nil {
+		return fmt.Errorf("putSideChain, indexSideChain error: %v", err)
+	}
 	return nil
 }
 
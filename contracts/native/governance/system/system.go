@@ -0,0 +1,103 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package system
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// System contract is the entrance of the consensus initiated state changes, its methods can only
+// be invoked by the system transactions which the block proposer appends to the end of block.
+// every node generates the expected system transactions after the common transactions of the
+// block, and rejects the block if the carried ones are different.
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodFinalize:     0,
+	}
+)
+
+func InitSystem() {
+	InitABI()
+	native.RegisterABI(native.NativeSystem, "System", abijson)
+	native.Contracts[this] = RegisterSystemContract
+	native.RegisterSystemCall(native.NativeSystem, finalizeCall)
+}
+
+func RegisterSystemContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.Register(MethodFinalize, Finalize)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+// Finalize invokes the finalize hooks of native contracts in ascending order of address. a failed
+// hook is reverted and recorded with event log without affecting the others, and the gas used by
+// hooks is metered in the system transaction.
+func Finalize(s *native.NativeContract) ([]byte, error) {
+	if err := checkSystemTx(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	height := s.ContractRef().BlockHeight()
+	for _, addr := range native.FinalizeHooks() {
+		err := s.RunFinalizeHook(addr)
+		if err == nil {
+			continue
+		}
+		log.Warn("Finalize hook failed", "contract", addr, "height", height, "err", err)
+		if err := emitHookFailed(s, addr, err); err != nil {
+			log.Trace("finalize", "emit event failed", err)
+			return utils.ByteFailed, ErrEmitLog
+		}
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodFinalize)
+}
+
+// checkSystemTx ensures that the method is invoked by the system transaction directly.
+func checkSystemTx(s *native.NativeContract) error {
+	ref := s.ContractRef()
+	if !ref.IsSystemTx() || ref.CallingContext() != nil {
+		return ErrNotSystemTx
+	}
+	return nil
+}
+
+// finalizeCall generates the `finalize` system call of every block if any hook is registered. it
+// is only consulted since the system tx fork, before which no block carries system transactions
+// and the hooks are not invoked.
+func finalizeCall(db *state.StateDB, height *big.Int) []byte {
+	if len(native.FinalizeHooks()) == 0 {
+		return nil
+	}
+	input, err := new(MethodFinalizeInput).Encode()
+	if err != nil {
+		panic(err)
+	}
+	return input
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package system
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testStateDB *state.StateDB
+	testHookA   = native.NativeContractAddrMap[native.NativeExtra18]
+	testHookB   = native.NativeContractAddrMap[native.NativeExtra19]
+	testHookKey = []byte("finalized")
+	testHookGas = uint64(1000)
+)
+
+func TestMain(m *testing.M) {
+	InitSystem()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+}
+
+func finalize(t *testing.T, systemTx bool, gas uint64) (uint64, error) {
+	input, err := new(MethodFinalizeInput).Encode()
+	assert.NoError(t, err)
+	origin := common.HexToAddress("0x1")
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(10), common.HexToHash("0x2"), gas, nil)
+	if systemTx {
+		ref.SetSystemTx()
+	}
+	_, gasLeft, err := ref.NativeCall(origin, this, input)
+	return gasLeft, err
+}
+
+func finalized(addr common.Address) bool {
+	value, _ := (*state.CacheDB)(testStateDB).Get(utils.ConcatKey(addr, testHookKey))
+	return len(value) > 0
+}
+
+func TestFinalize(t *testing.T) {
+	resetTestContext()
+
+	// nothing to do without finalize hooks
+	assert.Nil(t, native.SystemCalls(testStateDB, big.NewInt(10)))
+
+	native.RegisterFinalizeHook(native.NativeExtra18, testHookGas, func(s *native.NativeContract) error {
+		s.GetCacheDB().Put(utils.ConcatKey(testHookA, testHookKey), []byte{1})
+		return errors.New("hook failed")
+	})
+	native.RegisterFinalizeHook(native.NativeExtra19, testHookGas, func(s *native.NativeContract) error {
+		s.GetCacheDB().Put(utils.ConcatKey(testHookB, testHookKey), []byte{1})
+		return nil
+	})
+
+	calls := native.SystemCalls(testStateDB, big.NewInt(10))
+	assert.Equal(t, 1, len(calls))
+	assert.Equal(t, this, calls[0].To)
+	input, _ := new(MethodFinalizeInput).Encode()
+	assert.Equal(t, input, calls[0].Input)
+
+	_, err := finalize(t, false, 2*testHookGas)
+	assert.Equal(t, ErrNotSystemTx, err)
+	assert.False(t, finalized(testHookB))
+
+	// hooks are not invoked without gas
+	gasLeft, err := finalize(t, true, testHookGas-1)
+	assert.NoError(t, err)
+	assert.Equal(t, testHookGas-1, gasLeft)
+	assert.False(t, finalized(testHookA))
+	assert.False(t, finalized(testHookB))
+
+	// failed hook is reverted without affecting the others, and the gas of both is charged
+	gasLeft, err = finalize(t, true, 3*testHookGas)
+	assert.NoError(t, err)
+	assert.Equal(t, testHookGas, gasLeft)
+	assert.False(t, finalized(testHookA))
+	assert.True(t, finalized(testHookB))
+}
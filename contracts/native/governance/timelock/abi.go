@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package timelock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "timelock"
+
+const (
+	MethodContractName = "name"
+	MethodDelay        = "delay"
+	MethodAction       = "action"
+	MethodSchedule     = "schedule"
+	MethodExecute      = "execute"
+	MethodCancel       = "cancel"
+	MethodChangeDelay  = "changeDelay"
+
+	EventScheduled    = "scheduled"
+	EventExecuted     = "executed"
+	EventCanceled     = "canceled"
+	EventDelayChanged = "delayChanged"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodDelay + `","inputs":[],"outputs":[{"internalType":"uint64","name":"Delay","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodAction + `","inputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"outputs":[{"internalType":"address","name":"Target","type":"address"},{"internalType":"bytes","name":"Payload","type":"bytes"},{"internalType":"uint64","name":"Eta","type":"uint64"},{"internalType":"uint8","name":"Status","type":"uint8"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSchedule + `","inputs":[{"internalType":"bytes","name":"Payload","type":"bytes"}],"outputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodExecute + `","inputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodCancel + `","inputs":[{"internalType":"uint64","name":"ID","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodChangeDelay + `","inputs":[{"internalType":"uint64","name":"Delay","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"` + EventScheduled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ID","type":"uint64"},{"indexed":false,"internalType":"address","name":"Target","type":"address"},{"indexed":false,"internalType":"uint64","name":"Eta","type":"uint64"}]},
+	{"type":"event","name":"` + EventExecuted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ID","type":"uint64"}]},
+	{"type":"event","name":"` + EventCanceled + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ID","type":"uint64"}]},
+	{"type":"event","name":"` + EventDelayChanged + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"Delay","type":"uint64"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.TimelockContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+type MethodDelayOutput struct {
+	Delay uint64
+}
+
+func (m *MethodDelayOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodDelay, m.Delay)
+}
+func (m *MethodDelayOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodDelay, m, payload)
+}
+
+// MethodIDInput is shared by `action`, `execute` and `cancel`.
+type MethodIDInput struct {
+	ID uint64
+}
+
+func (m *MethodIDInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.ID)
+}
+func (m *MethodIDInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodActionOutput struct {
+	Target  common.Address
+	Payload []byte
+	Eta     uint64
+	Status  uint8
+}
+
+func (m *MethodActionOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodAction, m.Target, m.Payload, m.Eta, m.Status)
+}
+func (m *MethodActionOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodAction, m, payload)
+}
+
+type MethodScheduleInput struct {
+	Payload []byte
+}
+
+func (m *MethodScheduleInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSchedule, m.Payload)
+}
+func (m *MethodScheduleInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSchedule, m, payload)
+}
+
+type MethodScheduleOutput struct {
+	ID uint64
+}
+
+func (m *MethodScheduleOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSchedule, m.ID)
+}
+func (m *MethodScheduleOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSchedule, m, payload)
+}
+
+type MethodChangeDelayInput struct {
+	Delay uint64
+}
+
+func (m *MethodChangeDelayInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodChangeDelay, m.Delay)
+}
+func (m *MethodChangeDelayInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodChangeDelay, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitScheduled(s *native.NativeContract, action *Action) error {
+	return s.AddNotify(ABI, []string{EventScheduled}, action.ID, action.Target, action.Eta)
+}
+
+func emitExecuted(s *native.NativeContract, id uint64) error {
+	return s.AddNotify(ABI, []string{EventExecuted}, id)
+}
+
+func emitCanceled(s *native.NativeContract, id uint64) error {
+	return s.AddNotify(ABI, []string{EventCanceled}, id)
+}
+
+func emitDelayChanged(s *native.NativeContract, delay uint64) error {
+	return s.AddNotify(ABI, []string{EventDelayChanged}, delay)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package timelock
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_ACTION    = "st_action"
+	SKP_ACTION_ID = "st_action_id"
+	SKP_DELAY     = "st_delay"
+)
+
+func getAction(s *native.NativeContract, id uint64) (*Action, error) {
+	value, err := s.GetCacheDB().Get(actionKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrActionNotExist
+	}
+
+	action := new(Action)
+	if err := rlp.DecodeBytes(value, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+func setAction(s *native.NativeContract, action *Action) error {
+	value, err := rlp.EncodeToBytes(action)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(actionKey(action.ID), value)
+	return nil
+}
+
+// nextActionID increase and returns the action id, the first action id is 1.
+func nextActionID(s *native.NativeContract) (uint64, error) {
+	key := utils.ConcatKey(this, []byte(SKP_ACTION_ID))
+	value, err := s.GetCacheDB().Get(key)
+	if err != nil {
+		return 0, err
+	}
+	id := uint64(1)
+	if len(value) > 0 {
+		id = utils.GetBytesUint64(value) + 1
+	}
+	s.GetCacheDB().Put(key, utils.GetUint64Bytes(id))
+	return id, nil
+}
+
+// getDelay returns the stored delay, or `DefaultDelay` if it never changed.
+func getDelay(s *native.NativeContract) (uint64, error) {
+	value, err := s.GetCacheDB().Get(utils.ConcatKey(this, []byte(SKP_DELAY)))
+	if err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return DefaultDelay, nil
+	}
+	return utils.GetBytesUint64(value), nil
+}
+
+func setDelay(s *native.NativeContract, delay uint64) {
+	s.GetCacheDB().Put(utils.ConcatKey(this, []byte(SKP_DELAY)), utils.GetUint64Bytes(delay))
+}
+
+func actionKey(id uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ACTION), utils.GetUint64Bytes(id))
+}
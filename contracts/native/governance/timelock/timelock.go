@@ -0,0 +1,300 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package timelock
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/scheduler"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodDelay:        0,
+		MethodAction:       0,
+		MethodSchedule:     0,
+		MethodExecute:      30000,
+		MethodCancel:       30000,
+		MethodChangeDelay:  30000,
+	}
+)
+
+// delay of queued actions, measured in blocks.
+const (
+	MinDelay     uint64 = 60
+	DefaultDelay uint64 = 7200
+	MaxDelay     uint64 = 86400 * 10
+)
+
+func InitTimelock() {
+	InitABI()
+	native.RegisterABI(native.NativeTimelock, "Timelock", abijson)
+	native.Contracts[this] = RegisterTimelockContract
+}
+
+func RegisterTimelockContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodDelay, Delay)
+	s.RegisterQuery(MethodAction, GetAction)
+	s.Register(MethodSchedule, ScheduleAction)
+	s.Register(MethodExecute, Execute)
+	s.Register(MethodCancel, Cancel)
+	s.Register(MethodChangeDelay, ChangeDelay)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+func Delay(s *native.NativeContract) ([]byte, error) {
+	delay, err := getDelay(s)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	return (&MethodDelayOutput{Delay: delay}).Encode()
+}
+
+func GetAction(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodIDInput)
+	if err := input.Decode(MethodAction, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	action, err := getAction(s, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	output := &MethodActionOutput{
+		Target:  action.Target,
+		Payload: action.Payload,
+		Eta:     action.Eta,
+		Status:  uint8(action.Status),
+	}
+	return output.Encode()
+}
+
+// ScheduleAction queue the payload of a passed governance action, it can only be called by
+// native contracts, and the payload will be sent back to the caller after the delay.
+func ScheduleAction(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	if ctx.Caller == this || !native.IsNativeContract(ctx.Caller) {
+		return nil, ErrInvalidCaller
+	}
+
+	input := new(MethodScheduleInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("schedule", "decode input failed", err)
+		return nil, ErrInvalidInput
+	}
+	action, err := queue(s, ctx.Caller, input.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return (&MethodScheduleOutput{ID: action.ID}).Encode()
+}
+
+// Execute sends the payload of an unlocked action to its target, anyone can trigger it.
+func Execute(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodIDInput)
+	if err := input.Decode(MethodExecute, s.ContractRef().CurrentContext().Payload); err != nil {
+		log.Trace("execute", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	action, err := getAction(s, input.ID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if action.Status != StatusPending {
+		return utils.ByteFailed, ErrActionNotPending
+	}
+	if s.ContractRef().BlockHeight().Uint64() < action.Eta {
+		return utils.ByteFailed, ErrActionLocked
+	}
+
+	action.Status = StatusExecuted
+	if err := setAction(s, action); err != nil {
+		log.Trace("execute", "store action failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if action.Target == this {
+		err = applyChangeDelay(s, action.Payload)
+	} else {
+		_, err = s.CallNative(action.Target, action.Payload)
+	}
+	if err != nil {
+		log.Trace("execute", "action failed", err, "id", action.ID)
+		return utils.ByteFailed, err
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, MethodExecute, s.ContractRef().MsgSender(), action.Payload); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitExecuted(s, action.ID); err != nil {
+		log.Trace("execute", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodExecute)
+}
+
+// Cancel drops a pending action after validators consensus signs reached quorum.
+func Cancel(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodIDInput)
+	if err := input.Decode(MethodCancel, ctx.Payload); err != nil {
+		log.Trace("cancel", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	action, err := getAction(s, input.ID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if action.Status != StatusPending {
+		return utils.ByteFailed, ErrActionNotPending
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, MethodCancel, ctx.Payload, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodCancel)
+	}
+
+	action.Status = StatusCanceled
+	if err := setAction(s, action); err != nil {
+		log.Trace("cancel", "store action failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindCancel, MethodCancel, s.ContractRef().MsgSender(), action.Payload); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitCanceled(s, action.ID); err != nil {
+		log.Trace("cancel", "emit event failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodCancel)
+}
+
+// ChangeDelay validators vote for a new delay, the change itself is queued in the timelock
+// once quorum reached, so that it can not shorten the delay of actions already in flight.
+func ChangeDelay(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodChangeDelayInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("changeDelay", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Delay < MinDelay || input.Delay > MaxDelay {
+		return utils.ByteFailed, ErrInvalidDelay
+	}
+
+	// bind the latest action id into signs, so that signs of a past change can't be reused.
+	latest, err := s.GetCacheDB().Get(utils.ConcatKey(this, []byte(SKP_ACTION_ID)))
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, MethodChangeDelay, append(latest, ctx.Payload...), s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if ok {
+		if _, err := queue(s, this, ctx.Payload); err != nil {
+			return utils.ByteFailed, err
+		}
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodChangeDelay)
+}
+
+// Schedule queue the payload in timelock on behalf of the current native contract, and
+// returns the action id.
+func Schedule(s *native.NativeContract, payload []byte) (uint64, error) {
+	input, err := (&MethodScheduleInput{Payload: payload}).Encode()
+	if err != nil {
+		return 0, err
+	}
+	ret, err := s.CallNative(this, input)
+	if err != nil {
+		return 0, err
+	}
+	output := new(MethodScheduleOutput)
+	if err := output.Decode(ret); err != nil {
+		return 0, err
+	}
+	return output.ID, nil
+}
+
+// ValidateCaller checks that the current method is invoked by timelock execution, methods
+// that apply queued actions should call it first.
+func ValidateCaller(s *native.NativeContract) error {
+	if s.ContractRef().CurrentContext().Caller != this {
+		return ErrNotTimelock
+	}
+	return nil
+}
+
+func queue(s *native.NativeContract, target common.Address, payload []byte) (*Action, error) {
+	delay, err := getDelay(s)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	id, err := nextActionID(s)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	action := &Action{
+		ID:      id,
+		Target:  target,
+		Payload: payload,
+		Eta:     s.ContractRef().BlockHeight().Uint64() + delay,
+		Status:  StatusPending,
+	}
+	if err := setAction(s, action); err != nil {
+		log.Trace("schedule", "store action failed", err)
+		return nil, ErrStorage
+	}
+	// execute the action automatically once unlocked, anyone can still execute it manually
+	// if the scheduled execution failed.
+	execute, err := (&MethodIDInput{ID: id}).Encode(MethodExecute)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	if _, err := scheduler.Schedule(s, action.Eta, execute); err != nil {
+		log.Trace("schedule", "schedule execution failed", err)
+		return nil, err
+	}
+	if err := emitScheduled(s, action); err != nil {
+		log.Trace("schedule", "emit event failed", err)
+		return nil, ErrEmitLog
+	}
+	return action, nil
+}
+
+func applyChangeDelay(s *native.NativeContract, payload []byte) error {
+	input := new(MethodChangeDelayInput)
+	if err := input.Decode(payload); err != nil {
+		return ErrInvalidInput
+	}
+	setDelay(s, input.Delay)
+	return emitDelayChanged(s, input.Delay)
+}
@@ -0,0 +1,277 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package timelock
+
+import (
+	"crypto/rand"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/scheduler"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/system"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(100000000000000000)
+)
+
+// the target contract queues `apply` into timelock with `queue`.
+const testTargetABIJSON = `[
+	{"type":"function","name":"queue","inputs":[],"outputs":[{"name":"ID","type":"uint64"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"apply","inputs":[],"outputs":[{"name":"Success","type":"bool"}],"stateMutability":"nonpayable"}
+]`
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+	testTargetABI    *abi.ABI
+	testTarget       = native.NativeContractAddrMap[native.NativeExtra19]
+	testAppliedKey   = []byte("applied")
+)
+
+func TestMain(m *testing.M) {
+	ab, err := abi.JSON(strings.NewReader(testTargetABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	testTargetABI = &ab
+	node_manager.InitNodeManager()
+	scheduler.InitScheduler()
+	system.InitSystem()
+	InitTimelock()
+	native.Contracts[testTarget] = registerTestTarget
+	os.Exit(m.Run())
+}
+
+func registerTestTarget(s *native.NativeContract) {
+	s.Prepare(testTargetABI, map[string]uint64{"queue": 0, "apply": 0})
+	s.Register("queue", func(s *native.NativeContract) ([]byte, error) {
+		payload, err := utils.PackMethod(testTargetABI, "apply")
+		if err != nil {
+			return nil, err
+		}
+		id, err := Schedule(s, payload)
+		if err != nil {
+			return nil, err
+		}
+		return utils.PackOutputs(testTargetABI, "queue", id)
+	})
+	s.Register("apply", func(s *native.NativeContract) ([]byte, error) {
+		if err := ValidateCaller(s); err != nil {
+			return nil, err
+		}
+		s.GetCacheDB().Put(utils.ConcatKey(testTarget, testAppliedKey), []byte{1})
+		return utils.PackOutputs(testTargetABI, "apply", true)
+	})
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+}
+
+func finalize(height uint64) {
+	input, _ := new(system.MethodFinalizeInput).Encode()
+	ref := native.NewContractRef(testStateDB, common.EmptyAddress, common.EmptyAddress, new(big.Int).SetUint64(height), common.EmptyHash, testSupplyGas, nil)
+	ref.SetSystemTx()
+	ref.NativeCall(common.EmptyAddress, utils.SystemContractAddress, input)
+}
+
+func invoke(origin, contract common.Address, payload []byte, blockNum uint64) ([]byte, error) {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	ref := native.NewContractRef(testStateDB, origin, origin, new(big.Int).SetUint64(blockNum), common.BytesToHash(token), testSupplyGas, nil)
+	ret, _, err := ref.NativeCall(origin, contract, payload)
+	return ret, err
+}
+
+func queueTestAction(t *testing.T, blockNum uint64) uint64 {
+	payload, err := utils.PackMethod(testTargetABI, "queue")
+	assert.NoError(t, err)
+	ret, err := invoke(common.HexToAddress("0x1"), testTarget, payload, blockNum)
+	assert.NoError(t, err)
+	var id uint64
+	assert.NoError(t, testTargetABI.UnpackIntoInterface(&id, "queue", ret))
+	return id
+}
+
+func getTestAction(t *testing.T, id uint64) *MethodActionOutput {
+	payload, err := (&MethodIDInput{ID: id}).Encode(MethodAction)
+	assert.NoError(t, err)
+	ret, err := invoke(common.EmptyAddress, this, payload, 1)
+	assert.NoError(t, err)
+	output := new(MethodActionOutput)
+	assert.NoError(t, output.Decode(ret))
+	return output
+}
+
+func execute(id, blockNum uint64) error {
+	payload, _ := (&MethodIDInput{ID: id}).Encode(MethodExecute)
+	_, err := invoke(common.HexToAddress("0x2"), this, payload, blockNum)
+	return err
+}
+
+func applied() bool {
+	value, _ := (*state.CacheDB)(testStateDB).Get(utils.ConcatKey(testTarget, testAppliedKey))
+	return len(value) > 0
+}
+
+func TestScheduleAndExecute(t *testing.T) {
+	resetTestContext()
+
+	id := queueTestAction(t, 10)
+	assert.Equal(t, uint64(1), id)
+	action := getTestAction(t, id)
+	assert.Equal(t, testTarget, action.Target)
+	assert.Equal(t, 10+DefaultDelay, action.Eta)
+	assert.Equal(t, uint8(StatusPending), action.Status)
+
+	assert.Equal(t, ErrActionLocked, execute(id, action.Eta-1))
+	assert.False(t, applied())
+
+	assert.NoError(t, execute(id, action.Eta))
+	assert.True(t, applied())
+	assert.Equal(t, uint8(StatusExecuted), getTestAction(t, id).Status)
+	assert.Equal(t, ErrActionNotPending, execute(id, action.Eta+1))
+	assert.Equal(t, ErrActionNotExist, execute(id+1, action.Eta+1))
+	assert.Equal(t, uint64(2), queueTestAction(t, 10))
+}
+
+func TestScheduleInvalidCaller(t *testing.T) {
+	resetTestContext()
+
+	// accounts can't schedule or apply actions directly
+	payload, err := (&MethodScheduleInput{Payload: []byte{1}}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), this, payload, 1)
+	assert.Equal(t, ErrInvalidCaller, err)
+
+	payload, err = utils.PackMethod(testTargetABI, "apply")
+	assert.NoError(t, err)
+	_, err = invoke(common.HexToAddress("0x1"), testTarget, payload, 1)
+	assert.Equal(t, ErrNotTimelock, err)
+	assert.False(t, applied())
+}
+
+func TestCancel(t *testing.T) {
+	resetTestContext()
+
+	id := queueTestAction(t, 1)
+	payload, err := (&MethodIDInput{ID: id}).Encode(MethodCancel)
+	assert.NoError(t, err)
+
+	_, err = invoke(common.HexToAddress("0x1"), this, payload, 2)
+	assert.Equal(t, node_manager.ErrInvalidAuthority, err)
+
+	quorum := testGenesisEpoch.QuorumSize()
+	for i := 0; i < quorum; i++ {
+		assert.Equal(t, uint8(StatusPending), getTestAction(t, id).Status)
+		_, err = invoke(testGenesisEpoch.Peers.List[i].Address, this, payload, 2)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, uint8(StatusCanceled), getTestAction(t, id).Status)
+
+	_, err = invoke(testGenesisEpoch.Peers.List[quorum].Address, this, payload, 2)
+	assert.Equal(t, ErrActionNotPending, err)
+	assert.Equal(t, ErrActionNotPending, execute(id, 1+DefaultDelay))
+	assert.False(t, applied())
+}
+
+func TestChangeDelay(t *testing.T) {
+	resetTestContext()
+
+	changeDelay := func(delay uint64, blockNum uint64) error {
+		payload, err := (&MethodChangeDelayInput{Delay: delay}).Encode()
+		assert.NoError(t, err)
+		for i := 0; i < testGenesisEpoch.QuorumSize(); i++ {
+			if _, err := invoke(testGenesisEpoch.Peers.List[i].Address, this, payload, blockNum); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	getDelay := func() uint64 {
+		payload, err := utils.PackMethod(ABI, MethodDelay)
+		assert.NoError(t, err)
+		ret, err := invoke(common.EmptyAddress, this, payload, 1)
+		assert.NoError(t, err)
+		output := new(MethodDelayOutput)
+		assert.NoError(t, output.Decode(ret))
+		return output.Delay
+	}
+
+	assert.Equal(t, ErrInvalidDelay, changeDelay(MinDelay-1, 1))
+	assert.Equal(t, ErrInvalidDelay, changeDelay(MaxDelay+1, 1))
+
+	// the change is queued with the current delay
+	assert.NoError(t, changeDelay(MinDelay, 1))
+	action := getTestAction(t, 1)
+	assert.Equal(t, this, action.Target)
+	assert.Equal(t, 1+DefaultDelay, action.Eta)
+	assert.Equal(t, DefaultDelay, getDelay())
+
+	assert.NoError(t, execute(1, action.Eta))
+	assert.Equal(t, MinDelay, getDelay())
+	id := queueTestAction(t, 100)
+	assert.Equal(t, 100+MinDelay, getTestAction(t, id).Eta)
+
+	// signs of the first change can not be reused
+	assert.NoError(t, changeDelay(DefaultDelay, 200))
+	assert.Equal(t, uint8(StatusPending), getTestAction(t, id+1).Status)
+	payload, err := (&MethodChangeDelayInput{Delay: MinDelay}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(testGenesisEpoch.Peers.List[0].Address, this, payload, 300)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrActionNotExist, execute(id+2, 300+MinDelay))
+}
+
+func TestScheduledExecution(t *testing.T) {
+	resetTestContext()
+
+	id := queueTestAction(t, 10)
+	action := getTestAction(t, id)
+
+	finalize(action.Eta - 1)
+	assert.False(t, applied())
+	finalize(action.Eta)
+	assert.True(t, applied())
+	assert.Equal(t, uint8(StatusExecuted), getTestAction(t, id).Status)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package timelock
+
+import (
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+type Status uint8
+
+const (
+	StatusPending Status = iota + 1
+	StatusExecuted
+	StatusCanceled
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPending:
+		return "pending"
+	case StatusExecuted:
+		return "executed"
+	case StatusCanceled:
+		return "canceled"
+	default:
+		return "unknown"
+	}
+}
+
+// Action is a passed governance action waiting in the queue, `Payload` is sent to
+// `Target` by the timelock contract once the block height reaches `Eta`.
+type Action struct {
+	ID      uint64
+	Target  common.Address
+	Payload []byte
+	Eta     uint64
+	Status  Status
+}
+
+func (m *Action) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.ID, m.Target, m.Payload, m.Eta, uint8(m.Status)})
+}
+
+func (m *Action) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		ID      uint64
+		Target  common.Address
+		Payload []byte
+		Eta     uint64
+		Status  uint8
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.ID, m.Target, m.Payload, m.Eta, m.Status = data.ID, data.Target, data.Payload, data.Eta, Status(data.Status)
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"fmt"
+
+	abiPkg "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MethodGuard returns true if the method of the native contract is disabled in the state, e.g: for
+// a planned migration. it's checked before dispatching every non-query method, so it must be read
+// only and deterministic.
+type MethodGuard func(db *state.StateDB, contract common.Address, methodID []byte) bool
+
+var methodGuard MethodGuard
+
+// RegisterMethodGuard record the guard of native contract methods, it should be called in the init
+// function of the contract which manages the method switches.
+func RegisterMethodGuard(guard MethodGuard) {
+	methodGuard = guard
+}
+
+// CallerGuard returns an error if the caller is not allowed to invoke the non-query method of the
+// native contract, e.g: for the permissioned deployments. the method and the caller are read from
+// the current context, and the guard must be read only and deterministic as well.
+type CallerGuard func(s *NativeContract) error
+
+var callerGuards = make(map[common.Address]CallerGuard)
+
+// RegisterCallerGuard record the caller guard of the native contract, it should be called in the
+// init function of the contract which manages the permissions.
+func RegisterCallerGuard(contract common.Address, guard CallerGuard) {
+	callerGuards[contract] = guard
+}
+
+// MethodDisabledError is returned when invoking a disabled method, it's reverted with the reason so
+// that callers are able to tell the maintenance from failures of the method itself.
+type MethodDisabledError struct {
+	Contract common.Address
+	Method   string
+}
+
+func (e *MethodDisabledError) Error() string {
+	return fmt.Sprintf("method disabled: %s of %s", e.Method, e.Contract.Hex())
+}
+
+// revertSelector is the selector of solidity `Error(string)`.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// RevertData implements the RevertError interface, the error is encoded as solidity `Error(string)`.
+func (e *MethodDisabledError) RevertData() []byte {
+	return revertReason(e.Error())
+}
+
+// revertReason encodes the reason as solidity `Error(string)`.
+func revertReason(reason string) []byte {
+	typ, _ := abiPkg.NewType("string", "", nil)
+	enc, err := (abiPkg.Arguments{{Type: typ}}).Pack(reason)
+	if err != nil {
+		return nil
+	}
+	return append(append([]byte{}, revertSelector...), enc...)
+}
+
+// checkMethodGuard returns MethodDisabledError if the method is disabled by the guard.
+func (s *NativeContract) checkMethodGuard(contract common.Address, methodID []byte) error {
+	if methodGuard == nil || !methodGuard(s.db, contract, methodID) {
+		return nil
+	}
+	name := hexutil.Encode(methodID)
+	if s.ab != nil {
+		if method, err := s.ab.MethodById(methodID); err == nil {
+			name = method.Name
+		}
+	}
+	return &MethodDisabledError{Contract: contract, Method: name}
+}
+
+// checkCallerGuard returns the error of the caller guard registered for the contract.
+func (s *NativeContract) checkCallerGuard(contract common.Address) error {
+	guard, ok := callerGuards[contract]
+	if !ok {
+		return nil
+	}
+	return guard(s)
+}
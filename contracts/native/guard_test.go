@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodGuard(t *testing.T) {
+	defer RegisterMethodGuard(nil)
+
+	writeID := testABI.Methods["write"].ID
+	RegisterMethodGuard(func(db *state.StateDB, contract common.Address, methodID []byte) bool {
+		return contract == testCalleeB && bytes.Equal(methodID, writeID)
+	})
+
+	db, ref := newTestRef(t, testWriteGas)
+	_, _, err := ref.NativeCall(ref.caller, testCallerA, packCall(t, testCalleeB, "write"))
+	var disabled *MethodDisabledError
+	assert.True(t, errors.As(err, &disabled))
+	assert.Equal(t, &MethodDisabledError{Contract: testCalleeB, Method: "write"}, disabled)
+	assert.False(t, written(db, testCalleeB))
+
+	reason, err := abi.UnpackRevert(disabled.RevertData())
+	assert.NoError(t, err)
+	assert.Equal(t, disabled.Error(), reason)
+
+	// the other contracts and queries are not affected
+	db, ref = newTestRef(t, testWriteGas)
+	input, err := utils.PackMethod(testABI, "write")
+	assert.NoError(t, err)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, input)
+	assert.NoError(t, err)
+	assert.True(t, written(db, testCallerA))
+
+	RegisterMethodGuard(func(db *state.StateDB, contract common.Address, methodID []byte) bool { return true })
+	input, err = utils.PackMethod(testABI, "recurse")
+	assert.NoError(t, err)
+	_, ref = newTestRef(t, 0)
+	_, _, err = ref.NativeCall(ref.caller, testCallerA, input)
+	assert.Equal(t, ErrNativeCallDepth, err)
+}
+
+func TestCallerGuard(t *testing.T) {
+	defer delete(callerGuards, testCalleeB)
+
+	errNotAllowed := errors.New("caller not allowed")
+	RegisterCallerGuard(testCalleeB, func(s *NativeContract) error {
+		if s.ContractRef().CurrentContext().Caller != testCallerA {
+			return errNotAllowed
+		}
+		return nil
+	})
+
+	// the guarded contract is called by the allowed contract only
+	db, ref := newTestRef(t, testWriteGas)
+	_, _, err := ref.NativeCall(ref.caller, testCallerA, packCall(t, testCalleeB, "write"))
+	assert.NoError(t, err)
+	assert.True(t, written(db, testCalleeB))
+
+	db, ref = newTestRef(t, testWriteGas)
+	input, err := utils.PackMethod(testABI, "write")
+	assert.NoError(t, err)
+	_, _, err = ref.NativeCall(ref.caller, testCalleeB, input)
+	assert.Equal(t, errNotAllowed, err)
+	assert.False(t, written(db, testCalleeB))
+}
@@ -158,14 +158,12 @@ func (h *Handler) SyncGenesisHeader(native *native.NativeContract) (err error) {
 }
 
 // SyncBlockHeader ...
-func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
-	headerParams := &scom.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, headerParams, ctx.Payload); err != nil {
-			return err
-		}
+func (h *Handler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	headerParams, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer headerParams.Annotate(&err)
 
 	side, err := side_chain_manager.GetSideChain(native, headerParams.ChainID)
 	if err != nil {
@@ -179,7 +177,8 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 
 	ctx := &Context{ExtraInfo: extraInfo, ChainID: headerParams.ChainID}
 
-	for _, v := range headerParams.Headers {
+	for headerParams.Next() {
+		v := headerParams.Header()
 		var header types.Header
 		err := json.Unmarshal(v, &header)
 		if err != nil {
@@ -265,6 +264,9 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 
 		scom.NotifyPutHeader(native, headerParams.ChainID, header.Number.Uint64(), header.Hash().Hex())
 	}
+	if err := headerParams.Err(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -289,8 +291,9 @@ func verifySignature(native *native.NativeContract, header *types.Header, ctx *C
 
 func verifyHeader(native *native.NativeContract, header *types.Header, ctx *Context) (signer common.Address, err error) {
 
-	// Don't waste time checking blocks from the future
-	if header.Time > uint64(time.Now().Unix()) {
+	// Don't waste time checking blocks from the future, the validators execute the block after
+	// the proposer, so the header accepted by the proposer is rejected only by a lagging clock.
+	if header.Time > uint64(time.Now().Unix()) { // nativecheck:ignore future headers are bounded by wall clock
 		err = errors.New("block in the future")
 		return
 	}
@@ -477,7 +480,7 @@ func getGenesis(native *native.NativeContract, chainID uint64) (genesisHeader *G
 		return
 	}
 
-	genesisBytes, err = cstates.GetValueFromRawStorageItem(genesisBytes)
+	genesisBytes, err = scom.GetHeaderFromStorageItem(genesisBytes)
 	if err != nil {
 		err = fmt.Errorf("getGenesis, GetValueFromRawStorageItem err:%v", err)
 		return
@@ -504,7 +507,7 @@ func storeGenesis(native *native.NativeContract, params *scom.SyncGenesisHeaderP
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.GENESIS_HEADER), utils.GetUint64Bytes(params.ChainID)),
-		cstates.GenRawStorageItem(genesisBytes))
+		scom.GenHeaderStorageItem(native, genesisBytes))
 
 	headerWithSum := &HeaderWithDifficultySum{Header: &genesisHeader.Header, DifficultySum: genesisHeader.Header.Difficulty}
 
@@ -544,7 +547,7 @@ func putHeaderWithSum(native *native.NativeContract, chainID uint64, headerWithS
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), headerWithSum.Header.Hash().Bytes()),
-		cstates.GenRawStorageItem(headerBytes))
+		scom.GenHeaderStorageItem(native, headerBytes))
 	return
 }
 
@@ -777,7 +780,7 @@ func getHeader(native *native.NativeContract, hash common.Hash, chainID uint64)
 	if headerStore == nil {
 		return nil, fmt.Errorf("bsc Handler getHeader, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, fmt.Errorf("bsc Handler getHeader, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -26,17 +26,21 @@ import (
 )
 
 var (
-	MethodContractName      = header_sync_abi.MethodName
-	MethodSyncGenesisHeader = header_sync_abi.MethodSyncGenesisHeader
-	MethodSyncBlockHeader   = header_sync_abi.MethodSyncBlockHeader
-	MethodSyncCrossChainMsg = header_sync_abi.MethodSyncCrossChainMsg
+	MethodContractName       = header_sync_abi.MethodName
+	MethodSyncGenesisHeader  = header_sync_abi.MethodSyncGenesisHeader
+	MethodSyncBlockHeader    = header_sync_abi.MethodSyncBlockHeader
+	MethodSyncCrossChainMsg  = header_sync_abi.MethodSyncCrossChainMsg
+	MethodRebootstrapHeader  = header_sync_abi.MethodRebootstrapHeader
+	MethodLightClientArchive = header_sync_abi.MethodLightClientArchive
 )
 
 var GasTable = map[string]uint64{
-	MethodContractName:      0,
-	MethodSyncGenesisHeader: 0,
-	MethodSyncBlockHeader:   100000,
-	MethodSyncCrossChainMsg: 0,
+	MethodContractName:       0,
+	MethodSyncGenesisHeader:  0,
+	MethodSyncBlockHeader:    100000,
+	MethodSyncCrossChainMsg:  0,
+	MethodRebootstrapHeader:  0,
+	MethodLightClientArchive: 0,
 }
 
 func GetABI() *abi.ABI {
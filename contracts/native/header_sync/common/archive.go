@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// LightClientArchive records a re-bootstrap of the light client of a side chain, the state
+// replaced is kept for auditing rather than being overwritten silently.
+type LightClientArchive struct {
+	Height   uint64 // zion block height of the re-bootstrap
+	Header   []byte // side chain header the light client is re-bootstrapped from
+	Previous []byte // serialized light client state being replaced, in the format of the handler
+}
+
+func (this *LightClientArchive) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.Height)
+	sink.WriteVarBytes(this.Header)
+	sink.WriteVarBytes(this.Previous)
+}
+
+func (this *LightClientArchive) Deserialization(source *polycomm.ZeroCopySource) error {
+	height, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("LightClientArchive deserialize height error")
+	}
+	header, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("LightClientArchive deserialize header error")
+	}
+	previous, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("LightClientArchive deserialize previous error")
+	}
+	this.Height = height
+	this.Header = header
+	this.Previous = previous
+	return nil
+}
+
+// GetRebootstrapGeneration returns the times the light client of the chain has been
+// re-bootstrapped, which is also the generation of the next archive.
+func GetRebootstrapGeneration(native *native.NativeContract, chainID uint64) (uint64, error) {
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(utils.HeaderSyncContractAddress,
+		[]byte(REBOOTSTRAP_GENERATION), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return 0, fmt.Errorf("GetRebootstrapGeneration, get generation store error: %v", err)
+	}
+	if store == nil {
+		return 0, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return 0, fmt.Errorf("GetRebootstrapGeneration, deserialize from raw storage item err:%v", err)
+	}
+	return utils.GetBytesUint64(raw), nil
+}
+
+// PutLightClientArchive appends the archive to the chain and returns its generation.
+func PutLightClientArchive(native *native.NativeContract, chainID uint64, archive *LightClientArchive) (uint64, error) {
+	contract := utils.HeaderSyncContractAddress
+	generation, err := GetRebootstrapGeneration(native, chainID)
+	if err != nil {
+		return 0, err
+	}
+	sink := polycomm.NewZeroCopySink(nil)
+	archive.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(REBOOTSTRAP_ARCHIVE), utils.GetUint64Bytes(chainID),
+		utils.GetUint64Bytes(generation)), cstates.GenRawStorageItem(sink.Bytes()))
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(REBOOTSTRAP_GENERATION), utils.GetUint64Bytes(chainID)),
+		cstates.GenRawStorageItem(utils.GetUint64Bytes(generation+1)))
+	return generation, nil
+}
+
+// GetLightClientArchive returns nil if the generation of the chain is not archived.
+func GetLightClientArchive(native *native.NativeContract, chainID, generation uint64) (*LightClientArchive, error) {
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(REBOOTSTRAP_ARCHIVE),
+		utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(generation)))
+	if err != nil {
+		return nil, fmt.Errorf("GetLightClientArchive, get archive store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	raw, err := cstates.GetValueFromRawStorageItem(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetLightClientArchive, deserialize from raw storage item err:%v", err)
+	}
+	archive := new(LightClientArchive)
+	if err := archive.Deserialization(polycomm.NewZeroCopySource(raw)); err != nil {
+		return nil, fmt.Errorf("GetLightClientArchive, deserialize archive error: %v", err)
+	}
+	return archive, nil
+}
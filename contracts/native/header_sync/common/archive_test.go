@@ -0,0 +1,65 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLightClientArchive(t *testing.T) {
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.PushContext(&native.Context{ContractAddress: utils.HeaderSyncContractAddress})
+	service := native.NewNativeContract(db, ref)
+
+	generation, err := GetRebootstrapGeneration(service, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), generation)
+	archive, err := GetLightClientArchive(service, 1, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, archive)
+
+	// the archives are appended per chain, the previous generations are kept
+	first := &LightClientArchive{Height: 10, Header: []byte{1}, Previous: []byte{2}}
+	second := &LightClientArchive{Height: 20, Header: []byte{3}, Previous: []byte{4}}
+	for i, v := range []*LightClientArchive{first, second} {
+		generation, err := PutLightClientArchive(service, 1, v)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(i), generation)
+	}
+	generation, err = GetRebootstrapGeneration(service, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), generation)
+	for i, want := range []*LightClientArchive{first, second} {
+		archive, err := GetLightClientArchive(service, 1, uint64(i))
+		assert.NoError(t, err)
+		assert.Equal(t, want, archive)
+	}
+
+	generation, err = GetRebootstrapGeneration(service, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), generation)
+}
@@ -42,6 +42,10 @@ const (
 	SYNC_HEADER_NAME_EVENT      = "syncHeader"
 	SYNC_CROSSCHAIN_MSG         = "syncCrossChainMsg"
 	POLYGON_SPAN                = "polygonSpan"
+	SYNC_STATUS                 = "syncStatus"
+	REBOOTSTRAP_ARCHIVE         = "rebootstrapArchive"
+	REBOOTSTRAP_GENERATION      = "rebootstrapGeneration"
+	REBOOTSTRAP_EVENT           = "RebootstrapHeaderEvent"
 )
 
 type HeaderSyncHandler interface {
@@ -50,6 +54,23 @@ type HeaderSyncHandler interface {
 	SyncCrossChainMsg(service *native.NativeContract) error
 }
 
+// HeaderRebootstrapper is implemented by the handlers which support to re-bootstrap the light
+// client, the trusted state of the chain is replaced by the header approved by the validators,
+// and the serialized state being replaced is returned to be archived.
+type HeaderRebootstrapper interface {
+	RebootstrapHeader(service *native.NativeContract, chainID uint64, header []byte) ([]byte, error)
+}
+
+type RebootstrapHeaderParam struct {
+	ChainID uint64
+	Header  []byte
+}
+
+type LightClientArchiveParam struct {
+	ChainID    uint64
+	Generation uint64
+}
+
 type SyncGenesisHeaderParam struct {
 	ChainID       uint64
 	GenesisHeader []byte
@@ -166,4 +187,5 @@ func NotifyPutHeader(native *native.NativeContract, chainID uint64, height uint6
 	if err != nil {
 		panic(fmt.Sprintf("NotifyPutHeader failed: %v", err))
 	}
+	updateSyncStatus(native, chainID, height)
 }
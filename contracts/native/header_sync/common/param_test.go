@@ -18,8 +18,11 @@
 package common
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	polycomm "github.com/polynetwork/poly/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -56,3 +59,100 @@ func TestSyncBlockHeaderParam(t *testing.T) {
 
 	assert.Equal(t, p, param)
 }
+
+func TestSyncStatus(t *testing.T) {
+	status := SyncStatus{
+		Height:      123,
+		Submitter:   common.HexToAddress("0x1234"),
+		BlockHeight: 456,
+	}
+
+	sink := polycomm.NewZeroCopySink(nil)
+	status.Serialization(sink)
+
+	var s SyncStatus
+	err := s.Deserialization(polycomm.NewZeroCopySource(sink.Bytes()))
+	assert.NoError(t, err)
+
+	assert.Equal(t, status, s)
+}
+
+func TestHeaderStream(t *testing.T) {
+	ab := GetABI()
+	headers := [][]byte{{1, 2, 3}, {}, make([]byte, 100)}
+	address := common.HexToAddress("0x1234")
+	payload, err := utils.PackMethod(ab, MethodSyncBlockHeader, uint64(123), address, headers)
+	assert.NoError(t, err)
+
+	stream, err := NewHeaderStream(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(123), stream.ChainID)
+	assert.Equal(t, address, stream.Address)
+	assert.Equal(t, len(headers), stream.Len())
+	for i := 0; stream.Next(); i++ {
+		assert.Equal(t, i, stream.Index())
+		assert.Equal(t, headers[i], stream.Header())
+	}
+	assert.NoError(t, stream.Err())
+	assert.Equal(t, -1, stream.Index())
+
+	// the batch is accepted up to the malformed header
+	stream, err = NewHeaderStream(payload[:len(payload)-abiWordSize])
+	assert.NoError(t, err)
+	assert.True(t, stream.Next())
+	assert.True(t, stream.Next())
+	assert.False(t, stream.Next())
+	var herr *HeaderError
+	assert.True(t, errors.As(stream.Err(), &herr))
+	assert.Equal(t, 2, herr.Index)
+
+	_, err = NewHeaderStream(payload[:4+2*abiWordSize])
+	assert.Error(t, err)
+}
+
+func TestHeaderStreamAnnotate(t *testing.T) {
+	payload, err := utils.PackMethod(GetABI(), MethodSyncBlockHeader, uint64(1), common.Address{}, [][]byte{{1}, {2}})
+	assert.NoError(t, err)
+	handler := func(invalid int) (err error) {
+		stream, err := NewHeaderStream(payload)
+		if err != nil {
+			return err
+		}
+		defer stream.Annotate(&err)
+		for stream.Next() {
+			if stream.Index() == invalid {
+				return errors.New("invalid header")
+			}
+		}
+		if invalid < 0 {
+			return errors.New("after batch")
+		}
+		return nil
+	}
+	assert.NoError(t, handler(2))
+	assert.EqualError(t, handler(1), "header 1: invalid header")
+	assert.EqualError(t, handler(-1), "after batch")
+}
+
+func BenchmarkHeaderStream(b *testing.B) {
+	headers := make([][]byte, 200)
+	for i := range headers {
+		headers[i] = make([]byte, 600)
+	}
+	payload, err := utils.PackMethod(GetABI(), MethodSyncBlockHeader, uint64(1), common.Address{}, headers)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stream, err := NewHeaderStream(payload)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for stream.Next() {
+		}
+		if err := stream.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	polycomm "github.com/polynetwork/poly/common"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// SyncStatus records the latest header sync of a side chain.
+type SyncStatus struct {
+	Height      uint64         // highest side chain header height which has been synced
+	Submitter   common.Address // relayer of the latest sync
+	BlockHeight uint64         // zion block height of the latest sync
+}
+
+func (this *SyncStatus) Serialization(sink *polycomm.ZeroCopySink) {
+	sink.WriteUint64(this.Height)
+	sink.WriteVarBytes(this.Submitter[:])
+	sink.WriteUint64(this.BlockHeight)
+}
+
+func (this *SyncStatus) Deserialization(source *polycomm.ZeroCopySource) error {
+	height, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("SyncStatus deserialize height error")
+	}
+	submitter, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("SyncStatus deserialize submitter error")
+	}
+	blockHeight, eof := source.NextUint64()
+	if eof {
+		return fmt.Errorf("SyncStatus deserialize block height error")
+	}
+	this.Height = height
+	this.Submitter = common.BytesToAddress(submitter)
+	this.BlockHeight = blockHeight
+	return nil
+}
+
+func PutSyncStatus(native *native.NativeContract, chainID uint64, status *SyncStatus) {
+	contract := utils.HeaderSyncContractAddress
+	sink := polycomm.NewZeroCopySink(nil)
+	status.Serialization(sink)
+	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(SYNC_STATUS), utils.GetUint64Bytes(chainID)),
+		cstates.GenRawStorageItem(sink.Bytes()))
+}
+
+// GetSyncStatus returns nil if no header of the chain has been synced.
+func GetSyncStatus(native *native.NativeContract, chainID uint64) (*SyncStatus, error) {
+	contract := utils.HeaderSyncContractAddress
+	statusStore, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(SYNC_STATUS), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("GetSyncStatus, get statusStore error: %v", err)
+	}
+	if statusStore == nil {
+		return nil, nil
+	}
+	statusBytes, err := cstates.GetValueFromRawStorageItem(statusStore)
+	if err != nil {
+		return nil, fmt.Errorf("GetSyncStatus, deserialize from raw storage item err:%v", err)
+	}
+	status := new(SyncStatus)
+	if err := status.Deserialization(polycomm.NewZeroCopySource(statusBytes)); err != nil {
+		return nil, fmt.Errorf("GetSyncStatus, deserialize status error: %v", err)
+	}
+	return status, nil
+}
+
+// GetCanonicalHeight returns the height of the canonical header, chains which are verified
+// by epoch rather than by header chain(e.g. cosmos, quorum) have no canonical height and zero is returned.
+func GetCanonicalHeight(native *native.NativeContract, chainID uint64) (uint64, error) {
+	heightStore, err := native.GetCacheDB().Get(utils.ConcatKey(utils.HeaderSyncContractAddress,
+		[]byte(CURRENT_HEADER_HEIGHT), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return 0, fmt.Errorf("GetCanonicalHeight, get heightStore error: %v", err)
+	}
+	if heightStore == nil {
+		return 0, nil
+	}
+	heightBytes, err := cstates.GetValueFromRawStorageItem(heightStore)
+	if err != nil {
+		return 0, fmt.Errorf("GetCanonicalHeight, deserialize from raw storage item err:%v", err)
+	}
+	return utils.GetBytesUint64(heightBytes), nil
+}
+
+// updateSyncStatus records the header put by the sync handlers.
+func updateSyncStatus(native *native.NativeContract, chainID uint64, height uint64) {
+	status, err := GetSyncStatus(native, chainID)
+	if err != nil {
+		panic(fmt.Sprintf("updateSyncStatus failed: %v", err))
+	}
+	if status == nil {
+		status = new(SyncStatus)
+	}
+	if height > status.Height {
+		status.Height = height
+	}
+	status.Submitter = native.ContractRef().MsgSender()
+	status.BlockHeight = native.ContractRef().BlockHeight().Uint64()
+	PutSyncStatus(native, chainID, status)
+}
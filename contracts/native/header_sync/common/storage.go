@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/golang/snappy"
+	cstates "github.com/polynetwork/poly/core/states"
+)
+
+// compressedStateVersion is the state version of the raw storage items whose value is compressed
+// with snappy, the items written before the storage v2 fork are of version 0.
+const compressedStateVersion byte = 1
+
+// GenHeaderStorageItem encodes the synced header, or the genesis of the light client, into a raw
+// storage item. the value is compressed since the storage v2 fork, which cuts the state growth of
+// the headers in json by more than half.
+func GenHeaderStorageItem(native *native.NativeContract, value []byte) []byte {
+	if !native.ContractRef().IsStorageV2() {
+		return cstates.GenRawStorageItem(value)
+	}
+	item := &cstates.StorageItem{
+		StateBase: cstates.StateBase{StateVersion: compressedStateVersion},
+		Value:     snappy.Encode(nil, value),
+	}
+	return item.ToArray()
+}
+
+// GetHeaderFromStorageItem decodes the value of the raw storage item written by
+// GenHeaderStorageItem, either compressed or not.
+func GetHeaderFromStorageItem(raw []byte) ([]byte, error) {
+	item := new(cstates.StorageItem)
+	if err := item.Deserialize(bytes.NewBuffer(raw)); err != nil {
+		return nil, err
+	}
+	switch item.StateVersion {
+	case 0:
+		return item.Value, nil
+	case compressedStateVersion:
+		return snappy.Decode(nil, item.Value)
+	default:
+		return nil, fmt.Errorf("unknown state version %d of header storage item", item.StateVersion)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	cstates "github.com/polynetwork/poly/core/states"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderStorageItem(t *testing.T) {
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	config := *params.TestChainConfig
+	config.StorageV2Block = big.NewInt(10)
+	header := bytes.Repeat([]byte(`{"parentHash":"0x00","number":"0x1"}`), 16)
+
+	ctx := func(height int64) *native.NativeContract {
+		ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, big.NewInt(height), common.EmptyHash, 0, nil)
+		ref.SetChainConfig(&config)
+		return native.NewNativeContract(db, ref)
+	}
+
+	// raw before the fork, compatible with the items written by `GenRawStorageItem`
+	raw := GenHeaderStorageItem(ctx(9), header)
+	assert.Equal(t, cstates.GenRawStorageItem(header), raw)
+	got, err := GetHeaderFromStorageItem(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, header, got)
+
+	// compressed since the fork
+	compressed := GenHeaderStorageItem(ctx(10), header)
+	assert.Less(t, len(compressed), len(raw))
+	got, err = GetHeaderFromStorageItem(compressed)
+	assert.NoError(t, err)
+	assert.Equal(t, header, got)
+
+	// unknown version and corrupted value
+	compressed[0] = compressedStateVersion + 1
+	_, err = GetHeaderFromStorageItem(compressed)
+	assert.Error(t, err)
+	compressed[0] = compressedStateVersion
+	compressed[len(compressed)-1] ^= 0xff
+	_, err = GetHeaderFromStorageItem(compressed)
+	assert.Error(t, err)
+}
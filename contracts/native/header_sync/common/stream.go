@@ -0,0 +1,187 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const abiWordSize = 32
+
+var errHeaderStream = errors.New("malformed syncBlockHeader input")
+
+// HeaderError is the failure of the header at Index in the batch of `syncBlockHeader`, the
+// headers before it have been accepted.
+type HeaderError struct {
+	Index int
+	Err   error
+}
+
+func (e *HeaderError) Error() string {
+	return fmt.Sprintf("header %d: %v", e.Index, e.Err)
+}
+
+func (e *HeaderError) Unwrap() error {
+	return e.Err
+}
+
+// HeaderStream decodes the headers of `syncBlockHeader` one by one from the abi encoded call data.
+// the headers are sliced from the call data rather than unpacked up front, so that memory stays
+// bounded by the header in process however large the batch is.
+//
+//	for headers.Next() {
+//		header := headers.Header()
+//		...
+//	}
+type HeaderStream struct {
+	ChainID uint64
+	Address common.Address
+
+	data  []byte // abi encoded arguments
+	base  int    // start of the header offsets
+	count int
+	index int
+	cur   []byte
+	err   error
+}
+
+// word reads the abi word at offset as an integer, the value must fit in an int.
+func word(data []byte, offset int) (int, error) {
+	if offset < 0 || offset > len(data)-abiWordSize {
+		return 0, errHeaderStream
+	}
+	w := data[offset : offset+abiWordSize]
+	for _, b := range w[:abiWordSize-8] {
+		if b != 0 {
+			return 0, errHeaderStream
+		}
+	}
+	var v uint64
+	for _, b := range w[abiWordSize-8:] {
+		v = v<<8 | uint64(b)
+	}
+	if v > math.MaxInt32 {
+		return 0, errHeaderStream
+	}
+	return int(v), nil
+}
+
+// NewHeaderStream parses the head of the `syncBlockHeader` call data, the headers are decoded later
+// by Next.
+func NewHeaderStream(payload []byte) (*HeaderStream, error) {
+	if len(payload) < 4 {
+		return nil, errHeaderStream
+	}
+	data := payload[4:]
+	if len(data) < 3*abiWordSize {
+		return nil, errHeaderStream
+	}
+	for _, b := range data[:abiWordSize-8] {
+		if b != 0 {
+			return nil, errHeaderStream
+		}
+	}
+	s := &HeaderStream{data: data, index: -1}
+	for _, b := range data[abiWordSize-8 : abiWordSize] {
+		s.ChainID = s.ChainID<<8 | uint64(b)
+	}
+	s.Address = common.BytesToAddress(data[abiWordSize : 2*abiWordSize])
+
+	offset, err := word(data, 2*abiWordSize)
+	if err != nil {
+		return nil, err
+	}
+	if s.count, err = word(data, offset); err != nil {
+		return nil, err
+	}
+	s.base = offset + abiWordSize
+	if s.count > (len(data)-s.base)/abiWordSize {
+		return nil, errHeaderStream
+	}
+	return s, nil
+}
+
+// Len returns the number of headers in the batch.
+func (s *HeaderStream) Len() int {
+	return s.count
+}
+
+// Next decodes the next header, it returns false at the end of the batch, or if the header is
+// malformed, in which case the error is reported by Err.
+func (s *HeaderStream) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	if s.index+1 >= s.count {
+		s.index, s.cur = -1, nil
+		return false
+	}
+	s.index++
+	if s.cur, s.err = s.header(s.index); s.err != nil {
+		s.cur, s.err = nil, &HeaderError{Index: s.index, Err: s.err}
+		return false
+	}
+	return true
+}
+
+func (s *HeaderStream) header(i int) ([]byte, error) {
+	offset, err := word(s.data, s.base+i*abiWordSize)
+	if err != nil {
+		return nil, err
+	}
+	size, err := word(s.data, s.base+offset)
+	if err != nil {
+		return nil, err
+	}
+	start := s.base + offset + abiWordSize
+	if size > len(s.data)-start {
+		return nil, errHeaderStream
+	}
+	return s.data[start : start+size : start+size], nil
+}
+
+// Header returns the header decoded by the last Next.
+func (s *HeaderStream) Header() []byte {
+	return s.cur
+}
+
+// Index returns the index of the header in process, or -1 out of the iteration.
+func (s *HeaderStream) Index() int {
+	return s.index
+}
+
+// Err returns the decoding error of the batch if the iteration stopped early.
+func (s *HeaderStream) Err() error {
+	return s.err
+}
+
+// Annotate attaches the index of the header in process to the error, it is deferred by the
+// handlers so that relayers know where the batch was rejected.
+func (s *HeaderStream) Annotate(err *error) {
+	if *err == nil || s.index < 0 {
+		return
+	}
+	var e *HeaderError
+	if !errors.As(*err, &e) {
+		*err = &HeaderError{Index: s.index, Err: *err}
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	scom "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/polynetwork/poly/common"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/multisig"
@@ -81,22 +82,37 @@ func (this *CosmosHandler) SyncGenesisHeader(native *native.NativeContract) erro
 	if err == nil && info != nil {
 		return fmt.Errorf("CosmosHandler SyncGenesisHeader, genesis header had been initialized")
 	}
-	PutEpochSwitchInfo(native, param.ChainID, &CosmosEpochSwitchInfo{
-		Height:             header.Header.Height,
-		NextValidatorsHash: header.Header.NextValidatorsHash,
-		ChainID:            header.Header.ChainID,
-		BlockHash:          header.Header.Hash(),
-	})
+	PutEpochSwitchInfo(native, param.ChainID, NewEpochSwitchInfo(&header.Header))
 	return nil
 }
 
-func (this *CosmosHandler) SyncBlockHeader(native *native.NativeContract) error {
-	params := &scom.SyncBlockHeaderParam{}
-	
-	ctx := native.ContractRef().CurrentContext()
-	if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, params, ctx.Payload); err != nil {
+// RebootstrapHeader replaces the epoch switch info by the header, it's the only way to recover
+// the header sync from an expired trusted header or a hard fork. the header must be higher than
+// the trusted one unless the chain id is upgraded, which restarts the height of the chain.
+func (this *CosmosHandler) RebootstrapHeader(native *native.NativeContract, chainID uint64, raw []byte) ([]byte, error) {
+	info, err := GetEpochSwitchInfo(native, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, genesis header is not initialized: %v", err)
+	}
+	var header CosmosHeader
+	if err := newCDC().UnmarshalBinaryBare(raw, &header); err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, unmarshal header error: %v", err)
+	}
+	if header.Header.ChainID == info.ChainID && header.Header.Height <= info.Height {
+		return nil, fmt.Errorf("RebootstrapHeader, height %d is not higher than trusted height %d", header.Header.Height, info.Height)
+	}
+	sink := common.NewZeroCopySink(nil)
+	info.Serialization(sink)
+	PutEpochSwitchInfo(native, chainID, NewEpochSwitchInfo(&header.Header))
+	return sink.Bytes(), nil
+}
+
+func (this *CosmosHandler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	params, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
 		return err
 	}
+	defer params.Annotate(&err)
 	
 
 	cdc := newCDC()
@@ -105,28 +121,47 @@ func (this *CosmosHandler) SyncBlockHeader(native *native.NativeContract) error
 	if err != nil {
 		return fmt.Errorf("SyncBlockHeader, get epoch switching height failed: %v", err)
 	}
-	for _, v := range params.Headers {
+	if err := CheckTrustAt(native.ContractRef(), info.HeaderTime); err != nil {
+		return fmt.Errorf("SyncBlockHeader, %w", err)
+	}
+	for params.Next() {
+		v := params.Header()
 		var myHeader CosmosHeader
 		err := cdc.UnmarshalBinaryBare(v, &myHeader)
 		if err != nil {
 			return fmt.Errorf("SyncBlockHeader failed to unmarshal header: %v", err)
 		}
-		if bytes.Equal(myHeader.Header.NextValidatorsHash, myHeader.Header.ValidatorsHash) {
-			continue
-		}
 		if info.Height >= myHeader.Header.Height {
 			log.Debugf("SyncBlockHeader, height %d is lower or equal than epoch switching height %d",
 				myHeader.Header.Height, info.Height)
 			continue
 		}
-		if err = VerifyCosmosHeader(&myHeader, info); err != nil {
+		// the headers without validator change only refresh the trusting period since light client
+		// v2, and are skipped before
+		switched := !bytes.Equal(myHeader.Header.NextValidatorsHash, myHeader.Header.ValidatorsHash)
+		if !switched && !native.ContractRef().IsLightClientV2() {
+			continue
+		}
+		if !switched && (HeaderTime(&myHeader.Header) <= info.HeaderTime ||
+			!bytes.Equal(myHeader.Header.ValidatorsHash, info.NextValidatorsHash)) {
+			continue
+		}
+		if err = VerifyCosmosHeader(native, &myHeader, info); err != nil {
 			return fmt.Errorf("SyncBlockHeader, failed to verify header: %v", err)
 		}
-		info.NextValidatorsHash = myHeader.Header.NextValidatorsHash
-		info.Height = myHeader.Header.Height
-		info.BlockHash = myHeader.Header.Hash()
+		if switched {
+			info.NextValidatorsHash = myHeader.Header.NextValidatorsHash
+			info.Height = myHeader.Header.Height
+			info.BlockHash = myHeader.Header.Hash()
+		}
+		if t := HeaderTime(&myHeader.Header); t > info.HeaderTime {
+			info.HeaderTime = t
+		}
 		cnt++
 	}
+	if err := params.Err(); err != nil {
+		return err
+	}
 	if cnt == 0 {
 		return fmt.Errorf("no header you commited is useful")
 	}
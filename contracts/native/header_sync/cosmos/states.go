@@ -41,6 +41,10 @@ type CosmosEpochSwitchInfo struct {
 
 	// The cosmos chain-id of this chain basing Cosmos-sdk.
 	ChainID string
+
+	// Unix time of the latest header verified by the validators, which starts the
+	// trusting period. It's 0 for the infos stored before the time is tracked.
+	HeaderTime uint64
 }
 
 func (info *CosmosEpochSwitchInfo) Serialization(sink *common.ZeroCopySink) {
@@ -48,6 +52,10 @@ func (info *CosmosEpochSwitchInfo) Serialization(sink *common.ZeroCopySink) {
 	sink.WriteVarBytes(info.BlockHash)
 	sink.WriteVarBytes(info.NextValidatorsHash)
 	sink.WriteString(info.ChainID)
+	// the infos without header time are kept in the format before it's tracked
+	if info.HeaderTime > 0 {
+		sink.WriteUint64(info.HeaderTime)
+	}
 }
 
 func (info *CosmosEpochSwitchInfo) Deserialization(source *common.ZeroCopySource) error {
@@ -68,6 +76,15 @@ func (info *CosmosEpochSwitchInfo) Deserialization(source *common.ZeroCopySource
 	if eof {
 		return fmt.Errorf("deserialize ChainID of CosmosEpochSwitchInfo failed")
 	}
+	// the infos stored before the header time is tracked end here
+	if source.Len() == 0 {
+		info.HeaderTime = 0
+		return nil
+	}
+	info.HeaderTime, eof = source.NextUint64()
+	if eof {
+		return fmt.Errorf("deserialize HeaderTime of CosmosEpochSwitchInfo failed")
+	}
 	return nil
 }
 
@@ -76,3 +93,14 @@ type CosmosHeader struct {
 	Commit  *types.Commit
 	Valsets []*types.Validator
 }
+
+// NewEpochSwitchInfo returns the info trusting the validators of the header.
+func NewEpochSwitchInfo(header *types.Header) *CosmosEpochSwitchInfo {
+	return &CosmosEpochSwitchInfo{
+		Height:             header.Height,
+		BlockHash:          header.Hash(),
+		NextValidatorsHash: header.NextValidatorsHash,
+		ChainID:            header.ChainID,
+		HeaderTime:         HeaderTime(header),
+	}
+}
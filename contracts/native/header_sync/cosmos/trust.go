@@ -0,0 +1,65 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cosmos
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/tendermint/tendermint/types"
+)
+
+// TrustingPeriod is the period the trusted validator set stays trusted after the time of
+// the latest verified header, it should be shorter than the unbonding period of the chain,
+// otherwise the unbonded validators are able to sign the headers without being slashed.
+const TrustingPeriod = 14 * 24 * time.Hour
+
+var ErrTrustExpired = errors.New("trusted header expired, rebootstrap required")
+
+// CheckTrust checks the trusted header of time `headerTime` is still in the trusting period
+// at the zion block time `now`. The check is skipped if either of the times is unknown, which
+// are the infos stored before the header time is tracked, and the calls out of blocks.
+func CheckTrust(headerTime, now uint64) error {
+	if headerTime == 0 || now == 0 {
+		return nil
+	}
+	if expiry := headerTime + uint64(TrustingPeriod/time.Second); now > expiry {
+		return fmt.Errorf("%w: trusted header time %d, expired at %d, block time %d", ErrTrustExpired, headerTime, expiry, now)
+	}
+	return nil
+}
+
+// CheckTrustAt checks the trusted header of time `headerTime` at the block time of the native
+// call, the trusted headers never expire before the light client v2 fork.
+func CheckTrustAt(ref *native.ContractRef, headerTime uint64) error {
+	if !ref.IsLightClientV2() {
+		return nil
+	}
+	return CheckTrust(headerTime, ref.BlockTime())
+}
+
+// HeaderTime returns the unix time of the header in seconds.
+func HeaderTime(header *types.Header) uint64 {
+	if t := header.Time.Unix(); t > 0 {
+		return uint64(t)
+	}
+	return 0
+}
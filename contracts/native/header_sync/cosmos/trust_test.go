@@ -0,0 +1,219 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cosmos
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	polycomm "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestCheckTrust(t *testing.T) {
+	period := uint64(TrustingPeriod / time.Second)
+	assert.NoError(t, CheckTrust(0, 1<<40))
+	assert.NoError(t, CheckTrust(100, 0))
+	assert.NoError(t, CheckTrust(100, 100+period))
+	assert.True(t, errors.Is(CheckTrust(100, 101+period), ErrTrustExpired))
+}
+
+func TestEpochSwitchInfoLegacy(t *testing.T) {
+	info := &CosmosEpochSwitchInfo{Height: 10, BlockHash: []byte{1}, NextValidatorsHash: []byte{2}, ChainID: testChainID, HeaderTime: 1000}
+	sink := polycomm.NewZeroCopySink(nil)
+	info.Serialization(sink)
+	decoded := new(CosmosEpochSwitchInfo)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, info, decoded)
+
+	// the infos stored before the header time is tracked never expire
+	legacy := sink.Bytes()[:len(sink.Bytes())-8]
+	decoded = new(CosmosEpochSwitchInfo)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(legacy)))
+	assert.Equal(t, uint64(0), decoded.HeaderTime)
+	assert.Equal(t, testChainID, decoded.ChainID)
+
+	// and are stored in the legacy format
+	sink = polycomm.NewZeroCopySink(nil)
+	decoded.Serialization(sink)
+	assert.Equal(t, legacy, sink.Bytes())
+}
+
+// syncHeaders calls SyncBlockHeader with the headers at zion block time `now`.
+func syncHeaders(t *testing.T, db *state.StateDB, config *params.ChainConfig, now time.Time, chainID uint64, headers ...*CosmosHeader) error {
+	cdc := newCDC()
+	param := &scom.SyncBlockHeaderParam{ChainID: chainID}
+	for _, h := range headers {
+		raw, err := cdc.MarshalBinaryBare(h)
+		assert.NoError(t, err)
+		param.Headers = append(param.Headers, raw)
+	}
+	payload, err := utils.PackMethodWithStruct(scom.ABI, scom.MethodSyncBlockHeader, param)
+	assert.NoError(t, err)
+
+	ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.SetBlockTime(uint64(now.Unix()))
+	ref.SetChainConfig(config)
+	ref.PushContext(&native.Context{ContractAddress: utils.HeaderSyncContractAddress, Payload: payload})
+	return NewCosmosHandler().SyncBlockHeader(native.NewNativeContract(db, ref))
+}
+
+func TestSyncBlockHeaderTrust(t *testing.T) {
+	scom.ABI = scom.GetABI()
+	var (
+		chainID   = uint64(5)
+		tracked   = newTestChain(t, 4)
+		next      = newTestChain(t, 4)
+		genesisAt = time.Now().Add(-60 * 24 * time.Hour).UTC()
+	)
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.PushContext(&native.Context{ContractAddress: utils.HeaderSyncContractAddress})
+	PutEpochSwitchInfo(native.NewNativeContract(db, ref), chainID, NewEpochSwitchInfo(tracked.header(10, genesisAt)))
+
+	signed := func(chain *testChain, height int64, at time.Time, nextVals *types.ValidatorSet) *CosmosHeader {
+		header := chain.header(height, at)
+		header.NextValidatorsHash = nextVals.Hash()
+		return &CosmosHeader{Header: *header, Commit: chain.commit(t, header, testChainID, []int{0, 1, 2}), Valsets: chain.vals.Validators}
+	}
+	info := func() *CosmosEpochSwitchInfo {
+		ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+		info, err := GetEpochSwitchInfo(native.NewNativeContract(db, ref), chainID)
+		assert.NoError(t, err)
+		return info
+	}
+
+	// the header without validator change refreshes the trusting period only
+	refreshAt := genesisAt.Add(10 * 24 * time.Hour)
+	assert.NoError(t, syncHeaders(t, db, nil, refreshAt, chainID, signed(tracked, 20, refreshAt, tracked.vals)))
+	assert.Equal(t, int64(10), info().Height)
+	assert.Equal(t, uint64(refreshAt.Unix()), info().HeaderTime)
+
+	// the same header is useless afterwards
+	assert.Error(t, syncHeaders(t, db, nil, refreshAt, chainID, signed(tracked, 20, refreshAt, tracked.vals)))
+
+	// the validator change is accepted in the trusting period
+	switchAt := refreshAt.Add(TrustingPeriod - time.Hour)
+	assert.NoError(t, syncHeaders(t, db, nil, switchAt, chainID, signed(tracked, 30, switchAt, next.vals)))
+	assert.Equal(t, int64(30), info().Height)
+	assert.Equal(t, []byte(next.vals.Hash()), []byte(info().NextValidatorsHash))
+
+	// no update in the trusting period, the sync is rejected even with valid headers
+	expiredAt := switchAt.Add(TrustingPeriod + time.Second)
+	err := syncHeaders(t, db, nil, expiredAt, chainID, signed(next, 40, expiredAt, next.vals))
+	assert.True(t, errors.Is(err, ErrTrustExpired), "%v", err)
+}
+
+func TestRebootstrapHeader(t *testing.T) {
+	var (
+		chainID = uint64(5)
+		tracked = newTestChain(t, 4)
+		now     = time.Now().UTC()
+		cdc     = newCDC()
+	)
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.PushContext(&native.Context{ContractAddress: utils.HeaderSyncContractAddress})
+	service := native.NewNativeContract(db, ref)
+	rebootstrap := func(header *types.Header) ([]byte, error) {
+		raw, err := cdc.MarshalBinaryBare(&CosmosHeader{Header: *header})
+		assert.NoError(t, err)
+		return NewCosmosHandler().RebootstrapHeader(service, chainID, raw)
+	}
+
+	_, err := rebootstrap(tracked.header(20, now))
+	assert.Error(t, err, "not initialized")
+
+	genesis := NewEpochSwitchInfo(tracked.header(10, now))
+	PutEpochSwitchInfo(service, chainID, genesis)
+	_, err = rebootstrap(tracked.header(10, now))
+	assert.Error(t, err, "not higher")
+
+	// the state replaced is returned to be archived
+	previous, err := rebootstrap(tracked.header(20, now))
+	assert.NoError(t, err)
+	decoded := new(CosmosEpochSwitchInfo)
+	assert.NoError(t, decoded.Deserialization(polycomm.NewZeroCopySource(previous)))
+	assert.Equal(t, genesis, decoded)
+	info, err := GetEpochSwitchInfo(service, chainID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), info.Height)
+
+	// the height restarts with the upgraded chain id
+	upgraded := tracked.header(1, now)
+	upgraded.ChainID = testChainID + "-2"
+	_, err = rebootstrap(upgraded)
+	assert.NoError(t, err)
+	info, err = GetEpochSwitchInfo(service, chainID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), info.Height)
+	assert.Equal(t, upgraded.ChainID, info.ChainID)
+}
+
+func TestSyncBlockHeaderLegacy(t *testing.T) {
+	scom.ABI = scom.GetABI()
+	var (
+		chainID   = uint64(5)
+		tracked   = newTestChain(t, 4)
+		next      = newTestChain(t, 4)
+		genesisAt = time.Now().Add(-60 * 24 * time.Hour).UTC()
+		legacy    = &params.ChainConfig{LightClientV2Block: big.NewInt(2)}
+	)
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.SetChainConfig(legacy)
+	ref.PushContext(&native.Context{ContractAddress: utils.HeaderSyncContractAddress})
+	PutEpochSwitchInfo(native.NewNativeContract(db, ref), chainID, NewEpochSwitchInfo(tracked.header(10, genesisAt)))
+
+	signed := func(chain *testChain, height int64, at time.Time, nextVals *types.ValidatorSet) *CosmosHeader {
+		header := chain.header(height, at)
+		header.NextValidatorsHash = nextVals.Hash()
+		return &CosmosHeader{Header: *header, Commit: chain.commit(t, header, testChainID, []int{0, 1, 2}), Valsets: chain.vals.Validators}
+	}
+	info := func() *CosmosEpochSwitchInfo {
+		ref := native.NewContractRef(db, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+		info, err := GetEpochSwitchInfo(native.NewNativeContract(db, ref), chainID)
+		assert.NoError(t, err)
+		return info
+	}
+	assert.Equal(t, uint64(0), info().HeaderTime)
+
+	// the headers without validator change are skipped without verification before the fork
+	unsigned := signed(tracked, 20, genesisAt, tracked.vals)
+	unsigned.Commit = nil
+	assert.Error(t, syncHeaders(t, db, legacy, genesisAt, chainID, unsigned))
+	assert.NoError(t, syncHeaders(t, db, legacy, genesisAt, chainID, unsigned, signed(tracked, 30, genesisAt, next.vals)))
+	assert.Equal(t, int64(30), info().Height)
+	assert.Equal(t, uint64(0), info().HeaderTime)
+
+	// and the trusted header never expires
+	expiredAt := genesisAt.Add(TrustingPeriod + time.Hour)
+	assert.NoError(t, syncHeaders(t, db, legacy, expiredAt, chainID, signed(next, 40, expiredAt, tracked.vals)))
+	assert.Equal(t, int64(40), info().Height)
+}
@@ -18,8 +18,6 @@
 package cosmos
 
 import (
-	"bytes"
-	"encoding/hex"
 	"fmt"
 	"github.com/polynetwork/poly/common"
 	
@@ -28,7 +26,6 @@ import (
 	
 	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
-	"github.com/tendermint/tendermint/types"
 )
 
 func notifyEpochSwitchInfo(native *native.NativeContract, chainID uint64, info *CosmosEpochSwitchInfo) {
@@ -53,6 +50,13 @@ func GetEpochSwitchInfo(service *native.NativeContract, chainId uint64) (*Cosmos
 }
 
 func PutEpochSwitchInfo(service *native.NativeContract, chainId uint64, info *CosmosEpochSwitchInfo) {
+	// the header time is only tracked since light client v2, the infos are stored in the legacy
+	// format before
+	if info.HeaderTime > 0 && !service.ContractRef().IsLightClientV2() {
+		legacy := *info
+		legacy.HeaderTime = 0
+		info = &legacy
+	}
 	sink := common.NewZeroCopySink(nil)
 	info.Serialization(sink)
 	service.GetCacheDB().Put(
@@ -61,50 +65,13 @@ func PutEpochSwitchInfo(service *native.NativeContract, chainId uint64, info *Co
 	notifyEpochSwitchInfo(service, chainId, info)
 }
 
-func VerifyCosmosHeader(myHeader *CosmosHeader, info *CosmosEpochSwitchInfo) error {
-	// now verify this header
-	valset := types.NewValidatorSet(myHeader.Valsets)
-	if !bytes.Equal(info.NextValidatorsHash, valset.Hash()) {
-		return fmt.Errorf("VerifyCosmosHeader, block validator is not right, next validator hash: %s, "+
-			"validator set hash: %s", info.NextValidatorsHash.String(), hex.EncodeToString(valset.Hash()))
-	}
-	if !bytes.Equal(myHeader.Header.ValidatorsHash, valset.Hash()) {
-		return fmt.Errorf("VerifyCosmosHeader, block validator is not right!, header validator hash: %s, "+
-			"validator set hash: %s", myHeader.Header.ValidatorsHash.String(), hex.EncodeToString(valset.Hash()))
-	}
-	if myHeader.Commit.GetHeight() != myHeader.Header.Height {
-		return fmt.Errorf("VerifyCosmosHeader, commit height is not right! commit height: %d, "+
-			"header height: %d", myHeader.Commit.GetHeight(), myHeader.Header.Height)
-	}
-	if !bytes.Equal(myHeader.Commit.BlockID.Hash, myHeader.Header.Hash()) {
-		return fmt.Errorf("VerifyCosmosHeader, commit hash is not right!, commit block hash: %s,"+
-			" header hash: %s", myHeader.Commit.BlockID.Hash.String(), hex.EncodeToString(valset.Hash()))
-	}
-	if err := myHeader.Commit.ValidateBasic(); err != nil {
-		return fmt.Errorf("VerifyCosmosHeader, commit is not right! err: %s", err.Error())
+// VerifyCosmosHeader verifies the header is committed by the validators tracked in the epoch
+// switch info, see VerifyCommit for the details.
+func VerifyCosmosHeader(native *native.NativeContract, myHeader *CosmosHeader, info *CosmosEpochSwitchInfo) error {
+	ref := native.ContractRef()
+	if err := VerifyCommit(&myHeader.Header, myHeader.Commit, myHeader.Valsets, info.NextValidatorsHash, info.ChainID,
+		ref.BlockTime(), ref.IsLightClientV2()); err != nil {
+		return fmt.Errorf("VerifyCosmosHeader, %w", err)
 	}
-	if valset.Size() != len(myHeader.Commit.Signatures) {
-		return fmt.Errorf("VerifyCosmosHeader, the size of precommits is not right!")
-	}
-	talliedVotingPower := int64(0)
-	for idx, commitSig := range myHeader.Commit.Signatures {
-		if commitSig.Absent() {
-			continue // OK, some precommits can be missing.
-		}
-		_, val := valset.GetByIndex(idx)
-		// Validate signature.
-		precommitSignBytes := myHeader.Commit.VoteSignBytes(info.ChainID, idx)
-		if !val.PubKey.VerifyBytes(precommitSignBytes, commitSig.Signature) {
-			return fmt.Errorf("VerifyCosmosHeader, Invalid commit -- invalid signature: %v", commitSig)
-		}
-		// Good precommit!
-		if myHeader.Commit.BlockID.Equals(commitSig.BlockID(myHeader.Commit.BlockID)) {
-			talliedVotingPower += val.VotingPower
-		}
-	}
-	if talliedVotingPower <= valset.TotalVotingPower()*2/3 {
-		return fmt.Errorf("VerifyCosmosHeader, voteing power is not enough!")
-	}
-
 	return nil
 }
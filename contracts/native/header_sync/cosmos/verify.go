@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cosmos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// MaxClockDrift is the max time of a header allowed ahead of the zion block time.
+const MaxClockDrift = 10 * time.Second
+
+var (
+	ErrUntrustedValidators     = errors.New("validator set is not the tracked one")
+	ErrChainIDMismatch         = errors.New("chain id mismatch")
+	ErrFutureHeader            = errors.New("header from the future")
+	ErrCommitMismatch          = errors.New("commit is not for the header")
+	ErrInvalidCommitSignature  = errors.New("invalid commit signature")
+	ErrInsufficientVotingPower = errors.New("insufficient voting power")
+)
+
+// VerifyCommit verifies the header is committed by more than 2/3 voting power of the tracked
+// validator set. The validators carried with the header are only accepted if they hash to
+// `trustedValsHash`, so the power is never counted from a set chosen by the relayer, and the
+// precommits must be signed for `chainID`, which is the chain id the header is bound to.
+//
+// Since the light client v2 fork `hardened` is set, and the chain id of the header, its time
+// against the zion block time `now` and the validator addresses of the precommits are checked
+// as well. The time check is skipped if `now` is unknown, which are the calls out of blocks.
+func VerifyCommit(header *types.Header, commit *types.Commit, vals []*types.Validator, trustedValsHash []byte,
+	chainID string, now uint64, hardened bool) error {
+
+	if hardened {
+		if header.ChainID != chainID {
+			return fmt.Errorf("%w: header chain id %s, tracked chain id %s", ErrChainIDMismatch, header.ChainID, chainID)
+		}
+		if now > 0 {
+			blockTime := time.Unix(int64(now), 0)
+			if drift := header.Time.Sub(blockTime); drift > MaxClockDrift {
+				return fmt.Errorf("%w: header time %s is %s ahead of block time", ErrFutureHeader, header.Time, drift)
+			}
+		}
+		if len(vals) == 0 {
+			return fmt.Errorf("%w: empty validator set", ErrUntrustedValidators)
+		}
+	}
+	valset := types.NewValidatorSet(vals)
+	if !bytes.Equal(trustedValsHash, valset.Hash()) {
+		return fmt.Errorf("%w: tracked validator hash %X, validator set hash %X", ErrUntrustedValidators, trustedValsHash, valset.Hash())
+	}
+	if !bytes.Equal(header.ValidatorsHash, valset.Hash()) {
+		return fmt.Errorf("%w: header validator hash %X, validator set hash %X", ErrUntrustedValidators, header.ValidatorsHash, valset.Hash())
+	}
+
+	if commit == nil {
+		return fmt.Errorf("%w: missing commit", ErrCommitMismatch)
+	}
+	if commit.GetHeight() != header.Height {
+		return fmt.Errorf("%w: commit height %d, header height %d", ErrCommitMismatch, commit.GetHeight(), header.Height)
+	}
+	if !bytes.Equal(commit.BlockID.Hash, header.Hash()) {
+		return fmt.Errorf("%w: commit block hash %X, header hash %X", ErrCommitMismatch, commit.BlockID.Hash, header.Hash())
+	}
+	if err := commit.ValidateBasic(); err != nil {
+		return fmt.Errorf("%w: %v", ErrCommitMismatch, err)
+	}
+	if valset.Size() != len(commit.Signatures) {
+		return fmt.Errorf("%w: %d precommits for %d validators", ErrCommitMismatch, len(commit.Signatures), valset.Size())
+	}
+
+	tallied := int64(0)
+	for idx, sig := range commit.Signatures {
+		if sig.Absent() {
+			continue // some precommits can be missing
+		}
+		_, val := valset.GetByIndex(idx)
+		if hardened && !bytes.Equal(sig.ValidatorAddress, val.Address) {
+			return fmt.Errorf("%w: precommit %d signed by %X, expect %X", ErrInvalidCommitSignature, idx, sig.ValidatorAddress, val.Address)
+		}
+		if !val.PubKey.VerifyBytes(commit.VoteSignBytes(chainID, idx), sig.Signature) {
+			return fmt.Errorf("%w: precommit %d of %X", ErrInvalidCommitSignature, idx, val.Address)
+		}
+		// the precommits for nil are valid, but never counted
+		if commit.BlockID.Equals(sig.BlockID(commit.BlockID)) {
+			tallied += val.VotingPower
+		}
+	}
+	// the total voting power is capped by types.MaxTotalVotingPower, never overflows
+	if total := valset.TotalVotingPower(); tallied*3 <= total*2 {
+		return fmt.Errorf("%w: got %d, need more than 2/3 of %d", ErrInsufficientVotingPower, tallied, total)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cosmos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "cosmos-test"
+
+// testChain is a validator set signing the headers of the fixtures.
+type testChain struct {
+	vals  *types.ValidatorSet
+	privs map[string]types.PrivValidator
+}
+
+func newTestChain(t *testing.T, n int) *testChain {
+	vals, privs := types.RandValidatorSet(n, 10)
+	chain := &testChain{vals: vals, privs: make(map[string]types.PrivValidator)}
+	for _, priv := range privs {
+		pub, err := priv.GetPubKey()
+		assert.NoError(t, err)
+		chain.privs[string(pub.Address())] = priv
+	}
+	return chain
+}
+
+func (c *testChain) header(height int64, at time.Time) *types.Header {
+	return &types.Header{
+		ChainID:            testChainID,
+		Height:             height,
+		Time:               at,
+		ValidatorsHash:     c.vals.Hash(),
+		NextValidatorsHash: c.vals.Hash(),
+	}
+}
+
+// commit signs the header with the validators at `signers`, the `nils` sign for nil block.
+func (c *testChain) commit(t *testing.T, header *types.Header, chainID string, signers []int, nils ...int) *types.Commit {
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	sigs := make([]types.CommitSig, c.vals.Size())
+	for i := range sigs {
+		sigs[i] = types.NewCommitSigAbsent()
+	}
+	sign := func(i int, id types.BlockID) *types.Vote {
+		val := c.vals.Validators[i]
+		vote := &types.Vote{
+			Type:             types.PrecommitType,
+			Height:           header.Height,
+			BlockID:          id,
+			Timestamp:        header.Time,
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   i,
+		}
+		assert.NoError(t, c.privs[string(val.Address)].SignVote(chainID, vote))
+		return vote
+	}
+	for _, i := range signers {
+		vote := sign(i, blockID)
+		sigs[i] = types.NewCommitSigForBlock(vote.Signature, vote.ValidatorAddress, vote.Timestamp)
+	}
+	for _, i := range nils {
+		vote := sign(i, types.BlockID{})
+		sigs[i] = types.CommitSig{BlockIDFlag: types.BlockIDFlagNil, ValidatorAddress: vote.ValidatorAddress,
+			Timestamp: vote.Timestamp, Signature: vote.Signature}
+	}
+	return types.NewCommit(header.Height, 0, blockID, sigs)
+}
+
+func TestVerifyCommit(t *testing.T) {
+	var (
+		tracked  = newTestChain(t, 4)
+		attacker = newTestChain(t, 4)
+		now      = time.Now()
+		testNow  = uint64(now.Unix())
+		past     = now.Add(-time.Hour).UTC()
+	)
+
+	header := tracked.header(10, past)
+	commit := tracked.commit(t, header, testChainID, []int{0, 1, 2})
+	assert.NoError(t, VerifyCommit(header, commit, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true))
+
+	// the validators must be the tracked set
+	assert.ErrorIs(t, VerifyCommit(header, commit, tracked.vals.Validators, attacker.vals.Hash(), testChainID, testNow, true), ErrUntrustedValidators)
+	assert.ErrorIs(t, VerifyCommit(header, commit, nil, tracked.vals.Hash(), testChainID, testNow, true), ErrUntrustedValidators)
+
+	// header signed by its own validator set
+	forged := attacker.header(10, past)
+	forgedCommit := attacker.commit(t, forged, testChainID, []int{0, 1, 2, 3})
+	assert.ErrorIs(t, VerifyCommit(forged, forgedCommit, attacker.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true), ErrUntrustedValidators)
+
+	// header of another chain, and precommits signed for another chain
+	other := tracked.header(10, past)
+	other.ChainID = "cosmos-other"
+	assert.ErrorIs(t, VerifyCommit(other, tracked.commit(t, other, "cosmos-other", []int{0, 1, 2}), tracked.vals.Validators,
+		tracked.vals.Hash(), testChainID, testNow, true), ErrChainIDMismatch)
+	assert.ErrorIs(t, VerifyCommit(header, tracked.commit(t, header, "cosmos-other", []int{0, 1, 2}), tracked.vals.Validators,
+		tracked.vals.Hash(), testChainID, testNow, true), ErrInvalidCommitSignature)
+
+	// header too far ahead of the block time
+	future := tracked.header(10, now.Add(time.Minute).UTC())
+	assert.ErrorIs(t, VerifyCommit(future, tracked.commit(t, future, testChainID, []int{0, 1, 2}), tracked.vals.Validators,
+		tracked.vals.Hash(), testChainID, testNow, true), ErrFutureHeader)
+
+	// 2/3 exactly is not enough, and the precommits for nil are not counted
+	assert.ErrorIs(t, VerifyCommit(header, tracked.commit(t, header, testChainID, []int{0, 1}), tracked.vals.Validators,
+		tracked.vals.Hash(), testChainID, testNow, true), ErrInsufficientVotingPower)
+	assert.ErrorIs(t, VerifyCommit(header, tracked.commit(t, header, testChainID, []int{0, 1}, 2, 3), tracked.vals.Validators,
+		tracked.vals.Hash(), testChainID, testNow, true), ErrInsufficientVotingPower)
+
+	// commit of another header at the same height
+	tampered := *header
+	tampered.AppHash = []byte("tampered")
+	assert.ErrorIs(t, VerifyCommit(&tampered, commit, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true), ErrCommitMismatch)
+	assert.ErrorIs(t, VerifyCommit(header, nil, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true), ErrCommitMismatch)
+
+	// precommit claimed by another validator
+	swapped := tracked.commit(t, header, testChainID, []int{0, 1, 2})
+	swapped.Signatures[0].ValidatorAddress = tracked.vals.Validators[3].Address
+	assert.ErrorIs(t, VerifyCommit(header, swapped, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true), ErrInvalidCommitSignature)
+
+	// forged signature
+	broken := tracked.commit(t, header, testChainID, []int{0, 1, 2})
+	broken.Signatures[1].Signature = append([]byte{}, broken.Signatures[2].Signature...)
+	assert.ErrorIs(t, VerifyCommit(header, broken, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true), ErrInvalidCommitSignature)
+
+	// the checks added by light client v2 are skipped before the fork
+	mislabeled := tracked.header(10, past)
+	mislabeled.ChainID = "cosmos-other"
+	legacy := []struct {
+		header *types.Header
+		commit *types.Commit
+	}{
+		{mislabeled, tracked.commit(t, mislabeled, testChainID, []int{0, 1, 2})},
+		{future, tracked.commit(t, future, testChainID, []int{0, 1, 2})},
+		{header, swapped},
+	}
+	for _, c := range legacy {
+		assert.Error(t, VerifyCommit(c.header, c.commit, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, true))
+		assert.NoError(t, VerifyCommit(c.header, c.commit, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, false))
+	}
+	assert.ErrorIs(t, VerifyCommit(header, broken, tracked.vals.Validators, tracked.vals.Hash(), testChainID, testNow, false), ErrInvalidCommitSignature)
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/header_sync_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/bsc"
 	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
@@ -38,20 +40,32 @@ const contractName = "header sync"
 
 var (
 	this = native.NativeContractAddrMap[native.NativeSyncHeader]
+
+	// the bounds of arguments checked before dispatching, the handlers still validate the values
+	addressRule = &native.ArgRule{NonZero: true}
+	headerRule  = &native.ArgRule{NonZero: true}
 )
 
 func InitHeaderSync() {
 	native.Contracts[this] = RegisterHeaderSyncContract
 	hscommon.ABI = hscommon.GetABI()
+	native.RegisterABI(native.NativeSyncHeader, "HeaderSync", header_sync_abi.HeaderSyncABI)
 }
 
 func RegisterHeaderSyncContract(s *native.NativeContract) {
 	s.Prepare(hscommon.ABI, hscommon.GasTable)
 
-	s.Register(hscommon.MethodContractName, Name)
+	s.RegisterQuery(hscommon.MethodContractName, Name)
 	s.Register(hscommon.MethodSyncGenesisHeader, SyncGenesisHeader)
 	s.Register(hscommon.MethodSyncBlockHeader, SyncBlockHeader)
 	s.Register(hscommon.MethodSyncCrossChainMsg, SyncCrossChainMsg)
+	s.Register(hscommon.MethodRebootstrapHeader, RebootstrapHeader)
+	s.RegisterQuery(hscommon.MethodLightClientArchive, LightClientArchive)
+
+	s.RegisterRules(hscommon.MethodSyncGenesisHeader, native.MethodRules{"GenesisHeader": headerRule})
+	s.RegisterRules(hscommon.MethodSyncBlockHeader, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(hscommon.MethodSyncCrossChainMsg, native.MethodRules{"Address": addressRule})
+	s.RegisterRules(hscommon.MethodRebootstrapHeader, native.MethodRules{"Header": headerRule})
 }
 
 func Name(s *native.NativeContract) ([]byte, error) {
@@ -111,6 +125,8 @@ func SyncBlockHeader(native *native.NativeContract) ([]byte, error) {
 	}
 
 	err = handler.SyncBlockHeader(native)
+	markSubmission(hscommon.MethodSyncBlockHeader, chainID, err)
+	logSubmission(native, hscommon.MethodSyncBlockHeader, chainID, err)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +157,8 @@ func SyncCrossChainMsg(native *native.NativeContract) ([]byte, error) {
 	}
 
 	err = handler.SyncCrossChainMsg(native)
+	markSubmission(hscommon.MethodSyncCrossChainMsg, chainID, err)
+	logSubmission(native, hscommon.MethodSyncCrossChainMsg, chainID, err)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +166,93 @@ func SyncCrossChainMsg(native *native.NativeContract) ([]byte, error) {
 	return utils.PackOutputs(hscommon.ABI, hscommon.MethodSyncCrossChainMsg, true)
 }
 
+// logSubmission logs the submission of relayers tagged by the side chain, so that the header sync
+// of a single chain is raised by e.g. `--log.tag header_sync/2=4`.
+func logSubmission(native *native.NativeContract, method string, chainID uint64, err error) {
+	logger := utils.NewLogger(utils.LogModuleHeaderSync, "chainID", chainID, "txHash", native.ContractRef().TxHash())
+	if err != nil {
+		logger.Debug("Header sync submission failed", "method", method, "err", err)
+		return
+	}
+	logger.Trace("Header sync submission succeeded", "method", method)
+}
+
+// RebootstrapHeader (re)initializes the light client of the side chain from the header approved
+// by the validators, e.g. after a hard fork or a long outage of the side chain. the state being
+// replaced is archived under a new generation of the chain rather than overwritten.
+func RebootstrapHeader(native *native.NativeContract) ([]byte, error) {
+	ctx := native.ContractRef().CurrentContext()
+	params := &hscommon.RebootstrapHeaderParam{}
+	if err := utils.UnpackMethod(hscommon.ABI, hscommon.MethodRebootstrapHeader, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChain(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("RebootstrapHeader, side chain is not registered")
+	}
+
+	handler, err := GetChainHandler(sideChain.Router)
+	if err != nil {
+		return nil, err
+	}
+	rebootstrapper, ok := handler.(hscommon.HeaderRebootstrapper)
+	if !ok {
+		return nil, fmt.Errorf("RebootstrapHeader, router %d does not support rebootstrap", sideChain.Router)
+	}
+
+	// the approval is bound to the generation, so that it can't be replayed after the re-bootstrap
+	generation, err := hscommon.GetRebootstrapGeneration(native, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, %v", err)
+	}
+	sign := append(utils.GetUint64Bytes(generation), ctx.Payload...)
+	ok, err = node_manager.CheckConsensusSigns(native, hscommon.MethodRebootstrapHeader, sign, native.ContractRef().MsgSender())
+	if err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(hscommon.ABI, hscommon.MethodRebootstrapHeader, true)
+	}
+
+	previous, err := rebootstrapper.RebootstrapHeader(native, params.ChainID, params.Header)
+	if err != nil {
+		return nil, err
+	}
+	archive := &hscommon.LightClientArchive{
+		Height:   native.ContractRef().BlockHeight().Uint64(),
+		Header:   params.Header,
+		Previous: previous,
+	}
+	if _, err := hscommon.PutLightClientArchive(native, params.ChainID, archive); err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, %v", err)
+	}
+	err = native.AddNotify(hscommon.ABI, []string{hscommon.REBOOTSTRAP_EVENT}, params.ChainID, generation, native.ContractRef().BlockHeight())
+	if err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(hscommon.ABI, hscommon.MethodRebootstrapHeader, true)
+}
+
+// LightClientArchive returns the light client state archived by the re-bootstrap of the generation.
+func LightClientArchive(native *native.NativeContract) ([]byte, error) {
+	params := &hscommon.LightClientArchiveParam{}
+	if err := utils.UnpackMethod(hscommon.ABI, hscommon.MethodLightClientArchive, params, native.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, err
+	}
+	archive, err := hscommon.GetLightClientArchive(native, params.ChainID, params.Generation)
+	if err != nil {
+		return nil, err
+	}
+	if archive == nil {
+		return nil, fmt.Errorf("LightClientArchive, generation %d of chain %d is not archived", params.Generation, params.ChainID)
+	}
+	return utils.PackOutputs(hscommon.ABI, hscommon.MethodLightClientArchive, archive.Height, archive.Header, archive.Previous)
+}
+
 func GetChainHandler(router uint64) (hscommon.HeaderSyncHandler, error) {
 	switch router {
 	case utils.BSC_ROUTER:
@@ -95,16 +95,15 @@ func (this *ETHHandler) SyncGenesisHeader(native *native.NativeContract) error {
 	return nil
 }
 
-func (this *ETHHandler) SyncBlockHeader(native *native.NativeContract) error {
-	headerParams := &scom.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, headerParams, ctx.Payload); err != nil {
-			return err
-		}
+func (this *ETHHandler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	headerParams, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer headerParams.Annotate(&err)
 	caches := NewCaches(3, native)
-	for _, v := range headerParams.Headers {
+	for headerParams.Next() {
+		v := headerParams.Header()
 		var header Header
 		err := json.Unmarshal(v, &header)
 		if err != nil {
@@ -140,9 +139,11 @@ func (this *ETHHandler) SyncBlockHeader(native *native.NativeContract) error {
 		if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
 			return fmt.Errorf("SyncBlockHeader, SyncBlockHeader extra-data too long: %d > %d, header: %s", len(header.Extra), params.MaximumExtraDataSize, string(v))
 		}
-		//verify current time validity
-		if header.Time > uint64(time.Now().Add(allowedFutureBlockTime).Unix()) {
-			return fmt.Errorf("SyncBlockHeader,  verify header time error:%s, checktime: %d, header: %s", consensus.ErrFutureBlock, time.Now().Add(allowedFutureBlockTime).Unix(), string(v))
+		//verify current time validity, the validators execute the block after the proposer, so
+		//the header accepted by the proposer is rejected only by a lagging clock
+		checkTime := uint64(time.Now().Add(allowedFutureBlockTime).Unix()) // nativecheck:ignore future headers are bounded by wall clock
+		if header.Time > checkTime {
+			return fmt.Errorf("SyncBlockHeader,  verify header time error:%s, checktime: %d, header: %s", consensus.ErrFutureBlock, checkTime, string(v))
 		}
 		//verify whether current header time and prevent header time validity
 		if header.Time <= parentHeader.Time {
@@ -201,6 +202,9 @@ func (this *ETHHandler) SyncBlockHeader(native *native.NativeContract) error {
 			}
 		}
 	}
+	if err := headerParams.Err(); err != nil {
+		return err
+	}
 	caches.deleteCaches()
 	return nil
 }
@@ -62,9 +62,9 @@ func putGenesisBlockHeader(native *native.NativeContract, blockHeader Header, ch
 	}
 	storeBytes, _ := json.Marshal(&headerWithDifficultySum)
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.GENESIS_HEADER), utils.GetUint64Bytes(chainID)),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), blockHeader.Hash().Bytes()),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.MAIN_CHAIN), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(blockHeader.Number.Uint64())),
 		cstates.GenRawStorageItem(blockHeader.Hash().Bytes()))
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.CURRENT_HEADER_HEIGHT),
@@ -81,7 +81,7 @@ func putBlockHeader(native *native.NativeContract, blockHeader Header, difficult
 	}
 	storeBytes, _ := json.Marshal(&headerWithDifficultySum)
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), blockHeader.Hash().Bytes()),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	scom.NotifyPutHeader(native, chainID, blockHeader.Number.Uint64(), blockHeader.Hash().String())
 	return nil
 }
@@ -152,7 +152,7 @@ func GetHeaderByHash(native *native.NativeContract, hash []byte, chainID uint64)
 	if headerStore == nil {
 		return nil, big.NewInt(0), fmt.Errorf("GetHeaderByHash, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, big.NewInt(0), fmt.Errorf("GetHeaderByHash, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -138,7 +138,7 @@ func getGenesis(native *native.NativeContract, chainID uint64) (genesisHeader *G
 		return
 	}
 
-	genesisBytes, err = cstates.GetValueFromRawStorageItem(genesisBytes)
+	genesisBytes, err = scom.GetHeaderFromStorageItem(genesisBytes)
 	if err != nil {
 		err = fmt.Errorf("getGenesis, GetValueFromRawStorageItem err:%v", err)
 		return
@@ -165,7 +165,7 @@ func storeGenesis(native *native.NativeContract, params *scom.SyncGenesisHeaderP
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.GENESIS_HEADER), utils.GetUint64Bytes(params.ChainID)),
-		cstates.GenRawStorageItem(genesisBytes))
+		scom.GenHeaderStorageItem(native, genesisBytes))
 
 	headerWithSum := &HeaderWithDifficultySum{Header: &genesisHeader.Header, DifficultySum: genesisHeader.Header.Difficulty}
 
@@ -209,14 +209,12 @@ type HeaderWithDifficultySum struct {
 // SyncBlockHeader ...
 // Will verify header coming from congress consensus
 // https://github.com/HuobiGroup/huobi-eco-chain/tree/master/consensus/congress
-func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
-	headerParams := &scom.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, headerParams, ctx.Payload); err != nil {
-			return err
-		}
+func (h *Handler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	headerParams, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer headerParams.Annotate(&err)
 
 	side, err := side_chain_manager.GetSideChain(native, headerParams.ChainID)
 	if err != nil {
@@ -233,7 +231,8 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 
 	ctx := &Context{ExtraInfo: extraInfo, ChainID: headerParams.ChainID}
 
-	for _, v := range headerParams.Headers {
+	for headerParams.Next() {
+		v := headerParams.Header()
 		var header eth.Header
 		err := json.Unmarshal(v, &header)
 		if err != nil {
@@ -309,6 +308,9 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 
 		scom.NotifyPutHeader(native, headerParams.ChainID, header.Number.Uint64(), header.Hash().Hex())
 	}
+	if err := headerParams.Err(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -398,7 +400,7 @@ func putHeaderWithSum(native *native.NativeContract, chainID uint64, headerWithS
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), headerWithSum.Header.Hash().Bytes()),
-		cstates.GenRawStorageItem(headerBytes))
+		scom.GenHeaderStorageItem(native, headerBytes))
 	return
 }
 
@@ -633,7 +635,7 @@ func getHeader(native *native.NativeContract, hash ecommon.Hash, chainID uint64)
 	if headerStore == nil {
 		return nil, fmt.Errorf("heco Handler getHeader, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, fmt.Errorf("heco Handler getHeader, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -659,8 +661,9 @@ var (
 
 func verifyHeader(native *native.NativeContract, header *eth.Header, ctx *Context) (signer ecommon.Address, err error) {
 
-	// Don't waste time checking blocks from the future
-	if header.Time > uint64(time.Now().Unix()) {
+	// Don't waste time checking blocks from the future, the validators execute the block after
+	// the proposer, so the header accepted by the proposer is rejected only by a lagging clock.
+	if header.Time > uint64(time.Now().Unix()) { // nativecheck:ignore future headers are bounded by wall clock
 		err = errors.New("block in the future")
 		return
 	}
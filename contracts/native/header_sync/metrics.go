@@ -0,0 +1,35 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package header_sync
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// markSubmission counts the submission of relayers to the method, which is named by the side
+// chain id as `native/headersync/<chainID>/<method>/submit`, the failed ones are counted under
+// `failure` as well.
+func markSubmission(method string, chainID uint64, err error) {
+	prefix := fmt.Sprintf("native/headersync/%d/%s", chainID, method)
+	metrics.GetOrRegisterCounter(prefix+"/submit", nil).Inc(1)
+	if err != nil {
+		metrics.GetOrRegisterCounter(prefix+"/failure", nil).Inc(1)
+	}
+}
@@ -135,7 +135,7 @@ func getGenesis(native *native.NativeContract, chainID uint64) (genesisHeader *t
 		return
 	}
 
-	genesisBytes, err = cstates.GetValueFromRawStorageItem(genesisBytes)
+	genesisBytes, err = scom.GetHeaderFromStorageItem(genesisBytes)
 	if err != nil {
 		err = fmt.Errorf("getGenesis, GetValueFromRawStorageItem err:%v", err)
 		return
@@ -162,7 +162,7 @@ func storeGenesis(native *native.NativeContract, params *scom.SyncGenesisHeaderP
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.GENESIS_HEADER), utils.GetUint64Bytes(params.ChainID)),
-		cstates.GenRawStorageItem(genesisBytes))
+		scom.GenHeaderStorageItem(native, genesisBytes))
 
 	headerWithSum := &HeaderWithDifficultySum{Header: genesisHeader, DifficultySum: genesisHeader.Difficulty}
 
@@ -201,14 +201,12 @@ type HeaderWithDifficultySum struct {
 }
 
 // SyncBlockHeader ...
-func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
-	headerParams := &scom.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, headerParams, ctx.Payload); err != nil {
-			return err
-		}
+func (h *Handler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	headerParams, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer headerParams.Annotate(&err)
 
 	side, err := side_chain_manager.GetSideChain(native, headerParams.ChainID)
 	if err != nil {
@@ -222,7 +220,8 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 
 	ctx := &Context{ExtraInfo: extraInfo, ChainID: headerParams.ChainID}
 
-	for _, v := range headerParams.Headers {
+	for headerParams.Next() {
+		v := headerParams.Header()
 		var header types.Header
 		err := json.Unmarshal(v, &header)
 		if err != nil {
@@ -259,6 +258,9 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 
 		scom.NotifyPutHeader(native, headerParams.ChainID, header.Number.Uint64(), header.Hash().Hex())
 	}
+	if err := headerParams.Err(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -344,7 +346,7 @@ func putHeaderWithSum(native *native.NativeContract, chainID uint64, headerWithS
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), headerWithSum.Header.Hash().Bytes()),
-		cstates.GenRawStorageItem(headerBytes))
+		scom.GenHeaderStorageItem(native, headerBytes))
 	return
 }
 
@@ -559,7 +561,7 @@ func GetHeader(native *native.NativeContract, hash ecommon.Hash, chainID uint64)
 	if headerStore == nil {
 		return nil, fmt.Errorf("msc Handler getHeader, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, fmt.Errorf("msc Handler getHeader, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -647,8 +649,9 @@ func verifyHeader(native *native.NativeContract, header *types.Header, ctx *Cont
 	}
 	number := header.Number.Uint64()
 
-	// Don't waste time checking blocks from the future
-	if header.Time > uint64(time.Now().Unix()) {
+	// Don't waste time checking blocks from the future, the validators execute the block after
+	// the proposer, so the header accepted by the proposer is rejected only by a lagging clock.
+	if header.Time > uint64(time.Now().Unix()) { // nativecheck:ignore future headers are bounded by wall clock
 		err = errFutureBlock
 		return
 	}
@@ -17,7 +17,6 @@
 package okex
 
 import (
-	"encoding/hex"
 	"fmt"
 
 	"bytes"
@@ -28,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync/cosmos"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/okex/ethsecp256k1"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/log"
@@ -88,23 +88,37 @@ func (h *Handler) SyncGenesisHeader(native *native.NativeContract) (err error) {
 	if err == nil && info != nil {
 		return fmt.Errorf("CosmosHandler SyncGenesisHeader, genesis header had been initialized")
 	}
-	PutEpochSwitchInfo(native, param.ChainID, &CosmosEpochSwitchInfo{
-		Height:             header.Header.Height,
-		NextValidatorsHash: header.Header.NextValidatorsHash,
-		ChainID:            header.Header.ChainID,
-		BlockHash:          header.Header.Hash(),
-	})
+	PutEpochSwitchInfo(native, param.ChainID, NewEpochSwitchInfo(&header.Header))
 	return nil
 }
 
-func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
-	params := &hscommon.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(hscommon.ABI, hscommon.MethodSyncBlockHeader, params, ctx.Payload); err != nil {
-			return err
-		}
+// RebootstrapHeader replaces the epoch switch info by the header, it's the only way to recover
+// the header sync from an expired trusted header or a hard fork. the header must be higher than
+// the trusted one unless the chain id is upgraded, which restarts the height of the chain.
+func (h *Handler) RebootstrapHeader(native *native.NativeContract, chainID uint64, raw []byte) ([]byte, error) {
+	info, err := GetEpochSwitchInfo(native, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, genesis header is not initialized: %v", err)
+	}
+	var header CosmosHeader
+	if err := NewCDC().UnmarshalBinaryBare(raw, &header); err != nil {
+		return nil, fmt.Errorf("RebootstrapHeader, unmarshal header error: %v", err)
+	}
+	if header.Header.ChainID == info.ChainID && header.Header.Height <= info.Height {
+		return nil, fmt.Errorf("RebootstrapHeader, height %d is not higher than trusted height %d", header.Header.Height, info.Height)
+	}
+	sink := common.NewZeroCopySink(nil)
+	info.Serialization(sink)
+	PutEpochSwitchInfo(native, chainID, NewEpochSwitchInfo(&header.Header))
+	return sink.Bytes(), nil
+}
+
+func (h *Handler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	params, err := hscommon.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer params.Annotate(&err)
 
 	cdc := NewCDC()
 	cnt := 0
@@ -112,28 +126,47 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 	if err != nil {
 		return fmt.Errorf("SyncBlockHeader, get epoch switching height failed: %v", err)
 	}
-	for _, v := range params.Headers {
+	if err := cosmos.CheckTrustAt(native.ContractRef(), info.HeaderTime); err != nil {
+		return fmt.Errorf("SyncBlockHeader, %w", err)
+	}
+	for params.Next() {
+		v := params.Header()
 		var myHeader CosmosHeader
 		err := cdc.UnmarshalBinaryBare(v, &myHeader)
 		if err != nil {
 			return fmt.Errorf("SyncBlockHeader failed to unmarshal header: %v", err)
 		}
-		if bytes.Equal(myHeader.Header.NextValidatorsHash, myHeader.Header.ValidatorsHash) {
-			continue
-		}
 		if info.Height >= myHeader.Header.Height {
 			log.Debugf("SyncBlockHeader, height %d is lower or equal than epoch switching height %d",
 				myHeader.Header.Height, info.Height)
 			continue
 		}
-		if err = VerifyCosmosHeader(&myHeader, info); err != nil {
+		// the headers without validator change only refresh the trusting period since light client
+		// v2, and are skipped before
+		switched := !bytes.Equal(myHeader.Header.NextValidatorsHash, myHeader.Header.ValidatorsHash)
+		if !switched && !native.ContractRef().IsLightClientV2() {
+			continue
+		}
+		if !switched && (cosmos.HeaderTime(&myHeader.Header) <= info.HeaderTime ||
+			!bytes.Equal(myHeader.Header.ValidatorsHash, info.NextValidatorsHash)) {
+			continue
+		}
+		if err = VerifyCosmosHeader(native, &myHeader, info); err != nil {
 			return fmt.Errorf("SyncBlockHeader, failed to verify header: %v", err)
 		}
-		info.NextValidatorsHash = myHeader.Header.NextValidatorsHash
-		info.Height = myHeader.Header.Height
-		info.BlockHash = myHeader.Header.Hash()
+		if switched {
+			info.NextValidatorsHash = myHeader.Header.NextValidatorsHash
+			info.Height = myHeader.Header.Height
+			info.BlockHash = myHeader.Header.Hash()
+		}
+		if t := cosmos.HeaderTime(&myHeader.Header); t > info.HeaderTime {
+			info.HeaderTime = t
+		}
 		cnt++
 	}
+	if err := params.Err(); err != nil {
+		return err
+	}
 	if cnt == 0 {
 		return fmt.Errorf("no header you commited is useful")
 	}
@@ -164,6 +197,13 @@ func GetEpochSwitchInfo(service *native.NativeContract, chainId uint64) (*Cosmos
 }
 
 func PutEpochSwitchInfo(service *native.NativeContract, chainId uint64, info *CosmosEpochSwitchInfo) {
+	// the header time is only tracked since light client v2, the infos are stored in the legacy
+	// format before
+	if info.HeaderTime > 0 && !service.ContractRef().IsLightClientV2() {
+		legacy := *info
+		legacy.HeaderTime = 0
+		info = &legacy
+	}
 	sink := common.NewZeroCopySink(nil)
 	info.Serialization(sink)
 	service.GetCacheDB().Put(
@@ -195,6 +235,21 @@ type CosmosEpochSwitchInfo struct {
 
 	// The cosmos chain-id of this chain basing Cosmos-sdk.
 	ChainID string
+
+	// Unix time of the latest header verified by the validators, which starts the
+	// trusting period. It's 0 for the infos stored before the time is tracked.
+	HeaderTime uint64
+}
+
+// NewEpochSwitchInfo returns the info trusting the validators of the header.
+func NewEpochSwitchInfo(header *types.Header) *CosmosEpochSwitchInfo {
+	return &CosmosEpochSwitchInfo{
+		Height:             header.Height,
+		BlockHash:          header.Hash(),
+		NextValidatorsHash: header.NextValidatorsHash,
+		ChainID:            header.ChainID,
+		HeaderTime:         cosmos.HeaderTime(header),
+	}
 }
 
 func (info *CosmosEpochSwitchInfo) Serialization(sink *common.ZeroCopySink) {
@@ -202,6 +257,10 @@ func (info *CosmosEpochSwitchInfo) Serialization(sink *common.ZeroCopySink) {
 	sink.WriteVarBytes(info.BlockHash)
 	sink.WriteVarBytes(info.NextValidatorsHash)
 	sink.WriteString(info.ChainID)
+	// the infos without header time are kept in the format before it's tracked
+	if info.HeaderTime > 0 {
+		sink.WriteUint64(info.HeaderTime)
+	}
 }
 
 func (info *CosmosEpochSwitchInfo) Deserialization(source *common.ZeroCopySource) error {
@@ -222,53 +281,25 @@ func (info *CosmosEpochSwitchInfo) Deserialization(source *common.ZeroCopySource
 	if eof {
 		return fmt.Errorf("deserialize ChainID of CosmosEpochSwitchInfo failed")
 	}
+	// the infos stored before the header time is tracked end here
+	if source.Len() == 0 {
+		info.HeaderTime = 0
+		return nil
+	}
+	info.HeaderTime, eof = source.NextUint64()
+	if eof {
+		return fmt.Errorf("deserialize HeaderTime of CosmosEpochSwitchInfo failed")
+	}
 	return nil
 }
 
-func VerifyCosmosHeader(myHeader *CosmosHeader, info *CosmosEpochSwitchInfo) error {
-	// now verify this header
-	valset := types.NewValidatorSet(myHeader.Valsets)
-	if !bytes.Equal(info.NextValidatorsHash, valset.Hash()) {
-		return fmt.Errorf("VerifyCosmosHeader, block validator is not right, next validator hash: %s, "+
-			"validator set hash: %s", info.NextValidatorsHash.String(), hex.EncodeToString(valset.Hash()))
-	}
-	if !bytes.Equal(myHeader.Header.ValidatorsHash, valset.Hash()) {
-		return fmt.Errorf("VerifyCosmosHeader, block validator is not right!, header validator hash: %s, "+
-			"validator set hash: %s", myHeader.Header.ValidatorsHash.String(), hex.EncodeToString(valset.Hash()))
+// VerifyCosmosHeader verifies the header is committed by the validators tracked in the epoch
+// switch info, see VerifyCommit for the details.
+func VerifyCosmosHeader(native *native.NativeContract, myHeader *CosmosHeader, info *CosmosEpochSwitchInfo) error {
+	ref := native.ContractRef()
+	if err := cosmos.VerifyCommit(&myHeader.Header, myHeader.Commit, myHeader.Valsets, info.NextValidatorsHash, info.ChainID,
+		ref.BlockTime(), ref.IsLightClientV2()); err != nil {
+		return fmt.Errorf("VerifyCosmosHeader, %w", err)
 	}
-	if myHeader.Commit.GetHeight() != myHeader.Header.Height {
-		return fmt.Errorf("VerifyCosmosHeader, commit height is not right! commit height: %d, "+
-			"header height: %d", myHeader.Commit.GetHeight(), myHeader.Header.Height)
-	}
-	if !bytes.Equal(myHeader.Commit.BlockID.Hash, myHeader.Header.Hash()) {
-		return fmt.Errorf("VerifyCosmosHeader, commit hash is not right!, commit block hash: %s,"+
-			" header hash: %s", myHeader.Commit.BlockID.Hash.String(), hex.EncodeToString(valset.Hash()))
-	}
-	if err := myHeader.Commit.ValidateBasic(); err != nil {
-		return fmt.Errorf("VerifyCosmosHeader, commit is not right! err: %s", err.Error())
-	}
-	if valset.Size() != len(myHeader.Commit.Signatures) {
-		return fmt.Errorf("VerifyCosmosHeader, the size of precommits is not right!")
-	}
-	talliedVotingPower := int64(0)
-	for idx, commitSig := range myHeader.Commit.Signatures {
-		if commitSig.Absent() {
-			continue // OK, some precommits can be missing.
-		}
-		_, val := valset.GetByIndex(idx)
-		// Validate signature.
-		precommitSignBytes := myHeader.Commit.VoteSignBytes(info.ChainID, idx)
-		if !val.PubKey.VerifyBytes(precommitSignBytes, commitSig.Signature) {
-			return fmt.Errorf("VerifyCosmosHeader, Invalid commit -- invalid signature: %v", commitSig)
-		}
-		// Good precommit!
-		if myHeader.Commit.BlockID.Equals(commitSig.BlockID(myHeader.Commit.BlockID)) {
-			talliedVotingPower += val.VotingPower
-		}
-	}
-	if talliedVotingPower <= valset.TotalVotingPower()*2/3 {
-		return fmt.Errorf("VerifyCosmosHeader, voteing power is not enough!")
-	}
-
 	return nil
 }
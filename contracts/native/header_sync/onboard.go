@@ -0,0 +1,112 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package header_sync
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// OnboardPayloads are the calldata of the side chain onboarding, the registration is sent by the
+// owner, and the genesis header sync is signed by the validators.
+type OnboardPayloads struct {
+	Register []byte
+	Genesis  []byte
+}
+
+// PackOnboardPayloads packs the calldata of `registerSideChain` and `syncGenesisHeader`.
+func PackOnboardPayloads(register *side_chain_manager.RegisterSideChainParam, genesis []byte) (*OnboardPayloads, error) {
+	reg, err := utils.PackMethodWithStruct(side_chain_manager.ABI, side_chain_manager.MethodRegisterSideChain, register)
+	if err != nil {
+		return nil, fmt.Errorf("pack registerSideChain error: %v", err)
+	}
+	gen, err := utils.PackMethodWithStruct(hscommon.ABI, hscommon.MethodSyncGenesisHeader,
+		&hscommon.SyncGenesisHeaderParam{ChainID: register.ChainId, GenesisHeader: genesis})
+	if err != nil {
+		return nil, fmt.Errorf("pack syncGenesisHeader error: %v", err)
+	}
+	return &OnboardPayloads{Register: reg, Genesis: gen}, nil
+}
+
+// SimulateOnboarding runs the whole onboarding of a side chain against the given state: the
+// registration by the owner, the approval and the genesis header sync signed by the validators of
+// current epoch in order until the quorum is reached. the error of the first failing step is
+// returned. the state is modified, so the caller should pass in a copy.
+func SimulateOnboarding(db *state.StateDB, blockHeight *big.Int, register *side_chain_manager.RegisterSideChainParam, genesis []byte) error {
+	payloads, err := PackOnboardPayloads(register, genesis)
+	if err != nil {
+		return err
+	}
+	call := func(sender, contract common.Address, payload []byte) (*native.NativeContract, error) {
+		ref := native.NewContractRef(db, sender, sender, blockHeight, common.EmptyHash, math.MaxUint64, nil)
+		_, _, err := ref.NativeCall(sender, contract, payload)
+		return native.NewNativeContract(db, ref), err
+	}
+
+	s, err := call(register.Address, utils.SideChainManagerContractAddress, payloads.Register)
+	if err != nil {
+		return fmt.Errorf("registerSideChain: %v", err)
+	}
+	epoch, err := node_manager.GetCurrentEpoch(s)
+	if err != nil {
+		return fmt.Errorf("get current epoch: %v", err)
+	}
+	validators := epoch.MemberList()
+
+	registered := false
+	for _, v := range validators {
+		approve, err := utils.PackMethod(side_chain_manager.ABI, side_chain_manager.MethodApproveRegisterSideChain, register.ChainId, v)
+		if err != nil {
+			return fmt.Errorf("pack approveRegisterSideChain error: %v", err)
+		}
+		if s, err = call(v, utils.SideChainManagerContractAddress, approve); err != nil {
+			return fmt.Errorf("approveRegisterSideChain by %s: %v", v.Hex(), err)
+		}
+		if sideChain, err := side_chain_manager.GetSideChain(s, register.ChainId); err != nil {
+			return fmt.Errorf("approveRegisterSideChain, GetSideChain error: %v", err)
+		} else if sideChain != nil {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		return fmt.Errorf("approveRegisterSideChain, quorum not reached by %d validators", len(validators))
+	}
+
+	key := utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(hscommon.GENESIS_HEADER), utils.GetUint64Bytes(register.ChainId))
+	for _, v := range validators {
+		if s, err = call(v, utils.HeaderSyncContractAddress, payloads.Genesis); err != nil {
+			return fmt.Errorf("syncGenesisHeader by %s: %v", v.Hex(), err)
+		}
+		if stored, err := s.GetCacheDB().Get(key); err != nil {
+			return fmt.Errorf("syncGenesisHeader, get genesis header error: %v", err)
+		} else if stored != nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("syncGenesisHeader, quorum not reached by %d validators", len(validators))
+}
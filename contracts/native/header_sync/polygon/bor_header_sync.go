@@ -134,7 +134,7 @@ func getGenesis(native *native.NativeContract, chainID uint64) (genesisHeader *H
 		return
 	}
 
-	genesisBytes, err = cstates.GetValueFromRawStorageItem(genesisBytes)
+	genesisBytes, err = scom.GetHeaderFromStorageItem(genesisBytes)
 	if err != nil {
 		err = fmt.Errorf("getGenesis, GetValueFromRawStorageItem err:%v", err)
 		return
@@ -161,7 +161,7 @@ func storeGenesis(native *native.NativeContract, params *scom.SyncGenesisHeaderP
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.GENESIS_HEADER), utils.GetUint64Bytes(params.ChainID)),
-		cstates.GenRawStorageItem(genesisBytes))
+		scom.GenHeaderStorageItem(native, genesisBytes))
 
 	headerWithSum := &HeaderWithDifficultySum{HeaderWithOptionalSnap: genesisHeader, DifficultySum: genesisHeader.Header.Difficulty}
 
@@ -203,14 +203,12 @@ type HeaderWithOptionalProof struct {
 }
 
 // SyncBlockHeader ...
-func (h *BorHandler) SyncBlockHeader(native *native.NativeContract) error {
-	headerParams := &scom.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, headerParams, ctx.Payload); err != nil {
-			return err
-		}
+func (h *BorHandler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	headerParams, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer headerParams.Annotate(&err)
 
 	side, err := side_chain_manager.GetSideChain(native, headerParams.ChainID)
 	if err != nil {
@@ -224,7 +222,8 @@ func (h *BorHandler) SyncBlockHeader(native *native.NativeContract) error {
 
 	ctx := &Context{ExtraInfo: extraInfo, ChainID: headerParams.ChainID, Cdc: polygonTypes.NewCDC()}
 
-	for _, v := range headerParams.Headers {
+	for headerParams.Next() {
+		v := headerParams.Header()
 		var headerWOP HeaderWithOptionalProof
 		err := json.Unmarshal(v, &headerWOP)
 		if err != nil {
@@ -262,6 +261,9 @@ func (h *BorHandler) SyncBlockHeader(native *native.NativeContract) error {
 
 		scom.NotifyPutHeader(native, headerParams.ChainID, headerWOP.Header.Number.Uint64(), headerWOP.Header.Hash().Hex())
 	}
+	if err := headerParams.Err(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -347,7 +349,7 @@ func putHeaderWithSum(native *native.NativeContract, chainID uint64, headerWithS
 
 	native.GetCacheDB().Put(
 		utils.ConcatKey(utils.HeaderSyncContractAddress, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), headerWithSum.HeaderWithOptionalSnap.Header.Hash().Bytes()),
-		cstates.GenRawStorageItem(headerBytes))
+		scom.GenHeaderStorageItem(native, headerBytes))
 	return
 }
 
@@ -450,7 +452,7 @@ func getHeader(native *native.NativeContract, hash ecommon.Hash, chainID uint64)
 	if headerStore == nil {
 		return nil, fmt.Errorf("bor Handler getHeader, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, fmt.Errorf("bor Handler getHeader, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -478,8 +480,9 @@ func verifyHeader(native *native.NativeContract, headerWOP *HeaderWithOptionalPr
 		return
 	}
 	number := header.Number.Uint64()
-	// Don't waste time checking blocks from the future
-	if header.Time > uint64(time.Now().Unix()) {
+	// Don't waste time checking blocks from the future, the validators execute the block after
+	// the proposer, so the header accepted by the proposer is rejected only by a lagging clock.
+	if header.Time > uint64(time.Now().Unix()) { // nativecheck:ignore future headers are bounded by wall clock
 		err = errors.New("block in the future")
 		return
 	}
@@ -85,14 +85,12 @@ func (h *HeimdallHandler) SyncGenesisHeader(native *native.NativeContract) (err
 	return nil
 }
 
-func (h *HeimdallHandler) SyncBlockHeader(native *native.NativeContract) error {
-	params := &hscommon.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(hscommon.ABI, hscommon.MethodSyncBlockHeader, params, ctx.Payload); err != nil {
-			return err
-		}
+func (h *HeimdallHandler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	params, err := hscommon.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer params.Annotate(&err)
 
 	cdc := polygonTypes.NewCDC()
 	cnt := 0
@@ -100,7 +98,8 @@ func (h *HeimdallHandler) SyncBlockHeader(native *native.NativeContract) error {
 	if err != nil {
 		return fmt.Errorf("SyncBlockHeader, get epoch switching height failed: %v", err)
 	}
-	for _, v := range params.Headers {
+	for params.Next() {
+		v := params.Header()
 		var myHeader CosmosHeader
 		err := cdc.UnmarshalBinaryBare(v, &myHeader)
 		if err != nil {
@@ -122,6 +121,9 @@ func (h *HeimdallHandler) SyncBlockHeader(native *native.NativeContract) error {
 		info.BlockHash = myHeader.Header.Hash()
 		cnt++
 	}
+	if err := params.Err(); err != nil {
+		return err
+	}
 	if cnt == 0 {
 		return fmt.Errorf("no header you commited is useful")
 	}
@@ -62,14 +62,12 @@ func (h *QuorumHandler) SyncGenesisHeader(ns *native.NativeContract) error {
 	return nil
 }
 
-func (h *QuorumHandler) SyncBlockHeader(ns *native.NativeContract) error {
-	params := &common.SyncBlockHeaderParam{}
-	{
-		ctx := ns.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(common.ABI, common.MethodSyncBlockHeader, params, ctx.Payload); err != nil {
-			return err
-		}
+func (h *QuorumHandler) SyncBlockHeader(ns *native.NativeContract) (err error) {
+	params, err := common.NewHeaderStream(ns.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer params.Annotate(&err)
 
 	currh, err := GetCurrentValHeight(ns, params.ChainID)
 	if err != nil {
@@ -80,7 +78,8 @@ func (h *QuorumHandler) SyncBlockHeader(ns *native.NativeContract) error {
 		return fmt.Errorf("QuorumHandler SyncBlockHeader, failed to get validators: %v", err)
 	}
 	header := &types.Header{}
-	for i, v := range params.Headers {
+	for params.Next() {
+		i, v := params.Index(), params.Header()
 		if err := json.Unmarshal(v, header); err != nil {
 			return fmt.Errorf("QuorumHandler SyncBlockHeader, deserialize No.%d header err: %v", i, err)
 		}
@@ -96,6 +95,9 @@ func (h *QuorumHandler) SyncBlockHeader(ns *native.NativeContract) error {
 
 		currh, vs = h, extra.Validators
 	}
+	if err := params.Err(); err != nil {
+		return err
+	}
 
 	putValSet(ns, params.ChainID, currh, vs)
 	return nil
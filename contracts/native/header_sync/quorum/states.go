@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"math"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native/header_sync/eth/types"
@@ -175,4 +174,4 @@ func (vs QuorumValSet) JustOneChanged(another QuorumValSet) error {
 	return nil
 }
 
-func (vs QuorumValSet) F() int { return int(math.Ceil(float64(len(vs))/3)) - 1 }
+func (vs QuorumValSet) F() int { return (len(vs)+2)/3 - 1 }
@@ -0,0 +1,42 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package header_sync
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// GetSyncStatus reads the latest header sync status and the canonical header height of the
+// side chain from the given state, the status is nil if no header of the chain has been synced.
+func GetSyncStatus(db *state.StateDB, chainID uint64) (*hscommon.SyncStatus, uint64, error) {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big0, common.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	status, err := hscommon.GetSyncStatus(s, chainID)
+	if err != nil {
+		return nil, 0, err
+	}
+	canonical, err := hscommon.GetCanonicalHeight(s, chainID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return status, canonical, nil
+}
@@ -78,14 +78,12 @@ func (h *Handler) SyncGenesisHeader(native *native.NativeContract) (err error) {
 }
 
 // SyncBlockHeader ...
-func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
-	headerParams := &scom.SyncBlockHeaderParam{}
-	{
-		ctx := native.ContractRef().CurrentContext()
-		if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBlockHeader, headerParams, ctx.Payload); err != nil {
-			return err
-		}
+func (h *Handler) SyncBlockHeader(native *native.NativeContract) (err error) {
+	headerParams, err := scom.NewHeaderStream(native.ContractRef().CurrentContext().Payload)
+	if err != nil {
+		return err
 	}
+	defer headerParams.Annotate(&err)
 
 
 	side, err := side_chain_manager.GetSideChainApply(native, headerParams.ChainID)
@@ -104,7 +102,8 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 	}
 
 	// ...txblock1-1,txblock1-2...dsblock2,txblock2-1,txblock2-2...
-	for _, v := range headerParams.Headers {
+	for headerParams.Next() {
+		v := headerParams.Header()
 		var txBlockAndDsComm core.TxBlockOrDsBlock
 		err := json.Unmarshal(v, &txBlockAndDsComm)
 		if err != nil {
@@ -197,6 +196,9 @@ func (h *Handler) SyncBlockHeader(native *native.NativeContract) error {
 			AppendHeader2Main(native, txBlock.BlockHeader.BlockNum, txBlock.BlockHash[:], headerParams.ChainID)
 		}
 	}
+	if err := headerParams.Err(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -80,7 +80,7 @@ func GetTxHeaderByHash(native *native.NativeContract, hash []byte, chainID uint6
 	if headerStore == nil {
 		return nil, fmt.Errorf("GetTxHeaderByHash, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, fmt.Errorf("GetTxHeaderByHash, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -139,7 +139,7 @@ func putTxBlockHeader(native *native.NativeContract, txBlock *core.TxBlock, chai
 	storeBytes, _ := json.Marshal(txBlock)
 	hash := txBlock.BlockHash[:]
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), hash),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	scom.NotifyPutHeader(native, chainID, txBlock.BlockHeader.BlockNum, util.EncodeHex(hash))
 	return nil
 }
@@ -153,7 +153,7 @@ func GetDsHeaderByHash(native *native.NativeContract, hash []byte, chainID uint6
 	if headerStore == nil {
 		return nil, fmt.Errorf("GetDsHeaderByHash, can not find any header records")
 	}
-	storeBytes, err := cstates.GetValueFromRawStorageItem(headerStore)
+	storeBytes, err := scom.GetHeaderFromStorageItem(headerStore)
 	if err != nil {
 		return nil, fmt.Errorf("GetDsHeaderByHash, deserialize headerBytes from raw storage item err:%v", err)
 	}
@@ -169,7 +169,7 @@ func putDsBlockHeader(native *native.NativeContract, dsBlock *core.DsBlock, chai
 	storeBytes, _ := json.Marshal(dsBlock)
 	hash := dsBlock.BlockHash[:]
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), hash),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	scom.NotifyPutHeader(native, chainID, dsBlock.BlockHeader.BlockNum, util.EncodeHex(hash))
 	return nil
 }
@@ -181,9 +181,9 @@ func putGenesisBlockHeader(native *native.NativeContract, txBlockAndDsComm TxBlo
 	contract := utils.HeaderSyncContractAddress
 	storeBytes, _ := json.Marshal(&txBlockAndDsComm.TxBlock)
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.GENESIS_HEADER), utils.GetUint64Bytes(chainID)),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.HEADER_INDEX), utils.GetUint64Bytes(chainID), blockHash),
-		cstates.GenRawStorageItem(storeBytes))
+		scom.GenHeaderStorageItem(native, storeBytes))
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.MAIN_CHAIN), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(blockNum)),
 		cstates.GenRawStorageItem(blockHash))
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(scom.CURRENT_HEADER_HEIGHT),
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IAccessControl
+/// @notice interface of native contract `access_control` at 0x7d79D936DA7833c7fe056eB450064f34A327DcA8
+interface IAccessControl {
+    event roleGranted(address Contract, uint8 Role, address Account);
+    event roleRevoked(address Contract, uint8 Role, address Account);
+
+    /// @dev selector 0x61919bc1 `grantRole(address,uint8,address)`
+    function grantRole(address Contract, uint8 Role, address Account) external returns (bool Success);
+    /// @dev selector 0x67125a1b `hasRole(address,uint8,address)`
+    function hasRole(address Contract, uint8 Role, address Account) external view returns (bool Success);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0xdf30958a `revokeRole(address,uint8,address)`
+    function revokeRole(address Contract, uint8 Role, address Account) external returns (bool Success);
+    /// @dev selector 0xa6f6a87b `roleMembers(address,uint8)`
+    function roleMembers(address Contract, uint8 Role) external view returns (address[] memory Members);
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IAccounting
+/// @notice interface of native contract `accounting` at 0xC782D7244bdd2ebeb56ac87A65c4873B6c4D427D
+interface IAccounting {
+    event conservationViolated(address Asset, uint64 ChainID, uint8 Op, uint256 Amount, uint256 Locked, uint256 Minted);
+    event reconciled(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted);
+
+    /// @dev selector 0x26533220 `checkInvariant(address)`
+    function checkInvariant(address Asset) external view returns (bool Holds, uint256 Locked, uint256 Minted, uint64[] memory Violations);
+    /// @dev selector 0x0a074d4b `ledger(address,uint64)`
+    function ledger(address Asset, uint64 ChainID) external view returns (uint256 Locked, uint256 Minted);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0x0f8f37eb `reconcile(address,uint64,uint256,uint256)`
+    function reconcile(address Asset, uint64 ChainID, uint256 Locked, uint256 Minted) external returns (bool Success);
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IDataOracle
+/// @notice interface of native contract `data_oracle` at 0x4479AcbCeA458Badf21dbEC7Db6fC236Bf08fbb9
+interface IDataOracle {
+    event dataFinalized(string FeedID, uint64 Height, bytes Value);
+    event feedUpdated(string FeedID, bool Enabled);
+
+    /// @dev selector 0x8c79c48d `data(string,uint64)`
+    function data(string calldata FeedID, uint64 Height) external view returns (bytes memory Value);
+    /// @dev selector 0xe73ade8e `feed(string)`
+    function feed(string calldata FeedID) external view returns (bool Enabled, uint64 Latest);
+    /// @dev selector 0x95305680 `latest(string)`
+    function latest(string calldata FeedID) external view returns (uint64 Height, bytes memory Value);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0xbd9421c5 `setFeed(string,bool)`
+    function setFeed(string calldata FeedID, bool Enabled) external returns (bool Success);
+    /// @dev selector 0xe6013fe0 `submit(string,uint64,bytes)`
+    function submit(string calldata FeedID, uint64 Height, bytes calldata Value) external returns (bool Success);
+}
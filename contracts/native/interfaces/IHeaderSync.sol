@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IHeaderSync
+/// @notice interface of native contract `sync_header` at 0xb2799bDE6831449d73C1F22CE815f773D0CafCc5
+interface IHeaderSync {
+    event OKEpochSwitchInfoEvent(uint64 chainID, string BlockHash, uint64 Height, string NextValidatorsHash, string InfoChainID, uint64 BlockHeight);
+    event RebootstrapHeaderEvent(uint64 ChainID, uint64 Generation, uint256 BlockHeight);
+    event syncHeader(uint64 chainID, uint64 height, string blockHash, uint256 BlockHeight);
+
+    /// @dev selector 0x4e5703d8 `lightClientArchive(uint64,uint64)`
+    function lightClientArchive(uint64 ChainID, uint64 Generation) external view returns (uint64 Height, bytes memory Header, bytes memory Previous);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external returns (string memory Name);
+    /// @dev selector 0xadb46f23 `rebootstrapHeader(uint64,bytes)`
+    function rebootstrapHeader(uint64 ChainID, bytes calldata Header) external returns (bool success);
+    /// @dev selector 0x72ce6700 `syncBlockHeader(uint64,address,bytes[])`
+    function syncBlockHeader(uint64 ChainID, address Address, bytes[] calldata Headers) external returns (bool success);
+    /// @dev selector 0x21b5cff5 `syncCrossChainMsg(uint64,address,bytes[])`
+    function syncCrossChainMsg(uint64 ChainID, address Address, bytes[] calldata CrossChainMsgs) external returns (bool success);
+    /// @dev selector 0xb5ace618 `syncGenesisHeader(uint64,bytes)`
+    function syncGenesisHeader(uint64 ChainID, bytes calldata GenesisHeader) external returns (bool success);
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IMessageRouter
+/// @notice interface of native contract `message_router` at 0x2951b823F25344797D9294634F44e867490B86c9
+interface IMessageRouter {
+    event batchSent(address Sender, bytes32 BatchID);
+    event messageRouted(uint64 SourceChainID, bytes SourceApp, address Callback, bytes32 Hash, bool Success);
+    event messageSent(address Sender, uint64 ToChainID, bytes ToContract, uint64 Sequence);
+    event routeRegistered(uint64 SourceChainID, bytes SourceApp, address Owner, address Callback, uint64 GasLimit);
+    event routeRemoved(uint64 SourceChainID, bytes SourceApp);
+
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0x33bde539 `registerRoute(uint64,bytes,address,uint64)`
+    function registerRoute(uint64 SourceChainID, bytes calldata SourceApp, address Callback, uint64 GasLimit) external returns (bool Success);
+    /// @dev selector 0xfaf38193 `removeRoute(uint64,bytes)`
+    function removeRoute(uint64 SourceChainID, bytes calldata SourceApp) external returns (bool Success);
+    /// @dev selector 0xcd3e1266 `retryMessage(bytes32)`
+    function retryMessage(bytes32 Hash) external returns (bool Success);
+    /// @dev selector 0x438aa9fb `route(uint64,bytes)`
+    function route(uint64 SourceChainID, bytes calldata SourceApp) external view returns (address Owner, address Callback, uint64 GasLimit);
+    /// @dev selector 0x406cd78a `sendBatch(uint64[],bytes[],bytes[])`
+    function sendBatch(uint64[] calldata ToChainIDs, bytes[] calldata ToContracts, bytes[] calldata Messages) external returns (bytes32 BatchID);
+    /// @dev selector 0xb0be6151 `sendMessage(uint64,bytes,bytes)`
+    function sendMessage(uint64 ToChainID, bytes calldata ToContract, bytes calldata Message) external returns (uint64 Sequence);
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title INodeManager
+/// @notice interface of native contract `node_manager` at 0xA4Bf827047a08510722B2d62e668a72FCCFa232C
+interface INodeManager {
+    event actionsExecuted(uint64 EpochID, bytes Hash, uint64 Actions);
+    event consensusSigned(string Method, bytes Input, address Signer, uint64 Size);
+    event epochChangeSigned(uint64 EpochID, address Validator, uint64 SignedNumber);
+    event epochChanged(bytes Epoch, bytes NextEpoch);
+    event expirySwept(uint64 Height, uint64 Entries, uint64 Reclaimed);
+    event feeSplitChanged(uint64 BurnRate, uint64 TreasuryRate, address Treasury);
+    event groupEpochChanged(string Name, bytes Epoch, bytes NextEpoch);
+    event groupProposed(string Name, bytes Epoch);
+    event groupQuorumRuleChanged(string Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold);
+    event groupVoted(string Name, uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize);
+    event misconductRecorded(uint64 EpochID, address Validator, uint8 Kind);
+    event peersLimitChanged(uint64 Target, uint64 MaxChange);
+    event proposalRejected(uint64 EpochID, bytes Hash, uint64 Votes, bytes Winner);
+    event proposed(bytes Epoch);
+    event quorumRuleChanged(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold);
+    event validatorEjected(uint64 EpochID, address Validator, uint64 StartHeight);
+    event voteCommitted(uint64 EpochID, address Voter);
+    event voted(uint64 EpochID, bytes Hash, uint64 VotedNumber, uint64 GroupSize);
+    event votingSealed(uint64 EpochID, uint64 CommitEnd, uint64 RevealEnd);
+    event votingTallied(uint64 EpochID, bytes Winner);
+    event vrfSubmitted(uint64 EpochID, address Validator, bytes Output);
+
+    /// @dev selector 0x4486bc09 `commitVote(uint64,bytes)`
+    function commitVote(uint64 EpochID, bytes calldata Commitment) external returns (bool Success);
+    /// @dev selector 0xf559ab66 `eject(address,bytes)`
+    function eject(address Validator, bytes calldata Evidence) external returns (bool Success);
+    /// @dev selector 0x900cf0cf `epoch()`
+    function epoch() external view returns (bytes memory Epoch);
+    /// @dev selector 0x7429700c `epochChangeSigns(uint64)`
+    function epochChangeSigns(uint64 EpochID) external view returns (bytes memory Digest, bytes[] memory Signatures);
+    /// @dev selector 0x01eaa31a `epochPeers(bytes,uint64,uint64)`
+    function epochPeers(bytes calldata Hash, uint64 Start, uint64 Limit) external view returns (uint64 Total, bytes memory Peers);
+    /// @dev selector 0xb3564b8b `epochSeed(uint64)`
+    function epochSeed(uint64 EpochID) external view returns (bytes memory Seed);
+    /// @dev selector 0x6373ea69 `feeSplit()`
+    function feeSplit() external view returns (uint64 BurnRate, uint64 TreasuryRate, address Treasury);
+    /// @dev selector 0x76bc595a `groupEpoch(string)`
+    function groupEpoch(string calldata Name) external view returns (bytes memory Epoch);
+    /// @dev selector 0x2883bb2f `groupProof(string,uint64)`
+    function groupProof(string calldata Name, uint64 EpochID) external view returns (bytes memory Hash);
+    /// @dev selector 0x544d9f1f `groupQuorumRule(string)`
+    function groupQuorumRule(string calldata Name) external view returns (uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold);
+    /// @dev selector 0x5bf89d9e `groups()`
+    function groups() external view returns (string[] memory Names);
+    /// @dev selector 0x1ff88604 `misconduct(address,uint64,uint64)`
+    function misconduct(address Validator, uint64 StartEpoch, uint64 EndEpoch) external view returns (bytes memory Reports);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0xaea0e78b `nextEpoch()`
+    function nextEpoch() external view returns (bytes memory Epoch);
+    /// @dev selector 0xd592c8e0 `payouts(address,uint64,uint64)`
+    function payouts(address Validator, uint64 StartEpoch, uint64 EndEpoch) external view returns (bytes memory Payouts);
+    /// @dev selector 0xfb11136e `peersLimit()`
+    function peersLimit() external view returns (uint64 Target, uint64 MaxChange);
+    /// @dev selector 0xfaf924cf `proof()`
+    function proof() external view returns (bytes memory Hash);
+    /// @dev selector 0x6215af84 `proposalActions(bytes)`
+    function proposalActions(bytes calldata Hash) external view returns (bytes[] memory Actions);
+    /// @dev selector 0x31c5eec8 `proposals(uint64)`
+    function proposals(uint64 EpochID) external view returns (bytes memory Proposals);
+    /// @dev selector 0xbcc12328 `propose(uint64,bytes)`
+    function propose(uint64 StartHeight, bytes calldata Peers) external returns (bool Success);
+    /// @dev selector 0xbb56c490 `proposeGroup(string,uint64,bytes)`
+    function proposeGroup(string calldata Name, uint64 StartHeight, bytes calldata Peers) external returns (bool Success);
+    /// @dev selector 0xe24a4e3c `proposeWithActions(uint64,bytes,bytes[])`
+    function proposeWithActions(uint64 StartHeight, bytes calldata Peers, bytes[] calldata Actions) external returns (bool Success);
+    /// @dev selector 0x5cbcfeaa `quorumRule()`
+    function quorumRule() external view returns (uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold);
+    /// @dev selector 0x44cce719 `registerVrfKey(bytes,bytes,bytes)`
+    function registerVrfKey(bytes calldata PubKey, bytes calldata Output, bytes calldata Proof) external returns (bool Success);
+    /// @dev selector 0xc7c6efc4 `revealVote(uint64,bytes,bytes)`
+    function revealVote(uint64 EpochID, bytes calldata Hash, bytes calldata Salt) external returns (bool Success);
+    /// @dev selector 0x44026376 `sealVoting(uint64,uint64,uint64)`
+    function sealVoting(uint64 EpochID, uint64 CommitPeriod, uint64 RevealPeriod) external returns (bool Success);
+    /// @dev selector 0x7d94792a `seed()`
+    function seed() external view returns (bytes memory Seed);
+    /// @dev selector 0x4a105a4f `setFeeSplit(uint64,uint64,address)`
+    function setFeeSplit(uint64 BurnRate, uint64 TreasuryRate, address Treasury) external returns (bool Success);
+    /// @dev selector 0x8be10a03 `setGroupQuorumRule(string,uint64,uint64,bool,uint64)`
+    function setGroupQuorumRule(string calldata Name, uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) external returns (bool Success);
+    /// @dev selector 0xe950b066 `setPeersLimit(uint64,uint64)`
+    function setPeersLimit(uint64 Target, uint64 MaxChange) external returns (bool Success);
+    /// @dev selector 0x080d640a `setQuorumRule(uint64,uint64,bool,uint64)`
+    function setQuorumRule(uint64 Numerator, uint64 Denominator, bool Strict, uint64 Threshold) external returns (bool Success);
+    /// @dev selector 0xa722a953 `signEpochChange(uint64,bytes)`
+    function signEpochChange(uint64 EpochID, bytes calldata Signature) external returns (bool Success);
+    /// @dev selector 0x05f18c70 `submitVrf(uint64,bytes,bytes)`
+    function submitVrf(uint64 EpochID, bytes calldata Output, bytes calldata Proof) external returns (bool Success);
+    /// @dev selector 0x223b97c6 `sweepExpired(uint64)`
+    function sweepExpired(uint64 Height) external returns (bool Success);
+    /// @dev selector 0x08c16dbb `vote(uint64,bytes)`
+    function vote(uint64 EpochID, bytes calldata Hash) external returns (bool Success);
+    /// @dev selector 0x097c0ea6 `voteGroup(string,uint64,bytes)`
+    function voteGroup(string calldata Name, uint64 EpochID, bytes calldata Hash) external returns (bool Success);
+    /// @dev selector 0x37b927a6 `votingSeal(uint64)`
+    function votingSeal(uint64 EpochID) external view returns (uint64 CommitEnd, uint64 RevealEnd, bool Tallied);
+    /// @dev selector 0x4123453e `vrfKey(address)`
+    function vrfKey(address Validator) external view returns (bytes memory PubKey);
+    /// @dev selector 0xbfb9b84d `vrfOutput(uint64,address)`
+    function vrfOutput(uint64 EpochID, address Validator) external view returns (bytes memory Output);
+}
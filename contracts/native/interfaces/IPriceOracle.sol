@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IPriceOracle
+/// @notice interface of native contract `price_oracle` at 0x0F257CD338Fa8F1Af3D31b16C1fBddae2Dc96D41
+interface IPriceOracle {
+    event feedUpdated(string FeedID, uint64 MaxAge, uint64 MaxDeviation);
+    event priceUpdated(string FeedID, uint64 Round, uint256 Price);
+
+    /// @dev selector 0x1181dfc5 `convert(string,uint256)`
+    function convert(string calldata FeedID, uint256 Amount) external view returns (uint256 Value);
+    /// @dev selector 0xe73ade8e `feed(string)`
+    function feed(string calldata FeedID) external view returns (uint64 MaxAge, uint64 MaxDeviation);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0xfe2c6198 `price(string)`
+    function price(string calldata FeedID) external view returns (uint64 Round, uint256 Price, uint64 Height);
+    /// @dev selector 0xff5528bf `setFeed(string,uint64,uint64)`
+    function setFeed(string calldata FeedID, uint64 MaxAge, uint64 MaxDeviation) external returns (bool Success);
+    /// @dev selector 0x41d7b3fe `submitPrice(string,uint64,uint256)`
+    function submitPrice(string calldata FeedID, uint64 Round, uint256 Price) external returns (bool Success);
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IRelayerManager
+/// @notice interface of native contract `relayer_manager` at 0xA22f301D7Cb5b50dcA4a015b12EC0cc5f3971412
+interface IRelayerManager {
+    event evtApproveRegisterRelayer(uint64 ID);
+    event evtApproveRemoveRelayer(uint64 ID);
+    event evtRegisterRelayer(uint64 applyID);
+    event evtRemoveRelayer(uint64 removeID);
+
+    /// @dev selector 0x07b8ca31 `approveRegisterRelayer(uint64,address)`
+    function approveRegisterRelayer(uint64 ID, address Address) external returns (bool success);
+    /// @dev selector 0x2b1775dd `approveRemoveRelayer(uint64,address)`
+    function approveRemoveRelayer(uint64 ID, address Address) external returns (bool success);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external returns (string memory Name);
+    /// @dev selector 0xd99802fe `registerRelayer(address[],address)`
+    function registerRelayer(address[] calldata AddressList, address Address) external returns (bool success);
+    /// @dev selector 0x0cffb52a `removeRelayer(address[],address)`
+    function removeRelayer(address[] calldata AddressList, address Address) external returns (bool success);
+}
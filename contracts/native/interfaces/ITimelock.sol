@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title ITimelock
+/// @notice interface of native contract `timelock` at 0xD37F626c9E007DdD244E5Cbee0C223fec6D11289
+interface ITimelock {
+    event canceled(uint64 ID);
+    event delayChanged(uint64 Delay);
+    event executed(uint64 ID);
+    event scheduled(uint64 ID, address Target, uint64 Eta);
+
+    /// @dev selector 0xf5276cd5 `action(uint64)`
+    function action(uint64 ID) external view returns (address Target, bytes memory Payload, uint64 Eta, uint8 Status);
+    /// @dev selector 0x4c125e79 `cancel(uint64)`
+    function cancel(uint64 ID) external returns (bool Success);
+    /// @dev selector 0x60911aa5 `changeDelay(uint64)`
+    function changeDelay(uint64 Delay) external returns (bool Success);
+    /// @dev selector 0x6a42b8f8 `delay()`
+    function delay() external view returns (uint64 Delay);
+    /// @dev selector 0xb590be77 `execute(uint64)`
+    function execute(uint64 ID) external returns (bool Success);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0xdb26e34d `schedule(bytes)`
+    function schedule(bytes calldata Payload) external returns (uint64 ID);
+}
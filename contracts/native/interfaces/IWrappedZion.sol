@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: LGPL-3.0
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+/// @title IWrappedZion
+/// @notice interface of native contract `wzion` at 0x20B019ea369923eF1971A30f1974003051f1863C
+interface IWrappedZion {
+    event deposited(address Account, uint256 Amount);
+    event locked(address From, uint64 ToChainID, bytes ToAddress, uint256 Amount);
+    event proxyBound(uint64 ChainID, bytes Proxy, bytes Asset);
+    event transferred(address From, address To, uint256 Amount);
+    event unlocked(uint64 FromChainID, address To, uint256 Amount);
+    event withdrawn(address Account, uint256 Amount);
+
+    /// @dev selector 0x70a08231 `balanceOf(address)`
+    function balanceOf(address Account) external view returns (uint256 Amount);
+    /// @dev selector 0xd30542a1 `bindProxy(uint64,bytes,bytes)`
+    function bindProxy(uint64 ChainID, bytes calldata Proxy, bytes calldata Asset) external returns (bool Success);
+    /// @dev selector 0x17f936c6 `bridged(uint64)`
+    function bridged(uint64 ChainID) external view returns (uint256 Amount);
+    /// @dev selector 0x313ce567 `decimals()`
+    function decimals() external view returns (uint8 Decimals);
+    /// @dev selector 0xd0e30db0 `deposit()`
+    function deposit() external payable returns (bool Success);
+    /// @dev selector 0xcccf1562 `lock(uint64,bytes,uint256)`
+    function lock(uint64 ToChainID, bytes calldata ToAddress, uint256 Amount) external returns (bool Success);
+    /// @dev selector 0x06fdde03 `name()`
+    function name() external view returns (string memory Name);
+    /// @dev selector 0xe47d9dd3 `proxy(uint64)`
+    function proxy(uint64 ChainID) external view returns (bytes memory Proxy, bytes memory Asset);
+    /// @dev selector 0x95d89b41 `symbol()`
+    function symbol() external view returns (string memory Symbol);
+    /// @dev selector 0x18160ddd `totalSupply()`
+    function totalSupply() external view returns (uint256 Amount);
+    /// @dev selector 0xa9059cbb `transfer(address,uint256)`
+    function transfer(address To, uint256 Amount) external returns (bool Success);
+    /// @dev selector 0x2e1a7d4d `withdraw(uint256)`
+    function withdraw(uint256 Amount) external returns (bool Success);
+}
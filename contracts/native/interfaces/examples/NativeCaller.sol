@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: LGPL-3.0
+
+pragma solidity >=0.6.0 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+import "../INodeManager.sol";
+import "../ICrossChainManager.sol";
+import "../ISideChainManager.sol";
+
+/// @title NativeCaller
+/// @notice shows how an evm contract calls the native contracts through the canonical interfaces.
+contract NativeCaller {
+    INodeManager public constant nodeManager = INodeManager(0xA4Bf827047a08510722B2d62e668a72FCCFa232C);
+    ICrossChainManager public constant crossChainManager = ICrossChainManager(0x5747C05FF236F8d18BB21Bc02ecc389deF853cae);
+    ISideChainManager public constant sideChainManager = ISideChainManager(0x864Ff06eC5fFc75aB6eaf64263308ef5fa7d6637);
+
+    /// @notice rlp encoded current epoch info
+    function currentEpoch() external view returns (bytes memory) {
+        return nodeManager.epoch();
+    }
+
+    /// @notice hash of the current epoch info
+    function currentEpochProof() external view returns (bytes memory) {
+        return nodeManager.proof();
+    }
+
+    /// @notice names of the native contracts, in the order of node manager, cross chain manager and side chain manager
+    function names() external returns (string memory, string memory, string memory) {
+        return (nodeManager.name(), crossChainManager.name(), sideChainManager.name());
+    }
+
+    /// @notice forwards the proposal to node manager, which only accepts the transaction sent by validators directly,
+    /// so it always fails when called through a contract.
+    function propose(uint64 startHeight, bytes calldata peers) external returns (bool) {
+        return nodeManager.propose(startHeight, peers);
+    }
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package examples contains evm contracts which call the native contracts through the
+// generated solidity interfaces, together with their go bindings. the contracts are
+// compiled for istanbul, and the native contract interfaces are imported from the parent
+// directory.
+package examples
+
+//go:generate sh -c "solc --evm-version istanbul --optimize --allow-paths .. --combined-json abi,bin,hashes NativeCaller.sol | abigen --combined-json /dev/stdin --pkg examples --out native_caller.go"
@@ -0,0 +1,397 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package examples
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// NativeCallerABI is the input ABI used to generate the binding from.
+const NativeCallerABI = "[{\"inputs\":[],\"name\":\"crossChainManager\",\"outputs\":[{\"internalType\":\"contractICrossChainManager\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currentEpoch\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currentEpochProof\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"names\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nodeManager\",\"outputs\":[{\"internalType\":\"contractINodeManager\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"startHeight\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"peers\",\"type\":\"bytes\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sideChainManager\",\"outputs\":[{\"internalType\":\"contractISideChainManager\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// NativeCallerFuncSigs maps the 4-byte function signature to its string representation.
+var NativeCallerFuncSigs = map[string]string{
+	"cf5baffa": "crossChainManager()",
+	"76671808": "currentEpoch()",
+	"1269acab": "currentEpochProof()",
+	"056da048": "names()",
+	"9bb5cd3f": "nodeManager()",
+	"bcc12328": "propose(uint64,bytes)",
+	"489fa562": "sideChainManager()",
+}
+
+// NativeCallerBin is the compiled bytecode used for deploying new contracts.
+var NativeCallerBin = "0x608060405234801561001057600080fd5b506106cf806100206000396000f3fe608060405234801561001057600080fd5b506004361061007d5760003560e01c8063766718081161005b57806376671808146100ea5780639bb5cd3f146100f2578063bcc123281461010d578063cf5baffa1461013057600080fd5b8063056da048146100825780631269acab146100a2578063489fa562146100b7575b600080fd5b61008a61014b565b6040516100999392919061047b565b60405180910390f35b6100aa6102cd565b60405161009991906104be565b6100d273864ff06ec5ffc75ab6eaf64263308ef5fa7d663781565b6040516001600160a01b039091168152602001610099565b6100aa61034e565b6100d273a4bf827047a08510722b2d62e668a72fccfa232c81565b61012061011b3660046104d8565b6103a2565b6040519015158152602001610099565b6100d2735747c05ff236f8d18bb21bc02ecc389def853cae81565b606080606073a4bf827047a08510722b2d62e668a72fccfa232c6001600160a01b03166306fdde036040518163ffffffff1660e01b8152600401600060405180830381865afa1580156101a2573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526101ca91908101906105ee565b735747c05ff236f8d18bb21bc02ecc389def853cae6001600160a01b03166306fdde036040518163ffffffff1660e01b81526004016000604051808303816000875af115801561021e573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261024691908101906105ee565b73864ff06ec5ffc75ab6eaf64263308ef5fa7d66376001600160a01b03166306fdde036040518163ffffffff1660e01b81526004016000604051808303816000875af115801561029a573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526102c291908101906105ee565b925092509250909192565b606073a4bf827047a08510722b2d62e668a72fccfa232c6001600160a01b031663faf924cf6040518163ffffffff1660e01b8152600401600060405180830381865afa158015610321573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261034991908101906105ee565b905090565b606073a4bf827047a08510722b2d62e668a72fccfa232c6001600160a01b031663900cf0cf6040518163ffffffff1660e01b8152600401600060405180830381865afa158015610321573d6000803e3d6000fd5b604051631798246560e31b815260009073a4bf827047a08510722b2d62e668a72fccfa232c9063bcc12328906103e090879087908790600401610637565b6020604051808303816000875af11580156103ff573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104239190610677565b949350505050565b60005b8381101561044657818101518382015260200161042e565b50506000910152565b6000815180845261046781602086016020860161042b565b601f01601f19169290920160200192915050565b60608152600061048e606083018661044f565b82810360208401526104a0818661044f565b905082810360408401526104b4818561044f565b9695505050505050565b6020815260006104d1602083018461044f565b9392505050565b6000806000604084860312156104ed57600080fd5b833567ffffffffffffffff808216821461050657600080fd5b9093506020850135908082111561051c57600080fd5b818601915086601f83011261053057600080fd5b81358181111561053f57600080fd5b87602082850101111561055157600080fd5b6020830194508093505050509250925092565b634e487b7160e01b600052604160045260246000fd5b600067ffffffffffffffff8084111561059557610595610564565b604051601f8501601f19908116603f011681019082821181831017156105bd576105bd610564565b816040528093508581528686860111156105d657600080fd5b6105e486602083018761042b565b5050509392505050565b60006020828403121561060057600080fd5b815167ffffffffffffffff81111561061757600080fd5b8201601f8101841361062857600080fd5b6104238482516020840161057a565b67ffffffffffffffff8416815260406020820152816040820152818360608301376000818301606090810191909152601f909201601f1916010192915050565b60006020828403121561068957600080fd5b815180151581146104d157600080fdfea2646970667358221220fcb042b4a629f7ca737d8873aaf86a75febbabaaaea3694ec33a66a26e332ba264736f6c63430008150033"
+
+// DeployNativeCaller deploys a new Ethereum contract, binding an instance of NativeCaller to it.
+func DeployNativeCaller(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *NativeCaller, error) {
+	parsed, err := abi.JSON(strings.NewReader(NativeCallerABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(NativeCallerBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &NativeCaller{NativeCallerCaller: NativeCallerCaller{contract: contract}, NativeCallerTransactor: NativeCallerTransactor{contract: contract}, NativeCallerFilterer: NativeCallerFilterer{contract: contract}}, nil
+}
+
+// NativeCaller is an auto generated Go binding around an Ethereum contract.
+type NativeCaller struct {
+	NativeCallerCaller     // Read-only binding to the contract
+	NativeCallerTransactor // Write-only binding to the contract
+	NativeCallerFilterer   // Log filterer for contract events
+}
+
+// NativeCallerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NativeCallerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeCallerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NativeCallerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeCallerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NativeCallerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeCallerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NativeCallerSession struct {
+	Contract     *NativeCaller     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NativeCallerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NativeCallerCallerSession struct {
+	Contract *NativeCallerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// NativeCallerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NativeCallerTransactorSession struct {
+	Contract     *NativeCallerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// NativeCallerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NativeCallerRaw struct {
+	Contract *NativeCaller // Generic contract binding to access the raw methods on
+}
+
+// NativeCallerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NativeCallerCallerRaw struct {
+	Contract *NativeCallerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NativeCallerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NativeCallerTransactorRaw struct {
+	Contract *NativeCallerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNativeCaller creates a new instance of NativeCaller, bound to a specific deployed contract.
+func NewNativeCaller(address common.Address, backend bind.ContractBackend) (*NativeCaller, error) {
+	contract, err := bindNativeCaller(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeCaller{NativeCallerCaller: NativeCallerCaller{contract: contract}, NativeCallerTransactor: NativeCallerTransactor{contract: contract}, NativeCallerFilterer: NativeCallerFilterer{contract: contract}}, nil
+}
+
+// NewNativeCallerCaller creates a new read-only instance of NativeCaller, bound to a specific deployed contract.
+func NewNativeCallerCaller(address common.Address, caller bind.ContractCaller) (*NativeCallerCaller, error) {
+	contract, err := bindNativeCaller(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeCallerCaller{contract: contract}, nil
+}
+
+// NewNativeCallerTransactor creates a new write-only instance of NativeCaller, bound to a specific deployed contract.
+func NewNativeCallerTransactor(address common.Address, transactor bind.ContractTransactor) (*NativeCallerTransactor, error) {
+	contract, err := bindNativeCaller(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeCallerTransactor{contract: contract}, nil
+}
+
+// NewNativeCallerFilterer creates a new log filterer instance of NativeCaller, bound to a specific deployed contract.
+func NewNativeCallerFilterer(address common.Address, filterer bind.ContractFilterer) (*NativeCallerFilterer, error) {
+	contract, err := bindNativeCaller(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeCallerFilterer{contract: contract}, nil
+}
+
+// bindNativeCaller binds a generic wrapper to an already deployed contract.
+func bindNativeCaller(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(NativeCallerABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NativeCaller *NativeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NativeCaller.Contract.NativeCallerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NativeCaller *NativeCallerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeCaller.Contract.NativeCallerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NativeCaller *NativeCallerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NativeCaller.Contract.NativeCallerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NativeCaller *NativeCallerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NativeCaller.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NativeCaller *NativeCallerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeCaller.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NativeCaller *NativeCallerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NativeCaller.Contract.contract.Transact(opts, method, params...)
+}
+
+// CrossChainManager is a free data retrieval call binding the contract method 0xcf5baffa.
+//
+// Solidity: function crossChainManager() view returns(address)
+func (_NativeCaller *NativeCallerCaller) CrossChainManager(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NativeCaller.contract.Call(opts, &out, "crossChainManager")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CrossChainManager is a free data retrieval call binding the contract method 0xcf5baffa.
+//
+// Solidity: function crossChainManager() view returns(address)
+func (_NativeCaller *NativeCallerSession) CrossChainManager() (common.Address, error) {
+	return _NativeCaller.Contract.CrossChainManager(&_NativeCaller.CallOpts)
+}
+
+// CrossChainManager is a free data retrieval call binding the contract method 0xcf5baffa.
+//
+// Solidity: function crossChainManager() view returns(address)
+func (_NativeCaller *NativeCallerCallerSession) CrossChainManager() (common.Address, error) {
+	return _NativeCaller.Contract.CrossChainManager(&_NativeCaller.CallOpts)
+}
+
+// CurrentEpoch is a free data retrieval call binding the contract method 0x76671808.
+//
+// Solidity: function currentEpoch() view returns(bytes)
+func (_NativeCaller *NativeCallerCaller) CurrentEpoch(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _NativeCaller.contract.Call(opts, &out, "currentEpoch")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// CurrentEpoch is a free data retrieval call binding the contract method 0x76671808.
+//
+// Solidity: function currentEpoch() view returns(bytes)
+func (_NativeCaller *NativeCallerSession) CurrentEpoch() ([]byte, error) {
+	return _NativeCaller.Contract.CurrentEpoch(&_NativeCaller.CallOpts)
+}
+
+// CurrentEpoch is a free data retrieval call binding the contract method 0x76671808.
+//
+// Solidity: function currentEpoch() view returns(bytes)
+func (_NativeCaller *NativeCallerCallerSession) CurrentEpoch() ([]byte, error) {
+	return _NativeCaller.Contract.CurrentEpoch(&_NativeCaller.CallOpts)
+}
+
+// CurrentEpochProof is a free data retrieval call binding the contract method 0x1269acab.
+//
+// Solidity: function currentEpochProof() view returns(bytes)
+func (_NativeCaller *NativeCallerCaller) CurrentEpochProof(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _NativeCaller.contract.Call(opts, &out, "currentEpochProof")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// CurrentEpochProof is a free data retrieval call binding the contract method 0x1269acab.
+//
+// Solidity: function currentEpochProof() view returns(bytes)
+func (_NativeCaller *NativeCallerSession) CurrentEpochProof() ([]byte, error) {
+	return _NativeCaller.Contract.CurrentEpochProof(&_NativeCaller.CallOpts)
+}
+
+// CurrentEpochProof is a free data retrieval call binding the contract method 0x1269acab.
+//
+// Solidity: function currentEpochProof() view returns(bytes)
+func (_NativeCaller *NativeCallerCallerSession) CurrentEpochProof() ([]byte, error) {
+	return _NativeCaller.Contract.CurrentEpochProof(&_NativeCaller.CallOpts)
+}
+
+// NodeManager is a free data retrieval call binding the contract method 0x9bb5cd3f.
+//
+// Solidity: function nodeManager() view returns(address)
+func (_NativeCaller *NativeCallerCaller) NodeManager(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NativeCaller.contract.Call(opts, &out, "nodeManager")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NodeManager is a free data retrieval call binding the contract method 0x9bb5cd3f.
+//
+// Solidity: function nodeManager() view returns(address)
+func (_NativeCaller *NativeCallerSession) NodeManager() (common.Address, error) {
+	return _NativeCaller.Contract.NodeManager(&_NativeCaller.CallOpts)
+}
+
+// NodeManager is a free data retrieval call binding the contract method 0x9bb5cd3f.
+//
+// Solidity: function nodeManager() view returns(address)
+func (_NativeCaller *NativeCallerCallerSession) NodeManager() (common.Address, error) {
+	return _NativeCaller.Contract.NodeManager(&_NativeCaller.CallOpts)
+}
+
+// SideChainManager is a free data retrieval call binding the contract method 0x489fa562.
+//
+// Solidity: function sideChainManager() view returns(address)
+func (_NativeCaller *NativeCallerCaller) SideChainManager(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NativeCaller.contract.Call(opts, &out, "sideChainManager")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SideChainManager is a free data retrieval call binding the contract method 0x489fa562.
+//
+// Solidity: function sideChainManager() view returns(address)
+func (_NativeCaller *NativeCallerSession) SideChainManager() (common.Address, error) {
+	return _NativeCaller.Contract.SideChainManager(&_NativeCaller.CallOpts)
+}
+
+// SideChainManager is a free data retrieval call binding the contract method 0x489fa562.
+//
+// Solidity: function sideChainManager() view returns(address)
+func (_NativeCaller *NativeCallerCallerSession) SideChainManager() (common.Address, error) {
+	return _NativeCaller.Contract.SideChainManager(&_NativeCaller.CallOpts)
+}
+
+// Names is a paid mutator transaction binding the contract method 0x056da048.
+//
+// Solidity: function names() returns(string, string, string)
+func (_NativeCaller *NativeCallerTransactor) Names(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeCaller.contract.Transact(opts, "names")
+}
+
+// Names is a paid mutator transaction binding the contract method 0x056da048.
+//
+// Solidity: function names() returns(string, string, string)
+func (_NativeCaller *NativeCallerSession) Names() (*types.Transaction, error) {
+	return _NativeCaller.Contract.Names(&_NativeCaller.TransactOpts)
+}
+
+// Names is a paid mutator transaction binding the contract method 0x056da048.
+//
+// Solidity: function names() returns(string, string, string)
+func (_NativeCaller *NativeCallerTransactorSession) Names() (*types.Transaction, error) {
+	return _NativeCaller.Contract.Names(&_NativeCaller.TransactOpts)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0xbcc12328.
+//
+// Solidity: function propose(uint64 startHeight, bytes peers) returns(bool)
+func (_NativeCaller *NativeCallerTransactor) Propose(opts *bind.TransactOpts, startHeight uint64, peers []byte) (*types.Transaction, error) {
+	return _NativeCaller.contract.Transact(opts, "propose", startHeight, peers)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0xbcc12328.
+//
+// Solidity: function propose(uint64 startHeight, bytes peers) returns(bool)
+func (_NativeCaller *NativeCallerSession) Propose(startHeight uint64, peers []byte) (*types.Transaction, error) {
+	return _NativeCaller.Contract.Propose(&_NativeCaller.TransactOpts, startHeight, peers)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0xbcc12328.
+//
+// Solidity: function propose(uint64 startHeight, bytes peers) returns(bool)
+func (_NativeCaller *NativeCallerTransactorSession) Propose(startHeight uint64, peers []byte) (*types.Transaction, error) {
+	return _NativeCaller.Contract.Propose(&_NativeCaller.TransactOpts, startHeight, peers)
+}
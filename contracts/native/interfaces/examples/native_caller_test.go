@@ -0,0 +1,108 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package examples
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/boot"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testKey, _  = crypto.GenerateKey()
+	testAddress = crypto.PubkeyToAddress(testKey.PublicKey)
+)
+
+func TestMain(m *testing.M) {
+	boot.InitialNativeContracts()
+	os.Exit(m.Run())
+}
+
+func newTestBackend(t *testing.T) (*backends.SimulatedBackend, *bind.TransactOpts, *NativeCaller) {
+	// genesis allocated accounts are the genesis validators as well.
+	alloc := core.GenesisAlloc{testAddress: {
+		Balance:   new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18)),
+		PublicKey: crypto.CompressPubkey(&testKey.PublicKey),
+	}}
+	backend := backends.NewSimulatedBackend(alloc, 10000000)
+
+	auth, err := bind.NewKeyedTransactorWithChainID(testKey, big.NewInt(1337))
+	assert.NoError(t, err)
+	_, _, caller, err := DeployNativeCaller(auth, backend)
+	assert.NoError(t, err)
+	backend.Commit()
+	return backend, auth, caller
+}
+
+func TestCallNativeContractsFromEVM(t *testing.T) {
+	backend, _, caller := newTestBackend(t)
+	defer backend.Close()
+
+	opts := &bind.CallOpts{From: testAddress}
+
+	// `names` is not declared as view, evaluate it with eth_call through the raw binding.
+	var names []interface{}
+	raw := &NativeCallerCallerRaw{Contract: &caller.NativeCallerCaller}
+	assert.NoError(t, raw.Call(opts, &names, "names"))
+	assert.Equal(t, []interface{}{"node manager", "cross chain manager", "side chain manager"}, names)
+
+	enc, err := caller.CurrentEpoch(opts)
+	assert.NoError(t, err)
+	epoch := new(node_manager.EpochInfo)
+	assert.NoError(t, rlp.DecodeBytes(enc, epoch))
+	assert.Equal(t, 1, len(epoch.Peers.List))
+	assert.Equal(t, testAddress, epoch.Peers.List[0].Address)
+
+	proof, err := caller.CurrentEpochProof(opts)
+	assert.NoError(t, err)
+	// the genesis epoch is not voted, so there is no proof of it.
+	assert.Equal(t, common.EmptyHash, common.BytesToHash(proof))
+
+	addr, err := caller.NodeManager(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.NodeManagerContractAddress, addr)
+}
+
+func TestProposeThroughContractReverted(t *testing.T) {
+	backend, auth, caller := newTestBackend(t)
+	defer backend.Close()
+
+	// node manager only accepts proposals sent by validators directly.
+	auth.GasLimit = 1000000
+	tx, err := caller.Propose(auth, 100000, []byte{})
+	assert.NoError(t, err)
+	backend.Commit()
+
+	receipt, err := backend.TransactionReceipt(context.Background(), tx.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusFailed, receipt.Status)
+}
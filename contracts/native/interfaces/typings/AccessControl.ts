@@ -0,0 +1,203 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `access_control` */
+export const AccessControlAddress = "0x7d79D936DA7833c7fe056eB450064f34A327DcA8";
+
+export const AccessControlABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "grantRole",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "uint8",
+        "name": "Role",
+        "type": "uint8"
+      },
+      {
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "revokeRole",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "uint8",
+        "name": "Role",
+        "type": "uint8"
+      },
+      {
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "hasRole",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "uint8",
+        "name": "Role",
+        "type": "uint8"
+      },
+      {
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "roleMembers",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "uint8",
+        "name": "Role",
+        "type": "uint8"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "address[]",
+        "name": "Members",
+        "type": "address[]"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "event",
+    "name": "roleGranted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "Role",
+        "type": "uint8"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "roleRevoked",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "Role",
+        "type": "uint8"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const AccessControlSelectors = {
+  "grantRole(address,uint8,address)": "0x61919bc1",
+  "hasRole(address,uint8,address)": "0x67125a1b",
+  "name()": "0x06fdde03",
+  "revokeRole(address,uint8,address)": "0xdf30958a",
+  "roleMembers(address,uint8)": "0xa6f6a87b",
+} as const;
+
+export interface AccessControl {
+  grantRole(Contract: string, Role: number, Account: string): Promise<boolean>;
+  hasRole(Contract: string, Role: number, Account: string): Promise<boolean>;
+  name(): Promise<string>;
+  revokeRole(Contract: string, Role: number, Account: string): Promise<boolean>;
+  roleMembers(Contract: string, Role: number): Promise<string[]>;
+}
+
+export interface AccessControlEvents {
+  roleGranted: { Contract: string; Role: number; Account: string };
+  roleRevoked: { Contract: string; Role: number; Account: string };
+}
@@ -0,0 +1,211 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `accounting` */
+export const AccountingAddress = "0xC782D7244bdd2ebeb56ac87A65c4873B6c4D427D";
+
+export const AccountingABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "ledger",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Asset",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "Locked",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Minted",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "checkInvariant",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Asset",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Holds",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Locked",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Minted",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint64[]",
+        "name": "Violations",
+        "type": "uint64[]"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "reconcile",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Asset",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Locked",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Minted",
+        "type": "uint256"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "conservationViolated",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Asset",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "Op",
+        "type": "uint8"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Locked",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Minted",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "reconciled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Asset",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Locked",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Minted",
+        "type": "uint256"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const AccountingSelectors = {
+  "checkInvariant(address)": "0x26533220",
+  "ledger(address,uint64)": "0x0a074d4b",
+  "name()": "0x06fdde03",
+  "reconcile(address,uint64,uint256,uint256)": "0x0f8f37eb",
+} as const;
+
+export interface Accounting {
+  checkInvariant(Asset: string): Promise<[boolean, bigint, bigint, bigint[]]>;
+  ledger(Asset: string, ChainID: bigint): Promise<[bigint, bigint]>;
+  name(): Promise<string>;
+  reconcile(Asset: string, ChainID: bigint, Locked: bigint, Minted: bigint): Promise<boolean>;
+}
+
+export interface AccountingEvents {
+  conservationViolated: { Asset: string; ChainID: bigint; Op: number; Amount: bigint; Locked: bigint; Minted: bigint };
+  reconciled: { Asset: string; ChainID: bigint; Locked: bigint; Minted: bigint };
+}
@@ -0,0 +1,70 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `audit_log` */
+export const AuditLogAddress = "0x33463b771Da32D450723C7C23a2240dE223b53bd";
+
+export const AuditLogABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "recordCount",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Count",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "records",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Start",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Limit",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Records",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const AuditLogSelectors = {
+  "name()": "0x06fdde03",
+  "recordCount()": "0x900407bc",
+  "records(uint64,uint64)": "0x4a639d5d",
+} as const;
+
+export interface AuditLog {
+  name(): Promise<string>;
+  recordCount(): Promise<bigint>;
+  records(Start: bigint, Limit: bigint): Promise<string>;
+}
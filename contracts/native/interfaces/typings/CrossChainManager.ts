@@ -0,0 +1,1412 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `cross_chain` */
+export const CrossChainManagerAddress = "0x5747C05FF236F8d18BB21Bc02ecc389deF853cae";
+
+export const CrossChainManagerABI = [
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "TxHash",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "MultiSign",
+        "type": "bytes"
+      }
+    ],
+    "name": "btcTxMultiSignEvent",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "FromChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "buf",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "FromTxHash",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "RedeemKey",
+        "type": "string"
+      }
+    ],
+    "name": "btcTxToRelayEvent",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "BlockHash",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "StateRoot",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "EpochHash",
+        "type": "bytes"
+      }
+    ],
+    "name": "checkpointMade",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "TxHash",
+        "type": "bytes"
+      }
+    ],
+    "name": "deliveryConfirmed",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "rk",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "buf",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64[]",
+        "name": "amts",
+        "type": "uint64[]"
+      }
+    ],
+    "name": "makeBtcTxEvent",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "merkleValueHex",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "BlockHeight",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "key",
+        "type": "string"
+      }
+    ],
+    "name": "makeProof",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Enabled",
+        "type": "bool"
+      },
+      {
+        "indexed": false,
+        "internalType": "address[]",
+        "name": "Callers",
+        "type": "address[]"
+      }
+    ],
+    "name": "entranceWhitelistChanged",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "TxHash",
+        "type": "bytes"
+      }
+    ],
+    "name": "deliveryFailed",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      }
+    ],
+    "name": "outboundRefunded",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "GasLimit",
+        "type": "uint64"
+      }
+    ],
+    "name": "outboundCallbackChanged",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "State",
+        "type": "uint8"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "name": "outboundCallbackInvoked",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "BatchID",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "State",
+        "type": "uint8"
+      }
+    ],
+    "name": "batchStateChanged",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "BatchID",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "State",
+        "type": "uint8"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "name": "batchCallbackInvoked",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "CrossChainID",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Verified",
+        "type": "bool"
+      }
+    ],
+    "name": "importShadowed",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address[]",
+        "name": "Attestors",
+        "type": "address[]"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "name": "dualVerificationChanged",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "MessageHash",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Attestor",
+        "type": "address"
+      }
+    ],
+    "name": "messageAttested",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "MaxAge",
+        "type": "uint64"
+      }
+    ],
+    "name": "proofMaxAgeChanged",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "CrossChainID",
+        "type": "bytes"
+      }
+    ],
+    "name": "staleProofApproved",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "CodeHash",
+        "type": "bytes32"
+      }
+    ],
+    "name": "codeHashPinChanged",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "Expected",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "Actual",
+        "type": "bytes32"
+      }
+    ],
+    "name": "codeHashMismatched",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "FromChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "MessageHash",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Index",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "Leaf",
+        "type": "bytes32"
+      }
+    ],
+    "name": "messageImported",
+    "type": "event"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "BlackChain",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "string",
+        "name": "RedeemKey",
+        "type": "string"
+      },
+      {
+        "internalType": "bytes",
+        "name": "TxHash",
+        "type": "bytes"
+      },
+      {
+        "internalType": "string",
+        "name": "Address",
+        "type": "string"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "Signs",
+        "type": "bytes[]"
+      }
+    ],
+    "name": "MultiSign",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "WhiteChain",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "checkpoint",
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Checkpoint",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "Contracts",
+        "type": "bytes[]"
+      }
+    ],
+    "name": "setSourceAllowlist",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "sourceAllowlist",
+    "outputs": [
+      {
+        "internalType": "bytes[]",
+        "name": "Contracts",
+        "type": "bytes[]"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "checkpointConfig",
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Interval",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "AnchorChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "AnchorContract",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint32",
+        "name": "Height",
+        "type": "uint32"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Proof",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "RelayerAddress",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Extra",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "HeaderOrCrossChainMsg",
+        "type": "bytes"
+      }
+    ],
+    "name": "confirmDelivery",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint32",
+        "name": "Height",
+        "type": "uint32"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Proof",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "RelayerAddress",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Extra",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "HeaderOrCrossChainMsg",
+        "type": "bytes"
+      }
+    ],
+    "name": "importOuterTransfer",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "name",
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Interval",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "AnchorChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "AnchorContract",
+        "type": "bytes"
+      }
+    ],
+    "name": "setCheckpointConfig",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "BlockHash",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "StateRoot",
+        "type": "bytes"
+      }
+    ],
+    "name": "submitCheckpoint",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bool",
+        "name": "Enabled",
+        "type": "bool"
+      },
+      {
+        "internalType": "address[]",
+        "name": "Callers",
+        "type": "address[]"
+      }
+    ],
+    "name": "setEntranceWhitelist",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "entranceWhitelist",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Enabled",
+        "type": "bool"
+      },
+      {
+        "internalType": "address[]",
+        "name": "Callers",
+        "type": "address[]"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "GasLimit",
+        "type": "uint64"
+      }
+    ],
+    "name": "setOutboundCallback",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      }
+    ],
+    "name": "outboundCallback",
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "GasLimit",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      }
+    ],
+    "name": "refundOutbound",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      }
+    ],
+    "name": "outboundState",
+    "outputs": [
+      {
+        "internalType": "uint8",
+        "name": "State",
+        "type": "uint8"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "bytes32",
+        "name": "BatchID",
+        "type": "bytes32"
+      }
+    ],
+    "name": "refundBatch",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "bytes32",
+        "name": "BatchID",
+        "type": "bytes32"
+      }
+    ],
+    "name": "batch",
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "internalType": "uint8",
+        "name": "State",
+        "type": "uint8"
+      },
+      {
+        "internalType": "uint64[]",
+        "name": "ToChainIDs",
+        "type": "uint64[]"
+      },
+      {
+        "internalType": "uint64[]",
+        "name": "Sequences",
+        "type": "uint64[]"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address[]",
+        "name": "Attestors",
+        "type": "address[]"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "name": "setDualVerification",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "dualVerification",
+    "outputs": [
+      {
+        "internalType": "address[]",
+        "name": "Attestors",
+        "type": "address[]"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "MessageHash",
+        "type": "bytes32"
+      }
+    ],
+    "name": "attest",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "MessageHash",
+        "type": "bytes32"
+      }
+    ],
+    "name": "attestations",
+    "outputs": [
+      {
+        "internalType": "address[]",
+        "name": "Attestors",
+        "type": "address[]"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "MaxAge",
+        "type": "uint64"
+      }
+    ],
+    "name": "setProofMaxAge",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "proofMaxAge",
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "MaxAge",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "CrossChainID",
+        "type": "bytes"
+      }
+    ],
+    "name": "approveStaleProof",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "CodeHash",
+        "type": "bytes32"
+      }
+    ],
+    "name": "setCodeHashPin",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "name": "codeHashPin",
+    "outputs": [
+      {
+        "internalType": "bytes32",
+        "name": "CodeHash",
+        "type": "bytes32"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      }
+    ],
+    "name": "importRoot",
+    "outputs": [
+      {
+        "internalType": "bytes32",
+        "name": "Root",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Count",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Index",
+        "type": "uint64"
+      }
+    ],
+    "name": "importProof",
+    "outputs": [
+      {
+        "internalType": "bytes32",
+        "name": "Leaf",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32[]",
+        "name": "Proof",
+        "type": "bytes32[]"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const CrossChainManagerSelectors = {
+  "BlackChain(uint64)": "0x8a449f03",
+  "MultiSign(uint64,string,bytes,string,bytes[])": "0x48c79d9d",
+  "WhiteChain(uint64)": "0x99d0e87a",
+  "approveStaleProof(uint64,bytes)": "0x684fb1f9",
+  "attest(uint64,bytes32)": "0x99a4f846",
+  "attestations(uint64,bytes32)": "0xedeebd0f",
+  "batch(bytes32)": "0xfddaa065",
+  "checkpoint()": "0xc2c4c5c1",
+  "checkpointConfig()": "0x39e64e33",
+  "codeHashPin(uint64)": "0x306dae71",
+  "confirmDelivery(uint64,uint32,bytes,bytes,bytes,bytes)": "0x323d727b",
+  "dualVerification(uint64)": "0x6e38bd2a",
+  "entranceWhitelist(uint64)": "0x80b1b762",
+  "importOuterTransfer(uint64,uint32,bytes,bytes,bytes,bytes)": "0x5b60b01e",
+  "importProof(uint64,uint64)": "0xd78fb3d0",
+  "importRoot(uint64)": "0x1896016a",
+  "name()": "0x06fdde03",
+  "outboundCallback(address)": "0xdb72849a",
+  "outboundState(uint64,uint64)": "0xfd568ebc",
+  "proofMaxAge(uint64)": "0x2906106e",
+  "refundBatch(bytes32)": "0xb222367b",
+  "refundOutbound(uint64,uint64)": "0xbbf9a9da",
+  "setCheckpointConfig(uint64,uint64,bytes)": "0x36ec5ff0",
+  "setCodeHashPin(uint64,bytes32)": "0xe9d03da0",
+  "setDualVerification(uint64,address[],uint64)": "0x17895082",
+  "setEntranceWhitelist(uint64,bool,address[])": "0x277c0332",
+  "setOutboundCallback(address,uint64)": "0xfef25605",
+  "setProofMaxAge(uint64,uint64)": "0xf6bfd9ac",
+  "setSourceAllowlist(uint64,bytes[])": "0x7bc3a8ac",
+  "sourceAllowlist(uint64)": "0x42195455",
+  "submitCheckpoint(uint64,bytes,bytes)": "0x40a888c3",
+} as const;
+
+export interface CrossChainManager {
+  BlackChain(ChainID: bigint): Promise<boolean>;
+  MultiSign(ChainID: bigint, RedeemKey: string, TxHash: string, Address: string, Signs: string[]): Promise<boolean>;
+  WhiteChain(ChainID: bigint): Promise<boolean>;
+  approveStaleProof(ChainID: bigint, CrossChainID: string): Promise<boolean>;
+  attest(ChainID: bigint, MessageHash: string): Promise<boolean>;
+  attestations(ChainID: bigint, MessageHash: string): Promise<string[]>;
+  batch(BatchID: string): Promise<[string, number, bigint[], bigint[]]>;
+  checkpoint(): Promise<string>;
+  checkpointConfig(): Promise<[bigint, bigint, string]>;
+  codeHashPin(ChainID: bigint): Promise<string>;
+  confirmDelivery(SourceChainID: bigint, Height: number, Proof: string, RelayerAddress: string, Extra: string, HeaderOrCrossChainMsg: string): Promise<boolean>;
+  dualVerification(ChainID: bigint): Promise<[string[], bigint]>;
+  entranceWhitelist(ChainID: bigint): Promise<[boolean, string[]]>;
+  importOuterTransfer(SourceChainID: bigint, Height: number, Proof: string, RelayerAddress: string, Extra: string, HeaderOrCrossChainMsg: string): Promise<boolean>;
+  importProof(Height: bigint, Index: bigint): Promise<[string, string[]]>;
+  importRoot(Height: bigint): Promise<[string, bigint]>;
+  name(): Promise<string>;
+  outboundCallback(Sender: string): Promise<[string, bigint]>;
+  outboundState(ToChainID: bigint, Sequence: bigint): Promise<number>;
+  proofMaxAge(ChainID: bigint): Promise<bigint>;
+  refundBatch(BatchID: string): Promise<boolean>;
+  refundOutbound(ToChainID: bigint, Sequence: bigint): Promise<boolean>;
+  setCheckpointConfig(Interval: bigint, AnchorChainID: bigint, AnchorContract: string): Promise<boolean>;
+  setCodeHashPin(ChainID: bigint, CodeHash: string): Promise<boolean>;
+  setDualVerification(ChainID: bigint, Attestors: string[], Threshold: bigint): Promise<boolean>;
+  setEntranceWhitelist(ChainID: bigint, Enabled: boolean, Callers: string[]): Promise<boolean>;
+  setOutboundCallback(Callback: string, GasLimit: bigint): Promise<boolean>;
+  setProofMaxAge(ChainID: bigint, MaxAge: bigint): Promise<boolean>;
+  setSourceAllowlist(ChainID: bigint, Contracts: string[]): Promise<boolean>;
+  sourceAllowlist(ChainID: bigint): Promise<string[]>;
+  submitCheckpoint(Height: bigint, BlockHash: string, StateRoot: string): Promise<boolean>;
+}
+
+export interface CrossChainManagerEvents {
+  batchCallbackInvoked: { Sender: string; BatchID: string; State: number; Success: boolean };
+  batchStateChanged: { BatchID: string; State: number };
+  btcTxMultiSignEvent: { TxHash: string; MultiSign: string };
+  btcTxToRelayEvent: { FromChainID: bigint; ChainID: bigint; buf: string; FromTxHash: string; RedeemKey: string };
+  checkpointMade: { Height: bigint; BlockHash: string; StateRoot: string; EpochHash: string };
+  codeHashMismatched: { ChainID: bigint; Expected: string; Actual: string };
+  codeHashPinChanged: { ChainID: bigint; CodeHash: string };
+  deliveryConfirmed: { ToChainID: bigint; Sequence: bigint; TxHash: string };
+  deliveryFailed: { ToChainID: bigint; Sequence: bigint; TxHash: string };
+  dualVerificationChanged: { ChainID: bigint; Attestors: string[]; Threshold: bigint };
+  entranceWhitelistChanged: { ChainID: bigint; Enabled: boolean; Callers: string[] };
+  importShadowed: { SourceChainID: bigint; CrossChainID: string; Verified: boolean };
+  makeBtcTxEvent: { rk: string; buf: string; amts: bigint[] };
+  makeProof: { merkleValueHex: string; BlockHeight: bigint; key: string };
+  messageAttested: { ChainID: bigint; MessageHash: string; Attestor: string };
+  messageImported: { FromChainID: bigint; MessageHash: string; Index: bigint; Leaf: string };
+  outboundCallbackChanged: { Sender: string; Callback: string; GasLimit: bigint };
+  outboundCallbackInvoked: { Sender: string; ToChainID: bigint; Sequence: bigint; State: number; Success: boolean };
+  outboundRefunded: { ToChainID: bigint; Sequence: bigint };
+  proofMaxAgeChanged: { ChainID: bigint; MaxAge: bigint };
+  staleProofApproved: { ChainID: bigint; CrossChainID: string };
+}
@@ -0,0 +1,213 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `data_oracle` */
+export const DataOracleAddress = "0x4479AcbCeA458Badf21dbEC7Db6fC236Bf08fbb9";
+
+export const DataOracleABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "feed",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Enabled",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Latest",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "data",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Value",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "latest",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Value",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "setFeed",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "internalType": "bool",
+        "name": "Enabled",
+        "type": "bool"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "submit",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Value",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "feedUpdated",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Enabled",
+        "type": "bool"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "dataFinalized",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Value",
+        "type": "bytes"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const DataOracleSelectors = {
+  "data(string,uint64)": "0x8c79c48d",
+  "feed(string)": "0xe73ade8e",
+  "latest(string)": "0x95305680",
+  "name()": "0x06fdde03",
+  "setFeed(string,bool)": "0xbd9421c5",
+  "submit(string,uint64,bytes)": "0xe6013fe0",
+} as const;
+
+export interface DataOracle {
+  data(FeedID: string, Height: bigint): Promise<string>;
+  feed(FeedID: string): Promise<[boolean, bigint]>;
+  latest(FeedID: string): Promise<[bigint, string]>;
+  name(): Promise<string>;
+  setFeed(FeedID: string, Enabled: boolean): Promise<boolean>;
+  submit(FeedID: string, Height: bigint, Value: string): Promise<boolean>;
+}
+
+export interface DataOracleEvents {
+  dataFinalized: { FeedID: string; Height: bigint; Value: string };
+  feedUpdated: { FeedID: string; Enabled: boolean };
+}
@@ -0,0 +1,241 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `escrow` */
+export const EscrowAddress = "0x370f0dDA62BDc610d8FFE8c71882D27d2a26648f";
+
+export const EscrowABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "lock",
+    "inputs": [
+      {
+        "internalType": "bytes32",
+        "name": "HashLock",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Timelock",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Recipient",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "SourceContract",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "payable"
+  },
+  {
+    "type": "function",
+    "name": "refund",
+    "inputs": [
+      {
+        "internalType": "bytes32",
+        "name": "HashLock",
+        "type": "bytes32"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "swap",
+    "inputs": [
+      {
+        "internalType": "bytes32",
+        "name": "HashLock",
+        "type": "bytes32"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "internalType": "address",
+        "name": "Recipient",
+        "type": "address"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Timelock",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "SourceContract",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint8",
+        "name": "State",
+        "type": "uint8"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "event",
+    "name": "locked",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "HashLock",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Recipient",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Timelock",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "released",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "HashLock",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "Preimage",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Recipient",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "refunded",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "HashLock",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const EscrowSelectors = {
+  "lock(bytes32,uint64,address,uint64,bytes)": "0x3ee4da82",
+  "name()": "0x06fdde03",
+  "refund(bytes32)": "0x7249fbb6",
+  "swap(bytes32)": "0x76467cbd",
+} as const;
+
+export interface Escrow {
+  lock(HashLock: string, Timelock: bigint, Recipient: string, SourceChainID: bigint, SourceContract: string): Promise<boolean>;
+  name(): Promise<string>;
+  refund(HashLock: string): Promise<boolean>;
+  swap(HashLock: string): Promise<[string, string, bigint, bigint, bigint, string, number]>;
+}
+
+export interface EscrowEvents {
+  locked: { HashLock: string; Sender: string; Recipient: string; Amount: bigint; Timelock: bigint };
+  refunded: { HashLock: string; Sender: string; Amount: bigint };
+  released: { HashLock: string; Preimage: string; Recipient: string; Amount: bigint };
+}
@@ -0,0 +1,100 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `governance` */
+export const GovernanceAddress = "0x4600691499997fCc224425ba5C93EebC57f3615b";
+
+export const GovernanceABI = [
+  {
+    "type": "function",
+    "constant": true,
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "name": "_name",
+        "type": "string"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "constant": true,
+    "name": "epoch",
+    "inputs": [],
+    "outputs": [
+      {
+        "name": "_epoch",
+        "type": "uint256"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "constant": true,
+    "name": "addValidator",
+    "inputs": [
+      {
+        "name": "validator",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "succeed",
+        "type": "bool"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "constant": true,
+    "name": "validators",
+    "inputs": [],
+    "outputs": [
+      {
+        "name": "list",
+        "type": "address[]"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "anonymous": false,
+    "name": "addValidator",
+    "inputs": [
+      {
+        "indexed": false,
+        "name": "validator",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "name": "succeed",
+        "type": "bool"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const GovernanceSelectors = {
+  "addValidator(address)": "0x4d238c8e",
+  "epoch()": "0x900cf0cf",
+  "name()": "0x06fdde03",
+  "validators()": "0xca1e7819",
+} as const;
+
+export interface Governance {
+  addValidator(validator: string): Promise<boolean>;
+  epoch(): Promise<bigint>;
+  name(): Promise<string>;
+  validators(): Promise<string[]>;
+}
+
+export interface GovernanceEvents {
+  addValidator: { validator: string; succeed: boolean };
+}
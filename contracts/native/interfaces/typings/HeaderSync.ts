@@ -0,0 +1,284 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `sync_header` */
+export const HeaderSyncAddress = "0xb2799bDE6831449d73C1F22CE815f773D0CafCc5";
+
+export const HeaderSyncABI = [
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "chainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "BlockHash",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "NextValidatorsHash",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "InfoChainID",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "BlockHeight",
+        "type": "uint64"
+      }
+    ],
+    "name": "OKEpochSwitchInfoEvent",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Generation",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "BlockHeight",
+        "type": "uint256"
+      }
+    ],
+    "name": "RebootstrapHeaderEvent",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "chainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "height",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "blockHash",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "BlockHeight",
+        "type": "uint256"
+      }
+    ],
+    "name": "syncHeader",
+    "type": "event"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Generation",
+        "type": "uint64"
+      }
+    ],
+    "name": "lightClientArchive",
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Header",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Previous",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "name",
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "Headers",
+        "type": "bytes[]"
+      }
+    ],
+    "name": "syncBlockHeader",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "CrossChainMsgs",
+        "type": "bytes[]"
+      }
+    ],
+    "name": "syncCrossChainMsg",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Header",
+        "type": "bytes"
+      }
+    ],
+    "name": "rebootstrapHeader",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "GenesisHeader",
+        "type": "bytes"
+      }
+    ],
+    "name": "syncGenesisHeader",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const HeaderSyncSelectors = {
+  "lightClientArchive(uint64,uint64)": "0x4e5703d8",
+  "name()": "0x06fdde03",
+  "rebootstrapHeader(uint64,bytes)": "0xadb46f23",
+  "syncBlockHeader(uint64,address,bytes[])": "0x72ce6700",
+  "syncCrossChainMsg(uint64,address,bytes[])": "0x21b5cff5",
+  "syncGenesisHeader(uint64,bytes)": "0xb5ace618",
+} as const;
+
+export interface HeaderSync {
+  lightClientArchive(ChainID: bigint, Generation: bigint): Promise<[bigint, string, string]>;
+  name(): Promise<string>;
+  rebootstrapHeader(ChainID: bigint, Header: string): Promise<boolean>;
+  syncBlockHeader(ChainID: bigint, Address: string, Headers: string[]): Promise<boolean>;
+  syncCrossChainMsg(ChainID: bigint, Address: string, CrossChainMsgs: string[]): Promise<boolean>;
+  syncGenesisHeader(ChainID: bigint, GenesisHeader: string): Promise<boolean>;
+}
+
+export interface HeaderSyncEvents {
+  OKEpochSwitchInfoEvent: { chainID: bigint; BlockHash: string; Height: bigint; NextValidatorsHash: string; InfoChainID: string; BlockHeight: bigint };
+  RebootstrapHeaderEvent: { ChainID: bigint; Generation: bigint; BlockHeight: bigint };
+  syncHeader: { chainID: bigint; height: bigint; blockHash: string; BlockHeight: bigint };
+}
@@ -0,0 +1,150 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `maintenance` */
+export const MaintenanceAddress = "0xf7EBd79DB6240b9A85571f61b543425e2A7045Fb";
+
+export const MaintenanceABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "disableMethod",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "string",
+        "name": "Method",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "enableMethod",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "string",
+        "name": "Method",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "isDisabled",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "internalType": "string",
+        "name": "Method",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "event",
+    "name": "methodDisabled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Method",
+        "type": "string"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "methodEnabled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Method",
+        "type": "string"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const MaintenanceSelectors = {
+  "disableMethod(address,string)": "0xa5c18ac8",
+  "enableMethod(address,string)": "0x7a063069",
+  "isDisabled(address,string)": "0xa686b7b9",
+  "name()": "0x06fdde03",
+} as const;
+
+export interface Maintenance {
+  disableMethod(Contract: string, Method: string): Promise<boolean>;
+  enableMethod(Contract: string, Method: string): Promise<boolean>;
+  isDisabled(Contract: string, Method: string): Promise<boolean>;
+  name(): Promise<string>;
+}
+
+export interface MaintenanceEvents {
+  methodDisabled: { Contract: string; Method: string };
+  methodEnabled: { Contract: string; Method: string };
+}
@@ -0,0 +1,361 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `message_router` */
+export const MessageRouterAddress = "0x2951b823F25344797D9294634F44e867490B86c9";
+
+export const MessageRouterABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "registerRoute",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "SourceApp",
+        "type": "bytes"
+      },
+      {
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "GasLimit",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "removeRoute",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "SourceApp",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "route",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "SourceApp",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "Owner",
+        "type": "address"
+      },
+      {
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "GasLimit",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "retryMessage",
+    "inputs": [
+      {
+        "internalType": "bytes32",
+        "name": "Hash",
+        "type": "bytes32"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "sendMessage",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "ToContract",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Message",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "sendBatch",
+    "inputs": [
+      {
+        "internalType": "uint64[]",
+        "name": "ToChainIDs",
+        "type": "uint64[]"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "ToContracts",
+        "type": "bytes[]"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "Messages",
+        "type": "bytes[]"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes32",
+        "name": "BatchID",
+        "type": "bytes32"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "routeRegistered",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "SourceApp",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Owner",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "GasLimit",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "routeRemoved",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "SourceApp",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "messageRouted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "SourceChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "SourceApp",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Callback",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "Hash",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "messageSent",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "ToContract",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Sequence",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "batchSent",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "BatchID",
+        "type": "bytes32"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const MessageRouterSelectors = {
+  "name()": "0x06fdde03",
+  "registerRoute(uint64,bytes,address,uint64)": "0x33bde539",
+  "removeRoute(uint64,bytes)": "0xfaf38193",
+  "retryMessage(bytes32)": "0xcd3e1266",
+  "route(uint64,bytes)": "0x438aa9fb",
+  "sendBatch(uint64[],bytes[],bytes[])": "0x406cd78a",
+  "sendMessage(uint64,bytes,bytes)": "0xb0be6151",
+} as const;
+
+export interface MessageRouter {
+  name(): Promise<string>;
+  registerRoute(SourceChainID: bigint, SourceApp: string, Callback: string, GasLimit: bigint): Promise<boolean>;
+  removeRoute(SourceChainID: bigint, SourceApp: string): Promise<boolean>;
+  retryMessage(Hash: string): Promise<boolean>;
+  route(SourceChainID: bigint, SourceApp: string): Promise<[string, string, bigint]>;
+  sendBatch(ToChainIDs: bigint[], ToContracts: string[], Messages: string[]): Promise<string>;
+  sendMessage(ToChainID: bigint, ToContract: string, Message: string): Promise<bigint>;
+}
+
+export interface MessageRouterEvents {
+  batchSent: { Sender: string; BatchID: string };
+  messageRouted: { SourceChainID: bigint; SourceApp: string; Callback: string; Hash: string; Success: boolean };
+  messageSent: { Sender: string; ToChainID: bigint; ToContract: string; Sequence: bigint };
+  routeRegistered: { SourceChainID: bigint; SourceApp: string; Owner: string; Callback: string; GasLimit: bigint };
+  routeRemoved: { SourceChainID: bigint; SourceApp: string };
+}
@@ -0,0 +1,179 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `neo3_state_manager` */
+export const Neo3StateManagerAddress = "0x5E839898821dB2A2F0eC9F8aAE7D7053744DB051";
+
+export const Neo3StateManagerABI = [
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "name": "approveRegisterStateValidator",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "name": "approveRemoveStateValidator",
+    "type": "event"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "approveRegisterStateValidator",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "approveRemoveStateValidator",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "name",
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "getCurrentStateValidator",
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Validator",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "string[]",
+        "name": "StateValidators",
+        "type": "string[]"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "registerStateValidator",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "string[]",
+        "name": "StateValidators",
+        "type": "string[]"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "removeStateValidator",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const Neo3StateManagerSelectors = {
+  "approveRegisterStateValidator(uint64,address)": "0xca1c4d1b",
+  "approveRemoveStateValidator(uint64,address)": "0x3473fd55",
+  "getCurrentStateValidator()": "0x770fa9ad",
+  "name()": "0x06fdde03",
+  "registerStateValidator(string[],address)": "0xf7531edd",
+  "removeStateValidator(string[],address)": "0xd62c2f61",
+} as const;
+
+export interface Neo3StateManager {
+  approveRegisterStateValidator(ID: bigint, Address: string): Promise<boolean>;
+  approveRemoveStateValidator(ID: bigint, Address: string): Promise<boolean>;
+  getCurrentStateValidator(): Promise<string>;
+  name(): Promise<string>;
+  registerStateValidator(StateValidators: string[], Address: string): Promise<boolean>;
+  removeStateValidator(StateValidators: string[], Address: string): Promise<boolean>;
+}
+
+export interface Neo3StateManagerEvents {
+  approveRegisterStateValidator: { ID: bigint };
+  approveRemoveStateValidator: { ID: bigint };
+}
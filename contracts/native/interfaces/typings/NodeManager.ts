@@ -0,0 +1,1583 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `node_manager` */
+export const NodeManagerAddress = "0xA4Bf827047a08510722B2d62e668a72FCCFa232C";
+
+export const NodeManagerABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "propose",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "StartHeight",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Peers",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "vote",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "epoch",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "nextEpoch",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "proposals",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Proposals",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "proof",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "peersLimit",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Target",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "MaxChange",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "quorumRule",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Numerator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Denominator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bool",
+        "name": "Strict",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "seed",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Seed",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "epochSeed",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Seed",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "registerVrfKey",
+    "inputs": [
+      {
+        "internalType": "bytes",
+        "name": "PubKey",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Output",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Proof",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "submitVrf",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Output",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Proof",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "vrfKey",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "PubKey",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "vrfOutput",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Output",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "payouts",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "StartEpoch",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "EndEpoch",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Payouts",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "feeSplit",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "BurnRate",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "TreasuryRate",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Treasury",
+        "type": "address"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "setFeeSplit",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "BurnRate",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "TreasuryRate",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Treasury",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "proposeWithActions",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "StartHeight",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Peers",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "Actions",
+        "type": "bytes[]"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "proposalActions",
+    "inputs": [
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes[]",
+        "name": "Actions",
+        "type": "bytes[]"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "sealVoting",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "CommitPeriod",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "RevealPeriod",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "votingSeal",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "CommitEnd",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "RevealEnd",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bool",
+        "name": "Tallied",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "commitVote",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Commitment",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "revealVote",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Salt",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "eject",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Evidence",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "misconduct",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "internalType": "uint64",
+        "name": "StartEpoch",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "EndEpoch",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Reports",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "sweepExpired",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "proposeGroup",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "StartHeight",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Peers",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "voteGroup",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "groupEpoch",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "groupProof",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "groups",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string[]",
+        "name": "Names",
+        "type": "string[]"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "groupQuorumRule",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Numerator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Denominator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bool",
+        "name": "Strict",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "setGroupQuorumRule",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Numerator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Denominator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bool",
+        "name": "Strict",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "signEpochChange",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Signature",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "epochChangeSigns",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Digest",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes[]",
+        "name": "Signatures",
+        "type": "bytes[]"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "epochPeers",
+    "inputs": [
+      {
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Start",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Limit",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Total",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Peers",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "setPeersLimit",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Target",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "MaxChange",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "setQuorumRule",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Numerator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Denominator",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bool",
+        "name": "Strict",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "proposed",
+    "anonymous": false,
+    "inputs": [
+      {
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "voted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "VotedNumber",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "GroupSize",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "epochChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "NextEpoch",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "proposalRejected",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Votes",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Winner",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "consensusSigned",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Method",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Input",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Signer",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Size",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "peersLimitChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Target",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "MaxChange",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "quorumRuleChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Numerator",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Denominator",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Strict",
+        "type": "bool"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "vrfSubmitted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Output",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "feeSplitChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "BurnRate",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "TreasuryRate",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Treasury",
+        "type": "address"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "actionsExecuted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Actions",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "votingSealed",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "CommitEnd",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "RevealEnd",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "voteCommitted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Voter",
+        "type": "address"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "votingTallied",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Winner",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "validatorEjected",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "StartHeight",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "misconductRecorded",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint8",
+        "name": "Kind",
+        "type": "uint8"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "expirySwept",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Entries",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Reclaimed",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "groupProposed",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "groupVoted",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Hash",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "VotedNumber",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "GroupSize",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "groupEpochChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Epoch",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "NextEpoch",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "groupQuorumRuleChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Numerator",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Denominator",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bool",
+        "name": "Strict",
+        "type": "bool"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Threshold",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "epochChangeSigned",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "EpochID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Validator",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "SignedNumber",
+        "type": "uint64"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const NodeManagerSelectors = {
+  "commitVote(uint64,bytes)": "0x4486bc09",
+  "eject(address,bytes)": "0xf559ab66",
+  "epoch()": "0x900cf0cf",
+  "epochChangeSigns(uint64)": "0x7429700c",
+  "epochPeers(bytes,uint64,uint64)": "0x01eaa31a",
+  "epochSeed(uint64)": "0xb3564b8b",
+  "feeSplit()": "0x6373ea69",
+  "groupEpoch(string)": "0x76bc595a",
+  "groupProof(string,uint64)": "0x2883bb2f",
+  "groupQuorumRule(string)": "0x544d9f1f",
+  "groups()": "0x5bf89d9e",
+  "misconduct(address,uint64,uint64)": "0x1ff88604",
+  "name()": "0x06fdde03",
+  "nextEpoch()": "0xaea0e78b",
+  "payouts(address,uint64,uint64)": "0xd592c8e0",
+  "peersLimit()": "0xfb11136e",
+  "proof()": "0xfaf924cf",
+  "proposalActions(bytes)": "0x6215af84",
+  "proposals(uint64)": "0x31c5eec8",
+  "propose(uint64,bytes)": "0xbcc12328",
+  "proposeGroup(string,uint64,bytes)": "0xbb56c490",
+  "proposeWithActions(uint64,bytes,bytes[])": "0xe24a4e3c",
+  "quorumRule()": "0x5cbcfeaa",
+  "registerVrfKey(bytes,bytes,bytes)": "0x44cce719",
+  "revealVote(uint64,bytes,bytes)": "0xc7c6efc4",
+  "sealVoting(uint64,uint64,uint64)": "0x44026376",
+  "seed()": "0x7d94792a",
+  "setFeeSplit(uint64,uint64,address)": "0x4a105a4f",
+  "setGroupQuorumRule(string,uint64,uint64,bool,uint64)": "0x8be10a03",
+  "setPeersLimit(uint64,uint64)": "0xe950b066",
+  "setQuorumRule(uint64,uint64,bool,uint64)": "0x080d640a",
+  "signEpochChange(uint64,bytes)": "0xa722a953",
+  "submitVrf(uint64,bytes,bytes)": "0x05f18c70",
+  "sweepExpired(uint64)": "0x223b97c6",
+  "vote(uint64,bytes)": "0x08c16dbb",
+  "voteGroup(string,uint64,bytes)": "0x097c0ea6",
+  "votingSeal(uint64)": "0x37b927a6",
+  "vrfKey(address)": "0x4123453e",
+  "vrfOutput(uint64,address)": "0xbfb9b84d",
+} as const;
+
+export interface NodeManager {
+  commitVote(EpochID: bigint, Commitment: string): Promise<boolean>;
+  eject(Validator: string, Evidence: string): Promise<boolean>;
+  epoch(): Promise<string>;
+  epochChangeSigns(EpochID: bigint): Promise<[string, string[]]>;
+  epochPeers(Hash: string, Start: bigint, Limit: bigint): Promise<[bigint, string]>;
+  epochSeed(EpochID: bigint): Promise<string>;
+  feeSplit(): Promise<[bigint, bigint, string]>;
+  groupEpoch(Name: string): Promise<string>;
+  groupProof(Name: string, EpochID: bigint): Promise<string>;
+  groupQuorumRule(Name: string): Promise<[bigint, bigint, boolean, bigint]>;
+  groups(): Promise<string[]>;
+  misconduct(Validator: string, StartEpoch: bigint, EndEpoch: bigint): Promise<string>;
+  name(): Promise<string>;
+  nextEpoch(): Promise<string>;
+  payouts(Validator: string, StartEpoch: bigint, EndEpoch: bigint): Promise<string>;
+  peersLimit(): Promise<[bigint, bigint]>;
+  proof(): Promise<string>;
+  proposalActions(Hash: string): Promise<string[]>;
+  proposals(EpochID: bigint): Promise<string>;
+  propose(StartHeight: bigint, Peers: string): Promise<boolean>;
+  proposeGroup(Name: string, StartHeight: bigint, Peers: string): Promise<boolean>;
+  proposeWithActions(StartHeight: bigint, Peers: string, Actions: string[]): Promise<boolean>;
+  quorumRule(): Promise<[bigint, bigint, boolean, bigint]>;
+  registerVrfKey(PubKey: string, Output: string, Proof: string): Promise<boolean>;
+  revealVote(EpochID: bigint, Hash: string, Salt: string): Promise<boolean>;
+  sealVoting(EpochID: bigint, CommitPeriod: bigint, RevealPeriod: bigint): Promise<boolean>;
+  seed(): Promise<string>;
+  setFeeSplit(BurnRate: bigint, TreasuryRate: bigint, Treasury: string): Promise<boolean>;
+  setGroupQuorumRule(Name: string, Numerator: bigint, Denominator: bigint, Strict: boolean, Threshold: bigint): Promise<boolean>;
+  setPeersLimit(Target: bigint, MaxChange: bigint): Promise<boolean>;
+  setQuorumRule(Numerator: bigint, Denominator: bigint, Strict: boolean, Threshold: bigint): Promise<boolean>;
+  signEpochChange(EpochID: bigint, Signature: string): Promise<boolean>;
+  submitVrf(EpochID: bigint, Output: string, Proof: string): Promise<boolean>;
+  sweepExpired(Height: bigint): Promise<boolean>;
+  vote(EpochID: bigint, Hash: string): Promise<boolean>;
+  voteGroup(Name: string, EpochID: bigint, Hash: string): Promise<boolean>;
+  votingSeal(EpochID: bigint): Promise<[bigint, bigint, boolean]>;
+  vrfKey(Validator: string): Promise<string>;
+  vrfOutput(EpochID: bigint, Validator: string): Promise<string>;
+}
+
+export interface NodeManagerEvents {
+  actionsExecuted: { EpochID: bigint; Hash: string; Actions: bigint };
+  consensusSigned: { Method: string; Input: string; Signer: string; Size: bigint };
+  epochChangeSigned: { EpochID: bigint; Validator: string; SignedNumber: bigint };
+  epochChanged: { Epoch: string; NextEpoch: string };
+  expirySwept: { Height: bigint; Entries: bigint; Reclaimed: bigint };
+  feeSplitChanged: { BurnRate: bigint; TreasuryRate: bigint; Treasury: string };
+  groupEpochChanged: { Name: string; Epoch: string; NextEpoch: string };
+  groupProposed: { Name: string; Epoch: string };
+  groupQuorumRuleChanged: { Name: string; Numerator: bigint; Denominator: bigint; Strict: boolean; Threshold: bigint };
+  groupVoted: { Name: string; EpochID: bigint; Hash: string; VotedNumber: bigint; GroupSize: bigint };
+  misconductRecorded: { EpochID: bigint; Validator: string; Kind: number };
+  peersLimitChanged: { Target: bigint; MaxChange: bigint };
+  proposalRejected: { EpochID: bigint; Hash: string; Votes: bigint; Winner: string };
+  proposed: { Epoch: string };
+  quorumRuleChanged: { Numerator: bigint; Denominator: bigint; Strict: boolean; Threshold: bigint };
+  validatorEjected: { EpochID: bigint; Validator: string; StartHeight: bigint };
+  voteCommitted: { EpochID: bigint; Voter: string };
+  voted: { EpochID: bigint; Hash: string; VotedNumber: bigint; GroupSize: bigint };
+  votingSealed: { EpochID: bigint; CommitEnd: bigint; RevealEnd: bigint };
+  votingTallied: { EpochID: bigint; Winner: string };
+  vrfSubmitted: { EpochID: bigint; Validator: string; Output: string };
+}
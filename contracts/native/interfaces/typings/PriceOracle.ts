@@ -0,0 +1,229 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `price_oracle` */
+export const PriceOracleAddress = "0x0F257CD338Fa8F1Af3D31b16C1fBddae2Dc96D41";
+
+export const PriceOracleABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "feed",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "MaxAge",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "MaxDeviation",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "price",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Round",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Price",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "convert",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "Value",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "setFeed",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "MaxAge",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "MaxDeviation",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "submitPrice",
+    "inputs": [
+      {
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Round",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Price",
+        "type": "uint256"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "feedUpdated",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "MaxAge",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "MaxDeviation",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "priceUpdated",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "FeedID",
+        "type": "string"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Round",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Price",
+        "type": "uint256"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const PriceOracleSelectors = {
+  "convert(string,uint256)": "0x1181dfc5",
+  "feed(string)": "0xe73ade8e",
+  "name()": "0x06fdde03",
+  "price(string)": "0xfe2c6198",
+  "setFeed(string,uint64,uint64)": "0xff5528bf",
+  "submitPrice(string,uint64,uint256)": "0x41d7b3fe",
+} as const;
+
+export interface PriceOracle {
+  convert(FeedID: string, Amount: bigint): Promise<bigint>;
+  feed(FeedID: string): Promise<[bigint, bigint]>;
+  name(): Promise<string>;
+  price(FeedID: string): Promise<[bigint, bigint, bigint]>;
+  setFeed(FeedID: string, MaxAge: bigint, MaxDeviation: bigint): Promise<boolean>;
+  submitPrice(FeedID: string, Round: bigint, Price: bigint): Promise<boolean>;
+}
+
+export interface PriceOracleEvents {
+  feedUpdated: { FeedID: string; MaxAge: bigint; MaxDeviation: bigint };
+  priceUpdated: { FeedID: string; Round: bigint; Price: bigint };
+}
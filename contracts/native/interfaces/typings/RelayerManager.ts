@@ -0,0 +1,192 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `relayer_manager` */
+export const RelayerManagerAddress = "0xA22f301D7Cb5b50dcA4a015b12EC0cc5f3971412";
+
+export const RelayerManagerABI = [
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "name": "evtApproveRegisterRelayer",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "name": "evtApproveRemoveRelayer",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "applyID",
+        "type": "uint64"
+      }
+    ],
+    "name": "evtRegisterRelayer",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "removeID",
+        "type": "uint64"
+      }
+    ],
+    "name": "evtRemoveRelayer",
+    "type": "event"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "approveRegisterRelayer",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "approveRemoveRelayer",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "name",
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "address[]",
+        "name": "AddressList",
+        "type": "address[]"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "registerRelayer",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "address[]",
+        "name": "AddressList",
+        "type": "address[]"
+      },
+      {
+        "internalType": "address",
+        "name": "Address",
+        "type": "address"
+      }
+    ],
+    "name": "removeRelayer",
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const RelayerManagerSelectors = {
+  "approveRegisterRelayer(uint64,address)": "0x07b8ca31",
+  "approveRemoveRelayer(uint64,address)": "0x2b1775dd",
+  "name()": "0x06fdde03",
+  "registerRelayer(address[],address)": "0xd99802fe",
+  "removeRelayer(address[],address)": "0x0cffb52a",
+} as const;
+
+export interface RelayerManager {
+  approveRegisterRelayer(ID: bigint, Address: string): Promise<boolean>;
+  approveRemoveRelayer(ID: bigint, Address: string): Promise<boolean>;
+  name(): Promise<string>;
+  registerRelayer(AddressList: string[], Address: string): Promise<boolean>;
+  removeRelayer(AddressList: string[], Address: string): Promise<boolean>;
+}
+
+export interface RelayerManagerEvents {
+  evtApproveRegisterRelayer: { ID: bigint };
+  evtApproveRemoveRelayer: { ID: bigint };
+  evtRegisterRelayer: { applyID: bigint };
+  evtRemoveRelayer: { removeID: bigint };
+}
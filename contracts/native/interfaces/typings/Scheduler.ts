@@ -0,0 +1,176 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `scheduler` */
+export const SchedulerAddress = "0xc204aDF052C52F74863d76c94a311b82D98d87AE";
+
+export const SchedulerABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "task",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "Target",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Payload",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint8",
+        "name": "Status",
+        "type": "uint8"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "pending",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64[]",
+        "name": "IDs",
+        "type": "uint64[]"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "schedule",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Payload",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "cancel",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "taskScheduled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Target",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "taskCanceled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const SchedulerSelectors = {
+  "cancel(uint64)": "0x4c125e79",
+  "name()": "0x06fdde03",
+  "pending(uint64)": "0x19a64c1e",
+  "schedule(uint64,bytes)": "0x84853b2c",
+  "task(uint64)": "0x63cbcb4f",
+} as const;
+
+export interface Scheduler {
+  cancel(ID: bigint): Promise<boolean>;
+  name(): Promise<string>;
+  pending(Height: bigint): Promise<bigint[]>;
+  schedule(Height: bigint, Payload: string): Promise<bigint>;
+  task(ID: bigint): Promise<[string, string, bigint, number]>;
+}
+
+export interface SchedulerEvents {
+  taskCanceled: { ID: bigint };
+  taskScheduled: { ID: bigint; Target: string; Height: bigint };
+}
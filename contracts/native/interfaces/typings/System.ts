@@ -0,0 +1,73 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `system` */
+export const SystemAddress = "0xD62B67170A6bb645f1c59601FbC6766940ee12e5";
+
+export const SystemABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "finalize",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "hookFailed",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Contract",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Height",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "string",
+        "name": "Reason",
+        "type": "string"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const SystemSelectors = {
+  "finalize()": "0x4bb278f3",
+  "name()": "0x06fdde03",
+} as const;
+
+export interface System {
+  finalize(): Promise<boolean>;
+  name(): Promise<string>;
+}
+
+export interface SystemEvents {
+  hookFailed: { Contract: string; Height: bigint; Reason: string };
+}
@@ -0,0 +1,235 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `timelock` */
+export const TimelockAddress = "0xD37F626c9E007DdD244E5Cbee0C223fec6D11289";
+
+export const TimelockABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "delay",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "Delay",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "action",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "Target",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Payload",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint64",
+        "name": "Eta",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint8",
+        "name": "Status",
+        "type": "uint8"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "schedule",
+    "inputs": [
+      {
+        "internalType": "bytes",
+        "name": "Payload",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "execute",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "cancel",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "changeDelay",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "Delay",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "event",
+    "name": "scheduled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Target",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Eta",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "executed",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "canceled",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ID",
+        "type": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "delayChanged",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "Delay",
+        "type": "uint64"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const TimelockSelectors = {
+  "action(uint64)": "0xf5276cd5",
+  "cancel(uint64)": "0x4c125e79",
+  "changeDelay(uint64)": "0x60911aa5",
+  "delay()": "0x6a42b8f8",
+  "execute(uint64)": "0xb590be77",
+  "name()": "0x06fdde03",
+  "schedule(bytes)": "0xdb26e34d",
+} as const;
+
+export interface Timelock {
+  action(ID: bigint): Promise<[string, string, bigint, number]>;
+  cancel(ID: bigint): Promise<boolean>;
+  changeDelay(Delay: bigint): Promise<boolean>;
+  delay(): Promise<bigint>;
+  execute(ID: bigint): Promise<boolean>;
+  name(): Promise<string>;
+  schedule(Payload: string): Promise<bigint>;
+}
+
+export interface TimelockEvents {
+  canceled: { ID: bigint };
+  delayChanged: { Delay: bigint };
+  executed: { ID: bigint };
+  scheduled: { ID: bigint; Target: string; Eta: bigint };
+}
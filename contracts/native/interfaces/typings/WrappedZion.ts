@@ -0,0 +1,419 @@
+// Code generated by cmd/nativeabi - DO NOT EDIT.
+
+/** address of native contract `wzion` */
+export const WrappedZionAddress = "0x20B019ea369923eF1971A30f1974003051f1863C";
+
+export const WrappedZionABI = [
+  {
+    "type": "function",
+    "name": "name",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Name",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "symbol",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "string",
+        "name": "Symbol",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "decimals",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint8",
+        "name": "Decimals",
+        "type": "uint8"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "totalSupply",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "balanceOf",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "deposit",
+    "inputs": [],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "payable"
+  },
+  {
+    "type": "function",
+    "name": "withdraw",
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "transfer",
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "To",
+        "type": "address"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "lock",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "ToAddress",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "bindProxy",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Proxy",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Asset",
+        "type": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bool",
+        "name": "Success",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable"
+  },
+  {
+    "type": "function",
+    "name": "proxy",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "bytes",
+        "name": "Proxy",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "Asset",
+        "type": "bytes"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "function",
+    "name": "bridged",
+    "inputs": [
+      {
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view"
+  },
+  {
+    "type": "event",
+    "name": "transferred",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "From",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "To",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "deposited",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "withdrawn",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "Account",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "locked",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "From",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ToChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "ToAddress",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "unlocked",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "FromChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "To",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "Amount",
+        "type": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "event",
+    "name": "proxyBound",
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "uint64",
+        "name": "ChainID",
+        "type": "uint64"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Proxy",
+        "type": "bytes"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "Asset",
+        "type": "bytes"
+      }
+    ]
+  }
+] as const;
+
+/** 4-byte selectors of the method signatures */
+export const WrappedZionSelectors = {
+  "balanceOf(address)": "0x70a08231",
+  "bindProxy(uint64,bytes,bytes)": "0xd30542a1",
+  "bridged(uint64)": "0x17f936c6",
+  "decimals()": "0x313ce567",
+  "deposit()": "0xd0e30db0",
+  "lock(uint64,bytes,uint256)": "0xcccf1562",
+  "name()": "0x06fdde03",
+  "proxy(uint64)": "0xe47d9dd3",
+  "symbol()": "0x95d89b41",
+  "totalSupply()": "0x18160ddd",
+  "transfer(address,uint256)": "0xa9059cbb",
+  "withdraw(uint256)": "0x2e1a7d4d",
+} as const;
+
+export interface WrappedZion {
+  balanceOf(Account: string): Promise<bigint>;
+  bindProxy(ChainID: bigint, Proxy: string, Asset: string): Promise<boolean>;
+  bridged(ChainID: bigint): Promise<bigint>;
+  decimals(): Promise<number>;
+  deposit(): Promise<boolean>;
+  lock(ToChainID: bigint, ToAddress: string, Amount: bigint): Promise<boolean>;
+  name(): Promise<string>;
+  proxy(ChainID: bigint): Promise<[string, string]>;
+  symbol(): Promise<string>;
+  totalSupply(): Promise<bigint>;
+  transfer(To: string, Amount: bigint): Promise<boolean>;
+  withdraw(Amount: bigint): Promise<boolean>;
+}
+
+export interface WrappedZionEvents {
+  deposited: { Account: string; Amount: bigint };
+  locked: { From: string; ToChainID: bigint; ToAddress: string; Amount: bigint };
+  proxyBound: { ChainID: bigint; Proxy: string; Asset: string };
+  transferred: { From: string; To: string; Amount: bigint };
+  unlocked: { FromChainID: bigint; To: string; Amount: bigint };
+  withdrawn: { Account: string; Amount: bigint };
+}
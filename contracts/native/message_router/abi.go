@@ -0,0 +1,228 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package message_router
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "message router"
+
+const (
+	MethodContractName  = "name"
+	MethodRegisterRoute = "registerRoute"
+	MethodRemoveRoute   = "removeRoute"
+	MethodRoute         = "route"
+	MethodRetryMessage  = "retryMessage"
+	MethodSendMessage   = "sendMessage"
+	MethodSendBatch     = "sendBatch"
+
+	EventRouteRegistered = "routeRegistered"
+	EventRouteRemoved    = "routeRemoved"
+	EventMessageRouted   = "messageRouted"
+	EventMessageSent     = "messageSent"
+	EventBatchSent       = "batchSent"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodRegisterRoute + `","inputs":[{"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"internalType":"bytes","name":"SourceApp","type":"bytes"},{"internalType":"address","name":"Callback","type":"address"},{"internalType":"uint64","name":"GasLimit","type":"uint64"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodRemoveRoute + `","inputs":[{"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"internalType":"bytes","name":"SourceApp","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodRoute + `","inputs":[{"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"internalType":"bytes","name":"SourceApp","type":"bytes"}],"outputs":[{"internalType":"address","name":"Owner","type":"address"},{"internalType":"address","name":"Callback","type":"address"},{"internalType":"uint64","name":"GasLimit","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodRetryMessage + `","inputs":[{"internalType":"bytes32","name":"Hash","type":"bytes32"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSendMessage + `","inputs":[{"internalType":"uint64","name":"ToChainID","type":"uint64"},{"internalType":"bytes","name":"ToContract","type":"bytes"},{"internalType":"bytes","name":"Message","type":"bytes"}],"outputs":[{"internalType":"uint64","name":"Sequence","type":"uint64"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodSendBatch + `","inputs":[{"internalType":"uint64[]","name":"ToChainIDs","type":"uint64[]"},{"internalType":"bytes[]","name":"ToContracts","type":"bytes[]"},{"internalType":"bytes[]","name":"Messages","type":"bytes[]"}],"outputs":[{"internalType":"bytes32","name":"BatchID","type":"bytes32"}],"stateMutability":"nonpayable"},
+	{"type":"event","name":"` + EventRouteRegistered + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"SourceApp","type":"bytes"},{"indexed":false,"internalType":"address","name":"Owner","type":"address"},{"indexed":false,"internalType":"address","name":"Callback","type":"address"},{"indexed":false,"internalType":"uint64","name":"GasLimit","type":"uint64"}]},
+	{"type":"event","name":"` + EventRouteRemoved + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"SourceApp","type":"bytes"}]},
+	{"type":"event","name":"` + EventMessageRouted + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"SourceChainID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"SourceApp","type":"bytes"},{"indexed":false,"internalType":"address","name":"Callback","type":"address"},{"indexed":false,"internalType":"bytes32","name":"Hash","type":"bytes32"},{"indexed":false,"internalType":"bool","name":"Success","type":"bool"}]},
+	{"type":"event","name":"` + EventMessageSent + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Sender","type":"address"},{"indexed":false,"internalType":"uint64","name":"ToChainID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"ToContract","type":"bytes"},{"indexed":false,"internalType":"uint64","name":"Sequence","type":"uint64"}]},
+	{"type":"event","name":"` + EventBatchSent + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Sender","type":"address"},{"indexed":false,"internalType":"bytes32","name":"BatchID","type":"bytes32"}]}
+]`
+
+// callbackABI is the receive interface implemented by the callback contracts.
+const callbackABI = `[
+	{"type":"function","name":"` + MethodOnCrossChainMessage + `","inputs":[{"internalType":"bytes","name":"message","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+	cb, err := abi.JSON(strings.NewReader(callbackABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load callback abi json string: [%v]", err))
+	}
+	CallbackABI = &cb
+}
+
+var (
+	ABI         *abi.ABI
+	CallbackABI *abi.ABI
+	this        = utils.MessageRouterContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+type MethodRegisterRouteInput struct {
+	SourceChainID uint64
+	SourceApp     []byte
+	Callback      common.Address
+	GasLimit      uint64
+}
+
+func (m *MethodRegisterRouteInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRegisterRoute, m.SourceChainID, m.SourceApp, m.Callback, m.GasLimit)
+}
+func (m *MethodRegisterRouteInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodRegisterRoute, m, payload)
+}
+
+// MethodRouteKeyInput is shared by `removeRoute` and `route`.
+type MethodRouteKeyInput struct {
+	SourceChainID uint64
+	SourceApp     []byte
+}
+
+func (m *MethodRouteKeyInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.SourceChainID, m.SourceApp)
+}
+func (m *MethodRouteKeyInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodRouteOutput struct {
+	Owner    common.Address
+	Callback common.Address
+	GasLimit uint64
+}
+
+func (m *MethodRouteOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodRoute, m.Owner, m.Callback, m.GasLimit)
+}
+func (m *MethodRouteOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodRoute, m, payload)
+}
+
+type MethodRetryMessageInput struct {
+	Hash common.Hash
+}
+
+func (m *MethodRetryMessageInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRetryMessage, m.Hash)
+}
+func (m *MethodRetryMessageInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodRetryMessage, m, payload)
+}
+
+type MethodSendMessageInput struct {
+	ToChainID  uint64
+	ToContract []byte
+	Message    []byte
+}
+
+func (m *MethodSendMessageInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSendMessage, m.ToChainID, m.ToContract, m.Message)
+}
+func (m *MethodSendMessageInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSendMessage, m, payload)
+}
+
+type MethodSendMessageOutput struct {
+	Sequence uint64
+}
+
+func (m *MethodSendMessageOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSendMessage, m.Sequence)
+}
+func (m *MethodSendMessageOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSendMessage, m, payload)
+}
+
+type MethodSendBatchInput struct {
+	ToChainIDs  []uint64
+	ToContracts [][]byte
+	Messages    [][]byte
+}
+
+func (m *MethodSendBatchInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSendBatch, m.ToChainIDs, m.ToContracts, m.Messages)
+}
+func (m *MethodSendBatchInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodSendBatch, m, payload)
+}
+
+type MethodSendBatchOutput struct {
+	BatchID common.Hash
+}
+
+func (m *MethodSendBatchOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSendBatch, m.BatchID)
+}
+func (m *MethodSendBatchOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodSendBatch, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitRouteRegistered(s *native.NativeContract, chainID uint64, app []byte, route *Route) error {
+	return s.AddNotify(ABI, []string{EventRouteRegistered}, chainID, app, route.Owner, route.Callback, route.GasLimit)
+}
+
+func emitRouteRemoved(s *native.NativeContract, chainID uint64, app []byte) error {
+	return s.AddNotify(ABI, []string{EventRouteRemoved}, chainID, app)
+}
+
+func emitMessageRouted(s *native.NativeContract, msg *Message, callback common.Address, hash common.Hash, success bool) error {
+	return s.AddCrossChainNotify(ABI, []string{EventMessageRouted}, msg.SourceChainID, msg.SourceApp, callback, hash, success)
+}
+
+func emitMessageSent(s *native.NativeContract, sender common.Address, toChainID uint64, toContract []byte, seq uint64) error {
+	return s.AddCrossChainNotify(ABI, []string{EventMessageSent}, sender, toChainID, toContract, seq)
+}
+
+func emitBatchSent(s *native.NativeContract, sender common.Address, batchID common.Hash) error {
+	return s.AddCrossChainNotify(ABI, []string{EventBatchSent}, sender, batchID)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package message_router
+
+import "errors"
+
+var (
+	ErrInvalidInput = errors.New("invalid input")
+
+	ErrInvalidCallback = errors.New("callback is not a contract")
+
+	ErrInvalidGasLimit = errors.New("invalid callback gas limit")
+
+	ErrNotRouteOwner = errors.New("only the route owner is allowed")
+
+	ErrRouteNotFound = errors.New("route not registered")
+
+	ErrMessageNotFound = errors.New("failed message not found")
+
+	ErrInvalidChain = errors.New("invalid chain")
+
+	ErrChainBlacked = errors.New("chain blacked")
+
+	ErrChainIDUnknown = errors.New("chain id of zion unknown")
+
+	ErrDuplicateMessage = errors.New("only one message to a chain is allowed in a transaction")
+
+	ErrStorage = errors.New("store key value failed")
+
+	ErrEmitLog = errors.New("emit log failed")
+)
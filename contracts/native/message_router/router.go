@@ -0,0 +1,350 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package message_router
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName:  0,
+		MethodRegisterRoute: 100000,
+		MethodRemoveRoute:   30000,
+		MethodRoute:         0,
+		MethodRetryMessage:  30000,
+		MethodSendMessage:   100000,
+		MethodSendBatch:     100000,
+	}
+)
+
+func InitMessageRouter() {
+	InitABI()
+	native.RegisterABI(native.NativeMessageRouter, "MessageRouter", abijson)
+	cross_chain_manager.RegisterInboundHandler(this, Dispatch)
+	native.Contracts[this] = RegisterMessageRouterContract
+}
+
+func RegisterMessageRouterContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.Register(MethodRegisterRoute, RegisterRoute)
+	s.Register(MethodRemoveRoute, RemoveRoute)
+	s.RegisterQuery(MethodRoute, GetRoute)
+	s.Register(MethodRetryMessage, RetryMessage)
+	s.Register(MethodSendMessage, SendMessage)
+	s.Register(MethodSendBatch, SendBatch)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+// RegisterRoute registers the callback contract which the messages of the source app are routed
+// to. the route is owned by the first registrant, and only the owner is allowed to change it, so
+// the dApp should register the route before the source app sends any message.
+func RegisterRoute(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRegisterRouteInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if len(input.SourceApp) == 0 || s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if localID, ok := cross_chain_manager.LocalChainID(s); ok && localID == input.SourceChainID {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.GasLimit == 0 || input.GasLimit > MaxCallbackGas {
+		return utils.ByteFailed, ErrInvalidGasLimit
+	}
+	if s.StateDB().GetCodeSize(input.Callback) == 0 {
+		return utils.ByteFailed, ErrInvalidCallback
+	}
+	route, err := getRoute(s, input.SourceChainID, input.SourceApp)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	caller := s.ContractRef().MsgSender()
+	if route != nil && route.Owner != caller {
+		return utils.ByteFailed, ErrNotRouteOwner
+	}
+
+	route = &Route{Owner: caller, Callback: input.Callback, GasLimit: input.GasLimit}
+	if err := setRoute(s, input.SourceChainID, input.SourceApp, route); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitRouteRegistered(s, input.SourceChainID, input.SourceApp, route); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodRegisterRoute)
+}
+
+// RemoveRoute the owner removes the route, the messages of source app fail to import after that.
+func RemoveRoute(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRouteKeyInput)
+	if err := input.Decode(MethodRemoveRoute, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	route, err := getRoute(s, input.SourceChainID, input.SourceApp)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if route == nil {
+		return utils.ByteFailed, ErrRouteNotFound
+	}
+	if route.Owner != s.ContractRef().MsgSender() {
+		return utils.ByteFailed, ErrNotRouteOwner
+	}
+
+	delRoute(s, input.SourceChainID, input.SourceApp)
+	if err := emitRouteRemoved(s, input.SourceChainID, input.SourceApp); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodRemoveRoute)
+}
+
+func GetRoute(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRouteKeyInput)
+	if err := input.Decode(MethodRoute, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	route, err := getRoute(s, input.SourceChainID, input.SourceApp)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	output := new(MethodRouteOutput)
+	if route != nil {
+		output.Owner, output.Callback, output.GasLimit = route.Owner, route.Callback, route.GasLimit
+	}
+	return output.Encode()
+}
+
+// Dispatch implements the cross_chain_manager.InboundHandler, it routes the message sent by the
+// source app to the `onCrossChainMessage(bytes)` of the callback registered. the callback is
+// sandboxed: it runs with the gas limit of route only, and its failure reverts the callback
+// alone, the message is kept for `retryMessage` and the import still succeeds. the import fails
+// if the route is not registered or the gas left could not cover the gas limit, so that the
+// message could be imported again later.
+func Dispatch(s *native.NativeContract, fromChainID uint64, txParam *scom.MakeTxParam) error {
+	if txParam.Method != MethodOnCrossChainMessage {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "message router, invalid method %s", txParam.Method)
+	}
+	route, err := getRoute(s, fromChainID, txParam.FromContractAddress)
+	if err != nil {
+		return ErrStorage
+	}
+	if route == nil {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "message router, %v: %x", ErrRouteNotFound, txParam.FromContractAddress)
+	}
+	if gasLeft := s.ContractRef().GasLeft(); gasLeft < route.GasLimit {
+		return scom.NewImportError(scom.ErrCodeUnknown, "message router, gas left %d not enough for callback gas limit %d", gasLeft, route.GasLimit)
+	}
+
+	msg := &Message{SourceChainID: fromChainID, SourceApp: txParam.FromContractAddress, Payload: txParam.Args}
+	hash := messageHash(fromChainID, txParam.CrossChainID)
+	err = callback(s, route, msg)
+	if err != nil {
+		log.Debug("message router, callback failed", "chainID", fromChainID, "app", common.Bytes2Hex(msg.SourceApp),
+			"callback", route.Callback, "hash", hash, "err", err)
+		if err := setFailedMessage(s, hash, msg); err != nil {
+			return ErrStorage
+		}
+	}
+	if err := emitMessageRouted(s, msg, route.Callback, hash, err == nil); err != nil {
+		return ErrEmitLog
+	}
+	return nil
+}
+
+// RetryMessage anyone retries the message failed in callback, with the current route of source
+// app. the message is removed once the callback succeeds.
+func RetryMessage(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodRetryMessageInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	msg, err := getFailedMessage(s, input.Hash)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if msg == nil {
+		return utils.ByteFailed, ErrMessageNotFound
+	}
+	route, err := getRoute(s, msg.SourceChainID, msg.SourceApp)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if route == nil {
+		return utils.ByteFailed, ErrRouteNotFound
+	}
+	// the gas of method is charged after the callback
+	if gasLeft := s.ContractRef().GasLeft(); gasLeft < route.GasLimit+gasTable[MethodRetryMessage] {
+		return utils.ByteFailed, fmt.Errorf("gasLeft not enough for callback gas limit %d, got %d", route.GasLimit, gasLeft)
+	}
+
+	// removed before the callback, so that it's never retried again by the callback itself
+	delFailedMessage(s, input.Hash)
+	if err := callback(s, route, msg); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := emitMessageRouted(s, msg, route.Callback, input.Hash, true); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodRetryMessage)
+}
+
+// SendMessage the contract sends the message to the contract of target chain through cross chain
+// manager, the message is received on the target with the sender as the source app. the state
+// transitions of the message are notified to the outbound callback registered by the sender in
+// cross chain manager. as the cross chain request is keyed by the tx hash, only one message to a
+// chain is allowed in a transaction.
+func SendMessage(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsCrossChainV2() {
+		return utils.ByteFailed, ErrInvalidChain
+	}
+	input := new(MethodSendMessageInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	localID, ok := cross_chain_manager.LocalChainID(s)
+	if !ok {
+		return utils.ByteFailed, ErrChainIDUnknown
+	}
+	seq, err := send(s, localID, input.ToChainID, input.ToContract, input.Message)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	return (&MethodSendMessageOutput{Sequence: seq}).Encode()
+}
+
+// SendBatch the contract sends messages to the contracts of different chains as a batch, which is
+// delivered only if all of the messages are delivered. the cross chain manager reports the state of
+// the batch to the lifecycle callback of the sender, and the failed batch is refunded as a whole.
+// the batch id is the transaction hash, so that a transaction sends one batch at most.
+func SendBatch(s *native.NativeContract) ([]byte, error) {
+	if !s.ContractRef().IsCrossChainV2() {
+		return utils.ByteFailed, ErrInvalidChain
+	}
+	input := new(MethodSendBatchInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	size := len(input.ToChainIDs)
+	if size == 0 || size > cross_chain_manager.MaxBatchSize || len(input.ToContracts) != size || len(input.Messages) != size {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if s.ContractRef().Value().Sign() != 0 {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	localID, ok := cross_chain_manager.LocalChainID(s)
+	if !ok {
+		return utils.ByteFailed, ErrChainIDUnknown
+	}
+	members := make([]scom.BatchMember, size)
+	for i := range members {
+		seq, err := send(s, localID, input.ToChainIDs[i], input.ToContracts[i], input.Messages[i])
+		if err != nil {
+			return utils.ByteFailed, err
+		}
+		members[i] = scom.BatchMember{ToChainID: input.ToChainIDs[i], Sequence: seq}
+	}
+	sender := s.ContractRef().MsgSender()
+	batchID := s.ContractRef().TxHash()
+	if err := cross_chain_manager.MakeBatch(s, batchID, sender, members); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := emitBatchSent(s, sender, batchID); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodSendBatchOutput{BatchID: batchID}).Encode()
+}
+
+// send makes the outbound message from the sender to the contract of target chain, and returns
+// the sequence of the message.
+func send(s *native.NativeContract, localID, toChainID uint64, toContract, message []byte) (uint64, error) {
+	if len(toContract) == 0 {
+		return 0, ErrInvalidInput
+	}
+	if toChainID == localID {
+		return 0, ErrInvalidChain
+	}
+	blacked, err := cross_chain_manager.CheckIfChainBlacked(s, toChainID)
+	if err != nil {
+		return 0, err
+	}
+	if blacked {
+		return 0, ErrChainBlacked
+	}
+	txHash := s.ContractRef().TxHash()
+	if _, found, err := cross_chain_manager.GetOutboundSequence(s, toChainID, txHash.Bytes()); err != nil {
+		return 0, ErrStorage
+	} else if found {
+		return 0, ErrDuplicateMessage
+	}
+
+	sender := s.ContractRef().MsgSender()
+	txParam := &scom.MakeTxParam{
+		TxHash:              txHash.Bytes(),
+		CrossChainID:        crypto.Keccak256(this.Bytes(), txHash.Bytes()),
+		FromContractAddress: sender.Bytes(),
+		ToChainID:           toChainID,
+		ToContractAddress:   toContract,
+		Method:              MethodOnCrossChainMessage,
+		Args:                message,
+	}
+	if err := cross_chain_manager.MakeTransaction(s, txParam, localID); err != nil {
+		return 0, err
+	}
+	seq, _, err := cross_chain_manager.GetOutboundSequence(s, toChainID, txHash.Bytes())
+	if err != nil {
+		return 0, ErrStorage
+	}
+	if err := emitMessageSent(s, sender, toChainID, toContract, seq); err != nil {
+		return 0, ErrEmitLog
+	}
+	return seq, nil
+}
+
+// callback calls the callback contract with the router as `msg.sender`.
+func callback(s *native.NativeContract, route *Route, msg *Message) error {
+	input, err := utils.PackMethod(CallbackABI, MethodOnCrossChainMessage, msg.Payload)
+	if err != nil {
+		return err
+	}
+	_, err = s.ContractRef().EVMCall(this, route.Callback, input, route.GasLimit)
+	return err
+}
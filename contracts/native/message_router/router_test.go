@@ -0,0 +1,377 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package message_router
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSupplyGas = uint64(10000000)
+	testChainID   = uint64(2)
+)
+
+var (
+	testStateDB  *state.StateDB
+	testConfig   = &params.ChainConfig{ChainID: big.NewInt(1000), CrossChainV2Block: big.NewInt(0), CrossChainBloomBlock: big.NewInt(0)}
+	testApp      = common.HexToAddress("0x1001").Bytes()
+	testCallback = common.HexToAddress("0x2001")
+)
+
+func TestMain(m *testing.M) {
+	cross_chain_manager.InitCrossChainManager()
+	InitMessageRouter()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+}
+
+// testEVM records the callbacks, which use up half of the gas supplied and fail if `fail` is set.
+type testEVM struct {
+	fail   bool
+	caller common.Address
+	addr   common.Address
+	input  []byte
+}
+
+func (e *testEVM) call(caller, addr common.Address, input []byte, gas uint64) ([]byte, uint64, error) {
+	e.caller, e.addr, e.input = caller, addr, input
+	if e.fail {
+		return nil, 0, errors.New("execution reverted")
+	}
+	return nil, gas / 2, nil
+}
+
+func newTestRef(origin common.Address, evm *testEVM) *native.ContractRef {
+	var handler native.EVMHandler
+	if evm != nil {
+		handler = evm.call
+	}
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(1), common.HexToHash("0x1"), testSupplyGas, handler)
+	ref.SetChainConfig(testConfig)
+	return ref
+}
+
+func registerRoute(origin common.Address, callback common.Address, gasLimit uint64) error {
+	payload, _ := (&MethodRegisterRouteInput{SourceChainID: testChainID, SourceApp: testApp, Callback: callback, GasLimit: gasLimit}).Encode()
+	_, _, err := newTestRef(origin, nil).NativeCall(origin, this, payload)
+	return err
+}
+
+func getTestRoute(t *testing.T) *MethodRouteOutput {
+	payload, _ := (&MethodRouteKeyInput{SourceChainID: testChainID, SourceApp: testApp}).Encode(MethodRoute)
+	enc, _, err := newTestRef(common.EmptyAddress, nil).NativeCall(common.EmptyAddress, this, payload)
+	assert.NoError(t, err)
+	output := new(MethodRouteOutput)
+	assert.NoError(t, output.Decode(enc))
+	return output
+}
+
+// retry reverts the state on failure as evm does.
+func retry(origin common.Address, evm *testEVM, hash common.Hash) error {
+	payload, _ := (&MethodRetryMessageInput{Hash: hash}).Encode()
+	snapshot := testStateDB.Snapshot()
+	_, _, err := newTestRef(origin, evm).NativeCall(origin, this, payload)
+	if err != nil {
+		testStateDB.RevertToSnapshot(snapshot)
+	}
+	return err
+}
+
+func message(method string, crossChainID byte) *scom.MakeTxParam {
+	return &scom.MakeTxParam{
+		CrossChainID:        []byte{crossChainID},
+		FromContractAddress: testApp,
+		ToContractAddress:   this.Bytes(),
+		Method:              method,
+		Args:                []byte("hello"),
+	}
+}
+
+func TestRegisterRoute(t *testing.T) {
+	resetTestContext()
+	alice, bob := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+
+	assert.Equal(t, ErrInvalidCallback, registerRoute(alice, testCallback, 100000))
+	testStateDB.SetCode(testCallback, []byte{0x1})
+	assert.Equal(t, ErrInvalidGasLimit, registerRoute(alice, testCallback, 0))
+	assert.Equal(t, ErrInvalidGasLimit, registerRoute(alice, testCallback, MaxCallbackGas+1))
+	assert.NoError(t, registerRoute(alice, testCallback, 100000))
+	assert.Equal(t, &MethodRouteOutput{Owner: alice, Callback: testCallback, GasLimit: 100000}, getTestRoute(t))
+
+	// only the owner changes the route
+	assert.Equal(t, ErrNotRouteOwner, registerRoute(bob, testCallback, 200000))
+	assert.NoError(t, registerRoute(alice, testCallback, 200000))
+	assert.Equal(t, uint64(200000), getTestRoute(t).GasLimit)
+
+	remove, _ := (&MethodRouteKeyInput{SourceChainID: testChainID, SourceApp: testApp}).Encode(MethodRemoveRoute)
+	_, _, err := newTestRef(bob, nil).NativeCall(bob, this, remove)
+	assert.Equal(t, ErrNotRouteOwner, err)
+	_, _, err = newTestRef(alice, nil).NativeCall(alice, this, remove)
+	assert.NoError(t, err)
+	assert.Equal(t, new(MethodRouteOutput), getTestRoute(t))
+
+	// the route is free to claim again after removed
+	assert.NoError(t, registerRoute(bob, testCallback, 100000))
+	assert.Equal(t, bob, getTestRoute(t).Owner)
+}
+
+func TestDispatch(t *testing.T) {
+	resetTestContext()
+	alice := common.HexToAddress("0xa")
+	testStateDB.SetCode(testCallback, []byte{0x1})
+	gasLimit := uint64(100000)
+
+	// inbound handler runs in context of the target contract
+	evm := new(testEVM)
+	ref := newTestRef(common.EmptyAddress, evm)
+	ref.PushContext(&native.Context{Caller: utils.CrossChainManagerContractAddress, ContractAddress: this})
+	s := native.NewNativeContract(testStateDB, ref)
+
+	assert.Error(t, Dispatch(s, testChainID, message(MethodOnCrossChainMessage, 1)))
+	assert.NoError(t, registerRoute(alice, testCallback, gasLimit))
+	assert.Error(t, Dispatch(s, testChainID, message("unlock", 1)))
+	assert.Error(t, Dispatch(s, testChainID+1, message(MethodOnCrossChainMessage, 1)))
+
+	// the callback is called by the router with the payload, and the gas left over refunded
+	assert.NoError(t, Dispatch(s, testChainID, message(MethodOnCrossChainMessage, 1)))
+	expected, _ := utils.PackMethod(CallbackABI, MethodOnCrossChainMessage, []byte("hello"))
+	assert.Equal(t, this, evm.caller)
+	assert.Equal(t, testCallback, evm.addr)
+	assert.Equal(t, expected, evm.input)
+	assert.Equal(t, testSupplyGas-gasLimit/2, ref.GasLeft())
+
+	// the failed callback never fails the import, while the message is kept for retry
+	evm.fail = true
+	assert.NoError(t, Dispatch(s, testChainID, message(MethodOnCrossChainMessage, 2)))
+	assert.Equal(t, testSupplyGas-gasLimit/2-gasLimit, ref.GasLeft())
+	hash := messageHash(testChainID, []byte{2})
+	msg, err := getFailedMessage(s, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, &Message{SourceChainID: testChainID, SourceApp: testApp, Payload: []byte("hello")}, msg)
+
+	assert.Error(t, retry(alice, &testEVM{fail: true}, hash))
+	assert.NoError(t, retry(alice, new(testEVM), hash))
+	assert.Equal(t, ErrMessageNotFound, retry(alice, new(testEVM), hash))
+
+	// the import fails if the gas left could not cover the callback
+	s = native.NewNativeContract(testStateDB, native.NewContractRef(testStateDB, alice, alice, big.NewInt(1), common.EmptyHash, gasLimit-1, evm.call))
+	assert.Error(t, Dispatch(s, testChainID, message(MethodOnCrossChainMessage, 3)))
+}
+
+func TestDestinationGasLimit(t *testing.T) {
+	resetTestContext()
+	alice := common.HexToAddress("0xa")
+	testStateDB.SetCode(testCallback, []byte{0x1})
+	gasLimit := uint64(100000)
+	assert.NoError(t, registerRoute(alice, testCallback, gasLimit))
+
+	evm := new(testEVM)
+	ref := newTestRef(common.EmptyAddress, evm)
+	s := native.NewNativeContract(testStateDB, ref)
+
+	// the message is rejected if the gas limit specified by the source could not cover the callback
+	msg := message(MethodOnCrossChainMessage, 1)
+	msg.GasLimit = gasLimit - 1
+	assert.Error(t, cross_chain_manager.ExecuteInbound(s, testChainID, msg))
+	assert.Nil(t, evm.input)
+	assert.Equal(t, testSupplyGas, ref.GasLeft())
+
+	msg.GasLimit = gasLimit
+	assert.NoError(t, cross_chain_manager.ExecuteInbound(s, testChainID, msg))
+	assert.Equal(t, testCallback, evm.addr)
+	assert.Equal(t, testSupplyGas-gasLimit/2, ref.GasLeft())
+}
+
+func TestSendMessage(t *testing.T) {
+	resetTestContext()
+	app, callback := common.HexToAddress("0xa"), common.HexToAddress("0xc")
+	testStateDB.SetCode(callback, []byte{0x1})
+	ccm := utils.CrossChainManagerContractAddress
+
+	// the sender registers the outbound callback in cross chain manager
+	payload, _ := utils.PackMethod(scom.ABI, scom.MethodSetOutboundCallback, callback, uint64(100000))
+	_, _, err := newTestRef(app, nil).NativeCall(app, ccm, payload)
+	assert.NoError(t, err)
+
+	evm := new(testEVM)
+	txHash := common.HexToHash("0x123")
+	send, _ := (&MethodSendMessageInput{ToChainID: testChainID, ToContract: testApp, Message: []byte("hello")}).Encode()
+	ref := native.NewContractRef(testStateDB, app, app, big.NewInt(1), txHash, testSupplyGas, evm.call)
+	ref.SetChainConfig(testConfig)
+	enc, _, err := ref.NativeCall(app, this, send)
+	assert.NoError(t, err)
+	output := new(MethodSendMessageOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, uint64(0), output.Sequence)
+
+	// the message sent is flagged for the cross chain bloom
+	flagged := false
+	for _, l := range testStateDB.Logs() {
+		if len(l.Topics) == 2 && l.Topics[0] == types.CrossChainTopic && l.Topics[1] == ABI.Events[EventMessageSent].ID {
+			flagged = true
+		}
+	}
+	assert.True(t, flagged)
+
+	// the sender is notified that the message is accepted
+	crossChainID := crypto.Keccak256(this.Bytes(), txHash.Bytes())
+	expected, _ := utils.PackMethod(cross_chain_manager.OutboundCallbackABI, cross_chain_manager.MethodOnCrossChainLifecycle,
+		testChainID, uint64(0), crossChainID, uint8(cross_chain_manager.StateAccepted))
+	assert.Equal(t, ccm, evm.caller)
+	assert.Equal(t, callback, evm.addr)
+	assert.Equal(t, expected, evm.input)
+
+	// only one message to a chain in a transaction
+	ref = native.NewContractRef(testStateDB, app, app, big.NewInt(1), txHash, testSupplyGas, evm.call)
+	ref.SetChainConfig(testConfig)
+	_, _, err = ref.NativeCall(app, this, send)
+	assert.Equal(t, ErrDuplicateMessage, err)
+
+	query, _ := utils.PackMethod(scom.ABI, scom.MethodOutboundState, testChainID, uint64(0))
+	enc, _, err = newTestRef(app, nil).NativeCall(app, ccm, query)
+	assert.NoError(t, err)
+	state, err := scom.ABI.Unpack(scom.MethodOutboundState, enc)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(cross_chain_manager.StateAccepted), state[0])
+
+	// only the failed message is refunded
+	refund, _ := utils.PackMethod(scom.ABI, scom.MethodRefundOutbound, testChainID, uint64(0))
+	_, _, err = newTestRef(app, nil).NativeCall(app, ccm, refund)
+	assert.Error(t, err)
+}
+
+func TestSendBatch(t *testing.T) {
+	resetTestContext()
+	app := common.HexToAddress("0xa")
+	ccm := utils.CrossChainManagerContractAddress
+	txHash := common.HexToHash("0x456")
+	sendBatch := func(input *MethodSendBatchInput) ([]byte, error) {
+		payload, _ := input.Encode()
+		ref := native.NewContractRef(testStateDB, app, app, big.NewInt(1), txHash, testSupplyGas, new(testEVM).call)
+		ref.SetChainConfig(testConfig)
+		snapshot := testStateDB.Snapshot()
+		enc, _, err := ref.NativeCall(app, this, payload)
+		if err != nil {
+			testStateDB.RevertToSnapshot(snapshot)
+		}
+		return enc, err
+	}
+
+	// the members should be aligned and sent to different chains
+	_, err := sendBatch(&MethodSendBatchInput{ToChainIDs: []uint64{testChainID}, ToContracts: [][]byte{testApp}})
+	assert.Equal(t, ErrInvalidInput, err)
+	_, err = sendBatch(&MethodSendBatchInput{
+		ToChainIDs:  []uint64{testChainID, testChainID},
+		ToContracts: [][]byte{testApp, testApp},
+		Messages:    [][]byte{[]byte("a"), []byte("b")},
+	})
+	assert.Equal(t, ErrDuplicateMessage, err)
+
+	enc, err := sendBatch(&MethodSendBatchInput{ToChainIDs: []uint64{testChainID}, ToContracts: [][]byte{testApp}, Messages: [][]byte{[]byte("a")}})
+	assert.NoError(t, err)
+	output := new(MethodSendBatchOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, txHash, output.BatchID)
+
+	query, _ := utils.PackMethod(scom.ABI, scom.MethodBatch, output.BatchID)
+	enc, _, err = newTestRef(app, nil).NativeCall(app, ccm, query)
+	assert.NoError(t, err)
+	batch, err := scom.ABI.Unpack(scom.MethodBatch, enc)
+	assert.NoError(t, err)
+	assert.Equal(t, app, batch[0])
+	assert.Equal(t, uint8(cross_chain_manager.StateAccepted), batch[1])
+	assert.Equal(t, []uint64{testChainID}, batch[2])
+	assert.Equal(t, []uint64{0}, batch[3])
+
+	// the member of batch is refunded by the batch only
+	refund, _ := utils.PackMethod(scom.ABI, scom.MethodRefundOutbound, testChainID, uint64(0))
+	_, _, err = newTestRef(app, nil).NativeCall(app, ccm, refund)
+	assert.Error(t, err)
+	refund, _ = utils.PackMethod(scom.ABI, scom.MethodRefundBatch, output.BatchID)
+	_, _, err = newTestRef(app, nil).NativeCall(app, ccm, refund)
+	assert.Error(t, err)
+}
+
+func TestOutboundFork(t *testing.T) {
+	resetTestContext()
+	app, callback := common.HexToAddress("0xa"), common.HexToAddress("0xc")
+	testStateDB.SetCode(callback, []byte{0x1})
+	ccm := utils.CrossChainManagerContractAddress
+	payload, _ := utils.PackMethod(scom.ABI, scom.MethodSetOutboundCallback, callback, uint64(100000))
+	_, _, err := newTestRef(app, nil).NativeCall(app, ccm, payload)
+	assert.NoError(t, err)
+
+	config := &params.ChainConfig{ChainID: big.NewInt(1000), CrossChainV2Block: big.NewInt(10)}
+	makeTx := func(height int64, txHash common.Hash, evm *testEVM) *native.NativeContract {
+		ref := native.NewContractRef(testStateDB, app, app, big.NewInt(height), txHash, testSupplyGas, evm.call)
+		ref.SetChainConfig(config)
+		ref.PushContext(&native.Context{Caller: app, ContractAddress: ccm})
+		s := native.NewNativeContract(testStateDB, ref)
+		txParam := &scom.MakeTxParam{
+			TxHash:              txHash.Bytes(),
+			CrossChainID:        txHash.Bytes(),
+			FromContractAddress: app.Bytes(),
+			ToChainID:           testChainID,
+			ToContractAddress:   testApp,
+			Method:              "unlock",
+		}
+		assert.NoError(t, cross_chain_manager.MakeTransaction(s, txParam, testChainID+1))
+		return s
+	}
+
+	// no outbound slot is written and no callback is called before cross chain v2
+	evm := new(testEVM)
+	s := makeTx(9, common.HexToHash("0x1"), evm)
+	count, err := cross_chain_manager.GetOutboundCount(s, testChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+	_, found, err := cross_chain_manager.GetOutboundSequence(s, testChainID, common.HexToHash("0x1").Bytes())
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, common.Address{}, evm.addr)
+
+	s = makeTx(10, common.HexToHash("0x2"), evm)
+	count, err = cross_chain_manager.GetOutboundCount(s, testChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+	seq, found, err := cross_chain_manager.GetOutboundSequence(s, testChainID, common.HexToHash("0x2").Bytes())
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(0), seq)
+	assert.Equal(t, callback, evm.addr)
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package message_router
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_ROUTE          = "st_route"
+	SKP_FAILED_MESSAGE = "st_failed_message"
+)
+
+// getRoute returns nil if the route of source app never registered.
+func getRoute(s *native.NativeContract, chainID uint64, app []byte) (*Route, error) {
+	value, err := s.GetCacheDB().Get(routeKey(chainID, app))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	route := new(Route)
+	if err := rlp.DecodeBytes(value, route); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+func setRoute(s *native.NativeContract, chainID uint64, app []byte, route *Route) error {
+	value, err := rlp.EncodeToBytes(route)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(routeKey(chainID, app), value)
+	return nil
+}
+
+func delRoute(s *native.NativeContract, chainID uint64, app []byte) {
+	s.GetCacheDB().Delete(routeKey(chainID, app))
+}
+
+// getFailedMessage returns nil if the message is not found.
+func getFailedMessage(s *native.NativeContract, hash common.Hash) (*Message, error) {
+	value, err := s.GetCacheDB().Get(failedMessageKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	msg := new(Message)
+	if err := rlp.DecodeBytes(value, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func setFailedMessage(s *native.NativeContract, hash common.Hash, msg *Message) error {
+	value, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(failedMessageKey(hash), value)
+	return nil
+}
+
+func delFailedMessage(s *native.NativeContract, hash common.Hash) {
+	s.GetCacheDB().Delete(failedMessageKey(hash))
+}
+
+// routeKey hashes the source app, since its length varies with the source chain.
+func routeKey(chainID uint64, app []byte) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ROUTE), utils.GetUint64Bytes(chainID), crypto.Keccak256(app))
+}
+
+func failedMessageKey(hash common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_FAILED_MESSAGE), hash.Bytes())
+}
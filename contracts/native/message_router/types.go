@@ -0,0 +1,55 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package message_router
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MethodOnCrossChainMessage is the method which the source apps send the messages to the router
+// with, and the one called on the callback contracts.
+const MethodOnCrossChainMessage = "onCrossChainMessage"
+
+const (
+	// MaxCallbackGas is the upper bound of the gas supplied to a callback.
+	MaxCallbackGas = uint64(2000000)
+)
+
+// Route is the callback contract registered for a source app, the callback is called with at
+// most `GasLimit` gas, which is charged from the transaction delivering the message.
+type Route struct {
+	Owner    common.Address
+	Callback common.Address
+	GasLimit uint64
+}
+
+// Message is the cross chain message routed, it's kept for retry if the callback failed.
+type Message struct {
+	SourceChainID uint64
+	SourceApp     []byte
+	Payload       []byte
+}
+
+// messageHash identifies the message by the source chain and its cross chain id, which is unique
+// on the source chain.
+func messageHash(chainID uint64, crossChainID []byte) common.Hash {
+	return crypto.Keccak256Hash(utils.GetUint64Bytes(chainID), crossChainID)
+}
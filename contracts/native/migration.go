@@ -0,0 +1,197 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// SKP_LAYOUT_VERSION is the storage key of the layout version in every native contract.
+const SKP_LAYOUT_VERSION = "st_layout_version"
+
+// MigrateFunc transforms the storage of the native contract from the previous layout version, it
+// must be deterministic, e.g: never iterate a map without sorting the keys.
+type MigrateFunc func(db *state.CacheDB, contract common.Address) error
+
+// Migration is a versioned transformation of the native contract storage layout, which is applied
+// at the hard fork block configured in `ChainConfig.NativeMigrations` by its name.
+type Migration struct {
+	Name     string         // unique name referred by chain config
+	Contract common.Address // native contract of the storage
+	Version  uint64         // layout version after migration, the layout version is 0 at genesis
+	Migrate  MigrateFunc
+}
+
+var migrations = make(map[string]*Migration)
+
+// RegisterMigration record the storage migration of the native contract, it should be called in
+// the contract's init function, and panic if the migration is invalid or registered twice.
+func RegisterMigration(m *Migration) {
+	if m.Name == "" || m.Migrate == nil || m.Version == 0 {
+		panic(fmt.Sprintf("invalid native migration %s", m.Name))
+	}
+	if _, ok := migrations[m.Name]; ok {
+		panic(fmt.Sprintf("native migration %s registered twice", m.Name))
+	}
+	for _, v := range migrations { // nativecheck:ignore registration only
+		if v.Contract == m.Contract && v.Version == m.Version {
+			panic(fmt.Sprintf("native migration %s conflicts with %s on version %d", m.Name, v.Name, m.Version))
+		}
+	}
+	migrations[m.Name] = m
+}
+
+// GetMigration returns the registered migration of the name.
+func GetMigration(name string) (*Migration, bool) {
+	m, ok := migrations[name]
+	return m, ok
+}
+
+// LayoutVersion returns the storage layout version of the native contract.
+func LayoutVersion(db *state.CacheDB, contract common.Address) uint64 {
+	value, _ := db.Get(layoutVersionKey(contract))
+	if len(value) == 0 {
+		return 0
+	}
+	return utils.GetBytesUint64(value)
+}
+
+func setLayoutVersion(db *state.CacheDB, contract common.Address, version uint64) {
+	db.Put(layoutVersionKey(contract), utils.GetUint64Bytes(version))
+}
+
+func layoutVersionKey(contract common.Address) []byte {
+	return utils.ConcatKey(contract, []byte(SKP_LAYOUT_VERSION))
+}
+
+// MoveKey moves the value of the storage key to a new key, e.g: key prefix changes, nothing happens
+// if the value not exist.
+func MoveKey(db *state.CacheDB, from, to []byte) error {
+	value, err := db.Get(from)
+	if err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		return nil
+	}
+	db.Delete(from)
+	db.Put(to, value)
+	return nil
+}
+
+// MigrationsAt returns the migrations scheduled at the block in ascending order of contract
+// address and version, which is the order they are applied in.
+func MigrationsAt(schedule map[string]*big.Int, number *big.Int) ([]*Migration, error) {
+	names := make([]string, 0)
+	for name, block := range schedule {
+		if block != nil && block.Cmp(number) == 0 {
+			names = append(names, name)
+		}
+	}
+	return sortedMigrations(names)
+}
+
+func sortedMigrations(names []string) ([]*Migration, error) {
+	list := make([]*Migration, 0, len(names))
+	for _, name := range names {
+		m, ok := migrations[name]
+		if !ok {
+			return nil, fmt.Errorf("native migration %s not exist", name)
+		}
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if c := bytes.Compare(list[i].Contract[:], list[j].Contract[:]); c != 0 {
+			return c < 0
+		}
+		return list[i].Version < list[j].Version
+	})
+	return list, nil
+}
+
+// RunMigrations applies the migrations in order, the layout version of the contract must be right
+// before the version of migration, otherwise the migration fails and so does the block.
+func RunMigrations(db *state.StateDB, list []*Migration) error {
+	cache := (*state.CacheDB)(db)
+	for _, m := range list {
+		if cur := LayoutVersion(cache, m.Contract); cur+1 != m.Version {
+			return fmt.Errorf("native migration %s: layout version %d of %s, expect %d", m.Name, cur, m.Contract.Hex(), m.Version-1)
+		}
+		if err := m.Migrate(cache, m.Contract); err != nil {
+			return fmt.Errorf("native migration %s: %v", m.Name, err)
+		}
+		setLayoutVersion(cache, m.Contract, m.Version)
+		log.Info("Applied native migration", "name", m.Name, "contract", m.Contract.Hex(), "version", m.Version)
+	}
+	return nil
+}
+
+// ApplyMigrations applies the native migrations scheduled at the block according to the chain
+// config, it should be called at the beginning of block processing, before any transaction.
+func ApplyMigrations(config *params.ChainConfig, db *state.StateDB, number *big.Int) error {
+	if len(config.NativeMigrations) == 0 {
+		return nil
+	}
+	list, err := MigrationsAt(config.NativeMigrations, number)
+	if err != nil {
+		return err
+	}
+	return RunMigrations(db, list)
+}
+
+// MigrationReport is the outcome of a migration in dry run.
+type MigrationReport struct {
+	Name     string
+	Contract common.Address
+	Version  uint64
+	Slots    int64       // net number of storage slots occupied by the migration
+	Root     common.Hash // intermediate state root after the migration
+	Err      error
+}
+
+// DryRunMigrations applies the migrations of the names in the same order as a hard fork block
+// does, and reports the outcome of each one. the state is modified, so the caller should pass a
+// copy of state, and the migrations after the failed one are not applied.
+func DryRunMigrations(db *state.StateDB, names []string) ([]*MigrationReport, error) {
+	list, err := sortedMigrations(names)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*MigrationReport, 0, len(list))
+	for _, m := range list {
+		slots := db.NativeSlots()
+		report := &MigrationReport{Name: m.Name, Contract: m.Contract, Version: m.Version}
+		report.Err = RunMigrations(db, []*Migration{m})
+		report.Slots = db.NativeSlots() - slots
+		report.Root = db.IntermediateRoot(true)
+		reports = append(reports, report)
+		if report.Err != nil {
+			break
+		}
+	}
+	return reports, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigration(t *testing.T) {
+	contract := testCalleeB
+	oldKey := utils.ConcatKey(contract, []byte("old"))
+	newKey := utils.ConcatKey(contract, []byte("new"))
+
+	RegisterMigration(&Migration{Name: "test_rename", Contract: contract, Version: 1, Migrate: func(db *state.CacheDB, contract common.Address) error {
+		return MoveKey(db, oldKey, newKey)
+	}})
+	RegisterMigration(&Migration{Name: "test_append", Contract: contract, Version: 2, Migrate: func(db *state.CacheDB, contract common.Address) error {
+		value, _ := db.Get(newKey)
+		db.Put(newKey, append(value, make([]byte, 40)...))
+		return nil
+	}})
+	RegisterMigration(&Migration{Name: "test_fail", Contract: contract, Version: 3, Migrate: func(db *state.CacheDB, contract common.Address) error {
+		return errors.New("failed")
+	}})
+	assert.Panics(t, func() {
+		RegisterMigration(&Migration{Name: "test_conflict", Contract: contract, Version: 2, Migrate: func(db *state.CacheDB, contract common.Address) error {
+			return nil
+		}})
+	})
+
+	// native contracts are kept from deletion of empty accounts by the code set at genesis
+	db, _ := newTestRef(t, 0)
+	db.SetCode(contract, contract[:])
+	cache := (*state.CacheDB)(db)
+	cache.Put(oldKey, []byte{1})
+
+	// dry run reports every migration till the failed one
+	reports, err := DryRunMigrations(db.Copy(), []string{"test_fail", "test_append", "test_rename"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(reports))
+	assert.Equal(t, "test_rename", reports[0].Name)
+	assert.NoError(t, reports[0].Err)
+	assert.Equal(t, int64(1), reports[0].Slots, "layout version takes one slot")
+	assert.Equal(t, "test_append", reports[1].Name)
+	assert.Equal(t, int64(1), reports[1].Slots)
+	assert.Error(t, reports[2].Err)
+	_, err = DryRunMigrations(db.Copy(), []string{"test_unknown"})
+	assert.Error(t, err)
+
+	// migrations applied at the configured block only
+	config := &params.ChainConfig{NativeMigrations: map[string]*big.Int{
+		"test_rename": big.NewInt(10),
+		"test_append": big.NewInt(10),
+	}}
+	assert.NoError(t, ApplyMigrations(config, db, big.NewInt(9)))
+	assert.Equal(t, uint64(0), LayoutVersion(cache, contract))
+	assert.NoError(t, ApplyMigrations(config, db, big.NewInt(10)))
+	assert.Equal(t, uint64(2), LayoutVersion(cache, contract))
+	value, _ := cache.Get(oldKey)
+	assert.Empty(t, value)
+	value, _ = cache.Get(newKey)
+	assert.Equal(t, 41, len(value))
+
+	// the layout version should be right before the migration
+	assert.Error(t, ApplyMigrations(config, db, big.NewInt(10)))
+	config.NativeMigrations["test_unknown"] = big.NewInt(11)
+	assert.Error(t, ApplyMigrations(config, db, big.NewInt(11)))
+}
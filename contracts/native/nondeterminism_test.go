@@ -0,0 +1,56 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/internal/nativecheck"
+)
+
+// TestNondeterminism checks the sources of all native contracts, any map iteration without
+// sorting, wall clock, randomness or floating point in the handlers may split the network.
+func TestNondeterminism(t *testing.T) {
+	checker, err := nativecheck.NewChecker("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return err
+		}
+		switch info.Name() {
+		case "testdata", "go_abi", "interfaces":
+			return filepath.SkipDir
+		}
+		issues, err := checker.CheckDir(path)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			t.Error(issue)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
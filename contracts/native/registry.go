@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+//go:generate go run ../../cmd/nativeabi --go ./go_abi --sol ./interfaces --ts ./interfaces/typings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ContractABI is the registry entry of a native contract's abi. the json definition is the only
+// source of truth, go packing code, solidity interfaces and typescript typings are all generated
+// from it with `go generate`, so the method ids stay the same in every implementation.
+type ContractABI struct {
+	Name    string         // native contract name, e.g: `node_manager`
+	Type    string         // type name used by generated code, e.g: `NodeManager`
+	Address common.Address // native contract address
+	JSON    string         // abi json definition
+}
+
+var abiRegistry = make(map[string]*ContractABI)
+
+// RegisterABI record native contract's abi json into the registry, it should be called in the
+// contract's init function, and panic if the json is invalid or the name has been registered with
+// a different definition.
+func RegisterABI(name, typ string, json string) {
+	addr, ok := NativeContractAddrMap[name]
+	if !ok {
+		panic(fmt.Sprintf("native contract %s not exist", name))
+	}
+	if _, err := abi.JSON(strings.NewReader(json)); err != nil {
+		panic(fmt.Sprintf("invalid abi of native contract %s: %v", name, err))
+	}
+	if exist, ok := abiRegistry[name]; ok {
+		if exist.Type != typ || exist.JSON != json {
+			panic(fmt.Sprintf("native contract %s abi registered twice", name))
+		}
+		return
+	}
+	abiRegistry[name] = &ContractABI{
+		Name:    name,
+		Type:    typ,
+		Address: addr,
+		JSON:    json,
+	}
+}
+
+// RegisteredABI returns the registered abi of the native contract.
+func RegisteredABI(name string) (*ContractABI, bool) {
+	entry, ok := abiRegistry[name]
+	return entry, ok
+}
+
+// ABIByAddress returns the registered abi of the native contract at the address.
+func ABIByAddress(addr common.Address) (*ContractABI, bool) {
+	for _, entry := range abiRegistry { // nativecheck:ignore lookup only
+		if entry.Address == addr {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// RegisteredABIs returns all of the registered native contract abi sorted by name.
+func RegisteredABIs() []*ContractABI {
+	list := make([]*ContractABI, 0, len(abiRegistry))
+	for _, v := range abiRegistry {
+		list = append(list, v)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// SystemCall is a native contract call initiated by the consensus instead of any account. it is
+// carried by a system transaction which the block proposer appends to the block, and every node
+// checks that the block carries exactly the expected system calls.
+type SystemCall struct {
+	To    common.Address
+	Input []byte
+}
+
+// SystemCallGenerator returns the input of the system call to the native contract at the block
+// height, or nil if the contract has nothing to do. it is evaluated on the state after all of the
+// common transactions of the block, and must be deterministic.
+type SystemCallGenerator func(db *state.StateDB, height *big.Int) []byte
+
+var systemCallGenerators = make(map[common.Address]SystemCallGenerator)
+
+// RegisterSystemCall record the system call generator of the native contract, it should be called
+// in the contract's init function, and panic if the contract not exist.
+func RegisterSystemCall(name string, gen SystemCallGenerator) {
+	addr, ok := NativeContractAddrMap[name]
+	if !ok {
+		panic(fmt.Sprintf("native contract %s not exist", name))
+	}
+	systemCallGenerators[addr] = gen
+}
+
+// SystemCalls returns the system calls due at the block height in ascending order of contract
+// address.
+func SystemCalls(db *state.StateDB, height *big.Int) []*SystemCall {
+	addrs := make([]common.Address, 0, len(systemCallGenerators))
+	for addr := range systemCallGenerators {
+		addrs = append(addrs, addr)
+	}
+	sortAddresses(addrs)
+
+	var calls []*SystemCall
+	for _, addr := range addrs {
+		if input := systemCallGenerators[addr](db, height); input != nil {
+			calls = append(calls, &SystemCall{To: addr, Input: input})
+		}
+	}
+	return calls
+}
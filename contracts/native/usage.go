@@ -0,0 +1,58 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package native
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// StorageRefundGas is the gas refunded for each native storage slot released by a transaction,
+// which is the same as clearing a storage slot in evm. the refund is capped by state transition
+// as other refunds.
+const StorageRefundGas = params.SstoreClearsScheduleRefundEIP3529
+
+// accountStorage tracks the storage growth of the method, and grants gas refund for the slots
+// released since the storage refund fork. the nested native calls are included in the delta of
+// the entry call, so that the refund is only granted once in the entry context.
+func (s *NativeContract) accountStorage(contract common.Address, methodID string, delta int64) {
+	if delta == 0 {
+		return
+	}
+
+	name := methodID
+	if id, err := hexutil.Decode(methodID); err == nil && s.ab != nil {
+		if method, err := s.ab.MethodById(id); err == nil {
+			name = method.Name
+		}
+	}
+	prefix := fmt.Sprintf("native/storage/%s/%s", contract.Hex(), name)
+	if delta > 0 {
+		metrics.GetOrRegisterCounter(prefix+"/grown", nil).Inc(delta)
+	} else {
+		metrics.GetOrRegisterCounter(prefix+"/freed", nil).Inc(-delta)
+	}
+
+	if delta < 0 && len(s.ref.contexts) == 1 && s.ref.IsStorageRefund() {
+		s.db.AddRefund(uint64(-delta) * StorageRefundGas)
+	}
+}
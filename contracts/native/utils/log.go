@@ -0,0 +1,35 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package utils
+
+import "github.com/ethereum/go-ethereum/log"
+
+// the modules of native loggers, the verbosity of which could be raised by `--log.tag`, e.g.
+// `--log.tag cross_chain/2=5` raises the logs of cross chain transactions from chain 2 only.
+const (
+	LogModuleNodeManager = "node_manager"
+	LogModuleHeaderSync  = "header_sync"
+	LogModuleCrossChain  = "cross_chain"
+)
+
+// NewLogger creates the logger of native module, the records are tagged with the module and the
+// context fields, in which the `chainID` field tags the records of a single chain.
+func NewLogger(module string, ctx ...interface{}) log.Logger {
+	return log.New(append([]interface{}{log.TagModuleKey, module}, ctx...)...)
+}
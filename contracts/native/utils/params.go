@@ -40,6 +40,18 @@ var (
 	NodeManagerContractAddress       = common.HexToAddress("0xA4Bf827047a08510722B2d62e668a72FCCFa232C")
 	RelayerManagerContractAddress    = common.HexToAddress("0xA22f301D7Cb5b50dcA4a015b12EC0cc5f3971412")
 	Neo3StateManagerContractAddress  = common.HexToAddress("0x5E839898821dB2A2F0eC9F8aAE7D7053744DB051")
+	AccessControlContractAddress     = common.HexToAddress("0x7d79D936DA7833c7fe056eB450064f34A327DcA8")
+	TimelockContractAddress          = common.HexToAddress("0xD37F626c9E007DdD244E5Cbee0C223fec6D11289")
+	AuditLogContractAddress          = common.HexToAddress("0x33463b771Da32D450723C7C23a2240dE223b53bd")
+	PriceOracleContractAddress       = common.HexToAddress("0x0F257CD338Fa8F1Af3D31b16C1fBddae2Dc96D41")
+	DataOracleContractAddress        = common.HexToAddress("0x4479AcbCeA458Badf21dbEC7Db6fC236Bf08fbb9")
+	SchedulerContractAddress         = common.HexToAddress("0xc204aDF052C52F74863d76c94a311b82D98d87AE")
+	SystemContractAddress            = common.HexToAddress("0xD62B67170A6bb645f1c59601FbC6766940ee12e5")
+	MaintenanceContractAddress       = common.HexToAddress("0xf7EBd79DB6240b9A85571f61b543425e2A7045Fb")
+	WZionContractAddress             = common.HexToAddress("0x20B019ea369923eF1971A30f1974003051f1863C")
+	MessageRouterContractAddress     = common.HexToAddress("0x2951b823F25344797D9294634F44e867490B86c9")
+	EscrowContractAddress            = common.HexToAddress("0x370f0dDA62BDc610d8FFE8c71882D27d2a26648f")
+	AccountingContractAddress        = common.HexToAddress("0xC782D7244bdd2ebeb56ac87A65c4873B6c4D427D")
 
 	BTC_ROUTER              = uint64(1)
 	ETH_ROUTER              = uint64(2)
@@ -0,0 +1,204 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	abiPkg "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+// MaxPayloadBytes is the max size of the calldata of every native contract method, which is the
+// max size of transactions accepted by the tx pool.
+const MaxPayloadBytes = 128 * 1024
+
+// ArgRule is the declarative bound of an argument of native contract method, it's checked against
+// the argument unpacked with the abi before dispatching the handler, so that handlers share the
+// same bounds checking instead of re-implementing it.
+type ArgRule struct {
+	MaxBytes int      // max length of bytes or string, 0 = unbounded
+	MaxLen   int      // max number of elements of slice, 0 = unbounded
+	NonZero  bool     // address, bytes, string or integer must not be zero or empty
+	Min, Max *big.Int // inclusive range of integer, nil = unbounded
+}
+
+// MethodRules maps the argument name in abi to its rule.
+type MethodRules map[string]*ArgRule
+
+// InvalidInputError is returned when the calldata breaks the rules, it's reverted with the reason
+// like MethodDisabledError.
+type InvalidInputError struct {
+	Contract common.Address
+	Method   string
+	Arg      string
+	Reason   string
+}
+
+func (e *InvalidInputError) Error() string {
+	if e.Arg == "" {
+		return fmt.Sprintf("invalid input: %s of %s, %s", e.Method, e.Contract.Hex(), e.Reason)
+	}
+	return fmt.Sprintf("invalid input: %s.%s of %s, %s", e.Method, e.Arg, e.Contract.Hex(), e.Reason)
+}
+
+// RevertData implements the RevertError interface, the error is encoded as solidity `Error(string)`.
+func (e *InvalidInputError) RevertData() []byte {
+	return revertReason(e.Error())
+}
+
+// RegisterRules record the rules of method arguments, it should be called after `Prepare`. it panics
+// if the argument is not an input of the method in abi, or the rule doesn't apply to its type.
+func (s *NativeContract) RegisterRules(name string, rules MethodRules) {
+	method, ok := s.ab.Methods[name]
+	if !ok {
+		panic(fmt.Sprintf("rules of unknown method %s", name))
+	}
+	for arg, rule := range rules { // nativecheck:ignore registration only
+		var input *abiPkg.Argument
+		for i := range method.Inputs {
+			if method.Inputs[i].Name == arg {
+				input = &method.Inputs[i]
+			}
+		}
+		if input == nil {
+			panic(fmt.Sprintf("rule of unknown argument %s.%s", name, arg))
+		}
+		if err := rule.applies(input.Type); err != nil {
+			panic(fmt.Sprintf("invalid rule of %s.%s: %v", name, arg, err))
+		}
+	}
+	s.rules[utils.MethodID(s.ab, name)] = rules
+}
+
+// checkInput returns InvalidInputError if the calldata is too large or the arguments break the
+// rules of the method, which are checked in the order of abi.
+func (s *NativeContract) checkInput(contract common.Address, methodID string, payload []byte) error {
+	name := methodID
+	if s.ab != nil {
+		if method, err := s.ab.MethodById(payload[:4]); err == nil {
+			name = method.Name
+		}
+	}
+	if len(payload) > MaxPayloadBytes {
+		return &InvalidInputError{Contract: contract, Method: name, Reason: fmt.Sprintf("calldata exceeds %d bytes", MaxPayloadBytes)}
+	}
+	rules, ok := s.rules[methodID]
+	if !ok {
+		return nil
+	}
+	method := s.ab.Methods[name]
+	// the malformed calldata is left to the handler, which reports its own decoding error
+	values, err := method.Inputs.Unpack(payload[4:])
+	if err != nil {
+		return nil
+	}
+	for i, input := range method.Inputs {
+		rule, ok := rules[input.Name]
+		if !ok {
+			continue
+		}
+		if err := rule.check(values[i]); err != nil {
+			return &InvalidInputError{Contract: contract, Method: name, Arg: input.Name, Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+var errRuleType = errors.New("rule not applicable to the type")
+
+// applies returns errRuleType if any bound of the rule doesn't apply to the abi type.
+func (r *ArgRule) applies(typ abiPkg.Type) error {
+	isInt := typ.T == abiPkg.IntTy || typ.T == abiPkg.UintTy
+	if r.MaxBytes > 0 && typ.T != abiPkg.BytesTy && typ.T != abiPkg.StringTy {
+		return errRuleType
+	}
+	if r.MaxLen > 0 && typ.T != abiPkg.SliceTy {
+		return errRuleType
+	}
+	if (r.Min != nil || r.Max != nil) && !isInt {
+		return errRuleType
+	}
+	if r.NonZero && !isInt && typ.T != abiPkg.AddressTy && typ.T != abiPkg.FixedBytesTy &&
+		typ.T != abiPkg.BytesTy && typ.T != abiPkg.StringTy {
+		return errRuleType
+	}
+	return nil
+}
+
+// check returns the broken bound of the argument value unpacked with abi.
+func (r *ArgRule) check(value interface{}) error {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		if r.MaxBytes > 0 && rv.Len() > r.MaxBytes {
+			return fmt.Errorf("exceeds %d bytes", r.MaxBytes)
+		}
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			if r.MaxBytes > 0 && rv.Len() > r.MaxBytes {
+				return fmt.Errorf("exceeds %d bytes", r.MaxBytes)
+			}
+		} else if r.MaxLen > 0 && rv.Len() > r.MaxLen {
+			return fmt.Errorf("exceeds %d elements", r.MaxLen)
+		}
+	}
+	if r.NonZero && isZeroValue(rv) {
+		return errors.New("zero value")
+	}
+	if r.Min != nil || r.Max != nil {
+		n := bigValue(value)
+		if r.Min != nil && n.Cmp(r.Min) < 0 {
+			return fmt.Errorf("less than %v", r.Min)
+		}
+		if r.Max != nil && n.Cmp(r.Max) > 0 {
+			return fmt.Errorf("greater than %v", r.Max)
+		}
+	}
+	return nil
+}
+
+func isZeroValue(rv reflect.Value) bool {
+	if n, ok := rv.Interface().(*big.Int); ok {
+		return n == nil || n.Sign() == 0
+	}
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice:
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
+
+// bigValue converts the integer unpacked with abi to big integer.
+func bigValue(value interface{}) *big.Int {
+	if n, ok := value.(*big.Int); ok {
+		return n
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint())
+	default:
+		return big.NewInt(rv.Int())
+	}
+}
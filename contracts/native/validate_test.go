@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputRules(t *testing.T) {
+	call := func(config *params.ChainConfig, to common.Address, input []byte) error {
+		_, ref := newTestRef(t, testWriteGas)
+		ref.SetChainConfig(config)
+		payload, err := utils.PackMethod(testABI, "call", to, input)
+		assert.NoError(t, err)
+		_, _, err = ref.NativeCall(ref.caller, testCallerA, payload)
+		return err
+	}
+	rejected := func(err error, arg string) {
+		var invalid *InvalidInputError
+		if assert.True(t, errors.As(err, &invalid)) {
+			assert.Equal(t, testCallerA, invalid.Contract)
+			assert.Equal(t, "call", invalid.Method)
+			assert.Equal(t, arg, invalid.Arg)
+			reason, err := abi.UnpackRevert(invalid.RevertData())
+			assert.NoError(t, err)
+			assert.Equal(t, invalid.Error(), reason)
+		}
+	}
+
+	write, err := utils.PackMethod(testABI, "write")
+	assert.NoError(t, err)
+	assert.NoError(t, call(nil, testCalleeB, write))
+	rejected(call(nil, common.Address{}, write), "To")
+	rejected(call(nil, testCalleeB, make([]byte, 257)), "Input")
+	rejected(call(nil, testCalleeB, make([]byte, MaxPayloadBytes)), "")
+
+	// the rules are not checked before the fork
+	err = call(&params.ChainConfig{InputRulesBlock: big.NewInt(2)}, common.Address{}, write)
+	var invalid *InvalidInputError
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &invalid))
+}
+
+func TestArgRule(t *testing.T) {
+	rule := &ArgRule{NonZero: true, Min: big.NewInt(2), Max: big.NewInt(10)}
+	assert.Error(t, rule.check(uint64(0)))
+	assert.Error(t, rule.check(uint64(1)))
+	assert.NoError(t, rule.check(uint64(2)))
+	assert.NoError(t, rule.check(big.NewInt(10)))
+	assert.Error(t, rule.check(int64(11)))
+
+	rule = &ArgRule{MaxLen: 2}
+	assert.NoError(t, rule.check([][]byte{{1}, {2}}))
+	assert.Error(t, rule.check([][]byte{{1}, {2}, {3}}))
+
+	rule = &ArgRule{NonZero: true, MaxBytes: 3}
+	assert.Error(t, rule.check(""))
+	assert.NoError(t, rule.check("abc"))
+	assert.Error(t, rule.check("abcd"))
+	assert.Error(t, rule.check([]byte{}))
+
+	// the rules are validated against abi on registration
+	s := NewNativeContract(nil, nil)
+	s.Prepare(testABI, nil)
+	assert.Panics(t, func() { s.RegisterRules("call", MethodRules{"Unknown": {NonZero: true}}) })
+	assert.Panics(t, func() { s.RegisterRules("call", MethodRules{"To": {MaxBytes: 20}}) })
+	assert.Panics(t, func() { s.RegisterRules("missing", MethodRules{}) })
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package wzion
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const (
+	contractName = "Wrapped Zion"
+	symbol       = "WZION"
+	decimals     = uint8(18)
+)
+
+const (
+	MethodContractName = "name"
+	MethodSymbol       = "symbol"
+	MethodDecimals     = "decimals"
+	MethodTotalSupply  = "totalSupply"
+	MethodBalanceOf    = "balanceOf"
+	MethodDeposit      = "deposit"
+	MethodWithdraw     = "withdraw"
+	MethodTransfer     = "transfer"
+	MethodLock         = "lock"
+	MethodBindProxy    = "bindProxy"
+	MethodProxy        = "proxy"
+	MethodBridged      = "bridged"
+
+	EventTransferred = "transferred"
+	EventDeposited   = "deposited"
+	EventWithdrawn   = "withdrawn"
+	EventLocked      = "locked"
+	EventUnlocked    = "unlocked"
+	EventProxyBound  = "proxyBound"
+)
+
+const abijson = `[
+	{"type":"function","name":"` + MethodContractName + `","inputs":[],"outputs":[{"internalType":"string","name":"Name","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodSymbol + `","inputs":[],"outputs":[{"internalType":"string","name":"Symbol","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodDecimals + `","inputs":[],"outputs":[{"internalType":"uint8","name":"Decimals","type":"uint8"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodTotalSupply + `","inputs":[],"outputs":[{"internalType":"uint256","name":"Amount","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodBalanceOf + `","inputs":[{"internalType":"address","name":"Account","type":"address"}],"outputs":[{"internalType":"uint256","name":"Amount","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodDeposit + `","inputs":[],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"payable"},
+	{"type":"function","name":"` + MethodWithdraw + `","inputs":[{"internalType":"uint256","name":"Amount","type":"uint256"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodTransfer + `","inputs":[{"internalType":"address","name":"To","type":"address"},{"internalType":"uint256","name":"Amount","type":"uint256"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodLock + `","inputs":[{"internalType":"uint64","name":"ToChainID","type":"uint64"},{"internalType":"bytes","name":"ToAddress","type":"bytes"},{"internalType":"uint256","name":"Amount","type":"uint256"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodBindProxy + `","inputs":[{"internalType":"uint64","name":"ChainID","type":"uint64"},{"internalType":"bytes","name":"Proxy","type":"bytes"},{"internalType":"bytes","name":"Asset","type":"bytes"}],"outputs":[{"internalType":"bool","name":"Success","type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"` + MethodProxy + `","inputs":[{"internalType":"uint64","name":"ChainID","type":"uint64"}],"outputs":[{"internalType":"bytes","name":"Proxy","type":"bytes"},{"internalType":"bytes","name":"Asset","type":"bytes"}],"stateMutability":"view"},
+	{"type":"function","name":"` + MethodBridged + `","inputs":[{"internalType":"uint64","name":"ChainID","type":"uint64"}],"outputs":[{"internalType":"uint256","name":"Amount","type":"uint256"}],"stateMutability":"view"},
+	{"type":"event","name":"` + EventTransferred + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"From","type":"address"},{"indexed":false,"internalType":"address","name":"To","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]},
+	{"type":"event","name":"` + EventDeposited + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Account","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]},
+	{"type":"event","name":"` + EventWithdrawn + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"Account","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]},
+	{"type":"event","name":"` + EventLocked + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"From","type":"address"},{"indexed":false,"internalType":"uint64","name":"ToChainID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"ToAddress","type":"bytes"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]},
+	{"type":"event","name":"` + EventUnlocked + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"FromChainID","type":"uint64"},{"indexed":false,"internalType":"address","name":"To","type":"address"},{"indexed":false,"internalType":"uint256","name":"Amount","type":"uint256"}]},
+	{"type":"event","name":"` + EventProxyBound + `","anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"ChainID","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"Proxy","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"Asset","type":"bytes"}]}
+]`
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(abijson))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.WZionContractAddress
+)
+
+type MethodContractNameOutput struct {
+	Name string
+}
+
+func (m *MethodContractNameOutput) Encode() ([]byte, error) {
+	m.Name = contractName
+	return utils.PackOutputs(ABI, MethodContractName, m.Name)
+}
+func (m *MethodContractNameOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodContractName, m, payload)
+}
+
+type MethodBalanceOfInput struct {
+	Account common.Address
+}
+
+func (m *MethodBalanceOfInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodBalanceOf, m.Account)
+}
+func (m *MethodBalanceOfInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodBalanceOf, m, payload)
+}
+
+// MethodAmountOutput is shared by `totalSupply`, `balanceOf` and `bridged`.
+type MethodAmountOutput struct {
+	Amount *big.Int
+}
+
+func (m *MethodAmountOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Amount)
+}
+func (m *MethodAmountOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+type MethodWithdrawInput struct {
+	Amount *big.Int
+}
+
+func (m *MethodWithdrawInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodWithdraw, m.Amount)
+}
+func (m *MethodWithdrawInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodWithdraw, m, payload)
+}
+
+type MethodTransferInput struct {
+	To     common.Address
+	Amount *big.Int
+}
+
+func (m *MethodTransferInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodTransfer, m.To, m.Amount)
+}
+func (m *MethodTransferInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodTransfer, m, payload)
+}
+
+type MethodLockInput struct {
+	ToChainID uint64
+	ToAddress []byte
+	Amount    *big.Int
+}
+
+func (m *MethodLockInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodLock, m.ToChainID, m.ToAddress, m.Amount)
+}
+func (m *MethodLockInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodLock, m, payload)
+}
+
+type MethodBindProxyInput struct {
+	ChainID uint64
+	Proxy   []byte
+	Asset   []byte
+}
+
+func (m *MethodBindProxyInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodBindProxy, m.ChainID, m.Proxy, m.Asset)
+}
+func (m *MethodBindProxyInput) Decode(payload []byte) error {
+	return utils.UnpackMethod(ABI, MethodBindProxy, m, payload)
+}
+
+// MethodChainIDInput is shared by `proxy` and `bridged`.
+type MethodChainIDInput struct {
+	ChainID uint64
+}
+
+func (m *MethodChainIDInput) Encode(method string) ([]byte, error) {
+	return utils.PackMethod(ABI, method, m.ChainID)
+}
+func (m *MethodChainIDInput) Decode(method string, payload []byte) error {
+	return utils.UnpackMethod(ABI, method, m, payload)
+}
+
+type MethodProxyOutput struct {
+	Proxy []byte
+	Asset []byte
+}
+
+func (m *MethodProxyOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodProxy, m.Proxy, m.Asset)
+}
+func (m *MethodProxyOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodProxy, m, payload)
+}
+
+type MethodBoolOutput struct {
+	Success bool
+}
+
+func (m *MethodBoolOutput) Encode(method string) ([]byte, error) {
+	return utils.PackOutputs(ABI, method, m.Success)
+}
+func (m *MethodBoolOutput) Decode(method string, payload []byte) error {
+	return utils.UnpackOutputs(ABI, method, m, payload)
+}
+
+func emitTransferred(s *native.NativeContract, from, to common.Address, amount *big.Int) error {
+	return s.AddNotify(ABI, []string{EventTransferred}, from, to, amount)
+}
+
+// emitAccountEvent emits `deposited` or `withdrawn`.
+func emitAccountEvent(s *native.NativeContract, event string, account common.Address, amount *big.Int) error {
+	return s.AddNotify(ABI, []string{event}, account, amount)
+}
+
+func emitLocked(s *native.NativeContract, from common.Address, toChainID uint64, toAddress []byte, amount *big.Int) error {
+	return s.AddCrossChainNotify(ABI, []string{EventLocked}, from, toChainID, toAddress, amount)
+}
+
+func emitUnlocked(s *native.NativeContract, fromChainID uint64, to common.Address, amount *big.Int) error {
+	return s.AddCrossChainNotify(ABI, []string{EventUnlocked}, fromChainID, to, amount)
+}
+
+func emitProxyBound(s *native.NativeContract, chainID uint64, proxy, asset []byte) error {
+	return s.AddNotify(ABI, []string{EventProxyBound}, chainID, proxy, asset)
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package wzion
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_BALANCE       = "st_balance"
+	SKP_TOTAL_SUPPLY  = "st_total_supply"
+	SKP_BRIDGED       = "st_bridged"
+	SKP_BRIDGED_TOTAL = "st_bridged_total"
+	SKP_PROXY         = "st_proxy"
+	SKP_LOCK_TX       = "st_lock_tx"
+)
+
+func getAmount(s *native.NativeContract, key []byte) *big.Int {
+	value, _ := s.GetCacheDB().Get(key)
+	return new(big.Int).SetBytes(value)
+}
+
+func setAmount(s *native.NativeContract, key []byte, amount *big.Int) {
+	if amount.Sign() == 0 {
+		s.GetCacheDB().Delete(key)
+	} else {
+		s.GetCacheDB().Put(key, amount.Bytes())
+	}
+}
+
+func addAmount(s *native.NativeContract, key []byte, delta *big.Int) {
+	setAmount(s, key, new(big.Int).Add(getAmount(s, key), delta))
+}
+
+// subAmount returns false if the amount stored is less than delta.
+func subAmount(s *native.NativeContract, key []byte, delta *big.Int) bool {
+	amount := getAmount(s, key)
+	if amount.Cmp(delta) < 0 {
+		return false
+	}
+	setAmount(s, key, amount.Sub(amount, delta))
+	return true
+}
+
+// getProxy returns nil if the lock proxy of chain never bound.
+func getProxy(s *native.NativeContract, chainID uint64) (*ProxyBinding, error) {
+	value, err := s.GetCacheDB().Get(proxyKey(chainID))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	binding := new(ProxyBinding)
+	if err := rlp.DecodeBytes(value, binding); err != nil {
+		return nil, err
+	}
+	return binding, nil
+}
+
+func setProxy(s *native.NativeContract, chainID uint64, binding *ProxyBinding) error {
+	value, err := rlp.EncodeToBytes(binding)
+	if err != nil {
+		return err
+	}
+	s.GetCacheDB().Put(proxyKey(chainID), value)
+	return nil
+}
+
+func getLockTx(s *native.NativeContract) common.Hash {
+	value, _ := s.GetCacheDB().Get(lockTxKey())
+	return common.BytesToHash(value)
+}
+
+func setLockTx(s *native.NativeContract, hash common.Hash) {
+	s.GetCacheDB().Put(lockTxKey(), hash.Bytes())
+}
+
+func balanceKey(account common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_BALANCE), account.Bytes())
+}
+
+func totalSupplyKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_TOTAL_SUPPLY))
+}
+
+func bridgedKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_BRIDGED), utils.GetUint64Bytes(chainID))
+}
+
+func bridgedTotalKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_BRIDGED_TOTAL))
+}
+
+func proxyKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_PROXY), utils.GetUint64Bytes(chainID))
+}
+
+func lockTxKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_LOCK_TX))
+}
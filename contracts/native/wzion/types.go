@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package wzion
+
+import (
+	"fmt"
+	"math/big"
+
+	polycomm "github.com/polynetwork/poly/common"
+)
+
+// MethodUnlock is the method of lock proxy which the bridged token is unlocked by on the other side.
+const MethodUnlock = "unlock"
+
+// ProxyBinding is the lock proxy of the chain which the native token bridged to, and the asset
+// hash of the token on that chain.
+type ProxyBinding struct {
+	Proxy []byte
+	Asset []byte
+	Nonce uint64 // number of changes of the binding, bound into the consensus signs
+}
+
+// TxArgs is the args of lock proxy `unlock`, serialized in the same way as the poly lock proxy.
+type TxArgs struct {
+	ToAssetHash []byte
+	ToAddress   []byte
+	Amount      *big.Int
+}
+
+func (m *TxArgs) Serialization(sink *polycomm.ZeroCopySink) error {
+	if m.Amount == nil || m.Amount.Sign() < 0 || m.Amount.BitLen() > 255 {
+		return fmt.Errorf("invalid amount %v", m.Amount)
+	}
+	sink.WriteVarBytes(m.ToAssetHash)
+	sink.WriteVarBytes(m.ToAddress)
+	// uint255 in little endian
+	buf := make([]byte, 32)
+	enc := m.Amount.Bytes()
+	for i, b := range enc {
+		buf[len(enc)-1-i] = b
+	}
+	sink.WriteBytes(buf)
+	return nil
+}
+
+func (m *TxArgs) Deserialization(source *polycomm.ZeroCopySource) error {
+	var eof bool
+	if m.ToAssetHash, eof = source.NextVarBytes(); eof {
+		return fmt.Errorf("TxArgs deserialize ToAssetHash error")
+	}
+	if m.ToAddress, eof = source.NextVarBytes(); eof {
+		return fmt.Errorf("TxArgs deserialize ToAddress error")
+	}
+	buf, eof := source.NextBytes(32)
+	if eof {
+		return fmt.Errorf("TxArgs deserialize Amount error")
+	}
+	if buf[31]&0x80 != 0 {
+		return fmt.Errorf("TxArgs deserialize Amount exceeds uint255")
+	}
+	enc := make([]byte, 32)
+	for i, b := range buf {
+		enc[31-i] = b
+	}
+	m.Amount = new(big.Int).SetBytes(enc)
+	return nil
+}
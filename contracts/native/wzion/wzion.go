@@ -0,0 +1,390 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package wzion
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/accounting"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/audit_log"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	polycomm "github.com/polynetwork/poly/common"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodContractName: 0,
+		MethodSymbol:       0,
+		MethodDecimals:     0,
+		MethodTotalSupply:  0,
+		MethodBalanceOf:    0,
+		MethodDeposit:      30000,
+		MethodWithdraw:     30000,
+		MethodTransfer:     30000,
+		MethodLock:         100000,
+		MethodBindProxy:    100000,
+		MethodProxy:        0,
+		MethodBridged:      0,
+	}
+)
+
+func InitWZion() {
+	InitABI()
+	native.RegisterABI(native.NativeWZion, "WrappedZion", abijson)
+	cross_chain_manager.RegisterInboundHandler(this, Unlock)
+	native.Contracts[this] = RegisterWZionContract
+}
+
+func RegisterWZionContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.RegisterQuery(MethodContractName, Name)
+	s.RegisterQuery(MethodSymbol, Symbol)
+	s.RegisterQuery(MethodDecimals, Decimals)
+	s.RegisterQuery(MethodTotalSupply, TotalSupply)
+	s.RegisterQuery(MethodBalanceOf, BalanceOf)
+	s.Register(MethodDeposit, Deposit)
+	s.Register(MethodWithdraw, Withdraw)
+	s.Register(MethodTransfer, Transfer)
+	s.Register(MethodLock, Lock)
+	s.Register(MethodBindProxy, BindProxy)
+	s.RegisterQuery(MethodProxy, Proxy)
+	s.RegisterQuery(MethodBridged, Bridged)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return new(MethodContractNameOutput).Encode()
+}
+
+func Symbol(s *native.NativeContract) ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodSymbol, symbol)
+}
+
+func Decimals(s *native.NativeContract) ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodDecimals, decimals)
+}
+
+func TotalSupply(s *native.NativeContract) ([]byte, error) {
+	return (&MethodAmountOutput{Amount: getAmount(s, totalSupplyKey())}).Encode(MethodTotalSupply)
+}
+
+func BalanceOf(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodBalanceOfInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	return (&MethodAmountOutput{Amount: getAmount(s, balanceKey(input.Account))}).Encode(MethodBalanceOf)
+}
+
+// Deposit wraps the native token transferred with the call, which is held by the contract.
+func Deposit(s *native.NativeContract) ([]byte, error) {
+	amount := s.ContractRef().Value()
+	if amount.Sign() <= 0 {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	caller := s.ContractRef().MsgSender()
+	addAmount(s, balanceKey(caller), amount)
+	addAmount(s, totalSupplyKey(), amount)
+	if err := emitAccountEvent(s, EventDeposited, caller, amount); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodDeposit)
+}
+
+// Withdraw unwraps the token and pays the native token back to the caller.
+func Withdraw(s *native.NativeContract) ([]byte, error) {
+	if err := nonPayable(s); err != nil {
+		return utils.ByteFailed, err
+	}
+	input := new(MethodWithdrawInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Amount == nil || input.Amount.Sign() <= 0 {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	caller := s.ContractRef().MsgSender()
+	if err := burn(s, caller, input.Amount); err != nil {
+		return utils.ByteFailed, err
+	}
+	s.StateDB().SubBalance(this, input.Amount)
+	s.StateDB().AddBalance(caller, input.Amount)
+	if err := emitAccountEvent(s, EventWithdrawn, caller, input.Amount); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodWithdraw)
+}
+
+func Transfer(s *native.NativeContract) ([]byte, error) {
+	if err := nonPayable(s); err != nil {
+		return utils.ByteFailed, err
+	}
+	input := new(MethodTransferInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Amount == nil || input.Amount.Sign() < 0 {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	caller := s.ContractRef().MsgSender()
+	if !subAmount(s, balanceKey(caller), input.Amount) {
+		return utils.ByteFailed, ErrInsufficientBalance
+	}
+	addAmount(s, balanceKey(input.To), input.Amount)
+	if err := emitTransferred(s, caller, input.To, input.Amount); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodTransfer)
+}
+
+// Lock bridges the wrapped token of the caller out to the chain, the native token is kept locked
+// in the contract and the lock proxy of the target chain is requested to unlock the same amount
+// of its asset through cross chain manager. only one lock is allowed in a transaction, as the
+// cross chain request is keyed by the tx hash.
+func Lock(s *native.NativeContract) ([]byte, error) {
+	if err := nonPayable(s); err != nil {
+		return utils.ByteFailed, err
+	}
+	if !s.ContractRef().IsCrossChainV2() {
+		return utils.ByteFailed, ErrInvalidChain
+	}
+	input := new(MethodLockInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if input.Amount == nil || input.Amount.Sign() <= 0 || len(input.ToAddress) == 0 {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	localID, ok := cross_chain_manager.LocalChainID(s)
+	if !ok {
+		return utils.ByteFailed, ErrChainIDUnknown
+	}
+	if input.ToChainID == localID {
+		return utils.ByteFailed, ErrInvalidChain
+	}
+	blacked, err := cross_chain_manager.CheckIfChainBlacked(s, input.ToChainID)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if blacked {
+		return utils.ByteFailed, ErrChainBlacked
+	}
+	binding, err := getProxy(s, input.ToChainID)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if binding == nil {
+		return utils.ByteFailed, ErrProxyNotBound
+	}
+	txHash := s.ContractRef().TxHash()
+	if getLockTx(s) == txHash {
+		return utils.ByteFailed, ErrDuplicateLock
+	}
+	setLockTx(s, txHash)
+
+	caller := s.ContractRef().MsgSender()
+	if err := burn(s, caller, input.Amount); err != nil {
+		return utils.ByteFailed, err
+	}
+	addAmount(s, bridgedKey(input.ToChainID), input.Amount)
+	addAmount(s, bridgedTotalKey(), input.Amount)
+	// the native token is locked here and its wrapped token minted by the lock proxy
+	if err := accounting.Apply(s, this, input.ToChainID,
+		&accounting.Entry{Op: accounting.OpLock, Amount: input.Amount},
+		&accounting.Entry{Op: accounting.OpMint, Amount: input.Amount}); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	sink := polycomm.NewZeroCopySink(nil)
+	args := &TxArgs{ToAssetHash: binding.Asset, ToAddress: input.ToAddress, Amount: input.Amount}
+	if err := args.Serialization(sink); err != nil {
+		return utils.ByteFailed, ErrInvalidAmount
+	}
+	txParam := &scom.MakeTxParam{
+		TxHash:              txHash.Bytes(),
+		CrossChainID:        crypto.Keccak256(this.Bytes(), txHash.Bytes()),
+		FromContractAddress: this.Bytes(),
+		ToChainID:           input.ToChainID,
+		ToContractAddress:   binding.Proxy,
+		Method:              MethodUnlock,
+		Args:                sink.Bytes(),
+	}
+	if err := cross_chain_manager.MakeTransaction(s, txParam, localID); err != nil {
+		log.Trace("lock", "make transaction failed", err)
+		return utils.ByteFailed, err
+	}
+	if err := emitLocked(s, caller, input.ToChainID, input.ToAddress, input.Amount); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodLock)
+}
+
+// Unlock implements the cross_chain_manager.InboundHandler, it pays the native token locked to
+// the receiver once the token bridged out comes back from the lock proxy of the source chain.
+// the amount never exceeds what has been bridged out to the chain, and the native token held
+// by the contract always covers the wrapped supply and the token bridged out.
+func Unlock(s *native.NativeContract, fromChainID uint64, txParam *scom.MakeTxParam) error {
+	if txParam.Method != MethodUnlock {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "wzion unlock, invalid method %s", txParam.Method)
+	}
+	binding, err := getProxy(s, fromChainID)
+	if err != nil {
+		return ErrStorage
+	}
+	if binding == nil || !bytes.Equal(binding.Proxy, txParam.FromContractAddress) {
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "wzion unlock, %v", ErrInvalidProxy)
+	}
+	args := new(TxArgs)
+	if err := args.Deserialization(polycomm.NewZeroCopySource(txParam.Args)); err != nil {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "wzion unlock, %v", err)
+	}
+	if !bytes.Equal(args.ToAssetHash, this.Bytes()) || len(args.ToAddress) != common.AddressLength || args.Amount.Sign() <= 0 {
+		return scom.NewImportError(scom.ErrCodeInvalidParam, "wzion unlock, %v", ErrInvalidMessage)
+	}
+
+	if !subAmount(s, bridgedKey(fromChainID), args.Amount) {
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "wzion unlock, %v", ErrBridgedExceeded)
+	}
+	if !subAmount(s, bridgedTotalKey(), args.Amount) {
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "wzion unlock, %v", ErrBridgedExceeded)
+	}
+	if err := accounting.Apply(s, this, fromChainID,
+		&accounting.Entry{Op: accounting.OpBurn, Amount: args.Amount},
+		&accounting.Entry{Op: accounting.OpUnlock, Amount: args.Amount}); err != nil {
+		return scom.NewImportError(scom.ErrCodeInvalidProof, "wzion unlock, %v", err)
+	}
+	// the token still bridged out should be backed by the native token locked after unlocking
+	locked, bridged := lockedAmount(s), getAmount(s, bridgedTotalKey())
+	if locked.Cmp(new(big.Int).Add(bridged, args.Amount)) < 0 {
+		log.Error("wzion unlock, locked native token not enough", "locked", locked, "bridged", bridged, "amount", args.Amount)
+		return ErrInsufficientLocked
+	}
+	to := common.BytesToAddress(args.ToAddress)
+	s.StateDB().SubBalance(this, args.Amount)
+	s.StateDB().AddBalance(to, args.Amount)
+	if err := emitUnlocked(s, fromChainID, to, args.Amount); err != nil {
+		return ErrEmitLog
+	}
+	return nil
+}
+
+// BindProxy validators bind the lock proxy and the asset hash of the chain which the native
+// token is bridged to, the binding changes after quorum reached.
+func BindProxy(s *native.NativeContract) ([]byte, error) {
+	if err := nonPayable(s); err != nil {
+		return utils.ByteFailed, err
+	}
+	payload := s.ContractRef().CurrentContext().Payload
+	input := new(MethodBindProxyInput)
+	if err := input.Decode(payload); err != nil {
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if len(input.Proxy) == 0 || len(input.Asset) == 0 {
+		return utils.ByteFailed, ErrInvalidProxy
+	}
+	if localID, ok := cross_chain_manager.LocalChainID(s); ok && localID == input.ChainID {
+		return utils.ByteFailed, ErrInvalidChain
+	}
+	binding, err := getProxy(s, input.ChainID)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	nonce := uint64(0)
+	if binding != nil {
+		nonce = binding.Nonce
+	}
+	sign := append(utils.GetUint64Bytes(nonce), payload...)
+	ok, err := node_manager.CheckConsensusSigns(s, MethodBindProxy, sign, s.ContractRef().MsgSender())
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if !ok {
+		return (&MethodBoolOutput{Success: true}).Encode(MethodBindProxy)
+	}
+
+	if err := setProxy(s, input.ChainID, &ProxyBinding{Proxy: input.Proxy, Asset: input.Asset, Nonce: nonce + 1}); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := audit_log.AddRecord(s, audit_log.KindExecute, MethodBindProxy, s.ContractRef().MsgSender(), payload); err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitProxyBound(s, input.ChainID, input.Proxy, input.Asset); err != nil {
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return (&MethodBoolOutput{Success: true}).Encode(MethodBindProxy)
+}
+
+func Proxy(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodChainIDInput)
+	if err := input.Decode(MethodProxy, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	binding, err := getProxy(s, input.ChainID)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	output := new(MethodProxyOutput)
+	if binding != nil {
+		output.Proxy, output.Asset = binding.Proxy, binding.Asset
+	}
+	return output.Encode()
+}
+
+func Bridged(s *native.NativeContract) ([]byte, error) {
+	input := new(MethodChainIDInput)
+	if err := input.Decode(MethodBridged, s.ContractRef().CurrentContext().Payload); err != nil {
+		return nil, ErrInvalidInput
+	}
+	return (&MethodAmountOutput{Amount: getAmount(s, bridgedKey(input.ChainID))}).Encode(MethodBridged)
+}
+
+func burn(s *native.NativeContract, account common.Address, amount *big.Int) error {
+	if !subAmount(s, balanceKey(account), amount) {
+		return ErrInsufficientBalance
+	}
+	if !subAmount(s, totalSupplyKey(), amount) {
+		return ErrInsufficientBalance
+	}
+	return nil
+}
+
+// lockedAmount returns the native token held by the contract which backs the token bridged out,
+// i.e. the balance of contract excluding the wrapped supply.
+func lockedAmount(s *native.NativeContract) *big.Int {
+	locked := new(big.Int).Sub(s.StateDB().GetBalance(this), getAmount(s, totalSupplyKey()))
+	if locked.Sign() < 0 {
+		return new(big.Int)
+	}
+	return locked
+}
+
+// nonPayable rejects the native token transferred with the call, only `deposit` is payable.
+func nonPayable(s *native.NativeContract) error {
+	if s.ContractRef().Value().Sign() != 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
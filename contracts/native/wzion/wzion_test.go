@@ -0,0 +1,254 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package wzion
+
+import (
+	"crypto/rand"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/accounting"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	polycomm "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGenesisNum = 4
+	testSupplyGas  = uint64(100000000000000000)
+	testChainID    = uint64(2)
+)
+
+var (
+	testStateDB      *state.StateDB
+	testGenesisEpoch *node_manager.EpochInfo
+	testConfig       = &params.ChainConfig{ChainID: big.NewInt(1000), CrossChainV2Block: big.NewInt(0)}
+	testProxy        = common.HexToAddress("0x1001").Bytes()
+	testAsset        = common.HexToAddress("0x1002").Bytes()
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	accounting.InitAccounting()
+	InitWZion()
+	os.Exit(m.Run())
+}
+
+func resetTestContext() {
+	db := rawdb.NewMemoryDatabase()
+	testStateDB, _ = state.New(common.Hash{}, state.NewDatabase(db), nil)
+	peers := &node_manager.Peers{List: make([]*node_manager.PeerInfo, testGenesisNum)}
+	for i := 0; i < testGenesisNum; i++ {
+		pk, _ := crypto.GenerateKey()
+		peers.List[i] = &node_manager.PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+			Address: crypto.PubkeyToAddress(pk.PublicKey),
+		}
+	}
+	testGenesisEpoch, _ = node_manager.StoreGenesisEpoch(testStateDB, peers)
+}
+
+func newTestRef(origin common.Address, txHash common.Hash, value *big.Int) *native.ContractRef {
+	ref := native.NewContractRef(testStateDB, origin, origin, big.NewInt(1), txHash, testSupplyGas, nil)
+	ref.SetChainConfig(testConfig)
+	ref.SetValue(value)
+	return ref
+}
+
+// invoke transfers the value to the contract as evm does before the native call.
+func invoke(origin common.Address, payload []byte, value *big.Int) ([]byte, error) {
+	token := make([]byte, common.HashLength)
+	rand.Read(token)
+	snapshot := testStateDB.Snapshot()
+	if value != nil {
+		testStateDB.SubBalance(origin, value)
+		testStateDB.AddBalance(this, value)
+	}
+	ret, _, err := newTestRef(origin, common.BytesToHash(token), value).NativeCall(origin, this, payload)
+	if err != nil {
+		testStateDB.RevertToSnapshot(snapshot)
+	}
+	return ret, err
+}
+
+func balanceOf(t *testing.T, account common.Address) *big.Int {
+	payload, err := (&MethodBalanceOfInput{Account: account}).Encode()
+	assert.NoError(t, err)
+	enc, err := invoke(account, payload, nil)
+	assert.NoError(t, err)
+	output := new(MethodAmountOutput)
+	assert.NoError(t, output.Decode(MethodBalanceOf, enc))
+	return output.Amount
+}
+
+func bridged(t *testing.T, chainID uint64) *big.Int {
+	payload, err := (&MethodChainIDInput{ChainID: chainID}).Encode(MethodBridged)
+	assert.NoError(t, err)
+	enc, err := invoke(common.EmptyAddress, payload, nil)
+	assert.NoError(t, err)
+	output := new(MethodAmountOutput)
+	assert.NoError(t, output.Decode(MethodBridged, enc))
+	return output.Amount
+}
+
+func bindProxy(t *testing.T, chainID uint64, from, to int) {
+	payload, err := (&MethodBindProxyInput{ChainID: chainID, Proxy: testProxy, Asset: testAsset}).Encode()
+	assert.NoError(t, err)
+	for i := from; i < to; i++ {
+		_, err := invoke(testGenesisEpoch.Peers.List[i].Address, payload, nil)
+		assert.NoError(t, err)
+	}
+}
+
+func unlockMessage(t *testing.T, proxy []byte, to common.Address, amount int64) *scom.MakeTxParam {
+	sink := polycomm.NewZeroCopySink(nil)
+	args := &TxArgs{ToAssetHash: this.Bytes(), ToAddress: to.Bytes(), Amount: big.NewInt(amount)}
+	assert.NoError(t, args.Serialization(sink))
+	return &scom.MakeTxParam{
+		FromContractAddress: proxy,
+		ToChainID:           testConfig.ChainID.Uint64(),
+		ToContractAddress:   this.Bytes(),
+		Method:              MethodUnlock,
+		Args:                sink.Bytes(),
+	}
+}
+
+func TestTxArgs(t *testing.T) {
+	amount, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	args := &TxArgs{ToAssetHash: testAsset, ToAddress: testProxy, Amount: amount}
+	sink := polycomm.NewZeroCopySink(nil)
+	assert.NoError(t, args.Serialization(sink))
+	assert.Equal(t, byte(0xd2), sink.Bytes()[2+len(testAsset)+len(testProxy)], "amount in little endian")
+
+	got := new(TxArgs)
+	assert.NoError(t, got.Deserialization(polycomm.NewZeroCopySource(sink.Bytes())))
+	assert.Equal(t, args, got)
+
+	assert.Error(t, (&TxArgs{Amount: new(big.Int).Lsh(big.NewInt(1), 255)}).Serialization(polycomm.NewZeroCopySink(nil)))
+}
+
+func TestWrap(t *testing.T) {
+	resetTestContext()
+
+	alice, bob := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+	testStateDB.AddBalance(alice, big.NewInt(1000))
+
+	deposit, err := utils.PackMethod(ABI, MethodDeposit)
+	assert.NoError(t, err)
+	_, err = invoke(alice, deposit, nil)
+	assert.Equal(t, ErrInvalidAmount, err)
+	_, err = invoke(alice, deposit, big.NewInt(100))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), balanceOf(t, alice))
+	assert.Equal(t, big.NewInt(100), testStateDB.GetBalance(this))
+
+	transfer, err := (&MethodTransferInput{To: bob, Amount: big.NewInt(30)}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(alice, transfer, big.NewInt(1))
+	assert.Equal(t, ErrInvalidAmount, err, "only deposit is payable")
+	_, err = invoke(alice, transfer, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(70), balanceOf(t, alice))
+	assert.Equal(t, big.NewInt(30), balanceOf(t, bob))
+
+	withdraw, err := (&MethodWithdrawInput{Amount: big.NewInt(31)}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(bob, withdraw, nil)
+	assert.Equal(t, ErrInsufficientBalance, err)
+	withdraw, err = (&MethodWithdrawInput{Amount: big.NewInt(20)}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(bob, withdraw, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(10), balanceOf(t, bob))
+	assert.Equal(t, big.NewInt(20), testStateDB.GetBalance(bob))
+	assert.Equal(t, big.NewInt(80), testStateDB.GetBalance(this))
+
+	enc, err := invoke(alice, func() []byte { p, _ := utils.PackMethod(ABI, MethodTotalSupply); return p }(), nil)
+	assert.NoError(t, err)
+	supply := new(MethodAmountOutput)
+	assert.NoError(t, supply.Decode(MethodTotalSupply, enc))
+	assert.Equal(t, big.NewInt(80), supply.Amount)
+}
+
+func TestBridge(t *testing.T) {
+	resetTestContext()
+
+	alice, carol := common.HexToAddress("0xa"), common.HexToAddress("0xc")
+	testStateDB.AddBalance(alice, big.NewInt(1000))
+	deposit, err := utils.PackMethod(ABI, MethodDeposit)
+	assert.NoError(t, err)
+	_, err = invoke(alice, deposit, big.NewInt(100))
+	assert.NoError(t, err)
+
+	lock, err := (&MethodLockInput{ToChainID: testChainID, ToAddress: carol.Bytes(), Amount: big.NewInt(60)}).Encode()
+	assert.NoError(t, err)
+	_, err = invoke(alice, lock, nil)
+	assert.Equal(t, ErrProxyNotBound, err)
+
+	// the binding changes after quorum reached
+	bindProxy(t, testChainID, 0, 2)
+	_, err = invoke(alice, lock, nil)
+	assert.Equal(t, ErrProxyNotBound, err)
+	bindProxy(t, testChainID, 2, 3)
+
+	// only one lock in a transaction
+	txHash := common.HexToHash("0x123")
+	_, _, err = newTestRef(alice, txHash, nil).NativeCall(alice, this, lock)
+	assert.NoError(t, err)
+	_, _, err = newTestRef(alice, txHash, nil).NativeCall(alice, this, lock)
+	assert.Equal(t, ErrDuplicateLock, err)
+	assert.Equal(t, big.NewInt(40), balanceOf(t, alice))
+	assert.Equal(t, big.NewInt(60), bridged(t, testChainID))
+	assert.Equal(t, big.NewInt(100), testStateDB.GetBalance(this))
+
+	// inbound handler runs in context of the target contract
+	ref := newTestRef(common.EmptyAddress, common.EmptyHash, nil)
+	ref.PushContext(&native.Context{Caller: utils.CrossChainManagerContractAddress, ContractAddress: this})
+	s := native.NewNativeContract(testStateDB, ref)
+
+	// unlock from the bound proxy only, and never more than bridged out
+	assert.Error(t, Unlock(s, testChainID, unlockMessage(t, testAsset, carol, 10)))
+	assert.Error(t, Unlock(s, testChainID+1, unlockMessage(t, testProxy, carol, 10)))
+	assert.Error(t, Unlock(s, testChainID, unlockMessage(t, testProxy, carol, 61)))
+	assert.NoError(t, Unlock(s, testChainID, unlockMessage(t, testProxy, carol, 50)))
+	assert.Equal(t, big.NewInt(50), testStateDB.GetBalance(carol))
+	assert.Equal(t, big.NewInt(10), bridged(t, testChainID))
+	assert.Equal(t, big.NewInt(50), testStateDB.GetBalance(this))
+
+	// the ledger of bridge accounting is updated with the lock and unlock
+	query, _ := (&accounting.MethodLedgerInput{Asset: this, ChainID: testChainID}).Encode()
+	enc, _, err := newTestRef(alice, common.EmptyHash, nil).NativeCall(alice, utils.AccountingContractAddress, query)
+	assert.NoError(t, err)
+	ledger := new(accounting.MethodLedgerOutput)
+	assert.NoError(t, ledger.Decode(enc))
+	assert.Equal(t, &accounting.MethodLedgerOutput{Locked: big.NewInt(10), Minted: big.NewInt(10)}, ledger)
+
+	// the wrapped supply is never unlocked even if the locked native token is lost
+	testStateDB.SubBalance(this, big.NewInt(1))
+	assert.Equal(t, ErrInsufficientLocked, Unlock(s, testChainID, unlockMessage(t, testProxy, carol, 10)))
+}
@@ -209,6 +209,8 @@ type BlockChain struct {
 
 	shouldPreserve  func(*types.Block) bool        // Function used to determine whether should preserve the given block.
 	terminateInsert func(common.Hash, uint64) bool // Testing hook used to terminate ancient receipt chain insertion.
+
+	checkpoints map[uint64]*SubjectivityCheckpoint // Trusted checkpoints which the chain never reorgs behind
 }
 
 // NewBlockChain returns a fully initialised block chain using information
@@ -1145,6 +1147,9 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 					blockChain[i-1].Hash().Bytes()[:4], i, blockChain[i].NumberU64(), blockChain[i].Hash().Bytes()[:4], blockChain[i].ParentHash().Bytes()[:4])
 			}
 		}
+		if err := bc.verifyCheckpoint(blockChain[i], nil); err != nil {
+			return 0, err
+		}
 		if blockChain[i].NumberU64() <= ancientLimit {
 			ancientBlocks, ancientReceipts = append(ancientBlocks, blockChain[i]), append(ancientReceipts, receiptChain[i])
 		} else {
@@ -1788,6 +1793,11 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 			bc.reportBlock(block, nil, ErrBlacklistedHash)
 			return it.index, ErrBlacklistedHash
 		}
+		// If the block conflicts with a trusted checkpoint, straight out abort
+		if err := bc.verifyCheckpoint(block, nil); err != nil {
+			bc.reportBlock(block, nil, err)
+			return it.index, err
+		}
 		// If the block is known (in the middle of the chain), it's a special case for
 		// Clique blocks where they can share state among each other, so importing an
 		// older block might complete the state of the subsequent one. In this case,
@@ -1886,6 +1896,11 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 			atomic.StoreUint32(&followupInterrupt, 1)
 			return it.index, err
 		}
+		if err := bc.verifyCheckpoint(block, statedb); err != nil {
+			bc.reportBlock(block, receipts, err)
+			atomic.StoreUint32(&followupInterrupt, 1)
+			return it.index, err
+		}
 		proctime := time.Since(start)
 
 		// Update the metrics touched during block validation
@@ -2186,6 +2201,12 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("invalid new chain")
 		}
 	}
+	// Refuse to drop any trusted checkpoint from the canonical chain
+	if checkpoint := bc.droppedCheckpoint(oldChain); checkpoint != nil {
+		log.Error("Refusing to reorg behind trusted checkpoint", "number", checkpoint.Number, "hash", checkpoint.Hash,
+			"common", commonBlock.Number(), "drop", len(oldChain), "add", len(newChain))
+		return fmt.Errorf("%w: reorg from %d drops checkpoint %d", ErrReorgBehindCheckpoint, commonBlock.NumberU64(), checkpoint.Number)
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := log.Info
@@ -31,6 +31,13 @@ var (
 
 	// ErrNoGenesis is returned when there is no Genesis Block.
 	ErrNoGenesis = errors.New("genesis not found in chain")
+
+	// ErrCheckpointMismatch is returned if a block conflicts with a trusted checkpoint.
+	ErrCheckpointMismatch = errors.New("trusted checkpoint mismatch")
+
+	// ErrReorgBehindCheckpoint is returned if a reorg would drop a trusted checkpoint
+	// from the canonical chain.
+	ErrReorgBehindCheckpoint = errors.New("reorg behind trusted checkpoint")
 )
 
 // List of evm-call-message pre-checking errors. All state transition messages will
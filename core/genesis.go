@@ -280,6 +280,7 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 		g.createNativeContract(statedb, v)
 	}
 	g.storeGenesisPeers(statedb)
+	g.storeGenesisQuorumRule(statedb)
 
 	root := statedb.IntermediateRoot(false)
 	head := &types.Header{
@@ -333,6 +334,17 @@ func (g *Genesis) storeGenesisPeers(db *state.StateDB) {
 	node_manager.StoreGenesisEpoch(db, peers)
 }
 
+func (g *Genesis) storeGenesisQuorumRule(db *state.StateDB) {
+	if g.Config == nil || g.Config.HotStuff == nil || g.Config.HotStuff.Quorum == nil {
+		return
+	}
+	q := g.Config.HotStuff.Quorum
+	rule := &node_manager.QuorumRule{Numerator: q.Numerator, Denominator: q.Denominator, Strict: q.Strict, Threshold: q.Threshold}
+	if err := node_manager.StoreGenesisQuorumRule(db, rule); err != nil {
+		panic(fmt.Sprintf("store genesis quorum rule failed, err: %v", err))
+	}
+}
+
 // Commit writes the block and state of a genesis specification to the database.
 // The block is committed as the canonical head block.
 func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
@@ -29,35 +29,39 @@ func (c *CacheDB) Put(key []byte, value []byte) {
 		panic("CacheDB should only be used for native contract storage")
 	}
 
-	c.Delete(key)
+	freed := c.clear(key)
+	written := 0
 
 	s := (*StateDB)(c)
+	s.recordNativeKey(key)
 	so := s.GetOrNewStateObject(common.BytesToAddress(key[:common.AddressLength]))
 	if so != nil {
 		slot := Key2Slot(key[common.AddressLength:])
 		if len(value) <= common.HashLength-1 {
-			c.putValue(so, slot, value, false)
+			written += c.putValue(so, slot, value, false)
 			value = nil
 		} else {
-			c.putValue(so, slot, value[:common.HashLength-1], true)
+			written += c.putValue(so, slot, value[:common.HashLength-1], true)
 			value = value[common.HashLength-1:]
 		}
 
 		for len(value) > 0 {
 			slot = c.nextSlot(slot)
 			if len(value) <= common.HashLength-1 {
-				c.putValue(so, slot, value, false)
+				written += c.putValue(so, slot, value, false)
 				break
 			} else {
-				c.putValue(so, slot, value[:common.HashLength-1], true)
+				written += c.putValue(so, slot, value[:common.HashLength-1], true)
 				value = value[common.HashLength-1:]
 			}
 		}
 	}
-
+	s.addNativeSlots(int64(written - freed))
 }
 
-func (c *CacheDB) putValue(so *stateObject, slot common.Hash, value []byte, more bool) {
+// putValue writes the slot and returns the number of slots occupied, which is zero if the
+// value is empty.
+func (c *CacheDB) putValue(so *stateObject, slot common.Hash, value []byte, more bool) int {
 	if len(value) > common.HashLength-1 {
 		panic("value should not exceed 31")
 	}
@@ -77,6 +81,10 @@ func (c *CacheDB) putValue(so *stateObject, slot common.Hash, value []byte, more
 	s := (*StateDB)(c)
 	hashValue := common.BytesToHash(value)
 	so.SetState(s.db, slot, hashValue)
+	if hashValue == (common.Hash{}) {
+		return 0
+	}
+	return 1
 }
 
 func Key2Slot(key []byte) common.Hash {
@@ -141,18 +149,31 @@ func (c *CacheDB) Delete(key []byte) {
 		panic("CacheDB should only be used for native contract storage")
 	}
 
+	s := (*StateDB)(c)
+	s.recordNativeKey(key)
+	s.addNativeSlots(-int64(c.clear(key)))
+}
+
+// clear resets the slots of key and returns the number of slots released.
+func (c *CacheDB) clear(key []byte) int {
+	freed := 0
 	s := (*StateDB)(c)
 	so := s.GetOrNewStateObject(common.BytesToAddress(key[:common.AddressLength]))
 	if so != nil {
 		slot := Key2Slot(key[common.AddressLength:])
 		value := so.GetState(s.db, slot)
-		so.SetState(s.db, slot, common.Hash{})
+		if value != (common.Hash{}) {
+			so.SetState(s.db, slot, common.Hash{})
+			freed++
+		}
 		more := value[:][0]&1 == 1
 		for more {
 			slot = c.nextSlot(slot)
 			value = so.GetState(s.db, slot)
 			so.SetState(s.db, slot, common.Hash{})
+			freed++
 			more = value[:][0]&1 == 1
 		}
 	}
+	return freed
 }
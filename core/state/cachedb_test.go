@@ -61,3 +61,63 @@ func TestCacheDB(t *testing.T) {
 	}
 
 }
+
+func TestCacheDBNativeSlots(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	state, _ := New(common.Hash{}, NewDatabase(db), nil)
+	c := (*CacheDB)(state)
+
+	addr := common.BytesToAddress([]byte{1})
+	key := append(addr[:], []byte("a")...)
+
+	// 70 bytes take 3 slots
+	c.Put(key, make([]byte, 70))
+	if n := state.NativeSlots(); n != 3 {
+		t.Fatalf("slots mismatch, want 3, got %d", n)
+	}
+	// overwrite with a shorter value
+	c.Put(key, []byte{1})
+	if n := state.NativeSlots(); n != 1 {
+		t.Fatalf("slots mismatch, want 1, got %d", n)
+	}
+	snapshot := state.Snapshot()
+	c.Delete(key)
+	c.Delete(key)
+	if n := state.NativeSlots(); n != 0 {
+		t.Fatalf("slots mismatch, want 0, got %d", n)
+	}
+	state.RevertToSnapshot(snapshot)
+	if n := state.NativeSlots(); n != 1 {
+		t.Fatalf("slots mismatch after revert, want 1, got %d", n)
+	}
+}
+
+func TestCacheDBNativeKeys(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	state, _ := New(common.Hash{}, NewDatabase(db), nil)
+	c := (*CacheDB)(state)
+
+	addr := common.BytesToAddress([]byte{1})
+	c.Put(append(addr[:], 'a'), []byte{1})
+	if keys := state.NativeKeys(); len(keys) != 0 {
+		t.Fatalf("keys recorded before recording started: %x", keys)
+	}
+
+	state.RecordNativeKeys()
+	c.Put(append(addr[:], 'c'), []byte{1})
+	c.Delete(append(addr[:], 'a'))
+	c.Put(append(addr[:], 'c'), []byte{2})
+	if _, err := c.Get(append(addr[:], 'b')); err != nil {
+		t.Fatal(err)
+	}
+	keys := state.NativeKeys()
+	want := [][]byte{append(addr[:], 'a'), append(addr[:], 'c')}
+	if len(keys) != len(want) {
+		t.Fatalf("keys mismatch, want %x, got %x", want, keys)
+	}
+	for i := range want {
+		if !bytes.Equal(keys[i], want[i]) {
+			t.Fatalf("key %d mismatch, want %x, got %x", i, want[i], keys[i])
+		}
+	}
+}
@@ -121,6 +121,15 @@ type (
 	refundChange struct {
 		prev uint64
 	}
+	nativeSlotsChange struct {
+		prev int64
+	}
+	feesChange struct {
+		prev *big.Int
+	}
+	feeWaiverChange struct {
+		prev bool
+	}
 	addLogChange struct {
 		txhash common.Hash
 	}
@@ -221,6 +230,30 @@ func (ch refundChange) dirtied() *common.Address {
 	return nil
 }
 
+func (ch nativeSlotsChange) revert(s *StateDB) {
+	s.nativeSlots = ch.prev
+}
+
+func (ch nativeSlotsChange) dirtied() *common.Address {
+	return nil
+}
+
+func (ch feesChange) revert(s *StateDB) {
+	s.fees = ch.prev
+}
+
+func (ch feesChange) dirtied() *common.Address {
+	return nil
+}
+
+func (ch feeWaiverChange) revert(s *StateDB) {
+	s.feeWaived = ch.prev
+}
+
+func (ch feeWaiverChange) dirtied() *common.Address {
+	return nil
+}
+
 func (ch addLogChange) revert(s *StateDB) {
 	logs := s.logs[ch.txhash]
 	if len(logs) == 1 {
@@ -18,6 +18,7 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -90,6 +91,18 @@ type StateDB struct {
 	// The refund counter, also used by state transitioning.
 	refund uint64
 
+	// The net number of native contract storage slots occupied through the cache db.
+	nativeSlots int64
+
+	// The native contract storage keys written through the cache db, nil if not recorded.
+	nativeKeys map[string]struct{}
+
+	// The transaction fees paid to the coinbase, also used by state transitioning.
+	fees *big.Int
+
+	// Whether the fee of current transaction is waived, also used by state transitioning.
+	feeWaived bool
+
 	thash, bhash common.Hash
 	txIndex      int
 	logs         map[common.Hash][]*types.Log
@@ -237,6 +250,76 @@ func (s *StateDB) SubRefund(gas uint64) {
 	s.refund -= gas
 }
 
+// addNativeSlots adds the delta of occupied native contract storage slots.
+func (s *StateDB) addNativeSlots(delta int64) {
+	if delta == 0 {
+		return
+	}
+	s.journal.append(nativeSlotsChange{prev: s.nativeSlots})
+	s.nativeSlots += delta
+}
+
+// NativeSlots returns the net number of native contract storage slots occupied since the
+// state created, it's only meaningful as difference between two reads.
+func (s *StateDB) NativeSlots() int64 {
+	return s.nativeSlots
+}
+
+// RecordNativeKeys starts recording the native contract storage keys written through the cache
+// db, the keys written before are dropped. the keys of the reverted writes are recorded as well.
+func (s *StateDB) RecordNativeKeys() {
+	s.nativeKeys = make(map[string]struct{})
+}
+
+// NativeKeys returns the native contract storage keys written since the recording started in
+// ascending order, the keys are prefixed with the contract address.
+func (s *StateDB) NativeKeys() [][]byte {
+	keys := make([][]byte, 0, len(s.nativeKeys))
+	for key := range s.nativeKeys {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+func (s *StateDB) recordNativeKey(key []byte) {
+	if s.nativeKeys != nil {
+		s.nativeKeys[string(key)] = struct{}{}
+	}
+}
+
+// AddFees adds the transaction fee paid to the coinbase to the fee counter.
+func (s *StateDB) AddFees(fee *big.Int) {
+	if fee.Sign() == 0 {
+		return
+	}
+	s.journal.append(feesChange{prev: s.fees})
+	s.fees = new(big.Int).Add(s.Fees(), fee)
+}
+
+// Fees returns the transaction fees paid to the coinbase since the state created, the state of
+// each block is created from its parent, so it's the fees of the block collected so far.
+func (s *StateDB) Fees() *big.Int {
+	if s.fees == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(s.fees)
+}
+
+// WaiveFee waives the fee of current transaction, the gas used is refunded to the sender rather
+// than paid to the coinbase. it's reverted with the call which waives it.
+func (s *StateDB) WaiveFee() {
+	s.journal.append(feeWaiverChange{prev: s.feeWaived})
+	s.feeWaived = true
+}
+
+// FeeWaived returns whether the fee of current transaction is waived.
+func (s *StateDB) FeeWaived() bool {
+	return s.feeWaived
+}
+
 // Exist reports whether the given account address exists in the state.
 // Notably this also returns true for suicided accounts.
 func (s *StateDB) Exist(addr common.Address) bool {
@@ -668,6 +751,9 @@ func (s *StateDB) Copy() *StateDB {
 		stateObjectsPending: make(map[common.Address]struct{}, len(s.stateObjectsPending)),
 		stateObjectsDirty:   make(map[common.Address]struct{}, len(s.journal.dirties)),
 		refund:              s.refund,
+		nativeSlots:         s.nativeSlots,
+		fees:                s.fees,
+		feeWaived:           s.feeWaived,
 		logs:                make(map[common.Hash][]*types.Log, len(s.logs)),
 		logSize:             s.logSize,
 		preimages:           make(map[common.Hash][]byte, len(s.preimages)),
@@ -906,6 +992,7 @@ func (s *StateDB) clearJournalAndRefund() {
 	if len(s.journal.entries) > 0 {
 		s.journal = newJournal()
 		s.refund = 0
+		s.feeWaived = false
 	}
 	s.validRevisions = s.validRevisions[:0] // Snapshots can be created without journal entires
 }
@@ -915,3 +915,30 @@ func TestStateDBAccessList(t *testing.T) {
 		t.Fatalf("expected empty, got %d", got)
 	}
 }
+
+func TestFeeWaiver(t *testing.T) {
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	addr := common.BytesToAddress([]byte{1})
+
+	// the waiver is reverted with the failed call
+	snapshot := state.Snapshot()
+	state.WaiveFee()
+	if !state.FeeWaived() {
+		t.Fatal("fee not waived")
+	}
+	state.RevertToSnapshot(snapshot)
+	if state.FeeWaived() {
+		t.Fatal("fee waived after revert")
+	}
+
+	// the waiver is cleared for the next transaction
+	state.AddBalance(addr, big.NewInt(1))
+	state.WaiveFee()
+	if cpy := state.Copy(); !cpy.FeeWaived() {
+		t.Fatal("fee waiver not copied")
+	}
+	state.Finalise(true)
+	if state.FeeWaived() {
+		t.Fatal("fee waived for the next transaction")
+	}
+}
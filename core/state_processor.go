@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -67,14 +68,25 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 	if p.config.DAOForkSupport && p.config.DAOForkBlock != nil && p.config.DAOForkBlock.Cmp(block.Number()) == 0 {
 		misc.ApplyDAOHardFork(statedb)
 	}
+	if err := native.ApplyMigrations(p.config, statedb, block.Number()); err != nil {
+		return nil, nil, 0, err
+	}
 	blockContext := NewEVMBlockContext(header, p.bc, nil)
 	vmenv := vm.NewEVM(blockContext, vm.TxContext{}, statedb, p.config, cfg)
+	signer := types.MakeSigner(p.config, header.Number)
+	_, systemTxEnabled := p.engine.(consensus.SystemTxSigner)
+	systemTxEnabled = systemTxEnabled && p.config.IsSystemTx(header.Number)
+	txs := block.Transactions()
 	// Iterate over and process the individual transactions
-	for i, tx := range block.Transactions() {
-		msg, err := tx.AsMessage(types.MakeSigner(p.config, header.Number))
+	for i, tx := range txs {
+		msg, err := tx.AsMessage(signer)
 		if err != nil {
 			return nil, nil, 0, err
 		}
+		// the rest of transactions should be the system transactions
+		if systemTxEnabled && IsSystemTx(p.config, tx, msg.From(), header) {
+			break
+		}
 		statedb.Prepare(tx.Hash(), block.Hash(), i)
 		receipt, err := applyTransaction(msg, p.config, p.bc, nil, gp, statedb, header, tx, usedGas, vmenv)
 		if err != nil {
@@ -83,6 +95,28 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 		receipts = append(receipts, receipt)
 		allLogs = append(allLogs, receipt.Logs...)
 	}
+	// Verify and process the system transactions with a separate gas pool
+	if systemTxEnabled {
+		systemTxs := txs[len(receipts):]
+		if err := verifySystemTxs(p.config, statedb, header, signer, systemTxs); err != nil {
+			return nil, nil, 0, err
+		}
+		gp = new(GasPool).AddGas(systemTxGas(header) * uint64(len(systemTxs)))
+		for _, tx := range systemTxs {
+			i := len(receipts)
+			msg, err := tx.AsMessage(signer)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			statedb.Prepare(tx.Hash(), block.Hash(), i)
+			receipt, err := applyTransaction(msg, p.config, p.bc, nil, gp, statedb, header, tx, usedGas, vmenv)
+			if err != nil {
+				return nil, nil, 0, fmt.Errorf("could not apply system tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+			}
+			receipts = append(receipts, receipt)
+			allLogs = append(allLogs, receipt.Logs...)
+		}
+	}
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	p.engine.Finalize(p.bc, header, statedb, block.Transactions(), block.Uncles())
 
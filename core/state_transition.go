@@ -22,6 +22,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
@@ -283,7 +285,14 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	//	st.refundGas(params.RefundQuotientEIP3529)
 	//}
 	st.refundGas(params.RefundQuotientEIP3529)
-	st.state.AddBalance(st.evm.Context.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice))
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice)
+	if st.state.FeeWaived() {
+		// the fee of protocol duties, e.g: governance votes of validators, is waived by the
+		// native contracts, it's refunded to the sender rather than paid to the coinbase.
+		st.state.AddBalance(msg.From(), fee)
+	} else if err := st.payFee(fee); err != nil {
+		return nil, err
+	}
 
 	return &ExecutionResult{
 		UsedGas:    st.gasUsed(),
@@ -292,6 +301,26 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	}, nil
 }
 
+// payFee pays the fee of the transaction to the coinbase. since governance v2 the burned and the
+// treasury parts of the fee split in force are taken before the fee is paid, so that they never
+// pass through the balance of the proposer.
+func (st *StateTransition) payFee(fee *big.Int) error {
+	if fee.Sign() > 0 && st.evm.ChainConfig().IsGovV2(st.evm.Context.BlockNumber) {
+		split, err := node_manager.ReadFeeSplit(st.state.(*state.StateDB))
+		if err != nil {
+			return fmt.Errorf("failed to read fee split: %v", err)
+		}
+		burn, treasury := split.Split(fee)
+		if treasury.Sign() > 0 {
+			st.state.AddBalance(split.Treasury, treasury)
+		}
+		fee = new(big.Int).Sub(fee, burn.Add(burn, treasury))
+	}
+	st.state.AddBalance(st.evm.Context.Coinbase, fee)
+	st.state.AddFees(fee)
+	return nil
+}
+
 func (st *StateTransition) refundGas(refundQuotient uint64) {
 	// Apply refund counter, capped to a refund quotient
 	refund := st.gasUsed() / refundQuotient
@@ -0,0 +1,109 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestFeeSplit(t *testing.T) {
+	var (
+		key, _    = crypto.GenerateKey()
+		proposer  = crypto.PubkeyToAddress(key.PublicKey)
+		user      = common.HexToAddress("0x1000")
+		treasury  = common.HexToAddress("0x2000")
+		recipient = common.HexToAddress("0x3000")
+		config    = *params.TestChainConfig
+	)
+	config.GovV2Block = big.NewInt(2)
+	node_manager.InitNodeManager()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	(&Genesis{Config: &config}).createNativeContract(statedb, utils.NodeManagerContractAddress)
+	peer := &node_manager.PeerInfo{PubKey: hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)), Address: proposer}
+	if _, err := node_manager.StoreGenesisEpoch(statedb, &node_manager.Peers{List: []*node_manager.PeerInfo{peer}}); err != nil {
+		t.Fatal(err)
+	}
+	statedb.AddBalance(user, big.NewInt(params.Ether))
+
+	// the single validator sets the split alone
+	payload, err := (&node_manager.MethodSetFeeSplitInput{BurnRate: 2000, TreasuryRate: 3000, Treasury: treasury}).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := native.NewContractRef(statedb, proposer, proposer, config.GovV2Block, common.HexToHash("0x1"), 1000000, nil)
+	ref.SetChainConfig(&config)
+	if _, _, err := ref.NativeCall(proposer, utils.NodeManagerContractAddress, payload); err != nil {
+		t.Fatal(err)
+	}
+
+	apply := func(number int64, from, to common.Address, value *big.Int, gasPrice int64) {
+		statedb.Finalise(true)
+		msg := types.NewMessage(from, &to, statedb.GetNonce(from), value, params.TxGas, big.NewInt(gasPrice), nil, nil, true)
+		blockContext := vm.BlockContext{
+			CanTransfer: CanTransfer,
+			Transfer:    Transfer,
+			Coinbase:    proposer,
+			GasLimit:    params.TxGas,
+			BlockNumber: big.NewInt(number),
+			Time:        big.NewInt(0),
+			Difficulty:  big.NewInt(0),
+		}
+		evm := vm.NewEVM(blockContext, NewEVMTxContext(msg), statedb, &config, vm.Config{})
+		if _, err := ApplyMessage(evm, msg, new(GasPool).AddGas(params.TxGas)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	balances := func(coinbase, paid int64) {
+		t.Helper()
+		if have := statedb.GetBalance(proposer); have.Cmp(big.NewInt(coinbase)) != 0 {
+			t.Errorf("proposer balance mismatch: have %v, want %d", have, coinbase)
+		}
+		if have := statedb.GetBalance(treasury); have.Cmp(big.NewInt(paid)) != 0 {
+			t.Errorf("treasury balance mismatch: have %v, want %d", have, paid)
+		}
+	}
+
+	// the whole fee is paid to the coinbase before governance v2
+	fee := int64(params.TxGas)
+	apply(1, user, recipient, common.Big1, 1)
+	balances(fee, 0)
+	statedb.SubBalance(proposer, big.NewInt(fee))
+
+	// the burned and the treasury parts are taken before the fee is paid, so that draining the
+	// balance of the proposer in the same block keeps nothing more than its own part
+	apply(2, user, recipient, common.Big1, 1)
+	balances(fee/2, fee*3/10)
+	apply(2, proposer, recipient, big.NewInt(fee/2), 0)
+	balances(0, fee*3/10)
+	// the fees recorded in the payout statement are the parts paid to the proposer
+	if have := statedb.Fees(); have.Cmp(big.NewInt(fee*3/2)) != 0 {
+		t.Errorf("fees mismatch: have %v, want %d", have, fee*3/2)
+	}
+}
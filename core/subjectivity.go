@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package core
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SubjectivityCheckpoint is an operator supplied trusted block. validators rotate every
+// epoch and the retired ones are able to sign an alternative history cheaply, so the node
+// only accepts the chain which contains the checkpoint block and never reorgs behind it.
+type SubjectivityCheckpoint struct {
+	Number    uint64
+	Hash      common.Hash
+	EpochHash common.Hash // hash of node manager epoch which is active after the checkpoint block
+}
+
+// SetSubjectivityCheckpoints installs the trusted checkpoints, it should be called before
+// the chain starts to sync. an error is returned if the local canonical chain conflicts
+// with any of the checkpoints.
+func (bc *BlockChain) SetSubjectivityCheckpoints(checkpoints []*SubjectivityCheckpoint) error {
+	bc.chainmu.Lock()
+	defer bc.chainmu.Unlock()
+
+	trusted := make(map[uint64]*SubjectivityCheckpoint)
+	for _, checkpoint := range checkpoints {
+		hash := rawdb.ReadCanonicalHash(bc.db, checkpoint.Number)
+		if hash != (common.Hash{}) && hash != checkpoint.Hash {
+			return fmt.Errorf("%w: number %d, have %x, want %x", ErrCheckpointMismatch, checkpoint.Number, hash, checkpoint.Hash)
+		}
+		trusted[checkpoint.Number] = checkpoint
+	}
+	bc.checkpoints = trusted
+	return nil
+}
+
+// verifyCheckpoint checks the block against the trusted checkpoint of the same height, the
+// epoch hash is verified as well if the post state of the block is provided.
+func (bc *BlockChain) verifyCheckpoint(block *types.Block, statedb *state.StateDB) error {
+	checkpoint, ok := bc.checkpoints[block.NumberU64()]
+	if !ok {
+		return nil
+	}
+	if hash := block.Hash(); hash != checkpoint.Hash {
+		return fmt.Errorf("%w: number %d, have %x, want %x", ErrCheckpointMismatch, block.NumberU64(), hash, checkpoint.Hash)
+	}
+	if statedb == nil {
+		return nil
+	}
+	epochHash, err := node_manager.GetCurrentEpochHash(statedb)
+	if err != nil {
+		return fmt.Errorf("failed to read epoch hash of checkpoint %d: %v", block.NumberU64(), err)
+	}
+	if epochHash != checkpoint.EpochHash {
+		return fmt.Errorf("%w: number %d, have epoch %x, want %x", ErrCheckpointMismatch, block.NumberU64(), epochHash, checkpoint.EpochHash)
+	}
+	return nil
+}
+
+// droppedCheckpoint returns the trusted checkpoint which would be dropped from the canonical
+// chain by a reorg, or nil if the reorg happens above all of the checkpoints.
+func (bc *BlockChain) droppedCheckpoint(oldChain types.Blocks) *SubjectivityCheckpoint {
+	for _, block := range oldChain {
+		if checkpoint, ok := bc.checkpoints[block.NumberU64()]; ok && checkpoint.Hash == block.Hash() {
+			return checkpoint
+		}
+	}
+	return nil
+}
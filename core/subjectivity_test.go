@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestSubjectivityCheckpoint(t *testing.T) {
+	db, blockchain, err := newCanonical(ethash.NewFaker(), 0, true)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	statedb, err := blockchain.State()
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	epochHash, err := node_manager.GetCurrentEpochHash(statedb)
+	if err != nil {
+		t.Fatalf("failed to get epoch hash: %v", err)
+	}
+
+	blocks, _ := GenerateChain(params.TestChainConfig, blockchain.CurrentBlock(), ethash.NewFaker(), db, 8, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{1})
+	})
+	forks, _ := GenerateChain(params.TestChainConfig, blockchain.CurrentBlock(), ethash.NewFaker(), db, 10, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{2})
+	})
+
+	// block with mismatched epoch hash should be rejected
+	if err := blockchain.SetSubjectivityCheckpoints([]*SubjectivityCheckpoint{
+		{Number: 4, Hash: blocks[3].Hash(), EpochHash: common.HexToHash("0x01")},
+	}); err != nil {
+		t.Fatalf("failed to set checkpoints: %v", err)
+	}
+	if _, err := blockchain.InsertChain(blocks); !errors.Is(err, ErrCheckpointMismatch) {
+		t.Fatalf("expect checkpoint mismatch, got %v", err)
+	}
+
+	// canonical chain passes through the checkpoint
+	checkpoints := []*SubjectivityCheckpoint{{Number: 4, Hash: blocks[3].Hash(), EpochHash: epochHash}}
+	if err := blockchain.SetSubjectivityCheckpoints(checkpoints); err != nil {
+		t.Fatalf("failed to set checkpoints: %v", err)
+	}
+	if _, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+
+	// heavier fork conflicting with the checkpoint should be rejected
+	if _, err := blockchain.InsertChain(forks); !errors.Is(err, ErrCheckpointMismatch) {
+		t.Fatalf("expect checkpoint mismatch, got %v", err)
+	}
+	if head := blockchain.CurrentBlock().Hash(); head != blocks[len(blocks)-1].Hash() {
+		t.Fatalf("head mismatch, expect %x, got %x", blocks[len(blocks)-1].Hash(), head)
+	}
+	if checkpoint := blockchain.droppedCheckpoint(blocks[2:5]); checkpoint == nil || checkpoint.Number != 4 {
+		t.Fatalf("expect checkpoint 4 dropped, got %v", checkpoint)
+	}
+	if checkpoint := blockchain.droppedCheckpoint(blocks[4:]); checkpoint != nil {
+		t.Fatalf("expect no checkpoint dropped, got %v", checkpoint)
+	}
+
+	// local chain conflicting with the checkpoint should be refused
+	if err := blockchain.SetSubjectivityCheckpoints([]*SubjectivityCheckpoint{
+		{Number: 4, Hash: forks[3].Hash(), EpochHash: epochHash},
+	}); !errors.Is(err, ErrCheckpointMismatch) {
+		t.Fatalf("expect checkpoint mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// SystemTxGasLimit is the gas limit of each system transaction, which is bounded by the gas limit
+// of the block. system transactions are zero priced but metered, and drawn from a separate gas
+// pool so that they never compete with the common transactions for the block gas limit.
+const SystemTxGasLimit uint64 = 100000000
+
+// ErrInvalidSystemTx is returned if the system transactions of the block are different from the
+// expected ones.
+var ErrInvalidSystemTx = errors.New("invalid system transaction")
+
+// SystemTxSignFn signs the system transaction with the key of the block proposer.
+type SystemTxSignFn func(tx *types.Transaction, signer types.Signer) (*types.Transaction, error)
+
+// IsSystemTx reports whether the transaction is a system transaction of the block, which is sent
+// by the block proposer to a native contract with zero gas price since the system tx fork. system
+// transactions can only be placed at the end of block.
+func IsSystemTx(config *params.ChainConfig, tx *types.Transaction, from common.Address, header *types.Header) bool {
+	if !config.IsSystemTx(header.Number) {
+		return false
+	}
+	return from == header.Coinbase && tx.GasPrice().Sign() == 0 && tx.To() != nil && native.IsNativeContract(*tx.To())
+}
+
+// systemTxGas returns the gas limit of each system transaction of the block.
+func systemTxGas(header *types.Header) uint64 {
+	if header.GasLimit < SystemTxGasLimit {
+		return header.GasLimit
+	}
+	return SystemTxGasLimit
+}
+
+// expectedSystemTxs returns the unsigned system transactions expected at the end of the block,
+// it must be called after all of the common transactions of the block applied. no system
+// transaction is expected before the system tx fork.
+func expectedSystemTxs(config *params.ChainConfig, statedb *state.StateDB, header *types.Header) []*types.Transaction {
+	if !config.IsSystemTx(header.Number) {
+		return nil
+	}
+	calls := native.SystemCalls(statedb, header.Number)
+	nonce := statedb.GetNonce(header.Coinbase)
+	gas := systemTxGas(header)
+	txs := make([]*types.Transaction, len(calls))
+	for i, call := range calls {
+		txs[i] = types.NewTransaction(nonce+uint64(i), call.To, common.Big0, gas, common.Big0, call.Input)
+	}
+	return txs
+}
+
+// verifySystemTxs checks that the transactions are exactly the system transactions expected at
+// the end of the block.
+func verifySystemTxs(config *params.ChainConfig, statedb *state.StateDB, header *types.Header, signer types.Signer, txs []*types.Transaction) error {
+	expected := expectedSystemTxs(config, statedb, header)
+	if len(txs) != len(expected) {
+		return fmt.Errorf("%w: expect %d system txs, got %d", ErrInvalidSystemTx, len(expected), len(txs))
+	}
+	for i, tx := range txs {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSystemTx, err)
+		}
+		if from != header.Coinbase {
+			return fmt.Errorf("%w: tx %v sent by %v instead of proposer", ErrInvalidSystemTx, tx.Hash().Hex(), from.Hex())
+		}
+		if signer.Hash(tx) != signer.Hash(expected[i]) {
+			return fmt.Errorf("%w: tx %v is unexpected", ErrInvalidSystemTx, tx.Hash().Hex())
+		}
+	}
+	return nil
+}
+
+// ApplySystemTxs signs the system transactions of the block with `sign` and applies them to the
+// state, it is used by the block proposer after all of the common transactions applied. the gas
+// used of the header is updated, and the system transactions are indexed from `txIndex`.
+func ApplySystemTxs(config *params.ChainConfig, bc ChainContext, statedb *state.StateDB, header *types.Header,
+	txIndex int, sign SystemTxSignFn, cfg vm.Config) ([]*types.Transaction, []*types.Receipt, error) {
+
+	unsigned := expectedSystemTxs(config, statedb, header)
+	if len(unsigned) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		signer   = types.MakeSigner(config, header.Number)
+		gp       = new(GasPool).AddGas(systemTxGas(header) * uint64(len(unsigned)))
+		txs      = make([]*types.Transaction, 0, len(unsigned))
+		receipts = make([]*types.Receipt, 0, len(unsigned))
+	)
+	for i, tx := range unsigned {
+		signed, err := sign(tx, signer)
+		if err != nil {
+			return nil, nil, err
+		}
+		statedb.Prepare(signed.Hash(), common.Hash{}, txIndex+i)
+		receipt, err := ApplyTransaction(config, bc, &header.Coinbase, gp, statedb, header, signed, &header.GasUsed, cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not apply system tx %d [%v]: %w", i, signed.Hash().Hex(), err)
+		}
+		txs = append(txs, signed)
+		receipts = append(receipts, receipt)
+	}
+	return txs, receipts, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestVerifySystemTxs(t *testing.T) {
+	var (
+		proposerKey, _ = crypto.GenerateKey()
+		otherKey, _    = crypto.GenerateKey()
+		proposer       = crypto.PubkeyToAddress(proposerKey.PublicKey)
+		target         = native.NativeContractAddrMap[native.NativeExtra18]
+		input          = []byte{0x01, 0x02, 0x03, 0x04}
+		signer         = types.MakeSigner(params.TestChainConfig, common.Big1)
+		header         = &types.Header{Number: common.Big1, Coinbase: proposer, GasLimit: 2 * SystemTxGasLimit}
+		config         = *params.TestChainConfig
+	)
+	config.SystemTxBlock = common.Big1
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.SetNonce(proposer, 5)
+
+	native.RegisterSystemCall(native.NativeExtra18, func(db *state.StateDB, height *big.Int) []byte {
+		return input
+	})
+	expected := expectedSystemTxs(&config, statedb, header)
+	if len(expected) != 1 {
+		t.Fatalf("system txs mismatch: have %d, want 1", len(expected))
+	}
+	if tx := expected[0]; tx.Nonce() != 5 || *tx.To() != target || tx.GasPrice().Sign() != 0 || tx.Gas() != SystemTxGasLimit {
+		t.Fatalf("unexpected system tx: %v", tx)
+	}
+	if !IsSystemTx(&config, expected[0], proposer, header) {
+		t.Fatalf("system tx not recognized")
+	}
+	// gas of system txs is bounded by the block gas limit
+	bounded := &types.Header{Number: common.Big1, Coinbase: proposer, GasLimit: 8000000}
+	if tx := expectedSystemTxs(&config, statedb, bounded)[0]; tx.Gas() != bounded.GasLimit {
+		t.Fatalf("system tx gas mismatch: have %d, want %d", tx.Gas(), bounded.GasLimit)
+	}
+	// nothing is expected or recognized before the fork
+	legacy := &types.Header{Number: common.Big0, Coinbase: proposer, GasLimit: header.GasLimit}
+	if txs := expectedSystemTxs(&config, statedb, legacy); len(txs) != 0 {
+		t.Fatalf("system txs expected before fork: %d", len(txs))
+	}
+	if IsSystemTx(&config, expected[0], proposer, legacy) {
+		t.Fatalf("system tx recognized before fork")
+	}
+	if err := verifySystemTxs(&config, statedb, legacy, signer, nil); err != nil {
+		t.Fatalf("failed to verify block before fork: %v", err)
+	}
+
+	sign := func(tx *types.Transaction, key *ecdsa.PrivateKey) *types.Transaction {
+		signed, err := types.SignTx(tx, signer, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	tests := []struct {
+		txs []*types.Transaction
+		err error
+	}{
+		{[]*types.Transaction{sign(expected[0], proposerKey)}, nil},
+		// missing system tx
+		{nil, ErrInvalidSystemTx},
+		// signed by others
+		{[]*types.Transaction{sign(expected[0], otherKey)}, ErrInvalidSystemTx},
+		// different payload
+		{[]*types.Transaction{sign(types.NewTransaction(5, target, common.Big0, SystemTxGasLimit, common.Big0, nil), proposerKey)}, ErrInvalidSystemTx},
+		// different nonce
+		{[]*types.Transaction{sign(types.NewTransaction(6, target, common.Big0, SystemTxGasLimit, common.Big0, input), proposerKey)}, ErrInvalidSystemTx},
+	}
+	for i, tt := range tests {
+		if err := verifySystemTxs(&config, statedb, header, signer, tt.txs); !errors.Is(err, tt.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
@@ -18,11 +18,42 @@
 
 package types
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
+// CrossChainTopic is the first topic of the marker logs which follow the bridge events of the
+// cross chain manager and the lock proxy since the cross chain bloom fork, the second topic of
+// the marker is the id of the event marked. the topic is added to the logs bloom of the receipts
+// and the block header, so that the blocks containing bridge activity are filtered by the header
+// bloom without fetching all receipts.
+var CrossChainTopic = crypto.Keccak256Hash([]byte("ZionCrossChainEvent"))
+
+// EpochValidator is a validator of the epoch with its compressed consensus public key.
+type EpochValidator struct {
+	Address common.Address
+	PubKey  []byte
+}
+
+// EpochChangeEvent is posted when a proposal of new epoch passed in the node manager contract.
 type EpochChangeEvent struct {
 	EpochID     uint64
 	StartHeight uint64
-	Validators  []common.Address
+	Validators  []common.Address // addresses of `Peers` in the same order
+	Peers       []EpochValidator
+	QuorumSize  int
+	PrevHash    common.Hash // hash of the epoch changed from
 	Hash        common.Hash
 }
+
+// HasCrossChainEvents reports whether the bloom possibly contains the marker of bridge events,
+// false positives are possible as any bloom filter.
+func HasCrossChainEvents(bloom Bloom) bool {
+	return bloom.Test(CrossChainTopic.Bytes())
+}
+
+// IsCrossChainMarker reports whether the log is the marker of a bridge event.
+func IsCrossChainMarker(log *Log) bool {
+	return len(log.Topics) == 2 && log.Topics[0] == CrossChainTopic
+}
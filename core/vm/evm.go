@@ -143,6 +143,8 @@ type EVM struct {
 	// available gas is calculated in gasCall* according to the 63/64 rule and later
 	// applied in opCall*.
 	callGasTemp uint64
+	// nativeRef is the native call in progress, which the evm contracts are called back from
+	nativeRef *native.ContractRef
 }
 
 // NewEVM returns a new EVM. The returned EVM is not thread safe and should
@@ -246,7 +248,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	}
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, value)
 	} else {
 		if isPrecompile {
 			ret, gas, err = RunPrecompiledContract(p, input, gas)
@@ -308,7 +310,7 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 	var snapshot = evm.StateDB.Snapshot()
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, nil)
 	} else {
 		// It is allowed to call precompiles, even via delegatecall
 		if p, isPrecompile := evm.precompile(addr); isPrecompile {
@@ -349,7 +351,7 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 	var snapshot = evm.StateDB.Snapshot()
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, nil)
 	} else {
 		// It is allowed to call precompiles, even via delegatecall
 		if p, isPrecompile := evm.precompile(addr); isPrecompile {
@@ -399,7 +401,7 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 	evm.StateDB.AddBalance(addr, big0)
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, nil)
 	} else {
 		if p, isPrecompile := evm.precompile(addr); isPrecompile {
 			ret, gas, err = RunPrecompiledContract(p, input, gas)
@@ -436,7 +438,7 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 // In addition, the gas of native call temporarily uses a fixed value
 //
 // todo(fuk): try to test precompile and ensure that `nativeCall` is safe enough
-func (evm *EVM) nativeCall(caller, addr common.Address, input []byte, suppliedGas uint64) (ret []byte, leftOverGas uint64, err error) {
+func (evm *EVM) nativeCall(caller, addr common.Address, input []byte, suppliedGas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error) {
 	sdb := evm.StateDB.(*state.StateDB)
 	blockNumber := evm.Context.BlockNumber
 
@@ -446,15 +448,39 @@ func (evm *EVM) nativeCall(caller, addr common.Address, input []byte, suppliedGa
 		msgSender = evm.TxContext.Origin
 	}
 	contractRef := native.NewContractRef(sdb, msgSender, caller, blockNumber, txHash, suppliedGas, evm.Callback)
-
+	contractRef.SetChainConfig(evm.chainConfig)
+	contractRef.SetValue(value)
+	if evm.Context.Time != nil {
+		contractRef.SetBlockTime(evm.Context.Time.Uint64())
+	}
+	// the top level call of a zero priced transaction sent by the block proposer is taken as the
+	// system transaction since the system tx fork, the block processing ensures that it is exactly
+	// the one expected.
+	if evm.chainConfig.IsSystemTx(blockNumber) && evm.depth == 0 && evm.TxContext.Origin == evm.Context.Coinbase && evm.TxContext.GasPrice != nil && evm.TxContext.GasPrice.Sign() == 0 {
+		contractRef.SetSystemTx()
+	}
+
+	// the native call is linked to the outer one, so that the contracts entered before the evm
+	// callback are guarded against reentrancy as well.
+	contractRef.SetOuter(evm.nativeRef)
+	outer := evm.nativeRef
+	evm.nativeRef = contractRef
 	ret, leftOverGas, err = contractRef.NativeCall(caller, addr, input)
+	evm.nativeRef = outer
+
+	// typed native errors are reverted with data since the native revert fork, while all of the
+	// supplied gas is still consumed as the other native failures.
+	var revert native.RevertError
+	if err != nil && evm.chainConfig.IsNativeRevert(blockNumber) && errors.As(err, &revert) {
+		ret, leftOverGas, err = revert.RevertData(), 0, ErrExecutionReverted
+	}
 	return
 }
 
-// Callback used when the native contract call back the evm contracts.
-func (evm *EVM) Callback(nativeCaller, addr common.Address, input []byte) (ret []byte, leftOverGas uint64, err error) {
+// Callback used when the native contract call back the evm contracts with the gas supplied.
+func (evm *EVM) Callback(nativeCaller, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
 	accRef := AccountRef(nativeCaller)
-	return evm.Call(accRef, addr, input, 0, big.NewInt(0))
+	return evm.Call(accRef, addr, input, gas, big.NewInt(0))
 }
 
 type codeAndHash struct {
@@ -0,0 +1,73 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestNativeRevert(t *testing.T) {
+	ab, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"fail","inputs":[],"outputs":[],"stateMutability":"nonpayable"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := native.NativeContractAddrMap[native.NativeExtra18]
+	revert := &native.MethodDisabledError{Contract: addr, Method: "fail"}
+	native.Contracts[addr] = func(s *native.NativeContract) {
+		s.Prepare(&ab, map[string]uint64{"fail": 0})
+		s.Register("fail", func(s *native.NativeContract) ([]byte, error) {
+			return nil, revert
+		})
+	}
+	defer delete(native.Contracts, addr)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.SetCode(addr, addr[:])
+	config := *params.TestChainConfig
+	config.NativeRevertBlock = big.NewInt(2)
+	call := func(number int64) ([]byte, error) {
+		blockContext := BlockContext{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(number),
+		}
+		evm := NewEVM(blockContext, TxContext{GasPrice: common.Big1}, statedb, &config, Config{})
+		ret, _, err := evm.Call(AccountRef(common.HexToAddress("0x1")), addr, ab.Methods["fail"].ID, 100000, common.Big0)
+		return ret, err
+	}
+
+	// the native error is returned as is before the fork
+	ret, err := call(1)
+	if !errors.Is(err, revert) || len(ret) != 0 {
+		t.Fatalf("unexpected result before fork: ret %x, err %v", ret, err)
+	}
+	ret, err = call(2)
+	if err != ErrExecutionReverted || !bytes.Equal(ret, revert.RevertData()) {
+		t.Fatalf("unexpected result after fork: ret %x, err %v", ret, err)
+	}
+}
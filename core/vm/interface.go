@@ -43,6 +43,9 @@ type StateDB interface {
 	SubRefund(uint64)
 	GetRefund() uint64
 
+	AddFees(*big.Int)
+	FeeWaived() bool
+
 	GetCommittedState(common.Address, common.Hash) common.Hash
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
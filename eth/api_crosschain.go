@@ -0,0 +1,214 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package eth
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+)
+
+// PublicCrossChainAPI provides cross chain helpers for the relayers.
+type PublicCrossChainAPI struct {
+	eth *Ethereum
+}
+
+// NewPublicCrossChainAPI creates a new API definition for the cross chain methods.
+func NewPublicCrossChainAPI(eth *Ethereum) *PublicCrossChainAPI {
+	return &PublicCrossChainAPI{eth: eth}
+}
+
+// ImportArgs represents the arguments of cross chain manager method `importOuterTransfer`.
+type ImportArgs struct {
+	SourceChainID         hexutil.Uint64 `json:"sourceChainId"`
+	Height                hexutil.Uint   `json:"height"`
+	Proof                 hexutil.Bytes  `json:"proof"`
+	RelayerAddress        hexutil.Bytes  `json:"relayerAddress"`
+	Extra                 hexutil.Bytes  `json:"extra"`
+	HeaderOrCrossChainMsg hexutil.Bytes  `json:"headerOrCrossChainMsg"`
+}
+
+// MakeTxResult is the decoded target chain tx param of an import.
+type MakeTxResult struct {
+	TxHash              hexutil.Bytes  `json:"txHash"`
+	CrossChainID        hexutil.Bytes  `json:"crossChainId"`
+	FromContractAddress hexutil.Bytes  `json:"fromContractAddress"`
+	ToChainID           hexutil.Uint64 `json:"toChainId"`
+	ToContractAddress   hexutil.Bytes  `json:"toContractAddress"`
+	Method              string         `json:"method"`
+	Args                hexutil.Bytes  `json:"args"`
+}
+
+// SimulateImport runs the full verification of `importOuterTransfer` against the latest
+// state without creating a transaction, and returns the target chain tx param or the
+// exact error which the import would fail with, the error category is returned as data.
+func (api *PublicCrossChainAPI) SimulateImport(args ImportArgs) (*MakeTxResult, error) {
+	block := api.eth.blockchain.CurrentBlock()
+	statedb, err := api.eth.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	params := &scom.EntranceParam{
+		SourceChainID:         uint64(args.SourceChainID),
+		Height:                uint32(args.Height),
+		Proof:                 args.Proof,
+		RelayerAddress:        args.RelayerAddress,
+		Extra:                 args.Extra,
+		HeaderOrCrossChainMsg: args.HeaderOrCrossChainMsg,
+	}
+	height := new(big.Int).Add(block.Number(), common.Big1)
+	txParam, err := cross_chain_manager.SimulateImport(statedb, height, params)
+	if err != nil {
+		return nil, scom.AsImportError(err)
+	}
+	return &MakeTxResult{
+		TxHash:              txParam.TxHash,
+		CrossChainID:        txParam.CrossChainID,
+		FromContractAddress: txParam.FromContractAddress,
+		ToChainID:           hexutil.Uint64(txParam.ToChainID),
+		ToContractAddress:   txParam.ToContractAddress,
+		Method:              txParam.Method,
+		Args:                txParam.Args,
+	}, nil
+}
+
+// HeaderSyncStatus is the header sync progress of a side chain.
+type HeaderSyncStatus struct {
+	ChainID         hexutil.Uint64 `json:"chainId"`
+	SyncedHeight    hexutil.Uint64 `json:"syncedHeight"`
+	CanonicalHeight hexutil.Uint64 `json:"canonicalHeight"`
+	Submitter       common.Address `json:"submitter"`
+	BlockNumber     hexutil.Uint64 `json:"blockNumber"`
+	Timestamp       hexutil.Uint64 `json:"timestamp"`
+}
+
+// HeaderSyncStatus returns the latest header sync of the side chain, the block number and
+// timestamp are of the zion block which contains the latest sync. Nil is returned if no
+// header of the chain has been synced.
+func (api *PublicCrossChainAPI) HeaderSyncStatus(chainID hexutil.Uint64) (*HeaderSyncStatus, error) {
+	block := api.eth.blockchain.CurrentBlock()
+	statedb, err := api.eth.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	status, canonical, err := header_sync.GetSyncStatus(statedb, uint64(chainID))
+	if err != nil || status == nil {
+		return nil, err
+	}
+	result := &HeaderSyncStatus{
+		ChainID:         chainID,
+		SyncedHeight:    hexutil.Uint64(status.Height),
+		CanonicalHeight: hexutil.Uint64(canonical),
+		Submitter:       status.Submitter,
+		BlockNumber:     hexutil.Uint64(status.BlockHeight),
+	}
+	if header := api.eth.blockchain.GetHeaderByNumber(status.BlockHeight); header != nil {
+		result.Timestamp = hexutil.Uint64(header.Time)
+	}
+	return result, nil
+}
+
+// maxOutboundRange is the maximum number of messages returned by a single outbound range query.
+const maxOutboundRange = 1024
+
+// OutboundMessage is a message in the outbound queue of a target chain.
+type OutboundMessage struct {
+	Sequence    hexutil.Uint64 `json:"sequence"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	Key         string         `json:"key"`
+	MerkleValue hexutil.Bytes  `json:"merkleValue"`
+}
+
+// OutboundRange is a page of the outbound queue of a target chain.
+type OutboundRange struct {
+	Total    hexutil.Uint64     `json:"total"`
+	Messages []*OutboundMessage `json:"messages"`
+}
+
+// GetOutboundRange returns the outbound messages to the target chain with sequence number in
+// [from, to], so that the relayers are able to recover the backlog without scanning the logs.
+func (api *PublicCrossChainAPI) GetOutboundRange(chainID, from, to hexutil.Uint64) (*OutboundRange, error) {
+	if to < from {
+		return nil, fmt.Errorf("invalid range [%d, %d]", from, to)
+	}
+	if to-from >= maxOutboundRange {
+		return nil, fmt.Errorf("range exceeds the limit %d", maxOutboundRange)
+	}
+	block := api.eth.blockchain.CurrentBlock()
+	statedb, err := api.eth.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	msgs, total, err := cross_chain_manager.GetOutboundRange(statedb, uint64(chainID), uint64(from), uint64(to))
+	if err != nil {
+		return nil, err
+	}
+	result := &OutboundRange{
+		Total:    hexutil.Uint64(total),
+		Messages: make([]*OutboundMessage, 0, len(msgs)),
+	}
+	for i, msg := range msgs {
+		result.Messages = append(result.Messages, &OutboundMessage{
+			Sequence:    from + hexutil.Uint64(i),
+			BlockNumber: hexutil.Uint64(msg.Height),
+			Key:         msg.Key,
+			MerkleValue: msg.MerkleValue,
+		})
+	}
+	return result, nil
+}
+
+// DeliveryStatus is the delivery status of an outbound message.
+type DeliveryStatus struct {
+	Sequence            hexutil.Uint64  `json:"sequence"`
+	BlockNumber         hexutil.Uint64  `json:"blockNumber"`
+	Delivered           bool            `json:"delivered"`
+	DeliveryBlockNumber *hexutil.Uint64 `json:"deliveryBlockNumber"`
+	State               string          `json:"state"` // accepted, delivered, failed or refunded
+}
+
+// GetDeliveryStatus returns whether the outbound message to the target chain has been confirmed
+// delivered by a delivery receipt and its lifecycle state, nil is returned if the message doesn't
+// exist.
+func (api *PublicCrossChainAPI) GetDeliveryStatus(chainID, sequence hexutil.Uint64) (*DeliveryStatus, error) {
+	block := api.eth.blockchain.CurrentBlock()
+	statedb, err := api.eth.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	status, err := cross_chain_manager.GetDeliveryStatus(statedb, uint64(chainID), uint64(sequence))
+	if err != nil || status == nil {
+		return nil, err
+	}
+	result := &DeliveryStatus{
+		Sequence:    sequence,
+		BlockNumber: hexutil.Uint64(status.Message.Height),
+		Delivered:   status.Delivered,
+		State:       status.State.String(),
+	}
+	if status.Delivered {
+		height := hexutil.Uint64(status.BlockHeight)
+		result.DeliveryBlockNumber = &height
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package eth
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// MigrationResult is the outcome of a native storage migration in dry run.
+type MigrationResult struct {
+	Name     string         `json:"name"`
+	Contract common.Address `json:"contract"`
+	Version  hexutil.Uint64 `json:"version"`
+	Slots    int64          `json:"slots"`
+	Root     common.Hash    `json:"root"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// DryRunMigrations applies the native storage migrations on top of the state of the block without
+// persisting, so that the operators are able to verify the migrations before the hard fork. all
+// migrations scheduled in chain config are applied if no name given.
+func (api *PrivateDebugAPI) DryRunMigrations(blockNr rpc.BlockNumber, names []string) ([]*MigrationResult, error) {
+	var block *types.Block
+	if blockNr == rpc.LatestBlockNumber || blockNr == rpc.PendingBlockNumber {
+		block = api.eth.blockchain.CurrentBlock()
+	} else {
+		block = api.eth.blockchain.GetBlockByNumber(uint64(blockNr))
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", blockNr)
+	}
+	statedb, err := api.eth.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		for name := range api.eth.blockchain.Config().NativeMigrations {
+			names = append(names, name)
+		}
+	}
+	reports, err := native.DryRunMigrations(statedb, names)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*MigrationResult, 0, len(reports))
+	for _, v := range reports {
+		result := &MigrationResult{
+			Name:     v.Name,
+			Contract: v.Contract,
+			Version:  hexutil.Uint64(v.Version),
+			Slots:    v.Slots,
+			Root:     v.Root,
+		}
+		if v.Err != nil {
+			result.Error = v.Err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
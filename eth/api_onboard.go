@@ -0,0 +1,319 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync/bsc"
+	hseth "github.com/ethereum/go-ethereum/contracts/native/header_sync/eth"
+	ethtypes "github.com/ethereum/go-ethereum/contracts/native/header_sync/eth/types"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync/heco"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// defaultOnboardEpoch is the epoch length of parlia and congress, the validators are rotated
+	// at the epoch boundary.
+	defaultOnboardEpoch = 200
+	// defaultOnboardPeriod is the block period of heco.
+	defaultOnboardPeriod = 3
+	// extraVanity and extraSeal are the fixed prefix and suffix of the parlia and congress
+	// header extra, the validators are in between at the epoch boundary.
+	extraVanity = 32
+	extraSeal   = 65
+)
+
+// OnboardArgs are the side chain to onboard, the genesis material is fetched from the json rpc
+// endpoint of the source chain.
+type OnboardArgs struct {
+	Router       hexutil.Uint64  `json:"router"`
+	Endpoint     string          `json:"endpoint"`
+	ChainID      hexutil.Uint64  `json:"chainId"`
+	Name         string          `json:"name"`
+	Owner        common.Address  `json:"owner"`
+	BlocksToWait hexutil.Uint64  `json:"blocksToWait"`
+	CCMCAddress  hexutil.Bytes   `json:"ccmcAddress"`
+	Height       *hexutil.Uint64 `json:"height"` // genesis height, the latest safe or epoch boundary height if omitted
+	Epoch        hexutil.Uint64  `json:"epoch"`  // epoch length of bsc and heco, 200 if omitted
+	Period       hexutil.Uint64  `json:"period"` // block period of heco, 3 if omitted
+}
+
+// OnboardResult is the validated genesis material, and the payloads of `registerSideChain` sent
+// by the owner and `syncGenesisHeader` signed by the validators.
+type OnboardResult struct {
+	SourceChainID   *hexutil.Big     `json:"sourceChainId"`
+	GenesisHeight   hexutil.Uint64   `json:"genesisHeight"`
+	GenesisHash     common.Hash      `json:"genesisHash"`
+	Validators      []common.Address `json:"validators,omitempty"`
+	ExtraInfo       hexutil.Bytes    `json:"extraInfo"`
+	GenesisHeader   hexutil.Bytes    `json:"genesisHeader"`
+	RegisterTo      common.Address   `json:"registerTo"`
+	Register        hexutil.Bytes    `json:"register"`
+	GenesisTo       common.Address   `json:"genesisTo"`
+	Genesis         hexutil.Bytes    `json:"genesis"`
+	SimulationError string           `json:"simulationError,omitempty"`
+}
+
+// onboardMaterial is the genesis material of a side chain.
+type onboardMaterial struct {
+	height     uint64
+	hash       common.Hash
+	validators []common.Address
+	extraInfo  []byte
+	genesis    []byte
+}
+
+// OnboardSideChain fetches and validates the genesis header (and the validator sets for bsc and
+// heco) from the source chain, and returns the registration and genesis header sync payloads.
+// The whole onboarding is simulated against the latest state, including the approvals by the
+// validators of current epoch, and the error of the first failing step is returned in the result.
+func (api *PublicCrossChainAPI) OnboardSideChain(ctx context.Context, args OnboardArgs) (*OnboardResult, error) {
+	if args.Endpoint == "" {
+		return nil, fmt.Errorf("missing endpoint of the source chain")
+	}
+	client, err := rpc.DialContext(ctx, args.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("dial source chain: %v", err)
+	}
+	defer client.Close()
+
+	var sourceChainID hexutil.Big
+	if err := client.CallContext(ctx, &sourceChainID, "eth_chainId"); err != nil {
+		return nil, fmt.Errorf("query chain id of source chain: %v", err)
+	}
+	var material *onboardMaterial
+	switch uint64(args.Router) {
+	case utils.ETH_ROUTER:
+		material, err = fetchEthGenesis(ctx, client, args)
+	case utils.BSC_ROUTER, utils.HECO_ROUTER:
+		material, err = fetchParliaGenesis(ctx, client, args, sourceChainID.ToInt())
+	default:
+		return nil, fmt.Errorf("onboarding of router %d is not supported", args.Router)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	register := &side_chain_manager.RegisterSideChainParam{
+		Address:      args.Owner,
+		ChainId:      uint64(args.ChainID),
+		Router:       uint64(args.Router),
+		Name:         args.Name,
+		BlocksToWait: uint64(args.BlocksToWait),
+		CCMCAddress:  args.CCMCAddress,
+		ExtraInfo:    material.extraInfo,
+	}
+	payloads, err := header_sync.PackOnboardPayloads(register, material.genesis)
+	if err != nil {
+		return nil, err
+	}
+	result := &OnboardResult{
+		SourceChainID: &sourceChainID,
+		GenesisHeight: hexutil.Uint64(material.height),
+		GenesisHash:   material.hash,
+		Validators:    material.validators,
+		ExtraInfo:     material.extraInfo,
+		GenesisHeader: material.genesis,
+		RegisterTo:    utils.SideChainManagerContractAddress,
+		Register:      payloads.Register,
+		GenesisTo:     utils.HeaderSyncContractAddress,
+		Genesis:       payloads.Genesis,
+	}
+
+	block := api.eth.blockchain.CurrentBlock()
+	statedb, err := api.eth.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	height := new(big.Int).Add(block.Number(), common.Big1)
+	if err := header_sync.SimulateOnboarding(statedb, height, register, material.genesis); err != nil {
+		result.SimulationError = err.Error()
+	}
+	return result, nil
+}
+
+// fetchHeader fetches the raw header at the height, and the hash reported by the source chain.
+func fetchHeader(ctx context.Context, client *rpc.Client, height uint64) (json.RawMessage, common.Hash, error) {
+	var raw json.RawMessage
+	if err := client.CallContext(ctx, &raw, "eth_getBlockByNumber", hexutil.EncodeUint64(height), false); err != nil {
+		return nil, common.Hash{}, fmt.Errorf("fetch header %d: %v", height, err)
+	}
+	var block struct {
+		Hash *common.Hash `json:"hash"`
+	}
+	if err := json.Unmarshal(raw, &block); err != nil {
+		return nil, common.Hash{}, fmt.Errorf("decode header %d: %v", height, err)
+	}
+	if block.Hash == nil {
+		return nil, common.Hash{}, fmt.Errorf("header %d not found", height)
+	}
+	return raw, *block.Hash, nil
+}
+
+func latestHeight(ctx context.Context, client *rpc.Client) (uint64, error) {
+	var latest hexutil.Uint64
+	if err := client.CallContext(ctx, &latest, "eth_blockNumber"); err != nil {
+		return 0, fmt.Errorf("query latest height of source chain: %v", err)
+	}
+	return uint64(latest), nil
+}
+
+// fetchEthGenesis fetches the header at the height, or `blocksToWait` blocks behind the latest one.
+func fetchEthGenesis(ctx context.Context, client *rpc.Client, args OnboardArgs) (*onboardMaterial, error) {
+	var height uint64
+	if args.Height != nil {
+		height = uint64(*args.Height)
+	} else {
+		latest, err := latestHeight(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if latest < uint64(args.BlocksToWait) {
+			return nil, fmt.Errorf("latest height %d of source chain is lower than blocks to wait %d", latest, args.BlocksToWait)
+		}
+		height = latest - uint64(args.BlocksToWait)
+	}
+	raw, hash, err := fetchHeader(ctx, client, height)
+	if err != nil {
+		return nil, err
+	}
+	var header hseth.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, fmt.Errorf("decode header %d: %v", height, err)
+	}
+	if header.Hash() != hash {
+		return nil, fmt.Errorf("hash of header %d mismatch, computed %s, reported %s", height, header.Hash().Hex(), hash.Hex())
+	}
+	genesis, err := json.Marshal(&header)
+	if err != nil {
+		return nil, err
+	}
+	return &onboardMaterial{height: height, hash: hash, genesis: genesis}, nil
+}
+
+// fetchParliaGenesis fetches the epoch boundary header as genesis, and the validators of the
+// previous epoch, which are still signing the first blocks of the genesis epoch.
+func fetchParliaGenesis(ctx context.Context, client *rpc.Client, args OnboardArgs, sourceChainID *big.Int) (*onboardMaterial, error) {
+	epoch := uint64(args.Epoch)
+	if epoch == 0 {
+		epoch = defaultOnboardEpoch
+	}
+	var height uint64
+	if args.Height != nil {
+		height = uint64(*args.Height)
+	} else {
+		latest, err := latestHeight(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		height = latest - latest%epoch
+	}
+	if height%epoch != 0 || height < epoch {
+		return nil, fmt.Errorf("genesis height %d is not an epoch boundary after the first epoch of length %d", height, epoch)
+	}
+
+	header, hash, validators, err := fetchEpochHeader(ctx, client, height)
+	if err != nil {
+		return nil, err
+	}
+	prevHeader, prevHash, prevValidators, err := fetchEpochHeader(ctx, client, height-epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	material := &onboardMaterial{height: height, hash: hash, validators: validators}
+	var genesis interface{}
+	if uint64(args.Router) == utils.BSC_ROUTER {
+		material.extraInfo, err = json.Marshal(&bsc.ExtraInfo{ChainID: sourceChainID})
+		genesis = &bsc.GenesisHeader{
+			Header: *header,
+			PrevValidators: []bsc.HeightAndValidators{
+				{Height: prevHeader.Number, Validators: prevValidators, Hash: &prevHash},
+			},
+		}
+	} else {
+		period := uint64(args.Period)
+		if period == 0 {
+			period = defaultOnboardPeriod
+		}
+		material.extraInfo, err = json.Marshal(&heco.ExtraInfo{ChainID: sourceChainID, Period: period})
+		genesis = &heco.GenesisHeader{
+			Header: hseth.Header{
+				ParentHash:  header.ParentHash,
+				UncleHash:   header.UncleHash,
+				Coinbase:    header.Coinbase,
+				Root:        header.Root,
+				TxHash:      header.TxHash,
+				ReceiptHash: header.ReceiptHash,
+				Bloom:       header.Bloom,
+				Difficulty:  header.Difficulty,
+				Number:      header.Number,
+				GasLimit:    header.GasLimit,
+				GasUsed:     header.GasUsed,
+				Time:        header.Time,
+				Extra:       header.Extra,
+				MixDigest:   header.MixDigest,
+				Nonce:       header.Nonce,
+			},
+			PrevValidators: []heco.HeightAndValidators{
+				{Height: prevHeader.Number, Validators: prevValidators, Hash: &prevHash},
+			},
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if material.genesis, err = json.Marshal(genesis); err != nil {
+		return nil, err
+	}
+	return material, nil
+}
+
+// fetchEpochHeader fetches and validates the epoch boundary header, and the validators in the
+// header extra.
+func fetchEpochHeader(ctx context.Context, client *rpc.Client, height uint64) (*ethtypes.Header, common.Hash, []common.Address, error) {
+	raw, hash, err := fetchHeader(ctx, client, height)
+	if err != nil {
+		return nil, common.Hash{}, nil, err
+	}
+	header := new(ethtypes.Header)
+	if err := json.Unmarshal(raw, header); err != nil {
+		return nil, common.Hash{}, nil, fmt.Errorf("decode header %d: %v", height, err)
+	}
+	if header.Hash() != hash {
+		return nil, common.Hash{}, nil, fmt.Errorf("hash of header %d mismatch, computed %s, reported %s", height, header.Hash().Hex(), hash.Hex())
+	}
+	signersBytes := len(header.Extra) - extraVanity - extraSeal
+	if signersBytes <= 0 || signersBytes%common.AddressLength != 0 {
+		return nil, common.Hash{}, nil, fmt.Errorf("invalid validators in extra of header %d, %d bytes", height, signersBytes)
+	}
+	validators, err := bsc.ParseValidators(header.Extra[extraVanity : extraVanity+signersBytes])
+	if err != nil {
+		return nil, common.Hash{}, nil, fmt.Errorf("parse validators of header %d: %v", height, err)
+	}
+	return header, hash, validators, nil
+}
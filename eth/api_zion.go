@@ -0,0 +1,253 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// votingPowerCacheLimit is the number of finished epochs whose voting power is cached.
+const votingPowerCacheLimit = 32
+
+// PublicZionAPI provides the helpers of zion native contracts.
+type PublicZionAPI struct {
+	eth *Ethereum
+
+	powers *lru.Cache // voting power of the finished epochs by epoch hash
+}
+
+// NewPublicZionAPI creates a new API definition for the zion methods.
+func NewPublicZionAPI(eth *Ethereum) *PublicZionAPI {
+	powers, _ := lru.New(votingPowerCacheLimit)
+	return &PublicZionAPI{eth: eth, powers: powers}
+}
+
+// DecodedTx is a transaction or calldata decoded with the native contract abi, the events are
+// the logs emitted by native contracts in the transaction.
+type DecodedTx struct {
+	Hash        *common.Hash           `json:"hash,omitempty"`
+	BlockNumber *hexutil.Uint64        `json:"blockNumber,omitempty"`
+	To          *common.Address        `json:"to,omitempty"`
+	Status      *hexutil.Uint64        `json:"status,omitempty"`
+	Call        *native.DecodedCall    `json:"call"`
+	Events      []*native.DecodedEvent `json:"events"`
+}
+
+// DecodeTx decodes the native contract call and events of the transaction if the input is the
+// hash of a known transaction, otherwise the input is decoded as calldata of the contract `to`,
+// which is looked up by the method id if omitted. the call of a transaction to the non-native
+// contract is left empty, while the events emitted by the native contracts are still decoded.
+func (api *PublicZionAPI) DecodeTx(input hexutil.Bytes, to *common.Address) (*DecodedTx, error) {
+	if len(input) == common.HashLength {
+		hash := common.BytesToHash(input)
+		if tx, blockHash, blockNumber, index := rawdb.ReadTransaction(api.eth.ChainDb(), hash); tx != nil {
+			return api.decodeTx(tx, blockHash, blockNumber, index)
+		}
+	}
+	call, err := native.DecodeCall(to, input)
+	if err != nil {
+		return nil, err
+	}
+	return &DecodedTx{To: to, Call: call, Events: make([]*native.DecodedEvent, 0)}, nil
+}
+
+func (api *PublicZionAPI) decodeTx(tx *types.Transaction, blockHash common.Hash, blockNumber, index uint64) (*DecodedTx, error) {
+	hash := tx.Hash()
+	number := hexutil.Uint64(blockNumber)
+	decoded := &DecodedTx{
+		Hash:        &hash,
+		BlockNumber: &number,
+		To:          tx.To(),
+		Events:      make([]*native.DecodedEvent, 0),
+	}
+	if tx.To() != nil && native.IsNativeContract(*tx.To()) {
+		call, err := native.DecodeCall(tx.To(), tx.Data())
+		if err != nil {
+			return nil, err
+		}
+		decoded.Call = call
+	}
+
+	receipts := api.eth.blockchain.GetReceiptsByHash(blockHash)
+	if uint64(len(receipts)) <= index {
+		return decoded, nil
+	}
+	receipt := receipts[index]
+	status := hexutil.Uint64(receipt.Status)
+	decoded.Status = &status
+	for _, log := range receipt.Logs {
+		event, err := native.DecodeLog(log)
+		if err != nil {
+			return nil, fmt.Errorf("log %d: %v", log.Index, err)
+		}
+		if event != nil {
+			decoded.Events = append(decoded.Events, event)
+		}
+	}
+	return decoded, nil
+}
+
+// VotingPower is the snapshot of the validator set of an epoch with the realized participation of
+// each member, which is aggregated over the blocks of the epoch. validators of zion have equal
+// weight, so the weight of a member is 1 and the total weight is the size of the validator set.
+type VotingPower struct {
+	EpochID     hexutil.Uint64 `json:"epochID"`
+	EpochHash   common.Hash    `json:"epochHash"`
+	StartHeight hexutil.Uint64 `json:"startHeight"`
+	EndHeight   hexutil.Uint64 `json:"endHeight"` // the last block of epoch, or the head if not finished
+	Finished    bool           `json:"finished"`
+	Blocks      hexutil.Uint64 `json:"blocks"` // number of the blocks committed in the epoch
+	TotalWeight hexutil.Uint64 `json:"totalWeight"`
+	QuorumSize  hexutil.Uint64 `json:"quorumSize"`
+	Members     []*MemberPower `json:"members"`
+}
+
+// MemberPower is the weight and participation of a validator in the epoch, the participation is
+// the ratio of committed blocks sealed by the member in basis points.
+type MemberPower struct {
+	Address       common.Address `json:"address"`
+	Weight        hexutil.Uint64 `json:"weight"`
+	Proposed      hexutil.Uint64 `json:"proposed"`
+	Signed        hexutil.Uint64 `json:"signed"`
+	Missed        hexutil.Uint64 `json:"missed"`
+	Participation hexutil.Uint64 `json:"participation"`
+}
+
+// VotingPower returns the voting power of the members of the epoch and their participation, the
+// current epoch is used if the id is omitted. the quorum size is calculated with the quorum rule
+// in force, and the result of a finished epoch is cached.
+func (api *PublicZionAPI) VotingPower(epochID *hexutil.Uint64) (*VotingPower, error) {
+	statedb, err := api.eth.blockchain.State()
+	if err != nil {
+		return nil, err
+	}
+	cur, err := node_manager.ReadCurrentEpoch(statedb)
+	if err != nil {
+		return nil, fmt.Errorf("read current epoch: %v", err)
+	}
+	id := cur.ID
+	if epochID != nil {
+		id = uint64(*epochID)
+	}
+	if id < node_manager.StartEpoch || id > cur.ID {
+		return nil, fmt.Errorf("epoch %d not exist, current epoch %d", id, cur.ID)
+	}
+
+	// proof of the genesis epoch is not stored, read it from the genesis state instead
+	if id == node_manager.StartEpoch && cur.ID > id {
+		if statedb, err = api.eth.blockchain.StateAt(api.eth.blockchain.Genesis().Root()); err != nil {
+			return nil, err
+		}
+	}
+	epoch, err := node_manager.GetEpochSnapshot(statedb, id)
+	if err != nil {
+		return nil, err
+	}
+	if power, ok := api.powers.Get(epoch.Hash); ok {
+		return power.(*VotingPower), nil
+	}
+
+	head := api.eth.blockchain.CurrentHeader().Number.Uint64()
+	end, finished := head, false
+	if id < cur.ID {
+		if statedb, err = api.eth.blockchain.State(); err != nil {
+			return nil, err
+		}
+		next, err := node_manager.GetEpochSnapshot(statedb, id+1)
+		if err != nil {
+			return nil, err
+		}
+		if next.StartHeight <= head {
+			end, finished = next.StartHeight-1, true
+		}
+	}
+	power, err := api.votingPower(epoch, end)
+	if err != nil {
+		return nil, err
+	}
+	power.Finished = finished
+	if finished {
+		api.powers.Add(epoch.Hash, power)
+	}
+	return power, nil
+}
+
+// votingPower aggregates the proposers and committers of the blocks of the epoch until the end.
+func (api *PublicZionAPI) votingPower(epoch *types.EpochChangeEvent, end uint64) (*VotingPower, error) {
+	power := &VotingPower{
+		EpochID:     hexutil.Uint64(epoch.EpochID),
+		EpochHash:   epoch.Hash,
+		StartHeight: hexutil.Uint64(epoch.StartHeight),
+		EndHeight:   hexutil.Uint64(end),
+		TotalWeight: hexutil.Uint64(len(epoch.Validators)),
+		QuorumSize:  hexutil.Uint64(epoch.QuorumSize),
+		Members:     make([]*MemberPower, 0, len(epoch.Validators)),
+	}
+	members := make(map[common.Address]*MemberPower)
+	for _, addr := range epoch.Validators {
+		member := &MemberPower{Address: addr, Weight: 1}
+		members[addr] = member
+		power.Members = append(power.Members, member)
+	}
+
+	// the genesis block is not sealed
+	start := epoch.StartHeight
+	if start == 0 {
+		start = 1
+	}
+	for number := start; number <= end; number++ {
+		header := api.eth.blockchain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, fmt.Errorf("header %d not found", number)
+		}
+		proposer, err := api.eth.engine.Author(header)
+		if err != nil {
+			return nil, fmt.Errorf("recover proposer of block %d: %v", number, err)
+		}
+		if member, ok := members[proposer]; ok {
+			member.Proposed++
+		}
+		committers, err := snr.Committers(header)
+		if err != nil {
+			return nil, fmt.Errorf("recover committers of block %d: %v", number, err)
+		}
+		for _, addr := range committers {
+			if member, ok := members[addr]; ok {
+				member.Signed++
+			}
+		}
+		power.Blocks++
+	}
+	for _, member := range power.Members {
+		member.Missed = power.Blocks - member.Signed
+		if power.Blocks > 0 {
+			member.Participation = member.Signed * 10000 / power.Blocks
+		}
+	}
+	return power, nil
+}
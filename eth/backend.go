@@ -31,6 +31,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/selftest"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -122,6 +124,12 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	}
 	log.Info("Allocated trie memory caches", "clean", common.StorageSize(config.TrieCleanCache)*1024*1024, "dirty", common.StorageSize(config.TrieDirtyCache)*1024*1024)
 
+	// Check the proof verifiers of cross chain handlers before any message is imported, a
+	// regression of the verifiers would fork the node off the network.
+	if err := selftest.Verify(); err != nil {
+		return nil, err
+	}
+
 	// Transfer mining-related config to the ethash config.
 	ethashConfig := config.Ethash
 	ethashConfig.NotifyFull = config.Miner.NotifyFull
@@ -140,12 +148,13 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb, stack.ResolvePath(config.TrieCleanCacheJournal)); err != nil {
 		log.Error("Failed to recover state", "error", err)
 	}
+	remoteSigner := snr.RemoteConfig{Address: config.HotStuffValidator, Endpoints: config.HotStuffSigners, Timeout: config.HotStuffSignerTimeout}
 	eth := &Ethereum{
 		config:            config,
 		chainDb:           chainDb,
 		eventMux:          stack.EventMux(),
 		accountManager:    stack.AccountManager(),
-		engine:            ethconfig.CreateConsensusEngine(stack, chainConfig, &ethashConfig, config.Miner.Notify, config.Miner.Noverify, chainDb),
+		engine:            ethconfig.CreateConsensusEngine(stack, chainConfig, &ethashConfig, config.Miner.Notify, config.Miner.Noverify, chainDb, remoteSigner),
 		closeBloomHandler: make(chan struct{}),
 		networkID:         config.NetworkId,
 		gasPrice:          config.Miner.GasPrice,
@@ -200,6 +209,20 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		eth.blockchain.SetHead(compat.RewindTo)
 		rawdb.WriteChainConfig(chainDb, genesisHash, chainConfig)
 	}
+	// Enforce the trusted checkpoints both on the local chain and the remote peers
+	if err := eth.blockchain.SetSubjectivityCheckpoints(config.SubjectivityCheckpoints); err != nil {
+		return nil, err
+	}
+	whitelist := config.Whitelist
+	if len(config.SubjectivityCheckpoints) > 0 {
+		whitelist = make(map[uint64]common.Hash)
+		for number, hash := range config.Whitelist {
+			whitelist[number] = hash
+		}
+		for _, checkpoint := range config.SubjectivityCheckpoints {
+			whitelist[checkpoint.Number] = checkpoint.Hash
+		}
+	}
 	eth.bloomIndexer.Start(eth.blockchain)
 
 	if config.TxPool.Journal != "" {
@@ -222,7 +245,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		BloomCache: uint64(cacheLimit),
 		EventMux:   eth.eventMux,
 		Checkpoint: checkpoint,
-		Whitelist:  config.Whitelist,
+		Whitelist:  whitelist,
 	}, eth.engine); err != nil {
 		return nil, err
 	}
@@ -344,6 +367,16 @@ func (s *Ethereum) APIs() []rpc.API {
 			Version:   "1.0",
 			Service:   s.netRPCService,
 			Public:    true,
+		}, {
+			Namespace: "crosschain",
+			Version:   "1.0",
+			Service:   NewPublicCrossChainAPI(s),
+			Public:    true,
+		}, {
+			Namespace: "zion",
+			Version:   "1.0",
+			Service:   NewPublicZionAPI(s),
+			Public:    true,
 		},
 	}...)
 }
@@ -536,6 +569,11 @@ func (s *Ethereum) Start() error {
 	// Start the bloom bits servicing goroutines
 	s.startBloomHandlers(params.BloomBitsBlocks)
 
+	// Start the header sync lag alert if requested
+	if s.config.HeaderSyncAlertLag > 0 && len(s.config.HeaderSyncAlertChains) > 0 {
+		s.startHeaderSyncMonitor(s.config.HeaderSyncAlertChains, s.config.HeaderSyncAlertLag)
+	}
+
 	// Figure out a max peers count based on the server limits
 	maxPeers := s.p2pServer.MaxPeers
 	if s.config.LightServ > 0 {
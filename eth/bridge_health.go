@@ -0,0 +1,199 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maintenance"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// BridgeHealthPath is the path of the bridge health endpoint on the HTTP-RPC server.
+const BridgeHealthPath = "/health/bridge"
+
+// maxBacklogScan is the number of latest outbound messages checked for the delivery backlog.
+const maxBacklogScan = 256
+
+// EpochHealth is the current epoch in the bridge health report.
+type EpochHealth struct {
+	ID          uint64      `json:"id"`
+	Hash        common.Hash `json:"hash"`
+	StartHeight uint64      `json:"startHeight"`
+	Validators  int         `json:"validators"`
+}
+
+// ChainHealth is the bridge state of a registered side chain.
+type ChainHealth struct {
+	ChainID         uint64  `json:"chainId"`
+	SyncedHeight    uint64  `json:"syncedHeight"`
+	CanonicalHeight uint64  `json:"canonicalHeight"`
+	SyncLag         *uint64 `json:"syncLag"` // seconds since the latest header sync, null if never synced
+	Lagging         bool    `json:"lagging"`
+	Blacked         bool    `json:"blacked"`
+	Outbound        uint64  `json:"outbound"`
+	Undelivered     uint64  `json:"undelivered"`
+}
+
+// BridgeHealth summarizes the bridge state at the head block.
+type BridgeHealth struct {
+	Healthy     bool            `json:"healthy"`
+	BlockNumber uint64          `json:"blockNumber"`
+	Timestamp   uint64          `json:"timestamp"`
+	Epoch       *EpochHealth    `json:"epoch"`
+	Paused      map[string]bool `json:"paused"`
+	Chains      []*ChainHealth  `json:"chains"`
+}
+
+// pausableMethods are the relayer-facing methods reported in the health, they could be disabled
+// by the maintenance contract.
+var pausableMethods = []struct {
+	contract common.Address
+	method   string
+}{
+	{utils.CrossChainManagerContractAddress, scom.MethodImportOuterTransfer},
+	{utils.HeaderSyncContractAddress, hscommon.MethodSyncBlockHeader},
+	{utils.HeaderSyncContractAddress, hscommon.MethodSyncCrossChainMsg},
+}
+
+// BridgeHealth reports the header sync lag, pause states and delivery backlog of the registered
+// side chains and the current epoch. The bridge is unhealthy if the header sync of any monitored
+// chain lags behind `HeaderSyncAlertLag`, all the chains are monitored if `HeaderSyncAlertChains`
+// is empty, and the lag is never checked if the alert is disabled.
+func (s *Ethereum) BridgeHealth() (*BridgeHealth, error) {
+	head := s.blockchain.CurrentBlock()
+	statedb, err := s.blockchain.StateAt(head.Root())
+	if err != nil {
+		return nil, err
+	}
+	health := &BridgeHealth{
+		Healthy:     true,
+		BlockNumber: head.NumberU64(),
+		Timestamp:   head.Time(),
+		Paused:      make(map[string]bool),
+		Chains:      make([]*ChainHealth, 0),
+	}
+	if epoch, err := node_manager.ReadCurrentEpoch(statedb); err == nil {
+		health.Epoch = &EpochHealth{
+			ID:          epoch.ID,
+			Hash:        epoch.Hash(),
+			StartHeight: epoch.StartHeight,
+			Validators:  epoch.Peers.Len(),
+		}
+	}
+	for _, v := range pausableMethods {
+		ab := scom.ABI
+		if v.contract == utils.HeaderSyncContractAddress {
+			ab = hscommon.ABI
+		}
+		if ab == nil {
+			continue
+		}
+		if method, ok := ab.Methods[v.method]; ok {
+			health.Paused[v.method] = maintenance.IsMethodDisabled(statedb, v.contract, method.ID)
+		}
+	}
+
+	ids, err := side_chain_manager.GetSideChainIDs(statedb)
+	if err != nil {
+		return nil, err
+	}
+	for _, chainID := range ids {
+		chain, err := s.chainHealth(statedb, head.Time(), chainID)
+		if err != nil {
+			return nil, err
+		}
+		if chain.Lagging {
+			health.Healthy = false
+		}
+		health.Chains = append(health.Chains, chain)
+	}
+	return health, nil
+}
+
+func (s *Ethereum) chainHealth(statedb *state.StateDB, now uint64, chainID uint64) (*ChainHealth, error) {
+	chain := &ChainHealth{ChainID: chainID}
+	status, canonical, err := header_sync.GetSyncStatus(statedb, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if status != nil {
+		chain.SyncedHeight = status.Height
+		chain.CanonicalHeight = canonical
+		if synced := s.blockchain.GetHeaderByNumber(status.BlockHeight); synced != nil && synced.Time <= now {
+			lag := now - synced.Time
+			chain.SyncLag = &lag
+			threshold := s.config.HeaderSyncAlertLag
+			chain.Lagging = threshold > 0 && s.monitored(chainID) && time.Duration(lag)*time.Second > threshold
+		}
+	}
+	if chain.Blacked, err = cross_chain_manager.IsChainBlacked(statedb, chainID); err != nil {
+		return nil, err
+	}
+	if chain.Outbound, chain.Undelivered, err = cross_chain_manager.GetDeliveryBacklog(statedb, chainID, maxBacklogScan); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+func (s *Ethereum) monitored(chainID uint64) bool {
+	if len(s.config.HeaderSyncAlertChains) == 0 {
+		return true
+	}
+	for _, id := range s.config.HeaderSyncAlertChains {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// NewBridgeHealthHandler creates the handler of bridge health endpoint, the report is served as
+// JSON with status 503 if the bridge is unhealthy, so that uptime monitors are able to alert on
+// the status code alone.
+func NewBridgeHealthHandler(eth *Ethereum) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		health, err := eth.BridgeHealth()
+		if err != nil {
+			log.Debug("Failed to report bridge health", "err", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !health.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(health)
+	})
+}
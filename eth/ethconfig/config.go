@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	hsb "github.com/ethereum/go-ethereum/consensus/hotstuff/backend"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -140,6 +141,18 @@ type Config struct {
 	// Whitelist of required block number -> hash values to accept
 	Whitelist map[uint64]common.Hash `toml:"-"`
 
+	// Trusted checkpoints which the chain never reorgs behind
+	SubjectivityCheckpoints []*core.SubjectivityCheckpoint `toml:"-"`
+
+	// Header sync lag alert options
+	HeaderSyncAlertChains []uint64      `toml:",omitempty"` // Side chains whose header sync lag is monitored
+	HeaderSyncAlertLag    time.Duration `toml:",omitempty"` // Lag of the latest header sync to raise the alert, zero disables the alert
+
+	// Remote signer options of the validator key
+	HotStuffSigners       []string       `toml:",omitempty"` // RPC endpoints of the remote signers in order of failover, empty for the node key
+	HotStuffSignerTimeout time.Duration  `toml:",omitempty"` // Timeout of a signing request to the remote signer
+	HotStuffValidator     common.Address `toml:",omitempty"` // Address of the validator key held by the remote signers
+
 	// Light client options
 	LightServ          int  `toml:",omitempty"` // Maximum percentage of time allowed for serving LES requests
 	LightIngress       int  `toml:",omitempty"` // Incoming bandwidth limit for light servers
@@ -210,7 +223,7 @@ type Config struct {
 }
 
 // CreateConsensusEngine creates a consensus engine for the given chain configuration.
-func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, config *ethash.Config, notify []string, noverify bool, db ethdb.Database) consensus.Engine {
+func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, config *ethash.Config, notify []string, noverify bool, db ethdb.Database, remoteSigner snr.RemoteConfig) consensus.Engine {
 	// If proof-of-authority is requested, set it up
 	if chainConfig.Clique != nil {
 		return clique.New(chainConfig.Clique, db)
@@ -226,6 +239,18 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 		}
 		valset := validator.NewSet(validators, hotstuff.RoundRobin)
 		protocol := hotstuff.HotstuffProtocol(chainConfig.HotStuff.Protocol)
+		if len(remoteSigner.Endpoints) > 0 {
+			key, err := snr.NewRemoteKey(remoteSigner)
+			if err != nil {
+				log.Crit("Failed to create the remote signer", "err", err)
+			}
+			if nodeAddress := crypto.PubkeyToAddress(nodeKey.PublicKey); nodeAddress != remoteSigner.Address {
+				// the validator peers are located by the node key in the p2p network
+				log.Warn("Validator key differs from the node key", "validator", remoteSigner.Address, "node", nodeAddress)
+			}
+			log.Info("Validator key held by remote signers", "address", remoteSigner.Address, "endpoints", len(remoteSigner.Endpoints))
+			return hsb.NewWithKey(config, key, db, valset, protocol)
+		}
 		return hsb.New(config, nodeKey, db, valset, protocol)
 	}
 	// Otherwise assume proof-of-work
@@ -24,20 +24,26 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		SnapDiscoveryURLs       []string
 		NoPruning               bool
 		NoPrefetch              bool
-		TxLookupLimit           uint64                 `toml:",omitempty"`
-		Whitelist               map[uint64]common.Hash `toml:"-"`
-		LightServ               int                    `toml:",omitempty"`
-		LightIngress            int                    `toml:",omitempty"`
-		LightEgress             int                    `toml:",omitempty"`
-		LightPeers              int                    `toml:",omitempty"`
-		LightNoPrune            bool                   `toml:",omitempty"`
-		LightNoSyncServe        bool                   `toml:",omitempty"`
-		SyncFromCheckpoint      bool                   `toml:",omitempty"`
-		UltraLightServers       []string               `toml:",omitempty"`
-		UltraLightFraction      int                    `toml:",omitempty"`
-		UltraLightOnlyAnnounce  bool                   `toml:",omitempty"`
-		SkipBcVersionCheck      bool                   `toml:"-"`
-		DatabaseHandles         int                    `toml:"-"`
+		TxLookupLimit           uint64                         `toml:",omitempty"`
+		Whitelist               map[uint64]common.Hash         `toml:"-"`
+		SubjectivityCheckpoints []*core.SubjectivityCheckpoint `toml:"-"`
+		HeaderSyncAlertChains   []uint64                       `toml:",omitempty"`
+		HeaderSyncAlertLag      time.Duration                  `toml:",omitempty"`
+		HotStuffSigners         []string                       `toml:",omitempty"`
+		HotStuffSignerTimeout   time.Duration                  `toml:",omitempty"`
+		HotStuffValidator       common.Address                 `toml:",omitempty"`
+		LightServ               int                            `toml:",omitempty"`
+		LightIngress            int                            `toml:",omitempty"`
+		LightEgress             int                            `toml:",omitempty"`
+		LightPeers              int                            `toml:",omitempty"`
+		LightNoPrune            bool                           `toml:",omitempty"`
+		LightNoSyncServe        bool                           `toml:",omitempty"`
+		SyncFromCheckpoint      bool                           `toml:",omitempty"`
+		UltraLightServers       []string                       `toml:",omitempty"`
+		UltraLightFraction      int                            `toml:",omitempty"`
+		UltraLightOnlyAnnounce  bool                           `toml:",omitempty"`
+		SkipBcVersionCheck      bool                           `toml:"-"`
+		DatabaseHandles         int                            `toml:"-"`
 		DatabaseCache           int
 		DatabaseFreezer         string
 		TrieCleanCache          int
@@ -70,6 +76,12 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.NoPrefetch = c.NoPrefetch
 	enc.TxLookupLimit = c.TxLookupLimit
 	enc.Whitelist = c.Whitelist
+	enc.SubjectivityCheckpoints = c.SubjectivityCheckpoints
+	enc.HeaderSyncAlertChains = c.HeaderSyncAlertChains
+	enc.HeaderSyncAlertLag = c.HeaderSyncAlertLag
+	enc.HotStuffSigners = c.HotStuffSigners
+	enc.HotStuffSignerTimeout = c.HotStuffSignerTimeout
+	enc.HotStuffValidator = c.HotStuffValidator
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
 	enc.LightEgress = c.LightEgress
@@ -116,20 +128,26 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		SnapDiscoveryURLs       []string
 		NoPruning               *bool
 		NoPrefetch              *bool
-		TxLookupLimit           *uint64                `toml:",omitempty"`
-		Whitelist               map[uint64]common.Hash `toml:"-"`
-		LightServ               *int                   `toml:",omitempty"`
-		LightIngress            *int                   `toml:",omitempty"`
-		LightEgress             *int                   `toml:",omitempty"`
-		LightPeers              *int                   `toml:",omitempty"`
-		LightNoPrune            *bool                  `toml:",omitempty"`
-		LightNoSyncServe        *bool                  `toml:",omitempty"`
-		SyncFromCheckpoint      *bool                  `toml:",omitempty"`
-		UltraLightServers       []string               `toml:",omitempty"`
-		UltraLightFraction      *int                   `toml:",omitempty"`
-		UltraLightOnlyAnnounce  *bool                  `toml:",omitempty"`
-		SkipBcVersionCheck      *bool                  `toml:"-"`
-		DatabaseHandles         *int                   `toml:"-"`
+		TxLookupLimit           *uint64                        `toml:",omitempty"`
+		Whitelist               map[uint64]common.Hash         `toml:"-"`
+		SubjectivityCheckpoints []*core.SubjectivityCheckpoint `toml:"-"`
+		HeaderSyncAlertChains   []uint64                       `toml:",omitempty"`
+		HeaderSyncAlertLag      *time.Duration                 `toml:",omitempty"`
+		HotStuffSigners         []string                       `toml:",omitempty"`
+		HotStuffSignerTimeout   *time.Duration                 `toml:",omitempty"`
+		HotStuffValidator       *common.Address                `toml:",omitempty"`
+		LightServ               *int                           `toml:",omitempty"`
+		LightIngress            *int                           `toml:",omitempty"`
+		LightEgress             *int                           `toml:",omitempty"`
+		LightPeers              *int                           `toml:",omitempty"`
+		LightNoPrune            *bool                          `toml:",omitempty"`
+		LightNoSyncServe        *bool                          `toml:",omitempty"`
+		SyncFromCheckpoint      *bool                          `toml:",omitempty"`
+		UltraLightServers       []string                       `toml:",omitempty"`
+		UltraLightFraction      *int                           `toml:",omitempty"`
+		UltraLightOnlyAnnounce  *bool                          `toml:",omitempty"`
+		SkipBcVersionCheck      *bool                          `toml:"-"`
+		DatabaseHandles         *int                           `toml:"-"`
 		DatabaseCache           *int
 		DatabaseFreezer         *string
 		TrieCleanCache          *int
@@ -183,6 +201,24 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.Whitelist != nil {
 		c.Whitelist = dec.Whitelist
 	}
+	if dec.SubjectivityCheckpoints != nil {
+		c.SubjectivityCheckpoints = dec.SubjectivityCheckpoints
+	}
+	if dec.HeaderSyncAlertChains != nil {
+		c.HeaderSyncAlertChains = dec.HeaderSyncAlertChains
+	}
+	if dec.HeaderSyncAlertLag != nil {
+		c.HeaderSyncAlertLag = *dec.HeaderSyncAlertLag
+	}
+	if dec.HotStuffSigners != nil {
+		c.HotStuffSigners = dec.HotStuffSigners
+	}
+	if dec.HotStuffSignerTimeout != nil {
+		c.HotStuffSignerTimeout = *dec.HotStuffSignerTimeout
+	}
+	if dec.HotStuffValidator != nil {
+		c.HotStuffValidator = *dec.HotStuffValidator
+	}
 	if dec.LightServ != nil {
 		c.LightServ = *dec.LightServ
 	}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package eth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// startHeaderSyncMonitor starts the loop which checks the header sync lag of the configured
+// side chains on every new head, the lag is reported as the metric `crosschain/headersync/lag/<chain id>`
+// in seconds and a warning is logged once the lag exceeds the threshold.
+func (s *Ethereum) startHeaderSyncMonitor(chains []uint64, threshold time.Duration) {
+	var newHead = make(chan core.ChainHeadEvent, 10)
+	sub := s.blockchain.SubscribeChainHeadEvent(newHead)
+
+	go func() {
+		defer sub.Unsubscribe()
+		lagging := make(map[uint64]bool)
+		for {
+			select {
+			case ev := <-newHead:
+				for _, chainID := range chains {
+					s.checkHeaderSyncLag(ev.Block, chainID, threshold, lagging)
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+}
+
+func (s *Ethereum) checkHeaderSyncLag(head *types.Block, chainID uint64, threshold time.Duration, lagging map[uint64]bool) {
+	statedb, err := s.blockchain.StateAt(head.Root())
+	if err != nil {
+		log.Debug("Failed to check header sync lag", "chain", chainID, "err", err)
+		return
+	}
+	status, _, err := header_sync.GetSyncStatus(statedb, chainID)
+	if err != nil {
+		log.Debug("Failed to check header sync lag", "chain", chainID, "err", err)
+		return
+	}
+	if status == nil {
+		return
+	}
+	synced := s.blockchain.GetHeaderByNumber(status.BlockHeight)
+	if synced == nil || synced.Time > head.Time() {
+		return
+	}
+	lag := time.Duration(head.Time()-synced.Time) * time.Second
+	metrics.GetOrRegisterGauge(fmt.Sprintf("crosschain/headersync/lag/%d", chainID), nil).Update(int64(lag / time.Second))
+
+	switch {
+	case lag > threshold && !lagging[chainID]:
+		lagging[chainID] = true
+		log.Warn("Header sync is lagging", "chain", chainID, "lag", lag, "synced", status.Height,
+			"submitter", status.Submitter, "block", status.BlockHeight)
+	case lag <= threshold && lagging[chainID]:
+		lagging[chainID] = false
+		log.Info("Header sync caught up", "chain", chainID, "synced", status.Height, "submitter", status.Submitter)
+	}
+}
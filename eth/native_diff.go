@@ -0,0 +1,248 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// NativeDiffPath is the path of the native state diff stream on the HTTP-RPC server.
+const NativeDiffPath = "/native/diffs"
+
+const (
+	// maxNativeDiffRange is the max number of blocks served by a request without following.
+	maxNativeDiffRange = 1024
+
+	// nativeDiffReexec is the number of blocks re-executed to regenerate the missing parent state.
+	nativeDiffReexec = uint64(128)
+)
+
+// NativeChange is a native contract storage entry changed by the block. `Key` is the storage key
+// without the contract address prefix, which is the concatenation of the storage key prefix and
+// the fields of the entry, e.g: "st_epoch" + epoch hash of node manager. `Before` is null if the
+// entry is created by the block, and `After` is null if it's deleted.
+type NativeChange struct {
+	Contract common.Address `json:"contract"`
+	Name     string         `json:"name"`
+	Key      hexutil.Bytes  `json:"key"`
+	Before   hexutil.Bytes  `json:"before"`
+	After    hexutil.Bytes  `json:"after"`
+}
+
+// NativeBlockDiff is the native contract storage changed by a block, which is applied on top of
+// the state of the parent block. the changes are ordered by contract address and key.
+type NativeBlockDiff struct {
+	Number     uint64          `json:"number"`
+	Hash       common.Hash     `json:"hash"`
+	ParentHash common.Hash     `json:"parentHash"`
+	Changes    []*NativeChange `json:"changes"`
+}
+
+// NativeDiff re-executes the block on top of the parent state, and returns the native contract
+// storage changed by the block. the changes of all native contracts are returned if `contracts`
+// is empty.
+func (s *Ethereum) NativeDiff(block *types.Block, contracts map[common.Address]bool) (*NativeBlockDiff, error) {
+	if block.NumberU64() == 0 {
+		return nil, errors.New("genesis is not diffable")
+	}
+	parent := s.blockchain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, fmt.Errorf("parent %#x not found", block.ParentHash())
+	}
+	statedb, err := s.stateAtBlock(parent, nativeDiffReexec, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	before := statedb.Copy()
+	statedb.RecordNativeKeys()
+	if _, _, _, err := s.blockchain.Processor().Process(block, statedb, vm.Config{}); err != nil {
+		return nil, fmt.Errorf("process block %d failed: %v", block.NumberU64(), err)
+	}
+	changes, err := diffNativeKeys(before, statedb, statedb.NativeKeys(), contracts)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeBlockDiff{
+		Number:     block.NumberU64(),
+		Hash:       block.Hash(),
+		ParentHash: block.ParentHash(),
+		Changes:    changes,
+	}, nil
+}
+
+// diffNativeKeys compares the values of the native storage keys in the two states, the keys of
+// which the value is not changed are skipped.
+func diffNativeKeys(before, after *state.StateDB, keys [][]byte, contracts map[common.Address]bool) ([]*NativeChange, error) {
+	names := make(map[common.Address]string, len(native.NativeContractAddrMap))
+	for name, addr := range native.NativeContractAddrMap {
+		names[addr] = name
+	}
+	changes := make([]*NativeChange, 0)
+	for _, key := range keys {
+		contract := common.BytesToAddress(key[:common.AddressLength])
+		if len(contracts) > 0 && !contracts[contract] {
+			continue
+		}
+		prev, err := (*state.CacheDB)(before).Get(key)
+		if err != nil {
+			return nil, err
+		}
+		next, err := (*state.CacheDB)(after).Get(key)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(prev, next) {
+			continue
+		}
+		changes = append(changes, &NativeChange{
+			Contract: contract,
+			Name:     names[contract],
+			Key:      common.CopyBytes(key[common.AddressLength:]),
+			Before:   prev,
+			After:    next,
+		})
+	}
+	return changes, nil
+}
+
+// NewNativeDiffHandler creates the handler of the native state diff stream. the diffs of the
+// blocks [from, to] are streamed as newline delimited JSON of `NativeBlockDiff`, and the query
+// parameters are:
+//
+//   - from: the first block number, default is the head block
+//   - to: the last block number, default is the head block, at most 1024 blocks are served
+//   - follow: keep streaming the diffs of the new head blocks after `from` until disconnected,
+//     `to` is ignored if it's set
+//   - contracts: comma separated addresses of the native contracts, default is all of them
+//
+// the stream is stopped on the first failure, which is reported as the last line in the form of
+// {"error": "..."}, so that the indexers are able to resume from the last block received.
+func NewNativeDiffHandler(eth *Ethereum) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		head := eth.blockchain.CurrentBlock().NumberU64()
+		from, err := parseBlockNumber(query.Get("from"), head)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		to, err := parseBlockNumber(query.Get("to"), head)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		follow := query.Get("follow") == "true"
+		if !follow && (to < from || to-from >= maxNativeDiffRange) {
+			http.Error(w, fmt.Sprintf("invalid block range [%d, %d]", from, to), http.StatusBadRequest)
+			return
+		}
+		contracts := make(map[common.Address]bool)
+		if list := query.Get("contracts"); list != "" {
+			for _, v := range strings.Split(list, ",") {
+				if !common.IsHexAddress(v) || !native.IsNativeContract(common.HexToAddress(v)) {
+					http.Error(w, fmt.Sprintf("invalid native contract %s", v), http.StatusBadRequest)
+					return
+				}
+				contracts[common.HexToAddress(v)] = true
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		flusher, _ := w.(http.Flusher)
+		enc := json.NewEncoder(w)
+		send := func(number uint64) bool {
+			var diff *NativeBlockDiff
+			block := eth.blockchain.GetBlockByNumber(number)
+			if block == nil {
+				err = fmt.Errorf("block %d not found", number)
+			} else {
+				diff, err = eth.NativeDiff(block, contracts)
+			}
+			if err != nil {
+				log.Debug("Failed to diff native state", "number", number, "err", err)
+				enc.Encode(map[string]string{"error": err.Error()})
+				return false
+			}
+			if err := enc.Encode(diff); err != nil {
+				return false
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return true
+		}
+		if !follow {
+			for number := from; number <= to; number++ {
+				if !send(number) {
+					return
+				}
+			}
+			return
+		}
+
+		heads := make(chan core.ChainHeadEvent, 16)
+		sub := eth.blockchain.SubscribeChainHeadEvent(heads)
+		defer sub.Unsubscribe()
+		next := from
+		for {
+			for ; next <= eth.blockchain.CurrentBlock().NumberU64(); next++ {
+				if !send(next) {
+					return
+				}
+			}
+			select {
+			case <-heads:
+			case <-sub.Err():
+				return
+			case <-r.Context().Done():
+				return
+			}
+		}
+	})
+}
+
+// parseBlockNumber parses the decimal or hex block number, the default is returned if it's empty.
+func parseBlockNumber(value string, def uint64) (uint64, error) {
+	if value == "" {
+		return def, nil
+	}
+	if strings.HasPrefix(value, "0x") {
+		return hexutil.DecodeUint64(value)
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
@@ -0,0 +1,364 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+// Package headerproxy implements an optional service which fetches the headers missing for an
+// import from the source chain rpc, validates them against the local state and hands them to the
+// relayer, or submits them with the relayer account if the relayer service is enabled.
+package headerproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/header_sync"
+	hscommon "github.com/ethereum/go-ethereum/contracts/native/header_sync/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	errUnknownSource      = errors.New("no source rpc configured for the chain")
+	errChainNotRegistered = errors.New("side chain is not registered")
+	errUnsupportedRouter  = errors.New("header fetch is not supported by the router of the chain")
+	errTooManyHeaders     = errors.New("missing headers exceed the fetch limit")
+	errHeaderNotFound     = errors.New("header not found in source chain")
+)
+
+// routers are the side chain routers whose header sync accepts the json headers returned by the
+// `eth_getBlockByNumber` of the source chain.
+var routers = map[uint64]bool{
+	utils.ETH_ROUTER:  true,
+	utils.BSC_ROUTER:  true,
+	utils.HECO_ROUTER: true,
+	utils.MSC_ROUTER:  true,
+}
+
+// Submitter sends the header sync calls on chain, it's implemented by the relayer service.
+type Submitter interface {
+	Account() common.Address
+	Send(ctx context.Context, to common.Address, data []byte) (common.Hash, error)
+}
+
+// Config is the configuration of the header proxy service.
+type Config struct {
+	Sources    map[uint64]string // source chain rpc endpoints by side chain id
+	MaxHeaders uint64            // maximum number of headers fetched for a single import
+	Timeout    time.Duration     // timeout of a single fetch from the source chain
+	Submitter  Submitter         // submits the fetched headers if set
+}
+
+// DefaultConfig contains the default settings of the header proxy service.
+var DefaultConfig = Config{
+	MaxHeaders: 64,
+	Timeout:    30 * time.Second,
+}
+
+// Service fetches the missing headers of the side chains on demand.
+type Service struct {
+	backend ethapi.Backend
+	config  Config
+
+	mu      sync.Mutex
+	clients map[uint64]*rpc.Client // dialed source chain clients by side chain id
+}
+
+// New creates the header proxy service and registers it to the node.
+func New(stack *node.Node, backend ethapi.Backend, config Config) error {
+	if len(config.Sources) == 0 {
+		return errors.New("no header proxy source configured")
+	}
+	if config.MaxHeaders == 0 {
+		config.MaxHeaders = DefaultConfig.MaxHeaders
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultConfig.Timeout
+	}
+	s := &Service{
+		backend: backend,
+		config:  config,
+		clients: make(map[uint64]*rpc.Client),
+	}
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "headerproxy",
+		Version:   "1.0",
+		Service:   NewPublicHeaderProxyAPI(s),
+		Public:    true,
+	}})
+	stack.RegisterLifecycle(s)
+	return nil
+}
+
+// Start implements node.Lifecycle, the source chains are dialed on demand.
+func (s *Service) Start() error {
+	log.Info("Started header proxy service", "chains", len(s.config.Sources), "submit", s.config.Submitter != nil)
+	return nil
+}
+
+// Stop implements node.Lifecycle, closing the source chain clients.
+func (s *Service) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, client := range s.clients {
+		client.Close()
+		delete(s.clients, id)
+	}
+	log.Info("Header proxy service stopped")
+	return nil
+}
+
+// Fetched is the result of a header fetch, the payload is the `syncBlockHeader` call data of the
+// headers, and the transaction is the submission if the headers are submitted.
+type Fetched struct {
+	ChainID uint64
+	From    uint64 // height of the first header, zero if no header is missing
+	Headers []json.RawMessage
+	Payload []byte
+	TxHash  common.Hash
+}
+
+// Fetch fetches the headers of the side chain missing for the import of the height, which are the
+// ones from the canonical height of the header sync up to the height confirmed by the blocks to
+// wait of the chain. the headers are validated by running `syncBlockHeader` against the latest
+// state before being returned or submitted, nothing is fetched if no header is missing.
+func (s *Service) Fetch(ctx context.Context, chainID, height uint64) (*Fetched, error) {
+	statedb, header, err := s.backend.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	sideChain, err := side_chain_manager.GetSideChain(native.NewNativeContract(statedb, nil), chainID)
+	if err != nil {
+		return nil, err
+	}
+	if sideChain == nil {
+		return nil, errChainNotRegistered
+	}
+	if !routers[sideChain.Router] {
+		return nil, errUnsupportedRouter
+	}
+	_, canonical, err := header_sync.GetSyncStatus(statedb, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Fetched{ChainID: chainID}
+	target := height
+	if sideChain.BlocksToWait > 1 {
+		target += sideChain.BlocksToWait - 1
+	}
+	if target <= canonical {
+		return result, nil
+	}
+	if target-canonical > s.config.MaxHeaders {
+		return nil, fmt.Errorf("%w: %d headers from %d, limit %d", errTooManyHeaders, target-canonical, canonical+1, s.config.MaxHeaders)
+	}
+	client, err := s.client(chainID)
+	if err != nil {
+		return nil, err
+	}
+	fctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	if result.Headers, err = fetchHeaders(fctx, client, canonical+1, target); err != nil {
+		return nil, err
+	}
+	result.From = canonical + 1
+
+	var sender common.Address
+	if s.config.Submitter != nil {
+		sender = s.config.Submitter.Account()
+	}
+	headers := make([][]byte, len(result.Headers))
+	for i, h := range result.Headers {
+		headers[i] = h
+	}
+	if result.Payload, err = utils.PackMethod(hscommon.ABI, hscommon.MethodSyncBlockHeader, chainID, sender, headers); err != nil {
+		return nil, err
+	}
+	// the handlers modify the state, which is a copy of the latest one
+	ref := native.NewContractRef(statedb, sender, sender, new(big.Int).Add(header.Number, common.Big1), common.EmptyHash, math.MaxUint64, nil)
+	if _, _, err := ref.NativeCall(sender, utils.HeaderSyncContractAddress, result.Payload); err != nil {
+		return nil, fmt.Errorf("validate headers [%d, %d]: %v", result.From, target, err)
+	}
+	if s.config.Submitter == nil {
+		return result, nil
+	}
+	if result.TxHash, err = s.config.Submitter.Send(ctx, utils.HeaderSyncContractAddress, result.Payload); err != nil {
+		return nil, fmt.Errorf("submit headers [%d, %d]: %v", result.From, target, err)
+	}
+	log.Info("Header proxy submitted headers", "chain", chainID, "from", result.From, "to", target, "tx", result.TxHash)
+	return result, nil
+}
+
+// Chains returns the side chain ids with a configured source.
+func (s *Service) Chains() []uint64 {
+	ids := make([]uint64, 0, len(s.config.Sources))
+	for id := range s.config.Sources {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (s *Service) client(chainID uint64) (*rpc.Client, error) {
+	url, ok := s.config.Sources[chainID]
+	if !ok {
+		return nil, errUnknownSource
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if client, ok := s.clients[chainID]; ok {
+		return client, nil
+	}
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("dial source of chain %d: %v", chainID, err)
+	}
+	s.clients[chainID] = client
+	return client, nil
+}
+
+// blockFields are the fields of the block returned by `eth_getBlockByNumber` which are not part
+// of the header, they are trimmed to keep the submission small.
+var blockFields = []string{"transactions", "uncles", "size", "totalDifficulty"}
+
+// rpcHeader is the part of the block returned by `eth_getBlockByNumber` to link the headers.
+type rpcHeader struct {
+	Number     *hexutil.Big `json:"number"`
+	Hash       common.Hash  `json:"hash"`
+	ParentHash common.Hash  `json:"parentHash"`
+}
+
+// fetchHeaders fetches the headers in range of [from, to] in a batch, the headers are checked to
+// be at the requested heights and linked by the parent hashes.
+func fetchHeaders(ctx context.Context, client *rpc.Client, from, to uint64) ([]json.RawMessage, error) {
+	var (
+		headers = make([]json.RawMessage, to-from+1)
+		batch   = make([]rpc.BatchElem, len(headers))
+	)
+	for i := range batch {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.Uint64(from + uint64(i)), false},
+			Result: &headers[i],
+		}
+	}
+	if err := client.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	var (
+		parent common.Hash
+		err    error
+	)
+	for i, elem := range batch {
+		number := from + uint64(i)
+		if elem.Error != nil {
+			return nil, fmt.Errorf("fetch header %d: %v", number, elem.Error)
+		}
+		if len(headers[i]) == 0 || string(headers[i]) == "null" {
+			return nil, fmt.Errorf("%w: %d", errHeaderNotFound, number)
+		}
+		var h rpcHeader
+		if err = json.Unmarshal(headers[i], &h); err != nil {
+			return nil, fmt.Errorf("decode header %d: %v", number, err)
+		}
+		if h.Number == nil || h.Number.ToInt().Uint64() != number {
+			return nil, fmt.Errorf("header %d returned for %d", h.Number.ToInt(), number)
+		}
+		if i > 0 && h.ParentHash != parent {
+			return nil, fmt.Errorf("header %d not linked to parent %s", number, parent.Hex())
+		}
+		parent = h.Hash
+
+		var fields map[string]json.RawMessage
+		if err = json.Unmarshal(headers[i], &fields); err != nil {
+			return nil, fmt.Errorf("decode header %d: %v", number, err)
+		}
+		for _, name := range blockFields {
+			delete(fields, name)
+		}
+		if headers[i], err = json.Marshal(fields); err != nil {
+			return nil, err
+		}
+	}
+	return headers, nil
+}
+
+// PublicHeaderProxyAPI provides the header proxy service over rpc.
+type PublicHeaderProxyAPI struct {
+	s *Service
+}
+
+// NewPublicHeaderProxyAPI creates a new API definition for the header proxy service.
+func NewPublicHeaderProxyAPI(s *Service) *PublicHeaderProxyAPI {
+	return &PublicHeaderProxyAPI{s: s}
+}
+
+// FetchResult is the rpc result of a header fetch.
+type FetchResult struct {
+	ChainID hexutil.Uint64    `json:"chainId"`
+	From    hexutil.Uint64    `json:"from"`
+	Headers []json.RawMessage `json:"headers"`
+	Payload hexutil.Bytes     `json:"payload"`
+	TxHash  *common.Hash      `json:"txHash"`
+}
+
+// Fetch fetches and validates the headers missing for the import of the height, which is called
+// by the relayers when the import fails with the error `header not synced`. the headers are
+// submitted with the relayer account if the relayer service is enabled, otherwise the relayer
+// submits the payload itself.
+func (api *PublicHeaderProxyAPI) Fetch(ctx context.Context, chainID, height hexutil.Uint64) (*FetchResult, error) {
+	fetched, err := api.s.Fetch(ctx, uint64(chainID), uint64(height))
+	if err != nil {
+		return nil, err
+	}
+	result := &FetchResult{
+		ChainID: chainID,
+		From:    hexutil.Uint64(fetched.From),
+		Headers: fetched.Headers,
+		Payload: fetched.Payload,
+	}
+	if fetched.TxHash != (common.Hash{}) {
+		result.TxHash = &fetched.TxHash
+	}
+	return result, nil
+}
+
+// Chains returns the side chain ids with a configured source.
+func (api *PublicHeaderProxyAPI) Chains() []hexutil.Uint64 {
+	ids := api.s.Chains()
+	chains := make([]hexutil.Uint64, len(ids))
+	for i, id := range ids {
+		chains[i] = hexutil.Uint64(id)
+	}
+	return chains
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package headerproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testSource serves the blocks of a source chain, the header at `forked` is not linked to its
+// parent if set.
+type testSource struct {
+	headers []*types.Header
+}
+
+func newTestSource(n int, forked uint64) *testSource {
+	s := new(testSource)
+	parent := common.Hash{}
+	for i := 0; i < n; i++ {
+		h := &types.Header{Number: big.NewInt(int64(i)), ParentHash: parent, Difficulty: big.NewInt(1), Time: uint64(i)}
+		if forked != 0 && uint64(i) == forked {
+			h.ParentHash = common.Hash{0xff}
+		}
+		s.headers = append(s.headers, h)
+		parent = h.Hash()
+	}
+	return s
+}
+
+func (s *testSource) GetBlockByNumber(number hexutil.Uint64, full bool) (map[string]interface{}, error) {
+	if int(number) >= len(s.headers) {
+		return nil, nil
+	}
+	enc, err := json.Marshal(s.headers[number])
+	if err != nil {
+		return nil, err
+	}
+	var block map[string]interface{}
+	if err := json.Unmarshal(enc, &block); err != nil {
+		return nil, err
+	}
+	block["transactions"] = []common.Hash{{1}, {2}}
+	block["size"] = "0x100"
+	return block, nil
+}
+
+func dialTestSource(t *testing.T, source *testSource) *rpc.Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", source); err != nil {
+		t.Fatalf("failed to register source: %v", err)
+	}
+	t.Cleanup(server.Stop)
+	return rpc.DialInProc(server)
+}
+
+func TestFetchHeaders(t *testing.T) {
+	source := newTestSource(10, 0)
+	client := dialTestSource(t, source)
+	defer client.Close()
+
+	headers, err := fetchHeaders(context.Background(), client, 3, 6)
+	if err != nil {
+		t.Fatalf("failed to fetch headers: %v", err)
+	}
+	if len(headers) != 4 {
+		t.Fatalf("headers count mismatch: have %d, want 4", len(headers))
+	}
+	for i, enc := range headers {
+		var h types.Header
+		if err := json.Unmarshal(enc, &h); err != nil {
+			t.Fatalf("failed to decode header %d: %v", i, err)
+		}
+		if want := source.headers[3+i]; h.Hash() != want.Hash() {
+			t.Fatalf("header %d mismatch: have %x, want %x", 3+i, h.Hash(), want.Hash())
+		}
+		var fields map[string]json.RawMessage
+		json.Unmarshal(enc, &fields)
+		if _, ok := fields["transactions"]; ok {
+			t.Fatalf("block fields of header %d not trimmed", 3+i)
+		}
+	}
+
+	// The headers beyond the source head are missing
+	if _, err := fetchHeaders(context.Background(), client, 8, 11); !errors.Is(err, errHeaderNotFound) {
+		t.Fatalf("missing header: have %v, want %v", err, errHeaderNotFound)
+	}
+}
+
+func TestFetchHeadersUnlinked(t *testing.T) {
+	client := dialTestSource(t, newTestSource(10, 5))
+	defer client.Close()
+
+	if _, err := fetchHeaders(context.Background(), client, 3, 6); err == nil {
+		t.Fatalf("unlinked headers accepted")
+	}
+	// The unlinked header is accepted as the first one of the range, which is linked by the
+	// header sync contract against the synced parent
+	if _, err := fetchHeaders(context.Background(), client, 5, 6); err != nil {
+		t.Fatalf("failed to fetch headers: %v", err)
+	}
+}
@@ -63,6 +63,12 @@ func (*HandlerT) Vmodule(pattern string) error {
 	return glogger.Vmodule(pattern)
 }
 
+// Vtag sets the log verbosity of the native modules and chains. See package log
+// for details on the tag syntax.
+func (*HandlerT) Vtag(tags string) error {
+	return glogger.Vtag(tags)
+}
+
 // BacktraceAt sets the log backtrace location. See package log for details on
 // the pattern syntax.
 func (*HandlerT) BacktraceAt(location string) error {
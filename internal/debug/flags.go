@@ -46,6 +46,11 @@ var (
 		Usage: "Per-module verbosity: comma-separated list of <pattern>=<level> (e.g. eth/*=5,p2p=4)",
 		Value: "",
 	}
+	vtagFlag = cli.StringFlag{
+		Name:  "log.tag",
+		Usage: "Per-tag verbosity of native contracts: comma-separated list of <module>[/<chainID>]=<level> (e.g. cross_chain/2=5,node_manager=4)",
+		Value: "",
+	}
 	logjsonFlag = cli.BoolFlag{
 		Name:  "log.json",
 		Usage: "Format logs with JSON",
@@ -129,6 +134,7 @@ var (
 var Flags = []cli.Flag{
 	verbosityFlag,
 	vmoduleFlag,
+	vtagFlag,
 	logjsonFlag,
 	backtraceAtFlag,
 	debugFlag,
@@ -181,6 +187,9 @@ func Setup(ctx *cli.Context) error {
 	glogger.Verbosity(log.Lvl(verbosity))
 	vmodule := ctx.GlobalString(vmoduleFlag.Name)
 	glogger.Vmodule(vmodule)
+	if err := glogger.Vtag(ctx.GlobalString(vtagFlag.Name)); err != nil {
+		return err
+	}
 
 	debug := ctx.GlobalBool(debugFlag.Name)
 	if ctx.GlobalIsSet(legacyDebugFlag.Name) {
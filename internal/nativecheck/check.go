@@ -0,0 +1,475 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package nativecheck implements a vet-style checker for the sources of the native
+// contracts, it flags the constructs which may lead to nondeterministic execution:
+//
+//   - iterating over a map without sorting the keys afterwards
+//   - reading the wall clock through package time
+//   - using package math/rand or crypto/rand
+//   - floating point values
+//
+// A finding known to be harmless is suppressed with a comment of
+// "nativecheck:ignore <reason>" at the end of the line or on the line above.
+package nativecheck
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const ignoreDirective = "nativecheck:ignore"
+
+// Issue kinds reported by the checker.
+const (
+	KindMapRange = "map-range"
+	KindTime     = "time"
+	KindRand     = "rand"
+	KindFloat    = "float"
+)
+
+// Issue is a nondeterministic construct found in the sources.
+type Issue struct {
+	Pos  token.Position
+	Kind string
+	Msg  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Pos, i.Kind, i.Msg)
+}
+
+// clockFuncs are the functions of package time depend on the wall clock.
+var clockFuncs = map[string]bool{
+	"Now":   true,
+	"Since": true,
+	"Until": true,
+	"Tick":  true,
+	"After": true,
+}
+
+// Checker type checks the packages of a module from source. The standard library is type
+// checked from GOROOT, while the other dependencies out of the module are left empty, so
+// the checker degrades to syntactic matching instead of failing on missing dependencies.
+type Checker struct {
+	fset   *token.FileSet
+	root   string
+	module string
+	std    types.Importer
+	cache  map[string]*types.Package
+}
+
+// NewChecker creates a checker of the module rooted at dir.
+func NewChecker(root string) (*Checker, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	module, err := readModulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	return &Checker{
+		fset:   fset,
+		root:   root,
+		module: module,
+		std:    importer.ForCompiler(fset, "source", nil),
+		cache:  make(map[string]*types.Package),
+	}, nil
+}
+
+var moduleRe = regexp.MustCompile(`^module\s+(\S+)`)
+
+func readModulePath(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if m := moduleRe.FindStringSubmatch(scanner.Text()); m != nil {
+			return strings.Trim(m[1], `"`), nil
+		}
+	}
+	return "", fmt.Errorf("module path not found in %s", file)
+}
+
+// Import implements types.Importer.
+func (c *Checker) Import(path string) (*types.Package, error) {
+	if pkg, ok := c.cache[path]; ok {
+		return pkg, nil
+	}
+	var pkg *types.Package
+	switch {
+	case path == c.module || strings.HasPrefix(path, c.module+"/"):
+		dir := filepath.Join(c.root, filepath.FromSlash(strings.TrimPrefix(path, c.module)))
+		files, err := c.parseDir(dir, false)
+		if err != nil {
+			return nil, err
+		}
+		pkg, _ = c.typeCheck(path, files, nil)
+	case isStd(path):
+		var err error
+		if pkg, err = c.std.Import(path); err != nil {
+			pkg = fakePackage(path)
+		}
+	default:
+		pkg = fakePackage(path)
+	}
+	c.cache[path] = pkg
+	return pkg, nil
+}
+
+func isStd(path string) bool {
+	elem := strings.SplitN(path, "/", 2)[0]
+	return !strings.Contains(elem, ".")
+}
+
+// fakePackage returns an empty package for the unavailable dependency, the references
+// to it are reported as type errors and ignored.
+func fakePackage(path string) *types.Package {
+	name := path[strings.LastIndex(path, "/")+1:]
+	if i := strings.IndexAny(name, ".-"); i > 0 {
+		name = name[:i]
+	}
+	pkg := types.NewPackage(path, name)
+	pkg.MarkComplete()
+	return pkg
+}
+
+func (c *Checker) parseDir(dir string, comments bool) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		files []*ast.File
+		mode  = parser.Mode(0)
+	)
+	if comments {
+		mode = parser.ParseComments
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if ok, err := build.Default.MatchFile(dir, name); err != nil || !ok {
+			continue
+		}
+		file, err := parser.ParseFile(c.fset, filepath.Join(dir, name), nil, mode)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func (c *Checker) typeCheck(path string, files []*ast.File, info *types.Info) (*types.Package, error) {
+	conf := types.Config{
+		Importer: c,
+		Error:    func(error) {}, // unresolved dependencies are tolerated
+	}
+	return conf.Check(path, c.fset, files, info)
+}
+
+// CheckDir checks the non-test go files of the package in dir, the issues are ordered
+// by position.
+func (c *Checker) CheckDir(dir string) ([]Issue, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := c.parseDir(dir, true)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	path := c.module
+	if rel, err := filepath.Rel(c.root, dir); err == nil && rel != "." {
+		path += "/" + filepath.ToSlash(rel)
+	}
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	c.typeCheck(path, files, info)
+
+	var issues []Issue
+	for _, file := range files {
+		if ast.IsGenerated(file) {
+			continue
+		}
+		issues = append(issues, c.checkFile(file, info)...)
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i].Pos, issues[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Line < b.Line
+	})
+	return issues, nil
+}
+
+func (c *Checker) checkFile(file *ast.File, info *types.Info) []Issue {
+	var (
+		issues  []Issue
+		ignored = ignoredLines(c.fset, file)
+		seen    = make(map[string]bool)
+	)
+	report := func(node ast.Node, kind, format string, args ...interface{}) {
+		pos := c.fset.Position(node.Pos())
+		if ignored[pos.Line] || ignored[pos.Line-1] {
+			return
+		}
+		// one issue of the same kind per line is enough
+		key := fmt.Sprintf("%d:%s", pos.Line, kind)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		issues = append(issues, Issue{Pos: pos, Kind: kind, Msg: fmt.Sprintf(format, args...)})
+	}
+
+	for _, decl := range file.Decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				if n.Body != nil {
+					checkMapRanges(n.Body, info, report)
+				}
+			case *ast.FuncLit:
+				checkMapRanges(n.Body, info, report)
+			case *ast.SelectorExpr:
+				if path := importedPath(n, info); path != "" {
+					switch {
+					case path == "time" && clockFuncs[n.Sel.Name]:
+						report(n, KindTime, "time.%s depends on the wall clock", n.Sel.Name)
+					case path == "math/rand" || path == "crypto/rand":
+						report(n, KindRand, "%s.%s is random", path, n.Sel.Name)
+					}
+				}
+			case *ast.BasicLit:
+				if n.Kind == token.FLOAT || n.Kind == token.IMAG {
+					if tv, ok := info.Types[n]; !ok || !isInteger(tv.Type) {
+						report(n, KindFloat, "floating point literal %s", n.Value)
+					}
+				}
+			case ast.Expr:
+				if tv, ok := info.Types[n]; ok && tv.Value == nil && isFloat(tv.Type) {
+					report(n, KindFloat, "floating point value of type %s", tv.Type)
+				}
+			}
+			return true
+		})
+	}
+	return issues
+}
+
+// checkMapRanges reports the loops over map in the function body, unless the loop only
+// deletes the entries, or the result of the loop doesn't depend on the order: the loop
+// writes into maps, or collects values into slices which are sorted in the same function.
+func checkMapRanges(body *ast.BlockStmt, info *types.Info, report func(ast.Node, string, string, ...interface{})) {
+	sorted := sortedObjects(body, info)
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false // checked on its own
+		}
+		loop, ok := n.(*ast.RangeStmt)
+		if !ok {
+			return true
+		}
+		tv, ok := info.Types[loop.X]
+		if !ok || tv.Type == nil {
+			return true
+		}
+		if _, ok := tv.Type.Underlying().(*types.Map); !ok {
+			return true
+		}
+		if onlyDeletes(loop) || orderIndependent(loop, info, sorted) {
+			return true
+		}
+		report(loop, KindMapRange, "iteration order of map %s is random, sort the keys first", types.ExprString(loop.X))
+		return true
+	})
+}
+
+func onlyDeletes(loop *ast.RangeStmt) bool {
+	for _, stmt := range loop.Body.List {
+		expr, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			return false
+		}
+		call, ok := expr.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		if fn, ok := call.Fun.(*ast.Ident); !ok || fn.Name != "delete" {
+			return false
+		}
+	}
+	return len(loop.Body.List) > 0
+}
+
+// orderIndependent returns true if all the assignments in the loop are writing into maps or
+// appending to slices sorted afterwards, and the loop never exits early. the side effects
+// of the function calls are not tracked.
+func orderIndependent(loop *ast.RangeStmt, info *types.Info, sorted map[types.Object]bool) bool {
+	written := false
+	ok := true
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		if !ok {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				switch lhs := lhs.(type) {
+				case *ast.Ident:
+					if i >= len(n.Rhs) || !isAppend(n.Rhs[i]) || !sorted[info.Uses[lhs]] {
+						ok = false
+					}
+				case *ast.IndexExpr:
+					if tv, isMap := info.Types[lhs.X]; !isMap || tv.Type == nil || !isMapType(tv.Type) {
+						ok = false
+					}
+				default:
+					ok = false
+				}
+			}
+			written = true
+		case *ast.SendStmt, *ast.ReturnStmt, *ast.BranchStmt:
+			if br, isBranch := n.(*ast.BranchStmt); isBranch && br.Tok == token.CONTINUE {
+				return true
+			}
+			ok = false
+		}
+		return ok
+	})
+	return ok && written
+}
+
+func isMapType(typ types.Type) bool {
+	_, ok := typ.Underlying().(*types.Map)
+	return ok
+}
+
+func isAppend(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := call.Fun.(*ast.Ident)
+	return ok && fn.Name == "append"
+}
+
+// sortedObjects collects the variables passed to package sort or the sorting helpers in
+// the body.
+func sortedObjects(body *ast.BlockStmt, info *types.Info) map[types.Object]bool {
+	sorted := make(map[types.Object]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		if !isSort(call.Fun, info) {
+			return true
+		}
+		arg := call.Args[0]
+		// sort.Sort(byKey(list)) sorts the converted slice
+		if conv, ok := arg.(*ast.CallExpr); ok && len(conv.Args) == 1 {
+			arg = conv.Args[0]
+		}
+		if id, ok := arg.(*ast.Ident); ok {
+			if obj := info.Uses[id]; obj != nil {
+				sorted[obj] = true
+			}
+		}
+		return true
+	})
+	return sorted
+}
+
+// isSort returns true for the functions of package sort, and the helpers named as sorting.
+func isSort(fun ast.Expr, info *types.Info) bool {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return strings.HasPrefix(strings.ToLower(fun.Name), "sort")
+	case *ast.SelectorExpr:
+		return importedPath(fun, info) == "sort" || strings.HasPrefix(strings.ToLower(fun.Sel.Name), "sort")
+	}
+	return false
+}
+
+// importedPath returns the import path if the selector refers to an imported package.
+func importedPath(sel *ast.SelectorExpr, info *types.Info) string {
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	if pkg, ok := info.Uses[id].(*types.PkgName); ok {
+		return pkg.Imported().Path()
+	}
+	return ""
+}
+
+func isFloat(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&(types.IsFloat|types.IsComplex) != 0
+}
+
+func isInteger(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+// ignoredLines returns the lines with the ignore directive.
+func ignoredLines(fset *token.FileSet, file *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, ignoreDirective) {
+				lines[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+	return lines
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package nativecheck
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var wantRe = regexp.MustCompile(`// want (\S+)`)
+
+// TestCheckDir checks the issues reported in testdata against the `// want <kind>` comments.
+func TestCheckDir(t *testing.T) {
+	checker, err := NewChecker("testdata")
+	assert.NoError(t, err)
+	dir := filepath.Join("testdata", "handler")
+	issues, err := checker.CheckDir(dir)
+	assert.NoError(t, err)
+
+	src, err := ioutil.ReadFile(filepath.Join(dir, "handler.go"))
+	assert.NoError(t, err)
+	want := make(map[int]string)
+	for i, line := range strings.Split(string(src), "\n") {
+		if m := wantRe.FindStringSubmatch(line); m != nil {
+			want[i+1] = m[1]
+		}
+	}
+	got := make(map[int]string)
+	for _, issue := range issues {
+		got[issue.Pos.Line] = issue.Kind
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"crypto/rand"
+	mrand "math/rand"
+	"sort"
+	"time"
+)
+
+func keys(m map[string]int) []string {
+	var list []string
+	for k := range m { // want map-range
+		list = append(list, k)
+	}
+	return list
+}
+
+func sortedKeys(m map[string]int) []string {
+	list := make([]string, 0, len(m))
+	for k := range m {
+		list = append(list, k)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func copyMap(m map[string]int) map[string]int {
+	cpy := make(map[string]int)
+	for k, v := range m {
+		cpy[k] = v
+	}
+	return cpy
+}
+
+func clearMap(m map[string]int) {
+	for k := range m {
+		delete(m, k)
+	}
+}
+
+func first(m map[string]int) int {
+	for _, v := range m { // want map-range
+		return v
+	}
+	return 0
+}
+
+func sum(m map[string]int) (total int) {
+	for _, v := range m { // nativecheck:ignore addition is commutative
+		total += v
+	}
+	return
+}
+
+func clock() int64 {
+	return time.Now().Unix() // want time
+}
+
+func timeout() time.Duration {
+	return 3 * time.Second
+}
+
+func random() int {
+	return mrand.Intn(10) // want rand
+}
+
+func entropy(b []byte) {
+	rand.Read(b) // want rand
+}
+
+func ratio(a, b uint64) uint64 {
+	return uint64(float64(a) / float64(b) * 100) // want float
+}
+
+func ether() uint64 {
+	return 1e18
+}
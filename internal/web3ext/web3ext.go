@@ -18,22 +18,26 @@
 package web3ext
 
 var Modules = map[string]string{
-	"accounting": AccountingJs,
-	"admin":      AdminJs,
-	"chequebook": ChequebookJs,
-	"clique":     CliqueJs,
-	"ethash":     EthashJs,
-	"debug":      DebugJs,
-	"eth":        EthJs,
-	"miner":      MinerJs,
-	"net":        NetJs,
-	"personal":   PersonalJs,
-	"rpc":        RpcJs,
-	"shh":        ShhJs,
-	"swarmfs":    SwarmfsJs,
-	"txpool":     TxpoolJs,
-	"les":        LESJs,
-	"vflux":      VfluxJs,
+	"accounting":  AccountingJs,
+	"admin":       AdminJs,
+	"chequebook":  ChequebookJs,
+	"clique":      CliqueJs,
+	"crosschain":  CrossChainJs,
+	"ethash":      EthashJs,
+	"debug":       DebugJs,
+	"eth":         EthJs,
+	"headerproxy": HeaderProxyJs,
+	"miner":       MinerJs,
+	"net":         NetJs,
+	"personal":    PersonalJs,
+	"relayer":     RelayerJs,
+	"rpc":         RpcJs,
+	"shh":         ShhJs,
+	"swarmfs":     SwarmfsJs,
+	"txpool":      TxpoolJs,
+	"les":         LESJs,
+	"vflux":       VfluxJs,
+	"zion":        ZionJs,
 }
 
 const ChequebookJs = `
@@ -299,6 +303,11 @@ web3._extend({
 			call: 'debug_vmodule',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'vtag',
+			call: 'debug_vtag',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'backtraceAt',
 			call: 'debug_backtraceAt',
@@ -460,6 +469,12 @@ web3._extend({
 			call: 'debug_getBadBlocks',
 			params: 0,
 		}),
+		new web3._extend.Method({
+			name: 'dryRunMigrations',
+			call: 'debug_dryRunMigrations',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, null]
+		}),
 		new web3._extend.Method({
 			name: 'storageRangeAt',
 			call: 'debug_storageRangeAt',
@@ -644,6 +659,84 @@ web3._extend({
 });
 `
 
+const CrossChainJs = `
+web3._extend({
+	property: 'crosschain',
+	methods: [
+		new web3._extend.Method({
+			name: 'simulateImport',
+			call: 'crosschain_simulateImport',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'headerSyncStatus',
+			call: 'crosschain_headerSyncStatus',
+			params: 1,
+			inputFormatter: [web3._extend.utils.fromDecimal]
+		}),
+		new web3._extend.Method({
+			name: 'getOutboundRange',
+			call: 'crosschain_getOutboundRange',
+			params: 3,
+			inputFormatter: [web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal]
+		}),
+		new web3._extend.Method({
+			name: 'getDeliveryStatus',
+			call: 'crosschain_getDeliveryStatus',
+			params: 2,
+			inputFormatter: [web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal]
+		}),
+		new web3._extend.Method({
+			name: 'onboardSideChain',
+			call: 'crosschain_onboardSideChain',
+			params: 1
+		}),
+	]
+});
+`
+
+const RelayerJs = `
+web3._extend({
+	property: 'relayer',
+	methods: [
+		new web3._extend.Method({
+			name: 'submit',
+			call: 'relayer_submit',
+			params: 1
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'account',
+			getter: 'relayer_account'
+		}),
+		new web3._extend.Property({
+			name: 'pending',
+			getter: 'relayer_pending'
+		}),
+	]
+});
+`
+
+const HeaderProxyJs = `
+web3._extend({
+	property: 'headerproxy',
+	methods: [
+		new web3._extend.Method({
+			name: 'fetch',
+			call: 'headerproxy_fetch',
+			params: 2
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'chains',
+			getter: 'headerproxy_chains'
+		}),
+	]
+});
+`
+
 const NetJs = `
 web3._extend({
 	property: 'net',
@@ -925,3 +1018,23 @@ web3._extend({
 	]
 });
 `
+
+const ZionJs = `
+web3._extend({
+	property: 'zion',
+	methods: [
+		new web3._extend.Method({
+			name: 'decodeTx',
+			call: 'zion_decodeTx',
+			params: 2,
+			inputFormatter: [null, null]
+		}),
+		new web3._extend.Method({
+			name: 'votingPower',
+			call: 'zion_votingPower',
+			params: 1,
+			inputFormatter: [null]
+		}),
+	]
+});
+`
@@ -33,6 +33,9 @@ var errVmoduleSyntax = errors.New("expect comma-separated list of filename=N")
 // errTraceSyntax is returned when a user backtrace pattern is invalid.
 var errTraceSyntax = errors.New("expect file.go:234")
 
+// errVtagSyntax is returned when a user tag pattern is invalid.
+var errVtagSyntax = errors.New("expect comma-separated list of module[/chainID]=N")
+
 // GlogHandler is a log handler that mimics the filtering features of Google's
 // glog logger: setting global log levels; overriding with callsite pattern
 // matches; and requesting backtraces at certain positions.
@@ -47,6 +50,9 @@ type GlogHandler struct {
 	siteCache map[uintptr]Lvl // Cache of callsite pattern evaluations
 	location  string          // file:line location where to do a stackdump at
 	lock      sync.RWMutex    // Lock protecting the override pattern list
+
+	tagged uint32         // Flag whether context tag levels are used, atomically accessible
+	tags   map[string]Lvl // Current levels of context tags, protected by lock
 }
 
 // NewGlogHandler creates a new log handler with filtering functionality similar
@@ -143,6 +149,84 @@ func (h *GlogHandler) Vmodule(ruleset string) error {
 	return nil
 }
 
+// TagKeys are the context keys of a log record that are checked by Vtag, the tag of
+// a record is the value of "module", optionally suffixed with "/" and the value of
+// "chainID".
+const (
+	TagModuleKey = "module"
+	TagChainKey  = "chainID"
+)
+
+// Vtag sets the verbosity of the records tagged by context fields, which allows the
+// logs of a single module, or a single chain in a module, to be raised regardless of
+// the call site.
+//
+// The syntax of the argument is a comma-separated list of tag=N, for instance:
+//
+//  tag="node_manager=4"
+//   sets the V level to 4 in all records with the context field module=node_manager
+//
+//  tag="cross_chain/2=5"
+//   sets the V level to 5 in all records with module=cross_chain and chainID=2
+func (h *GlogHandler) Vtag(ruleset string) error {
+	tags := make(map[string]Lvl)
+	for _, rule := range strings.Split(ruleset, ",") {
+		if len(rule) == 0 {
+			continue
+		}
+		parts := strings.Split(rule, "=")
+		if len(parts) != 2 {
+			return errVtagSyntax
+		}
+		parts[0] = strings.TrimSpace(parts[0])
+		parts[1] = strings.TrimSpace(parts[1])
+		if len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return errVtagSyntax
+		}
+		level, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return errVtagSyntax
+		}
+		if level <= 0 {
+			continue
+		}
+		tags[parts[0]] = Lvl(level)
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.tags = tags
+	atomic.StoreUint32(&h.tagged, uint32(len(tags)))
+
+	return nil
+}
+
+// tagLevel returns the level of the most specific tag of the record.
+func (h *GlogHandler) tagLevel(r *Record) (Lvl, bool) {
+	var module, chain string
+	for i := 0; i+1 < len(r.Ctx); i += 2 {
+		switch r.Ctx[i] {
+		case TagModuleKey:
+			module = fmt.Sprint(r.Ctx[i+1])
+		case TagChainKey:
+			chain = fmt.Sprint(r.Ctx[i+1])
+		}
+	}
+	if module == "" {
+		return 0, false
+	}
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	if chain != "" {
+		if lvl, ok := h.tags[module+"/"+chain]; ok {
+			return lvl, true
+		}
+	}
+	lvl, ok := h.tags[module]
+	return lvl, ok
+}
+
 // BacktraceAt sets the glog backtrace location. When set to a file and line
 // number holding a logging statement, a stack trace will be written to the Info
 // log whenever execution hits that statement.
@@ -201,6 +285,12 @@ func (h *GlogHandler) Log(r *Record) error {
 	if atomic.LoadUint32(&h.level) >= uint32(r.Lvl) {
 		return h.origin.Log(r)
 	}
+	// If the record is tagged with a raised level, the tag takes precedence
+	if atomic.LoadUint32(&h.tagged) > 0 {
+		if lvl, ok := h.tagLevel(r); ok && lvl >= r.Lvl {
+			return h.origin.Log(r)
+		}
+	}
 	// If no local overrides are present, fast track skipping
 	if atomic.LoadUint32(&h.override) == 0 {
 		return nil
@@ -0,0 +1,30 @@
+package log
+
+import "testing"
+
+func TestGlogHandlerVtag(t *testing.T) {
+	var records []*Record
+	h := NewGlogHandler(FuncHandler(func(r *Record) error {
+		records = append(records, r)
+		return nil
+	}))
+	h.Verbosity(LvlInfo)
+	if err := h.Vtag("cross_chain=3, cross_chain/2=5,node_manager=4"); err != nil {
+		t.Fatalf("failed to set tags: %v", err)
+	}
+	logger := New()
+	logger.SetHandler(h)
+
+	logger.Trace("raised chain", TagModuleKey, "cross_chain", TagChainKey, uint64(2))
+	logger.Trace("other chain", TagModuleKey, "cross_chain", TagChainKey, uint64(3))
+	logger.Debug("raised module", TagModuleKey, "node_manager")
+	logger.Trace("above module level", TagModuleKey, "node_manager")
+	logger.Debug("untagged")
+
+	if len(records) != 2 || records[0].Msg != "raised chain" || records[1].Msg != "raised module" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+	if err := h.Vtag("cross_chain/2"); err != errVtagSyntax {
+		t.Fatalf("expected syntax error, got %v", err)
+	}
+}
@@ -839,6 +839,13 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 			txs.Pop()
 			continue
 		}
+		// System transactions are only generated by the consensus engine at the end of block
+		if _, ok := w.engine.(consensus.SystemTxSigner); ok && core.IsSystemTx(w.chainConfig, tx, from, w.current.header) {
+			log.Trace("Ignoring system transaction from pool", "hash", tx.Hash(), "sender", from)
+
+			txs.Pop()
+			continue
+		}
 		// Start executing the transaction
 		w.current.state.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
 
@@ -967,6 +974,10 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 	if w.chainConfig.DAOForkSupport && w.chainConfig.DAOForkBlock != nil && w.chainConfig.DAOForkBlock.Cmp(header.Number) == 0 {
 		misc.ApplyDAOHardFork(env.state)
 	}
+	if err := native.ApplyMigrations(w.chainConfig, env.state, header.Number); err != nil {
+		log.Error("Failed to apply native migrations", "err", err)
+		return
+	}
 	// Accumulate the uncles for the current block
 	uncles := make([]*types.Header, 0, 2)
 	commitUncles := func(blocks map[common.Hash]*types.Block) {
@@ -1037,7 +1048,11 @@ func (w *worker) commit(uncles []*types.Header, interval func(), update bool, st
 	// Deep copy receipts here to avoid interaction between different tasks.
 	receipts := copyReceipts(w.current.receipts)
 	s := w.current.state.Copy()
-	block, err := w.engine.FinalizeAndAssemble(w.chain, w.current.header, s, w.current.txs, uncles, receipts)
+	header, txs, receipts, err := applySystemTxs(w.engine, w.chain, s, w.current.header, w.current.txs, receipts)
+	if err != nil {
+		return err
+	}
+	block, err := w.engine.FinalizeAndAssemble(w.chain, header, s, txs, uncles, receipts)
 	if err != nil {
 		return err
 	}
@@ -1063,6 +1078,25 @@ func (w *worker) commit(uncles []*types.Header, interval func(), update bool, st
 	return nil
 }
 
+// applySystemTxs appends the system transactions signed by the consensus engine to the end of
+// block. they are applied on the copied state and header of the sealing task, so that the current
+// environment is still available for the transactions arrived later.
+func applySystemTxs(engine consensus.Engine, chain *core.BlockChain, state *state.StateDB, header *types.Header,
+	txs []*types.Transaction, receipts []*types.Receipt) (*types.Header, []*types.Transaction, []*types.Receipt, error) {
+
+	signer, ok := engine.(consensus.SystemTxSigner)
+	if !ok {
+		return header, txs, receipts, nil
+	}
+	header = types.CopyHeader(header)
+	systemTxs, systemReceipts, err := core.ApplySystemTxs(chain.Config(), chain, state, header, len(txs), signer.SignSystemTx, *chain.GetVMConfig())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	txs = append(txs[:len(txs):len(txs)], systemTxs...)
+	return header, txs, append(receipts, systemReceipts...), nil
+}
+
 // copyReceipts makes a deep copy of the given receipts.
 func copyReceipts(receipts []*types.Receipt) []*types.Receipt {
 	result := make([]*types.Receipt, len(receipts))
@@ -485,7 +485,12 @@ func (w *eventDrivenWorker) commit(interval func(), update bool, start time.Time
 	// Deep copy receipts here to avoid interaction between different tasks.
 	receipts := copyReceipts(w.current.receipts)
 	s := w.current.state.Copy()
-	block, err := w.engine.FinalizeAndAssemble(w.chain, w.current.header, s, w.current.txs, nil, receipts)
+	header, txs, receipts, err := applySystemTxs(w.engine, w.chain, s, w.current.header, w.current.txs, receipts)
+	if err != nil {
+		log.Trace("Miner worker apply system transactions failed", "err", err)
+		return err
+	}
+	block, err := w.engine.FinalizeAndAssemble(w.chain, header, s, txs, nil, receipts)
 	if err != nil {
 		log.Trace("Miner worker FinalizeAndAssemble failed", "err", err)
 		return err
@@ -635,6 +640,13 @@ func (w *eventDrivenWorker) commitTransactions(txs *types.TransactionsByPriceAnd
 			txs.Pop()
 			continue
 		}
+		// System transactions are only generated by the consensus engine at the end of block
+		if _, ok := w.engine.(consensus.SystemTxSigner); ok && core.IsSystemTx(w.chainConfig, tx, from, w.current.header) {
+			log.Trace("Ignoring system transaction from pool", "hash", tx.Hash(), "sender", from)
+
+			txs.Pop()
+			continue
+		}
 		// Start executing the transaction
 		w.current.state.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
 
@@ -243,16 +243,16 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil}
 
-	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil}
 	TestRules       = TestChainConfig.Rules(new(big.Int))
 )
 
@@ -335,6 +335,27 @@ type ChainConfig struct {
 	EWASMBlock    *big.Int `json:"ewasmBlock,omitempty"`    // EWASM switch block (nil = no fork, 0 = already activated)
 	CatalystBlock *big.Int `json:"catalystBlock,omitempty"` // Catalyst switch block (nil = no fork, 0 = already on catalyst)
 
+	// Native contract behavior changes
+	GovV2Block            *big.Int `json:"govV2Block,omitempty"`            // Governance v2 switch block, configurable quorum rule (nil = no fork, 0 = already on v2)
+	CrossChainV2Block     *big.Int `json:"crossChainV2Block,omitempty"`     // Cross chain v2 switch block, delivery receipts (nil = no fork, 0 = already on v2)
+	StorageV2Block        *big.Int `json:"storageV2Block,omitempty"`        // Storage v2 switch block, compressed headers and epochs (nil = no fork, 0 = already on v2)
+	CrossChainBloomBlock  *big.Int `json:"crossChainBloomBlock,omitempty"`  // Cross chain bloom switch block, marker logs of bridge events (nil = no fork, 0 = already activated)
+	ImportRootBlock       *big.Int `json:"importRootBlock,omitempty"`       // Import root switch block, merkle root of imports per block (nil = no fork, 0 = already activated)
+	EpochHashV1Block      *big.Int `json:"epochHashV1Block,omitempty"`      // Epoch hash v1 switch block, version byte in epoch hash preimage (nil = no fork, 0 = already on v1)
+	InputRulesBlock       *big.Int `json:"inputRulesBlock,omitempty"`       // Input rules switch block, bounds checking of native method arguments (nil = no fork, 0 = already activated)
+	SystemTxBlock         *big.Int `json:"systemTxBlock,omitempty"`         // System tx switch block, consensus initiated native calls in system transactions (nil = no fork, 0 = already activated)
+	LightClientV2Block    *big.Int `json:"lightClientV2Block,omitempty"`    // Light client v2 switch block, hardened tendermint commit verification and trusted header expiry (nil = no fork, 0 = already on v2)
+	StorageRefundBlock    *big.Int `json:"storageRefundBlock,omitempty"`    // Storage refund switch block, gas refund of released native storage (nil = no fork, 0 = already activated)
+	EpochHashV2Block      *big.Int `json:"epochHashV2Block,omitempty"`      // Epoch hash v2 switch block, proposer in epoch hash preimage (nil = no fork, 0 = already on v2)
+	AccessControlBlock    *big.Int `json:"accessControlBlock,omitempty"`    // Access control switch block, roles checked by governance methods (nil = no fork, 0 = already activated)
+	StorageRootCacheBlock *big.Int `json:"storageRootCacheBlock,omitempty"` // Storage root cache switch block, bounded cache of verified source chain storage roots (nil = no fork, 0 = already activated)
+	ReentrancyGuardBlock  *big.Int `json:"reentrancyGuardBlock,omitempty"`  // Reentrancy guard switch block, native contracts entered through evm callbacks are guarded (nil = no fork, 0 = already activated)
+	NativeRevertBlock     *big.Int `json:"nativeRevertBlock,omitempty"`     // Native revert switch block, typed native errors reverted with data (nil = no fork, 0 = already activated)
+
+	// NativeMigrations maps the name of native contract storage migration to the hard fork block
+	// it's applied at, before any transaction of the block.
+	NativeMigrations map[string]*big.Int `json:"nativeMigrations,omitempty"`
+
 	// Various consensus engines
 	Ethash   *EthashConfig   `json:"ethash,omitempty"`
 	Clique   *CliqueConfig   `json:"clique,omitempty"`
@@ -344,7 +365,17 @@ type ChainConfig struct {
 // EthashConfig is the consensus engine configs for proof-of-work based sealing.
 // todo:
 type HotStuffConfig struct {
-	Protocol string `json:"protocol"`
+	Protocol string        `json:"protocol"`
+	Quorum   *QuorumConfig `json:"quorum,omitempty"` // quorum rule of the governance, default 2/3 if nil
+}
+
+// QuorumConfig is the quorum rule of validators set in the node manager contract at genesis, only
+// one of the fraction and the threshold is allowed to be set. the rule takes effect since GovV2Block.
+type QuorumConfig struct {
+	Numerator   uint64 `json:"numerator,omitempty"`
+	Denominator uint64 `json:"denominator,omitempty"`
+	Strict      bool   `json:"strict,omitempty"`    // quorum must be more than the fraction, e.g: 2/3+1
+	Threshold   uint64 `json:"threshold,omitempty"` // fixed number of validators
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -468,6 +499,81 @@ func (c *ChainConfig) IsCatalyst(num *big.Int) bool {
 	return isForked(c.CatalystBlock, num)
 }
 
+// IsGovV2 returns whether num is either equal to the governance v2 fork block or greater.
+func (c *ChainConfig) IsGovV2(num *big.Int) bool {
+	return isForked(c.GovV2Block, num)
+}
+
+// IsCrossChainV2 returns whether num is either equal to the cross chain v2 fork block or greater.
+func (c *ChainConfig) IsCrossChainV2(num *big.Int) bool {
+	return isForked(c.CrossChainV2Block, num)
+}
+
+// IsStorageV2 returns whether num is either equal to the storage v2 fork block or greater.
+func (c *ChainConfig) IsStorageV2(num *big.Int) bool {
+	return isForked(c.StorageV2Block, num)
+}
+
+// IsCrossChainBloom returns whether num is either equal to the cross chain bloom fork block or greater.
+func (c *ChainConfig) IsCrossChainBloom(num *big.Int) bool {
+	return isForked(c.CrossChainBloomBlock, num)
+}
+
+// IsImportRoot returns whether num is either equal to the import root fork block or greater.
+func (c *ChainConfig) IsImportRoot(num *big.Int) bool {
+	return isForked(c.ImportRootBlock, num)
+}
+
+// IsEpochHashV1 returns whether num is either equal to the epoch hash v1 fork block or greater.
+func (c *ChainConfig) IsEpochHashV1(num *big.Int) bool {
+	return isForked(c.EpochHashV1Block, num)
+}
+
+// IsInputRules returns whether num is either equal to the input rules fork block or greater.
+func (c *ChainConfig) IsInputRules(num *big.Int) bool {
+	return isForked(c.InputRulesBlock, num)
+}
+
+// IsSystemTx returns whether num is either equal to the system tx fork block or greater.
+func (c *ChainConfig) IsSystemTx(num *big.Int) bool {
+	return isForked(c.SystemTxBlock, num)
+}
+
+// IsLightClientV2 returns whether num is either equal to the light client v2 fork block or greater.
+func (c *ChainConfig) IsLightClientV2(num *big.Int) bool {
+	return isForked(c.LightClientV2Block, num)
+}
+
+// IsStorageRefund returns whether num is either equal to the storage refund fork block or greater.
+func (c *ChainConfig) IsStorageRefund(num *big.Int) bool {
+	return isForked(c.StorageRefundBlock, num)
+}
+
+// IsEpochHashV2 returns whether num is either equal to the epoch hash v2 fork block or greater.
+func (c *ChainConfig) IsEpochHashV2(num *big.Int) bool {
+	return isForked(c.EpochHashV2Block, num)
+}
+
+// IsAccessControl returns whether num is either equal to the access control fork block or greater.
+func (c *ChainConfig) IsAccessControl(num *big.Int) bool {
+	return isForked(c.AccessControlBlock, num)
+}
+
+// IsStorageRootCache returns whether num is either equal to the storage root cache fork block or greater.
+func (c *ChainConfig) IsStorageRootCache(num *big.Int) bool {
+	return isForked(c.StorageRootCacheBlock, num)
+}
+
+// IsReentrancyGuard returns whether num is either equal to the reentrancy guard fork block or greater.
+func (c *ChainConfig) IsReentrancyGuard(num *big.Int) bool {
+	return isForked(c.ReentrancyGuardBlock, num)
+}
+
+// IsNativeRevert returns whether num is either equal to the native revert fork block or greater.
+func (c *ChainConfig) IsNativeRevert(num *big.Int) bool {
+	return isForked(c.NativeRevertBlock, num)
+}
+
 // IsEWASM returns whether num represents a block number after the EWASM fork
 func (c *ChainConfig) IsEWASM(num *big.Int) bool {
 	return isForked(c.EWASMBlock, num)
@@ -582,6 +688,51 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.LondonBlock, newcfg.LondonBlock, head) {
 		return newCompatError("London fork block", c.LondonBlock, newcfg.LondonBlock)
 	}
+	if isForkIncompatible(c.GovV2Block, newcfg.GovV2Block, head) {
+		return newCompatError("Governance v2 fork block", c.GovV2Block, newcfg.GovV2Block)
+	}
+	if isForkIncompatible(c.CrossChainV2Block, newcfg.CrossChainV2Block, head) {
+		return newCompatError("Cross chain v2 fork block", c.CrossChainV2Block, newcfg.CrossChainV2Block)
+	}
+	if isForkIncompatible(c.StorageV2Block, newcfg.StorageV2Block, head) {
+		return newCompatError("Storage v2 fork block", c.StorageV2Block, newcfg.StorageV2Block)
+	}
+	if isForkIncompatible(c.CrossChainBloomBlock, newcfg.CrossChainBloomBlock, head) {
+		return newCompatError("Cross chain bloom fork block", c.CrossChainBloomBlock, newcfg.CrossChainBloomBlock)
+	}
+	if isForkIncompatible(c.ImportRootBlock, newcfg.ImportRootBlock, head) {
+		return newCompatError("Import root fork block", c.ImportRootBlock, newcfg.ImportRootBlock)
+	}
+	if isForkIncompatible(c.EpochHashV1Block, newcfg.EpochHashV1Block, head) {
+		return newCompatError("Epoch hash v1 fork block", c.EpochHashV1Block, newcfg.EpochHashV1Block)
+	}
+	if isForkIncompatible(c.InputRulesBlock, newcfg.InputRulesBlock, head) {
+		return newCompatError("Input rules fork block", c.InputRulesBlock, newcfg.InputRulesBlock)
+	}
+	if isForkIncompatible(c.SystemTxBlock, newcfg.SystemTxBlock, head) {
+		return newCompatError("System tx fork block", c.SystemTxBlock, newcfg.SystemTxBlock)
+	}
+	if isForkIncompatible(c.LightClientV2Block, newcfg.LightClientV2Block, head) {
+		return newCompatError("Light client v2 fork block", c.LightClientV2Block, newcfg.LightClientV2Block)
+	}
+	if isForkIncompatible(c.StorageRefundBlock, newcfg.StorageRefundBlock, head) {
+		return newCompatError("Storage refund fork block", c.StorageRefundBlock, newcfg.StorageRefundBlock)
+	}
+	if isForkIncompatible(c.EpochHashV2Block, newcfg.EpochHashV2Block, head) {
+		return newCompatError("Epoch hash v2 fork block", c.EpochHashV2Block, newcfg.EpochHashV2Block)
+	}
+	if isForkIncompatible(c.AccessControlBlock, newcfg.AccessControlBlock, head) {
+		return newCompatError("Access control fork block", c.AccessControlBlock, newcfg.AccessControlBlock)
+	}
+	if isForkIncompatible(c.StorageRootCacheBlock, newcfg.StorageRootCacheBlock, head) {
+		return newCompatError("Storage root cache fork block", c.StorageRootCacheBlock, newcfg.StorageRootCacheBlock)
+	}
+	if isForkIncompatible(c.ReentrancyGuardBlock, newcfg.ReentrancyGuardBlock, head) {
+		return newCompatError("Reentrancy guard fork block", c.ReentrancyGuardBlock, newcfg.ReentrancyGuardBlock)
+	}
+	if isForkIncompatible(c.NativeRevertBlock, newcfg.NativeRevertBlock, head) {
+		return newCompatError("Native revert fork block", c.NativeRevertBlock, newcfg.NativeRevertBlock)
+	}
 	if isForkIncompatible(c.EWASMBlock, newcfg.EWASMBlock, head) {
 		return newCompatError("ewasm fork block", c.EWASMBlock, newcfg.EWASMBlock)
 	}
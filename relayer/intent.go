@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package relayer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var errInvalidSignature = errors.New("invalid intent signature")
+
+// Intent is a call to the cross chain native contracts which the requester asks the relayer
+// account to send, the relayer service takes care of the nonce, gas price and resubmission.
+type Intent struct {
+	To        common.Address `json:"to"`
+	Data      hexutil.Bytes  `json:"data"`
+	Expiry    hexutil.Uint64 `json:"expiry"` // unix timestamp in seconds after which the intent is dropped
+	Signature hexutil.Bytes  `json:"signature"`
+}
+
+// Hash returns the hash signed by the requester, the chain id is included to prevent the
+// intent from being replayed on another chain.
+func (i *Intent) Hash(chainID *big.Int) common.Hash {
+	enc, _ := rlp.EncodeToBytes([]interface{}{chainID, i.To, []byte(i.Data), uint64(i.Expiry)})
+	return crypto.Keccak256Hash(enc)
+}
+
+// Sign signs the intent with the requester key.
+func (i *Intent) Sign(chainID *big.Int, key *ecdsa.PrivateKey) error {
+	sig, err := crypto.Sign(i.Hash(chainID).Bytes(), key)
+	if err != nil {
+		return err
+	}
+	i.Signature = sig
+	return nil
+}
+
+// Signer recovers the requester address from the signature.
+func (i *Intent) Signer(chainID *big.Int) (common.Address, error) {
+	if len(i.Signature) != crypto.SignatureLength {
+		return common.Address{}, errInvalidSignature
+	}
+	pub, err := crypto.SigToPub(i.Hash(chainID).Bytes(), i.Signature)
+	if err != nil {
+		return common.Address{}, errInvalidSignature
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package relayer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestIntentSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chainID := big.NewInt(1)
+	intent := &Intent{
+		To:     utils.CrossChainManagerContractAddress,
+		Data:   []byte{1, 2, 3},
+		Expiry: 100,
+	}
+	if _, err := intent.Signer(chainID); err != errInvalidSignature {
+		t.Fatalf("unsigned intent: have %v, want %v", err, errInvalidSignature)
+	}
+	if err := intent.Sign(chainID, key); err != nil {
+		t.Fatalf("failed to sign intent: %v", err)
+	}
+	signer, err := intent.Signer(chainID)
+	if err != nil {
+		t.Fatalf("failed to recover signer: %v", err)
+	}
+	if signer != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("signer mismatch: have %x, want %x", signer, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	// The intent must not be valid on another chain
+	if signer, _ := intent.Signer(big.NewInt(2)); signer == crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("intent replayed on another chain")
+	}
+}
@@ -0,0 +1,370 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+// Package relayer implements an optional service which sends the cross chain calls of the
+// relayers with a single managed account, so that concurrent relayers sharing the account
+// don't race on the nonce.
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	errIntentExpired      = errors.New("intent expired")
+	errIntentKnown        = errors.New("intent already submitted")
+	errUnauthorizedSigner = errors.New("unauthorized intent signer")
+	errUnsupportedTarget  = errors.New("intent target is not a cross chain contract")
+)
+
+// Config is the configuration of the relayer service.
+type Config struct {
+	Key       *ecdsa.PrivateKey // key of the relayer account which sends the transactions
+	Signers   []common.Address  // requesters allowed to submit intents, only the relayer account if empty
+	PriceBump uint64            // gas price bump percentage of a resubmission
+	Resubmit  time.Duration     // time to wait for a transaction to be included before the resubmission
+}
+
+// DefaultConfig contains the default settings of the relayer service.
+var DefaultConfig = Config{
+	PriceBump: 10,
+	Resubmit:  30 * time.Second,
+}
+
+// targets are the contracts which the intents are allowed to call.
+var targets = map[common.Address]bool{
+	utils.CrossChainManagerContractAddress: true,
+	utils.HeaderSyncContractAddress:        true,
+}
+
+type pendingTx struct {
+	intent common.Hash
+	tx     *types.Transaction
+	sent   time.Time
+}
+
+// Service sends the intents with the relayer account.
+type Service struct {
+	backend ethapi.Backend
+	config  Config
+	account common.Address
+	signer  types.Signer
+	signers map[common.Address]bool
+
+	mu          sync.Mutex
+	nonce       uint64                 // next nonce to assign
+	nonceSynced bool                   // whether the nonce has been fetched from the pool
+	pending     map[uint64]*pendingTx  // unconfirmed transactions by nonce
+	known       map[common.Hash]uint64 // submitted intents to their expiry
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates the relayer service and registers it to the node.
+func New(stack *node.Node, backend ethapi.Backend, config Config) (*Service, error) {
+	if config.Key == nil {
+		return nil, errors.New("relayer key is not set")
+	}
+	if config.PriceBump == 0 {
+		config.PriceBump = DefaultConfig.PriceBump
+	}
+	if config.Resubmit == 0 {
+		config.Resubmit = DefaultConfig.Resubmit
+	}
+	s := &Service{
+		backend: backend,
+		config:  config,
+		account: crypto.PubkeyToAddress(config.Key.PublicKey),
+		signer:  types.LatestSigner(backend.ChainConfig()),
+		signers: make(map[common.Address]bool),
+		pending: make(map[uint64]*pendingTx),
+		known:   make(map[common.Hash]uint64),
+		quit:    make(chan struct{}),
+	}
+	for _, addr := range config.Signers {
+		s.signers[addr] = true
+	}
+	if len(s.signers) == 0 {
+		s.signers[s.account] = true
+	}
+
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "relayer",
+		Version:   "1.0",
+		Service:   NewPublicRelayerAPI(s),
+		Public:    true,
+	}})
+	stack.RegisterLifecycle(s)
+	return s, nil
+}
+
+// Start implements node.Lifecycle, starting the resubmission loop.
+func (s *Service) Start() error {
+	s.wg.Add(1)
+	go s.loop()
+	log.Info("Started relayer service", "account", s.account)
+	return nil
+}
+
+// Stop implements node.Lifecycle, terminating the resubmission loop.
+func (s *Service) Stop() error {
+	close(s.quit)
+	s.wg.Wait()
+	log.Info("Relayer service stopped")
+	return nil
+}
+
+// Submit validates the intent and sends it with the next nonce of the relayer account.
+func (s *Service) Submit(ctx context.Context, intent *Intent) (common.Hash, error) {
+	chainID := s.backend.ChainConfig().ChainID
+	if uint64(intent.Expiry) <= uint64(time.Now().Unix()) {
+		return common.Hash{}, errIntentExpired
+	}
+	if !targets[intent.To] {
+		return common.Hash{}, errUnsupportedTarget
+	}
+	requester, err := intent.Signer(chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if !s.signers[requester] {
+		return common.Hash{}, errUnauthorizedSigner
+	}
+	tx, err := s.relay(ctx, intent.To, intent.Data, intent.Hash(chainID), uint64(intent.Expiry))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	log.Debug("Relayer submitted intent", "requester", requester, "to", intent.To, "nonce", tx.Nonce(), "hash", tx.Hash())
+	return tx.Hash(), nil
+}
+
+// Send sends the call with the next nonce of the relayer account, it's used by the node services
+// relaying with the account directly, e.g. the header proxy.
+func (s *Service) Send(ctx context.Context, to common.Address, data []byte) (common.Hash, error) {
+	if !targets[to] {
+		return common.Hash{}, errUnsupportedTarget
+	}
+	tx, err := s.relay(ctx, to, data, common.Hash{}, 0)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	log.Debug("Relayer sent call", "to", to, "nonce", tx.Nonce(), "hash", tx.Hash())
+	return tx.Hash(), nil
+}
+
+// Account returns the address of the relayer account.
+func (s *Service) Account() common.Address {
+	return s.account
+}
+
+// relay sends the call with the next nonce of the relayer account and tracks it for the
+// resubmission, the intent is recorded to reject the duplicates unless its hash is empty.
+func (s *Service) relay(ctx context.Context, to common.Address, data []byte, intent common.Hash, expiry uint64) (*types.Transaction, error) {
+	gas, err := ethapi.DoEstimateGas(ctx, s.backend, ethapi.CallArgs{
+		From: &s.account,
+		To:   &to,
+		Data: (*hexutil.Bytes)(&data),
+	}, rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber), s.backend.RPCGasCap())
+	if err != nil {
+		return nil, err
+	}
+	price, err := s.backend.SuggestPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.known[intent]; ok && intent != (common.Hash{}) {
+		return nil, errIntentKnown
+	}
+	if !s.nonceSynced {
+		if err := s.syncNonce(ctx); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := s.send(ctx, types.NewTransaction(s.nonce, to, new(big.Int), uint64(gas), price, data))
+	if errors.Is(err, core.ErrNonceTooLow) {
+		// The account is used out of the service, catch up with the pool and try again
+		if err = s.syncNonce(ctx); err != nil {
+			return nil, err
+		}
+		tx, err = s.send(ctx, types.NewTransaction(s.nonce, to, new(big.Int), uint64(gas), price, data))
+	}
+	if err != nil {
+		s.nonceSynced = false
+		return nil, err
+	}
+	s.pending[s.nonce] = &pendingTx{intent: intent, tx: tx, sent: time.Now()}
+	if intent != (common.Hash{}) {
+		s.known[intent] = expiry
+	}
+	s.nonce++
+	return tx, nil
+}
+
+// Pending returns the unconfirmed transactions of the relayer account ordered by nonce.
+func (s *Service) Pending() []*types.Transaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txs := make([]*types.Transaction, 0, len(s.pending))
+	for _, p := range s.pending {
+		txs = append(txs, p.tx)
+	}
+	sort.Sort(types.TxByNonce(txs))
+	return txs
+}
+
+// syncNonce fetches the next nonce of the relayer account from the pool.
+func (s *Service) syncNonce(ctx context.Context) error {
+	nonce, err := s.backend.GetPoolNonce(ctx, s.account)
+	if err != nil {
+		return fmt.Errorf("failed to get relayer nonce: %v", err)
+	}
+	s.nonce, s.nonceSynced = nonce, true
+	return nil
+}
+
+// send signs the transaction with the relayer key and sends it to the pool.
+func (s *Service) send(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	signed, err := types.SignTx(tx, s.signer, s.config.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.backend.SendTx(ctx, signed); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+func (s *Service) loop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.config.Resubmit)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.resubmit()
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// resubmit drops the confirmed transactions and expired intents, then resends the transactions
+// which are not included in time with a bumped gas price.
+func (s *Service) resubmit() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Resubmit)
+	defer cancel()
+
+	statedb, _, err := s.backend.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		log.Warn("Relayer failed to get latest state", "err", err)
+		return
+	}
+	confirmed := statedb.GetNonce(s.account)
+	price, err := s.backend.SuggestPrice(ctx)
+	if err != nil {
+		log.Warn("Relayer failed to suggest gas price", "err", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := uint64(time.Now().Unix())
+	for hash, expiry := range s.known {
+		if expiry <= now {
+			delete(s.known, hash)
+		}
+	}
+	for nonce, p := range s.pending {
+		if nonce < confirmed {
+			delete(s.pending, nonce)
+			continue
+		}
+		if time.Since(p.sent) < s.config.Resubmit {
+			continue
+		}
+		bumped := new(big.Int).Mul(p.tx.GasPrice(), new(big.Int).SetUint64(100+s.config.PriceBump))
+		bumped.Div(bumped, big.NewInt(100))
+		if bumped.Cmp(price) < 0 {
+			bumped.Set(price)
+		}
+		tx, err := s.send(ctx, types.NewTransaction(nonce, *p.tx.To(), p.tx.Value(), p.tx.Gas(), bumped, p.tx.Data()))
+		if err != nil {
+			log.Debug("Relayer failed to resubmit transaction", "nonce", nonce, "hash", p.tx.Hash(), "err", err)
+			continue
+		}
+		log.Debug("Relayer resubmitted transaction", "nonce", nonce, "old", p.tx.Hash(), "new", tx.Hash(), "price", bumped)
+		p.tx, p.sent = tx, time.Now()
+	}
+}
+
+// PublicRelayerAPI provides the relayer service over rpc.
+type PublicRelayerAPI struct {
+	s *Service
+}
+
+// NewPublicRelayerAPI creates a new API definition for the relayer service.
+func NewPublicRelayerAPI(s *Service) *PublicRelayerAPI {
+	return &PublicRelayerAPI{s: s}
+}
+
+// Account returns the address of the relayer account.
+func (api *PublicRelayerAPI) Account() common.Address {
+	return api.s.Account()
+}
+
+// Submit sends the signed intent with the relayer account and returns the transaction hash,
+// the hash changes if the transaction is resubmitted with a bumped gas price.
+func (api *PublicRelayerAPI) Submit(ctx context.Context, intent Intent) (common.Hash, error) {
+	return api.s.Submit(ctx, &intent)
+}
+
+// Pending returns the nonce and hash of the unconfirmed transactions of the relayer account.
+func (api *PublicRelayerAPI) Pending() map[hexutil.Uint64]common.Hash {
+	txs := api.s.Pending()
+	pending := make(map[hexutil.Uint64]common.Hash, len(txs))
+	for _, tx := range txs {
+		pending[hexutil.Uint64(tx.Nonce())] = tx.Hash()
+	}
+	return pending
+}